@@ -6,6 +6,7 @@ import (
 
 	inventory "github.com/restaurant-platform/inventory-service/internal/domain"
 	"github.com/restaurant-platform/shared/events"
+	"github.com/restaurant-platform/shared/pkg/concurrency"
 	"github.com/restaurant-platform/shared/pkg/errors"
 )
 
@@ -88,17 +89,12 @@ func (s *InventoryService) GetItemBySKU(ctx context.Context, sku string) (*inven
 
 // AddStock adds stock to an inventory item
 func (s *InventoryService) AddStock(ctx context.Context, itemID inventory.InventoryItemID, quantity float64, notes, reference, performedBy string) error {
-	item, err := s.inventoryRepo.GetItemByID(ctx, itemID)
-	if err != nil {
+	var movement *inventory.StockMovement
+	item, err := s.modifyItem(ctx, s.itemByID(ctx, itemID), func(item *inventory.InventoryItem) error {
+		var err error
+		movement, err = item.AddMovement(inventory.MovementTypeReceived, quantity, notes, reference, performedBy)
 		return err
-	}
-
-	movement, err := item.AddMovement(inventory.MovementTypeReceived, quantity, notes, reference, performedBy)
-	if err != nil {
-		return err
-	}
-
-	err = s.inventoryRepo.UpdateItem(ctx, item)
+	})
 	if err != nil {
 		return err
 	}
@@ -134,18 +130,15 @@ func (s *InventoryService) AddStock(ctx context.Context, itemID inventory.Invent
 
 // UseStock removes stock from an inventory item
 func (s *InventoryService) UseStock(ctx context.Context, itemID inventory.InventoryItemID, quantity float64, notes, reference, performedBy string) error {
-	item, err := s.inventoryRepo.GetItemByID(ctx, itemID)
-	if err != nil {
-		return err
-	}
+	var previousStock float64
+	var movement *inventory.StockMovement
+	item, err := s.modifyItem(ctx, s.itemByID(ctx, itemID), func(item *inventory.InventoryItem) error {
+		previousStock = item.CurrentStock
 
-	previousStock := item.CurrentStock
-	movement, err := item.AddMovement(inventory.MovementTypeUsed, quantity, notes, reference, performedBy)
-	if err != nil {
+		var err error
+		movement, err = item.AddMovement(inventory.MovementTypeUsed, quantity, notes, reference, performedBy)
 		return err
-	}
-
-	err = s.inventoryRepo.UpdateItem(ctx, item)
+	})
 	if err != nil {
 		return err
 	}
@@ -184,23 +177,20 @@ func (s *InventoryService) UseStock(ctx context.Context, itemID inventory.Invent
 
 // ReserveStock reserves stock for an order
 func (s *InventoryService) ReserveStock(ctx context.Context, sku string, quantity float64, reference, performedBy string) error {
-	item, err := s.inventoryRepo.GetItemBySKU(ctx, sku)
-	if err != nil {
-		return err
-	}
-
-	if !item.CanFulfillOrder(quantity) {
-		// Publish out of stock alert
-		s.publishOutOfStockAlert(ctx, item, quantity)
-		return errors.ErrInsufficientStock
-	}
-
-	movement, err := item.ReserveStock(quantity, reference, performedBy)
-	if err != nil {
+	var movement *inventory.StockMovement
+	item, err := s.modifyItem(ctx, func() (*inventory.InventoryItem, error) {
+		return s.inventoryRepo.GetItemBySKU(ctx, sku)
+	}, func(item *inventory.InventoryItem) error {
+		if !item.CanFulfillOrder(quantity) {
+			// Publish out of stock alert
+			s.publishOutOfStockAlert(ctx, item, quantity)
+			return errors.ErrInsufficientStock
+		}
+
+		var err error
+		movement, err = item.ReserveStock(quantity, reference, performedBy)
 		return err
-	}
-
-	err = s.inventoryRepo.UpdateItem(ctx, item)
+	})
 	if err != nil {
 		return err
 	}
@@ -251,17 +241,10 @@ func (s *InventoryService) GetStockLevel(ctx context.Context, sku string) (float
 
 // UpdateThresholds updates the stock thresholds for an item
 func (s *InventoryService) UpdateThresholds(ctx context.Context, itemID inventory.InventoryItemID, min, max, reorderPoint float64) error {
-	item, err := s.inventoryRepo.GetItemByID(ctx, itemID)
-	if err != nil {
-		return err
-	}
-
-	err = item.UpdateThresholds(min, max, reorderPoint)
-	if err != nil {
-		return err
-	}
-
-	return s.inventoryRepo.UpdateItem(ctx, item)
+	_, err := s.modifyItem(ctx, s.itemByID(ctx, itemID), func(item *inventory.InventoryItem) error {
+		return item.UpdateThresholds(min, max, reorderPoint)
+	})
+	return err
 }
 
 // GetLowStockItems returns items that are below reorder point
@@ -281,28 +264,23 @@ func (s *InventoryService) ListItems(ctx context.Context, offset, limit int, fil
 
 // RecordMovement records a stock movement
 func (s *InventoryService) RecordMovement(ctx context.Context, itemID inventory.InventoryItemID, movementType inventory.MovementType, quantity float64, notes, reference, performedBy string) error {
-	item, err := s.inventoryRepo.GetItemByID(ctx, itemID)
-	if err != nil {
+	// Update the item with new stock level
+	var movement *inventory.StockMovement
+	item, err := s.modifyItem(ctx, s.itemByID(ctx, itemID), func(item *inventory.InventoryItem) error {
+		var err error
+		movement, err = item.AddMovement(movementType, quantity, notes, reference, performedBy)
 		return err
-	}
-
-	movement, err := item.AddMovement(movementType, quantity, notes, reference, performedBy)
+	})
 	if err != nil {
 		return err
 	}
 
-	// Save the movement
+	// Save the movement once the stock level it produced has been stored
 	err = s.inventoryRepo.CreateMovement(ctx, movement)
 	if err != nil {
 		return err
 	}
 
-	// Update the item with new stock level
-	err = s.inventoryRepo.UpdateItem(ctx, item)
-	if err != nil {
-		return err
-	}
-
 	// Publish movement event based on type
 	eventType := events.StockUsedEvent
 	switch movementType {
@@ -498,6 +476,40 @@ func (s *InventoryService) ListSuppliers(ctx context.Context, offset, limit int)
 	return s.inventoryRepo.ListSuppliersWithPagination(ctx, offset, limit)
 }
 
+// modifyItem loads an inventory item, applies change and saves it, starting over from a
+// fresh copy when another writer saved the item in between
+func (s *InventoryService) modifyItem(ctx context.Context, load func() (*inventory.InventoryItem, error), change func(item *inventory.InventoryItem) error) (*inventory.InventoryItem, error) {
+	var item *inventory.InventoryItem
+	err := concurrency.RetryOnConflict(ctx, func() error {
+		var err error
+		item, err = load()
+		if err != nil {
+			return err
+		}
+
+		if err := concurrency.CheckVersion(ctx, "modifyItem", "inventory_item", item.ID.String(), item.Version); err != nil {
+			return err
+		}
+
+		if err := change(item); err != nil {
+			return err
+		}
+
+		return s.inventoryRepo.UpdateItem(ctx, item)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return item, nil
+}
+
+// itemByID returns a loader of the inventory item with the given ID for modifyItem
+func (s *InventoryService) itemByID(ctx context.Context, itemID inventory.InventoryItemID) func() (*inventory.InventoryItem, error) {
+	return func() (*inventory.InventoryItem, error) {
+		return s.inventoryRepo.GetItemByID(ctx, itemID)
+	}
+}
+
 // Helper function to check and publish stock alerts
 func (s *InventoryService) checkAndPublishStockAlerts(ctx context.Context, item *inventory.InventoryItem, previousStock float64) {
 	// Check if item just went out of stock
@@ -406,6 +406,45 @@ func (suite *InventoryServiceTestSuite) TestUseStock_Success() {
 	assert.Equal(suite.T(), quantity, item.Movements[0].Quantity)
 }
 
+func (suite *InventoryServiceTestSuite) TestUseStock_VersionConflict_ShouldReapplyToFreshStock() {
+	// Given
+	itemID := inventory.InventoryItemID("inv_123")
+	staleItem := &inventory.InventoryItem{
+		ID:           itemID,
+		SKU:          "INV001",
+		Name:         "Test Item",
+		CurrentStock: 50.0,
+		Unit:         inventory.UnitTypeKilograms,
+		Movements:    make([]*inventory.StockMovement, 0),
+		Version:      1,
+	}
+	// Another writer used stock in the meantime
+	freshItem := &inventory.InventoryItem{
+		ID:           itemID,
+		SKU:          "INV001",
+		Name:         "Test Item",
+		CurrentStock: 45.0,
+		Unit:         inventory.UnitTypeKilograms,
+		Movements:    make([]*inventory.StockMovement, 0),
+		Version:      2,
+	}
+	conflict := sharederrors.WrapVersionConflict("InventoryRepository.UpdateItem", "inventory_item", itemID.String(), 1)
+
+	suite.mockRepo.On("GetItemByID", suite.ctx, itemID).Return(staleItem, nil).Once()
+	suite.mockRepo.On("UpdateItem", suite.ctx, staleItem).Return(conflict).Once()
+	suite.mockRepo.On("GetItemByID", suite.ctx, itemID).Return(freshItem, nil).Once()
+	suite.mockRepo.On("UpdateItem", suite.ctx, freshItem).Return(nil).Once()
+	suite.mockPublisher.On("Publish", suite.ctx, mock.AnythingOfType("*events.DomainEvent")).Return(nil)
+
+	// When
+	err := suite.service.UseStock(suite.ctx, itemID, 20.0, "", "ORD001", "kitchen")
+
+	// Then
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 25.0, freshItem.CurrentStock)
+	suite.mockRepo.AssertExpectations(suite.T())
+}
+
 func (suite *InventoryServiceTestSuite) TestUseStock_InsufficientStock() {
 	// Given
 	itemID := inventory.InventoryItemID("inv_123")
@@ -73,6 +73,7 @@ type InventoryItem struct {
 	LastOrdered  time.Time        `json:"last_ordered,omitempty"`
 	ExpiryDate   time.Time        `json:"expiry_date,omitempty"`
 	Movements    []*StockMovement `json:"movements,omitempty"`
+	Version      int              `json:"version"`
 	CreatedAt    time.Time        `json:"created_at"`
 	UpdatedAt    time.Time        `json:"updated_at"`
 }
@@ -148,6 +149,7 @@ func NewInventoryItem(sku, name string, initialStock float64, unit UnitType, cos
 		Unit:         unit,
 		Cost:         cost,
 		Movements:    make([]*StockMovement, 0),
+		Version:      1,
 		CreatedAt:    now,
 		UpdatedAt:    now,
 	}, nil
@@ -80,6 +80,7 @@ func createTestTables(db *sql.DB) error {
 			supplier_id TEXT,
 			last_ordered DATETIME,
 			expiry_date DATETIME,
+			version INTEGER NOT NULL DEFAULT 1,
 			created_at DATETIME NOT NULL,
 			updated_at DATETIME NOT NULL
 		)`
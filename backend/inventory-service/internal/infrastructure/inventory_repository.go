@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"time"
 	inventory "github.com/restaurant-platform/inventory-service/internal/domain"
+	"github.com/restaurant-platform/shared/pkg/errors"
 )
 
 type InventoryRepository struct {
@@ -21,14 +22,14 @@ func (r *InventoryRepository) CreateItem(ctx context.Context, item *inventory.In
 		INSERT INTO inventory (
 			id, sku, name, description, current_stock, unit, min_threshold, 
 			max_threshold, reorder_point, cost, category, location, supplier_id, 
-			last_ordered, expiry_date, created_at, updated_at
-		) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+			last_ordered, expiry_date, version, created_at, updated_at
+		) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
 
 	_, err := r.db.ExecContext(ctx, query,
 		item.ID.String(), item.SKU, item.Name, item.Description, item.CurrentStock,
 		string(item.Unit), item.MinThreshold, item.MaxThreshold, item.ReorderPoint,
 		item.Cost, item.Category, item.Location, nullString(item.SupplierID.String()),
-		nullTime(item.LastOrdered), nullTime(item.ExpiryDate), item.CreatedAt, item.UpdatedAt)
+		nullTime(item.LastOrdered), nullTime(item.ExpiryDate), item.Version, item.CreatedAt, item.UpdatedAt)
 
 	return err
 }
@@ -37,7 +38,7 @@ func (r *InventoryRepository) GetItemByID(ctx context.Context, id inventory.Inve
 	query := `
 		SELECT id, sku, name, description, current_stock, unit, min_threshold,
 		       max_threshold, reorder_point, cost, category, location, supplier_id,
-		       last_ordered, expiry_date, created_at, updated_at
+		       last_ordered, expiry_date, version, created_at, updated_at
 		FROM inventory WHERE id = ?`
 
 	var item inventory.InventoryItem
@@ -49,7 +50,7 @@ func (r *InventoryRepository) GetItemByID(ctx context.Context, id inventory.Inve
 		&idStr, &item.SKU, &item.Name, &item.Description, &item.CurrentStock,
 		&unit, &item.MinThreshold, &item.MaxThreshold, &item.ReorderPoint,
 		&item.Cost, &item.Category, &item.Location, &supplierStr,
-		&lastOrdered, &expiryDate, &item.CreatedAt, &item.UpdatedAt)
+		&lastOrdered, &expiryDate, &item.Version, &item.CreatedAt, &item.UpdatedAt)
 
 	if err != nil {
 		if err == sql.ErrNoRows {
@@ -77,7 +78,7 @@ func (r *InventoryRepository) GetItemBySKU(ctx context.Context, sku string) (*in
 	query := `
 		SELECT id, sku, name, description, current_stock, unit, min_threshold,
 		       max_threshold, reorder_point, cost, category, location, supplier_id,
-		       last_ordered, expiry_date, created_at, updated_at
+		       last_ordered, expiry_date, version, created_at, updated_at
 		FROM inventory WHERE sku = ?`
 
 	var item inventory.InventoryItem
@@ -89,7 +90,7 @@ func (r *InventoryRepository) GetItemBySKU(ctx context.Context, sku string) (*in
 		&idStr, &item.SKU, &item.Name, &item.Description, &item.CurrentStock,
 		&unit, &item.MinThreshold, &item.MaxThreshold, &item.ReorderPoint,
 		&item.Cost, &item.Category, &item.Location, &supplierStr,
-		&lastOrdered, &expiryDate, &item.CreatedAt, &item.UpdatedAt)
+		&lastOrdered, &expiryDate, &item.Version, &item.CreatedAt, &item.UpdatedAt)
 
 	if err != nil {
 		if err == sql.ErrNoRows {
@@ -120,15 +121,16 @@ func (r *InventoryRepository) UpdateItem(ctx context.Context, item *inventory.In
 		SET name = ?, description = ?, current_stock = ?, unit = ?,
 		    min_threshold = ?, max_threshold = ?, reorder_point = ?,
 		    cost = ?, category = ?, location = ?, supplier_id = ?,
-		    last_ordered = ?, expiry_date = ?, updated_at = ?
-		WHERE id = ?`
+		    last_ordered = ?, expiry_date = ?, updated_at = ?,
+		    version = version + 1
+		WHERE id = ? AND version = ?`
 
 	result, err := r.db.ExecContext(ctx, query,
 		item.Name, item.Description, item.CurrentStock, string(item.Unit),
 		item.MinThreshold, item.MaxThreshold, item.ReorderPoint,
 		item.Cost, item.Category, item.Location, nullString(item.SupplierID.String()),
 		nullTime(item.LastOrdered), nullTime(item.ExpiryDate), item.UpdatedAt,
-		item.ID.String())
+		item.ID.String(), item.Version)
 
 	if err != nil {
 		return err
@@ -141,9 +143,19 @@ func (r *InventoryRepository) UpdateItem(ctx context.Context, item *inventory.In
 	}
 	
 	if rowsAffected == 0 {
-		return fmt.Errorf("no rows updated for item ID %s", item.ID.String())
+		// Either the item is gone or another writer saved a newer version since it was loaded
+		var exists bool
+		err := r.db.QueryRowContext(ctx, `SELECT EXISTS(SELECT 1 FROM inventory WHERE id = ?)`, item.ID.String()).Scan(&exists)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return fmt.Errorf("no rows updated for item ID %s", item.ID.String())
+		}
+		return errors.WrapVersionConflict("InventoryRepository.UpdateItem", "inventory_item", item.ID.String(), item.Version)
 	}
-	
+
+	item.Version++
 	return nil
 }
 
@@ -166,7 +178,7 @@ func (r *InventoryRepository) GetLowStockItems(ctx context.Context) ([]*inventor
 	query := `
 		SELECT id, sku, name, description, current_stock, unit, min_threshold,
 		       max_threshold, reorder_point, cost, category, location, supplier_id,
-		       last_ordered, expiry_date, created_at, updated_at
+		       last_ordered, expiry_date, version, created_at, updated_at
 		FROM inventory 
 		WHERE current_stock <= reorder_point AND reorder_point > 0
 		ORDER BY current_stock ASC`
@@ -178,7 +190,7 @@ func (r *InventoryRepository) GetOutOfStockItems(ctx context.Context) ([]*invent
 	query := `
 		SELECT id, sku, name, description, current_stock, unit, min_threshold,
 		       max_threshold, reorder_point, cost, category, location, supplier_id,
-		       last_ordered, expiry_date, created_at, updated_at
+		       last_ordered, expiry_date, version, created_at, updated_at
 		FROM inventory 
 		WHERE current_stock <= 0
 		ORDER BY updated_at DESC`
@@ -197,7 +209,7 @@ func (r *InventoryRepository) ListItems(ctx context.Context, offset, limit int)
 	query := `
 		SELECT id, sku, name, description, current_stock, unit, min_threshold,
 		       max_threshold, reorder_point, cost, category, location, supplier_id,
-		       last_ordered, expiry_date, created_at, updated_at
+		       last_ordered, expiry_date, version, created_at, updated_at
 		FROM inventory 
 		ORDER BY name ASC 
 		LIMIT ? OFFSET ?`
@@ -225,7 +237,7 @@ func (r *InventoryRepository) queryItems(ctx context.Context, query string, args
 			&idStr, &item.SKU, &item.Name, &item.Description, &item.CurrentStock,
 			&unit, &item.MinThreshold, &item.MaxThreshold, &item.ReorderPoint,
 			&item.Cost, &item.Category, &item.Location, &supplierStr,
-			&lastOrdered, &expiryDate, &item.CreatedAt, &item.UpdatedAt)
+			&lastOrdered, &expiryDate, &item.Version, &item.CreatedAt, &item.UpdatedAt)
 		if err != nil {
 			return nil, err
 		}
@@ -274,7 +286,7 @@ func (r *InventoryRepository) GetItemsByCategory(ctx context.Context, category s
 	query := `
 		SELECT id, sku, name, description, current_stock, unit, min_threshold,
 		       max_threshold, reorder_point, cost, category, location, supplier_id,
-		       last_ordered, expiry_date, created_at, updated_at
+		       last_ordered, expiry_date, version, created_at, updated_at
 		FROM inventory WHERE category = ?`
 	
 	return r.queryItems(ctx, query, category)
@@ -284,7 +296,7 @@ func (r *InventoryRepository) SearchItems(ctx context.Context, query string) ([]
 	sql := `
 		SELECT id, sku, name, description, current_stock, unit, min_threshold,
 		       max_threshold, reorder_point, cost, category, location, supplier_id,
-		       last_ordered, expiry_date, created_at, updated_at
+		       last_ordered, expiry_date, version, created_at, updated_at
 		FROM inventory 
 		WHERE name LIKE ? OR sku LIKE ? OR description LIKE ?`
 	
@@ -518,7 +530,7 @@ func (r *InventoryRepository) GetItemsBySupplier(ctx context.Context, supplierID
 	query := `
 		SELECT id, sku, name, description, current_stock, unit, min_threshold,
 		       max_threshold, reorder_point, cost, category, location, supplier_id,
-		       last_ordered, expiry_date, created_at, updated_at
+		       last_ordered, expiry_date, version, created_at, updated_at
 		FROM inventory 
 		WHERE supplier_id = ?
 		ORDER BY name ASC`
@@ -43,6 +43,7 @@ func setupTestDB(t *testing.T) (*InventoryRepository, func()) {
 			supplier_id VARCHAR(255),
 			last_ordered TIMESTAMP WITH TIME ZONE,
 			expiry_date TIMESTAMP WITH TIME ZONE,
+			version INTEGER NOT NULL DEFAULT 1,
 			created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
 			updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
 		)`)
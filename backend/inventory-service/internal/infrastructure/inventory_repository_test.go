@@ -241,6 +241,7 @@ func (suite *InventoryRepositoryTestSuite) createSchemas() {
 			supplier_id TEXT,
 			last_ordered DATETIME,
 			expiry_date DATETIME,
+			version INTEGER NOT NULL DEFAULT 1,
 			created_at DATETIME NOT NULL,
 			updated_at DATETIME NOT NULL,
 			FOREIGN KEY (supplier_id) REFERENCES suppliers(id)
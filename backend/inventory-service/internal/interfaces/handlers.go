@@ -7,6 +7,8 @@ import (
 	
 	"github.com/restaurant-platform/inventory-service/internal/application"
 	inventory "github.com/restaurant-platform/inventory-service/internal/domain"
+	"github.com/restaurant-platform/shared/pkg/concurrency"
+	"github.com/restaurant-platform/shared/pkg/errors"
 
 	"github.com/gin-gonic/gin"
 )
@@ -66,6 +68,7 @@ func (h *InventoryHandler) CreateItem(c *gin.Context) {
 		return
 	}
 
+	concurrency.SetETag(c, item.Version)
 	c.JSON(http.StatusCreated, item)
 }
 
@@ -78,6 +81,7 @@ func (h *InventoryHandler) GetItem(c *gin.Context) {
 		return
 	}
 
+	concurrency.SetETag(c, item.Version)
 	c.JSON(http.StatusOK, item)
 }
 
@@ -90,6 +94,7 @@ func (h *InventoryHandler) GetItemBySKU(c *gin.Context) {
 		return
 	}
 
+	concurrency.SetETag(c, item.Version)
 	c.JSON(http.StatusOK, item)
 }
 
@@ -143,7 +148,7 @@ func (h *InventoryHandler) AddStock(c *gin.Context) {
 		req.PerformedBy,
 	)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		handleWriteError(c, err)
 		return
 	}
 
@@ -168,7 +173,7 @@ func (h *InventoryHandler) UseStock(c *gin.Context) {
 		req.PerformedBy,
 	)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		handleWriteError(c, err)
 		return
 	}
 
@@ -190,7 +195,7 @@ func (h *InventoryHandler) ReserveStock(c *gin.Context) {
 		req.PerformedBy,
 	)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		handleWriteError(c, err)
 		return
 	}
 
@@ -289,7 +294,7 @@ func (h *InventoryHandler) RecordMovement(c *gin.Context) {
 		req.PerformedBy,
 	)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		handleWriteError(c, err)
 		return
 	}
 
@@ -444,4 +449,17 @@ func (h *InventoryHandler) ListSuppliers(c *gin.Context) {
 		"suppliers": suppliers,
 		"total":     total,
 	})
-}
\ No newline at end of file
+}
+
+// handleWriteError reports a failed stock change, telling clients whether to reload
+// the item before trying again
+func handleWriteError(c *gin.Context, err error) {
+	switch {
+	case errors.IsPreconditionFailed(err):
+		c.JSON(http.StatusPreconditionFailed, gin.H{"error": err.Error()})
+	case errors.IsVersionConflict(err):
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	}
+}
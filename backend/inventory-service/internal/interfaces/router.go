@@ -7,9 +7,12 @@ import (
 
 	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
+
+	"github.com/restaurant-platform/shared/pkg/concurrency"
+	"github.com/restaurant-platform/shared/pkg/idempotency"
 )
 
-func SetupRouter(inventoryService *application.InventoryService) *gin.Engine {
+func SetupRouter(inventoryService *application.InventoryService, idempotencyStore idempotency.Store) *gin.Engine {
 	router := gin.Default()
 
 	// CORS middleware
@@ -17,7 +20,7 @@ func SetupRouter(inventoryService *application.InventoryService) *gin.Engine {
 		AllowOrigins:     []string{"*"},
 		AllowMethods:     []string{"GET", "POST", "PUT", "PATCH", "DELETE", "OPTIONS"},
 		AllowHeaders:     []string{"*"},
-		ExposeHeaders:    []string{"Content-Length"},
+		ExposeHeaders:    []string{"Content-Length", "ETag", idempotency.HeaderReplayed},
 		AllowCredentials: true,
 		MaxAge:           12 * time.Hour,
 	}))
@@ -30,11 +33,13 @@ func SetupRouter(inventoryService *application.InventoryService) *gin.Engine {
 	// Initialize handlers
 	inventoryHandler := NewInventoryHandler(inventoryService)
 
-	// API routes
+	// API routes; writes carrying an Idempotency-Key are replayed instead of being applied twice
 	v1 := router.Group("/api/v1")
+	v1.Use(idempotency.Middleware(idempotencyStore, idempotency.DefaultTTL))
 	{
 		// Inventory item routes
 		inventory := v1.Group("/inventory")
+		inventory.Use(concurrency.IfMatchMiddleware())
 		{
 			inventory.POST("/items", inventoryHandler.CreateItem)
 			inventory.GET("/items", inventoryHandler.ListItems)
@@ -45,6 +50,7 @@ func SetupRouter(inventoryService *application.InventoryService) *gin.Engine {
 
 		// Stock management routes
 		stock := v1.Group("/stock")
+		stock.Use(concurrency.IfMatchMiddleware())
 		{
 			stock.GET("/availability", inventoryHandler.CheckAvailability)
 			stock.GET("/level/:sku", inventoryHandler.GetStockLevel)
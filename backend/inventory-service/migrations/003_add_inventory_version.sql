@@ -0,0 +1,5 @@
+-- Inventory Service Database Schema
+-- Database: inventory_service_db
+
+-- Optimistic concurrency: updates only apply to the version they were loaded at
+ALTER TABLE inventory ADD COLUMN IF NOT EXISTS version INTEGER NOT NULL DEFAULT 1;
@@ -11,6 +11,7 @@ import (
 	"time"
 
 	"github.com/restaurant-platform/kitchen-service/internal/application"
+	"github.com/restaurant-platform/kitchen-service/internal/domain"
 	"github.com/restaurant-platform/kitchen-service/internal/infrastructure"
 	"github.com/restaurant-platform/kitchen-service/internal/interfaces"
 	"github.com/restaurant-platform/shared/events"
@@ -41,9 +42,23 @@ func main() {
 
 	// Initialize repositories
 	kitchenRepo := infrastructure.NewKitchenOrderRepository(db.Connection)
+	menuItemRepo := infrastructure.NewMenuItemRepository(db.Connection)
+	stationRepo := infrastructure.NewStationRepository(db.Connection)
+	prepTimeRepo := infrastructure.NewPrepTimeStatRepository(db.Connection)
 
 	// Initialize services
-	kitchenService := application.NewKitchenOrderService(kitchenRepo, eventPublisher)
+	kitchenService := application.NewKitchenOrderService(kitchenRepo, menuItemRepo, stationRepo, prepTimeRepo, eventPublisher)
+	stationService := application.NewKitchenStationService(stationRepo)
+	prepTimeService := application.NewKitchenPrepTimeService(kitchenRepo, prepTimeRepo, menuItemRepo, cfg.PrepTime.Lookback)
+
+	ticketSLAPolicy, err := domain.NewTicketSLAPolicy(cfg.SLA.KitchenThresholds)
+	if err != nil {
+		log.Fatalf("Failed to parse SLA config: %v", err)
+	}
+	if cfg.SLA.CheckInterval <= 0 {
+		log.Fatalf("Failed to parse SLA config: check interval must be positive")
+	}
+	ticketSLAService := application.NewTicketSLAService(kitchenService, ticketSLAPolicy, eventPublisher)
 
 	// Setup event consumer for order events
 	redisConsumer, err := events.NewRedisStreamConsumer(
@@ -64,13 +79,60 @@ func main() {
 	// Subscribe to order events
 	err = redisConsumer.Subscribe(context.Background(), []events.EventType{
 		events.OrderCreatedEvent,
+		events.OrderReleasedEvent,
 		events.OrderPaidEvent,
 		events.OrderCancelledEvent,
+		events.OrderCourseFiredEvent,
+		events.OrderItemAddedEvent,
+		events.OrderItemRemovedEvent,
+		events.OrderItemUpdatedEvent,
+		events.OrderItemSeatChangedEvent,
+		events.OrderItemVoidedEvent,
+		events.OrderTableChangedEvent,
+		events.OrderItemsMovedEvent,
+		events.OrderMergedEvent,
+		events.OrderSLABreachedEvent,
 	}, eventHandler.HandleOrderEvent)
 	if err != nil {
 		log.Fatalf("Failed to subscribe to order events: %v", err)
 	}
 
+	// Setup event consumer for menu events
+	menuConsumer, err := events.NewRedisStreamConsumer(
+		redisAddr,
+		cfg.Redis.Password,
+		cfg.Redis.DB,
+		events.MenuStream,
+		"kitchen-service-group",
+		"kitchen-service-consumer-1",
+	)
+	if err != nil {
+		log.Fatalf("Failed to create menu event consumer: %v", err)
+	}
+
+	// Keep the local menu read model current for item preparation times
+	menuEventHandler := application.NewMenuEventHandler(menuItemRepo)
+
+	// Load the active menu before the consumer catches up with menu events
+	menuCatalog, err := infrastructure.NewMenuServiceCatalog(cfg.MenuService.URL, &http.Client{Timeout: 10 * time.Second})
+	if err != nil {
+		log.Fatalf("Failed to parse menu service config: %v", err)
+	}
+	if err := menuEventHandler.LoadActiveMenu(context.Background(), menuCatalog); err != nil {
+		log.Printf("Failed to load active menu, waiting for menu events: %v", err)
+	}
+
+	err = menuConsumer.Subscribe(context.Background(), []events.EventType{
+		events.MenuActivatedEvent,
+		events.MenuDeactivatedEvent,
+		events.MenuItemAddedEvent,
+		events.MenuItemUpdatedEvent,
+		events.MenuItemRemovedEvent,
+	}, menuEventHandler.HandleMenuEvent)
+	if err != nil {
+		log.Fatalf("Failed to subscribe to menu events: %v", err)
+	}
+
 	// Start consuming events in the background
 	go func() {
 		if err := redisConsumer.Start(context.Background()); err != nil {
@@ -78,8 +140,52 @@ func main() {
 		}
 	}()
 
+	go func() {
+		if err := menuConsumer.Start(context.Background()); err != nil {
+			log.Printf("Menu event consumer error: %v", err)
+		}
+	}()
+
+	// Tail the kitchen event stream for kitchen display screens
+	kitchenStreamReader, err := events.NewRedisStreamReader(redisAddr, cfg.Redis.Password, cfg.Redis.DB, events.KitchenStream)
+	if err != nil {
+		log.Fatalf("Failed to create kitchen stream reader: %v", err)
+	}
+	defer kitchenStreamReader.Close()
+
+	displayHub := application.NewKitchenDisplayHub(kitchenStreamReader)
+	if err := displayHub.Start(context.Background()); err != nil {
+		log.Fatalf("Failed to start kitchen display hub: %v", err)
+	}
+
+	// Learn preparation times from the items the kitchen has prepared
+	go func() {
+		ticker := time.NewTicker(cfg.PrepTime.StatsInterval)
+		defer ticker.Stop()
+
+		for range ticker.C {
+			if _, err := prepTimeService.RecomputePrepTimeStats(context.Background(), time.Now()); err != nil {
+				log.Printf("Failed to recompute prep time stats: %v", err)
+			}
+		}
+	}()
+
+	// Raise the priority of tickets left too long in a status
+	go func() {
+		ticker := time.NewTicker(cfg.SLA.CheckInterval)
+		defer ticker.Stop()
+
+		for range ticker.C {
+			if breached, err := ticketSLAService.CheckTickets(context.Background(), time.Now()); err != nil {
+				log.Printf("Failed to check kitchen order SLAs: %v", err)
+			} else if breached > 0 {
+				log.Printf("%d kitchen orders breached their SLA", breached)
+			}
+		}
+	}()
+
 	// Setup router
-	router := interfaces.SetupRouter(kitchenService)
+	router := interfaces.SetupRouter(kitchenService, stationService, prepTimeService, ticketSLAService, displayHub, cfg.KitchenDisplay.AllowedOrigins)
 
 	// Create HTTP server
 	srv := &http.Server{
@@ -108,8 +214,12 @@ func main() {
 	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 	defer cancel()
 
-	// Stop event consumer
+	// Stop event consumers
 	redisConsumer.Stop()
+	menuConsumer.Stop()
+
+	// Close display streams so open connections do not hold up the shutdown
+	displayHub.Stop()
 
 	if err := srv.Shutdown(ctx); err != nil {
 		log.Fatalf("Kitchen Service forced to shutdown: %v", err)
@@ -0,0 +1,315 @@
+package application
+
+import (
+	"context"
+	"log"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/restaurant-platform/shared/events"
+	"github.com/restaurant-platform/shared/pkg/errors"
+)
+
+const (
+	// displayReadBatch caps how many stream events the hub reads at a time
+	displayReadBatch = 100
+
+	// displayReadBlock is how long a stream read waits for new events
+	displayReadBlock = 2 * time.Second
+
+	// displayReplayLimit caps how many missed events a reconnecting screen is sent;
+	// a screen further behind is told to resync instead
+	displayReplayLimit = 500
+
+	// displayBufferSize is how many updates may queue for a slow screen before it is dropped
+	displayBufferSize = 256
+)
+
+// DisplayResyncType is sent to a screen that missed more updates than can be replayed;
+// it should reload its tickets and resume from the update's sequence
+const DisplayResyncType = "display.resync"
+
+// DisplayUpdate is a kitchen event as pushed to kitchen display screens
+type DisplayUpdate struct {
+	Sequence       string                 `json:"sequence"`
+	Type           string                 `json:"type"`
+	KitchenOrderID string                 `json:"kitchen_order_id,omitempty"`
+	Stations       []string               `json:"stations,omitempty"`
+	Data           map[string]interface{} `json:"data,omitempty"`
+	OccurredAt     time.Time              `json:"occurred_at"`
+}
+
+// DisplaySubscription is one screen's feed of display updates
+type DisplaySubscription struct {
+	updates  chan *DisplayUpdate
+	stations []string
+
+	mu        sync.Mutex
+	replaying bool
+	backlog   []*DisplayUpdate
+	last      string
+	closed    bool
+}
+
+// Updates returns the subscription's updates. The channel is closed when the screen
+// falls too far behind or the hub stops; the screen should reconnect and resume.
+func (sub *DisplaySubscription) Updates() <-chan *DisplayUpdate {
+	return sub.updates
+}
+
+// wants reports whether the screen is subscribed to an update's stations.
+// Updates that name no station go to every screen.
+func (sub *DisplaySubscription) wants(update *DisplayUpdate) bool {
+	if len(sub.stations) == 0 || len(update.Stations) == 0 {
+		return true
+	}
+	for _, station := range update.Stations {
+		if slices.Contains(sub.stations, station) {
+			return true
+		}
+	}
+	return false
+}
+
+// deliver queues an update for the screen, holding it back while missed updates are
+// replayed and skipping anything the screen has already been sent
+func (sub *DisplaySubscription) deliver(update *DisplayUpdate) {
+	sub.mu.Lock()
+	defer sub.mu.Unlock()
+
+	if sub.replaying {
+		sub.backlog = append(sub.backlog, update)
+		return
+	}
+	sub.send(update)
+}
+
+// send must be called with sub.mu held
+func (sub *DisplaySubscription) send(update *DisplayUpdate) {
+	if sub.closed {
+		return
+	}
+	if update.Type != DisplayResyncType {
+		if sub.last != "" && events.CompareSequences(update.Sequence, sub.last) <= 0 {
+			return
+		}
+		if !sub.wants(update) {
+			return
+		}
+	}
+
+	select {
+	case sub.updates <- update:
+		sub.last = update.Sequence
+	default:
+		// A screen this far behind reconnects and resumes from its last sequence
+		log.Printf("Dropping kitchen display subscription that fell behind at %s", sub.last)
+		sub.close()
+	}
+}
+
+// close must be called with sub.mu held
+func (sub *DisplaySubscription) close() {
+	if !sub.closed {
+		sub.closed = true
+		close(sub.updates)
+	}
+}
+
+// KitchenDisplayHub tails the kitchen event stream and fans it out to kitchen display screens
+type KitchenDisplayHub struct {
+	reader events.EventStreamReader
+
+	mu          sync.Mutex
+	subscribers map[*DisplaySubscription]struct{}
+	last        string
+
+	stop chan struct{}
+	done chan struct{}
+}
+
+// NewKitchenDisplayHub creates a new kitchen display hub reading the given stream
+func NewKitchenDisplayHub(reader events.EventStreamReader) *KitchenDisplayHub {
+	return &KitchenDisplayHub{
+		reader:      reader,
+		subscribers: make(map[*DisplaySubscription]struct{}),
+		stop:        make(chan struct{}),
+		done:        make(chan struct{}),
+	}
+}
+
+// Start begins tailing the stream from its newest event
+func (h *KitchenDisplayHub) Start(ctx context.Context) error {
+	last, err := h.reader.LastSequence(ctx)
+	if err != nil {
+		return err
+	}
+	h.mu.Lock()
+	h.last = last
+	h.mu.Unlock()
+
+	go h.tailLoop(ctx)
+	log.Printf("Kitchen display hub tailing the kitchen event stream from %s", last)
+	return nil
+}
+
+// Stop stops tailing the stream and closes every subscription
+func (h *KitchenDisplayHub) Stop() {
+	close(h.stop)
+	<-h.done
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for sub := range h.subscribers {
+		sub.mu.Lock()
+		sub.close()
+		sub.mu.Unlock()
+		delete(h.subscribers, sub)
+	}
+}
+
+// tailLoop reads new events from the stream and dispatches them until the hub stops
+func (h *KitchenDisplayHub) tailLoop(ctx context.Context) {
+	defer close(h.done)
+	for {
+		select {
+		case <-h.stop:
+			return
+		case <-ctx.Done():
+			return
+		default:
+		}
+
+		h.mu.Lock()
+		last := h.last
+		h.mu.Unlock()
+
+		streamed, err := h.reader.ReadAfter(ctx, last, displayReadBatch, displayReadBlock)
+		if err != nil {
+			log.Printf("Error reading kitchen event stream: %v", err)
+			time.Sleep(1 * time.Second) // Backoff on error
+			continue
+		}
+		for _, se := range streamed {
+			h.dispatch(se)
+		}
+	}
+}
+
+// dispatch sends one stream event to every subscribed screen
+func (h *KitchenDisplayHub) dispatch(se *events.StreamedEvent) {
+	update := toDisplayUpdate(se)
+
+	h.mu.Lock()
+	if events.CompareSequences(se.Sequence, h.last) > 0 {
+		h.last = se.Sequence
+	}
+	subscribers := make([]*DisplaySubscription, 0, len(h.subscribers))
+	for sub := range h.subscribers {
+		subscribers = append(subscribers, sub)
+	}
+	h.mu.Unlock()
+
+	for _, sub := range subscribers {
+		sub.deliver(update)
+	}
+}
+
+// Subscribe opens a feed of display updates for the given stations, or all stations
+// when none are given. A screen reconnecting with the sequence of the last update it
+// saw is first sent the updates it missed.
+func (h *KitchenDisplayHub) Subscribe(ctx context.Context, stations []string, after string) (*DisplaySubscription, error) {
+	if after != "" && !events.ValidSequence(after) {
+		return nil, errors.WrapValidation("Subscribe", "after", "invalid sequence", nil)
+	}
+
+	sub := &DisplaySubscription{
+		updates:   make(chan *DisplayUpdate, displayBufferSize),
+		stations:  stations,
+		replaying: after != "",
+		last:      after,
+	}
+
+	h.mu.Lock()
+	h.subscribers[sub] = struct{}{}
+	head := h.last
+	h.mu.Unlock()
+
+	if after == "" {
+		return sub, nil
+	}
+
+	// Live updates are held in the backlog until the missed ones have been sent
+	missed, err := h.reader.ReadAfter(ctx, after, displayReplayLimit, -1)
+	if err != nil {
+		h.Unsubscribe(sub)
+		return nil, err
+	}
+
+	sub.mu.Lock()
+	defer sub.mu.Unlock()
+
+	if len(missed) == displayReplayLimit {
+		sub.send(&DisplayUpdate{Sequence: head, Type: DisplayResyncType, OccurredAt: time.Now()})
+		sub.last = head
+	} else {
+		for _, se := range missed {
+			sub.send(toDisplayUpdate(se))
+		}
+	}
+	for _, update := range sub.backlog {
+		sub.send(update)
+	}
+	sub.backlog = nil
+	sub.replaying = false
+
+	return sub, nil
+}
+
+// Unsubscribe closes a screen's feed
+func (h *KitchenDisplayHub) Unsubscribe(sub *DisplaySubscription) {
+	h.mu.Lock()
+	delete(h.subscribers, sub)
+	h.mu.Unlock()
+
+	sub.mu.Lock()
+	sub.close()
+	sub.mu.Unlock()
+}
+
+// ParseStations splits a comma-separated station filter
+func ParseStations(value string) []string {
+	var stations []string
+	for _, station := range strings.Split(value, ",") {
+		if station = strings.TrimSpace(station); station != "" {
+			stations = append(stations, station)
+		}
+	}
+	return stations
+}
+
+// toDisplayUpdate converts a kitchen stream event to a display update, taking its
+// stations from the event's "stations" or "station" field
+func toDisplayUpdate(se *events.StreamedEvent) *DisplayUpdate {
+	update := &DisplayUpdate{
+		Sequence:       se.Sequence,
+		Type:           string(se.Event.Type),
+		KitchenOrderID: se.Event.AggregateID,
+		Data:           se.Event.Data,
+		OccurredAt:     se.Event.OccurredAt,
+	}
+
+	if stations, ok := se.Event.Data["stations"].([]interface{}); ok {
+		for _, station := range stations {
+			if id, ok := station.(string); ok {
+				update.Stations = append(update.Stations, id)
+			}
+		}
+	}
+	if station, ok := se.Event.Data["station"].(string); ok && station != "" {
+		update.Stations = append(update.Stations, station)
+	}
+	return update
+}
@@ -0,0 +1,195 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/restaurant-platform/shared/events"
+	sharedErrors "github.com/restaurant-platform/shared/pkg/errors"
+)
+
+// fakeStreamReader serves a fixed kitchen event stream
+type fakeStreamReader struct {
+	events []*events.StreamedEvent
+}
+
+func (r *fakeStreamReader) ReadAfter(ctx context.Context, after string, count int64, block time.Duration) ([]*events.StreamedEvent, error) {
+	var result []*events.StreamedEvent
+	for _, se := range r.events {
+		if events.CompareSequences(se.Sequence, after) > 0 && int64(len(result)) < count {
+			result = append(result, se)
+		}
+	}
+	return result, nil
+}
+
+func (r *fakeStreamReader) LastSequence(ctx context.Context) (string, error) {
+	if len(r.events) == 0 {
+		return events.StreamStart, nil
+	}
+	return r.events[len(r.events)-1].Sequence, nil
+}
+
+func (r *fakeStreamReader) Close() error {
+	return nil
+}
+
+func (r *fakeStreamReader) append(sequence string, eventType events.EventType, data map[string]interface{}) *events.StreamedEvent {
+	se := &events.StreamedEvent{Sequence: sequence, Event: events.NewDomainEvent(eventType, "ko_1", data)}
+	r.events = append(r.events, se)
+	return se
+}
+
+// KitchenDisplayHubTestSuite contains the kitchen display push tests
+type KitchenDisplayHubTestSuite struct {
+	suite.Suite
+	reader *fakeStreamReader
+	hub    *KitchenDisplayHub
+	ctx    context.Context
+}
+
+func (suite *KitchenDisplayHubTestSuite) SetupTest() {
+	suite.reader = &fakeStreamReader{}
+	suite.hub = NewKitchenDisplayHub(suite.reader)
+	suite.ctx = context.Background()
+}
+
+func TestKitchenDisplayHubTestSuite(t *testing.T) {
+	suite.Run(t, new(KitchenDisplayHubTestSuite))
+}
+
+// received drains the updates queued for a subscription
+func received(sub *DisplaySubscription) []*DisplayUpdate {
+	var updates []*DisplayUpdate
+	for {
+		select {
+		case update, ok := <-sub.Updates():
+			if !ok {
+				return updates
+			}
+			updates = append(updates, update)
+		default:
+			return updates
+		}
+	}
+}
+
+func (suite *KitchenDisplayHubTestSuite) TestDispatch_FiltersByStation() {
+	// Given
+	grill, _ := suite.hub.Subscribe(suite.ctx, []string{"grill"}, "")
+	everything, _ := suite.hub.Subscribe(suite.ctx, nil, "")
+
+	// When
+	suite.hub.dispatch(suite.reader.append("1-0", events.KitchenOrderCreatedEvent, map[string]interface{}{"stations": []interface{}{"grill", "fry"}}))
+	suite.hub.dispatch(suite.reader.append("2-0", events.KitchenItemStatusChangedEvent, map[string]interface{}{"station": "bar"}))
+	suite.hub.dispatch(suite.reader.append("3-0", events.KitchenOrderPriorityChangedEvent, map[string]interface{}{}))
+
+	// Then
+	assert := assert.New(suite.T())
+	grillUpdates := received(grill)
+	assert.Len(grillUpdates, 2)
+	assert.Equal("1-0", grillUpdates[0].Sequence)
+	assert.Equal([]string{"grill", "fry"}, grillUpdates[0].Stations)
+	assert.Equal("3-0", grillUpdates[1].Sequence)
+	assert.Len(received(everything), 3)
+}
+
+func (suite *KitchenDisplayHubTestSuite) TestSubscribe_ResumesAfterSequence() {
+	// Given
+	suite.reader.append("1-0", events.KitchenOrderCreatedEvent, nil)
+	suite.reader.append("2-0", events.KitchenOrderUpdatedEvent, nil)
+	suite.reader.append("3-0", events.KitchenOrderUpdatedEvent, nil)
+	suite.hub.last = "3-0"
+
+	// When
+	sub, err := suite.hub.Subscribe(suite.ctx, nil, "1-0")
+	suite.hub.dispatch(suite.reader.append("4-0", events.KitchenItemStatusChangedEvent, nil))
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	updates := received(sub)
+	assert.Len(updates, 3)
+	assert.Equal("2-0", updates[0].Sequence)
+	assert.Equal("3-0", updates[1].Sequence)
+	assert.Equal("4-0", updates[2].Sequence)
+}
+
+func (suite *KitchenDisplayHubTestSuite) TestSubscribe_LiveUpdatesDuringReplayAreNotDuplicated() {
+	// Given
+	suite.reader.append("1-0", events.KitchenOrderCreatedEvent, nil)
+	suite.reader.append("2-0", events.KitchenOrderUpdatedEvent, nil)
+	sub := &DisplaySubscription{updates: make(chan *DisplayUpdate, displayBufferSize), replaying: true, last: "1-0"}
+	suite.hub.subscribers[sub] = struct{}{}
+
+	// When - 2-0 arrives live while the screen is still being replayed to
+	suite.hub.dispatch(suite.reader.events[1])
+	assert.Empty(suite.T(), received(sub))
+
+	sub.mu.Lock()
+	sub.send(toDisplayUpdate(suite.reader.events[1]))
+	for _, update := range sub.backlog {
+		sub.send(update)
+	}
+	sub.replaying = false
+	sub.mu.Unlock()
+
+	// Then
+	updates := received(sub)
+	assert.Len(suite.T(), updates, 1)
+	assert.Equal(suite.T(), "2-0", updates[0].Sequence)
+}
+
+func (suite *KitchenDisplayHubTestSuite) TestSubscribe_TooFarBehind_SendsResync() {
+	// Given
+	for i := 1; i <= displayReplayLimit+10; i++ {
+		suite.reader.append(fmt.Sprintf("%d-0", i), events.KitchenOrderUpdatedEvent, nil)
+	}
+	suite.hub.last = fmt.Sprintf("%d-0", displayReplayLimit+10)
+
+	// When
+	sub, err := suite.hub.Subscribe(suite.ctx, nil, "0-1")
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	updates := received(sub)
+	assert.Len(updates, 1)
+	assert.Equal(DisplayResyncType, updates[0].Type)
+	assert.Equal(suite.hub.last, updates[0].Sequence)
+}
+
+func (suite *KitchenDisplayHubTestSuite) TestSubscribe_InvalidSequence_ShouldFail() {
+	// When
+	_, err := suite.hub.Subscribe(suite.ctx, nil, "yesterday")
+
+	// Then
+	assert.True(suite.T(), sharedErrors.IsValidationError(err))
+	assert.Empty(suite.T(), suite.hub.subscribers)
+}
+
+func (suite *KitchenDisplayHubTestSuite) TestDeliver_SlowScreenIsDropped() {
+	// Given
+	sub, _ := suite.hub.Subscribe(suite.ctx, nil, "")
+
+	// When
+	for i := 1; i <= displayBufferSize+1; i++ {
+		suite.hub.dispatch(suite.reader.append(fmt.Sprintf("%d-0", i), events.KitchenOrderUpdatedEvent, nil))
+	}
+
+	// Then
+	updates := received(sub)
+	assert.Len(suite.T(), updates, displayBufferSize)
+	_, open := <-sub.Updates()
+	assert.False(suite.T(), open)
+}
+
+func (suite *KitchenDisplayHubTestSuite) TestParseStations() {
+	assert.Equal(suite.T(), []string{"grill", "fry"}, ParseStations(" grill, ,fry"))
+	assert.Nil(suite.T(), ParseStations(""))
+}
@@ -13,12 +13,29 @@ type CreateKitchenOrderRequest struct {
 
 // AddKitchenItemRequest represents the request to add an item to a kitchen order
 type AddKitchenItemRequest struct {
-	MenuItemID    string   `json:"menu_item_id" binding:"required"`
-	Name          string   `json:"name" binding:"required"`
-	Quantity      int      `json:"quantity" binding:"required,min=1"`
-	PrepTime      int      `json:"prep_time" binding:"min=0"` // in seconds
-	Modifications []string `json:"modifications,omitempty"`
-	Notes         string   `json:"notes,omitempty"`
+	MenuItemID    string                       `json:"menu_item_id" binding:"required"`
+	Name          string                       `json:"name" binding:"required"`
+	Quantity      int                          `json:"quantity" binding:"required,min=1"`
+	PrepTime      int                          `json:"prep_time" binding:"min=0"` // in seconds
+	Course        int                          `json:"course,omitempty" binding:"min=0"`
+	Modifiers     []KitchenItemModifierRequest `json:"modifiers,omitempty"`
+	Modifications []string                     `json:"modifications,omitempty"`
+	Notes         string                       `json:"notes,omitempty"`
+}
+
+// KitchenItemModifierRequest represents a single chosen modifier option
+type KitchenItemModifierRequest struct {
+	Group  string `json:"group" binding:"required"`
+	Option string `json:"option" binding:"required"`
+}
+
+// ToDomainModifiers converts the requested modifiers to domain values
+func (r AddKitchenItemRequest) ToDomainModifiers() []*domain.KitchenItemModifier {
+	modifiers := make([]*domain.KitchenItemModifier, len(r.Modifiers))
+	for i, m := range r.Modifiers {
+		modifiers[i] = &domain.KitchenItemModifier{Group: m.Group, Option: m.Option}
+	}
+	return modifiers
 }
 
 // UpdateItemStatusRequest represents the request to update a kitchen item status
@@ -36,6 +53,25 @@ type AssignToStationRequest struct {
 	StationID string `json:"station_id" binding:"required"`
 }
 
+// CreateStationRequest represents the request to add a station to the registry
+type CreateStationRequest struct {
+	ID        string `json:"id" binding:"required"`
+	Name      string `json:"name" binding:"required"`
+	SortOrder int    `json:"sort_order"`
+}
+
+// CreateRoutingRuleRequest represents the request to route matching items to a station
+type CreateRoutingRuleRequest struct {
+	StationID string `json:"station_id" binding:"required"`
+	Match     string `json:"match" binding:"required"`
+	Value     string `json:"value" binding:"required"`
+}
+
+// BumpRequest represents the request to bump or recall a kitchen order
+type BumpRequest struct {
+	UserID string `json:"user_id" binding:"required"`
+}
+
 // SetPriorityRequest represents the request to set the priority of a kitchen order
 type SetPriorityRequest struct {
 	Priority string `json:"priority" binding:"required"`
@@ -53,26 +89,75 @@ type KitchenOrderResponse struct {
 	EstimatedTime   int                   `json:"estimated_time"` // in seconds
 	StartedAt       *time.Time            `json:"started_at,omitempty"`
 	CompletedAt     *time.Time            `json:"completed_at,omitempty"`
+	ServedAt        *time.Time            `json:"served_at,omitempty"`
 	Notes           string                `json:"notes,omitempty"`
+	Version         int                   `json:"version"`
 	CreatedAt       time.Time             `json:"created_at"`
 	UpdatedAt       time.Time             `json:"updated_at"`
 	TimeElapsed     int                   `json:"time_elapsed"`     // in seconds
 	TimeRemaining   int                   `json:"time_remaining"`   // in seconds
+	Courses         []*CourseTimingResponse `json:"courses,omitempty"`
+	Bumps           []*BumpRecordResponse   `json:"bumps,omitempty"`
+}
+
+// BumpRecordResponse represents one entry in a kitchen order's bump history
+type BumpRecordResponse struct {
+	Action  string    `json:"action"`
+	Station string    `json:"station,omitempty"`
+	ItemIDs []string  `json:"item_ids,omitempty"`
+	UserID  string    `json:"user_id"`
+	At      time.Time `json:"at"`
+}
+
+// CourseTimingResponse represents the hold and fire-to-ready timing of one course
+type CourseTimingResponse struct {
+	Course      int        `json:"course"`
+	Status      string     `json:"status"`
+	ItemCount   int        `json:"item_count"`
+	FiredAt     *time.Time `json:"fired_at,omitempty"`
+	ReadyAt     *time.Time `json:"ready_at,omitempty"`
+	HeldFor     int        `json:"held_for"`      // in seconds
+	FireToReady int        `json:"fire_to_ready"` // in seconds
+}
+
+// CourseMetricsResponse represents average course timings across kitchen orders
+type CourseMetricsResponse struct {
+	Course             int `json:"course"`
+	FiredCount         int `json:"fired_count"`
+	ReadyCount         int `json:"ready_count"`
+	AverageHeldFor     int `json:"average_held_for"`      // in seconds
+	AverageFireToReady int `json:"average_fire_to_ready"` // in seconds
 }
 
 // KitchenItemResponse represents the response containing kitchen item details
 type KitchenItemResponse struct {
-	ID              string     `json:"id"`
-	MenuItemID      string     `json:"menu_item_id"`
-	Name            string     `json:"name"`
-	Quantity        int        `json:"quantity"`
-	Status          string     `json:"status"`
-	PrepTime        int        `json:"prep_time"` // in seconds
-	StartedAt       *time.Time `json:"started_at,omitempty"`
-	CompletedAt     *time.Time `json:"completed_at,omitempty"`
-	AssignedStation string     `json:"assigned_station,omitempty"`
-	Notes           string     `json:"notes,omitempty"`
-	Modifications   []string   `json:"modifications,omitempty"`
+	ID              string                          `json:"id"`
+	MenuItemID      string                          `json:"menu_item_id"`
+	Name            string                          `json:"name"`
+	Quantity        int                             `json:"quantity"`
+	Status          string                          `json:"status"`
+	PrepTime        int                             `json:"prep_time"` // in seconds
+	LearnedPrepTime bool                            `json:"learned_prep_time,omitempty"`
+	StartedAt       *time.Time                      `json:"started_at,omitempty"`
+	CompletedAt     *time.Time                      `json:"completed_at,omitempty"`
+	AssignedStation string                          `json:"assigned_station,omitempty"`
+	Notes           string                          `json:"notes,omitempty"`
+	Modifiers       []*KitchenModifierGroupResponse `json:"modifiers,omitempty"`
+	Modifications   []string                        `json:"modifications,omitempty"`
+	Course          int                             `json:"course"`
+	CourseStatus    string                          `json:"course_status"`
+	Seat            int                             `json:"seat,omitempty"`
+	FiredAt         *time.Time                      `json:"fired_at,omitempty"`
+	OrderItemID     string                          `json:"order_item_id,omitempty"`
+	Ticket          int                             `json:"ticket,omitempty"`
+	Wasted          bool                            `json:"wasted,omitempty"`
+	VoidReason      string                          `json:"void_reason,omitempty"`
+}
+
+// KitchenModifierGroupResponse lists the options chosen within one modifier group
+type KitchenModifierGroupResponse struct {
+	Group   string   `json:"group"`
+	Options []string `json:"options"`
 }
 
 // KitchenOrderListRequest represents the request to list kitchen orders
@@ -95,6 +180,58 @@ type KitchenOrderListResponse struct {
 	Limit      int                     `json:"limit"`
 }
 
+// StationResponse represents a station of the line
+type StationResponse struct {
+	ID        string    `json:"id"`
+	Name      string    `json:"name"`
+	SortOrder int       `json:"sort_order"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// RoutingRuleResponse represents a rule routing items to a station
+type RoutingRuleResponse struct {
+	ID        string    `json:"id"`
+	StationID string    `json:"station_id"`
+	Match     string    `json:"match"`
+	Value     string    `json:"value"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// PrepTimeStatResponse represents the learned preparation time of a menu item at a station
+type PrepTimeStatResponse struct {
+	MenuItemID   string    `json:"menu_item_id"`
+	Station      string    `json:"station"`
+	Hour         int       `json:"hour"` // -1 across all hours
+	SampleCount  int       `json:"sample_count"`
+	TrimmedCount int       `json:"trimmed_count"`
+	Median       int       `json:"median"` // in seconds
+	P90          int       `json:"p90"`    // in seconds
+	ComputedAt   time.Time `json:"computed_at"`
+}
+
+// PrepTimeEstimateResponse represents the preparation time a menu item is expected to take
+type PrepTimeEstimateResponse struct {
+	MenuItemID string                `json:"menu_item_id"`
+	Station    string                `json:"station"`
+	Hour       int                   `json:"hour"`
+	PrepTime   int                   `json:"prep_time"` // in seconds
+	Source     string                `json:"source"`
+	Stat       *PrepTimeStatResponse `json:"stat,omitempty"`
+}
+
+// TicketSLABreachResponse represents a kitchen order past its SLA
+type TicketSLABreachResponse struct {
+	KitchenOrderID   string    `json:"kitchen_order_id"`
+	OrderID          string    `json:"order_id"`
+	TableID          string    `json:"table_id,omitempty"`
+	Status           string    `json:"status"`
+	Priority         string    `json:"priority"`
+	ThresholdSeconds int64     `json:"threshold_seconds"`
+	ElapsedSeconds   int64     `json:"elapsed_seconds"`
+	EnteredAt        time.Time `json:"entered_at"`
+	BreachedAt       time.Time `json:"breached_at"`
+}
+
 // HealthResponse represents the health check response
 type HealthResponse struct {
 	Status    string    `json:"status"`
@@ -118,13 +255,16 @@ func ToKitchenOrderResponse(order *domain.KitchenOrder) *KitchenOrderResponse {
 		items[i] = ToKitchenItemResponse(item)
 	}
 
-	var startedAt, completedAt *time.Time
+	var startedAt, completedAt, servedAt *time.Time
 	if !order.StartedAt.IsZero() {
 		startedAt = &order.StartedAt
 	}
 	if !order.CompletedAt.IsZero() {
 		completedAt = &order.CompletedAt
 	}
+	if !order.ServedAt.IsZero() {
+		servedAt = &order.ServedAt
+	}
 
 	return &KitchenOrderResponse{
 		ID:              string(order.ID),
@@ -137,23 +277,95 @@ func ToKitchenOrderResponse(order *domain.KitchenOrder) *KitchenOrderResponse {
 		EstimatedTime:   int(order.EstimatedTime.Seconds()),
 		StartedAt:       startedAt,
 		CompletedAt:     completedAt,
+		ServedAt:        servedAt,
 		Notes:           order.Notes,
+		Version:         order.Version,
 		CreatedAt:       order.CreatedAt,
 		UpdatedAt:       order.UpdatedAt,
 		TimeElapsed:     int(order.TimeElapsed().Seconds()),
 		TimeRemaining:   int(order.TimeRemaining().Seconds()),
+		Courses:         ToCourseTimingResponses(order.CourseTimings()),
+		Bumps:           ToBumpRecordResponses(order.Bumps),
 	}
 }
 
+// ToBumpRecordResponses converts a kitchen order's bump history to response DTOs
+func ToBumpRecordResponses(records []*domain.BumpRecord) []*BumpRecordResponse {
+	if len(records) == 0 {
+		return nil
+	}
+
+	responses := make([]*BumpRecordResponse, len(records))
+	for i, record := range records {
+		itemIDs := make([]string, len(record.ItemIDs))
+		for j, id := range record.ItemIDs {
+			itemIDs[j] = string(id)
+		}
+		responses[i] = &BumpRecordResponse{
+			Action:  string(record.Action),
+			Station: record.Station,
+			ItemIDs: itemIDs,
+			UserID:  record.UserID,
+			At:      record.At,
+		}
+	}
+	return responses
+}
+
+// ToCourseTimingResponses converts domain course timings to response DTOs
+func ToCourseTimingResponses(timings []*domain.CourseTiming) []*CourseTimingResponse {
+	responses := make([]*CourseTimingResponse, len(timings))
+	for i, timing := range timings {
+		var firedAt, readyAt *time.Time
+		if !timing.FiredAt.IsZero() {
+			firedAt = &timing.FiredAt
+		}
+		if !timing.ReadyAt.IsZero() {
+			readyAt = &timing.ReadyAt
+		}
+
+		responses[i] = &CourseTimingResponse{
+			Course:      timing.Course,
+			Status:      string(timing.Status),
+			ItemCount:   timing.ItemCount,
+			FiredAt:     firedAt,
+			ReadyAt:     readyAt,
+			HeldFor:     int(timing.HeldFor.Seconds()),
+			FireToReady: int(timing.FireToReady.Seconds()),
+		}
+	}
+	return responses
+}
+
+// ToCourseMetricsResponses converts domain course metrics to response DTOs
+func ToCourseMetricsResponses(metrics []*domain.CourseMetrics) []*CourseMetricsResponse {
+	responses := make([]*CourseMetricsResponse, len(metrics))
+	for i, m := range metrics {
+		responses[i] = &CourseMetricsResponse{
+			Course:             m.Course,
+			FiredCount:         m.FiredCount,
+			ReadyCount:         m.ReadyCount,
+			AverageHeldFor:     int(m.AverageHeldFor.Seconds()),
+			AverageFireToReady: int(m.AverageFireToReady.Seconds()),
+		}
+	}
+	return responses
+}
+
 // ToKitchenItemResponse converts a domain kitchen item to response DTO
 func ToKitchenItemResponse(item *domain.KitchenItem) *KitchenItemResponse {
-	var startedAt, completedAt *time.Time
+	var startedAt, completedAt, firedAt *time.Time
 	if !item.StartedAt.IsZero() {
 		startedAt = &item.StartedAt
 	}
 	if !item.CompletedAt.IsZero() {
 		completedAt = &item.CompletedAt
 	}
+	courseStatus := domain.CourseStatusHeld
+	if !item.IsHeld() {
+		firedAt = &item.FiredAt
+		courseStatus = domain.CourseStatusFired
+	}
 
 	return &KitchenItemResponse{
 		ID:              string(item.ID),
@@ -162,12 +374,39 @@ func ToKitchenItemResponse(item *domain.KitchenItem) *KitchenItemResponse {
 		Quantity:        item.Quantity,
 		Status:          string(item.Status),
 		PrepTime:        int(item.PrepTime.Seconds()),
+		LearnedPrepTime: item.LearnedPrepTime,
 		StartedAt:       startedAt,
 		CompletedAt:     completedAt,
 		AssignedStation: item.AssignedStation,
 		Notes:           item.Notes,
+		Modifiers:       groupModifiers(item.Modifiers),
 		Modifications:   item.Modifications,
+		Course:          item.Course,
+		CourseStatus:    string(courseStatus),
+		Seat:            item.Seat,
+		FiredAt:         firedAt,
+		OrderItemID:     item.OrderItemID,
+		Ticket:          item.Ticket,
+		Wasted:          item.Wasted,
+		VoidReason:      item.VoidReason,
+	}
+}
+
+// groupModifiers groups chosen options under their modifier group, keeping the
+// order in which groups first appear so the ticket reads like the menu
+func groupModifiers(modifiers []*domain.KitchenItemModifier) []*KitchenModifierGroupResponse {
+	var groups []*KitchenModifierGroupResponse
+	byName := make(map[string]*KitchenModifierGroupResponse)
+	for _, m := range modifiers {
+		group, ok := byName[m.Group]
+		if !ok {
+			group = &KitchenModifierGroupResponse{Group: m.Group}
+			byName[m.Group] = group
+			groups = append(groups, group)
+		}
+		group.Options = append(group.Options, m.Option)
 	}
+	return groups
 }
 
 // ToKitchenOrderListResponse converts domain kitchen orders to list response DTO
@@ -183,4 +422,81 @@ func ToKitchenOrderListResponse(orders []*domain.KitchenOrder, totalCount, offse
 		Offset:     offset,
 		Limit:      limit,
 	}
-}
\ No newline at end of file
+}
+
+// ToStationResponse converts a domain station to response DTO
+func ToStationResponse(station *domain.Station) *StationResponse {
+	return &StationResponse{
+		ID:        station.ID,
+		Name:      station.Name,
+		SortOrder: station.SortOrder,
+		CreatedAt: station.CreatedAt,
+	}
+}
+
+// ToRoutingRuleResponse converts a domain routing rule to response DTO
+func ToRoutingRuleResponse(rule *domain.RoutingRule) *RoutingRuleResponse {
+	return &RoutingRuleResponse{
+		ID:        rule.ID,
+		StationID: rule.StationID,
+		Match:     string(rule.Match),
+		Value:     rule.Value,
+		CreatedAt: rule.CreatedAt,
+	}
+}
+
+// ToTicketSLABreachResponses converts SLA breaches, with how long each ticket has been in the breached status as of now
+func ToTicketSLABreachResponses(breaches []*domain.TicketSLABreach, now time.Time) []*TicketSLABreachResponse {
+	responses := make([]*TicketSLABreachResponse, len(breaches))
+	for i, breach := range breaches {
+		responses[i] = &TicketSLABreachResponse{
+			KitchenOrderID:   string(breach.KitchenOrderID),
+			OrderID:          breach.OrderID,
+			TableID:          breach.TableID,
+			Status:           string(breach.Status),
+			Priority:         string(breach.Priority),
+			ThresholdSeconds: int64(breach.Threshold.Seconds()),
+			ElapsedSeconds:   int64(breach.Elapsed(now).Seconds()),
+			EnteredAt:        breach.EnteredAt,
+			BreachedAt:       breach.BreachedAt,
+		}
+	}
+	return responses
+}
+
+// ToPrepTimeStatResponses converts learned preparation times to response DTOs
+func ToPrepTimeStatResponses(stats domain.PrepTimeStats) []*PrepTimeStatResponse {
+	responses := make([]*PrepTimeStatResponse, len(stats))
+	for i, stat := range stats {
+		responses[i] = toPrepTimeStatResponse(stat)
+	}
+	return responses
+}
+
+// ToPrepTimeEstimateResponse converts a domain prep time estimate to response DTO
+func ToPrepTimeEstimateResponse(estimate *domain.PrepTimeEstimate) *PrepTimeEstimateResponse {
+	response := &PrepTimeEstimateResponse{
+		MenuItemID: estimate.MenuItemID,
+		Station:    estimate.Station,
+		Hour:       estimate.Hour,
+		PrepTime:   int(estimate.PrepTime.Seconds()),
+		Source:     string(estimate.Source),
+	}
+	if estimate.Stat != nil {
+		response.Stat = toPrepTimeStatResponse(estimate.Stat)
+	}
+	return response
+}
+
+func toPrepTimeStatResponse(stat *domain.PrepTimeStat) *PrepTimeStatResponse {
+	return &PrepTimeStatResponse{
+		MenuItemID:   stat.MenuItemID,
+		Station:      stat.Station,
+		Hour:         stat.Hour,
+		SampleCount:  stat.SampleCount,
+		TrimmedCount: stat.TrimmedCount,
+		Median:       int(stat.Median.Seconds()),
+		P90:          int(stat.P90.Seconds()),
+		ComputedAt:   stat.ComputedAt,
+	}
+}
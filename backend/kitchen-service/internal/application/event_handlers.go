@@ -4,9 +4,11 @@ import (
 	"context"
 	"encoding/json"
 	"log"
+	"time"
 
 	"github.com/restaurant-platform/kitchen-service/internal/domain"
 	"github.com/restaurant-platform/shared/events"
+	"github.com/restaurant-platform/shared/pkg/errors"
 )
 
 // EventHandler handles domain events from other services
@@ -26,10 +28,30 @@ func (h *EventHandler) HandleOrderEvent(ctx context.Context, event *events.Domai
 	switch event.Type {
 	case events.OrderCreatedEvent:
 		return h.handleOrderCreated(ctx, event)
+	case events.OrderReleasedEvent:
+		return h.handleOrderReleased(ctx, event)
 	case events.OrderPaidEvent:
 		return h.handleOrderPaid(ctx, event)
 	case events.OrderCancelledEvent:
 		return h.handleOrderCancelled(ctx, event)
+	case events.OrderCourseFiredEvent:
+		return h.handleOrderCourseFired(ctx, event)
+	case events.OrderItemAddedEvent:
+		return h.handleOrderItemAdded(ctx, event)
+	case events.OrderItemRemovedEvent:
+		return h.handleOrderItemRemoved(ctx, event)
+	case events.OrderItemUpdatedEvent:
+		return h.handleOrderItemUpdated(ctx, event)
+	case events.OrderItemSeatChangedEvent:
+		return h.handleOrderItemSeatChanged(ctx, event)
+	case events.OrderItemVoidedEvent:
+		return h.handleOrderItemVoided(ctx, event)
+	case events.OrderTableChangedEvent:
+		return h.handleOrderTableChanged(ctx, event)
+	case events.OrderItemsMovedEvent, events.OrderMergedEvent:
+		return h.handleOrderItemsMoved(ctx, event)
+	case events.OrderSLABreachedEvent:
+		return h.handleOrderSLABreached(ctx, event)
 	default:
 		log.Printf("Unhandled order event type: %s", event.Type)
 		return nil
@@ -41,12 +63,14 @@ func (h *EventHandler) handleOrderCreated(ctx context.Context, event *events.Dom
 	log.Printf("Processing order created event: %s", event.AggregateID)
 
 	var eventData struct {
-		OrderID     string  `json:"order_id"`
-		CustomerID  string  `json:"customer_id"`
-		TableID     string  `json:"table_id"`
-		OrderType   string  `json:"order_type"`
-		TotalAmount float64 `json:"total_amount"`
-		Status      string  `json:"status"`
+		OrderID         string                 `json:"order_id"`
+		CustomerID      string                 `json:"customer_id"`
+		TableID         string                 `json:"table_id"`
+		OrderType       string                 `json:"order_type"`
+		TotalAmount     float64                `json:"total_amount"`
+		Status          string                 `json:"status"`
+		FulfillmentTime *time.Time             `json:"fulfillment_time"`
+		Items           []events.OrderLineData `json:"items"`
 	}
 
 	dataBytes, err := json.Marshal(event.Data)
@@ -61,9 +85,15 @@ func (h *EventHandler) handleOrderCreated(ctx context.Context, event *events.Dom
 	log.Printf("Order created: %s for customer: %s at table: %s", 
 		eventData.OrderID, eventData.CustomerID, eventData.TableID)
 
+	// Scheduled orders are ticketed when order-service releases them
+	if eventData.FulfillmentTime != nil {
+		log.Printf("Order %s is scheduled for %s, waiting for release", eventData.OrderID, eventData.FulfillmentTime.Format(time.RFC3339))
+		return nil
+	}
+
 	// Create a kitchen order when an order is created
 	// Note: In a real implementation, you might wait for the order to be paid
-	_, err = h.kitchenService.CreateKitchenOrder(ctx, eventData.OrderID, eventData.TableID)
+	_, err = h.kitchenService.CreateKitchenOrder(ctx, eventData.OrderID, eventData.TableID, toTicketLines(eventData.Items))
 	if err != nil {
 		log.Printf("Failed to create kitchen order for order %s: %v", eventData.OrderID, err)
 		return err
@@ -73,6 +103,41 @@ func (h *EventHandler) handleOrderCreated(ctx context.Context, event *events.Dom
 	return nil
 }
 
+// handleOrderReleased tickets a scheduled order once order-service releases it to the kitchen
+func (h *EventHandler) handleOrderReleased(ctx context.Context, event *events.DomainEvent) error {
+	log.Printf("Processing order released event: %s", event.AggregateID)
+
+	var eventData events.OrderReleasedData
+
+	dataBytes, err := json.Marshal(event.Data)
+	if err != nil {
+		return err
+	}
+
+	if err := json.Unmarshal(dataBytes, &eventData); err != nil {
+		return err
+	}
+
+	kitchenOrder, err := h.kitchenService.CreateKitchenOrder(ctx, eventData.OrderID, eventData.TableID, toTicketLines(eventData.Items))
+	if err != nil {
+		log.Printf("Failed to create kitchen order for released order %s: %v", eventData.OrderID, err)
+		return err
+	}
+
+	// Orders paid in advance go straight into preparation
+	if eventData.Status == "PAID" {
+		err = h.kitchenService.UpdateOrderStatus(ctx, kitchenOrder.ID, domain.KitchenOrderStatusPreparing)
+		if err != nil {
+			log.Printf("Failed to start preparation for released order %s: %v", eventData.OrderID, err)
+			return err
+		}
+	}
+
+	log.Printf("Kitchen order %s created for released order %s due at %s",
+		kitchenOrder.ID, eventData.OrderID, eventData.FulfillmentTime.Format(time.RFC3339))
+	return nil
+}
+
 // handleOrderPaid processes order paid events
 func (h *EventHandler) handleOrderPaid(ctx context.Context, event *events.DomainEvent) error {
 	log.Printf("Processing order paid event: %s", event.AggregateID)
@@ -97,6 +162,11 @@ func (h *EventHandler) handleOrderPaid(ctx context.Context, event *events.Domain
 
 	// Get the kitchen order and start preparation
 	kitchenOrder, err := h.kitchenService.GetKitchenOrderByOrderID(ctx, eventData.OrderID)
+	if errors.IsNotFound(err) {
+		// Scheduled orders paid in advance start preparation when they are released
+		log.Printf("No kitchen order yet for paid order %s, waiting for release", eventData.OrderID)
+		return nil
+	}
 	if err != nil {
 		log.Printf("Failed to get kitchen order for order %s: %v", eventData.OrderID, err)
 		return err
@@ -137,6 +207,11 @@ func (h *EventHandler) handleOrderCancelled(ctx context.Context, event *events.D
 
 	// Get the kitchen order and cancel it
 	kitchenOrder, err := h.kitchenService.GetKitchenOrderByOrderID(ctx, eventData.OrderID)
+	if errors.IsNotFound(err) {
+		// Scheduled orders cancelled before release never reached the kitchen
+		log.Printf("No kitchen order for cancelled order %s, nothing to cancel", eventData.OrderID)
+		return nil
+	}
 	if err != nil {
 		log.Printf("Failed to get kitchen order for order %s: %v", eventData.OrderID, err)
 		return err
@@ -151,4 +226,394 @@ func (h *EventHandler) handleOrderCancelled(ctx context.Context, event *events.D
 
 	log.Printf("Kitchen order %s cancelled for cancelled order: %s", kitchenOrder.ID, eventData.OrderID)
 	return nil
-}
\ No newline at end of file
+}
+
+// handleOrderCourseFired releases a held course of the matching kitchen order to the line
+func (h *EventHandler) handleOrderCourseFired(ctx context.Context, event *events.DomainEvent) error {
+	log.Printf("Processing order course fired event: %s", event.AggregateID)
+
+	var eventData events.OrderCourseFiredData
+
+	dataBytes, err := json.Marshal(event.Data)
+	if err != nil {
+		return err
+	}
+
+	if err := json.Unmarshal(dataBytes, &eventData); err != nil {
+		return err
+	}
+
+	kitchenOrder, err := h.kitchenService.GetKitchenOrderByOrderID(ctx, eventData.OrderID)
+	if errors.IsNotFound(err) {
+		// Scheduled orders are ticketed with their fired courses when they are released
+		log.Printf("No kitchen order yet for order %s, waiting for release", eventData.OrderID)
+		return nil
+	}
+	if err != nil {
+		log.Printf("Failed to get kitchen order for order %s: %v", eventData.OrderID, err)
+		return err
+	}
+
+	err = h.kitchenService.FireCourse(ctx, kitchenOrder.ID, eventData.Course)
+	if err != nil {
+		log.Printf("Failed to fire course %d for order %s: %v", eventData.Course, eventData.OrderID, err)
+		return err
+	}
+
+	log.Printf("Kitchen order %s fired course %d for order: %s", kitchenOrder.ID, eventData.Course, eventData.OrderID)
+	return nil
+}
+
+// handleOrderItemAdded tickets an item added to an order, as a delta ticket once the order is on the line
+func (h *EventHandler) handleOrderItemAdded(ctx context.Context, event *events.DomainEvent) error {
+	log.Printf("Processing order item added event: %s", event.AggregateID)
+
+	var eventData events.OrderItemAddedData
+
+	dataBytes, err := json.Marshal(event.Data)
+	if err != nil {
+		return err
+	}
+
+	if err := json.Unmarshal(dataBytes, &eventData); err != nil {
+		return err
+	}
+
+	kitchenOrder, err := h.kitchenService.GetKitchenOrderByOrderID(ctx, eventData.OrderID)
+	if errors.IsNotFound(err) {
+		// Scheduled orders are ticketed with all their items when they are released
+		log.Printf("No kitchen order yet for order %s, waiting for release", eventData.OrderID)
+		return nil
+	}
+	if err != nil {
+		log.Printf("Failed to get kitchen order for order %s: %v", eventData.OrderID, err)
+		return err
+	}
+
+	err = h.kitchenService.AddOrderItem(ctx, kitchenOrder.ID, &domain.TicketLine{
+		OrderItemID:   eventData.ItemID,
+		MenuItemID:    eventData.MenuItemID,
+		Name:          eventData.Name,
+		Quantity:      eventData.Quantity,
+		Course:        eventData.Course,
+		Seat:          eventData.Seat,
+		Modifiers:     toKitchenItemModifiers(eventData.Modifiers),
+		Modifications: eventData.Modifications,
+		Notes:         eventData.Notes,
+	})
+	if err != nil {
+		log.Printf("Failed to add item %s to kitchen order for order %s: %v", eventData.ItemID, eventData.OrderID, err)
+		return err
+	}
+
+	log.Printf("Kitchen order %s amended with %s for order: %s", kitchenOrder.ID, eventData.Name, eventData.OrderID)
+	return nil
+}
+
+// handleOrderItemRemoved takes an item removed from the order off its ticket
+func (h *EventHandler) handleOrderItemRemoved(ctx context.Context, event *events.DomainEvent) error {
+	log.Printf("Processing order item removed event: %s", event.AggregateID)
+
+	var eventData events.OrderItemRemovedData
+
+	dataBytes, err := json.Marshal(event.Data)
+	if err != nil {
+		return err
+	}
+
+	if err := json.Unmarshal(dataBytes, &eventData); err != nil {
+		return err
+	}
+
+	kitchenOrder, err := h.kitchenService.GetKitchenOrderByOrderID(ctx, eventData.OrderID)
+	if errors.IsNotFound(err) {
+		log.Printf("No kitchen order yet for order %s, waiting for release", eventData.OrderID)
+		return nil
+	}
+	if err != nil {
+		log.Printf("Failed to get kitchen order for order %s: %v", eventData.OrderID, err)
+		return err
+	}
+
+	err = h.kitchenService.RemoveOrderItem(ctx, kitchenOrder.ID, eventData.ItemID)
+	if err != nil {
+		log.Printf("Failed to remove item %s from kitchen order for order %s: %v", eventData.ItemID, eventData.OrderID, err)
+		return err
+	}
+
+	log.Printf("Kitchen order %s removed %s for order: %s", kitchenOrder.ID, eventData.Name, eventData.OrderID)
+	return nil
+}
+
+// handleOrderItemUpdated brings the quantity of a ticket item in line with the order
+func (h *EventHandler) handleOrderItemUpdated(ctx context.Context, event *events.DomainEvent) error {
+	log.Printf("Processing order item updated event: %s", event.AggregateID)
+
+	var eventData events.OrderItemUpdatedData
+
+	dataBytes, err := json.Marshal(event.Data)
+	if err != nil {
+		return err
+	}
+
+	if err := json.Unmarshal(dataBytes, &eventData); err != nil {
+		return err
+	}
+
+	kitchenOrder, err := h.kitchenService.GetKitchenOrderByOrderID(ctx, eventData.OrderID)
+	if errors.IsNotFound(err) {
+		log.Printf("No kitchen order yet for order %s, waiting for release", eventData.OrderID)
+		return nil
+	}
+	if err != nil {
+		log.Printf("Failed to get kitchen order for order %s: %v", eventData.OrderID, err)
+		return err
+	}
+
+	err = h.kitchenService.UpdateOrderItemQuantity(ctx, kitchenOrder.ID, eventData.ItemID, eventData.Quantity)
+	if err != nil {
+		log.Printf("Failed to update item %s in kitchen order for order %s: %v", eventData.ItemID, eventData.OrderID, err)
+		return err
+	}
+
+	log.Printf("Kitchen order %s set %s to %d for order: %s", kitchenOrder.ID, eventData.Name, eventData.Quantity, eventData.OrderID)
+	return nil
+}
+
+// handleOrderItemVoided cancels the kitchen item for a line voided from the order
+func (h *EventHandler) handleOrderItemVoided(ctx context.Context, event *events.DomainEvent) error {
+	log.Printf("Processing order item voided event: %s", event.AggregateID)
+
+	var eventData events.OrderItemVoidedData
+
+	dataBytes, err := json.Marshal(event.Data)
+	if err != nil {
+		return err
+	}
+
+	if err := json.Unmarshal(dataBytes, &eventData); err != nil {
+		return err
+	}
+
+	kitchenOrder, err := h.kitchenService.GetKitchenOrderByOrderID(ctx, eventData.OrderID)
+	if errors.IsNotFound(err) {
+		// Scheduled orders are ticketed without their voided items when they are released
+		log.Printf("No kitchen order yet for order %s, waiting for release", eventData.OrderID)
+		return nil
+	}
+	if err != nil {
+		log.Printf("Failed to get kitchen order for order %s: %v", eventData.OrderID, err)
+		return err
+	}
+
+	err = h.kitchenService.VoidItem(ctx, kitchenOrder.ID, eventData.ItemID, eventData.Reason)
+	if err != nil {
+		log.Printf("Failed to void item %s in kitchen order for order %s: %v", eventData.ItemID, eventData.OrderID, err)
+		return err
+	}
+
+	log.Printf("Kitchen order %s voided %s for order: %s", kitchenOrder.ID, eventData.Name, eventData.OrderID)
+	return nil
+}
+
+// handleOrderItemSeatChanged moves the kitchen item for an order line to its new seat
+func (h *EventHandler) handleOrderItemSeatChanged(ctx context.Context, event *events.DomainEvent) error {
+	log.Printf("Processing order item seat changed event: %s", event.AggregateID)
+
+	var eventData events.OrderItemSeatChangedData
+
+	dataBytes, err := json.Marshal(event.Data)
+	if err != nil {
+		return err
+	}
+
+	if err := json.Unmarshal(dataBytes, &eventData); err != nil {
+		return err
+	}
+
+	kitchenOrder, err := h.kitchenService.GetKitchenOrderByOrderID(ctx, eventData.OrderID)
+	if errors.IsNotFound(err) {
+		// Scheduled orders are ticketed with their current seats when they are released
+		log.Printf("No kitchen order yet for order %s, waiting for release", eventData.OrderID)
+		return nil
+	}
+	if err != nil {
+		log.Printf("Failed to get kitchen order for order %s: %v", eventData.OrderID, err)
+		return err
+	}
+
+	err = h.kitchenService.ChangeItemSeat(ctx, kitchenOrder.ID, eventData.ItemID, eventData.Seat)
+	if err != nil {
+		log.Printf("Failed to move item %s in kitchen order for order %s: %v", eventData.ItemID, eventData.OrderID, err)
+		return err
+	}
+
+	log.Printf("Kitchen order %s moved %s to seat %d for order: %s", kitchenOrder.ID, eventData.Name, eventData.Seat, eventData.OrderID)
+	return nil
+}
+
+// handleOrderTableChanged moves the kitchen order to the order's new table
+func (h *EventHandler) handleOrderTableChanged(ctx context.Context, event *events.DomainEvent) error {
+	log.Printf("Processing order table changed event: %s", event.AggregateID)
+
+	var eventData events.OrderTableChangedData
+
+	dataBytes, err := json.Marshal(event.Data)
+	if err != nil {
+		return err
+	}
+
+	if err := json.Unmarshal(dataBytes, &eventData); err != nil {
+		return err
+	}
+
+	kitchenOrder, err := h.kitchenService.GetKitchenOrderByOrderID(ctx, eventData.OrderID)
+	if errors.IsNotFound(err) {
+		// Scheduled orders are ticketed on release, with the table they have by then
+		log.Printf("No kitchen order for order %s, nothing to transfer", eventData.OrderID)
+		return nil
+	}
+	if err != nil {
+		log.Printf("Failed to get kitchen order for order %s: %v", eventData.OrderID, err)
+		return err
+	}
+
+	if kitchenOrder.TableID == eventData.NewTableID {
+		return nil
+	}
+
+	err = h.kitchenService.TransferTable(ctx, kitchenOrder.ID, eventData.NewTableID)
+	if err != nil {
+		log.Printf("Failed to transfer kitchen order for order %s: %v", eventData.OrderID, err)
+		return err
+	}
+
+	log.Printf("Kitchen order %s moved from table %s to %s", kitchenOrder.ID, eventData.OldTableID, eventData.NewTableID)
+	return nil
+}
+
+// handleOrderItemsMoved moves kitchen items along with order lines moved to another check.
+// A merged check's kitchen order is cancelled once its items have moved.
+func (h *EventHandler) handleOrderItemsMoved(ctx context.Context, event *events.DomainEvent) error {
+	log.Printf("Processing %s event: %s", event.Type, event.AggregateID)
+
+	var eventData events.OrderItemsMovedData
+
+	dataBytes, err := json.Marshal(event.Data)
+	if err != nil {
+		return err
+	}
+
+	if err := json.Unmarshal(dataBytes, &eventData); err != nil {
+		return err
+	}
+
+	source, err := h.kitchenService.GetKitchenOrderByOrderID(ctx, eventData.FromOrderID)
+	if errors.IsNotFound(err) {
+		log.Printf("No kitchen order for order %s, nothing to move", eventData.FromOrderID)
+		return nil
+	}
+	if err != nil {
+		log.Printf("Failed to get kitchen order for order %s: %v", eventData.FromOrderID, err)
+		return err
+	}
+
+	target, err := h.kitchenService.GetKitchenOrderByOrderID(ctx, eventData.ToOrderID)
+	if err != nil && !errors.IsNotFound(err) {
+		log.Printf("Failed to get kitchen order for order %s: %v", eventData.ToOrderID, err)
+		return err
+	}
+	if target != nil {
+		err = h.kitchenService.MoveItems(ctx, source.ID, target.ID, eventData.ItemIDs)
+		if err != nil {
+			log.Printf("Failed to move items from kitchen order %s to %s: %v", source.ID, target.ID, err)
+			return err
+		}
+		log.Printf("Kitchen order %s moved %d items to table %s", source.ID, len(eventData.ItemIDs), eventData.ToTableID)
+	}
+
+	if event.Type == events.OrderMergedEvent && !source.IsCancelled() {
+		err = h.kitchenService.CancelKitchenOrder(ctx, source.ID)
+		if err != nil {
+			log.Printf("Failed to cancel kitchen order for merged order %s: %v", eventData.FromOrderID, err)
+			return err
+		}
+		log.Printf("Kitchen order %s cancelled for order %s merged into %s", source.ID, eventData.FromOrderID, eventData.ToOrderID)
+	}
+
+	return nil
+}
+
+// handleOrderSLABreached raises the priority of the kitchen order of an order that breached its SLA
+func (h *EventHandler) handleOrderSLABreached(ctx context.Context, event *events.DomainEvent) error {
+	log.Printf("Processing order SLA breached event: %s", event.AggregateID)
+
+	var eventData events.OrderSLABreachedData
+
+	dataBytes, err := json.Marshal(event.Data)
+	if err != nil {
+		return err
+	}
+
+	if err := json.Unmarshal(dataBytes, &eventData); err != nil {
+		return err
+	}
+
+	kitchenOrder, err := h.kitchenService.GetKitchenOrderByOrderID(ctx, eventData.OrderID)
+	if errors.IsNotFound(err) {
+		// Orders breaching an SLA before they are paid have no kitchen order yet
+		log.Printf("No kitchen order for order %s, nothing to escalate", eventData.OrderID)
+		return nil
+	}
+	if err != nil {
+		log.Printf("Failed to get kitchen order for order %s: %v", eventData.OrderID, err)
+		return err
+	}
+
+	if kitchenOrder.IsComplete() || kitchenOrder.IsCancelled() {
+		return nil
+	}
+	priority := kitchenOrder.EscalatedPriority()
+	if priority == kitchenOrder.Priority {
+		return nil
+	}
+
+	err = h.kitchenService.SetPriority(ctx, kitchenOrder.ID, priority)
+	if err != nil {
+		log.Printf("Failed to escalate kitchen order for order %s: %v", eventData.OrderID, err)
+		return err
+	}
+
+	log.Printf("Kitchen order %s escalated to %s: order %s was %ds in %s", kitchenOrder.ID, priority,
+		eventData.OrderID, eventData.ElapsedSeconds, eventData.Status)
+	return nil
+}
+
+// Helper functions
+
+func toTicketLines(items []events.OrderLineData) []*domain.TicketLine {
+	lines := make([]*domain.TicketLine, len(items))
+	for i, item := range items {
+		lines[i] = &domain.TicketLine{
+			OrderItemID:   item.ItemID,
+			MenuItemID:    item.MenuItemID,
+			Name:          item.Name,
+			Quantity:      item.Quantity,
+			Course:        item.Course,
+			Fired:         item.Fired,
+			Seat:          item.Seat,
+			Modifiers:     toKitchenItemModifiers(item.Modifiers),
+			Modifications: item.Modifications,
+			Notes:         item.Notes,
+		}
+	}
+	return lines
+}
+
+func toKitchenItemModifiers(modifiers []events.OrderItemModifierData) []*domain.KitchenItemModifier {
+	result := make([]*domain.KitchenItemModifier, len(modifiers))
+	for i, m := range modifiers {
+		result[i] = &domain.KitchenItemModifier{Group: m.Group, Option: m.Option}
+	}
+	return result
+}
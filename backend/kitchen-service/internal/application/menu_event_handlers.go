@@ -0,0 +1,157 @@
+package application
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/restaurant-platform/kitchen-service/internal/domain"
+	"github.com/restaurant-platform/shared/events"
+	"github.com/restaurant-platform/shared/pkg/errors"
+)
+
+// MenuEventHandler keeps the local menu read model current from menu events
+type MenuEventHandler struct {
+	menuItemRepo domain.MenuItemRepository
+}
+
+// NewMenuEventHandler creates a new menu event handler
+func NewMenuEventHandler(menuItemRepo domain.MenuItemRepository) *MenuEventHandler {
+	return &MenuEventHandler{
+		menuItemRepo: menuItemRepo,
+	}
+}
+
+// HandleMenuEvent processes menu-related events
+func (h *MenuEventHandler) HandleMenuEvent(ctx context.Context, event *events.DomainEvent) error {
+	switch event.Type {
+	case events.MenuActivatedEvent:
+		return h.handleMenuActivated(ctx, event)
+	case events.MenuDeactivatedEvent:
+		return h.handleMenuDeactivated(ctx, event)
+	case events.MenuItemAddedEvent, events.MenuItemUpdatedEvent:
+		return h.handleMenuItemChanged(ctx, event)
+	case events.MenuItemRemovedEvent:
+		return h.handleMenuItemRemoved(ctx, event)
+	default:
+		log.Printf("Unhandled menu event type: %s", event.Type)
+		return nil
+	}
+}
+
+// LoadActiveMenu loads the menu currently on sale into the read model. Menus activated before
+// the kitchen service subscribed never produce a MenuActivated event for it, so this runs at startup
+// and tickets are routed and timed from the real menu rather than the defaults.
+func (h *MenuEventHandler) LoadActiveMenu(ctx context.Context, catalog domain.MenuCatalog) error {
+	menuID, items, err := catalog.ActiveMenu(ctx)
+	if errors.IsNotFound(err) {
+		log.Printf("No active menu to load")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get active menu: %w", err)
+	}
+
+	if err := h.menuItemRepo.ReplaceMenu(ctx, menuID, items); err != nil {
+		return fmt.Errorf("failed to load items for menu %s: %w", menuID, err)
+	}
+
+	log.Printf("Loaded %d items for active menu %s", len(items), menuID)
+	return nil
+}
+
+// handleMenuActivated replaces the items of the activated menu with the snapshot in the event
+func (h *MenuEventHandler) handleMenuActivated(ctx context.Context, event *events.DomainEvent) error {
+	log.Printf("Processing menu activated event: %s", event.AggregateID)
+
+	var eventData events.MenuActivatedData
+	if err := decodeEventData(event, &eventData); err != nil {
+		return err
+	}
+
+	items := make([]*domain.MenuItem, len(eventData.Items))
+	for i, data := range eventData.Items {
+		items[i] = toMenuItem(data, event.OccurredAt)
+	}
+
+	if err := h.menuItemRepo.ReplaceMenu(ctx, eventData.MenuID, items); err != nil {
+		log.Printf("Failed to load items for menu %s: %v", eventData.MenuID, err)
+		return err
+	}
+
+	log.Printf("Loaded %d items for menu %s", len(items), eventData.MenuID)
+	return nil
+}
+
+// handleMenuDeactivated removes the items of a deactivated menu
+func (h *MenuEventHandler) handleMenuDeactivated(ctx context.Context, event *events.DomainEvent) error {
+	log.Printf("Processing menu deactivated event: %s", event.AggregateID)
+
+	var eventData events.MenuDeactivatedData
+	if err := decodeEventData(event, &eventData); err != nil {
+		return err
+	}
+
+	if err := h.menuItemRepo.DeleteByMenu(ctx, eventData.MenuID); err != nil {
+		log.Printf("Failed to remove items for menu %s: %v", eventData.MenuID, err)
+		return err
+	}
+
+	return nil
+}
+
+// handleMenuItemChanged creates or replaces a menu item
+func (h *MenuEventHandler) handleMenuItemChanged(ctx context.Context, event *events.DomainEvent) error {
+	var eventData events.MenuItemData
+	if err := decodeEventData(event, &eventData); err != nil {
+		return err
+	}
+
+	// Items on inactive menus cannot be ordered, so they never reach a ticket
+	if !eventData.MenuActive {
+		log.Printf("Ignoring item %s on inactive menu %s", eventData.ItemID, eventData.MenuID)
+		return nil
+	}
+
+	if err := h.menuItemRepo.Upsert(ctx, toMenuItem(eventData, event.OccurredAt)); err != nil {
+		log.Printf("Failed to store menu item %s: %v", eventData.ItemID, err)
+		return err
+	}
+
+	log.Printf("Menu item %s (%s) stored with prep time %ds", eventData.ItemID, eventData.Name, eventData.PrepTimeSeconds)
+	return nil
+}
+
+// handleMenuItemRemoved removes a menu item
+func (h *MenuEventHandler) handleMenuItemRemoved(ctx context.Context, event *events.DomainEvent) error {
+	var eventData events.MenuItemData
+	if err := decodeEventData(event, &eventData); err != nil {
+		return err
+	}
+
+	return h.menuItemRepo.Delete(ctx, eventData.ItemID)
+}
+
+// Helper functions
+
+func decodeEventData(event *events.DomainEvent, target interface{}) error {
+	dataBytes, err := json.Marshal(event.Data)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(dataBytes, target)
+}
+
+func toMenuItem(data events.MenuItemData, occurredAt time.Time) *domain.MenuItem {
+	return &domain.MenuItem{
+		ID:           data.ItemID,
+		MenuID:       data.MenuID,
+		Name:         data.Name,
+		CategoryID:   data.CategoryID,
+		CategoryName: data.CategoryName,
+		PrepTime:     time.Duration(data.PrepTimeSeconds) * time.Second,
+		UpdatedAt:    occurredAt,
+	}
+}
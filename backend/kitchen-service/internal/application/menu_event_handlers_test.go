@@ -0,0 +1,128 @@
+package application
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/restaurant-platform/kitchen-service/internal/domain"
+	"github.com/restaurant-platform/shared/events"
+	sharedErrors "github.com/restaurant-platform/shared/pkg/errors"
+)
+
+// MockMenuCatalog is a mock implementation of MenuCatalog
+type MockMenuCatalog struct {
+	mock.Mock
+}
+
+func (m *MockMenuCatalog) ActiveMenu(ctx context.Context) (string, []*domain.MenuItem, error) {
+	args := m.Called(ctx)
+	if args.Get(1) == nil {
+		return args.String(0), nil, args.Error(2)
+	}
+	return args.String(0), args.Get(1).([]*domain.MenuItem), args.Error(2)
+}
+
+// MenuEventHandlerTestSuite contains menu read model projection tests
+type MenuEventHandlerTestSuite struct {
+	suite.Suite
+	handler      *MenuEventHandler
+	mockMenuRepo *MockMenuItemRepository
+	ctx          context.Context
+}
+
+func (suite *MenuEventHandlerTestSuite) SetupTest() {
+	suite.mockMenuRepo = new(MockMenuItemRepository)
+	suite.handler = NewMenuEventHandler(suite.mockMenuRepo)
+	suite.ctx = context.Background()
+}
+
+func TestMenuEventHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(MenuEventHandlerTestSuite))
+}
+
+func (suite *MenuEventHandlerTestSuite) TestLoadActiveMenu_ReplacesMenuItems() {
+	// Given
+	catalog := new(MockMenuCatalog)
+	items := []*domain.MenuItem{{ID: "steak-1", MenuID: "menu-1", Name: "Ribeye", CategoryName: "Grill", PrepTime: 18 * time.Minute}}
+	catalog.On("ActiveMenu", suite.ctx).Return("menu-1", items, nil)
+	suite.mockMenuRepo.On("ReplaceMenu", suite.ctx, "menu-1", items).Return(nil)
+
+	// When
+	err := suite.handler.LoadActiveMenu(suite.ctx, catalog)
+
+	// Then
+	assert.New(suite.T()).NoError(err)
+	suite.mockMenuRepo.AssertExpectations(suite.T())
+}
+
+func (suite *MenuEventHandlerTestSuite) TestLoadActiveMenu_NoActiveMenu_LoadsNothing() {
+	// Given
+	catalog := new(MockMenuCatalog)
+	catalog.On("ActiveMenu", suite.ctx).Return("", nil, sharedErrors.WrapNotFound("ActiveMenu", "menu", "active", sharedErrors.ErrNotFound))
+
+	// When
+	err := suite.handler.LoadActiveMenu(suite.ctx, catalog)
+
+	// Then
+	assert.New(suite.T()).NoError(err)
+	suite.mockMenuRepo.AssertNotCalled(suite.T(), "ReplaceMenu", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *MenuEventHandlerTestSuite) TestMenuItemUpdated_StoresPrepTime() {
+	// Given
+	eventData, _ := events.ToEventData(events.MenuItemData{
+		MenuID: "menu-1", ItemID: "steak-1", Name: "Ribeye", CategoryName: "Mains",
+		PrepTimeSeconds: 1080, MenuActive: true,
+	})
+	event := events.NewDomainEvent(events.MenuItemUpdatedEvent, "menu-1", eventData)
+	suite.mockMenuRepo.On("Upsert", suite.ctx, mock.MatchedBy(func(item *domain.MenuItem) bool {
+		return item.ID == "steak-1" && item.PrepTime == 18*time.Minute && item.CategoryName == "Mains"
+	})).Return(nil)
+
+	// When
+	err := suite.handler.HandleMenuEvent(suite.ctx, event)
+
+	// Then
+	assert.New(suite.T()).NoError(err)
+	suite.mockMenuRepo.AssertExpectations(suite.T())
+}
+
+func (suite *MenuEventHandlerTestSuite) TestMenuItemUpdated_InactiveMenu_IsIgnored() {
+	// Given
+	eventData, _ := events.ToEventData(events.MenuItemData{MenuID: "menu-2", ItemID: "steak-1", PrepTimeSeconds: 1080})
+	event := events.NewDomainEvent(events.MenuItemUpdatedEvent, "menu-2", eventData)
+
+	// When
+	err := suite.handler.HandleMenuEvent(suite.ctx, event)
+
+	// Then
+	assert.New(suite.T()).NoError(err)
+	suite.mockMenuRepo.AssertNotCalled(suite.T(), "Upsert", mock.Anything, mock.Anything)
+}
+
+func (suite *MenuEventHandlerTestSuite) TestMenuActivated_ReplacesMenu() {
+	// Given
+	eventData, _ := events.ToEventData(events.MenuActivatedData{
+		MenuID: "menu-1",
+		Items: []events.MenuItemData{
+			{MenuID: "menu-1", ItemID: "steak-1", Name: "Ribeye", PrepTimeSeconds: 1080},
+			{MenuID: "menu-1", ItemID: "salad-1", Name: "Salad", PrepTimeSeconds: 300},
+		},
+	})
+	event := events.NewDomainEvent(events.MenuActivatedEvent, "menu-1", eventData)
+	suite.mockMenuRepo.On("ReplaceMenu", suite.ctx, "menu-1", mock.MatchedBy(func(items []*domain.MenuItem) bool {
+		return len(items) == 2 && items[1].PrepTime == 5*time.Minute
+	})).Return(nil)
+
+	// When
+	err := suite.handler.HandleMenuEvent(suite.ctx, event)
+
+	// Then
+	assert.New(suite.T()).NoError(err)
+	suite.mockMenuRepo.AssertExpectations(suite.T())
+}
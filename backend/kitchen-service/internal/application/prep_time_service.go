@@ -0,0 +1,99 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/restaurant-platform/kitchen-service/internal/domain"
+	"github.com/restaurant-platform/shared/pkg/errors"
+)
+
+// prepTimeOrderPageSize is how many kitchen orders are read at a time when learning prep times
+const prepTimeOrderPageSize = 500
+
+// KitchenPrepTimeService learns preparation times from past kitchen orders
+type KitchenPrepTimeService struct {
+	orderRepo    domain.KitchenOrderRepository
+	statRepo     domain.PrepTimeStatRepository
+	menuItemRepo domain.MenuItemRepository
+	lookback     time.Duration
+}
+
+// NewKitchenPrepTimeService creates a new prep time service learning from the kitchen
+// orders created within lookback
+func NewKitchenPrepTimeService(orderRepo domain.KitchenOrderRepository, statRepo domain.PrepTimeStatRepository, menuItemRepo domain.MenuItemRepository, lookback time.Duration) *KitchenPrepTimeService {
+	return &KitchenPrepTimeService{
+		orderRepo:    orderRepo,
+		statRepo:     statRepo,
+		menuItemRepo: menuItemRepo,
+		lookback:     lookback,
+	}
+}
+
+// RecomputePrepTimeStats learns preparation times from the kitchen orders created within the
+// lookback before now, replacing the previous statistics. It returns how many were stored.
+func (s *KitchenPrepTimeService) RecomputePrepTimeStats(ctx context.Context, now time.Time) (int, error) {
+	from := now.Add(-s.lookback)
+	filters := domain.KitchenOrderFilters{
+		DateFrom: &from,
+		DateTo:   &now,
+	}
+
+	var orders []*domain.KitchenOrder
+	for offset := 0; ; offset += prepTimeOrderPageSize {
+		page, _, err := s.orderRepo.List(ctx, offset, prepTimeOrderPageSize, filters)
+		if err != nil {
+			return 0, fmt.Errorf("failed to list kitchen orders: %w", err)
+		}
+		orders = append(orders, page...)
+		if len(page) < prepTimeOrderPageSize {
+			break
+		}
+	}
+
+	stats := domain.ComputePrepTimeStats(orders, now)
+	if err := s.statRepo.ReplacePrepTimeStats(ctx, stats); err != nil {
+		return 0, fmt.Errorf("failed to save prep time stats: %w", err)
+	}
+
+	log.Printf("Learned %d prep time stats from %d kitchen orders", len(stats), len(orders))
+	return len(stats), nil
+}
+
+// ListPrepTimeStats retrieves the learned preparation times of a menu item, or of every menu item
+func (s *KitchenPrepTimeService) ListPrepTimeStats(ctx context.Context, menuItemID string) (domain.PrepTimeStats, error) {
+	stats, err := s.statRepo.ListPrepTimeStats(ctx, menuItemID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list prep time stats: %w", err)
+	}
+
+	return stats, nil
+}
+
+// EstimatePrepTime returns the preparation time a menu item is expected to take at a station
+// in an hour of the day, and whether it was learned or taken from the menu
+func (s *KitchenPrepTimeService) EstimatePrepTime(ctx context.Context, menuItemID, station string, hour int) (*domain.PrepTimeEstimate, error) {
+	if menuItemID == "" {
+		return nil, errors.WrapValidation("EstimatePrepTime", "menu_item_id", "menu item ID is required", nil)
+	}
+	if hour < 0 || hour > 23 {
+		return nil, errors.WrapValidation("EstimatePrepTime", "hour", "hour must be between 0 and 23", nil)
+	}
+
+	stats, err := s.statRepo.ListPrepTimeStats(ctx, menuItemID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list prep time stats: %w", err)
+	}
+
+	menuItem, err := s.menuItemRepo.GetByID(ctx, menuItemID)
+	if err != nil {
+		if !errors.IsNotFound(err) {
+			return nil, fmt.Errorf("failed to get menu item: %w", err)
+		}
+		menuItem = nil
+	}
+
+	return stats.Estimate(menuItemID, station, hour, menuItem), nil
+}
@@ -0,0 +1,138 @@
+package application
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/restaurant-platform/kitchen-service/internal/domain"
+	sharedErrors "github.com/restaurant-platform/shared/pkg/errors"
+)
+
+// MockPrepTimeStatRepository is a mock implementation of PrepTimeStatRepository
+type MockPrepTimeStatRepository struct {
+	mock.Mock
+}
+
+func (m *MockPrepTimeStatRepository) ListPrepTimeStats(ctx context.Context, menuItemID string) (domain.PrepTimeStats, error) {
+	args := m.Called(ctx, menuItemID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(domain.PrepTimeStats), args.Error(1)
+}
+
+func (m *MockPrepTimeStatRepository) ReplacePrepTimeStats(ctx context.Context, stats domain.PrepTimeStats) error {
+	args := m.Called(ctx, stats)
+	return args.Error(0)
+}
+
+// KitchenPrepTimeServiceTestSuite contains the learned prep time service tests
+type KitchenPrepTimeServiceTestSuite struct {
+	suite.Suite
+	service       *KitchenPrepTimeService
+	mockRepo      *MockKitchenOrderRepository
+	mockPrepTimes *MockPrepTimeStatRepository
+	mockMenuRepo  *MockMenuItemRepository
+	ctx           context.Context
+}
+
+func (suite *KitchenPrepTimeServiceTestSuite) SetupTest() {
+	suite.mockRepo = new(MockKitchenOrderRepository)
+	suite.mockPrepTimes = new(MockPrepTimeStatRepository)
+	suite.mockMenuRepo = new(MockMenuItemRepository)
+	suite.service = NewKitchenPrepTimeService(suite.mockRepo, suite.mockPrepTimes, suite.mockMenuRepo, 30*24*time.Hour)
+	suite.ctx = context.Background()
+}
+
+func TestKitchenPrepTimeServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(KitchenPrepTimeServiceTestSuite))
+}
+
+// preparedOrder returns a kitchen order whose burger was prepared at the grill in the given time
+func preparedOrder(startedAt time.Time, took time.Duration) *domain.KitchenOrder {
+	order, _ := domain.NewKitchenOrder("order-123", "table-4")
+	item, _ := order.AddOrderLine(&domain.TicketLine{OrderItemID: "item_burger", MenuItemID: "burger-1", Name: "Burger", Quantity: 1}, 12*time.Minute)
+	item.AssignedStation = domain.StationGrill
+	item.Status = domain.KitchenItemStatusReady
+	item.StartedAt = startedAt
+	item.CompletedAt = startedAt.Add(took)
+	return order
+}
+
+func (suite *KitchenPrepTimeServiceTestSuite) TestRecomputePrepTimeStats_ReplacesStatsFromLookback() {
+	// Given
+	now := time.Date(2026, 10, 18, 22, 0, 0, 0, time.UTC)
+	startedAt := time.Date(2026, 10, 17, 19, 5, 0, 0, time.UTC)
+	orders := []*domain.KitchenOrder{
+		preparedOrder(startedAt, 14*time.Minute),
+		preparedOrder(startedAt, 15*time.Minute),
+		preparedOrder(startedAt, 16*time.Minute),
+	}
+	suite.mockRepo.On("List", suite.ctx, 0, prepTimeOrderPageSize, mock.MatchedBy(func(filters domain.KitchenOrderFilters) bool {
+		return filters.DateFrom.Equal(now.Add(-30*24*time.Hour)) && filters.DateTo.Equal(now)
+	})).Return(orders, len(orders), nil)
+	suite.mockPrepTimes.On("ReplacePrepTimeStats", suite.ctx, mock.MatchedBy(func(stats domain.PrepTimeStats) bool {
+		return len(stats) == 2 && stats[0].Hour == 19 && stats[1].Hour == domain.AllHours && stats[1].Median == 15*time.Minute
+	})).Return(nil)
+
+	// When
+	count, err := suite.service.RecomputePrepTimeStats(suite.ctx, now)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	assert.Equal(2, count)
+	suite.mockRepo.AssertExpectations(suite.T())
+	suite.mockPrepTimes.AssertExpectations(suite.T())
+}
+
+func (suite *KitchenPrepTimeServiceTestSuite) TestEstimatePrepTime_SparseData_FallsBackToMenu() {
+	// Given
+	sparse := domain.PrepTimeStats{
+		{MenuItemID: "burger-1", Station: domain.StationGrill, Hour: domain.AllHours, SampleCount: 2, Median: 20 * time.Minute},
+	}
+	suite.mockPrepTimes.On("ListPrepTimeStats", suite.ctx, "burger-1").Return(sparse, nil)
+	suite.mockMenuRepo.On("GetByID", suite.ctx, "burger-1").Return(&domain.MenuItem{ID: "burger-1", PrepTime: 12 * time.Minute}, nil)
+
+	// When
+	estimate, err := suite.service.EstimatePrepTime(suite.ctx, "burger-1", domain.StationGrill, 19)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	assert.Equal(domain.PrepTimeSourceMenu, estimate.Source)
+	assert.Equal(12*time.Minute, estimate.PrepTime)
+	assert.Nil(estimate.Stat)
+}
+
+func (suite *KitchenPrepTimeServiceTestSuite) TestEstimatePrepTime_UnknownMenuItem_UsesLearnedTime() {
+	// Given
+	learned := domain.PrepTimeStats{
+		{MenuItemID: "special-1", Station: domain.StationSaute, Hour: 19, SampleCount: 12, Median: 9 * time.Minute},
+	}
+	suite.mockPrepTimes.On("ListPrepTimeStats", suite.ctx, "special-1").Return(learned, nil)
+	suite.mockMenuRepo.On("GetByID", suite.ctx, "special-1").Return(nil, sharedErrors.WrapNotFound("GetByID", "menu_item", "special-1", sharedErrors.ErrNotFound))
+
+	// When
+	estimate, err := suite.service.EstimatePrepTime(suite.ctx, "special-1", domain.StationSaute, 19)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	assert.Equal(domain.PrepTimeSourceHour, estimate.Source)
+	assert.Equal(9*time.Minute, estimate.PrepTime)
+}
+
+func (suite *KitchenPrepTimeServiceTestSuite) TestEstimatePrepTime_InvalidHour_ShouldFail() {
+	// When
+	_, err := suite.service.EstimatePrepTime(suite.ctx, "burger-1", domain.StationGrill, 24)
+
+	// Then
+	assert.True(suite.T(), sharedErrors.IsValidationError(err))
+	suite.mockPrepTimes.AssertNotCalled(suite.T(), "ListPrepTimeStats", mock.Anything, mock.Anything)
+}
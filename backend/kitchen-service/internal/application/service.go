@@ -4,41 +4,65 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"slices"
 	"time"
 
 	"github.com/restaurant-platform/kitchen-service/internal/domain"
 	"github.com/restaurant-platform/shared/events"
+	"github.com/restaurant-platform/shared/pkg/concurrency"
 	"github.com/restaurant-platform/shared/pkg/errors"
 )
 
 // KitchenOrderService implements the kitchen order business logic
 type KitchenOrderService struct {
 	repo           domain.KitchenOrderRepository
+	menuItemRepo   domain.MenuItemRepository
+	stationRepo    domain.StationRepository
+	prepTimeRepo   domain.PrepTimeStatRepository
 	eventPublisher events.EventPublisher
 }
 
+// courseMetricsOrderLimit caps how many orders are scanned when computing course metrics
+const courseMetricsOrderLimit = 1000
+
 // NewKitchenOrderService creates a new kitchen order service
-func NewKitchenOrderService(repo domain.KitchenOrderRepository, eventPublisher events.EventPublisher) *KitchenOrderService {
+func NewKitchenOrderService(repo domain.KitchenOrderRepository, menuItemRepo domain.MenuItemRepository, stationRepo domain.StationRepository, prepTimeRepo domain.PrepTimeStatRepository, eventPublisher events.EventPublisher) *KitchenOrderService {
 	return &KitchenOrderService{
 		repo:           repo,
+		menuItemRepo:   menuItemRepo,
+		stationRepo:    stationRepo,
+		prepTimeRepo:   prepTimeRepo,
 		eventPublisher: eventPublisher,
 	}
 }
 
-// CreateKitchenOrder creates a new kitchen order from a regular order
-func (s *KitchenOrderService) CreateKitchenOrder(ctx context.Context, orderID, tableID string) (*domain.KitchenOrder, error) {
+// CreateKitchenOrder creates a new kitchen order from a regular order, ticketing the given order lines
+func (s *KitchenOrderService) CreateKitchenOrder(ctx context.Context, orderID, tableID string, lines []*domain.TicketLine) (*domain.KitchenOrder, error) {
 	// Create a new kitchen order
 	order, err := domain.NewKitchenOrder(orderID, tableID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create kitchen order: %w", err)
 	}
 
+	if len(lines) > 0 {
+		rules := s.loadRoutingRules(ctx)
+		for _, line := range lines {
+			menuItem := s.lookupMenuItem(ctx, line.MenuItemID)
+			item, err := order.AddOrderLine(line, menuItem.EffectivePrepTime())
+			if err != nil {
+				return nil, fmt.Errorf("failed to add %s to kitchen order: %w", line.Name, err)
+			}
+			item.Route(rules, menuItem)
+			order.ApplyLearnedPrepTime(item, s.loadPrepTimeStats(ctx, line.MenuItemID), time.Now())
+		}
+	}
+
 	// Save to repository
 	if err := s.repo.Save(ctx, order); err != nil {
 		return nil, fmt.Errorf("failed to save kitchen order: %w", err)
 	}
 
-	log.Printf("Created kitchen order: %s for order: %s", order.ID, orderID)
+	log.Printf("Created kitchen order: %s for order: %s with %d items at stations %v", order.ID, orderID, len(order.Items), order.Stations())
 
 	// Publish KitchenOrderCreatedEvent
 	eventData, err := events.ToEventData(events.KitchenOrderCreatedData{
@@ -48,6 +72,7 @@ func (s *KitchenOrderService) CreateKitchenOrder(ctx context.Context, orderID, t
 		Status:         string(order.Status),
 		Priority:       string(order.Priority),
 		EstimatedTime:  int64(order.EstimatedTime.Seconds()),
+		Stations:       order.Stations(),
 	})
 
 	if err != nil {
@@ -86,64 +111,245 @@ func (s *KitchenOrderService) GetKitchenOrderByOrderID(ctx context.Context, orde
 	return order, nil
 }
 
-// AddKitchenItem adds an item to a kitchen order
-func (s *KitchenOrderService) AddKitchenItem(ctx context.Context, kitchenOrderID domain.KitchenOrderID, menuItemID, name string, quantity int, prepTime time.Duration, modifications []string, notes string) error {
-	// Get the kitchen order
-	order, err := s.repo.FindByID(ctx, kitchenOrderID)
+// AddKitchenItem adds an item on a course to a kitchen order
+func (s *KitchenOrderService) AddKitchenItem(ctx context.Context, kitchenOrderID domain.KitchenOrderID, menuItemID, name string, quantity, course int, prepTime time.Duration, modifiers []*domain.KitchenItemModifier, modifications []string, notes string) error {
+	// Add the item to the order
+	order, err := s.modifyKitchenOrder(ctx, kitchenOrderID, func(order *domain.KitchenOrder) error {
+		if err := order.AddCourseItem(course, menuItemID, name, quantity, prepTime, modifiers, modifications, notes); err != nil {
+			return fmt.Errorf("failed to add item to kitchen order: %w", err)
+		}
+		return nil
+	})
 	if err != nil {
-		return fmt.Errorf("failed to get kitchen order: %w", err)
+		return err
 	}
 
-	// Add the item to the order
-	if err := order.AddItem(menuItemID, name, quantity, prepTime, modifications, notes); err != nil {
-		return fmt.Errorf("failed to add item to kitchen order: %w", err)
+	log.Printf("Added item %s to kitchen order: %s", name, kitchenOrderID)
+	s.publishOrderUpdated(ctx, order, "item_added", nil)
+
+	return nil
+}
+
+// AddOrderItem tickets an order line with its learned preparation time, or the one from the
+// menu read model while data is sparse, routing it to its station. Lines added once the order has gone to the line print on a delta ticket.
+func (s *KitchenOrderService) AddOrderItem(ctx context.Context, kitchenOrderID domain.KitchenOrderID, line *domain.TicketLine) error {
+	menuItem := s.lookupMenuItem(ctx, line.MenuItemID)
+	rules := s.loadRoutingRules(ctx)
+	stats := s.loadPrepTimeStats(ctx, line.MenuItemID)
+
+	var item *domain.KitchenItem
+	order, err := s.modifyKitchenOrder(ctx, kitchenOrderID, func(order *domain.KitchenOrder) error {
+		var err error
+		item, err = order.AddOrderLine(line, menuItem.EffectivePrepTime())
+		if err != nil {
+			return err
+		}
+		item.Route(rules, menuItem)
+		order.ApplyLearnedPrepTime(item, stats, time.Now())
+		return nil
+	})
+	if err != nil {
+		return err
 	}
 
-	// Update the repository
-	if err := s.repo.Update(ctx, order); err != nil {
-		return fmt.Errorf("failed to update kitchen order: %w", err)
+	if item.Ticket > 0 {
+		log.Printf("Added %s to kitchen order %s on delta ticket %d", line.Name, kitchenOrderID, item.Ticket)
+	} else {
+		log.Printf("Added %s to kitchen order %s", line.Name, kitchenOrderID)
 	}
+	s.publishOrderUpdated(ctx, order, "item_added", nil)
 
-	log.Printf("Added item %s to kitchen order: %s", name, kitchenOrderID)
+	return nil
+}
+
+// RemoveOrderItem takes an order line off a ticket that has not gone to the line yet
+func (s *KitchenOrderService) RemoveOrderItem(ctx context.Context, kitchenOrderID domain.KitchenOrderID, orderItemID string) error {
+	var previousStations []string
+	order, err := s.modifyKitchenOrder(ctx, kitchenOrderID, func(order *domain.KitchenOrder) error {
+		previousStations = order.Stations()
+		return order.RemoveOrderLine(orderItemID)
+	})
+	if err != nil {
+		return err
+	}
+
+	log.Printf("Removed order item %s from kitchen order %s", orderItemID, kitchenOrderID)
+	s.publishOrderUpdated(ctx, order, "item_removed", previousStations)
 
 	return nil
 }
 
-// UpdateItemStatus changes the status of an item in a kitchen order
-func (s *KitchenOrderService) UpdateItemStatus(ctx context.Context, kitchenOrderID domain.KitchenOrderID, itemID string, status domain.KitchenItemStatus) error {
-	// Get the kitchen order
-	order, err := s.repo.FindByID(ctx, kitchenOrderID)
+// UpdateOrderItemQuantity changes the quantity of an order line on a ticket that has not gone to the line yet
+func (s *KitchenOrderService) UpdateOrderItemQuantity(ctx context.Context, kitchenOrderID domain.KitchenOrderID, orderItemID string, quantity int) error {
+	var item *domain.KitchenItem
+	order, err := s.modifyKitchenOrder(ctx, kitchenOrderID, func(order *domain.KitchenOrder) error {
+		var err error
+		item, err = order.SetOrderLineQuantity(orderItemID, quantity)
+		return err
+	})
 	if err != nil {
-		return fmt.Errorf("failed to get kitchen order: %w", err)
+		return err
 	}
 
-	// Store the previous status for events
+	log.Printf("Set %s in kitchen order %s to %d", item.Name, kitchenOrderID, quantity)
+	s.publishOrderUpdated(ctx, order, "item_quantity_changed", nil)
+
+	return nil
+}
+
+// lookupMenuItem returns a menu item from the menu read model, or nil when the item is not
+// known to the kitchen; a nil menu item gets the default prep time and is routed without its category
+func (s *KitchenOrderService) lookupMenuItem(ctx context.Context, menuItemID string) *domain.MenuItem {
+	menuItem, err := s.menuItemRepo.GetByID(ctx, menuItemID)
+	if err != nil {
+		if !errors.IsNotFound(err) {
+			log.Printf("Failed to get menu item %s, using defaults: %v", menuItemID, err)
+		}
+		return nil
+	}
+	return menuItem
+}
+
+// loadRoutingRules returns the station routing rules. Tickets still print when the rules
+// cannot be read; their items are left unrouted.
+func (s *KitchenOrderService) loadRoutingRules(ctx context.Context) domain.RoutingRules {
+	rules, err := s.stationRepo.ListRoutingRules(ctx)
+	if err != nil {
+		log.Printf("Failed to load routing rules, leaving items unrouted: %v", err)
+		return nil
+	}
+	return rules
+}
+
+// loadPrepTimeStats returns the preparation times learned for a menu item. Items keep the
+// menu's preparation time when the statistics cannot be read.
+func (s *KitchenOrderService) loadPrepTimeStats(ctx context.Context, menuItemID string) domain.PrepTimeStats {
+	if menuItemID == "" {
+		return nil
+	}
+	stats, err := s.prepTimeRepo.ListPrepTimeStats(ctx, menuItemID)
+	if err != nil {
+		log.Printf("Failed to load prep time stats for %s, using the menu prep time: %v", menuItemID, err)
+		return nil
+	}
+	return stats
+}
+
+// ChangeItemSeat moves the kitchen item for an order line to another seat
+func (s *KitchenOrderService) ChangeItemSeat(ctx context.Context, kitchenOrderID domain.KitchenOrderID, orderItemID string, seat int) error {
+	var item *domain.KitchenItem
+	order, err := s.modifyKitchenOrder(ctx, kitchenOrderID, func(order *domain.KitchenOrder) error {
+		var err error
+		item, err = order.SetItemSeat(orderItemID, seat)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	log.Printf("Moved %s in kitchen order %s to seat %d", item.Name, kitchenOrderID, seat)
+	s.publishOrderUpdated(ctx, order, "item_seat_changed", nil)
+
+	return nil
+}
+
+// VoidItem cancels the kitchen item for a voided order line, flagging it as waste if the line had started on it
+func (s *KitchenOrderService) VoidItem(ctx context.Context, kitchenOrderID domain.KitchenOrderID, orderItemID, reason string) error {
 	var previousStatus domain.KitchenItemStatus
-	for _, item := range order.Items {
-		if string(item.ID) == itemID {
-			previousStatus = item.Status
-			break
+	var item *domain.KitchenItem
+	order, err := s.modifyKitchenOrder(ctx, kitchenOrderID, func(order *domain.KitchenOrder) error {
+		previousStatus = domain.KitchenItemStatusNew
+		for _, item := range order.Items {
+			if item.OrderItemID == orderItemID {
+				previousStatus = item.Status
+				break
+			}
 		}
+
+		var err error
+		item, err = order.VoidItem(orderItemID, reason)
+		return err
+	})
+	if err != nil {
+		return err
 	}
 
-	// Update the item status
-	if err := order.UpdateItemStatus(domain.KitchenItemID(itemID), status); err != nil {
-		return fmt.Errorf("failed to update item status: %w", err)
+	log.Printf("Voided item %s in kitchen order %s (wasted: %t): %s", item.ID, kitchenOrderID, item.Wasted, reason)
+
+	eventData, err := events.ToEventData(events.KitchenItemStatusChangedData{
+		KitchenOrderID: string(order.ID),
+		ItemID:         string(item.ID),
+		MenuItemID:     item.MenuItemID,
+		ItemName:       item.Name,
+		OldStatus:      string(previousStatus),
+		NewStatus:      string(item.Status),
+		UpdatedBy:      "kitchen-service",
+		Wasted:         item.Wasted,
+		Station:        order.StationOf(item),
+	})
+	if err != nil {
+		log.Printf("Failed to convert event data to map: %v", err)
+		return fmt.Errorf("failed to convert event data: %w", err)
+	}
+
+	event := events.NewDomainEvent(events.KitchenItemStatusChangedEvent, string(order.ID), eventData).
+		WithMetadata("service", "kitchen-service").
+		WithMetadata("order_id", order.OrderID).
+		WithMetadata("item_id", string(item.ID))
+
+	if err := s.eventPublisher.Publish(ctx, event); err != nil {
+		log.Printf("Failed to publish kitchen item status changed event: %v", err)
+	}
+
+	return nil
+}
+
+// FireCourse releases a held course of a kitchen order to the line
+func (s *KitchenOrderService) FireCourse(ctx context.Context, kitchenOrderID domain.KitchenOrderID, course int) error {
+	order, err := s.modifyKitchenOrder(ctx, kitchenOrderID, func(order *domain.KitchenOrder) error {
+		return order.FireCourse(course)
+	})
+	if err != nil {
+		return err
 	}
 
-	// Update the repository
-	if err := s.repo.Update(ctx, order); err != nil {
-		return fmt.Errorf("failed to update kitchen order: %w", err)
+	log.Printf("Fired course %d of kitchen order: %s", course, kitchenOrderID)
+	s.publishOrderUpdated(ctx, order, "course_fired", nil)
+
+	return nil
+}
+
+// UpdateItemStatus changes the status of an item in a kitchen order
+func (s *KitchenOrderService) UpdateItemStatus(ctx context.Context, kitchenOrderID domain.KitchenOrderID, itemID string, status domain.KitchenItemStatus) error {
+	var previousStatus domain.KitchenItemStatus
+	order, err := s.modifyKitchenOrder(ctx, kitchenOrderID, func(order *domain.KitchenOrder) error {
+		// Store the previous status for events
+		for _, item := range order.Items {
+			if string(item.ID) == itemID {
+				previousStatus = item.Status
+				break
+			}
+		}
+
+		// Update the item status
+		if err := order.UpdateItemStatus(domain.KitchenItemID(itemID), status); err != nil {
+			return fmt.Errorf("failed to update item status: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
 	}
 
 	log.Printf("Updated item %s status from %s to %s in kitchen order: %s", itemID, previousStatus, status, kitchenOrderID)
 
 	// Publish KitchenItemStatusChangedEvent
-	var itemName, menuItemID string
+	var itemName, menuItemID, station string
 	for _, item := range order.Items {
 		if string(item.ID) == itemID {
 			itemName = item.Name
 			menuItemID = item.MenuItemID
+			station = order.StationOf(item)
 			break
 		}
 	}
@@ -156,6 +362,7 @@ func (s *KitchenOrderService) UpdateItemStatus(ctx context.Context, kitchenOrder
 		OldStatus:      string(previousStatus),
 		NewStatus:      string(status),
 		UpdatedBy:      "kitchen-service",
+		Station:        station,
 	})
 
 	if err != nil {
@@ -177,22 +384,18 @@ func (s *KitchenOrderService) UpdateItemStatus(ctx context.Context, kitchenOrder
 
 // UpdateOrderStatus changes the status of a kitchen order
 func (s *KitchenOrderService) UpdateOrderStatus(ctx context.Context, kitchenOrderID domain.KitchenOrderID, status domain.KitchenOrderStatus) error {
-	// Get the kitchen order
-	order, err := s.repo.FindByID(ctx, kitchenOrderID)
-	if err != nil {
-		return fmt.Errorf("failed to get kitchen order: %w", err)
-	}
-
-	previousStatus := order.Status
+	var previousStatus domain.KitchenOrderStatus
+	order, err := s.modifyKitchenOrder(ctx, kitchenOrderID, func(order *domain.KitchenOrder) error {
+		previousStatus = order.Status
 
-	// Update the order status
-	if err := order.UpdateStatus(status); err != nil {
-		return fmt.Errorf("failed to update order status: %w", err)
-	}
-
-	// Update the repository
-	if err := s.repo.Update(ctx, order); err != nil {
-		return fmt.Errorf("failed to update kitchen order: %w", err)
+		// Update the order status
+		if err := order.UpdateStatus(status); err != nil {
+			return fmt.Errorf("failed to update order status: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
 	}
 
 	log.Printf("Updated kitchen order %s status from %s to %s", kitchenOrderID, previousStatus, status)
@@ -204,6 +407,7 @@ func (s *KitchenOrderService) UpdateOrderStatus(ctx context.Context, kitchenOrde
 		OldStatus:      string(previousStatus),
 		NewStatus:      string(status),
 		UpdatedBy:      "kitchen-service",
+		Stations:       order.Stations(),
 	})
 
 	if err != nil {
@@ -224,20 +428,15 @@ func (s *KitchenOrderService) UpdateOrderStatus(ctx context.Context, kitchenOrde
 
 // AssignToStation assigns a kitchen order to a station
 func (s *KitchenOrderService) AssignToStation(ctx context.Context, kitchenOrderID domain.KitchenOrderID, stationID string) error {
-	// Get the kitchen order
-	order, err := s.repo.FindByID(ctx, kitchenOrderID)
-	if err != nil {
-		return fmt.Errorf("failed to get kitchen order: %w", err)
-	}
-
 	// Assign to station
-	if err := order.AssignToStation(stationID); err != nil {
-		return fmt.Errorf("failed to assign kitchen order to station: %w", err)
-	}
-
-	// Update the repository
-	if err := s.repo.Update(ctx, order); err != nil {
-		return fmt.Errorf("failed to update kitchen order: %w", err)
+	order, err := s.modifyKitchenOrder(ctx, kitchenOrderID, func(order *domain.KitchenOrder) error {
+		if err := order.AssignToStation(stationID); err != nil {
+			return fmt.Errorf("failed to assign kitchen order to station: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
 	}
 
 	log.Printf("Assigned kitchen order %s to station: %s", kitchenOrderID, stationID)
@@ -250,6 +449,7 @@ func (s *KitchenOrderService) AssignToStation(ctx context.Context, kitchenOrderI
 		Status:         string(order.Status),
 		Priority:       string(order.Priority),
 		EstimatedTime:  int64(order.EstimatedTime.Seconds()),
+		Stations:       order.Stations(),
 	})
 
 	if err != nil {
@@ -269,22 +469,42 @@ func (s *KitchenOrderService) AssignToStation(ctx context.Context, kitchenOrderI
 	return nil
 }
 
-// SetPriority sets the priority of a kitchen order
-func (s *KitchenOrderService) SetPriority(ctx context.Context, kitchenOrderID domain.KitchenOrderID, priority domain.KitchenPriority) error {
-	// Get the kitchen order
-	order, err := s.repo.FindByID(ctx, kitchenOrderID)
+// AssignItemToStation reroutes one item of a kitchen order to another registered station
+func (s *KitchenOrderService) AssignItemToStation(ctx context.Context, kitchenOrderID domain.KitchenOrderID, itemID string, stationID string) error {
+	if _, err := s.stationRepo.GetStation(ctx, stationID); err != nil {
+		return fmt.Errorf("failed to get station: %w", err)
+	}
+
+	var item *domain.KitchenItem
+	var previousStations []string
+	order, err := s.modifyKitchenOrder(ctx, kitchenOrderID, func(order *domain.KitchenOrder) error {
+		previousStations = order.Stations()
+		var err error
+		item, err = order.AssignItemToStation(domain.KitchenItemID(itemID), stationID)
+		return err
+	})
 	if err != nil {
-		return fmt.Errorf("failed to get kitchen order: %w", err)
+		return err
 	}
 
-	previousPriority := order.Priority
+	log.Printf("Rerouted %s in kitchen order %s to station: %s", item.Name, kitchenOrderID, stationID)
+	s.publishOrderUpdated(ctx, order, "item_rerouted", previousStations)
 
-	// Set priority
-	order.SetPriority(priority)
+	return nil
+}
+
+// SetPriority sets the priority of a kitchen order
+func (s *KitchenOrderService) SetPriority(ctx context.Context, kitchenOrderID domain.KitchenOrderID, priority domain.KitchenPriority) error {
+	var previousPriority domain.KitchenPriority
+	order, err := s.modifyKitchenOrder(ctx, kitchenOrderID, func(order *domain.KitchenOrder) error {
+		previousPriority = order.Priority
 
-	// Update the repository
-	if err := s.repo.Update(ctx, order); err != nil {
-		return fmt.Errorf("failed to update kitchen order: %w", err)
+		// Set priority
+		order.SetPriority(priority)
+		return nil
+	})
+	if err != nil {
+		return err
 	}
 
 	log.Printf("Set kitchen order %s priority from %s to %s", kitchenOrderID, previousPriority, priority)
@@ -297,6 +517,7 @@ func (s *KitchenOrderService) SetPriority(ctx context.Context, kitchenOrderID do
 		Status:         string(order.Status),
 		Priority:       string(order.Priority),
 		EstimatedTime:  int64(order.EstimatedTime.Seconds()),
+		Stations:       order.Stations(),
 	})
 
 	if err != nil {
@@ -317,22 +538,66 @@ func (s *KitchenOrderService) SetPriority(ctx context.Context, kitchenOrderID do
 	return nil
 }
 
-// CancelKitchenOrder cancels a kitchen order
-func (s *KitchenOrderService) CancelKitchenOrder(ctx context.Context, kitchenOrderID domain.KitchenOrderID) error {
-	// Get the kitchen order
-	order, err := s.repo.FindByID(ctx, kitchenOrderID)
+// TransferTable moves a kitchen order to the table its order was transferred to
+func (s *KitchenOrderService) TransferTable(ctx context.Context, kitchenOrderID domain.KitchenOrderID, tableID string) error {
+	order, err := s.modifyKitchenOrder(ctx, kitchenOrderID, func(order *domain.KitchenOrder) error {
+		return order.TransferTable(tableID)
+	})
+	if err != nil {
+		return err
+	}
+
+	log.Printf("Transferred kitchen order %s to table %s", kitchenOrderID, tableID)
+	s.publishOrderUpdated(ctx, order, "table_transferred", nil)
+	return nil
+}
+
+// MoveItems moves the kitchen items of the given order lines from one kitchen order to another.
+// The items are added to the target before they are removed from the source, and both steps
+// skip items already handled, so a redelivered move neither loses nor duplicates a ticket item.
+func (s *KitchenOrderService) MoveItems(ctx context.Context, fromID, toID domain.KitchenOrderID, orderItemIDs []string) error {
+	source, err := s.repo.FindByID(ctx, fromID)
 	if err != nil {
 		return fmt.Errorf("failed to get kitchen order: %w", err)
 	}
+	items := source.ItemsForOrderLines(orderItemIDs)
 
-	// Cancel the order
-	if err := order.Cancel(); err != nil {
-		return fmt.Errorf("failed to cancel kitchen order: %w", err)
+	if len(items) > 0 {
+		target, err := s.modifyKitchenOrder(ctx, toID, func(order *domain.KitchenOrder) error {
+			return order.ReceiveItems(items)
+		})
+		if err != nil {
+			return err
+		}
+		s.publishOrderUpdated(ctx, target, "items_received", nil)
 	}
 
-	// Update the repository
-	if err := s.repo.Update(ctx, order); err != nil {
-		return fmt.Errorf("failed to update kitchen order: %w", err)
+	var previousStations []string
+	source, err = s.modifyKitchenOrder(ctx, fromID, func(order *domain.KitchenOrder) error {
+		previousStations = order.Stations()
+		order.ReleaseItems(orderItemIDs)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	log.Printf("Moved %d items from kitchen order %s to %s", len(items), fromID, toID)
+	s.publishOrderUpdated(ctx, source, "items_moved_out", previousStations)
+	return nil
+}
+
+// CancelKitchenOrder cancels a kitchen order
+func (s *KitchenOrderService) CancelKitchenOrder(ctx context.Context, kitchenOrderID domain.KitchenOrderID) error {
+	// Cancel the order
+	order, err := s.modifyKitchenOrder(ctx, kitchenOrderID, func(order *domain.KitchenOrder) error {
+		if err := order.Cancel(); err != nil {
+			return fmt.Errorf("failed to cancel kitchen order: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
 	}
 
 	log.Printf("Cancelled kitchen order: %s", kitchenOrderID)
@@ -345,6 +610,7 @@ func (s *KitchenOrderService) CancelKitchenOrder(ctx context.Context, kitchenOrd
 		Status:         string(order.Status),
 		Priority:       string(order.Priority),
 		EstimatedTime:  int64(order.EstimatedTime.Seconds()),
+		Stations:       order.Stations(),
 	})
 
 	if err != nil {
@@ -365,20 +631,15 @@ func (s *KitchenOrderService) CancelKitchenOrder(ctx context.Context, kitchenOrd
 
 // CompleteKitchenOrder marks a kitchen order as completed
 func (s *KitchenOrderService) CompleteKitchenOrder(ctx context.Context, kitchenOrderID domain.KitchenOrderID) error {
-	// Get the kitchen order
-	order, err := s.repo.FindByID(ctx, kitchenOrderID)
-	if err != nil {
-		return fmt.Errorf("failed to get kitchen order: %w", err)
-	}
-
 	// Complete the order
-	if err := order.UpdateStatus(domain.KitchenOrderStatusCompleted); err != nil {
-		return fmt.Errorf("failed to complete kitchen order: %w", err)
-	}
-
-	// Update the repository
-	if err := s.repo.Update(ctx, order); err != nil {
-		return fmt.Errorf("failed to update kitchen order: %w", err)
+	order, err := s.modifyKitchenOrder(ctx, kitchenOrderID, func(order *domain.KitchenOrder) error {
+		if err := order.UpdateStatus(domain.KitchenOrderStatusCompleted); err != nil {
+			return fmt.Errorf("failed to complete kitchen order: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
 	}
 
 	log.Printf("Completed kitchen order: %s", kitchenOrderID)
@@ -391,6 +652,7 @@ func (s *KitchenOrderService) CompleteKitchenOrder(ctx context.Context, kitchenO
 		Status:         string(order.Status),
 		Priority:       string(order.Priority),
 		EstimatedTime:  int64(order.EstimatedTime.Seconds()),
+		Stations:       order.Stations(),
 	})
 
 	if err != nil {
@@ -409,6 +671,117 @@ func (s *KitchenOrderService) CompleteKitchenOrder(ctx context.Context, kitchenO
 	return nil
 }
 
+// BumpStation marks the items a station still has to prepare on a kitchen order as done
+func (s *KitchenOrderService) BumpStation(ctx context.Context, kitchenOrderID domain.KitchenOrderID, stationID, userID string) error {
+	return s.bump(ctx, kitchenOrderID, events.KitchenOrderBumpedEvent, func(order *domain.KitchenOrder) (*domain.BumpRecord, error) {
+		return order.BumpStation(stationID, userID)
+	})
+}
+
+// RecallStation puts the items of a station's last bump on a kitchen order back on its screen
+func (s *KitchenOrderService) RecallStation(ctx context.Context, kitchenOrderID domain.KitchenOrderID, stationID, userID string) error {
+	return s.bump(ctx, kitchenOrderID, events.KitchenOrderRecalledEvent, func(order *domain.KitchenOrder) (*domain.BumpRecord, error) {
+		return order.RecallStation(stationID, userID)
+	})
+}
+
+// BumpExpo marks a ready kitchen order as served
+func (s *KitchenOrderService) BumpExpo(ctx context.Context, kitchenOrderID domain.KitchenOrderID, userID string) error {
+	return s.bump(ctx, kitchenOrderID, events.KitchenOrderBumpedEvent, func(order *domain.KitchenOrder) (*domain.BumpRecord, error) {
+		return order.BumpExpo(userID)
+	})
+}
+
+// RecallExpo puts a recently served kitchen order back on the expo screen
+func (s *KitchenOrderService) RecallExpo(ctx context.Context, kitchenOrderID domain.KitchenOrderID, userID string) error {
+	return s.bump(ctx, kitchenOrderID, events.KitchenOrderRecalledEvent, func(order *domain.KitchenOrder) (*domain.BumpRecord, error) {
+		return order.RecallExpo(userID)
+	})
+}
+
+// GetRecentlyBumped retrieves the kitchen orders whose last bump can still be recalled: for a
+// station, active orders with a recallable station bump; without one, orders served by expo
+// within the recall window
+func (s *KitchenOrderService) GetRecentlyBumped(ctx context.Context, stationID string) ([]*domain.KitchenOrder, error) {
+	if stationID == "" {
+		orders, err := s.repo.FindServedSince(ctx, time.Now().Add(-domain.RecallWindow))
+		if err != nil {
+			return nil, fmt.Errorf("failed to get served kitchen orders: %w", err)
+		}
+		return orders, nil
+	}
+
+	orders, err := s.repo.FindActive(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get bumped kitchen orders: %w", err)
+	}
+
+	bumped := make([]*domain.KitchenOrder, 0, len(orders))
+	for _, order := range orders {
+		if order.RecallableStationBump(stationID) != nil {
+			bumped = append(bumped, order)
+		}
+	}
+	return bumped, nil
+}
+
+// bump applies a bump or recall to a kitchen order and tells kitchen screens about it
+func (s *KitchenOrderService) bump(ctx context.Context, kitchenOrderID domain.KitchenOrderID, eventType events.EventType, apply func(order *domain.KitchenOrder) (*domain.BumpRecord, error)) error {
+	var previousStatus domain.KitchenOrderStatus
+	var record *domain.BumpRecord
+	order, err := s.modifyKitchenOrder(ctx, kitchenOrderID, func(order *domain.KitchenOrder) error {
+		previousStatus = order.Status
+
+		var err error
+		record, err = apply(order)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	log.Printf("%s by %s on kitchen order %s, status %s to %s", record.Action, record.UserID, kitchenOrderID, previousStatus, order.Status)
+
+	itemIDs := make([]string, 0, len(record.ItemIDs))
+	for _, id := range record.ItemIDs {
+		itemIDs = append(itemIDs, string(id))
+	}
+
+	// A station bump or recall also concerns the station itself when it has nothing left on the ticket
+	stations := order.Stations()
+	if record.Station != "" && !slices.Contains(stations, record.Station) {
+		stations = append(stations, record.Station)
+	}
+
+	eventData, err := events.ToEventData(events.KitchenOrderBumpedData{
+		KitchenOrderID: string(order.ID),
+		OrderID:        order.OrderID,
+		Action:         string(record.Action),
+		Station:        record.Station,
+		ItemIDs:        itemIDs,
+		UserID:         record.UserID,
+		OldStatus:      string(previousStatus),
+		NewStatus:      string(order.Status),
+		Stations:       stations,
+		BumpedAt:       record.At,
+	})
+	if err != nil {
+		log.Printf("Failed to convert event data to map: %v", err)
+		return nil
+	}
+
+	event := events.NewDomainEvent(eventType, string(order.ID), eventData).
+		WithMetadata("service", "kitchen-service").
+		WithMetadata("order_id", order.OrderID).
+		WithMetadata("user_id", record.UserID)
+
+	if err := s.eventPublisher.Publish(ctx, event); err != nil {
+		log.Printf("Failed to publish %s event: %v", eventType, err)
+	}
+
+	return nil
+}
+
 // GetActiveOrders retrieves all active kitchen orders
 func (s *KitchenOrderService) GetActiveOrders(ctx context.Context) ([]*domain.KitchenOrder, error) {
 	orders, err := s.repo.FindActive(ctx)
@@ -429,14 +802,22 @@ func (s *KitchenOrderService) GetOrdersByStatus(ctx context.Context, status doma
 	return orders, nil
 }
 
-// GetOrdersByStation retrieves kitchen orders assigned to a specific station
+// GetOrdersByStation retrieves a station's queue. Items are routed individually, so each
+// active order is cut down to the fired items the station still has to prepare.
 func (s *KitchenOrderService) GetOrdersByStation(ctx context.Context, stationID string) ([]*domain.KitchenOrder, error) {
-	orders, err := s.repo.FindByStation(ctx, stationID)
+	orders, err := s.repo.FindActive(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get kitchen orders by station: %w", err)
 	}
 
-	return orders, nil
+	queue := make([]*domain.KitchenOrder, 0, len(orders))
+	for _, order := range orders {
+		if ticket := order.StationTicket(stationID); ticket != nil {
+			queue = append(queue, ticket)
+		}
+	}
+
+	return queue, nil
 }
 
 // ListKitchenOrders retrieves kitchen orders with pagination and filters
@@ -449,6 +830,86 @@ func (s *KitchenOrderService) ListKitchenOrders(ctx context.Context, offset, lim
 	return orders, totalCount, nil
 }
 
+// GetCourseMetrics aggregates course hold and fire-to-ready times for orders created in a time range
+func (s *KitchenOrderService) GetCourseMetrics(ctx context.Context, from, to time.Time) ([]*domain.CourseMetrics, error) {
+	filters := domain.KitchenOrderFilters{
+		DateFrom: &from,
+		DateTo:   &to,
+	}
+
+	orders, _, err := s.repo.List(ctx, 0, courseMetricsOrderLimit, filters)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list kitchen orders: %w", err)
+	}
+
+	return domain.AggregateCourseTimings(orders), nil
+}
+
+// publishOrderUpdated tells kitchen screens a ticket's items changed. The event names the
+// stations the ticket now uses as well as previousStations, so a station that lost its
+// last item on the ticket also hears about it.
+func (s *KitchenOrderService) publishOrderUpdated(ctx context.Context, order *domain.KitchenOrder, change string, previousStations []string) {
+	stations := order.Stations()
+	for _, station := range previousStations {
+		if !slices.Contains(stations, station) {
+			stations = append(stations, station)
+		}
+	}
+
+	eventData, err := events.ToEventData(events.KitchenOrderUpdatedData{
+		KitchenOrderID: string(order.ID),
+		OrderID:        order.OrderID,
+		TableID:        order.TableID,
+		Status:         string(order.Status),
+		Priority:       string(order.Priority),
+		EstimatedTime:  int64(order.EstimatedTime.Seconds()),
+		Stations:       stations,
+		Change:         change,
+	})
+	if err != nil {
+		log.Printf("Failed to convert event data to map: %v", err)
+		return
+	}
+
+	event := events.NewDomainEvent(events.KitchenOrderUpdatedEvent, string(order.ID), eventData).
+		WithMetadata("service", "kitchen-service").
+		WithMetadata("order_id", order.OrderID)
+
+	if err := s.eventPublisher.Publish(ctx, event); err != nil {
+		log.Printf("Failed to publish kitchen order updated event: %v", err)
+	}
+}
+
+// modifyKitchenOrder loads a kitchen order, applies change and saves it, starting over
+// from a fresh copy when another writer saved the order in between
+func (s *KitchenOrderService) modifyKitchenOrder(ctx context.Context, kitchenOrderID domain.KitchenOrderID, change func(order *domain.KitchenOrder) error) (*domain.KitchenOrder, error) {
+	var order *domain.KitchenOrder
+	err := concurrency.RetryOnConflict(ctx, func() error {
+		var err error
+		order, err = s.repo.FindByID(ctx, kitchenOrderID)
+		if err != nil {
+			return fmt.Errorf("failed to get kitchen order: %w", err)
+		}
+
+		if err := concurrency.CheckVersion(ctx, "modifyKitchenOrder", "kitchen_order", string(kitchenOrderID), order.Version); err != nil {
+			return err
+		}
+
+		if err := change(order); err != nil {
+			return err
+		}
+
+		if err := s.repo.Update(ctx, order); err != nil {
+			return fmt.Errorf("failed to update kitchen order: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return order, nil
+}
+
 // Validation helpers
 
 // ValidateKitchenOrderStatus validates a kitchen order status string
@@ -460,6 +921,8 @@ func ValidateKitchenOrderStatus(status string) (domain.KitchenOrderStatus, error
 		return domain.KitchenOrderStatusPreparing, nil
 	case string(domain.KitchenOrderStatusReady):
 		return domain.KitchenOrderStatusReady, nil
+	case string(domain.KitchenOrderStatusServed):
+		return domain.KitchenOrderStatusServed, nil
 	case string(domain.KitchenOrderStatusCompleted):
 		return domain.KitchenOrderStatusCompleted, nil
 	case string(domain.KitchenOrderStatusCancelled):
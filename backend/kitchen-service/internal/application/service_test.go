@@ -12,6 +12,8 @@ import (
 
 	"github.com/restaurant-platform/kitchen-service/internal/domain"
 	"github.com/restaurant-platform/shared/events"
+	"github.com/restaurant-platform/shared/pkg/concurrency"
+	sharedErrors "github.com/restaurant-platform/shared/pkg/errors"
 )
 
 // MockKitchenOrderRepository is a mock implementation of KitchenOrderRepository
@@ -69,6 +71,14 @@ func (m *MockKitchenOrderRepository) FindActive(ctx context.Context) ([]*domain.
 	return args.Get(0).([]*domain.KitchenOrder), args.Error(1)
 }
 
+func (m *MockKitchenOrderRepository) FindServedSince(ctx context.Context, since time.Time) ([]*domain.KitchenOrder, error) {
+	args := m.Called(ctx, since)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.KitchenOrder), args.Error(1)
+}
+
 func (m *MockKitchenOrderRepository) List(ctx context.Context, offset, limit int, filters domain.KitchenOrderFilters) ([]*domain.KitchenOrder, int, error) {
 	args := m.Called(ctx, offset, limit, filters)
 	if args.Get(0) == nil {
@@ -102,19 +112,58 @@ func (m *MockEventPublisher) Close() error {
 	return args.Error(0)
 }
 
+// MockMenuItemRepository is a mock implementation of MenuItemRepository
+type MockMenuItemRepository struct {
+	mock.Mock
+}
+
+func (m *MockMenuItemRepository) GetByID(ctx context.Context, id string) (*domain.MenuItem, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.MenuItem), args.Error(1)
+}
+
+func (m *MockMenuItemRepository) Upsert(ctx context.Context, item *domain.MenuItem) error {
+	args := m.Called(ctx, item)
+	return args.Error(0)
+}
+
+func (m *MockMenuItemRepository) Delete(ctx context.Context, id string) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockMenuItemRepository) ReplaceMenu(ctx context.Context, menuID string, items []*domain.MenuItem) error {
+	args := m.Called(ctx, menuID, items)
+	return args.Error(0)
+}
+
+func (m *MockMenuItemRepository) DeleteByMenu(ctx context.Context, menuID string) error {
+	args := m.Called(ctx, menuID)
+	return args.Error(0)
+}
+
 // KitchenOrderServiceTestSuite contains all service layer tests
 type KitchenOrderServiceTestSuite struct {
 	suite.Suite
 	service       *KitchenOrderService
 	mockRepo      *MockKitchenOrderRepository
+	mockMenuRepo  *MockMenuItemRepository
+	mockStations  *MockStationRepository
+	mockPrepTimes *MockPrepTimeStatRepository
 	mockPublisher *MockEventPublisher
 	ctx           context.Context
 }
 
 func (suite *KitchenOrderServiceTestSuite) SetupTest() {
 	suite.mockRepo = new(MockKitchenOrderRepository)
+	suite.mockMenuRepo = new(MockMenuItemRepository)
+	suite.mockStations = new(MockStationRepository)
+	suite.mockPrepTimes = new(MockPrepTimeStatRepository)
 	suite.mockPublisher = new(MockEventPublisher)
-	suite.service = NewKitchenOrderService(suite.mockRepo, suite.mockPublisher)
+	suite.service = NewKitchenOrderService(suite.mockRepo, suite.mockMenuRepo, suite.mockStations, suite.mockPrepTimes, suite.mockPublisher)
 	suite.ctx = context.Background()
 }
 
@@ -132,7 +181,7 @@ func (suite *KitchenOrderServiceTestSuite) TestCreateKitchenOrder_Success() {
 	suite.mockPublisher.On("Publish", suite.ctx, mock.AnythingOfType("*events.DomainEvent")).Return(nil)
 
 	// When
-	result, err := suite.service.CreateKitchenOrder(suite.ctx, orderID, tableID)
+	result, err := suite.service.CreateKitchenOrder(suite.ctx, orderID, tableID, nil)
 
 	// Then
 	assert := assert.New(suite.T())
@@ -153,7 +202,7 @@ func (suite *KitchenOrderServiceTestSuite) TestCreateKitchenOrder_EmptyOrderID_S
 	tableID := "table-5"
 
 	// When
-	result, err := suite.service.CreateKitchenOrder(suite.ctx, orderID, tableID)
+	result, err := suite.service.CreateKitchenOrder(suite.ctx, orderID, tableID, nil)
 
 	// Then
 	assert := assert.New(suite.T())
@@ -175,7 +224,7 @@ func (suite *KitchenOrderServiceTestSuite) TestCreateKitchenOrder_RepositoryErro
 	suite.mockRepo.On("Save", suite.ctx, mock.AnythingOfType("*domain.KitchenOrder")).Return(repoError)
 
 	// When
-	result, err := suite.service.CreateKitchenOrder(suite.ctx, orderID, tableID)
+	result, err := suite.service.CreateKitchenOrder(suite.ctx, orderID, tableID, nil)
 
 	// Then
 	assert := assert.New(suite.T())
@@ -197,7 +246,7 @@ func (suite *KitchenOrderServiceTestSuite) TestCreateKitchenOrder_EventPublishEr
 	suite.mockPublisher.On("Publish", suite.ctx, mock.AnythingOfType("*events.DomainEvent")).Return(eventError)
 
 	// When
-	result, err := suite.service.CreateKitchenOrder(suite.ctx, orderID, tableID)
+	result, err := suite.service.CreateKitchenOrder(suite.ctx, orderID, tableID, nil)
 
 	// Then - Event publishing errors should not fail the operation
 	assert := assert.New(suite.T())
@@ -209,6 +258,121 @@ func (suite *KitchenOrderServiceTestSuite) TestCreateKitchenOrder_EventPublishEr
 	suite.mockPublisher.AssertExpectations(suite.T())
 }
 
+func (suite *KitchenOrderServiceTestSuite) TestCreateKitchenOrder_WithLines_ResolvesPrepTimes() {
+	// Given
+	lines := []*domain.TicketLine{
+		{OrderItemID: "item_steak", MenuItemID: "steak-1", Name: "Ribeye", Quantity: 1, Seat: 2},
+		{OrderItemID: "item_special", MenuItemID: "special-1", Name: "Chef's Special", Quantity: 1},
+	}
+	suite.mockMenuRepo.On("GetByID", suite.ctx, "steak-1").Return(&domain.MenuItem{ID: "steak-1", PrepTime: 18 * time.Minute}, nil)
+	suite.mockMenuRepo.On("GetByID", suite.ctx, "special-1").Return(nil, sharedErrors.WrapNotFound("GetByID", "menu_item", "special-1", sharedErrors.ErrNotFound))
+	suite.mockStations.On("ListRoutingRules", suite.ctx).Return(domain.RoutingRules{}, nil)
+	suite.mockPrepTimes.On("ListPrepTimeStats", suite.ctx, mock.Anything).Return(domain.PrepTimeStats{}, nil)
+	suite.mockRepo.On("Save", suite.ctx, mock.AnythingOfType("*domain.KitchenOrder")).Return(nil)
+	suite.mockPublisher.On("Publish", suite.ctx, mock.MatchedBy(func(event *events.DomainEvent) bool {
+		return event.Type == events.KitchenOrderCreatedEvent && event.Data["estimated_time"] == float64(18*60)
+	})).Return(nil)
+
+	// When
+	result, err := suite.service.CreateKitchenOrder(suite.ctx, "order-123", "table-5", lines)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	assert.Len(result.Items, 2)
+	assert.Equal(18*time.Minute, result.Items[0].PrepTime)
+	assert.Equal(2, result.Items[0].Seat)
+	assert.Equal("item_steak", result.Items[0].OrderItemID)
+	assert.Equal(domain.DefaultPrepTime, result.Items[1].PrepTime)
+	assert.Zero(result.Items[1].Ticket)
+	assert.Equal(18*time.Minute, result.EstimatedTime)
+	suite.mockPublisher.AssertExpectations(suite.T())
+}
+
+func (suite *KitchenOrderServiceTestSuite) TestCreateKitchenOrder_WithLines_SplitsItemsAcrossStations() {
+	// Given
+	grillRule, _ := domain.NewRoutingRule(domain.StationGrill, domain.RoutingMatchCategory, "Mains")
+	fryRule, _ := domain.NewRoutingRule(domain.StationFry, domain.RoutingMatchMenuItem, "fries-1")
+	lines := []*domain.TicketLine{
+		{OrderItemID: "item_steak", MenuItemID: "steak-1", Name: "Ribeye", Quantity: 1},
+		{OrderItemID: "item_fries", MenuItemID: "fries-1", Name: "Fries", Quantity: 1},
+		{OrderItemID: "item_special", MenuItemID: "special-1", Name: "Chef's Special", Quantity: 1},
+	}
+	suite.mockMenuRepo.On("GetByID", suite.ctx, "steak-1").Return(&domain.MenuItem{ID: "steak-1", CategoryName: "Mains", PrepTime: 18 * time.Minute}, nil)
+	suite.mockMenuRepo.On("GetByID", suite.ctx, "fries-1").Return(&domain.MenuItem{ID: "fries-1", CategoryName: "Sides", PrepTime: 4 * time.Minute}, nil)
+	suite.mockMenuRepo.On("GetByID", suite.ctx, "special-1").Return(nil, sharedErrors.WrapNotFound("GetByID", "menu_item", "special-1", sharedErrors.ErrNotFound))
+	suite.mockStations.On("ListRoutingRules", suite.ctx).Return(domain.RoutingRules{grillRule, fryRule}, nil)
+	suite.mockPrepTimes.On("ListPrepTimeStats", suite.ctx, mock.Anything).Return(domain.PrepTimeStats{}, nil)
+	suite.mockRepo.On("Save", suite.ctx, mock.AnythingOfType("*domain.KitchenOrder")).Return(nil)
+	suite.mockPublisher.On("Publish", suite.ctx, mock.AnythingOfType("*events.DomainEvent")).Return(nil)
+
+	// When
+	result, err := suite.service.CreateKitchenOrder(suite.ctx, "order-123", "table-5", lines)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	assert.Equal(domain.StationGrill, result.Items[0].AssignedStation)
+	assert.Equal(domain.StationFry, result.Items[1].AssignedStation)
+	assert.Empty(result.Items[2].AssignedStation)
+	assert.Equal([]string{domain.StationGrill, domain.StationFry}, result.Stations())
+}
+
+func (suite *KitchenOrderServiceTestSuite) TestCreateKitchenOrder_RoutingRulesUnavailable_LeavesItemsUnrouted() {
+	// Given
+	lines := []*domain.TicketLine{
+		{OrderItemID: "item_steak", MenuItemID: "steak-1", Name: "Ribeye", Quantity: 1},
+	}
+	suite.mockMenuRepo.On("GetByID", suite.ctx, "steak-1").Return(&domain.MenuItem{ID: "steak-1", CategoryName: "Mains"}, nil)
+	suite.mockStations.On("ListRoutingRules", suite.ctx).Return(nil, errors.New("database error"))
+	suite.mockPrepTimes.On("ListPrepTimeStats", suite.ctx, mock.Anything).Return(domain.PrepTimeStats{}, nil)
+	suite.mockRepo.On("Save", suite.ctx, mock.AnythingOfType("*domain.KitchenOrder")).Return(nil)
+	suite.mockPublisher.On("Publish", suite.ctx, mock.AnythingOfType("*events.DomainEvent")).Return(nil)
+
+	// When
+	result, err := suite.service.CreateKitchenOrder(suite.ctx, "order-123", "table-5", lines)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	assert.Len(result.Items, 1)
+	assert.Empty(result.Items[0].AssignedStation)
+}
+
+func (suite *KitchenOrderServiceTestSuite) TestCreateKitchenOrder_WithLines_UsesLearnedPrepTimes() {
+	// Given
+	grillRule, _ := domain.NewRoutingRule(domain.StationGrill, domain.RoutingMatchCategory, "Mains")
+	lines := []*domain.TicketLine{
+		{OrderItemID: "item_steak", MenuItemID: "steak-1", Name: "Ribeye", Quantity: 1},
+		{OrderItemID: "item_fries", MenuItemID: "fries-1", Name: "Fries", Quantity: 1},
+	}
+	learned := domain.PrepTimeStats{
+		{MenuItemID: "steak-1", Station: domain.StationGrill, Hour: domain.AllHours, SampleCount: 40, Median: 22 * time.Minute},
+	}
+	suite.mockMenuRepo.On("GetByID", suite.ctx, "steak-1").Return(&domain.MenuItem{ID: "steak-1", CategoryName: "Mains", PrepTime: 18 * time.Minute}, nil)
+	suite.mockMenuRepo.On("GetByID", suite.ctx, "fries-1").Return(&domain.MenuItem{ID: "fries-1", CategoryName: "Sides", PrepTime: 4 * time.Minute}, nil)
+	suite.mockStations.On("ListRoutingRules", suite.ctx).Return(domain.RoutingRules{grillRule}, nil)
+	suite.mockPrepTimes.On("ListPrepTimeStats", suite.ctx, "steak-1").Return(learned, nil)
+	suite.mockPrepTimes.On("ListPrepTimeStats", suite.ctx, "fries-1").Return(nil, errors.New("database error"))
+	suite.mockRepo.On("Save", suite.ctx, mock.AnythingOfType("*domain.KitchenOrder")).Return(nil)
+	suite.mockPublisher.On("Publish", suite.ctx, mock.MatchedBy(func(event *events.DomainEvent) bool {
+		return event.Type == events.KitchenOrderCreatedEvent && event.Data["estimated_time"] == float64(22*60)
+	})).Return(nil)
+
+	// When
+	result, err := suite.service.CreateKitchenOrder(suite.ctx, "order-123", "table-5", lines)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	assert.Equal(22*time.Minute, result.Items[0].PrepTime)
+	assert.True(result.Items[0].LearnedPrepTime)
+	assert.Equal(4*time.Minute, result.Items[1].PrepTime)
+	assert.False(result.Items[1].LearnedPrepTime)
+	assert.Equal(22*time.Minute, result.EstimatedTime)
+	suite.mockPublisher.AssertExpectations(suite.T())
+}
+
 // Test GetKitchenOrder
 func (suite *KitchenOrderServiceTestSuite) TestGetKitchenOrder_Success() {
 	// Given
@@ -303,9 +467,10 @@ func (suite *KitchenOrderServiceTestSuite) TestAddKitchenItem_Success() {
 
 	suite.mockRepo.On("FindByID", suite.ctx, kitchenOrderID).Return(existingOrder, nil)
 	suite.mockRepo.On("Update", suite.ctx, existingOrder).Return(nil)
+	suite.mockPublisher.On("Publish", suite.ctx, mock.AnythingOfType("*events.DomainEvent")).Return(nil)
 
 	// When
-	err := suite.service.AddKitchenItem(suite.ctx, kitchenOrderID, menuItemID, name, quantity, prepTime, modifications, notes)
+	err := suite.service.AddKitchenItem(suite.ctx, kitchenOrderID, menuItemID, name, quantity, 0, prepTime, nil, modifications, notes)
 
 	// Then
 	assert := assert.New(suite.T())
@@ -317,6 +482,34 @@ func (suite *KitchenOrderServiceTestSuite) TestAddKitchenItem_Success() {
 	suite.mockRepo.AssertExpectations(suite.T())
 }
 
+func (suite *KitchenOrderServiceTestSuite) TestAddKitchenItem_WithModifiers_RendersGroupedOnTicket() {
+	// Given
+	kitchenOrderID := domain.KitchenOrderID("ko_123")
+	existingOrder, _ := domain.NewKitchenOrder("order-123", "table-5")
+	existingOrder.ID = kitchenOrderID
+	modifiers := []*domain.KitchenItemModifier{
+		{Group: "Temperature", Option: "Medium rare"},
+		{Group: "Add-ons", Option: "Bacon"},
+		{Group: "Add-ons", Option: "Fried egg"},
+	}
+
+	suite.mockRepo.On("FindByID", suite.ctx, kitchenOrderID).Return(existingOrder, nil)
+	suite.mockRepo.On("Update", suite.ctx, existingOrder).Return(nil)
+	suite.mockPublisher.On("Publish", suite.ctx, mock.AnythingOfType("*events.DomainEvent")).Return(nil)
+
+	// When
+	err := suite.service.AddKitchenItem(suite.ctx, kitchenOrderID, "burger-1", "Burger", 1, 0, 12*time.Minute, modifiers, nil, "")
+	response := ToKitchenItemResponse(existingOrder.Items[0])
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	assert.Len(existingOrder.Items[0].Modifiers, 3)
+	assert.Len(response.Modifiers, 2)
+	assert.Equal("Temperature", response.Modifiers[0].Group)
+	assert.Equal([]string{"Bacon", "Fried egg"}, response.Modifiers[1].Options)
+}
+
 func (suite *KitchenOrderServiceTestSuite) TestAddKitchenItem_OrderNotFound_ShouldFail() {
 	// Given
 	kitchenOrderID := domain.KitchenOrderID("non-existent")
@@ -325,7 +518,7 @@ func (suite *KitchenOrderServiceTestSuite) TestAddKitchenItem_OrderNotFound_Shou
 	suite.mockRepo.On("FindByID", suite.ctx, kitchenOrderID).Return(nil, repoError)
 
 	// When
-	err := suite.service.AddKitchenItem(suite.ctx, kitchenOrderID, "item-1", "Item", 1, 10*time.Minute, nil, "")
+	err := suite.service.AddKitchenItem(suite.ctx, kitchenOrderID, "item-1", "Item", 1, 0, 10*time.Minute, nil, nil, "")
 
 	// Then
 	assert := assert.New(suite.T())
@@ -480,6 +673,47 @@ func (suite *KitchenOrderServiceTestSuite) TestAssignToStation_EmptyStationID_Sh
 	suite.mockRepo.AssertNotCalled(suite.T(), "Update")
 }
 
+// Test AssignItemToStation
+func (suite *KitchenOrderServiceTestSuite) TestAssignItemToStation_Success() {
+	// Given
+	kitchenOrderID := domain.KitchenOrderID("ko_123")
+	existingOrder, _ := domain.NewKitchenOrder("order-123", "table-5")
+	existingOrder.ID = kitchenOrderID
+	item, _ := existingOrder.AddOrderLine(&domain.TicketLine{OrderItemID: "item_fish", MenuItemID: "fish-1", Name: "Fish", Quantity: 1}, 12*time.Minute)
+	item.AssignedStation = domain.StationGrill
+
+	suite.mockStations.On("GetStation", suite.ctx, domain.StationSaute).Return(&domain.Station{ID: domain.StationSaute}, nil)
+	suite.mockRepo.On("FindByID", suite.ctx, kitchenOrderID).Return(existingOrder, nil)
+	suite.mockRepo.On("Update", suite.ctx, existingOrder).Return(nil)
+	suite.mockPublisher.On("Publish", suite.ctx, mock.MatchedBy(func(event *events.DomainEvent) bool {
+		stations, _ := event.Data["stations"].([]interface{})
+		return event.Type == events.KitchenOrderUpdatedEvent && len(stations) == 2 &&
+			stations[0] == domain.StationSaute && stations[1] == domain.StationGrill
+	})).Return(nil)
+
+	// When
+	err := suite.service.AssignItemToStation(suite.ctx, kitchenOrderID, string(item.ID), domain.StationSaute)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	assert.Equal(domain.StationSaute, item.AssignedStation)
+	suite.mockRepo.AssertExpectations(suite.T())
+}
+
+func (suite *KitchenOrderServiceTestSuite) TestAssignItemToStation_UnknownStation_ShouldFail() {
+	// Given
+	kitchenOrderID := domain.KitchenOrderID("ko_123")
+	suite.mockStations.On("GetStation", suite.ctx, "wok").Return(nil, sharedErrors.WrapNotFound("GetStation", "station", "wok", sharedErrors.ErrNotFound))
+
+	// When
+	err := suite.service.AssignItemToStation(suite.ctx, kitchenOrderID, "ki_1", "wok")
+
+	// Then
+	assert.True(suite.T(), sharedErrors.IsNotFound(err))
+	suite.mockRepo.AssertNotCalled(suite.T(), "FindByID", mock.Anything, mock.Anything)
+}
+
 // Test SetPriority
 func (suite *KitchenOrderServiceTestSuite) TestSetPriority_Success() {
 	// Given
@@ -504,7 +738,101 @@ func (suite *KitchenOrderServiceTestSuite) TestSetPriority_Success() {
 	suite.mockPublisher.AssertExpectations(suite.T())
 }
 
+func (suite *KitchenOrderServiceTestSuite) TestSetPriority_VersionConflict_RetriesOnFreshCopy() {
+	// Given
+	kitchenOrderID := domain.KitchenOrderID("ko_123")
+	staleOrder, _ := domain.NewKitchenOrder("order-123", "table-5")
+	staleOrder.ID = kitchenOrderID
+	freshOrder, _ := domain.NewKitchenOrder("order-123", "table-5")
+	freshOrder.ID = kitchenOrderID
+	freshOrder.Version = 2
+	conflict := sharedErrors.WrapVersionConflict("UpdateKitchenOrder", "kitchen_order", string(kitchenOrderID), 1)
+
+	suite.mockRepo.On("FindByID", suite.ctx, kitchenOrderID).Return(staleOrder, nil).Once()
+	suite.mockRepo.On("Update", suite.ctx, staleOrder).Return(conflict).Once()
+	suite.mockRepo.On("FindByID", suite.ctx, kitchenOrderID).Return(freshOrder, nil).Once()
+	suite.mockRepo.On("Update", suite.ctx, freshOrder).Return(nil).Once()
+	suite.mockPublisher.On("Publish", suite.ctx, mock.AnythingOfType("*events.DomainEvent")).Return(nil)
+
+	// When
+	err := suite.service.SetPriority(suite.ctx, kitchenOrderID, domain.KitchenPriorityUrgent)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	assert.Equal(domain.KitchenPriorityUrgent, freshOrder.Priority)
+	suite.mockRepo.AssertExpectations(suite.T())
+}
+
+func (suite *KitchenOrderServiceTestSuite) TestSetPriority_StaleIfMatch_ShouldFailWithoutSaving() {
+	// Given
+	kitchenOrderID := domain.KitchenOrderID("ko_123")
+	existingOrder, _ := domain.NewKitchenOrder("order-123", "table-5")
+	existingOrder.ID = kitchenOrderID
+	existingOrder.Version = 3
+	ctx := concurrency.WithExpectedVersion(suite.ctx, 2)
+
+	suite.mockRepo.On("FindByID", ctx, kitchenOrderID).Return(existingOrder, nil).Once()
+
+	// When
+	err := suite.service.SetPriority(ctx, kitchenOrderID, domain.KitchenPriorityUrgent)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.True(sharedErrors.IsPreconditionFailed(err))
+	assert.Equal(domain.KitchenPriorityNormal, existingOrder.Priority)
+	suite.mockRepo.AssertNotCalled(suite.T(), "Update", mock.Anything, mock.Anything)
+}
+
 // Test CancelKitchenOrder
+func (suite *KitchenOrderServiceTestSuite) TestTransferTable_Success() {
+	// Given
+	kitchenOrderID := domain.KitchenOrderID("ko_123")
+	existingOrder, _ := domain.NewKitchenOrder("order-123", "table-5")
+	existingOrder.ID = kitchenOrderID
+
+	suite.mockRepo.On("FindByID", suite.ctx, kitchenOrderID).Return(existingOrder, nil)
+	suite.mockRepo.On("Update", suite.ctx, existingOrder).Return(nil)
+	suite.mockPublisher.On("Publish", suite.ctx, mock.AnythingOfType("*events.DomainEvent")).Return(nil)
+
+	// When
+	err := suite.service.TransferTable(suite.ctx, kitchenOrderID, "table-9")
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	assert.Equal("table-9", existingOrder.TableID)
+	suite.mockRepo.AssertExpectations(suite.T())
+}
+
+func (suite *KitchenOrderServiceTestSuite) TestMoveItems_AddsToTargetThenRemovesFromSource() {
+	// Given
+	source, _ := domain.NewKitchenOrder("order-123", "table-5")
+	source.ID = "ko_source"
+	_, _ = source.AddAmendmentItem("item_steak", 1, 0, "steak-1", "Ribeye", 1, 20*time.Minute, nil, nil, "")
+	_, _ = source.AddAmendmentItem("item_salad", 1, 0, "salad-1", "Salad", 1, 5*time.Minute, nil, nil, "")
+	target, _ := domain.NewKitchenOrder("order-456", "table-7")
+	target.ID = "ko_target"
+
+	suite.mockRepo.On("FindByID", suite.ctx, source.ID).Return(source, nil)
+	suite.mockRepo.On("FindByID", suite.ctx, target.ID).Return(target, nil)
+	suite.mockRepo.On("Update", suite.ctx, target).Return(nil).Once()
+	suite.mockRepo.On("Update", suite.ctx, source).Return(nil).Once()
+	suite.mockPublisher.On("Publish", suite.ctx, mock.AnythingOfType("*events.DomainEvent")).Return(nil)
+
+	// When
+	err := suite.service.MoveItems(suite.ctx, source.ID, target.ID, []string{"item_steak"})
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	assert.Len(target.Items, 1)
+	assert.Equal("item_steak", target.Items[0].OrderItemID)
+	assert.Len(source.Items, 1)
+	assert.Equal("item_salad", source.Items[0].OrderItemID)
+	suite.mockRepo.AssertExpectations(suite.T())
+}
+
 func (suite *KitchenOrderServiceTestSuite) TestCancelKitchenOrder_Success() {
 	// Given
 	kitchenOrderID := domain.KitchenOrderID("ko_123")
@@ -641,11 +969,14 @@ func (suite *KitchenOrderServiceTestSuite) TestGetOrdersByStatus_Success() {
 func (suite *KitchenOrderServiceTestSuite) TestGetOrdersByStation_Success() {
 	// Given
 	stationID := "grill-station-1"
-	expectedOrders := []*domain.KitchenOrder{
-		{AssignedStation: stationID, OrderID: "order-1"},
-	}
+	assigned, _ := domain.NewKitchenOrder("order-1", "table-1")
+	_ = assigned.AddItem("steak-1", "Ribeye", 1, 20*time.Minute, nil, "")
+	_ = assigned.AssignToStation(stationID)
+	elsewhere, _ := domain.NewKitchenOrder("order-2", "table-2")
+	_ = elsewhere.AddItem("salad-1", "Salad", 1, 5*time.Minute, nil, "")
+	_ = elsewhere.AssignToStation("salad-station")
 
-	suite.mockRepo.On("FindByStation", suite.ctx, stationID).Return(expectedOrders, nil)
+	suite.mockRepo.On("FindActive", suite.ctx).Return([]*domain.KitchenOrder{assigned, elsewhere}, nil)
 
 	// When
 	result, err := suite.service.GetOrdersByStation(suite.ctx, stationID)
@@ -659,6 +990,328 @@ func (suite *KitchenOrderServiceTestSuite) TestGetOrdersByStation_Success() {
 	suite.mockRepo.AssertExpectations(suite.T())
 }
 
+func (suite *KitchenOrderServiceTestSuite) TestGetOrdersByStation_ShowsOnlyTheStationsItems() {
+	// Given
+	order, _ := domain.NewKitchenOrder("order-1", "table-1")
+	steak, _ := order.AddOrderLine(&domain.TicketLine{OrderItemID: "item_steak", MenuItemID: "steak-1", Name: "Ribeye", Quantity: 1}, 20*time.Minute)
+	fries, _ := order.AddOrderLine(&domain.TicketLine{OrderItemID: "item_fries", MenuItemID: "fries-1", Name: "Fries", Quantity: 1}, 4*time.Minute)
+	steak.AssignedStation = domain.StationGrill
+	fries.AssignedStation = domain.StationFry
+
+	suite.mockRepo.On("FindActive", suite.ctx).Return([]*domain.KitchenOrder{order}, nil)
+
+	// When
+	result, err := suite.service.GetOrdersByStation(suite.ctx, domain.StationFry)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	assert.Len(result, 1)
+	assert.Len(result[0].Items, 1)
+	assert.Equal("Fries", result[0].Items[0].Name)
+	assert.Len(order.Items, 2)
+}
+
+func (suite *KitchenOrderServiceTestSuite) TestGetOrdersByStation_HidesHeldCourses() {
+	// Given
+	stationID := "grill-station-1"
+	mixed, _ := domain.NewKitchenOrder("order-1", "table-1")
+	_ = mixed.AddCourseItem(1, "salad-1", "Salad", 1, 5*time.Minute, nil, nil, "")
+	_ = mixed.AddCourseItem(2, "steak-1", "Ribeye", 1, 20*time.Minute, nil, nil, "")
+	_ = mixed.AssignToStation(stationID)
+	allHeld, _ := domain.NewKitchenOrder("order-2", "table-2")
+	_ = allHeld.AddCourseItem(2, "steak-1", "Ribeye", 1, 20*time.Minute, nil, nil, "")
+	_ = allHeld.AssignToStation(stationID)
+
+	suite.mockRepo.On("FindActive", suite.ctx).Return([]*domain.KitchenOrder{mixed, allHeld}, nil)
+
+	// When
+	result, err := suite.service.GetOrdersByStation(suite.ctx, stationID)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	assert.Len(result, 1)
+	assert.Len(result[0].Items, 1)
+	assert.Equal("Salad", result[0].Items[0].Name)
+}
+
+// Test FireCourse
+func (suite *KitchenOrderServiceTestSuite) TestFireCourse_Success() {
+	// Given
+	kitchenOrderID := domain.KitchenOrderID("ko_123")
+	existingOrder, _ := domain.NewKitchenOrder("order-123", "table-5")
+	existingOrder.ID = kitchenOrderID
+	_ = existingOrder.AddCourseItem(2, "steak-1", "Ribeye", 1, 20*time.Minute, nil, nil, "")
+
+	suite.mockRepo.On("FindByID", suite.ctx, kitchenOrderID).Return(existingOrder, nil)
+	suite.mockRepo.On("Update", suite.ctx, existingOrder).Return(nil)
+	suite.mockPublisher.On("Publish", suite.ctx, mock.AnythingOfType("*events.DomainEvent")).Return(nil)
+
+	// When
+	err := suite.service.FireCourse(suite.ctx, kitchenOrderID, 2)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	assert.Equal(domain.CourseStatusFired, existingOrder.CourseStatus(2))
+	suite.mockRepo.AssertExpectations(suite.T())
+}
+
+func (suite *KitchenOrderServiceTestSuite) TestFireCourse_UnknownCourse_ShouldFail() {
+	// Given
+	kitchenOrderID := domain.KitchenOrderID("ko_123")
+	existingOrder, _ := domain.NewKitchenOrder("order-123", "table-5")
+	existingOrder.ID = kitchenOrderID
+
+	suite.mockRepo.On("FindByID", suite.ctx, kitchenOrderID).Return(existingOrder, nil)
+
+	// When
+	err := suite.service.FireCourse(suite.ctx, kitchenOrderID, 2)
+
+	// Then
+	assert.True(suite.T(), sharedErrors.IsNotFound(err))
+	suite.mockRepo.AssertNotCalled(suite.T(), "Update", mock.Anything, mock.Anything)
+}
+
+// Test AddOrderItem
+func (suite *KitchenOrderServiceTestSuite) TestAddOrderItem_OnTheLine_AddsDeltaTicket() {
+	// Given
+	kitchenOrderID := domain.KitchenOrderID("ko_123")
+	existingOrder, _ := domain.NewKitchenOrder("order-123", "table-5")
+	existingOrder.ID = kitchenOrderID
+	_ = existingOrder.UpdateStatus(domain.KitchenOrderStatusPreparing)
+
+	pastryRule, _ := domain.NewRoutingRule(domain.StationPastry, domain.RoutingMatchCategory, "desserts")
+
+	suite.mockMenuRepo.On("GetByID", suite.ctx, "cake-1").Return(&domain.MenuItem{ID: "cake-1", CategoryName: "Desserts", PrepTime: 3 * time.Minute}, nil)
+	suite.mockStations.On("ListRoutingRules", suite.ctx).Return(domain.RoutingRules{pastryRule}, nil)
+	suite.mockPrepTimes.On("ListPrepTimeStats", suite.ctx, mock.Anything).Return(domain.PrepTimeStats{}, nil)
+	suite.mockRepo.On("FindByID", suite.ctx, kitchenOrderID).Return(existingOrder, nil)
+	suite.mockRepo.On("Update", suite.ctx, existingOrder).Return(nil)
+	suite.mockPublisher.On("Publish", suite.ctx, mock.MatchedBy(func(event *events.DomainEvent) bool {
+		return event.Type == events.KitchenOrderUpdatedEvent && event.Data["change"] == "item_added"
+	})).Return(nil)
+
+	// When
+	err := suite.service.AddOrderItem(suite.ctx, kitchenOrderID, &domain.TicketLine{
+		OrderItemID: "item_cake", MenuItemID: "cake-1", Name: "Cheesecake", Quantity: 1, Seat: 3,
+	})
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	assert.Len(existingOrder.Items, 1)
+	assert.Equal("item_cake", existingOrder.Items[0].OrderItemID)
+	assert.Equal(1, existingOrder.Items[0].Ticket)
+	assert.Equal(3, existingOrder.Items[0].Seat)
+	assert.Equal(3*time.Minute, existingOrder.Items[0].PrepTime)
+	assert.Equal(domain.StationPastry, existingOrder.Items[0].AssignedStation)
+	suite.mockRepo.AssertExpectations(suite.T())
+}
+
+func (suite *KitchenOrderServiceTestSuite) TestUpdateOrderItemQuantity_Success() {
+	// Given
+	kitchenOrderID := domain.KitchenOrderID("ko_123")
+	existingOrder, _ := domain.NewKitchenOrder("order-123", "table-5")
+	existingOrder.ID = kitchenOrderID
+	_, _ = existingOrder.AddOrderLine(&domain.TicketLine{OrderItemID: "item_fries", MenuItemID: "fries-1", Name: "Fries", Quantity: 1}, 4*time.Minute)
+
+	suite.mockRepo.On("FindByID", suite.ctx, kitchenOrderID).Return(existingOrder, nil)
+	suite.mockRepo.On("Update", suite.ctx, existingOrder).Return(nil)
+	suite.mockPublisher.On("Publish", suite.ctx, mock.AnythingOfType("*events.DomainEvent")).Return(nil)
+
+	// When
+	err := suite.service.UpdateOrderItemQuantity(suite.ctx, kitchenOrderID, "item_fries", 3)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	assert.Equal(3, existingOrder.Items[0].Quantity)
+	suite.mockRepo.AssertExpectations(suite.T())
+}
+
+// Test ChangeItemSeat
+func (suite *KitchenOrderServiceTestSuite) TestChangeItemSeat_Success() {
+	// Given
+	kitchenOrderID := domain.KitchenOrderID("ko_123")
+	existingOrder, _ := domain.NewKitchenOrder("order-123", "table-5")
+	existingOrder.ID = kitchenOrderID
+	_, _ = existingOrder.AddAmendmentItem("item_steak", 1, 1, "steak-1", "Ribeye", 1, 20*time.Minute, nil, nil, "")
+
+	suite.mockRepo.On("FindByID", suite.ctx, kitchenOrderID).Return(existingOrder, nil)
+	suite.mockRepo.On("Update", suite.ctx, existingOrder).Return(nil)
+	suite.mockPublisher.On("Publish", suite.ctx, mock.AnythingOfType("*events.DomainEvent")).Return(nil)
+
+	// When
+	err := suite.service.ChangeItemSeat(suite.ctx, kitchenOrderID, "item_steak", 2)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	assert.Equal(2, existingOrder.Items[0].Seat)
+	suite.mockRepo.AssertExpectations(suite.T())
+}
+
+// Test VoidItem
+func (suite *KitchenOrderServiceTestSuite) TestVoidItem_InPreparation_PublishesWaste() {
+	// Given
+	kitchenOrderID := domain.KitchenOrderID("ko_123")
+	existingOrder, _ := domain.NewKitchenOrder("order-123", "table-5")
+	existingOrder.ID = kitchenOrderID
+	_, _ = existingOrder.AddAmendmentItem("item_steak", 1, 0, "steak-1", "Ribeye", 1, 20*time.Minute, nil, nil, "")
+	_, _ = existingOrder.AddAmendmentItem("item_salad", 1, 0, "salad-1", "Salad", 1, 5*time.Minute, nil, nil, "")
+	_ = existingOrder.UpdateItemStatus(existingOrder.Items[0].ID, domain.KitchenItemStatusPreparing)
+
+	suite.mockRepo.On("FindByID", suite.ctx, kitchenOrderID).Return(existingOrder, nil)
+	suite.mockRepo.On("Update", suite.ctx, existingOrder).Return(nil)
+	suite.mockPublisher.On("Publish", suite.ctx, mock.MatchedBy(func(event *events.DomainEvent) bool {
+		return event.Type == events.KitchenItemStatusChangedEvent &&
+			event.Data["old_status"] == string(domain.KitchenItemStatusPreparing) &&
+			event.Data["new_status"] == string(domain.KitchenItemStatusCancelled) &&
+			event.Data["wasted"] == true
+	})).Return(nil)
+
+	// When
+	err := suite.service.VoidItem(suite.ctx, kitchenOrderID, "item_steak", "sent to wrong table")
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	assert.True(existingOrder.Items[0].Wasted)
+	suite.mockRepo.AssertExpectations(suite.T())
+	suite.mockPublisher.AssertExpectations(suite.T())
+}
+
+func (suite *KitchenOrderServiceTestSuite) TestVoidItem_UnknownItem_ShouldFail() {
+	// Given
+	kitchenOrderID := domain.KitchenOrderID("ko_123")
+	existingOrder, _ := domain.NewKitchenOrder("order-123", "table-5")
+	existingOrder.ID = kitchenOrderID
+
+	suite.mockRepo.On("FindByID", suite.ctx, kitchenOrderID).Return(existingOrder, nil)
+
+	// When
+	err := suite.service.VoidItem(suite.ctx, kitchenOrderID, "item_missing", "sent to wrong table")
+
+	// Then
+	assert.True(suite.T(), sharedErrors.IsNotFound(err))
+	suite.mockRepo.AssertNotCalled(suite.T(), "Update", mock.Anything, mock.Anything)
+	suite.mockPublisher.AssertNotCalled(suite.T(), "Publish", mock.Anything, mock.Anything)
+}
+
+// Test bump and recall
+func (suite *KitchenOrderServiceTestSuite) TestBumpStation_PublishesBumpForStation() {
+	// Given
+	kitchenOrderID := domain.KitchenOrderID("ko_123")
+	existingOrder, _ := domain.NewKitchenOrder("order-123", "table-5")
+	existingOrder.ID = kitchenOrderID
+	item, _ := existingOrder.AddOrderLine(&domain.TicketLine{OrderItemID: "item_fries", MenuItemID: "fries-1", Name: "Fries", Quantity: 1}, 4*time.Minute)
+	item.AssignedStation = domain.StationFry
+
+	suite.mockRepo.On("FindByID", suite.ctx, kitchenOrderID).Return(existingOrder, nil)
+	suite.mockRepo.On("Update", suite.ctx, existingOrder).Return(nil)
+	suite.mockPublisher.On("Publish", suite.ctx, mock.MatchedBy(func(event *events.DomainEvent) bool {
+		return event.Type == events.KitchenOrderBumpedEvent &&
+			event.Data["action"] == string(domain.BumpActionStation) &&
+			event.Data["station"] == domain.StationFry &&
+			event.Data["user_id"] == "cook-1" &&
+			event.Data["new_status"] == string(domain.KitchenOrderStatusReady)
+	})).Return(nil)
+
+	// When
+	err := suite.service.BumpStation(suite.ctx, kitchenOrderID, domain.StationFry, "cook-1")
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	assert.Equal(domain.KitchenItemStatusReady, item.Status)
+	assert.Len(existingOrder.Bumps, 1)
+	suite.mockRepo.AssertExpectations(suite.T())
+	suite.mockPublisher.AssertExpectations(suite.T())
+}
+
+func (suite *KitchenOrderServiceTestSuite) TestRecallExpo_PastRecallWindow_ShouldFail() {
+	// Given
+	kitchenOrderID := domain.KitchenOrderID("ko_123")
+	existingOrder, _ := domain.NewKitchenOrder("order-123", "table-5")
+	existingOrder.ID = kitchenOrderID
+	existingOrder.Status = domain.KitchenOrderStatusServed
+	existingOrder.ServedAt = time.Now().Add(-domain.RecallWindow - time.Minute)
+
+	suite.mockRepo.On("FindByID", suite.ctx, kitchenOrderID).Return(existingOrder, nil)
+
+	// When
+	err := suite.service.RecallExpo(suite.ctx, kitchenOrderID, "expo-1")
+
+	// Then
+	assert.True(suite.T(), sharedErrors.IsConflictError(err))
+	suite.mockRepo.AssertNotCalled(suite.T(), "Update", mock.Anything, mock.Anything)
+	suite.mockPublisher.AssertNotCalled(suite.T(), "Publish", mock.Anything, mock.Anything)
+}
+
+func (suite *KitchenOrderServiceTestSuite) TestGetRecentlyBumped_Station_ListsRecallableOrders() {
+	// Given
+	bumped, _ := domain.NewKitchenOrder("order-1", "table-1")
+	bumpedItem, _ := bumped.AddOrderLine(&domain.TicketLine{OrderItemID: "item_steak", MenuItemID: "steak-1", Name: "Steak", Quantity: 1}, 15*time.Minute)
+	bumpedItem.AssignedStation = domain.StationGrill
+	other, _ := bumped.AddOrderLine(&domain.TicketLine{OrderItemID: "item_salad", MenuItemID: "salad-1", Name: "Salad", Quantity: 1}, 5*time.Minute)
+	other.AssignedStation = domain.StationCold
+	_, _ = bumped.BumpStation(domain.StationGrill, "cook-1")
+
+	untouched, _ := domain.NewKitchenOrder("order-2", "table-2")
+	untouchedItem, _ := untouched.AddOrderLine(&domain.TicketLine{OrderItemID: "item_burger", MenuItemID: "burger-1", Name: "Burger", Quantity: 1}, 12*time.Minute)
+	untouchedItem.AssignedStation = domain.StationGrill
+
+	suite.mockRepo.On("FindActive", suite.ctx).Return([]*domain.KitchenOrder{bumped, untouched}, nil)
+
+	// When
+	orders, err := suite.service.GetRecentlyBumped(suite.ctx, domain.StationGrill)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	assert.Len(orders, 1)
+	assert.Equal("order-1", orders[0].OrderID)
+}
+
+func (suite *KitchenOrderServiceTestSuite) TestGetRecentlyBumped_Expo_ListsOrdersServedWithinWindow() {
+	// Given
+	served := []*domain.KitchenOrder{{OrderID: "order-1", Status: domain.KitchenOrderStatusServed}}
+	suite.mockRepo.On("FindServedSince", suite.ctx, mock.MatchedBy(func(since time.Time) bool {
+		return time.Since(since) >= domain.RecallWindow && time.Since(since) < domain.RecallWindow+time.Minute
+	})).Return(served, nil)
+
+	// When
+	orders, err := suite.service.GetRecentlyBumped(suite.ctx, "")
+
+	// Then
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), served, orders)
+	suite.mockRepo.AssertNotCalled(suite.T(), "FindActive", mock.Anything)
+}
+
+// Test GetCourseMetrics
+func (suite *KitchenOrderServiceTestSuite) TestGetCourseMetrics_Success() {
+	// Given
+	to := time.Now()
+	from := to.Add(-time.Hour)
+	order, _ := domain.NewKitchenOrder("order-1", "table-1")
+	_ = order.AddCourseItem(1, "salad-1", "Salad", 1, 5*time.Minute, nil, nil, "")
+
+	suite.mockRepo.On("List", suite.ctx, 0, mock.AnythingOfType("int"), domain.KitchenOrderFilters{DateFrom: &from, DateTo: &to}).
+		Return([]*domain.KitchenOrder{order}, 1, nil)
+
+	// When
+	metrics, err := suite.service.GetCourseMetrics(suite.ctx, from, to)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	assert.Len(metrics, 1)
+	assert.Equal(1, metrics[0].FiredCount)
+}
+
 // Test ListKitchenOrders
 func (suite *KitchenOrderServiceTestSuite) TestListKitchenOrders_Success() {
 	// Given
@@ -697,6 +1350,7 @@ func (suite *KitchenOrderServiceTestSuite) TestValidateKitchenOrderStatus_ValidS
 		{"NEW", domain.KitchenOrderStatusNew},
 		{"PREPARING", domain.KitchenOrderStatusPreparing},
 		{"READY", domain.KitchenOrderStatusReady},
+		{"SERVED", domain.KitchenOrderStatusServed},
 		{"COMPLETED", domain.KitchenOrderStatusCompleted},
 		{"CANCELLED", domain.KitchenOrderStatusCancelled},
 	}
@@ -828,7 +1482,7 @@ func (suite *KitchenOrderServiceTestSuite) TestAddKitchenItem_UpdateRepositoryEr
 	suite.mockRepo.On("Update", suite.ctx, existingOrder).Return(updateError)
 
 	// When
-	err := suite.service.AddKitchenItem(suite.ctx, kitchenOrderID, "item-1", "Item", 1, 10*time.Minute, nil, "")
+	err := suite.service.AddKitchenItem(suite.ctx, kitchenOrderID, "item-1", "Item", 1, 0, 10*time.Minute, nil, nil, "")
 
 	// Then
 	assert := assert.New(suite.T())
@@ -852,7 +1506,7 @@ func (suite *KitchenOrderServiceTestSuite) TestComplexWorkflow_OrderLifecycle()
 
 	// When - Simulate a complete workflow
 	// 1. Add items
-	err1 := suite.service.AddKitchenItem(suite.ctx, kitchenOrderID, "item-1", "Burger", 1, 15*time.Minute, nil, "")
+	err1 := suite.service.AddKitchenItem(suite.ctx, kitchenOrderID, "item-1", "Burger", 1, 0, 15*time.Minute, nil, nil, "")
 	// 2. Assign to station
 	err2 := suite.service.AssignToStation(suite.ctx, kitchenOrderID, "grill-station-1")
 	// 3. Set priority
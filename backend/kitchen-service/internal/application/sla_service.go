@@ -0,0 +1,121 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/restaurant-platform/kitchen-service/internal/domain"
+	"github.com/restaurant-platform/shared/events"
+)
+
+// TicketSLAService monitors active kitchen orders against their ticket times, raising the
+// priority of tickets left too long in a status so they move up the line
+type TicketSLAService struct {
+	kitchenService domain.KitchenService
+	policy         domain.TicketSLAPolicy
+	eventPublisher events.EventPublisher
+
+	mu sync.Mutex
+	// breached holds the status each ticket was last escalated in, so a breach is acted on once.
+	// It is rebuilt after a restart, escalating tickets still in breach one more time.
+	breached map[domain.KitchenOrderID]domain.KitchenOrderStatus
+}
+
+// NewTicketSLAService creates a new ticket SLA service
+func NewTicketSLAService(kitchenService domain.KitchenService, policy domain.TicketSLAPolicy, eventPublisher events.EventPublisher) *TicketSLAService {
+	return &TicketSLAService{
+		kitchenService: kitchenService,
+		policy:         policy,
+		eventPublisher: eventPublisher,
+		breached:       make(map[domain.KitchenOrderID]domain.KitchenOrderStatus),
+	}
+}
+
+// CheckTickets raises the priority of, and publishes a KitchenOrderSLABreachedEvent for, each
+// active kitchen order that has stayed in its status past the threshold, once per ticket and
+// status. It returns the number of new breaches.
+func (s *TicketSLAService) CheckTickets(ctx context.Context, now time.Time) (int, error) {
+	orders, err := s.kitchenService.GetActiveOrders(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get active kitchen orders: %w", err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	current := make(map[domain.KitchenOrderID]domain.KitchenOrderStatus)
+	newBreaches := 0
+	for _, order := range orders {
+		breach := s.policy.Evaluate(order, now)
+		if breach == nil {
+			continue
+		}
+		if status, ok := s.breached[order.ID]; ok && status == order.Status {
+			current[order.ID] = status
+			continue
+		}
+
+		if priority := order.EscalatedPriority(); priority != order.Priority {
+			if err := s.kitchenService.SetPriority(ctx, order.ID, priority); err != nil {
+				log.Printf("Failed to escalate kitchen order %s: %v", order.ID, err)
+				continue
+			}
+			breach.Priority = priority
+		}
+
+		log.Printf("Kitchen order %s breached its %s SLA of %s, now %s", order.ID, order.Status, breach.Threshold, breach.Priority)
+		s.publishBreach(ctx, breach, now)
+		current[order.ID] = order.Status
+		newBreaches++
+	}
+
+	// Tickets that have moved on or left the kitchen are forgotten
+	s.breached = current
+	return newBreaches, nil
+}
+
+// GetBreaches retrieves the active kitchen orders that are past their SLA
+func (s *TicketSLAService) GetBreaches(ctx context.Context, now time.Time) ([]*domain.TicketSLABreach, error) {
+	orders, err := s.kitchenService.GetActiveOrders(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get active kitchen orders: %w", err)
+	}
+
+	breaches := make([]*domain.TicketSLABreach, 0)
+	for _, order := range orders {
+		if breach := s.policy.Evaluate(order, now); breach != nil {
+			breaches = append(breaches, breach)
+		}
+	}
+	return breaches, nil
+}
+
+// Helper methods
+
+func (s *TicketSLAService) publishBreach(ctx context.Context, breach *domain.TicketSLABreach, now time.Time) {
+	eventData, err := events.ToEventData(events.KitchenOrderSLABreachedData{
+		KitchenOrderID:   string(breach.KitchenOrderID),
+		OrderID:          breach.OrderID,
+		TableID:          breach.TableID,
+		Status:           string(breach.Status),
+		Priority:         string(breach.Priority),
+		ThresholdSeconds: int64(breach.Threshold.Seconds()),
+		ElapsedSeconds:   int64(breach.Elapsed(now).Seconds()),
+		BreachedAt:       breach.BreachedAt,
+	})
+	if err != nil {
+		log.Printf("Failed to convert event data to map: %v", err)
+		return
+	}
+
+	event := events.NewDomainEvent(events.KitchenOrderSLABreachedEvent, string(breach.KitchenOrderID), eventData).
+		WithMetadata("service", "kitchen-service").
+		WithMetadata("order_id", breach.OrderID)
+
+	if err := s.eventPublisher.Publish(ctx, event); err != nil {
+		log.Printf("Failed to publish kitchen order SLA breached event: %v", err)
+	}
+}
@@ -0,0 +1,140 @@
+package application
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/restaurant-platform/kitchen-service/internal/domain"
+	"github.com/restaurant-platform/shared/events"
+)
+
+// TicketSLAServiceTestSuite contains kitchen order SLA monitoring tests
+type TicketSLAServiceTestSuite struct {
+	suite.Suite
+	service       *TicketSLAService
+	mockRepo      *MockKitchenOrderRepository
+	mockPublisher *MockEventPublisher
+	ctx           context.Context
+	now           time.Time
+}
+
+func (suite *TicketSLAServiceTestSuite) SetupTest() {
+	suite.mockRepo = new(MockKitchenOrderRepository)
+	suite.mockPublisher = new(MockEventPublisher)
+	kitchenService := NewKitchenOrderService(suite.mockRepo, new(MockMenuItemRepository), new(MockStationRepository), new(MockPrepTimeStatRepository), suite.mockPublisher)
+	policy, _ := domain.NewTicketSLAPolicy(map[string]time.Duration{"new": 5 * time.Minute, "preparing": 20 * time.Minute})
+	suite.service = NewTicketSLAService(kitchenService, policy, suite.mockPublisher)
+	suite.ctx = context.Background()
+	suite.now = time.Now()
+}
+
+func TestTicketSLAServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(TicketSLAServiceTestSuite))
+}
+
+// ticket returns a kitchen order with a burger on the first course, created the given time ago
+func (suite *TicketSLAServiceTestSuite) ticket(age time.Duration) *domain.KitchenOrder {
+	order, _ := domain.NewKitchenOrder("order-123", "table-4")
+	order.CreatedAt = suite.now.Add(-age)
+	_ = order.AddCourseItem(domain.DefaultCourse, "burger-1", "Burger", 1, 12*time.Minute, nil, nil, "")
+	return order
+}
+
+func (suite *TicketSLAServiceTestSuite) breachEvents() []*events.DomainEvent {
+	var published []*events.DomainEvent
+	for _, call := range suite.mockPublisher.Calls {
+		if event := call.Arguments.Get(1).(*events.DomainEvent); event.Type == events.KitchenOrderSLABreachedEvent {
+			published = append(published, event)
+		}
+	}
+	return published
+}
+
+func (suite *TicketSLAServiceTestSuite) TestCheckTickets_LateTicket_EscalatesAndPublishes() {
+	// Given
+	late := suite.ticket(10 * time.Minute)
+	onTime := suite.ticket(time.Minute)
+	suite.mockRepo.On("FindActive", suite.ctx).Return([]*domain.KitchenOrder{late, onTime}, nil)
+	suite.mockRepo.On("FindByID", suite.ctx, late.ID).Return(late, nil)
+	suite.mockRepo.On("Update", suite.ctx, late).Return(nil)
+	suite.mockPublisher.On("Publish", suite.ctx, mock.AnythingOfType("*events.DomainEvent")).Return(nil)
+
+	// When
+	breached, err := suite.service.CheckTickets(suite.ctx, suite.now)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	assert.Equal(1, breached)
+	assert.Equal(domain.KitchenPriorityHigh, late.Priority)
+	assert.Equal(domain.KitchenPriorityNormal, onTime.Priority)
+	published := suite.breachEvents()
+	assert.Len(published, 1)
+	assert.Equal(string(late.ID), published[0].AggregateID)
+	assert.Equal(string(domain.KitchenPriorityHigh), published[0].Data["priority"])
+}
+
+func (suite *TicketSLAServiceTestSuite) TestCheckTickets_SameBreach_IsEscalatedOnce() {
+	// Given
+	late := suite.ticket(10 * time.Minute)
+	suite.mockRepo.On("FindActive", suite.ctx).Return([]*domain.KitchenOrder{late}, nil)
+	suite.mockRepo.On("FindByID", suite.ctx, late.ID).Return(late, nil)
+	suite.mockRepo.On("Update", suite.ctx, late).Return(nil)
+	suite.mockPublisher.On("Publish", suite.ctx, mock.AnythingOfType("*events.DomainEvent")).Return(nil)
+	_, _ = suite.service.CheckTickets(suite.ctx, suite.now)
+
+	// When
+	breached, err := suite.service.CheckTickets(suite.ctx, suite.now.Add(time.Minute))
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	assert.Equal(0, breached)
+	assert.Equal(domain.KitchenPriorityHigh, late.Priority)
+	assert.Len(suite.breachEvents(), 1)
+	suite.mockRepo.AssertNumberOfCalls(suite.T(), "Update", 1)
+}
+
+func (suite *TicketSLAServiceTestSuite) TestCheckTickets_BreachInNextStatus_EscalatesAgain() {
+	// Given
+	late := suite.ticket(10 * time.Minute)
+	suite.mockRepo.On("FindActive", suite.ctx).Return([]*domain.KitchenOrder{late}, nil)
+	suite.mockRepo.On("FindByID", suite.ctx, late.ID).Return(late, nil)
+	suite.mockRepo.On("Update", suite.ctx, late).Return(nil)
+	suite.mockPublisher.On("Publish", suite.ctx, mock.AnythingOfType("*events.DomainEvent")).Return(nil)
+	_, _ = suite.service.CheckTickets(suite.ctx, suite.now)
+	late.Status = domain.KitchenOrderStatusPreparing
+	late.StartedAt = suite.now.Add(-25 * time.Minute)
+
+	// When
+	breached, err := suite.service.CheckTickets(suite.ctx, suite.now)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	assert.Equal(1, breached)
+	assert.Equal(domain.KitchenPriorityUrgent, late.Priority)
+	assert.Len(suite.breachEvents(), 2)
+}
+
+func (suite *TicketSLAServiceTestSuite) TestGetBreaches_ReturnsLateTicketsOnly() {
+	// Given
+	late := suite.ticket(10 * time.Minute)
+	onTime := suite.ticket(time.Minute)
+	suite.mockRepo.On("FindActive", suite.ctx).Return([]*domain.KitchenOrder{late, onTime}, nil)
+
+	// When
+	breaches, err := suite.service.GetBreaches(suite.ctx, suite.now)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	assert.Len(breaches, 1)
+	assert.Equal(late.ID, breaches[0].KitchenOrderID)
+	assert.Equal(domain.KitchenOrderStatusNew, breaches[0].Status)
+}
@@ -0,0 +1,106 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/restaurant-platform/kitchen-service/internal/domain"
+	"github.com/restaurant-platform/shared/pkg/errors"
+)
+
+// KitchenStationService implements the station registry and its routing rules
+type KitchenStationService struct {
+	stationRepo domain.StationRepository
+}
+
+// NewKitchenStationService creates a new kitchen station service
+func NewKitchenStationService(stationRepo domain.StationRepository) *KitchenStationService {
+	return &KitchenStationService{
+		stationRepo: stationRepo,
+	}
+}
+
+// ListStations retrieves every station in display order
+func (s *KitchenStationService) ListStations(ctx context.Context) ([]*domain.Station, error) {
+	stations, err := s.stationRepo.ListStations(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list stations: %w", err)
+	}
+
+	return stations, nil
+}
+
+// CreateStation adds a station to the registry
+func (s *KitchenStationService) CreateStation(ctx context.Context, id, name string, sortOrder int) (*domain.Station, error) {
+	station, err := domain.NewStation(id, name, sortOrder)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := s.stationRepo.GetStation(ctx, id); err == nil {
+		return nil, errors.WrapConflict("CreateStation", "station", "station "+id+" already exists", nil)
+	} else if !errors.IsNotFound(err) {
+		return nil, fmt.Errorf("failed to get station: %w", err)
+	}
+
+	if err := s.stationRepo.SaveStation(ctx, station); err != nil {
+		return nil, fmt.Errorf("failed to save station: %w", err)
+	}
+
+	log.Printf("Created station %s (%s)", station.ID, station.Name)
+
+	return station, nil
+}
+
+// ListRoutingRules retrieves every routing rule
+func (s *KitchenStationService) ListRoutingRules(ctx context.Context) (domain.RoutingRules, error) {
+	rules, err := s.stationRepo.ListRoutingRules(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list routing rules: %w", err)
+	}
+
+	return rules, nil
+}
+
+// AddRoutingRule routes items matching a category, menu item or modifier option to a station.
+// The rule applies to items ticketed from now on; items already on the line keep their station.
+func (s *KitchenStationService) AddRoutingRule(ctx context.Context, stationID string, match domain.RoutingMatch, value string) (*domain.RoutingRule, error) {
+	rule, err := domain.NewRoutingRule(stationID, match, value)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := s.stationRepo.GetStation(ctx, stationID); err != nil {
+		return nil, fmt.Errorf("failed to get station: %w", err)
+	}
+
+	if err := s.stationRepo.SaveRoutingRule(ctx, rule); err != nil {
+		return nil, fmt.Errorf("failed to save routing rule: %w", err)
+	}
+
+	log.Printf("Routing %s %q to station %s", rule.Match, rule.Value, rule.StationID)
+
+	return rule, nil
+}
+
+// DeleteRoutingRule removes a routing rule
+func (s *KitchenStationService) DeleteRoutingRule(ctx context.Context, id string) error {
+	if err := s.stationRepo.DeleteRoutingRule(ctx, id); err != nil {
+		return fmt.Errorf("failed to delete routing rule: %w", err)
+	}
+
+	log.Printf("Deleted routing rule %s", id)
+
+	return nil
+}
+
+// ValidateRoutingMatch validates a routing match string
+func ValidateRoutingMatch(match string) (domain.RoutingMatch, error) {
+	switch domain.RoutingMatch(match) {
+	case domain.RoutingMatchCategory, domain.RoutingMatchMenuItem, domain.RoutingMatchModifier:
+		return domain.RoutingMatch(match), nil
+	default:
+		return "", errors.WrapValidation("ValidateRoutingMatch", "match", "invalid routing match", nil)
+	}
+}
@@ -0,0 +1,144 @@
+package application
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/restaurant-platform/kitchen-service/internal/domain"
+	sharedErrors "github.com/restaurant-platform/shared/pkg/errors"
+)
+
+// MockStationRepository is a mock implementation of StationRepository
+type MockStationRepository struct {
+	mock.Mock
+}
+
+func (m *MockStationRepository) ListStations(ctx context.Context) ([]*domain.Station, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.Station), args.Error(1)
+}
+
+func (m *MockStationRepository) GetStation(ctx context.Context, id string) (*domain.Station, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Station), args.Error(1)
+}
+
+func (m *MockStationRepository) SaveStation(ctx context.Context, station *domain.Station) error {
+	args := m.Called(ctx, station)
+	return args.Error(0)
+}
+
+func (m *MockStationRepository) ListRoutingRules(ctx context.Context) (domain.RoutingRules, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(domain.RoutingRules), args.Error(1)
+}
+
+func (m *MockStationRepository) SaveRoutingRule(ctx context.Context, rule *domain.RoutingRule) error {
+	args := m.Called(ctx, rule)
+	return args.Error(0)
+}
+
+func (m *MockStationRepository) DeleteRoutingRule(ctx context.Context, id string) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+// KitchenStationServiceTestSuite contains the station registry service tests
+type KitchenStationServiceTestSuite struct {
+	suite.Suite
+	service      *KitchenStationService
+	mockStations *MockStationRepository
+	ctx          context.Context
+}
+
+func (suite *KitchenStationServiceTestSuite) SetupTest() {
+	suite.mockStations = new(MockStationRepository)
+	suite.service = NewKitchenStationService(suite.mockStations)
+	suite.ctx = context.Background()
+}
+
+func TestKitchenStationServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(KitchenStationServiceTestSuite))
+}
+
+func (suite *KitchenStationServiceTestSuite) TestCreateStation_Success() {
+	// Given
+	suite.mockStations.On("GetStation", suite.ctx, "wok").Return(nil, sharedErrors.WrapNotFound("GetStation", "station", "wok", sharedErrors.ErrNotFound))
+	suite.mockStations.On("SaveStation", suite.ctx, mock.MatchedBy(func(station *domain.Station) bool {
+		return station.ID == "wok" && station.Name == "Wok" && station.SortOrder == 7
+	})).Return(nil)
+
+	// When
+	station, err := suite.service.CreateStation(suite.ctx, "wok", "Wok", 7)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	assert.Equal("wok", station.ID)
+	suite.mockStations.AssertExpectations(suite.T())
+}
+
+func (suite *KitchenStationServiceTestSuite) TestCreateStation_Existing_ShouldFail() {
+	// Given
+	suite.mockStations.On("GetStation", suite.ctx, domain.StationGrill).Return(&domain.Station{ID: domain.StationGrill}, nil)
+
+	// When
+	_, err := suite.service.CreateStation(suite.ctx, domain.StationGrill, "Grill", 1)
+
+	// Then
+	assert.True(suite.T(), sharedErrors.IsConflictError(err))
+	suite.mockStations.AssertNotCalled(suite.T(), "SaveStation", mock.Anything, mock.Anything)
+}
+
+func (suite *KitchenStationServiceTestSuite) TestAddRoutingRule_Success() {
+	// Given
+	suite.mockStations.On("GetStation", suite.ctx, domain.StationBar).Return(&domain.Station{ID: domain.StationBar}, nil)
+	suite.mockStations.On("SaveRoutingRule", suite.ctx, mock.MatchedBy(func(rule *domain.RoutingRule) bool {
+		return rule.StationID == domain.StationBar && rule.Match == domain.RoutingMatchCategory && rule.Value == "Cocktails"
+	})).Return(nil)
+
+	// When
+	rule, err := suite.service.AddRoutingRule(suite.ctx, domain.StationBar, domain.RoutingMatchCategory, " Cocktails ")
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	assert.NotEmpty(rule.ID)
+	suite.mockStations.AssertExpectations(suite.T())
+}
+
+func (suite *KitchenStationServiceTestSuite) TestAddRoutingRule_UnknownStation_ShouldFail() {
+	// Given
+	suite.mockStations.On("GetStation", suite.ctx, "wok").Return(nil, sharedErrors.WrapNotFound("GetStation", "station", "wok", sharedErrors.ErrNotFound))
+
+	// When
+	_, err := suite.service.AddRoutingRule(suite.ctx, "wok", domain.RoutingMatchMenuItem, "noodles-1")
+
+	// Then
+	assert.True(suite.T(), sharedErrors.IsNotFound(err))
+	suite.mockStations.AssertNotCalled(suite.T(), "SaveRoutingRule", mock.Anything, mock.Anything)
+}
+
+func (suite *KitchenStationServiceTestSuite) TestValidateRoutingMatch() {
+	assert := assert.New(suite.T())
+
+	match, err := ValidateRoutingMatch("MODIFIER")
+	assert.NoError(err)
+	assert.Equal(domain.RoutingMatchModifier, match)
+
+	_, err = ValidateRoutingMatch("TABLE")
+	assert.True(sharedErrors.IsValidationError(err))
+}
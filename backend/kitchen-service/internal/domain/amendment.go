@@ -0,0 +1,117 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/restaurant-platform/shared/pkg/errors"
+)
+
+// AddAmendmentItem adds an item that was ordered after the ticket went to the kitchen.
+// Each amendment prints as its own delta ticket; a READY or SERVED order goes back to
+// PREPARING since there is new work on the line.
+func (ko *KitchenOrder) AddAmendmentItem(orderItemID string, course, seat int, menuItemID, name string, quantity int, prepTime time.Duration, modifiers []*KitchenItemModifier, mods []string, notes string) (*KitchenItem, error) {
+	if ko.Status == KitchenOrderStatusCompleted || ko.Status == KitchenOrderStatusCancelled {
+		return nil, errors.WrapConflict("AddAmendmentItem", "status", "cannot amend a completed or cancelled order", nil)
+	}
+	if orderItemID == "" {
+		return nil, errors.WrapValidation("AddAmendmentItem", "orderItemID", "order item ID is required", nil)
+	}
+	if ko.findByOrderItemID(orderItemID) != nil {
+		return nil, errors.WrapConflict("AddAmendmentItem", "orderItemID", "order item "+orderItemID+" is already on the ticket", nil)
+	}
+
+	ticket := ko.LastTicket() + 1
+	if err := ko.AddCourseItem(course, menuItemID, name, quantity, prepTime, modifiers, mods, notes); err != nil {
+		return nil, err
+	}
+
+	item := ko.Items[len(ko.Items)-1]
+	item.OrderItemID = orderItemID
+	item.Ticket = ticket
+	item.Seat = seat
+
+	if !item.IsHeld() {
+		ko.reopen()
+	}
+	return item, nil
+}
+
+// LastTicket returns the number of the most recent delta ticket, or 0 when the order
+// has only its original ticket
+func (ko *KitchenOrder) LastTicket() int {
+	last := 0
+	for _, item := range ko.Items {
+		if item.Ticket > last {
+			last = item.Ticket
+		}
+	}
+	return last
+}
+
+// VoidItem cancels the kitchen item for a voided order line. Items voided after the line
+// started on them are flagged as waste.
+func (ko *KitchenOrder) VoidItem(orderItemID, reason string) (*KitchenItem, error) {
+	if orderItemID == "" {
+		return nil, errors.WrapValidation("VoidItem", "orderItemID", "order item ID is required", nil)
+	}
+
+	item := ko.findByOrderItemID(orderItemID)
+	if item == nil {
+		return nil, errors.WrapNotFound("VoidItem", "kitchen item", orderItemID, errors.ErrNotFound)
+	}
+	if item.Status == KitchenItemStatusCancelled {
+		return nil, errors.WrapConflict("VoidItem", "status", "item has already been cancelled", nil)
+	}
+
+	item.Wasted = item.Status == KitchenItemStatusPreparing || item.Status == KitchenItemStatusReady
+	item.Status = KitchenItemStatusCancelled
+	item.VoidReason = reason
+	item.VoidedAt = time.Now()
+
+	ko.updateOrderStatus()
+	ko.recalculateEstimatedTime()
+
+	ko.UpdatedAt = time.Now()
+	return item, nil
+}
+
+// SetItemSeat moves the kitchen item for an order line to another seat so that
+// runners deliver it to the right guest
+func (ko *KitchenOrder) SetItemSeat(orderItemID string, seat int) (*KitchenItem, error) {
+	if seat < 0 {
+		return nil, errors.WrapValidation("SetItemSeat", "seat", "seat cannot be negative", nil)
+	}
+
+	item := ko.findByOrderItemID(orderItemID)
+	if item == nil {
+		return nil, errors.WrapNotFound("SetItemSeat", "kitchen item", orderItemID, errors.ErrNotFound)
+	}
+	if item.Status == KitchenItemStatusCancelled {
+		return nil, errors.WrapConflict("SetItemSeat", "status", "item has been cancelled", nil)
+	}
+
+	item.Seat = seat
+	ko.UpdatedAt = time.Now()
+	return item, nil
+}
+
+// WastedItems returns the items that were voided after preparation had started
+func (ko *KitchenOrder) WastedItems() []*KitchenItem {
+	var wasted []*KitchenItem
+	for _, item := range ko.Items {
+		if item.Wasted {
+			wasted = append(wasted, item)
+		}
+	}
+	return wasted
+}
+
+// findByOrderItemID returns the kitchen item for an order line, or nil
+func (ko *KitchenOrder) findByOrderItemID(orderItemID string) *KitchenItem {
+	for _, item := range ko.Items {
+		if item.OrderItemID == orderItemID {
+			return item
+		}
+	}
+	return nil
+}
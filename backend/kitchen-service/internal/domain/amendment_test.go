@@ -0,0 +1,146 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/restaurant-platform/shared/pkg/errors"
+)
+
+// AmendmentTestSuite contains delta ticket and void tests
+type AmendmentTestSuite struct {
+	suite.Suite
+	order *KitchenOrder
+}
+
+func TestAmendmentTestSuite(t *testing.T) {
+	suite.Run(t, new(AmendmentTestSuite))
+}
+
+func (suite *AmendmentTestSuite) SetupTest() {
+	suite.order, _ = NewKitchenOrder("order-123", "table-4")
+	_, _ = suite.order.AddAmendmentItem("item_burger", 1, 0, "burger-1", "Burger", 1, 12*time.Minute, nil, nil, "")
+	_ = suite.order.UpdateStatus(KitchenOrderStatusPreparing)
+}
+
+func (suite *AmendmentTestSuite) TestAddAmendmentItem_NumbersDeltaTickets() {
+	// When
+	item, err := suite.order.AddAmendmentItem("item_cake", 1, 0, "cake-1", "Cheesecake", 1, 3*time.Minute, nil, nil, "")
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	assert.Equal("item_cake", item.OrderItemID)
+	assert.Equal(2, item.Ticket)
+	assert.Equal(2, suite.order.LastTicket())
+	assert.False(item.IsHeld())
+}
+
+func (suite *AmendmentTestSuite) TestAddAmendmentItem_ReadyOrderGoesBackToPreparing() {
+	// Given
+	itemID := suite.order.Items[0].ID
+	_ = suite.order.UpdateItemStatus(itemID, KitchenItemStatusPreparing)
+	_ = suite.order.UpdateItemStatus(itemID, KitchenItemStatusReady)
+	suite.Require().Equal(KitchenOrderStatusReady, suite.order.Status)
+
+	// When
+	_, err := suite.order.AddAmendmentItem("item_fries", 1, 0, "fries-1", "Fries", 1, 4*time.Minute, nil, nil, "")
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	assert.Equal(KitchenOrderStatusPreparing, suite.order.Status)
+	assert.Equal(4*time.Minute, suite.order.EstimatedTime)
+}
+
+func (suite *AmendmentTestSuite) TestAddAmendmentItem_DuplicateOrderItem_ShouldFail() {
+	// When
+	_, err := suite.order.AddAmendmentItem("item_burger", 1, 0, "burger-1", "Burger", 1, 12*time.Minute, nil, nil, "")
+
+	// Then
+	assert.True(suite.T(), errors.IsConflictError(err))
+	assert.Len(suite.T(), suite.order.Items, 1)
+}
+
+func (suite *AmendmentTestSuite) TestAddAmendmentItem_CompletedOrder_ShouldFail() {
+	// Given
+	suite.order.Status = KitchenOrderStatusCompleted
+
+	// When
+	_, err := suite.order.AddAmendmentItem("item_cake", 1, 0, "cake-1", "Cheesecake", 1, 3*time.Minute, nil, nil, "")
+
+	// Then
+	assert.True(suite.T(), errors.IsConflictError(err))
+}
+
+func (suite *AmendmentTestSuite) TestVoidItem_NotStarted_IsNotWaste() {
+	// When
+	item, err := suite.order.VoidItem("item_burger", "guest changed mind")
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	assert.Equal(KitchenItemStatusCancelled, item.Status)
+	assert.False(item.Wasted)
+	assert.Equal("guest changed mind", item.VoidReason)
+	assert.Empty(suite.order.WastedItems())
+}
+
+func (suite *AmendmentTestSuite) TestVoidItem_InPreparation_FlagsWaste() {
+	// Given
+	_, _ = suite.order.AddAmendmentItem("item_soup", 1, 0, "soup-1", "Soup", 1, 6*time.Minute, nil, nil, "")
+	_ = suite.order.UpdateItemStatus(suite.order.Items[0].ID, KitchenItemStatusPreparing)
+
+	// When
+	item, err := suite.order.VoidItem("item_burger", "sent back")
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	assert.True(item.Wasted)
+	assert.Len(suite.order.WastedItems(), 1)
+	assert.Equal(6*time.Minute, suite.order.EstimatedTime)
+}
+
+func (suite *AmendmentTestSuite) TestVoidItem_AlreadyCancelled_ShouldFail() {
+	// Given
+	_, _ = suite.order.VoidItem("item_burger", "guest changed mind")
+
+	// When
+	_, err := suite.order.VoidItem("item_burger", "guest changed mind")
+
+	// Then
+	assert.True(suite.T(), errors.IsConflictError(err))
+}
+
+func (suite *AmendmentTestSuite) TestVoidItem_UnknownItem_ShouldFail() {
+	// When
+	_, err := suite.order.VoidItem("item_missing", "guest changed mind")
+
+	// Then
+	assert.True(suite.T(), errors.IsNotFound(err))
+}
+
+func (suite *AmendmentTestSuite) TestSetItemSeat_Success() {
+	// When
+	item, err := suite.order.SetItemSeat("item_burger", 2)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	assert.Equal(2, item.Seat)
+}
+
+func (suite *AmendmentTestSuite) TestSetItemSeat_CancelledItem_ShouldFail() {
+	// Given
+	_, _ = suite.order.VoidItem("item_burger", "guest changed mind")
+
+	// When
+	_, err := suite.order.SetItemSeat("item_burger", 2)
+
+	// Then
+	assert.True(suite.T(), errors.IsConflictError(err))
+}
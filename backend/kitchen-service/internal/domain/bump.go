@@ -0,0 +1,181 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/restaurant-platform/shared/pkg/errors"
+)
+
+// RecallWindow is how long after a bump the bump can still be recalled
+const RecallWindow = 10 * time.Minute
+
+// BumpAction is a bump or recall recorded on a kitchen ticket
+type BumpAction string
+
+const (
+	// BumpActionStation marks a station's items on the ticket done
+	BumpActionStation BumpAction = "STATION_BUMP"
+	// BumpActionStationRecall puts a station's last bumped items back on its screen
+	BumpActionStationRecall BumpAction = "STATION_RECALL"
+	// BumpActionExpo marks the whole ticket served
+	BumpActionExpo BumpAction = "EXPO_BUMP"
+	// BumpActionExpoRecall puts a served ticket back on the expo screen
+	BumpActionExpoRecall BumpAction = "EXPO_RECALL"
+)
+
+// BumpRecord is one entry in a ticket's bump history
+type BumpRecord struct {
+	Action  BumpAction      `json:"action"`
+	Station string          `json:"station,omitempty"`
+	ItemIDs []KitchenItemID `json:"item_ids,omitempty"`
+	UserID  string          `json:"user_id"`
+	At      time.Time       `json:"at"`
+}
+
+// BumpStation marks every item a station still has to prepare on the ticket as ready.
+// The order becomes READY once no other station has work left on it.
+func (ko *KitchenOrder) BumpStation(stationID, userID string) (*BumpRecord, error) {
+	if err := ko.checkBumpable("BumpStation", stationID, userID); err != nil {
+		return nil, err
+	}
+
+	ticket := ko.StationTicket(stationID)
+	if ticket == nil {
+		return nil, errors.WrapConflict("BumpStation", "station", "station "+stationID+" has nothing to bump on this ticket", nil)
+	}
+
+	now := time.Now()
+	record := &BumpRecord{Action: BumpActionStation, Station: stationID, UserID: userID, At: now}
+	for _, item := range ticket.Items {
+		if item.StartedAt.IsZero() {
+			item.StartedAt = now
+		}
+		item.CompletedAt = now
+		item.Status = KitchenItemStatusReady
+		record.ItemIDs = append(record.ItemIDs, item.ID)
+	}
+	if ko.StartedAt.IsZero() {
+		ko.StartedAt = now
+	}
+
+	ko.updateOrderStatus()
+	ko.recalculateEstimatedTime()
+	ko.Bumps = append(ko.Bumps, record)
+	ko.UpdatedAt = now
+	return record, nil
+}
+
+// RecallStation puts the items of a station's last bump back on its screen. Only a bump
+// made within the recall window can be recalled, and only until the ticket is served.
+func (ko *KitchenOrder) RecallStation(stationID, userID string) (*BumpRecord, error) {
+	if err := ko.checkBumpable("RecallStation", stationID, userID); err != nil {
+		return nil, err
+	}
+
+	bump := ko.RecallableStationBump(stationID)
+	if bump == nil {
+		return nil, errors.WrapConflict("RecallStation", "station", "station "+stationID+" has no bump to recall within the recall window", nil)
+	}
+
+	now := time.Now()
+	record := &BumpRecord{Action: BumpActionStationRecall, Station: stationID, UserID: userID, At: now}
+	for _, id := range bump.ItemIDs {
+		for _, item := range ko.Items {
+			if item.ID == id && item.Status == KitchenItemStatusReady {
+				item.Status = KitchenItemStatusPreparing
+				item.CompletedAt = time.Time{}
+				record.ItemIDs = append(record.ItemIDs, item.ID)
+			}
+		}
+	}
+
+	ko.updateOrderStatus()
+	ko.recalculateEstimatedTime()
+	ko.Bumps = append(ko.Bumps, record)
+	ko.UpdatedAt = now
+	return record, nil
+}
+
+// BumpExpo marks a READY ticket as served, taking it off the kitchen screens
+func (ko *KitchenOrder) BumpExpo(userID string) (*BumpRecord, error) {
+	if userID == "" {
+		return nil, errors.WrapValidation("BumpExpo", "userID", "user ID is required", nil)
+	}
+	if ko.Status != KitchenOrderStatusReady {
+		return nil, errors.WrapConflict("BumpExpo", "status", "only a ready ticket can be bumped by expo", nil)
+	}
+
+	if err := ko.UpdateStatus(KitchenOrderStatusServed); err != nil {
+		return nil, err
+	}
+
+	record := &BumpRecord{Action: BumpActionExpo, UserID: userID, At: ko.ServedAt}
+	ko.Bumps = append(ko.Bumps, record)
+	return record, nil
+}
+
+// RecallExpo puts a ticket served within the recall window back on the expo screen
+func (ko *KitchenOrder) RecallExpo(userID string) (*BumpRecord, error) {
+	if userID == "" {
+		return nil, errors.WrapValidation("RecallExpo", "userID", "user ID is required", nil)
+	}
+	if ko.Status != KitchenOrderStatusServed {
+		return nil, errors.WrapConflict("RecallExpo", "status", "only a served ticket can be recalled", nil)
+	}
+
+	if err := ko.UpdateStatus(KitchenOrderStatusReady); err != nil {
+		return nil, err
+	}
+
+	record := &BumpRecord{Action: BumpActionExpoRecall, UserID: userID, At: ko.UpdatedAt}
+	ko.Bumps = append(ko.Bumps, record)
+	return record, nil
+}
+
+// RecallableStationBump returns a station's last bump on the ticket if it can still be
+// recalled: it was made within the recall window, has not been recalled already and the
+// ticket has not been served
+func (ko *KitchenOrder) RecallableStationBump(stationID string) *BumpRecord {
+	if ko.Status == KitchenOrderStatusServed || ko.Status == KitchenOrderStatusCompleted || ko.Status == KitchenOrderStatusCancelled {
+		return nil
+	}
+
+	for i := len(ko.Bumps) - 1; i >= 0; i-- {
+		bump := ko.Bumps[i]
+		if bump.Station != stationID {
+			continue
+		}
+		if bump.Action != BumpActionStation || time.Since(bump.At) > RecallWindow {
+			return nil
+		}
+		return bump
+	}
+	return nil
+}
+
+// CanRecallExpo reports whether the ticket was served within the recall window
+func (ko *KitchenOrder) CanRecallExpo() bool {
+	return ko.Status == KitchenOrderStatusServed && time.Since(ko.ServedAt) <= RecallWindow
+}
+
+// reopen puts a ticket that has been passed or served back on the line when new work
+// arrives on it
+func (ko *KitchenOrder) reopen() {
+	if ko.Status == KitchenOrderStatusReady || ko.Status == KitchenOrderStatusServed {
+		ko.Status = KitchenOrderStatusPreparing
+		ko.ServedAt = time.Time{}
+	}
+}
+
+func (ko *KitchenOrder) checkBumpable(op, stationID, userID string) error {
+	if stationID == "" {
+		return errors.WrapValidation(op, "stationID", "station ID is required", nil)
+	}
+	if userID == "" {
+		return errors.WrapValidation(op, "userID", "user ID is required", nil)
+	}
+	if ko.Status == KitchenOrderStatusServed || ko.Status == KitchenOrderStatusCompleted || ko.Status == KitchenOrderStatusCancelled {
+		return errors.WrapConflict(op, "status", "cannot bump a served, completed or cancelled ticket", nil)
+	}
+	return nil
+}
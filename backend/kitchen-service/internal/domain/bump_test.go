@@ -0,0 +1,227 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/restaurant-platform/shared/pkg/errors"
+)
+
+// BumpTestSuite contains tests for the station and expo bump/recall workflow
+type BumpTestSuite struct {
+	suite.Suite
+	order  *KitchenOrder
+	burger *KitchenItem
+	fries  *KitchenItem
+}
+
+func TestBumpTestSuite(t *testing.T) {
+	suite.Run(t, new(BumpTestSuite))
+}
+
+func (suite *BumpTestSuite) SetupTest() {
+	suite.order, _ = NewKitchenOrder("order-123", "table-4")
+	suite.burger, _ = suite.order.AddOrderLine(&TicketLine{OrderItemID: "item_burger", MenuItemID: "burger-1", Name: "Burger", Quantity: 1}, 12*time.Minute)
+	suite.fries, _ = suite.order.AddOrderLine(&TicketLine{OrderItemID: "item_fries", MenuItemID: "fries-1", Name: "Fries", Quantity: 1}, 4*time.Minute)
+	suite.burger.AssignedStation = StationGrill
+	suite.fries.AssignedStation = StationFry
+}
+
+// bumpBothStations bumps every station so the ticket is READY
+func (suite *BumpTestSuite) bumpBothStations() {
+	_, err := suite.order.BumpStation(StationGrill, "cook-1")
+	suite.Require().NoError(err)
+	_, err = suite.order.BumpStation(StationFry, "cook-2")
+	suite.Require().NoError(err)
+}
+
+func (suite *BumpTestSuite) TestBumpStation_MarksOnlyThatStationsItemsReady() {
+	// When
+	record, err := suite.order.BumpStation(StationFry, "cook-1")
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	assert.Equal(BumpActionStation, record.Action)
+	assert.Equal([]KitchenItemID{suite.fries.ID}, record.ItemIDs)
+	assert.Equal("cook-1", record.UserID)
+	assert.Equal(KitchenItemStatusReady, suite.fries.Status)
+	assert.False(suite.fries.CompletedAt.IsZero())
+	assert.Equal(KitchenItemStatusNew, suite.burger.Status)
+	assert.NotEqual(KitchenOrderStatusReady, suite.order.Status)
+	assert.Len(suite.order.Bumps, 1)
+}
+
+func (suite *BumpTestSuite) TestBumpStation_LastStationMakesTicketReady() {
+	// When
+	suite.bumpBothStations()
+
+	// Then
+	assert.Equal(suite.T(), KitchenOrderStatusReady, suite.order.Status)
+	assert.Len(suite.T(), suite.order.Bumps, 2)
+}
+
+func (suite *BumpTestSuite) TestBumpStation_NothingToBump_ShouldFail() {
+	// When
+	_, err := suite.order.BumpStation(StationBar, "cook-1")
+
+	// Then
+	assert.True(suite.T(), errors.IsConflictError(err))
+	assert.Empty(suite.T(), suite.order.Bumps)
+}
+
+func (suite *BumpTestSuite) TestBumpStation_MissingUser_ShouldFail() {
+	// When
+	_, err := suite.order.BumpStation(StationGrill, "")
+
+	// Then
+	assert.True(suite.T(), errors.IsValidationError(err))
+}
+
+func (suite *BumpTestSuite) TestRecallStation_PutsBumpedItemsBackOnTheLine() {
+	// Given
+	suite.bumpBothStations()
+
+	// When
+	record, err := suite.order.RecallStation(StationGrill, "cook-1")
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	assert.Equal(BumpActionStationRecall, record.Action)
+	assert.Equal([]KitchenItemID{suite.burger.ID}, record.ItemIDs)
+	assert.Equal(KitchenItemStatusPreparing, suite.burger.Status)
+	assert.True(suite.burger.CompletedAt.IsZero())
+	assert.Equal(KitchenItemStatusReady, suite.fries.Status)
+	assert.Equal(KitchenOrderStatusPreparing, suite.order.Status)
+	assert.NotNil(suite.order.StationTicket(StationGrill))
+}
+
+func (suite *BumpTestSuite) TestRecallStation_OnlyOncePerBump() {
+	// Given
+	_, _ = suite.order.BumpStation(StationGrill, "cook-1")
+	_, _ = suite.order.RecallStation(StationGrill, "cook-1")
+
+	// When
+	_, err := suite.order.RecallStation(StationGrill, "cook-1")
+
+	// Then
+	assert.True(suite.T(), errors.IsConflictError(err))
+}
+
+func (suite *BumpTestSuite) TestRecallStation_PastRecallWindow_ShouldFail() {
+	// Given
+	record, _ := suite.order.BumpStation(StationGrill, "cook-1")
+	record.At = time.Now().Add(-RecallWindow - time.Minute)
+
+	// When
+	_, err := suite.order.RecallStation(StationGrill, "cook-1")
+
+	// Then
+	assert.True(suite.T(), errors.IsConflictError(err))
+	assert.Equal(suite.T(), KitchenItemStatusReady, suite.burger.Status)
+}
+
+func (suite *BumpTestSuite) TestBumpExpo_ServesReadyTicket() {
+	// Given
+	suite.bumpBothStations()
+
+	// When
+	record, err := suite.order.BumpExpo("expo-1")
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	assert.Equal(BumpActionExpo, record.Action)
+	assert.Equal(KitchenOrderStatusServed, suite.order.Status)
+	assert.True(suite.order.IsServed())
+	assert.False(suite.order.ServedAt.IsZero())
+	assert.Equal(time.Duration(0), suite.order.TimeRemaining())
+}
+
+func (suite *BumpTestSuite) TestBumpExpo_TicketNotReady_ShouldFail() {
+	// Given
+	_, _ = suite.order.BumpStation(StationGrill, "cook-1")
+
+	// When
+	_, err := suite.order.BumpExpo("expo-1")
+
+	// Then
+	assert.True(suite.T(), errors.IsConflictError(err))
+}
+
+func (suite *BumpTestSuite) TestServedTicket_StationRecallAndCancel_ShouldFail() {
+	// Given
+	suite.bumpBothStations()
+	_, _ = suite.order.BumpExpo("expo-1")
+
+	// When
+	_, stationErr := suite.order.RecallStation(StationGrill, "cook-1")
+	cancelErr := suite.order.Cancel()
+
+	// Then
+	assert.True(suite.T(), errors.IsConflictError(stationErr))
+	assert.True(suite.T(), errors.IsConflictError(cancelErr))
+}
+
+func (suite *BumpTestSuite) TestRecallExpo_WithinWindow_ReturnsTicketToReady() {
+	// Given
+	suite.bumpBothStations()
+	_, _ = suite.order.BumpExpo("expo-1")
+
+	// When
+	record, err := suite.order.RecallExpo("expo-2")
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	assert.Equal(BumpActionExpoRecall, record.Action)
+	assert.Equal("expo-2", record.UserID)
+	assert.Equal(KitchenOrderStatusReady, suite.order.Status)
+	assert.True(suite.order.ServedAt.IsZero())
+	assert.Len(suite.order.Bumps, 4)
+}
+
+func (suite *BumpTestSuite) TestRecallExpo_PastRecallWindow_ShouldFail() {
+	// Given
+	suite.bumpBothStations()
+	_, _ = suite.order.BumpExpo("expo-1")
+	suite.order.ServedAt = time.Now().Add(-RecallWindow - time.Minute)
+
+	// When
+	_, err := suite.order.RecallExpo("expo-1")
+
+	// Then
+	assert.True(suite.T(), errors.IsConflictError(err))
+	assert.Equal(suite.T(), KitchenOrderStatusServed, suite.order.Status)
+}
+
+func (suite *BumpTestSuite) TestUpdateStatus_ServedTicketCanBeCompleted() {
+	// Given
+	suite.bumpBothStations()
+	_, _ = suite.order.BumpExpo("expo-1")
+
+	// When
+	err := suite.order.UpdateStatus(KitchenOrderStatusCompleted)
+
+	// Then
+	assert.NoError(suite.T(), err)
+	assert.True(suite.T(), suite.order.IsComplete())
+}
+
+func (suite *BumpTestSuite) TestAddAmendmentItem_ReopensServedTicket() {
+	// Given
+	suite.bumpBothStations()
+	_, _ = suite.order.BumpExpo("expo-1")
+
+	// When
+	_, err := suite.order.AddAmendmentItem("item_shake", 0, 0, "shake-1", "Shake", 1, 3*time.Minute, nil, nil, "")
+
+	// Then
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), KitchenOrderStatusPreparing, suite.order.Status)
+	assert.True(suite.T(), suite.order.ServedAt.IsZero())
+}
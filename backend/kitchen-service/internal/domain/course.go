@@ -0,0 +1,225 @@
+package domain
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/restaurant-platform/shared/pkg/errors"
+)
+
+// DefaultCourse is the course that goes to the line as soon as the ticket arrives.
+// Later courses are held until the server fires them.
+const DefaultCourse = 1
+
+// CourseStatus represents whether a course has been released to the line
+type CourseStatus string
+
+const (
+	CourseStatusHeld  CourseStatus = "HELD"
+	CourseStatusFired CourseStatus = "FIRED"
+)
+
+// CourseTiming summarises how long a course was held and how long the line took once it was fired
+type CourseTiming struct {
+	Course      int           `json:"course"`
+	Status      CourseStatus  `json:"status"`
+	ItemCount   int           `json:"item_count"`
+	FiredAt     time.Time     `json:"fired_at,omitempty"`
+	ReadyAt     time.Time     `json:"ready_at,omitempty"`
+	HeldFor     time.Duration `json:"held_for"`
+	FireToReady time.Duration `json:"fire_to_ready"`
+}
+
+// IsHeld reports whether the item's course has not been fired yet
+func (ki *KitchenItem) IsHeld() bool {
+	return ki.FiredAt.IsZero()
+}
+
+// AddCourseItem adds an item on a given course to the kitchen order. Items on the first
+// course, or on a course that has already been fired, go straight to the line.
+func (ko *KitchenOrder) AddCourseItem(course int, menuItemID, name string, quantity int, prepTime time.Duration, modifiers []*KitchenItemModifier, mods []string, notes string) error {
+	if course == 0 {
+		course = DefaultCourse
+	}
+	if course < DefaultCourse {
+		return errors.WrapValidation("AddCourseItem", "course", "course must be positive", nil)
+	}
+
+	fire := course == DefaultCourse || ko.CourseStatus(course) == CourseStatusFired
+
+	item, err := ko.addItem(menuItemID, name, quantity, prepTime, modifiers, mods, notes)
+	if err != nil {
+		return err
+	}
+
+	item.Course = course
+	if fire {
+		item.FiredAt = time.Now()
+	}
+
+	ko.recalculateEstimatedTime()
+	return nil
+}
+
+// CourseStatus reports whether a course has been fired
+func (ko *KitchenOrder) CourseStatus(course int) CourseStatus {
+	for _, item := range ko.Items {
+		if item.Course == course && !item.IsHeld() {
+			return CourseStatusFired
+		}
+	}
+	return CourseStatusHeld
+}
+
+// FireCourse releases the held items of a course to the line
+func (ko *KitchenOrder) FireCourse(course int) error {
+	if ko.Status == KitchenOrderStatusCompleted || ko.Status == KitchenOrderStatusCancelled {
+		return errors.WrapConflict("FireCourse", "status", "cannot fire a course on a completed or cancelled order", nil)
+	}
+
+	var held []*KitchenItem
+	found := false
+	for _, item := range ko.Items {
+		if item.Course != course {
+			continue
+		}
+		found = true
+		if item.IsHeld() {
+			held = append(held, item)
+		}
+	}
+
+	if !found {
+		return errors.WrapNotFound("FireCourse", "course", fmt.Sprintf("%d", course), errors.ErrNotFound)
+	}
+	if len(held) == 0 {
+		return errors.WrapConflict("FireCourse", "course", fmt.Sprintf("course %d has already been fired", course), nil)
+	}
+
+	now := time.Now()
+	for _, item := range held {
+		item.FiredAt = now
+	}
+
+	ko.recalculateEstimatedTime()
+	ko.UpdatedAt = now
+	return nil
+}
+
+// FiredItemsOnly returns a copy of the order containing only items on fired courses,
+// or nil when every item is still held. Station queues use it to hide held courses.
+func (ko *KitchenOrder) FiredItemsOnly() *KitchenOrder {
+	fired := make([]*KitchenItem, 0, len(ko.Items))
+	for _, item := range ko.Items {
+		if !item.IsHeld() {
+			fired = append(fired, item)
+		}
+	}
+	if len(fired) == 0 && len(ko.Items) > 0 {
+		return nil
+	}
+
+	view := *ko
+	view.Items = fired
+	return &view
+}
+
+// CourseTimings returns the hold and fire-to-ready timing of each course, ordered by course
+func (ko *KitchenOrder) CourseTimings() []*CourseTiming {
+	byCourse := make(map[int]*CourseTiming)
+	allReady := make(map[int]bool)
+	for _, item := range ko.Items {
+		if item.Status == KitchenItemStatusCancelled {
+			continue
+		}
+
+		timing, ok := byCourse[item.Course]
+		if !ok {
+			timing = &CourseTiming{Course: item.Course, Status: CourseStatusHeld}
+			byCourse[item.Course] = timing
+			allReady[item.Course] = true
+		}
+		timing.ItemCount++
+
+		if !item.IsHeld() {
+			timing.Status = CourseStatusFired
+			if timing.FiredAt.IsZero() || item.FiredAt.Before(timing.FiredAt) {
+				timing.FiredAt = item.FiredAt
+			}
+		}
+		if item.Status == KitchenItemStatusReady {
+			if item.CompletedAt.After(timing.ReadyAt) {
+				timing.ReadyAt = item.CompletedAt
+			}
+		} else {
+			allReady[item.Course] = false
+		}
+	}
+
+	timings := make([]*CourseTiming, 0, len(byCourse))
+	for course, timing := range byCourse {
+		if !allReady[course] {
+			timing.ReadyAt = time.Time{}
+		}
+		if !timing.FiredAt.IsZero() {
+			timing.HeldFor = timing.FiredAt.Sub(ko.CreatedAt)
+			if !timing.ReadyAt.IsZero() {
+				timing.FireToReady = timing.ReadyAt.Sub(timing.FiredAt)
+			}
+		}
+		timings = append(timings, timing)
+	}
+
+	sort.Slice(timings, func(i, j int) bool { return timings[i].Course < timings[j].Course })
+	return timings
+}
+
+// CourseMetrics aggregates course timings across kitchen orders
+type CourseMetrics struct {
+	Course             int           `json:"course"`
+	FiredCount         int           `json:"fired_count"`
+	ReadyCount         int           `json:"ready_count"`
+	AverageHeldFor     time.Duration `json:"average_held_for"`
+	AverageFireToReady time.Duration `json:"average_fire_to_ready"`
+}
+
+// AggregateCourseTimings averages hold and fire-to-ready times per course number
+func AggregateCourseTimings(orders []*KitchenOrder) []*CourseMetrics {
+	byCourse := make(map[int]*CourseMetrics)
+	heldTotals := make(map[int]time.Duration)
+	readyTotals := make(map[int]time.Duration)
+
+	for _, order := range orders {
+		for _, timing := range order.CourseTimings() {
+			if timing.Status != CourseStatusFired {
+				continue
+			}
+
+			metrics, ok := byCourse[timing.Course]
+			if !ok {
+				metrics = &CourseMetrics{Course: timing.Course}
+				byCourse[timing.Course] = metrics
+			}
+			metrics.FiredCount++
+			heldTotals[timing.Course] += timing.HeldFor
+
+			if !timing.ReadyAt.IsZero() {
+				metrics.ReadyCount++
+				readyTotals[timing.Course] += timing.FireToReady
+			}
+		}
+	}
+
+	result := make([]*CourseMetrics, 0, len(byCourse))
+	for course, metrics := range byCourse {
+		metrics.AverageHeldFor = heldTotals[course] / time.Duration(metrics.FiredCount)
+		if metrics.ReadyCount > 0 {
+			metrics.AverageFireToReady = readyTotals[course] / time.Duration(metrics.ReadyCount)
+		}
+		result = append(result, metrics)
+	}
+
+	sort.Slice(result, func(i, j int) bool { return result[i].Course < result[j].Course })
+	return result
+}
@@ -0,0 +1,132 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/restaurant-platform/shared/pkg/errors"
+)
+
+// CourseTestSuite contains hold-and-fire course tests
+type CourseTestSuite struct {
+	suite.Suite
+	order *KitchenOrder
+}
+
+func TestCourseTestSuite(t *testing.T) {
+	suite.Run(t, new(CourseTestSuite))
+}
+
+func (suite *CourseTestSuite) SetupTest() {
+	suite.order, _ = NewKitchenOrder("order-123", "table-4")
+	_ = suite.order.AddCourseItem(1, "calamari-1", "Calamari", 1, 8*time.Minute, nil, nil, "")
+	_ = suite.order.AddCourseItem(2, "steak-1", "Ribeye", 2, 20*time.Minute, nil, nil, "")
+}
+
+func (suite *CourseTestSuite) TestAddCourseItem_LaterCoursesHeld() {
+	// Then
+	assert := assert.New(suite.T())
+	assert.False(suite.order.Items[0].IsHeld())
+	assert.True(suite.order.Items[1].IsHeld())
+	assert.Equal(CourseStatusHeld, suite.order.CourseStatus(2))
+	assert.Equal(8*time.Minute, suite.order.EstimatedTime)
+}
+
+func (suite *CourseTestSuite) TestUpdateItemStatus_HeldItemCannotStart() {
+	// When
+	err := suite.order.UpdateItemStatus(suite.order.Items[1].ID, KitchenItemStatusPreparing)
+
+	// Then
+	assert.True(suite.T(), errors.IsConflictError(err))
+}
+
+func (suite *CourseTestSuite) TestFireCourse_Success() {
+	// When
+	err := suite.order.FireCourse(2)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	assert.False(suite.order.Items[1].IsHeld())
+	assert.Equal(20*time.Minute, suite.order.EstimatedTime)
+	assert.NoError(suite.order.UpdateItemStatus(suite.order.Items[1].ID, KitchenItemStatusPreparing))
+}
+
+func (suite *CourseTestSuite) TestFireCourse_AlreadyFired_ShouldFail() {
+	// When
+	err := suite.order.FireCourse(1)
+
+	// Then
+	assert.True(suite.T(), errors.IsConflictError(err))
+}
+
+func (suite *CourseTestSuite) TestFireCourse_UnknownCourse_ShouldFail() {
+	// When
+	err := suite.order.FireCourse(3)
+
+	// Then
+	assert.True(suite.T(), errors.IsNotFound(err))
+}
+
+func (suite *CourseTestSuite) TestFiredItemsOnly_HidesHeldCourses() {
+	// When
+	view := suite.order.FiredItemsOnly()
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.Len(view.Items, 1)
+	assert.Equal("Calamari", view.Items[0].Name)
+	assert.Len(suite.order.Items, 2)
+}
+
+func (suite *CourseTestSuite) TestFiredItemsOnly_AllHeld_ReturnsNil() {
+	// Given
+	order, _ := NewKitchenOrder("order-456", "table-9")
+	_ = order.AddCourseItem(2, "steak-1", "Ribeye", 1, 20*time.Minute, nil, nil, "")
+
+	// Then
+	assert.Nil(suite.T(), order.FiredItemsOnly())
+}
+
+func (suite *CourseTestSuite) TestCourseTimings() {
+	// Given
+	suite.order.CreatedAt = time.Now().Add(-30 * time.Minute)
+	starter := suite.order.Items[0]
+	_ = suite.order.UpdateItemStatus(starter.ID, KitchenItemStatusPreparing)
+	_ = suite.order.UpdateItemStatus(starter.ID, KitchenItemStatusReady)
+	starter.FiredAt = suite.order.CreatedAt
+	starter.CompletedAt = suite.order.CreatedAt.Add(10 * time.Minute)
+	_ = suite.order.FireCourse(2)
+
+	// When
+	timings := suite.order.CourseTimings()
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.Len(timings, 2)
+	assert.Equal(CourseStatusFired, timings[0].Status)
+	assert.Equal(10*time.Minute, timings[0].FireToReady)
+	assert.Equal(time.Duration(0), timings[0].HeldFor)
+	assert.InDelta(float64(30*time.Minute), float64(timings[1].HeldFor), float64(time.Second))
+	assert.True(timings[1].ReadyAt.IsZero())
+}
+
+func (suite *CourseTestSuite) TestAggregateCourseTimings() {
+	// Given
+	other, _ := NewKitchenOrder("order-456", "table-9")
+	_ = other.AddCourseItem(1, "soup-1", "Soup", 1, 5*time.Minute, nil, nil, "")
+	_ = suite.order.FireCourse(2)
+
+	// When
+	metrics := AggregateCourseTimings([]*KitchenOrder{suite.order, other})
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.Len(metrics, 2)
+	assert.Equal(2, metrics[0].FiredCount)
+	assert.Equal(1, metrics[1].FiredCount)
+	assert.Equal(0, metrics[1].ReadyCount)
+}
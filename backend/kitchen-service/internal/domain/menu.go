@@ -0,0 +1,37 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// DefaultPrepTime is the preparation time assumed for an item whose menu item is unknown
+// to the kitchen or has no preparation time set
+const DefaultPrepTime = 10 * time.Minute
+
+// MenuItem is kitchen-service's local read model of a menu item.
+// It is kept current from menu.* events and supplies the preparation time of each ticket item.
+type MenuItem struct {
+	ID           string        `json:"id"`
+	MenuID       string        `json:"menu_id"`
+	Name         string        `json:"name"`
+	CategoryID   string        `json:"category_id"`
+	CategoryName string        `json:"category_name"`
+	PrepTime     time.Duration `json:"prep_time"`
+	UpdatedAt    time.Time     `json:"updated_at"`
+}
+
+// EffectivePrepTime returns the menu item's preparation time, or DefaultPrepTime when none
+// is set or the item is not known to the kitchen (a nil menu item)
+func (m *MenuItem) EffectivePrepTime() time.Duration {
+	if m == nil || m.PrepTime <= 0 {
+		return DefaultPrepTime
+	}
+	return m.PrepTime
+}
+
+// MenuCatalog reads the menu on sale from the menu service
+type MenuCatalog interface {
+	// ActiveMenu returns the ID and items of the active menu, or a not found error when no menu is active
+	ActiveMenu(ctx context.Context) (string, []*MenuItem, error)
+}
@@ -37,6 +37,7 @@ const (
 	KitchenOrderStatusNew       KitchenOrderStatus = "NEW"
 	KitchenOrderStatusPreparing KitchenOrderStatus = "PREPARING"
 	KitchenOrderStatusReady     KitchenOrderStatus = "READY"
+	KitchenOrderStatusServed    KitchenOrderStatus = "SERVED"
 	KitchenOrderStatusCompleted KitchenOrderStatus = "COMPLETED"
 	KitchenOrderStatusCancelled KitchenOrderStatus = "CANCELLED"
 )
@@ -73,24 +74,44 @@ type KitchenOrder struct {
 	EstimatedTime   time.Duration      `json:"estimated_time"`
 	StartedAt       time.Time          `json:"started_at,omitempty"`
 	CompletedAt     time.Time          `json:"completed_at,omitempty"`
+	ServedAt        time.Time          `json:"served_at,omitempty"`
+	Bumps           []*BumpRecord      `json:"bumps,omitempty"`
 	Notes           string             `json:"notes,omitempty"`
+	Version         int                `json:"version"`
 	CreatedAt       time.Time          `json:"created_at"`
 	UpdatedAt       time.Time          `json:"updated_at"`
 }
 
 // KitchenItem represents an item in a kitchen order
 type KitchenItem struct {
-	ID              KitchenItemID     `json:"id"`
-	MenuItemID      string            `json:"menu_item_id"`
-	Name            string            `json:"name"`
-	Quantity        int               `json:"quantity"`
-	Status          KitchenItemStatus `json:"status"`
-	PrepTime        time.Duration     `json:"prep_time"`
-	StartedAt       time.Time         `json:"started_at,omitempty"`
-	CompletedAt     time.Time         `json:"completed_at,omitempty"`
-	AssignedStation string            `json:"assigned_station,omitempty"`
-	Notes           string            `json:"notes,omitempty"`
-	Modifications   []string          `json:"modifications,omitempty"`
+	ID              KitchenItemID          `json:"id"`
+	MenuItemID      string                 `json:"menu_item_id"`
+	Name            string                 `json:"name"`
+	Quantity        int                    `json:"quantity"`
+	Status          KitchenItemStatus      `json:"status"`
+	PrepTime        time.Duration          `json:"prep_time"`
+	LearnedPrepTime bool                   `json:"learned_prep_time,omitempty"`
+	StartedAt       time.Time              `json:"started_at,omitempty"`
+	CompletedAt     time.Time              `json:"completed_at,omitempty"`
+	AssignedStation string                 `json:"assigned_station,omitempty"`
+	Notes           string                 `json:"notes,omitempty"`
+	Modifiers       []*KitchenItemModifier `json:"modifiers,omitempty"`
+	Modifications   []string               `json:"modifications,omitempty"`
+	Course          int                    `json:"course"`
+	Seat            int                    `json:"seat,omitempty"`
+	FiredAt         time.Time              `json:"fired_at,omitempty"`
+	OrderItemID     string                 `json:"order_item_id,omitempty"`
+	Ticket          int                    `json:"ticket,omitempty"`
+	Wasted          bool                   `json:"wasted,omitempty"`
+	VoidReason      string                 `json:"void_reason,omitempty"`
+	VoidedAt        time.Time              `json:"voided_at,omitempty"`
+}
+
+// KitchenItemModifier is a modifier option chosen for a kitchen item, e.g.
+// group "Temperature" with option "Medium rare"
+type KitchenItemModifier struct {
+	Group  string `json:"group"`
+	Option string `json:"option"`
 }
 
 // KitchenOrderFilters for querying kitchen orders
@@ -119,18 +140,24 @@ func NewKitchenOrder(orderID, tableID string) (*KitchenOrder, error) {
 		Items:         make([]*KitchenItem, 0),
 		Priority:      KitchenPriorityNormal,
 		EstimatedTime: 0,
+		Version:       1,
 		CreatedAt:     now,
 		UpdatedAt:     now,
 	}, nil
 }
 
-// AddItem adds an item to the kitchen order
+// AddItem adds an item on the first course to the kitchen order
 func (ko *KitchenOrder) AddItem(menuItemID, name string, quantity int, prepTime time.Duration, mods []string, notes string) error {
+	return ko.AddCourseItem(DefaultCourse, menuItemID, name, quantity, prepTime, nil, mods, notes)
+}
+
+// addItem adds an item with its structured modifier selections to the kitchen order
+func (ko *KitchenOrder) addItem(menuItemID, name string, quantity int, prepTime time.Duration, modifiers []*KitchenItemModifier, mods []string, notes string) (*KitchenItem, error) {
 	if menuItemID == "" {
-		return errors.WrapValidation("AddItem", "menuItemID", "menu item ID is required", nil)
+		return nil, errors.WrapValidation("AddItem", "menuItemID", "menu item ID is required", nil)
 	}
 	if quantity <= 0 {
-		return errors.WrapValidation("AddItem", "quantity", "quantity must be positive", nil)
+		return nil, errors.WrapValidation("AddItem", "quantity", "quantity must be positive", nil)
 	}
 
 	// Create a new item with unique ID
@@ -143,6 +170,7 @@ func (ko *KitchenOrder) AddItem(menuItemID, name string, quantity int, prepTime
 		Quantity:      quantity,
 		Status:        KitchenItemStatusNew,
 		PrepTime:      prepTime,
+		Modifiers:     modifiers,
 		Modifications: mods,
 		Notes:         notes,
 	}
@@ -150,11 +178,8 @@ func (ko *KitchenOrder) AddItem(menuItemID, name string, quantity int, prepTime
 	// Add to items
 	ko.Items = append(ko.Items, item)
 
-	// Recalculate estimated time
-	ko.recalculateEstimatedTime()
-
 	ko.UpdatedAt = time.Now()
-	return nil
+	return item, nil
 }
 
 // RemoveItem removes an item from the kitchen order
@@ -178,6 +203,10 @@ func (ko *KitchenOrder) RemoveItem(itemID KitchenItemID) error {
 func (ko *KitchenOrder) recalculateEstimatedTime() {
 	var maxPrepTime time.Duration
 	for _, item := range ko.Items {
+		// Held courses are not on the line yet, so they do not count towards the estimate
+		if item.IsHeld() {
+			continue
+		}
 		if item.Status != KitchenItemStatusReady && item.Status != KitchenItemStatusCancelled {
 			if item.PrepTime > maxPrepTime {
 				maxPrepTime = item.PrepTime
@@ -208,6 +237,9 @@ func (ko *KitchenOrder) UpdateItemStatus(itemID KitchenItemID, status KitchenIte
 				if status != KitchenItemStatusPreparing && status != KitchenItemStatusCancelled {
 					return errors.WrapConflict("StatusUpdate", "status_transition", "invalid status transition", nil)
 				}
+				if status == KitchenItemStatusPreparing && item.IsHeld() {
+					return errors.WrapConflict("StatusUpdate", "course", "item's course has not been fired", nil)
+				}
 				if status == KitchenItemStatusPreparing {
 					item.StartedAt = time.Now()
 				}
@@ -253,9 +285,26 @@ func (ko *KitchenOrder) UpdateStatus(status KitchenOrderStatus) error {
 			return errors.WrapConflict("StatusUpdate", "status_transition", "invalid status transition", nil)
 		}
 	case KitchenOrderStatusReady:
-		if status != KitchenOrderStatusCompleted && status != KitchenOrderStatusCancelled {
+		if status != KitchenOrderStatusServed && status != KitchenOrderStatusCompleted && status != KitchenOrderStatusCancelled {
+			return errors.WrapConflict("StatusUpdate", "status_transition", "invalid status transition", nil)
+		}
+		if status == KitchenOrderStatusServed {
+			ko.ServedAt = time.Now()
+		}
+		if status == KitchenOrderStatusCompleted {
+			ko.CompletedAt = time.Now()
+		}
+	case KitchenOrderStatusServed:
+		// A served ticket goes back to READY only when it is recalled within the recall window
+		if status != KitchenOrderStatusCompleted && status != KitchenOrderStatusReady {
 			return errors.WrapConflict("StatusUpdate", "status_transition", "invalid status transition", nil)
 		}
+		if status == KitchenOrderStatusReady {
+			if !ko.CanRecallExpo() {
+				return errors.WrapConflict("StatusUpdate", "status_transition", "served ticket is past the recall window", nil)
+			}
+			ko.ServedAt = time.Time{}
+		}
 		if status == KitchenOrderStatusCompleted {
 			ko.CompletedAt = time.Now()
 		}
@@ -270,7 +319,7 @@ func (ko *KitchenOrder) UpdateStatus(status KitchenOrderStatus) error {
 
 // updateOrderStatus updates the kitchen order status based on item statuses
 func (ko *KitchenOrder) updateOrderStatus() {
-	if ko.Status == KitchenOrderStatusCompleted || ko.Status == KitchenOrderStatusCancelled {
+	if ko.Status == KitchenOrderStatusServed || ko.Status == KitchenOrderStatusCompleted || ko.Status == KitchenOrderStatusCancelled {
 		return
 	}
 
@@ -319,6 +368,17 @@ func (ko *KitchenOrder) SetPriority(priority KitchenPriority) {
 	ko.UpdatedAt = time.Now()
 }
 
+// EscalatedPriority returns the priority of the kitchen order once its order has breached an SLA:
+// at least HIGH, and URGENT if it was already HIGH
+func (ko *KitchenOrder) EscalatedPriority() KitchenPriority {
+	switch ko.Priority {
+	case KitchenPriorityHigh, KitchenPriorityUrgent:
+		return KitchenPriorityUrgent
+	default:
+		return KitchenPriorityHigh
+	}
+}
+
 // AddNotes adds notes to the kitchen order
 func (ko *KitchenOrder) AddNotes(notes string) {
 	ko.Notes = notes
@@ -330,6 +390,9 @@ func (ko *KitchenOrder) Cancel() error {
 	if ko.Status == KitchenOrderStatusCompleted {
 		return errors.WrapConflict("Cancel", "kitchen order", "cannot cancel a completed order", nil)
 	}
+	if ko.Status == KitchenOrderStatusServed {
+		return errors.WrapConflict("Cancel", "kitchen order", "cannot cancel a served order", nil)
+	}
 
 	ko.Status = KitchenOrderStatusCancelled
 
@@ -354,6 +417,11 @@ func (ko *KitchenOrder) IsReady() bool {
 	return ko.Status == KitchenOrderStatusReady
 }
 
+// IsServed checks if the kitchen order has been bumped by expo
+func (ko *KitchenOrder) IsServed() bool {
+	return ko.Status == KitchenOrderStatusServed
+}
+
 // IsCancelled checks if the kitchen order is cancelled
 func (ko *KitchenOrder) IsCancelled() bool {
 	return ko.Status == KitchenOrderStatusCancelled
@@ -372,18 +440,31 @@ func (ko *KitchenOrder) TimeElapsed() time.Duration {
 	return ko.CompletedAt.Sub(ko.StartedAt)
 }
 
-// TimeRemaining returns the estimated time remaining
+// TimeRemaining returns the estimated time remaining: the longest any fired item still
+// needs, from its own start or, before it is started, from the start of the order
 func (ko *KitchenOrder) TimeRemaining() time.Duration {
-	if ko.Status == KitchenOrderStatusReady || ko.Status == KitchenOrderStatusCompleted || ko.Status == KitchenOrderStatusCancelled {
+	if ko.Status == KitchenOrderStatusReady || ko.Status == KitchenOrderStatusServed || ko.Status == KitchenOrderStatusCompleted || ko.Status == KitchenOrderStatusCancelled {
 		return 0
 	}
 
-	elapsed := ko.TimeElapsed()
-	if elapsed >= ko.EstimatedTime {
-		return 0
+	var remaining time.Duration
+	for _, item := range ko.Items {
+		if item.IsHeld() || item.Status == KitchenItemStatusReady || item.Status == KitchenItemStatusCancelled {
+			continue
+		}
+
+		left := item.PrepTime
+		if !item.StartedAt.IsZero() {
+			left -= time.Since(item.StartedAt)
+		} else {
+			left -= ko.TimeElapsed()
+		}
+		if left > remaining {
+			remaining = left
+		}
 	}
 
-	return ko.EstimatedTime - elapsed
+	return remaining
 }
 
 // Validate checks if the kitchen order is valid
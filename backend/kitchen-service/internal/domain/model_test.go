@@ -506,6 +506,21 @@ func (suite *KitchenOrderTestSuite) TestSetPriority() {
 	assert.Equal(KitchenPriorityHigh, kitchenOrder.Priority)
 }
 
+func (suite *KitchenOrderTestSuite) TestEscalatedPriority() {
+	// Given
+	kitchenOrder, _ := NewKitchenOrder("order-123", "table-5")
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.Equal(KitchenPriorityHigh, kitchenOrder.EscalatedPriority())
+	kitchenOrder.SetPriority(KitchenPriorityLow)
+	assert.Equal(KitchenPriorityHigh, kitchenOrder.EscalatedPriority())
+	kitchenOrder.SetPriority(KitchenPriorityHigh)
+	assert.Equal(KitchenPriorityUrgent, kitchenOrder.EscalatedPriority())
+	kitchenOrder.SetPriority(KitchenPriorityUrgent)
+	assert.Equal(KitchenPriorityUrgent, kitchenOrder.EscalatedPriority())
+}
+
 // Test Notes Management
 func (suite *KitchenOrderTestSuite) TestAddNotes() {
 	// Given
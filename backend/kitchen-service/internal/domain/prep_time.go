@@ -0,0 +1,192 @@
+package domain
+
+import (
+	"math"
+	"slices"
+	"time"
+)
+
+// AllHours is the hour of a prep time statistic covering every hour of the day
+const AllHours = -1
+
+// MinPrepTimeSamples is the fewest observations, after outliers are trimmed, a learned prep
+// time needs before it replaces the menu's
+const MinPrepTimeSamples = 5
+
+// PrepTimeSource tells where an estimated prep time came from
+type PrepTimeSource string
+
+const (
+	// PrepTimeSourceHour is learned from items started in the same hour of the day
+	PrepTimeSourceHour PrepTimeSource = "LEARNED_HOUR"
+	// PrepTimeSourceAllHours is learned from items started at any hour of the day
+	PrepTimeSourceAllHours PrepTimeSource = "LEARNED"
+	// PrepTimeSourceMenu is the menu item's preparation time, used while data is sparse
+	PrepTimeSourceMenu PrepTimeSource = "MENU"
+)
+
+// PrepTimeStat is the observed preparation time of a menu item at a station, from start
+// to ready, in one hour of the day or across all hours
+type PrepTimeStat struct {
+	MenuItemID   string        `json:"menu_item_id"`
+	Station      string        `json:"station"`
+	Hour         int           `json:"hour"`
+	SampleCount  int           `json:"sample_count"`
+	TrimmedCount int           `json:"trimmed_count"`
+	Median       time.Duration `json:"median"`
+	P90          time.Duration `json:"p90"`
+	ComputedAt   time.Time     `json:"computed_at"`
+}
+
+// PrepTimeStats are the learned preparation times of one or more menu items
+type PrepTimeStats []*PrepTimeStat
+
+// PrepTimeEstimate is the preparation time a ticket item is expected to take
+type PrepTimeEstimate struct {
+	MenuItemID string         `json:"menu_item_id"`
+	Station    string         `json:"station"`
+	Hour       int            `json:"hour"`
+	PrepTime   time.Duration  `json:"prep_time"`
+	Source     PrepTimeSource `json:"source"`
+	Stat       *PrepTimeStat  `json:"stat,omitempty"`
+}
+
+// Learned returns the statistic to estimate a menu item at a station with: the one for the
+// hour of the day, else the one across all hours. It returns nil when neither has enough samples.
+func (stats PrepTimeStats) Learned(menuItemID, station string, hour int) *PrepTimeStat {
+	var allHours *PrepTimeStat
+	for _, stat := range stats {
+		if stat.MenuItemID != menuItemID || stat.Station != station || stat.SampleCount < MinPrepTimeSamples {
+			continue
+		}
+		if stat.Hour == hour {
+			return stat
+		}
+		if stat.Hour == AllHours {
+			allHours = stat
+		}
+	}
+	return allHours
+}
+
+// Estimate returns the expected preparation time of a menu item at a station in an hour of
+// the day, falling back to the menu item's preparation time while data is sparse
+func (stats PrepTimeStats) Estimate(menuItemID, station string, hour int, menuItem *MenuItem) *PrepTimeEstimate {
+	estimate := &PrepTimeEstimate{
+		MenuItemID: menuItemID,
+		Station:    station,
+		Hour:       hour,
+		PrepTime:   menuItem.EffectivePrepTime(),
+		Source:     PrepTimeSourceMenu,
+	}
+
+	if stat := stats.Learned(menuItemID, station, hour); stat != nil {
+		estimate.PrepTime = stat.Median
+		estimate.Stat = stat
+		estimate.Source = PrepTimeSourceAllHours
+		if stat.Hour == hour {
+			estimate.Source = PrepTimeSourceHour
+		}
+	}
+	return estimate
+}
+
+// ApplyLearnedPrepTime replaces an item's menu preparation time with the learned one for
+// its station and the hour it is ticketed at, when there is enough data
+func (ko *KitchenOrder) ApplyLearnedPrepTime(item *KitchenItem, stats PrepTimeStats, at time.Time) {
+	stat := stats.Learned(item.MenuItemID, ko.StationOf(item), at.Hour())
+	if stat == nil {
+		return
+	}
+
+	item.PrepTime = stat.Median
+	item.LearnedPrepTime = true
+	ko.recalculateEstimatedTime()
+}
+
+// prepTimeKey groups observed preparation times
+type prepTimeKey struct {
+	menuItemID string
+	station    string
+	hour       int
+}
+
+// ComputePrepTimeStats derives preparation time statistics from the items of past kitchen
+// orders. Every item prepared from start to ready counts once for the hour of the day it
+// was started in and once across all hours; wasted items are left out.
+func ComputePrepTimeStats(orders []*KitchenOrder, now time.Time) PrepTimeStats {
+	samples := make(map[prepTimeKey][]time.Duration)
+	var keys []prepTimeKey
+	observe := func(key prepTimeKey, duration time.Duration) {
+		if _, ok := samples[key]; !ok {
+			keys = append(keys, key)
+		}
+		samples[key] = append(samples[key], duration)
+	}
+
+	for _, order := range orders {
+		for _, item := range order.Items {
+			if item.MenuItemID == "" || item.Status != KitchenItemStatusReady || item.Wasted {
+				continue
+			}
+			// Items bumped without being started have no real preparation time
+			if item.StartedAt.IsZero() || !item.CompletedAt.After(item.StartedAt) {
+				continue
+			}
+
+			duration := item.CompletedAt.Sub(item.StartedAt)
+			station := order.StationOf(item)
+			observe(prepTimeKey{item.MenuItemID, station, item.StartedAt.Hour()}, duration)
+			observe(prepTimeKey{item.MenuItemID, station, AllHours}, duration)
+		}
+	}
+
+	stats := make(PrepTimeStats, 0, len(keys))
+	for _, key := range keys {
+		kept := trimOutliers(samples[key])
+		stats = append(stats, &PrepTimeStat{
+			MenuItemID:   key.menuItemID,
+			Station:      key.station,
+			Hour:         key.hour,
+			SampleCount:  len(kept),
+			TrimmedCount: len(samples[key]) - len(kept),
+			Median:       quantile(kept, 0.5),
+			P90:          quantile(kept, 0.9),
+			ComputedAt:   now,
+		})
+	}
+	return stats
+}
+
+// trimOutliers sorts the durations and drops those outside the Tukey fences, 1.5 times the
+// interquartile range beyond the quartiles. Fewer than four durations are kept as they are.
+func trimOutliers(durations []time.Duration) []time.Duration {
+	sorted := slices.Clone(durations)
+	slices.Sort(sorted)
+	if len(sorted) < 4 {
+		return sorted
+	}
+
+	q1, q3 := quantile(sorted, 0.25), quantile(sorted, 0.75)
+	fence := time.Duration(1.5 * float64(q3-q1))
+	kept := make([]time.Duration, 0, len(sorted))
+	for _, d := range sorted {
+		if d >= q1-fence && d <= q3+fence {
+			kept = append(kept, d)
+		}
+	}
+	return kept
+}
+
+// quantile returns the q-th quantile of sorted durations, interpolating between neighbours
+func quantile(sorted []time.Duration, q float64) time.Duration {
+	if len(sorted) == 0 {
+		return 0
+	}
+
+	pos := q * float64(len(sorted)-1)
+	lower := int(math.Floor(pos))
+	upper := int(math.Ceil(pos))
+	fraction := pos - float64(lower)
+	return sorted[lower] + time.Duration(fraction*float64(sorted[upper]-sorted[lower]))
+}
@@ -0,0 +1,175 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+// PrepTimeTestSuite contains tests for learning preparation times from past tickets
+type PrepTimeTestSuite struct {
+	suite.Suite
+	now time.Time
+}
+
+func TestPrepTimeTestSuite(t *testing.T) {
+	suite.Run(t, new(PrepTimeTestSuite))
+}
+
+func (suite *PrepTimeTestSuite) SetupTest() {
+	suite.now = time.Date(2026, 10, 18, 23, 0, 0, 0, time.UTC)
+}
+
+// prepared returns a ticket with a burger prepared at the grill, started at the given time
+func (suite *PrepTimeTestSuite) prepared(startedAt time.Time, took time.Duration) *KitchenOrder {
+	order, _ := NewKitchenOrder("order-123", "table-4")
+	item, _ := order.AddOrderLine(&TicketLine{OrderItemID: "item_burger", MenuItemID: "burger-1", Name: "Burger", Quantity: 1}, 12*time.Minute)
+	item.AssignedStation = StationGrill
+	item.Status = KitchenItemStatusReady
+	item.StartedAt = startedAt
+	item.CompletedAt = startedAt.Add(took)
+	return order
+}
+
+func (suite *PrepTimeTestSuite) statFor(stats PrepTimeStats, hour int) *PrepTimeStat {
+	for _, stat := range stats {
+		if stat.MenuItemID == "burger-1" && stat.Station == StationGrill && stat.Hour == hour {
+			return stat
+		}
+	}
+	return nil
+}
+
+func (suite *PrepTimeTestSuite) TestComputePrepTimeStats_TrimsOutliersPerHourAndAcrossHours() {
+	// Given
+	dinner := time.Date(2026, 10, 17, 19, 10, 0, 0, time.UTC)
+	var orders []*KitchenOrder
+	for _, minutes := range []int{10, 11, 12, 12, 13, 14, 60} {
+		orders = append(orders, suite.prepared(dinner, time.Duration(minutes)*time.Minute))
+	}
+	orders = append(orders, suite.prepared(time.Date(2026, 10, 17, 12, 30, 0, 0, time.UTC), 8*time.Minute))
+
+	// When
+	stats := ComputePrepTimeStats(orders, suite.now)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.Len(stats, 3)
+
+	hour := suite.statFor(stats, 19)
+	assert.Equal(6, hour.SampleCount)
+	assert.Equal(1, hour.TrimmedCount)
+	assert.Equal(12*time.Minute, hour.Median)
+	assert.Equal(13*time.Minute+30*time.Second, hour.P90)
+	assert.Equal(suite.now, hour.ComputedAt)
+
+	allHours := suite.statFor(stats, AllHours)
+	assert.Equal(7, allHours.SampleCount)
+	assert.Equal(1, allHours.TrimmedCount)
+	assert.Equal(12*time.Minute, allHours.Median)
+
+	assert.Equal(1, suite.statFor(stats, 12).SampleCount)
+}
+
+func (suite *PrepTimeTestSuite) TestComputePrepTimeStats_SkipsItemsWithoutARealPrepTime() {
+	// Given
+	startedAt := time.Date(2026, 10, 17, 19, 10, 0, 0, time.UTC)
+	wasted := suite.prepared(startedAt, 10*time.Minute)
+	wasted.Items[0].Wasted = true
+	preparing := suite.prepared(startedAt, 10*time.Minute)
+	preparing.Items[0].Status = KitchenItemStatusPreparing
+	bumpedUnstarted := suite.prepared(startedAt, 0)
+
+	// When
+	stats := ComputePrepTimeStats([]*KitchenOrder{wasted, preparing, bumpedUnstarted}, suite.now)
+
+	// Then
+	assert.Empty(suite.T(), stats)
+}
+
+func (suite *PrepTimeTestSuite) TestEstimate_PrefersHourThenAllHoursThenMenu() {
+	// Given
+	stats := PrepTimeStats{
+		{MenuItemID: "burger-1", Station: StationGrill, Hour: AllHours, SampleCount: 40, Median: 14 * time.Minute},
+		{MenuItemID: "burger-1", Station: StationGrill, Hour: 19, SampleCount: 12, Median: 17 * time.Minute},
+		{MenuItemID: "burger-1", Station: StationGrill, Hour: 15, SampleCount: MinPrepTimeSamples - 1, Median: 9 * time.Minute},
+		{MenuItemID: "fries-1", Station: StationFry, Hour: AllHours, SampleCount: MinPrepTimeSamples - 1, Median: 6 * time.Minute},
+	}
+	burger := &MenuItem{ID: "burger-1", PrepTime: 12 * time.Minute}
+	fries := &MenuItem{ID: "fries-1", PrepTime: 4 * time.Minute}
+
+	// When
+	dinner := stats.Estimate("burger-1", StationGrill, 19, burger)
+	afternoon := stats.Estimate("burger-1", StationGrill, 15, burger)
+	otherStation := stats.Estimate("burger-1", StationSaute, 19, burger)
+	sparse := stats.Estimate("fries-1", StationFry, 19, fries)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.Equal(PrepTimeSourceHour, dinner.Source)
+	assert.Equal(17*time.Minute, dinner.PrepTime)
+	assert.Equal(PrepTimeSourceAllHours, afternoon.Source)
+	assert.Equal(14*time.Minute, afternoon.PrepTime)
+	assert.Equal(PrepTimeSourceMenu, otherStation.Source)
+	assert.Equal(12*time.Minute, otherStation.PrepTime)
+	assert.Equal(PrepTimeSourceMenu, sparse.Source)
+	assert.Equal(4*time.Minute, sparse.PrepTime)
+	assert.Nil(sparse.Stat)
+}
+
+func (suite *PrepTimeTestSuite) TestApplyLearnedPrepTime_UpdatesEstimatedTime() {
+	// Given
+	order, _ := NewKitchenOrder("order-123", "table-4")
+	burger, _ := order.AddOrderLine(&TicketLine{OrderItemID: "item_burger", MenuItemID: "burger-1", Name: "Burger", Quantity: 1}, 12*time.Minute)
+	burger.AssignedStation = StationGrill
+	stats := PrepTimeStats{
+		{MenuItemID: "burger-1", Station: StationGrill, Hour: AllHours, SampleCount: 40, Median: 15 * time.Minute},
+	}
+
+	// When
+	order.ApplyLearnedPrepTime(burger, stats, suite.now)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.Equal(15*time.Minute, burger.PrepTime)
+	assert.True(burger.LearnedPrepTime)
+	assert.Equal(15*time.Minute, order.EstimatedTime)
+}
+
+func (suite *PrepTimeTestSuite) TestApplyLearnedPrepTime_SparseData_KeepsMenuPrepTime() {
+	// Given
+	order, _ := NewKitchenOrder("order-123", "table-4")
+	burger, _ := order.AddOrderLine(&TicketLine{OrderItemID: "item_burger", MenuItemID: "burger-1", Name: "Burger", Quantity: 1}, 12*time.Minute)
+	burger.AssignedStation = StationGrill
+	stats := PrepTimeStats{
+		{MenuItemID: "burger-1", Station: StationGrill, Hour: AllHours, SampleCount: 2, Median: 15 * time.Minute},
+	}
+
+	// When
+	order.ApplyLearnedPrepTime(burger, stats, suite.now)
+
+	// Then
+	assert.Equal(suite.T(), 12*time.Minute, burger.PrepTime)
+	assert.False(suite.T(), burger.LearnedPrepTime)
+}
+
+func (suite *PrepTimeTestSuite) TestTimeRemaining_CountsFromEachItemsStart() {
+	// Given
+	order, _ := NewKitchenOrder("order-123", "table-4")
+	burger, _ := order.AddOrderLine(&TicketLine{OrderItemID: "item_burger", MenuItemID: "burger-1", Name: "Burger", Quantity: 1}, 12*time.Minute)
+	steak, _ := order.AddOrderLine(&TicketLine{OrderItemID: "item_steak", MenuItemID: "steak-1", Name: "Steak", Quantity: 1}, 20*time.Minute)
+	order.Status = KitchenOrderStatusPreparing
+	order.StartedAt = time.Now().Add(-10 * time.Minute)
+	burger.Status = KitchenItemStatusPreparing
+	burger.StartedAt = order.StartedAt
+	steak.Status = KitchenItemStatusPreparing
+	steak.StartedAt = time.Now().Add(-2 * time.Minute)
+
+	// When
+	remaining := order.TimeRemaining()
+
+	// Then
+	assert.InDelta(suite.T(), float64(18*time.Minute), float64(remaining), float64(time.Second))
+}
@@ -2,6 +2,7 @@ package domain
 
 import (
 	"context"
+	"time"
 )
 
 // KitchenOrderRepository defines the data access interface for kitchen orders
@@ -27,12 +28,64 @@ type KitchenOrderRepository interface {
 	// FindByStation retrieves kitchen orders assigned to a specific station
 	FindByStation(ctx context.Context, stationID string) ([]*KitchenOrder, error)
 
-	// FindActive retrieves all active kitchen orders (not completed or cancelled)
+	// FindActive retrieves all active kitchen orders (not served, completed or cancelled)
 	FindActive(ctx context.Context) ([]*KitchenOrder, error)
 
+	// FindServedSince retrieves kitchen orders served at or after the given time, most recent first
+	FindServedSince(ctx context.Context, since time.Time) ([]*KitchenOrder, error)
+
 	// List retrieves kitchen orders with pagination and filters
 	List(ctx context.Context, offset, limit int, filters KitchenOrderFilters) ([]*KitchenOrder, int, error)
 
 	// Count returns the total number of kitchen orders matching the filters
 	Count(ctx context.Context, filters KitchenOrderFilters) (int, error)
-}
\ No newline at end of file
+}
+
+// MenuItemRepository defines the interface for the local menu read model
+type MenuItemRepository interface {
+	// GetByID retrieves a menu item by its menu-service ID
+	GetByID(ctx context.Context, id string) (*MenuItem, error)
+
+	// Upsert creates or replaces a menu item
+	Upsert(ctx context.Context, item *MenuItem) error
+
+	// Delete removes a menu item
+	Delete(ctx context.Context, id string) error
+
+	// ReplaceMenu replaces every item belonging to a menu with the given snapshot
+	ReplaceMenu(ctx context.Context, menuID string, items []*MenuItem) error
+
+	// DeleteByMenu removes every item belonging to a menu
+	DeleteByMenu(ctx context.Context, menuID string) error
+}
+
+// StationRepository defines the data access interface for the station registry and routing rules
+type StationRepository interface {
+	// ListStations retrieves every station in display order
+	ListStations(ctx context.Context) ([]*Station, error)
+
+	// GetStation retrieves a station by its ID
+	GetStation(ctx context.Context, id string) (*Station, error)
+
+	// SaveStation creates a station
+	SaveStation(ctx context.Context, station *Station) error
+
+	// ListRoutingRules retrieves every routing rule, oldest first
+	ListRoutingRules(ctx context.Context) (RoutingRules, error)
+
+	// SaveRoutingRule creates a routing rule
+	SaveRoutingRule(ctx context.Context, rule *RoutingRule) error
+
+	// DeleteRoutingRule removes a routing rule
+	DeleteRoutingRule(ctx context.Context, id string) error
+}
+
+// PrepTimeStatRepository defines the data access interface for learned preparation times
+type PrepTimeStatRepository interface {
+	// ListPrepTimeStats retrieves the learned preparation times of a menu item, or of every
+	// menu item when menuItemID is empty
+	ListPrepTimeStats(ctx context.Context, menuItemID string) (PrepTimeStats, error)
+
+	// ReplacePrepTimeStats replaces every learned preparation time with a fresh computation
+	ReplacePrepTimeStats(ctx context.Context, stats PrepTimeStats) error
+}
@@ -7,8 +7,8 @@ import (
 
 // KitchenService defines the business operations for kitchen orders
 type KitchenService interface {
-	// CreateKitchenOrder creates a new kitchen order from a regular order
-	CreateKitchenOrder(ctx context.Context, orderID, tableID string) (*KitchenOrder, error)
+	// CreateKitchenOrder creates a new kitchen order from a regular order, ticketing the given order lines
+	CreateKitchenOrder(ctx context.Context, orderID, tableID string, lines []*TicketLine) (*KitchenOrder, error)
 
 	// GetKitchenOrder retrieves a kitchen order by ID
 	GetKitchenOrder(ctx context.Context, id KitchenOrderID) (*KitchenOrder, error)
@@ -16,8 +16,26 @@ type KitchenService interface {
 	// GetKitchenOrderByOrderID retrieves a kitchen order by its corresponding order ID
 	GetKitchenOrderByOrderID(ctx context.Context, orderID string) (*KitchenOrder, error)
 
-	// AddKitchenItem adds an item to a kitchen order
-	AddKitchenItem(ctx context.Context, kitchenOrderID KitchenOrderID, menuItemID, name string, quantity int, prepTime time.Duration, modifications []string, notes string) error
+	// AddKitchenItem adds an item on a course to a kitchen order
+	AddKitchenItem(ctx context.Context, kitchenOrderID KitchenOrderID, menuItemID, name string, quantity, course int, prepTime time.Duration, modifiers []*KitchenItemModifier, modifications []string, notes string) error
+
+	// AddOrderItem tickets an order line, as a delta ticket once the order has gone to the line
+	AddOrderItem(ctx context.Context, kitchenOrderID KitchenOrderID, line *TicketLine) error
+
+	// RemoveOrderItem takes an order line off a ticket that has not gone to the line yet
+	RemoveOrderItem(ctx context.Context, kitchenOrderID KitchenOrderID, orderItemID string) error
+
+	// UpdateOrderItemQuantity changes the quantity of an order line on a ticket that has not gone to the line yet
+	UpdateOrderItemQuantity(ctx context.Context, kitchenOrderID KitchenOrderID, orderItemID string, quantity int) error
+
+	// VoidItem cancels the kitchen item for a voided order line, flagging waste if preparation had started
+	VoidItem(ctx context.Context, kitchenOrderID KitchenOrderID, orderItemID, reason string) error
+
+	// ChangeItemSeat moves the kitchen item for an order line to another seat
+	ChangeItemSeat(ctx context.Context, kitchenOrderID KitchenOrderID, orderItemID string, seat int) error
+
+	// FireCourse releases a held course of a kitchen order to the line
+	FireCourse(ctx context.Context, kitchenOrderID KitchenOrderID, course int) error
 
 	// UpdateItemStatus changes the status of an item in a kitchen order
 	UpdateItemStatus(ctx context.Context, kitchenOrderID KitchenOrderID, itemID string, status KitchenItemStatus) error
@@ -28,24 +46,92 @@ type KitchenService interface {
 	// AssignToStation assigns a kitchen order to a station
 	AssignToStation(ctx context.Context, kitchenOrderID KitchenOrderID, stationID string) error
 
+	// AssignItemToStation reroutes one item of a kitchen order to another station
+	AssignItemToStation(ctx context.Context, kitchenOrderID KitchenOrderID, itemID string, stationID string) error
+
 	// SetPriority sets the priority of a kitchen order
 	SetPriority(ctx context.Context, kitchenOrderID KitchenOrderID, priority KitchenPriority) error
 
+	// TransferTable moves a kitchen order to the table its order was transferred to
+	TransferTable(ctx context.Context, kitchenOrderID KitchenOrderID, tableID string) error
+
+	// MoveItems moves the kitchen items of the given order lines from one kitchen order to another
+	MoveItems(ctx context.Context, fromID, toID KitchenOrderID, orderItemIDs []string) error
+
 	// CancelKitchenOrder cancels a kitchen order
 	CancelKitchenOrder(ctx context.Context, kitchenOrderID KitchenOrderID) error
 
 	// CompleteKitchenOrder marks a kitchen order as completed
 	CompleteKitchenOrder(ctx context.Context, kitchenOrderID KitchenOrderID) error
 
+	// BumpStation marks the items a station still has to prepare on a kitchen order as done
+	BumpStation(ctx context.Context, kitchenOrderID KitchenOrderID, stationID, userID string) error
+
+	// RecallStation puts the items of a station's last bump back on its screen, within the recall window
+	RecallStation(ctx context.Context, kitchenOrderID KitchenOrderID, stationID, userID string) error
+
+	// BumpExpo marks a ready kitchen order as served
+	BumpExpo(ctx context.Context, kitchenOrderID KitchenOrderID, userID string) error
+
+	// RecallExpo puts a served kitchen order back on the expo screen, within the recall window
+	RecallExpo(ctx context.Context, kitchenOrderID KitchenOrderID, userID string) error
+
+	// GetRecentlyBumped retrieves the kitchen orders a station, or expo when no station is
+	// given, can still recall
+	GetRecentlyBumped(ctx context.Context, stationID string) ([]*KitchenOrder, error)
+
 	// GetActiveOrders retrieves all active kitchen orders
 	GetActiveOrders(ctx context.Context) ([]*KitchenOrder, error)
 
 	// GetOrdersByStatus retrieves kitchen orders with a specific status
 	GetOrdersByStatus(ctx context.Context, status KitchenOrderStatus) ([]*KitchenOrder, error)
 
-	// GetOrdersByStation retrieves kitchen orders assigned to a specific station
+	// GetOrdersByStation retrieves a station's queue: active kitchen orders with only the fired items
+	// the station still has to prepare
 	GetOrdersByStation(ctx context.Context, stationID string) ([]*KitchenOrder, error)
 
 	// ListKitchenOrders retrieves kitchen orders with pagination and filters
 	ListKitchenOrders(ctx context.Context, offset, limit int, filters KitchenOrderFilters) ([]*KitchenOrder, int, error)
-}
\ No newline at end of file
+
+	// GetCourseMetrics aggregates course hold and fire-to-ready times for orders created in a time range
+	GetCourseMetrics(ctx context.Context, from, to time.Time) ([]*CourseMetrics, error)
+}
+
+// StationService defines the operations on the station registry and its routing rules
+type StationService interface {
+	// ListStations retrieves every station in display order
+	ListStations(ctx context.Context) ([]*Station, error)
+
+	// CreateStation adds a station to the registry
+	CreateStation(ctx context.Context, id, name string, sortOrder int) (*Station, error)
+
+	// ListRoutingRules retrieves every routing rule
+	ListRoutingRules(ctx context.Context) (RoutingRules, error)
+
+	// AddRoutingRule routes items matching a category, menu item or modifier option to a station
+	AddRoutingRule(ctx context.Context, stationID string, match RoutingMatch, value string) (*RoutingRule, error)
+
+	// DeleteRoutingRule removes a routing rule
+	DeleteRoutingRule(ctx context.Context, id string) error
+}
+
+// PrepTimeService defines the operations on the preparation times learned from past kitchen orders
+type PrepTimeService interface {
+	// ListPrepTimeStats retrieves the learned preparation times of a menu item, or of every menu item
+	ListPrepTimeStats(ctx context.Context, menuItemID string) (PrepTimeStats, error)
+
+	// EstimatePrepTime returns the expected preparation time of a menu item at a station in an hour of the day
+	EstimatePrepTime(ctx context.Context, menuItemID, station string, hour int) (*PrepTimeEstimate, error)
+
+	// RecomputePrepTimeStats learns preparation times from recent kitchen orders, replacing the previous ones
+	RecomputePrepTimeStats(ctx context.Context, now time.Time) (int, error)
+}
+
+// TicketSLAService defines the operations on the service levels of kitchen orders
+type TicketSLAService interface {
+	// CheckTickets escalates the kitchen orders that have newly breached their SLA and returns how many did
+	CheckTickets(ctx context.Context, now time.Time) (int, error)
+
+	// GetBreaches retrieves the active kitchen orders that are past their SLA
+	GetBreaches(ctx context.Context, now time.Time) ([]*TicketSLABreach, error)
+}
@@ -0,0 +1,120 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// TicketSLAPolicy sets how long a kitchen order may stay in each status before it breaches its service level
+type TicketSLAPolicy struct {
+	// Thresholds maps a kitchen order status to the longest a ticket may stay in it
+	Thresholds map[KitchenOrderStatus]time.Duration
+}
+
+// NewTicketSLAPolicy builds a policy from configured thresholds keyed by kitchen order status.
+// Keys are case-insensitive.
+func NewTicketSLAPolicy(thresholds map[string]time.Duration) (TicketSLAPolicy, error) {
+	policy := TicketSLAPolicy{
+		Thresholds: make(map[KitchenOrderStatus]time.Duration),
+	}
+
+	for statusKey, threshold := range thresholds {
+		status := KitchenOrderStatus(strings.ToUpper(statusKey))
+		switch status {
+		case KitchenOrderStatusNew, KitchenOrderStatusPreparing, KitchenOrderStatusReady:
+		default:
+			return TicketSLAPolicy{}, fmt.Errorf("invalid kitchen SLA status %q: only open statuses can have an SLA", statusKey)
+		}
+		if threshold <= 0 {
+			return TicketSLAPolicy{}, fmt.Errorf("invalid kitchen SLA threshold %s for %s: must be positive", threshold, status)
+		}
+		policy.Thresholds[status] = threshold
+	}
+
+	return policy, nil
+}
+
+// Evaluate returns a breach if the kitchen order has been in its current status for longer
+// than its threshold. Tickets whose every item is held for a later course are not monitored.
+func (p TicketSLAPolicy) Evaluate(order *KitchenOrder, now time.Time) *TicketSLABreach {
+	threshold, ok := p.Thresholds[order.Status]
+	if !ok || !order.hasFiredItems() {
+		return nil
+	}
+
+	enteredAt := order.statusEnteredAt()
+	if now.Sub(enteredAt) <= threshold {
+		return nil
+	}
+
+	return &TicketSLABreach{
+		KitchenOrderID: order.ID,
+		OrderID:        order.OrderID,
+		TableID:        order.TableID,
+		Status:         order.Status,
+		Priority:       order.Priority,
+		Threshold:      threshold,
+		EnteredAt:      enteredAt,
+		BreachedAt:     enteredAt.Add(threshold),
+	}
+}
+
+// TicketSLABreach records a kitchen order that has stayed in a status for longer than its SLA allows
+type TicketSLABreach struct {
+	KitchenOrderID KitchenOrderID     `json:"kitchen_order_id"`
+	OrderID        string             `json:"order_id"`
+	TableID        string             `json:"table_id,omitempty"`
+	Status         KitchenOrderStatus `json:"status"`
+	Priority       KitchenPriority    `json:"priority"`
+	Threshold      time.Duration      `json:"threshold"`
+	EnteredAt      time.Time          `json:"entered_at"`
+	BreachedAt     time.Time          `json:"breached_at"`
+}
+
+// Elapsed returns how long the kitchen order has been in the breached status
+func (b *TicketSLABreach) Elapsed(now time.Time) time.Duration {
+	return now.Sub(b.EnteredAt)
+}
+
+// hasFiredItems reports whether any item of the kitchen order has been sent to the line
+func (ko *KitchenOrder) hasFiredItems() bool {
+	for _, item := range ko.Items {
+		if !item.IsHeld() && item.Status != KitchenItemStatusCancelled {
+			return true
+		}
+	}
+	return false
+}
+
+// statusEnteredAt returns when the kitchen order entered its current status. A ticket moved
+// on by its items has no order-level timestamp, so the items' own start and completion are used.
+func (ko *KitchenOrder) statusEnteredAt() time.Time {
+	switch ko.Status {
+	case KitchenOrderStatusPreparing:
+		if !ko.StartedAt.IsZero() {
+			return ko.StartedAt
+		}
+		var first time.Time
+		for _, item := range ko.Items {
+			if !item.StartedAt.IsZero() && (first.IsZero() || item.StartedAt.Before(first)) {
+				first = item.StartedAt
+			}
+		}
+		if !first.IsZero() {
+			return first
+		}
+	case KitchenOrderStatusReady:
+		var last time.Time
+		for _, item := range ko.Items {
+			if item.Status == KitchenItemStatusReady && item.CompletedAt.After(last) {
+				last = item.CompletedAt
+			}
+		}
+		if !last.IsZero() {
+			return last
+		}
+		return ko.UpdatedAt
+	}
+	return ko.CreatedAt
+}
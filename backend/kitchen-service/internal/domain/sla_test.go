@@ -0,0 +1,125 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+// TicketSLATestSuite contains tests for kitchen order service levels
+type TicketSLATestSuite struct {
+	suite.Suite
+	policy TicketSLAPolicy
+	now    time.Time
+}
+
+func TestTicketSLATestSuite(t *testing.T) {
+	suite.Run(t, new(TicketSLATestSuite))
+}
+
+func (suite *TicketSLATestSuite) SetupTest() {
+	suite.now = time.Date(2026, 10, 19, 19, 0, 0, 0, time.UTC)
+	suite.policy, _ = NewTicketSLAPolicy(map[string]time.Duration{
+		"new":       5 * time.Minute,
+		"preparing": 20 * time.Minute,
+		"ready":     5 * time.Minute,
+	})
+}
+
+// ticket returns a kitchen order with a burger on the first course, created at the given time
+func (suite *TicketSLATestSuite) ticket(createdAt time.Time) *KitchenOrder {
+	order, _ := NewKitchenOrder("order-123", "table-4")
+	order.CreatedAt = createdAt
+	_ = order.AddCourseItem(DefaultCourse, "burger-1", "Burger", 1, 12*time.Minute, nil, nil, "")
+	return order
+}
+
+func (suite *TicketSLATestSuite) TestNewTicketSLAPolicy_InvalidStatus_ShouldFail() {
+	// When
+	_, err := NewTicketSLAPolicy(map[string]time.Duration{"served": time.Minute})
+
+	// Then
+	assert.Error(suite.T(), err)
+}
+
+func (suite *TicketSLATestSuite) TestNewTicketSLAPolicy_NonPositiveThreshold_ShouldFail() {
+	// When
+	_, err := NewTicketSLAPolicy(map[string]time.Duration{"new": 0})
+
+	// Then
+	assert.Error(suite.T(), err)
+}
+
+func (suite *TicketSLATestSuite) TestEvaluate_NewTicketPastThreshold_Breaches() {
+	// Given
+	order := suite.ticket(suite.now.Add(-6 * time.Minute))
+
+	// When
+	breach := suite.policy.Evaluate(order, suite.now)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NotNil(breach)
+	assert.Equal(KitchenOrderStatusNew, breach.Status)
+	assert.Equal(order.ID, breach.KitchenOrderID)
+	assert.Equal(suite.now.Add(-time.Minute), breach.BreachedAt)
+	assert.Equal(6*time.Minute, breach.Elapsed(suite.now))
+}
+
+func (suite *TicketSLATestSuite) TestEvaluate_WithinThreshold_DoesNotBreach() {
+	// Given
+	order := suite.ticket(suite.now.Add(-4 * time.Minute))
+
+	// Then
+	assert.Nil(suite.T(), suite.policy.Evaluate(order, suite.now))
+}
+
+func (suite *TicketSLATestSuite) TestEvaluate_PreparingTicket_MeasuredFromItemStart() {
+	// Given
+	order := suite.ticket(suite.now.Add(-40 * time.Minute))
+	order.Status = KitchenOrderStatusPreparing
+	order.Items[0].Status = KitchenItemStatusPreparing
+	order.Items[0].StartedAt = suite.now.Add(-15 * time.Minute)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.Nil(suite.policy.Evaluate(order, suite.now))
+	assert.NotNil(suite.policy.Evaluate(order, suite.now.Add(6*time.Minute)))
+}
+
+func (suite *TicketSLATestSuite) TestEvaluate_ReadyTicket_MeasuredFromLastItemReady() {
+	// Given
+	order := suite.ticket(suite.now.Add(-40 * time.Minute))
+	order.Status = KitchenOrderStatusReady
+	order.Items[0].Status = KitchenItemStatusReady
+	order.Items[0].CompletedAt = suite.now.Add(-7 * time.Minute)
+
+	// When
+	breach := suite.policy.Evaluate(order, suite.now)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NotNil(breach)
+	assert.Equal(suite.now.Add(-7*time.Minute), breach.EnteredAt)
+}
+
+func (suite *TicketSLATestSuite) TestEvaluate_OnlyHeldItems_IsNotMonitored() {
+	// Given
+	order, _ := NewKitchenOrder("order-123", "table-4")
+	order.CreatedAt = suite.now.Add(-30 * time.Minute)
+	_ = order.AddCourseItem(2, "cake-1", "Cake", 1, 5*time.Minute, nil, nil, "")
+
+	// Then
+	assert.Nil(suite.T(), suite.policy.Evaluate(order, suite.now))
+}
+
+func (suite *TicketSLATestSuite) TestEvaluate_StatusWithoutThreshold_IsNotMonitored() {
+	// Given
+	policy, _ := NewTicketSLAPolicy(map[string]time.Duration{"ready": 5 * time.Minute})
+	order := suite.ticket(suite.now.Add(-time.Hour))
+
+	// Then
+	assert.Nil(suite.T(), policy.Evaluate(order, suite.now))
+}
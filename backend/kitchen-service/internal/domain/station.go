@@ -0,0 +1,219 @@
+package domain
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/restaurant-platform/shared/pkg/errors"
+)
+
+var routingRuleCounter uint64
+
+// Standard stations of the line, seeded by migration
+const (
+	StationGrill  = "grill"
+	StationFry    = "fry"
+	StationSaute  = "saute"
+	StationCold   = "cold"
+	StationPastry = "pastry"
+	StationBar    = "bar"
+)
+
+// stationIDPattern keeps station IDs usable as URL path segments
+var stationIDPattern = regexp.MustCompile(`^[a-z0-9][a-z0-9-]*$`)
+
+// Station is a section of the line that prepares its share of each ticket
+type Station struct {
+	ID        string    `json:"id"`
+	Name      string    `json:"name"`
+	SortOrder int       `json:"sort_order"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// NewStation creates a station with a validated ID
+func NewStation(id, name string, sortOrder int) (*Station, error) {
+	if !stationIDPattern.MatchString(id) {
+		return nil, errors.WrapValidation("NewStation", "id", "station ID must be lowercase letters, digits and hyphens", nil)
+	}
+	if strings.TrimSpace(name) == "" {
+		return nil, errors.WrapValidation("NewStation", "name", "station name is required", nil)
+	}
+
+	return &Station{
+		ID:        id,
+		Name:      name,
+		SortOrder: sortOrder,
+		CreatedAt: time.Now(),
+	}, nil
+}
+
+// RoutingMatch is what a routing rule matches a kitchen item on
+type RoutingMatch string
+
+const (
+	RoutingMatchCategory RoutingMatch = "CATEGORY"
+	RoutingMatchMenuItem RoutingMatch = "MENU_ITEM"
+	RoutingMatchModifier RoutingMatch = "MODIFIER"
+)
+
+// routingPrecedence ranks matches from most to least specific
+var routingPrecedence = []RoutingMatch{RoutingMatchModifier, RoutingMatchMenuItem, RoutingMatchCategory}
+
+// RoutingRule sends kitchen items matching a menu category, a menu item or a chosen
+// modifier option to a station
+type RoutingRule struct {
+	ID        string       `json:"id"`
+	StationID string       `json:"station_id"`
+	Match     RoutingMatch `json:"match"`
+	Value     string       `json:"value"`
+	CreatedAt time.Time    `json:"created_at"`
+}
+
+// NewRoutingRule creates a routing rule with validated fields
+func NewRoutingRule(stationID string, match RoutingMatch, value string) (*RoutingRule, error) {
+	if stationID == "" {
+		return nil, errors.WrapValidation("NewRoutingRule", "stationID", "station ID is required", nil)
+	}
+	switch match {
+	case RoutingMatchCategory, RoutingMatchMenuItem, RoutingMatchModifier:
+	default:
+		return nil, errors.WrapValidation("NewRoutingRule", "match", "match must be CATEGORY, MENU_ITEM or MODIFIER", nil)
+	}
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return nil, errors.WrapValidation("NewRoutingRule", "value", "value is required", nil)
+	}
+
+	now := time.Now()
+	counter := atomic.AddUint64(&routingRuleCounter, 1)
+	return &RoutingRule{
+		ID:        fmt.Sprintf("rr_%d_%d", now.UnixNano(), counter),
+		StationID: stationID,
+		Match:     match,
+		Value:     value,
+		CreatedAt: now,
+	}, nil
+}
+
+// Matches reports whether the rule applies to a kitchen item. Categories match on ID or
+// name and modifiers on the chosen option, both ignoring case; menuItem may be nil when
+// the item is not in the menu read model.
+func (r *RoutingRule) Matches(item *KitchenItem, menuItem *MenuItem) bool {
+	switch r.Match {
+	case RoutingMatchCategory:
+		return menuItem != nil &&
+			(strings.EqualFold(r.Value, menuItem.CategoryID) || strings.EqualFold(r.Value, menuItem.CategoryName))
+	case RoutingMatchMenuItem:
+		return item.MenuItemID == r.Value
+	case RoutingMatchModifier:
+		for _, modifier := range item.Modifiers {
+			if strings.EqualFold(r.Value, modifier.Option) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// RoutingRules is the set of rules the kitchen routes items by
+type RoutingRules []*RoutingRule
+
+// StationFor returns the station a kitchen item is routed to, or "" when no rule matches.
+// A modifier rule wins over a menu item rule, which wins over a category rule; among
+// rules of the same kind the oldest wins.
+func (rules RoutingRules) StationFor(item *KitchenItem, menuItem *MenuItem) string {
+	for _, match := range routingPrecedence {
+		var chosen *RoutingRule
+		for _, rule := range rules {
+			if rule.Match != match || !rule.Matches(item, menuItem) {
+				continue
+			}
+			if chosen == nil || rule.CreatedAt.Before(chosen.CreatedAt) {
+				chosen = rule
+			}
+		}
+		if chosen != nil {
+			return chosen.StationID
+		}
+	}
+	return ""
+}
+
+// Route assigns the item to the station its routing rules pick, leaving it unrouted
+// when none match. It returns the station.
+func (ki *KitchenItem) Route(rules RoutingRules, menuItem *MenuItem) string {
+	if station := rules.StationFor(ki, menuItem); station != "" {
+		ki.AssignedStation = station
+	}
+	return ki.AssignedStation
+}
+
+// StationOf returns the station an item is prepared at: its own routing, or for an
+// unrouted item the station the whole order was assigned to
+func (ko *KitchenOrder) StationOf(item *KitchenItem) string {
+	if item.AssignedStation != "" {
+		return item.AssignedStation
+	}
+	return ko.AssignedStation
+}
+
+// AssignItemToStation reroutes one item of the kitchen order to another station
+func (ko *KitchenOrder) AssignItemToStation(itemID KitchenItemID, stationID string) (*KitchenItem, error) {
+	if stationID == "" {
+		return nil, errors.WrapValidation("AssignItemToStation", "stationID", "station ID is required", nil)
+	}
+
+	for _, item := range ko.Items {
+		if item.ID != itemID {
+			continue
+		}
+		if item.Status == KitchenItemStatusReady || item.Status == KitchenItemStatusCancelled {
+			return nil, errors.WrapConflict("AssignItemToStation", "status", "cannot reroute a ready or cancelled item", nil)
+		}
+
+		item.AssignedStation = stationID
+		ko.UpdatedAt = time.Now()
+		return item, nil
+	}
+	return nil, errors.WrapNotFound("AssignItemToStation", "kitchen item", string(itemID), errors.ErrNotFound)
+}
+
+// StationTicket returns a copy of the order holding only the items a station still has
+// to prepare: fired, not ready or cancelled, and prepared at that station. It returns
+// nil when the station has nothing left on this order.
+func (ko *KitchenOrder) StationTicket(stationID string) *KitchenOrder {
+	items := make([]*KitchenItem, 0, len(ko.Items))
+	for _, item := range ko.Items {
+		if item.IsHeld() || item.Status == KitchenItemStatusReady || item.Status == KitchenItemStatusCancelled {
+			continue
+		}
+		if ko.StationOf(item) == stationID {
+			items = append(items, item)
+		}
+	}
+	if len(items) == 0 {
+		return nil
+	}
+
+	view := *ko
+	view.Items = items
+	return &view
+}
+
+// Stations lists the stations the order's items are prepared at, in ticket order
+func (ko *KitchenOrder) Stations() []string {
+	seen := make(map[string]bool)
+	stations := make([]string, 0)
+	for _, item := range ko.Items {
+		station := ko.StationOf(item)
+		if station == "" || seen[station] {
+			continue
+		}
+		seen[station] = true
+		stations = append(stations, station)
+	}
+	return stations
+}
@@ -0,0 +1,170 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/restaurant-platform/shared/pkg/errors"
+)
+
+// StationTestSuite contains tests for routing ticket items to stations
+type StationTestSuite struct {
+	suite.Suite
+	order  *KitchenOrder
+	burger *KitchenItem
+	fries  *KitchenItem
+}
+
+func TestStationTestSuite(t *testing.T) {
+	suite.Run(t, new(StationTestSuite))
+}
+
+func (suite *StationTestSuite) SetupTest() {
+	suite.order, _ = NewKitchenOrder("order-123", "table-4")
+	suite.burger, _ = suite.order.AddOrderLine(&TicketLine{
+		OrderItemID: "item_burger", MenuItemID: "burger-1", Name: "Burger", Quantity: 1,
+		Modifiers: []*KitchenItemModifier{{Group: "Side", Option: "Onion rings"}},
+	}, 12*time.Minute)
+	suite.fries, _ = suite.order.AddOrderLine(&TicketLine{OrderItemID: "item_fries", MenuItemID: "fries-1", Name: "Fries", Quantity: 1}, 4*time.Minute)
+}
+
+func (suite *StationTestSuite) rule(stationID string, match RoutingMatch, value string) *RoutingRule {
+	rule, err := NewRoutingRule(stationID, match, value)
+	suite.Require().NoError(err)
+	return rule
+}
+
+func (suite *StationTestSuite) TestNewStation_InvalidID_ShouldFail() {
+	// When
+	_, err := NewStation("Wok Station", "Wok", 7)
+
+	// Then
+	assert.True(suite.T(), errors.IsValidationError(err))
+}
+
+func (suite *StationTestSuite) TestNewRoutingRule_InvalidMatch_ShouldFail() {
+	// When
+	_, err := NewRoutingRule(StationGrill, RoutingMatch("TABLE"), "table-1")
+
+	// Then
+	assert.True(suite.T(), errors.IsValidationError(err))
+}
+
+func (suite *StationTestSuite) TestStationFor_CategoryMatchesIDOrNameIgnoringCase() {
+	// Given
+	rules := RoutingRules{suite.rule(StationGrill, RoutingMatchCategory, "mains")}
+	byName := &MenuItem{ID: "burger-1", CategoryID: "cat_1", CategoryName: "Mains"}
+	byID := &MenuItem{ID: "burger-1", CategoryID: "MAINS"}
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.Equal(StationGrill, rules.StationFor(suite.burger, byName))
+	assert.Equal(StationGrill, rules.StationFor(suite.burger, byID))
+	assert.Empty(rules.StationFor(suite.burger, nil))
+}
+
+func (suite *StationTestSuite) TestStationFor_MostSpecificRuleWins() {
+	// Given
+	rules := RoutingRules{
+		suite.rule(StationGrill, RoutingMatchCategory, "Mains"),
+		suite.rule(StationSaute, RoutingMatchMenuItem, "burger-1"),
+		suite.rule(StationFry, RoutingMatchModifier, "onion rings"),
+	}
+	menuItem := &MenuItem{ID: "burger-1", CategoryName: "Mains"}
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.Equal(StationFry, rules.StationFor(suite.burger, menuItem))
+	assert.Equal(StationSaute, rules[:2].StationFor(suite.burger, menuItem))
+	assert.Equal(StationGrill, rules[:1].StationFor(suite.burger, menuItem))
+}
+
+func (suite *StationTestSuite) TestStationFor_OldestRuleOfAKindWins() {
+	// Given
+	older := suite.rule(StationGrill, RoutingMatchMenuItem, "burger-1")
+	newer := suite.rule(StationSaute, RoutingMatchMenuItem, "burger-1")
+	newer.CreatedAt = older.CreatedAt.Add(time.Minute)
+
+	// Then
+	assert.Equal(suite.T(), StationGrill, RoutingRules{newer, older}.StationFor(suite.burger, nil))
+}
+
+func (suite *StationTestSuite) TestRoute_NoMatch_LeavesItemUnrouted() {
+	// Given
+	rules := RoutingRules{suite.rule(StationPastry, RoutingMatchCategory, "Desserts")}
+
+	// When
+	station := suite.fries.Route(rules, &MenuItem{ID: "fries-1", CategoryName: "Sides"})
+
+	// Then
+	assert.Empty(suite.T(), station)
+	assert.Empty(suite.T(), suite.fries.AssignedStation)
+}
+
+func (suite *StationTestSuite) TestStationTicket_SplitsItemsByStation() {
+	// Given
+	suite.burger.AssignedStation = StationGrill
+	suite.fries.AssignedStation = StationFry
+
+	// When
+	grill := suite.order.StationTicket(StationGrill)
+	fry := suite.order.StationTicket(StationFry)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.Len(grill.Items, 1)
+	assert.Equal("Burger", grill.Items[0].Name)
+	assert.Len(fry.Items, 1)
+	assert.Equal("Fries", fry.Items[0].Name)
+	assert.Nil(suite.order.StationTicket(StationBar))
+	assert.Len(suite.order.Items, 2)
+}
+
+func (suite *StationTestSuite) TestStationTicket_UnroutedItemsFollowTheOrdersStation() {
+	// Given
+	suite.burger.AssignedStation = StationGrill
+	_ = suite.order.AssignToStation(StationSaute)
+
+	// When
+	ticket := suite.order.StationTicket(StationSaute)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.Len(ticket.Items, 1)
+	assert.Equal("Fries", ticket.Items[0].Name)
+	assert.Equal([]string{StationGrill, StationSaute}, suite.order.Stations())
+}
+
+func (suite *StationTestSuite) TestStationTicket_DropsReadyItems() {
+	// Given
+	suite.fries.AssignedStation = StationFry
+	_ = suite.order.UpdateItemStatus(suite.fries.ID, KitchenItemStatusPreparing)
+	_ = suite.order.UpdateItemStatus(suite.fries.ID, KitchenItemStatusReady)
+
+	// Then
+	assert.Nil(suite.T(), suite.order.StationTicket(StationFry))
+}
+
+func (suite *StationTestSuite) TestAssignItemToStation_Success() {
+	// When
+	item, err := suite.order.AssignItemToStation(suite.fries.ID, StationCold)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	assert.Equal(StationCold, item.AssignedStation)
+}
+
+func (suite *StationTestSuite) TestAssignItemToStation_CancelledItem_ShouldFail() {
+	// Given
+	_ = suite.order.UpdateItemStatus(suite.fries.ID, KitchenItemStatusCancelled)
+
+	// When
+	_, err := suite.order.AssignItemToStation(suite.fries.ID, StationCold)
+
+	// Then
+	assert.True(suite.T(), errors.IsConflictError(err))
+}
@@ -0,0 +1,90 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/restaurant-platform/shared/pkg/errors"
+)
+
+// TicketLine is an order line as order-service describes it to the kitchen
+type TicketLine struct {
+	OrderItemID   string
+	MenuItemID    string
+	Name          string
+	Quantity      int
+	Course        int
+	Fired         bool
+	Seat          int
+	Modifiers     []*KitchenItemModifier
+	Modifications []string
+	Notes         string
+}
+
+// AddOrderLine tickets an order line. Until the order goes to the line the item joins the
+// original ticket; afterwards it prints on a delta ticket like any other amendment.
+func (ko *KitchenOrder) AddOrderLine(line *TicketLine, prepTime time.Duration) (*KitchenItem, error) {
+	if ko.Status != KitchenOrderStatusNew {
+		return ko.AddAmendmentItem(line.OrderItemID, line.Course, line.Seat, line.MenuItemID, line.Name, line.Quantity,
+			prepTime, line.Modifiers, line.Modifications, line.Notes)
+	}
+	if line.OrderItemID == "" {
+		return nil, errors.WrapValidation("AddOrderLine", "orderItemID", "order item ID is required", nil)
+	}
+	if ko.findByOrderItemID(line.OrderItemID) != nil {
+		return nil, errors.WrapConflict("AddOrderLine", "orderItemID", "order item "+line.OrderItemID+" is already on the ticket", nil)
+	}
+
+	if err := ko.AddCourseItem(line.Course, line.MenuItemID, line.Name, line.Quantity, prepTime, line.Modifiers, line.Modifications, line.Notes); err != nil {
+		return nil, err
+	}
+
+	item := ko.Items[len(ko.Items)-1]
+	item.OrderItemID = line.OrderItemID
+	item.Seat = line.Seat
+
+	// A course fired on the order before it reached the kitchen goes straight to the line
+	if line.Fired && item.IsHeld() {
+		item.FiredAt = time.Now()
+		ko.recalculateEstimatedTime()
+	}
+	return item, nil
+}
+
+// RemoveOrderLine takes an order line off a ticket that has not gone to the line yet.
+// Once the line has the ticket, the item has to be voided instead.
+func (ko *KitchenOrder) RemoveOrderLine(orderItemID string) error {
+	item, err := ko.pendingOrderLine("RemoveOrderLine", orderItemID)
+	if err != nil {
+		return err
+	}
+	return ko.RemoveItem(item.ID)
+}
+
+// SetOrderLineQuantity changes the quantity of an order line on a ticket that has not gone to the line yet
+func (ko *KitchenOrder) SetOrderLineQuantity(orderItemID string, quantity int) (*KitchenItem, error) {
+	if quantity <= 0 {
+		return nil, errors.WrapValidation("SetOrderLineQuantity", "quantity", "quantity must be positive", nil)
+	}
+
+	item, err := ko.pendingOrderLine("SetOrderLineQuantity", orderItemID)
+	if err != nil {
+		return nil, err
+	}
+
+	item.Quantity = quantity
+	ko.UpdatedAt = time.Now()
+	return item, nil
+}
+
+// pendingOrderLine returns the kitchen item for an order line that may still be edited in place
+func (ko *KitchenOrder) pendingOrderLine(op, orderItemID string) (*KitchenItem, error) {
+	if ko.Status != KitchenOrderStatusNew {
+		return nil, errors.WrapConflict(op, "status", "ticket is already on the line; void the item instead", nil)
+	}
+
+	item := ko.findByOrderItemID(orderItemID)
+	if item == nil {
+		return nil, errors.WrapNotFound(op, "kitchen item", orderItemID, errors.ErrNotFound)
+	}
+	return item, nil
+}
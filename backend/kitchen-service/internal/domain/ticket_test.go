@@ -0,0 +1,113 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/restaurant-platform/shared/pkg/errors"
+)
+
+// TicketTestSuite contains tests for keeping a ticket in step with its order's lines
+type TicketTestSuite struct {
+	suite.Suite
+	order *KitchenOrder
+}
+
+func TestTicketTestSuite(t *testing.T) {
+	suite.Run(t, new(TicketTestSuite))
+}
+
+func (suite *TicketTestSuite) SetupTest() {
+	suite.order, _ = NewKitchenOrder("order-123", "table-4")
+	_, _ = suite.order.AddOrderLine(&TicketLine{OrderItemID: "item_burger", MenuItemID: "burger-1", Name: "Burger", Quantity: 1, Seat: 1}, 12*time.Minute)
+}
+
+func (suite *TicketTestSuite) TestAddOrderLine_NewTicket_JoinsOriginalTicket() {
+	// When
+	item, err := suite.order.AddOrderLine(&TicketLine{OrderItemID: "item_soup", MenuItemID: "soup-1", Name: "Soup", Quantity: 2, Course: 2}, 6*time.Minute)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	assert.Zero(item.Ticket)
+	assert.Equal(2, item.Course)
+	assert.True(item.IsHeld())
+	assert.Equal(12*time.Minute, suite.order.EstimatedTime)
+}
+
+func (suite *TicketTestSuite) TestAddOrderLine_FiredCourse_GoesStraightToTheLine() {
+	// When
+	item, err := suite.order.AddOrderLine(&TicketLine{OrderItemID: "item_steak", MenuItemID: "steak-1", Name: "Steak", Quantity: 1, Course: 2, Fired: true}, 20*time.Minute)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	assert.False(item.IsHeld())
+	assert.Equal(CourseStatusFired, suite.order.CourseStatus(2))
+	assert.Equal(20*time.Minute, suite.order.EstimatedTime)
+}
+
+func (suite *TicketTestSuite) TestAddOrderLine_OnTheLine_PrintsDeltaTicket() {
+	// Given
+	_ = suite.order.UpdateStatus(KitchenOrderStatusPreparing)
+
+	// When
+	item, err := suite.order.AddOrderLine(&TicketLine{OrderItemID: "item_fries", MenuItemID: "fries-1", Name: "Fries", Quantity: 1}, 4*time.Minute)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	assert.Equal(1, item.Ticket)
+}
+
+func (suite *TicketTestSuite) TestAddOrderLine_Duplicate_ShouldFail() {
+	// When
+	_, err := suite.order.AddOrderLine(&TicketLine{OrderItemID: "item_burger", MenuItemID: "burger-1", Name: "Burger", Quantity: 1}, 12*time.Minute)
+
+	// Then
+	assert.True(suite.T(), errors.IsConflictError(err))
+	assert.Len(suite.T(), suite.order.Items, 1)
+}
+
+func (suite *TicketTestSuite) TestRemoveOrderLine_RecalculatesEstimate() {
+	// When
+	err := suite.order.RemoveOrderLine("item_burger")
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	assert.Empty(suite.order.Items)
+	assert.Zero(suite.order.EstimatedTime)
+}
+
+func (suite *TicketTestSuite) TestRemoveOrderLine_OnTheLine_ShouldFail() {
+	// Given
+	_ = suite.order.UpdateStatus(KitchenOrderStatusPreparing)
+
+	// When
+	err := suite.order.RemoveOrderLine("item_burger")
+
+	// Then
+	assert.True(suite.T(), errors.IsConflictError(err))
+}
+
+func (suite *TicketTestSuite) TestSetOrderLineQuantity_Success() {
+	// When
+	item, err := suite.order.SetOrderLineQuantity("item_burger", 3)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	assert.Equal(3, item.Quantity)
+}
+
+func (suite *TicketTestSuite) TestSetOrderLineQuantity_UnknownLine_ShouldFail() {
+	// When
+	_, err := suite.order.SetOrderLineQuantity("item_missing", 3)
+
+	// Then
+	assert.True(suite.T(), errors.IsNotFound(err))
+}
@@ -0,0 +1,85 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/restaurant-platform/shared/pkg/errors"
+)
+
+// TransferTable moves the ticket to the table its order was transferred to
+func (ko *KitchenOrder) TransferTable(tableID string) error {
+	if ko.Status == KitchenOrderStatusCompleted || ko.Status == KitchenOrderStatusCancelled {
+		return errors.WrapConflict("TransferTable", "status", "cannot transfer a completed or cancelled order", nil)
+	}
+	if tableID == "" {
+		return errors.WrapValidation("TransferTable", "tableID", "table ID is required", nil)
+	}
+
+	ko.TableID = tableID
+	ko.UpdatedAt = time.Now()
+	return nil
+}
+
+// ItemsForOrderLines returns the kitchen items of the given order lines that are on the ticket
+func (ko *KitchenOrder) ItemsForOrderLines(orderItemIDs []string) []*KitchenItem {
+	var items []*KitchenItem
+	for _, orderItemID := range orderItemIDs {
+		if item := ko.findByOrderItemID(orderItemID); item != nil {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// ReceiveItems takes over kitchen items moved from another order's ticket. They print
+// as a delta ticket for this table; items already on the ticket are skipped so that a
+// redelivered move is harmless.
+func (ko *KitchenOrder) ReceiveItems(items []*KitchenItem) error {
+	if ko.Status == KitchenOrderStatusCompleted || ko.Status == KitchenOrderStatusCancelled {
+		return errors.WrapConflict("ReceiveItems", "status", "cannot move items onto a completed or cancelled order", nil)
+	}
+
+	ticket := ko.LastTicket() + 1
+	for _, item := range items {
+		if ko.findByOrderItemID(item.OrderItemID) != nil {
+			continue
+		}
+		item.Ticket = ticket
+		ko.Items = append(ko.Items, item)
+
+		if item.Status != KitchenItemStatusReady && item.Status != KitchenItemStatusCancelled && !item.IsHeld() {
+			ko.reopen()
+		}
+	}
+
+	ko.recalculateEstimatedTime()
+	ko.UpdatedAt = time.Now()
+	return nil
+}
+
+// ReleaseItems removes the kitchen items of order lines that moved to another order.
+// Lines that are not on the ticket are ignored.
+func (ko *KitchenOrder) ReleaseItems(orderItemIDs []string) {
+	releasing := make(map[string]bool, len(orderItemIDs))
+	for _, orderItemID := range orderItemIDs {
+		releasing[orderItemID] = true
+	}
+
+	kept := make([]*KitchenItem, 0, len(ko.Items))
+	for _, item := range ko.Items {
+		if item.OrderItemID == "" || !releasing[item.OrderItemID] {
+			kept = append(kept, item)
+		}
+	}
+	if len(kept) == len(ko.Items) {
+		return
+	}
+
+	ko.Items = kept
+	// An emptied ticket keeps its status; merged orders are cancelled separately
+	if len(ko.Items) > 0 {
+		ko.updateOrderStatus()
+	}
+	ko.recalculateEstimatedTime()
+	ko.UpdatedAt = time.Now()
+}
@@ -0,0 +1,118 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/restaurant-platform/shared/pkg/errors"
+)
+
+// TransferTestSuite contains table transfer and item move tests
+type TransferTestSuite struct {
+	suite.Suite
+	source *KitchenOrder
+	target *KitchenOrder
+}
+
+func TestTransferTestSuite(t *testing.T) {
+	suite.Run(t, new(TransferTestSuite))
+}
+
+func (suite *TransferTestSuite) SetupTest() {
+	suite.source, _ = NewKitchenOrder("order-123", "table-4")
+	_, _ = suite.source.AddAmendmentItem("item_burger", 1, 0, "burger-1", "Burger", 1, 12*time.Minute, nil, nil, "")
+	_, _ = suite.source.AddAmendmentItem("item_salad", 1, 0, "salad-1", "Salad", 1, 5*time.Minute, nil, nil, "")
+
+	suite.target, _ = NewKitchenOrder("order-456", "table-7")
+	_, _ = suite.target.AddAmendmentItem("item_soup", 1, 0, "soup-1", "Soup", 1, 3*time.Minute, nil, nil, "")
+}
+
+func (suite *TransferTestSuite) TestTransferTable_Success() {
+	// When
+	err := suite.source.TransferTable("table-9")
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	assert.Equal("table-9", suite.source.TableID)
+}
+
+func (suite *TransferTestSuite) TestTransferTable_CancelledOrder_ShouldFail() {
+	// Given
+	_ = suite.source.Cancel()
+
+	// When
+	err := suite.source.TransferTable("table-9")
+
+	// Then
+	assert.True(suite.T(), errors.IsConflictError(err))
+}
+
+func (suite *TransferTestSuite) TestReceiveItems_PrintsDeltaTicket() {
+	// Given
+	items := suite.source.ItemsForOrderLines([]string{"item_burger"})
+
+	// When
+	err := suite.target.ReceiveItems(items)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	assert.Len(suite.target.Items, 2)
+	assert.Equal(2, suite.target.Items[1].Ticket)
+	assert.Equal(12*time.Minute, suite.target.EstimatedTime)
+}
+
+func (suite *TransferTestSuite) TestReceiveItems_Redelivered_IsSkipped() {
+	// Given
+	items := suite.source.ItemsForOrderLines([]string{"item_burger"})
+	_ = suite.target.ReceiveItems(items)
+
+	// When
+	err := suite.target.ReceiveItems(items)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	assert.Len(suite.target.Items, 2)
+}
+
+func (suite *TransferTestSuite) TestReceiveItems_ReadyOrderGoesBackToPreparing() {
+	// Given
+	soup := suite.target.Items[0].ID
+	_ = suite.target.UpdateItemStatus(soup, KitchenItemStatusPreparing)
+	_ = suite.target.UpdateItemStatus(soup, KitchenItemStatusReady)
+	suite.Require().Equal(KitchenOrderStatusReady, suite.target.Status)
+
+	// When
+	err := suite.target.ReceiveItems(suite.source.ItemsForOrderLines([]string{"item_salad"}))
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	assert.Equal(KitchenOrderStatusPreparing, suite.target.Status)
+}
+
+func (suite *TransferTestSuite) TestReleaseItems_RemovesLinesAndRecalculates() {
+	// When
+	suite.source.ReleaseItems([]string{"item_burger", "item_unknown"})
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.Len(suite.source.Items, 1)
+	assert.Equal("item_salad", suite.source.Items[0].OrderItemID)
+	assert.Equal(5*time.Minute, suite.source.EstimatedTime)
+}
+
+func (suite *TransferTestSuite) TestReleaseItems_AllItems_KeepsStatus() {
+	// When
+	suite.source.ReleaseItems([]string{"item_burger", "item_salad"})
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.Empty(suite.source.Items)
+	assert.Equal(KitchenOrderStatusNew, suite.source.Status)
+}
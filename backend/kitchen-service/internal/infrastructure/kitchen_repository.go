@@ -31,14 +31,18 @@ func (r *KitchenOrderRepository) Save(ctx context.Context, order *domain.Kitchen
 	if err != nil {
 		return fmt.Errorf("failed to marshal items: %w", err)
 	}
+	bumpsJSON, err := json.Marshal(order.Bumps)
+	if err != nil {
+		return fmt.Errorf("failed to marshal bumps: %w", err)
+	}
 
 	query := `
 		INSERT INTO kitchen_orders (
 			id, order_id, table_id, status, items, priority, 
 			assigned_station, estimated_time, started_at, 
-			completed_at, notes, created_at, updated_at
+			completed_at, served_at, bumps, notes, version, created_at, updated_at
 		) VALUES (
-			?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?
+			?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?
 		)`
 
 	_, err = r.db.ExecContext(ctx, query,
@@ -52,7 +56,10 @@ func (r *KitchenOrderRepository) Save(ctx context.Context, order *domain.Kitchen
 		int64(order.EstimatedTime.Seconds()),
 		nullTimeOrValue(order.StartedAt),
 		nullTimeOrValue(order.CompletedAt),
+		nullTimeOrValue(order.ServedAt),
+		bumpsJSON,
 		order.Notes,
+		order.Version,
 		order.CreatedAt,
 		order.UpdatedAt,
 	)
@@ -69,7 +76,7 @@ func (r *KitchenOrderRepository) FindByID(ctx context.Context, id domain.Kitchen
 	query := `
 		SELECT id, order_id, table_id, status, items, priority, 
 		       assigned_station, estimated_time, started_at, 
-		       completed_at, notes, created_at, updated_at
+		       completed_at, served_at, bumps, notes, version, created_at, updated_at
 		FROM kitchen_orders 
 		WHERE id = ?`
 
@@ -82,7 +89,7 @@ func (r *KitchenOrderRepository) FindByOrderID(ctx context.Context, orderID stri
 	query := `
 		SELECT id, order_id, table_id, status, items, priority, 
 		       assigned_station, estimated_time, started_at, 
-		       completed_at, notes, created_at, updated_at
+		       completed_at, served_at, bumps, notes, version, created_at, updated_at
 		FROM kitchen_orders 
 		WHERE order_id = ?`
 
@@ -97,6 +104,10 @@ func (r *KitchenOrderRepository) Update(ctx context.Context, order *domain.Kitch
 	if err != nil {
 		return fmt.Errorf("failed to marshal items: %w", err)
 	}
+	bumpsJSON, err := json.Marshal(order.Bumps)
+	if err != nil {
+		return fmt.Errorf("failed to marshal bumps: %w", err)
+	}
 
 	query := `
 		UPDATE kitchen_orders SET
@@ -109,9 +120,12 @@ func (r *KitchenOrderRepository) Update(ctx context.Context, order *domain.Kitch
 			estimated_time = ?,
 			started_at = ?,
 			completed_at = ?,
+			served_at = ?,
+			bumps = ?,
 			notes = ?,
-			updated_at = ?
-		WHERE id = ?`
+			updated_at = ?,
+			version = version + 1
+		WHERE id = ? AND version = ?`
 
 	result, err := r.db.ExecContext(ctx, query,
 		order.OrderID,
@@ -123,9 +137,12 @@ func (r *KitchenOrderRepository) Update(ctx context.Context, order *domain.Kitch
 		int64(order.EstimatedTime.Seconds()),
 		nullTimeOrValue(order.StartedAt),
 		nullTimeOrValue(order.CompletedAt),
+		nullTimeOrValue(order.ServedAt),
+		bumpsJSON,
 		order.Notes,
 		order.UpdatedAt,
-		string(order.ID), // ID is now the last parameter for WHERE clause
+		string(order.ID),
+		order.Version,
 	)
 
 	if err != nil {
@@ -138,9 +155,19 @@ func (r *KitchenOrderRepository) Update(ctx context.Context, order *domain.Kitch
 	}
 
 	if rowsAffected == 0 {
-		return errors.WrapNotFound("UpdateKitchenOrder", "kitchen_order", string(order.ID), errors.ErrNotFound)
+		// Either the order is gone or another writer saved a newer version since it was loaded
+		var exists bool
+		err := r.db.QueryRowContext(ctx, `SELECT EXISTS(SELECT 1 FROM kitchen_orders WHERE id = ?)`, string(order.ID)).Scan(&exists)
+		if err != nil {
+			return fmt.Errorf("failed to check kitchen order: %w", err)
+		}
+		if !exists {
+			return errors.WrapNotFound("UpdateKitchenOrder", "kitchen_order", string(order.ID), errors.ErrNotFound)
+		}
+		return errors.WrapVersionConflict("UpdateKitchenOrder", "kitchen_order", string(order.ID), order.Version)
 	}
 
+	order.Version++
 	return nil
 }
 
@@ -170,7 +197,7 @@ func (r *KitchenOrderRepository) FindByStatus(ctx context.Context, status domain
 	query := `
 		SELECT id, order_id, table_id, status, items, priority, 
 		       assigned_station, estimated_time, started_at, 
-		       completed_at, notes, created_at, updated_at
+		       completed_at, served_at, bumps, notes, version, created_at, updated_at
 		FROM kitchen_orders 
 		WHERE status = ?
 		ORDER BY created_at ASC`
@@ -189,7 +216,7 @@ func (r *KitchenOrderRepository) FindByStation(ctx context.Context, stationID st
 	query := `
 		SELECT id, order_id, table_id, status, items, priority, 
 		       assigned_station, estimated_time, started_at, 
-		       completed_at, notes, created_at, updated_at
+		       completed_at, served_at, bumps, notes, version, created_at, updated_at
 		FROM kitchen_orders 
 		WHERE assigned_station = ?
 		ORDER BY 
@@ -211,14 +238,14 @@ func (r *KitchenOrderRepository) FindByStation(ctx context.Context, stationID st
 	return r.scanKitchenOrders(rows)
 }
 
-// FindActive retrieves all active kitchen orders (not completed or cancelled)
+// FindActive retrieves all active kitchen orders (not served, completed or cancelled)
 func (r *KitchenOrderRepository) FindActive(ctx context.Context) ([]*domain.KitchenOrder, error) {
 	query := `
 		SELECT id, order_id, table_id, status, items, priority, 
 		       assigned_station, estimated_time, started_at, 
-		       completed_at, notes, created_at, updated_at
+		       completed_at, served_at, bumps, notes, version, created_at, updated_at
 		FROM kitchen_orders 
-		WHERE status NOT IN ('COMPLETED', 'CANCELLED')
+		WHERE status NOT IN ('SERVED', 'COMPLETED', 'CANCELLED')
 		ORDER BY 
 			CASE priority 
 				WHEN 'URGENT' THEN 1 
@@ -238,6 +265,25 @@ func (r *KitchenOrderRepository) FindActive(ctx context.Context) ([]*domain.Kitc
 	return r.scanKitchenOrders(rows)
 }
 
+// FindServedSince retrieves kitchen orders bumped by expo at or after the given time, most recent first
+func (r *KitchenOrderRepository) FindServedSince(ctx context.Context, since time.Time) ([]*domain.KitchenOrder, error) {
+	query := `
+		SELECT id, order_id, table_id, status, items, priority, 
+		       assigned_station, estimated_time, started_at, 
+		       completed_at, served_at, bumps, notes, version, created_at, updated_at
+		FROM kitchen_orders 
+		WHERE status = 'SERVED' AND served_at >= ?
+		ORDER BY served_at DESC`
+
+	rows, err := r.db.QueryContext(ctx, query, since)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query served kitchen orders: %w", err)
+	}
+	defer rows.Close()
+
+	return r.scanKitchenOrders(rows)
+}
+
 // List retrieves kitchen orders with pagination and filters
 func (r *KitchenOrderRepository) List(ctx context.Context, offset, limit int, filters domain.KitchenOrderFilters) ([]*domain.KitchenOrder, int, error) {
 	// Build WHERE clause based on filters
@@ -298,7 +344,7 @@ func (r *KitchenOrderRepository) List(ctx context.Context, offset, limit int, fi
 	query := fmt.Sprintf(`
 		SELECT id, order_id, table_id, status, items, priority, 
 		       assigned_station, estimated_time, started_at, 
-		       completed_at, notes, created_at, updated_at
+		       completed_at, served_at, bumps, notes, version, created_at, updated_at
 		FROM kitchen_orders 
 		%s
 		ORDER BY 
@@ -394,7 +440,8 @@ func (r *KitchenOrderRepository) scanKitchenOrder(row *sql.Row) (*domain.Kitchen
 	var idStr string
 	var itemsJSON []byte
 	var estimatedTimeSeconds int64
-	var startedAt, completedAt sql.NullTime
+	var startedAt, completedAt, servedAt sql.NullTime
+	var bumpsJSON []byte
 
 	err := row.Scan(
 		&idStr,
@@ -407,7 +454,10 @@ func (r *KitchenOrderRepository) scanKitchenOrder(row *sql.Row) (*domain.Kitchen
 		&estimatedTimeSeconds,
 		&startedAt,
 		&completedAt,
+		&servedAt,
+		&bumpsJSON,
 		&order.Notes,
+		&order.Version,
 		&order.CreatedAt,
 		&order.UpdatedAt,
 	)
@@ -426,6 +476,11 @@ func (r *KitchenOrderRepository) scanKitchenOrder(row *sql.Row) (*domain.Kitchen
 	if err := json.Unmarshal(itemsJSON, &order.Items); err != nil {
 		return nil, fmt.Errorf("failed to unmarshal items: %w", err)
 	}
+	if len(bumpsJSON) > 0 {
+		if err := json.Unmarshal(bumpsJSON, &order.Bumps); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal bumps: %w", err)
+		}
+	}
 
 	// Convert time fields
 	order.EstimatedTime = time.Duration(estimatedTimeSeconds) * time.Second
@@ -435,6 +490,9 @@ func (r *KitchenOrderRepository) scanKitchenOrder(row *sql.Row) (*domain.Kitchen
 	if completedAt.Valid {
 		order.CompletedAt = completedAt.Time
 	}
+	if servedAt.Valid {
+		order.ServedAt = servedAt.Time
+	}
 
 	return &order, nil
 }
@@ -447,7 +505,8 @@ func (r *KitchenOrderRepository) scanKitchenOrders(rows *sql.Rows) ([]*domain.Ki
 		var idStr string
 		var itemsJSON []byte
 		var estimatedTimeSeconds int64
-		var startedAt, completedAt sql.NullTime
+		var startedAt, completedAt, servedAt sql.NullTime
+		var bumpsJSON []byte
 
 		err := rows.Scan(
 			&idStr,
@@ -460,7 +519,10 @@ func (r *KitchenOrderRepository) scanKitchenOrders(rows *sql.Rows) ([]*domain.Ki
 			&estimatedTimeSeconds,
 			&startedAt,
 			&completedAt,
+			&servedAt,
+			&bumpsJSON,
 			&order.Notes,
+			&order.Version,
 			&order.CreatedAt,
 			&order.UpdatedAt,
 		)
@@ -476,6 +538,11 @@ func (r *KitchenOrderRepository) scanKitchenOrders(rows *sql.Rows) ([]*domain.Ki
 		if err := json.Unmarshal(itemsJSON, &order.Items); err != nil {
 			return nil, fmt.Errorf("failed to unmarshal items: %w", err)
 		}
+		if len(bumpsJSON) > 0 {
+			if err := json.Unmarshal(bumpsJSON, &order.Bumps); err != nil {
+				return nil, fmt.Errorf("failed to unmarshal bumps: %w", err)
+			}
+		}
 
 		// Convert time fields
 		order.EstimatedTime = time.Duration(estimatedTimeSeconds) * time.Second
@@ -485,6 +552,9 @@ func (r *KitchenOrderRepository) scanKitchenOrders(rows *sql.Rows) ([]*domain.Ki
 		if completedAt.Valid {
 			order.CompletedAt = completedAt.Time
 		}
+		if servedAt.Valid {
+			order.ServedAt = servedAt.Time
+		}
 
 		orders = append(orders, &order)
 	}
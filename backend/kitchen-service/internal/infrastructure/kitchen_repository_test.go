@@ -11,6 +11,7 @@ import (
 	"github.com/stretchr/testify/suite"
 
 	"github.com/restaurant-platform/kitchen-service/internal/domain"
+	sharedErrors "github.com/restaurant-platform/shared/pkg/errors"
 
 	_ "github.com/mattn/go-sqlite3"
 )
@@ -65,7 +66,10 @@ func (suite *KitchenOrderRepositoryTestSuite) createSchema() {
 			estimated_time INTEGER NOT NULL, -- seconds
 			started_at DATETIME,
 			completed_at DATETIME,
+			served_at DATETIME,
+			bumps TEXT, -- JSON text for SQLite
 			notes TEXT,
+			version INTEGER NOT NULL DEFAULT 1,
 			created_at DATETIME NOT NULL,
 			updated_at DATETIME NOT NULL
 		)`
@@ -216,6 +220,31 @@ func (suite *KitchenOrderRepositoryTestSuite) TestUpdate_Success() {
 	assert.Equal("pizza-station", updatedOrder.AssignedStation)
 	assert.Equal(domain.KitchenOrderStatusPreparing, updatedOrder.Status)
 	assert.False(updatedOrder.StartedAt.IsZero())
+	assert.Equal(2, updatedOrder.Version)
+}
+
+func (suite *KitchenOrderRepositoryTestSuite) TestUpdate_StaleVersion_ShouldConflict() {
+	// Given
+	order, _ := domain.NewKitchenOrder("order-123", "table-5")
+	suite.repo.Save(suite.ctx, order)
+
+	first, _ := suite.repo.FindByID(suite.ctx, order.ID)
+	second, _ := suite.repo.FindByID(suite.ctx, order.ID)
+	first.SetPriority(domain.KitchenPriorityUrgent)
+	assert.NoError(suite.T(), suite.repo.Update(suite.ctx, first))
+
+	// When
+	second.AssignToStation("grill-station")
+	err := suite.repo.Update(suite.ctx, second)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.True(sharedErrors.IsVersionConflict(err))
+	assert.False(sharedErrors.IsNotFound(err))
+
+	stored, _ := suite.repo.FindByID(suite.ctx, order.ID)
+	assert.Equal(domain.KitchenPriorityUrgent, stored.Priority)
+	assert.Empty(stored.AssignedStation)
 }
 
 func (suite *KitchenOrderRepositoryTestSuite) TestUpdate_NotFound_ShouldFail() {
@@ -229,6 +258,7 @@ func (suite *KitchenOrderRepositoryTestSuite) TestUpdate_NotFound_ShouldFail() {
 	// Then
 	assert := assert.New(suite.T())
 	assert.Error(err)
+	assert.True(sharedErrors.IsNotFound(err))
 }
 
 // Test Delete operation
@@ -373,6 +403,48 @@ func (suite *KitchenOrderRepositoryTestSuite) TestFindActive_Success() {
 	assert.Equal(domain.KitchenPriorityNormal, activeOrders[1].Priority) // order-1 (normal priority)
 }
 
+// Test FindServedSince operation
+func (suite *KitchenOrderRepositoryTestSuite) TestFindServedSince_ReturnsRecentlyServedWithBumps() {
+	// Given
+	recent, _ := domain.NewKitchenOrder("order-1", "table-1")
+	recent.AddItem("item-1", "Steak", 1, 15*time.Minute, nil, "")
+	recent.Items[0].AssignedStation = domain.StationGrill
+	_, err := recent.BumpStation(domain.StationGrill, "cook-1")
+	suite.Require().NoError(err)
+	_, err = recent.BumpExpo("expo-1")
+	suite.Require().NoError(err)
+
+	earlier, _ := domain.NewKitchenOrder("order-2", "table-2")
+	earlier.Status = domain.KitchenOrderStatusServed
+	earlier.ServedAt = time.Now().Add(-time.Hour)
+
+	active, _ := domain.NewKitchenOrder("order-3", "table-3")
+
+	suite.Require().NoError(suite.repo.Save(suite.ctx, recent))
+	suite.Require().NoError(suite.repo.Save(suite.ctx, earlier))
+	suite.Require().NoError(suite.repo.Save(suite.ctx, active))
+
+	// When
+	served, err := suite.repo.FindServedSince(suite.ctx, time.Now().Add(-domain.RecallWindow))
+	activeOrders, activeErr := suite.repo.FindActive(suite.ctx)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	assert.Len(served, 1)
+	assert.Equal("order-1", served[0].OrderID)
+	assert.False(served[0].ServedAt.IsZero())
+	assert.Len(served[0].Bumps, 2)
+	assert.Equal(domain.BumpActionStation, served[0].Bumps[0].Action)
+	assert.Equal("cook-1", served[0].Bumps[0].UserID)
+	assert.Equal([]domain.KitchenItemID{recent.Items[0].ID}, served[0].Bumps[0].ItemIDs)
+	assert.Equal(domain.BumpActionExpo, served[0].Bumps[1].Action)
+
+	assert.NoError(activeErr)
+	assert.Len(activeOrders, 1)
+	assert.Equal("order-3", activeOrders[0].OrderID)
+}
+
 // Test List operation with pagination and filters
 func (suite *KitchenOrderRepositoryTestSuite) TestList_WithFilters() {
 	// Given - Create multiple orders
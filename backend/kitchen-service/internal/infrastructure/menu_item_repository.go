@@ -0,0 +1,114 @@
+package infrastructure
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/restaurant-platform/kitchen-service/internal/domain"
+	"github.com/restaurant-platform/shared/pkg/errors"
+)
+
+// MenuItemRepository stores the local menu read model
+type MenuItemRepository struct {
+	db *sql.DB
+}
+
+// NewMenuItemRepository creates a new menu item repository
+func NewMenuItemRepository(db *sql.DB) *MenuItemRepository {
+	return &MenuItemRepository{
+		db: db,
+	}
+}
+
+// GetByID retrieves a menu item by its menu-service ID
+func (r *MenuItemRepository) GetByID(ctx context.Context, id string) (*domain.MenuItem, error) {
+	query := `
+		SELECT id, menu_id, name, category_id, category_name, prep_time_seconds, updated_at
+		FROM menu_items WHERE id = $1`
+
+	var item domain.MenuItem
+	var categoryID, categoryName sql.NullString
+	var prepTimeSeconds int64
+
+	err := r.db.QueryRowContext(ctx, query, id).Scan(
+		&item.ID, &item.MenuID, &item.Name, &categoryID, &categoryName, &prepTimeSeconds, &item.UpdatedAt)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, errors.WrapNotFound("MenuItemRepository.GetByID", "menu_item", id, err)
+		}
+		return nil, fmt.Errorf("failed to get menu item: %w", err)
+	}
+
+	item.CategoryID = categoryID.String
+	item.CategoryName = categoryName.String
+	item.PrepTime = time.Duration(prepTimeSeconds) * time.Second
+	return &item, nil
+}
+
+// Upsert creates or replaces a menu item
+func (r *MenuItemRepository) Upsert(ctx context.Context, item *domain.MenuItem) error {
+	return upsertMenuItem(ctx, r.db, item)
+}
+
+// Delete removes a menu item
+func (r *MenuItemRepository) Delete(ctx context.Context, id string) error {
+	_, err := r.db.ExecContext(ctx, `DELETE FROM menu_items WHERE id = $1`, id)
+	return err
+}
+
+// ReplaceMenu replaces every item belonging to a menu with the given snapshot
+func (r *MenuItemRepository) ReplaceMenu(ctx context.Context, menuID string, items []*domain.MenuItem) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM menu_items WHERE menu_id = $1`, menuID); err != nil {
+		return fmt.Errorf("failed to clear menu items: %w", err)
+	}
+
+	for _, item := range items {
+		if err := upsertMenuItem(ctx, tx, item); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// DeleteByMenu removes every item belonging to a menu
+func (r *MenuItemRepository) DeleteByMenu(ctx context.Context, menuID string) error {
+	_, err := r.db.ExecContext(ctx, `DELETE FROM menu_items WHERE menu_id = $1`, menuID)
+	return err
+}
+
+// Helper functions
+
+type execer interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+}
+
+func upsertMenuItem(ctx context.Context, db execer, item *domain.MenuItem) error {
+	query := `
+		INSERT INTO menu_items (
+			id, menu_id, name, category_id, category_name, prep_time_seconds, updated_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7)
+		ON CONFLICT (id) DO UPDATE SET
+			menu_id = EXCLUDED.menu_id,
+			name = EXCLUDED.name,
+			category_id = EXCLUDED.category_id,
+			category_name = EXCLUDED.category_name,
+			prep_time_seconds = EXCLUDED.prep_time_seconds,
+			updated_at = EXCLUDED.updated_at`
+
+	_, err := db.ExecContext(ctx, query,
+		item.ID, item.MenuID, item.Name, item.CategoryID, item.CategoryName,
+		int64(item.PrepTime.Seconds()), item.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to upsert menu item: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,97 @@
+package infrastructure
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/restaurant-platform/kitchen-service/internal/domain"
+	"github.com/restaurant-platform/shared/pkg/errors"
+)
+
+// MenuServiceCatalog reads the active menu from the menu service's REST API
+type MenuServiceCatalog struct {
+	baseURL string
+	client  *http.Client
+}
+
+// NewMenuServiceCatalog creates a catalog for the menu service at baseURL
+func NewMenuServiceCatalog(baseURL string, client *http.Client) (*MenuServiceCatalog, error) {
+	if _, err := url.ParseRequestURI(baseURL); err != nil {
+		return nil, fmt.Errorf("menu service URL is invalid: %w", err)
+	}
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	return &MenuServiceCatalog{
+		baseURL: strings.TrimRight(baseURL, "/"),
+		client:  client,
+	}, nil
+}
+
+// menuServiceMenu is the part of the menu service's menu response the read model needs
+type menuServiceMenu struct {
+	ID         string                `json:"id"`
+	Categories []menuServiceCategory `json:"categories"`
+}
+
+type menuServiceCategory struct {
+	ID    string            `json:"id"`
+	Name  string            `json:"name"`
+	Items []menuServiceItem `json:"items"`
+}
+
+type menuServiceItem struct {
+	ID              string        `json:"id"`
+	Name            string        `json:"name"`
+	PreparationTime time.Duration `json:"preparation_time"`
+	UpdatedAt       time.Time     `json:"updated_at"`
+}
+
+// ActiveMenu returns the ID and items of the menu currently on sale
+func (c *MenuServiceCatalog) ActiveMenu(ctx context.Context) (string, []*domain.MenuItem, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseURL+"/api/v1/menus/active", nil)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to create menu request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to reach menu service: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return "", nil, errors.WrapNotFound("MenuServiceCatalog.ActiveMenu", "menu", "active", errors.ErrNotFound)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return "", nil, fmt.Errorf("menu service rejected request with %s", resp.Status)
+	}
+
+	var menu menuServiceMenu
+	if err := json.NewDecoder(resp.Body).Decode(&menu); err != nil {
+		return "", nil, fmt.Errorf("failed to decode menu service response: %w", err)
+	}
+
+	var items []*domain.MenuItem
+	for _, category := range menu.Categories {
+		for _, data := range category.Items {
+			items = append(items, &domain.MenuItem{
+				ID:           data.ID,
+				MenuID:       menu.ID,
+				Name:         data.Name,
+				CategoryID:   category.ID,
+				CategoryName: category.Name,
+				PrepTime:     data.PreparationTime,
+				UpdatedAt:    data.UpdatedAt,
+			})
+		}
+	}
+	return menu.ID, items, nil
+}
@@ -0,0 +1,53 @@
+package infrastructure
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sharedErrors "github.com/restaurant-platform/shared/pkg/errors"
+)
+
+func TestMenuServiceCatalog_ActiveMenu_FlattensCategories(t *testing.T) {
+	// Given
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v1/menus/active", r.URL.Path)
+		w.Write([]byte(`{"id": "menu-1", "categories": [{"id": "cat-1", "name": "Grill", "items": [
+			{"id": "steak-1", "name": "Ribeye", "price": 32, "preparation_time": 1080000000000}]}]}`))
+	}))
+	defer server.Close()
+	catalog, err := NewMenuServiceCatalog(server.URL, server.Client())
+	require.NoError(t, err)
+
+	// When
+	menuID, items, err := catalog.ActiveMenu(context.Background())
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, "menu-1", menuID)
+	require.Len(t, items, 1)
+	assert.Equal(t, "menu-1", items[0].MenuID)
+	assert.Equal(t, "cat-1", items[0].CategoryID)
+	assert.Equal(t, "Grill", items[0].CategoryName)
+	assert.Equal(t, 18*time.Minute, items[0].PrepTime)
+}
+
+func TestMenuServiceCatalog_ActiveMenu_NoActiveMenu_ShouldBeNotFound(t *testing.T) {
+	// Given
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+	catalog, _ := NewMenuServiceCatalog(server.URL, server.Client())
+
+	// When
+	_, _, err := catalog.ActiveMenu(context.Background())
+
+	// Then
+	assert.True(t, sharedErrors.IsNotFound(err))
+}
@@ -0,0 +1,92 @@
+package infrastructure
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/restaurant-platform/kitchen-service/internal/domain"
+)
+
+// PrepTimeStatRepository stores the preparation times learned from past kitchen orders
+type PrepTimeStatRepository struct {
+	db *sql.DB
+}
+
+// NewPrepTimeStatRepository creates a new prep time statistics repository
+func NewPrepTimeStatRepository(db *sql.DB) *PrepTimeStatRepository {
+	return &PrepTimeStatRepository{
+		db: db,
+	}
+}
+
+// ListPrepTimeStats retrieves the learned preparation times of a menu item, or of every menu item
+func (r *PrepTimeStatRepository) ListPrepTimeStats(ctx context.Context, menuItemID string) (domain.PrepTimeStats, error) {
+	query := `
+		SELECT menu_item_id, station, hour, sample_count, trimmed_count,
+		       median_seconds, p90_seconds, computed_at
+		FROM prep_time_stats
+		WHERE $1 = '' OR menu_item_id = $1
+		ORDER BY menu_item_id ASC, station ASC, hour ASC`
+
+	rows, err := r.db.QueryContext(ctx, query, menuItemID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query prep time stats: %w", err)
+	}
+	defer rows.Close()
+
+	stats := make(domain.PrepTimeStats, 0)
+	for rows.Next() {
+		var stat domain.PrepTimeStat
+		var medianSeconds, p90Seconds float64
+		err := rows.Scan(&stat.MenuItemID, &stat.Station, &stat.Hour, &stat.SampleCount, &stat.TrimmedCount,
+			&medianSeconds, &p90Seconds, &stat.ComputedAt)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan prep time stat: %w", err)
+		}
+		stat.Median = time.Duration(medianSeconds * float64(time.Second))
+		stat.P90 = time.Duration(p90Seconds * float64(time.Second))
+		stats = append(stats, &stat)
+	}
+
+	return stats, rows.Err()
+}
+
+// ReplacePrepTimeStats replaces every learned preparation time in one transaction, so
+// estimates never see a half-written computation
+func (r *PrepTimeStatRepository) ReplacePrepTimeStats(ctx context.Context, stats domain.PrepTimeStats) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM prep_time_stats`); err != nil {
+		return fmt.Errorf("failed to clear prep time stats: %w", err)
+	}
+
+	query := `
+		INSERT INTO prep_time_stats (
+			menu_item_id, station, hour, sample_count, trimmed_count,
+			median_seconds, p90_seconds, computed_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`
+
+	for _, stat := range stats {
+		_, err := tx.ExecContext(ctx, query,
+			stat.MenuItemID,
+			stat.Station,
+			stat.Hour,
+			stat.SampleCount,
+			stat.TrimmedCount,
+			stat.Median.Seconds(),
+			stat.P90.Seconds(),
+			stat.ComputedAt,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to save prep time stat for %s: %w", stat.MenuItemID, err)
+		}
+	}
+
+	return tx.Commit()
+}
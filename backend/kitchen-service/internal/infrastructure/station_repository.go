@@ -0,0 +1,135 @@
+package infrastructure
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/restaurant-platform/kitchen-service/internal/domain"
+	"github.com/restaurant-platform/shared/pkg/errors"
+)
+
+// StationRepository stores the station registry and routing rules
+type StationRepository struct {
+	db *sql.DB
+}
+
+// NewStationRepository creates a new station repository
+func NewStationRepository(db *sql.DB) *StationRepository {
+	return &StationRepository{
+		db: db,
+	}
+}
+
+// ListStations retrieves every station in display order
+func (r *StationRepository) ListStations(ctx context.Context) ([]*domain.Station, error) {
+	query := `
+		SELECT id, name, sort_order, created_at
+		FROM stations
+		ORDER BY sort_order ASC, id ASC`
+
+	rows, err := r.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query stations: %w", err)
+	}
+	defer rows.Close()
+
+	stations := make([]*domain.Station, 0)
+	for rows.Next() {
+		var station domain.Station
+		if err := rows.Scan(&station.ID, &station.Name, &station.SortOrder, &station.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan station: %w", err)
+		}
+		stations = append(stations, &station)
+	}
+
+	return stations, rows.Err()
+}
+
+// GetStation retrieves a station by its ID
+func (r *StationRepository) GetStation(ctx context.Context, id string) (*domain.Station, error) {
+	query := `
+		SELECT id, name, sort_order, created_at
+		FROM stations WHERE id = $1`
+
+	var station domain.Station
+	err := r.db.QueryRowContext(ctx, query, id).Scan(&station.ID, &station.Name, &station.SortOrder, &station.CreatedAt)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, errors.WrapNotFound("StationRepository.GetStation", "station", id, err)
+		}
+		return nil, fmt.Errorf("failed to get station: %w", err)
+	}
+
+	return &station, nil
+}
+
+// SaveStation creates a station
+func (r *StationRepository) SaveStation(ctx context.Context, station *domain.Station) error {
+	query := `
+		INSERT INTO stations (id, name, sort_order, created_at)
+		VALUES ($1, $2, $3, $4)`
+
+	_, err := r.db.ExecContext(ctx, query, station.ID, station.Name, station.SortOrder, station.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to save station: %w", err)
+	}
+	return nil
+}
+
+// ListRoutingRules retrieves every routing rule, oldest first
+func (r *StationRepository) ListRoutingRules(ctx context.Context) (domain.RoutingRules, error) {
+	query := `
+		SELECT id, station_id, match, value, created_at
+		FROM routing_rules
+		ORDER BY created_at ASC`
+
+	rows, err := r.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query routing rules: %w", err)
+	}
+	defer rows.Close()
+
+	rules := make(domain.RoutingRules, 0)
+	for rows.Next() {
+		var rule domain.RoutingRule
+		var match string
+		if err := rows.Scan(&rule.ID, &rule.StationID, &match, &rule.Value, &rule.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan routing rule: %w", err)
+		}
+		rule.Match = domain.RoutingMatch(match)
+		rules = append(rules, &rule)
+	}
+
+	return rules, rows.Err()
+}
+
+// SaveRoutingRule creates a routing rule
+func (r *StationRepository) SaveRoutingRule(ctx context.Context, rule *domain.RoutingRule) error {
+	query := `
+		INSERT INTO routing_rules (id, station_id, match, value, created_at)
+		VALUES ($1, $2, $3, $4, $5)`
+
+	_, err := r.db.ExecContext(ctx, query, rule.ID, rule.StationID, string(rule.Match), rule.Value, rule.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to save routing rule: %w", err)
+	}
+	return nil
+}
+
+// DeleteRoutingRule removes a routing rule
+func (r *StationRepository) DeleteRoutingRule(ctx context.Context, id string) error {
+	result, err := r.db.ExecContext(ctx, `DELETE FROM routing_rules WHERE id = $1`, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete routing rule: %w", err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get affected rows: %w", err)
+	}
+	if affected == 0 {
+		return errors.WrapNotFound("StationRepository.DeleteRoutingRule", "routing_rule", id, errors.ErrNotFound)
+	}
+	return nil
+}
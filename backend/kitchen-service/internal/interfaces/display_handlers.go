@@ -0,0 +1,179 @@
+package interfaces
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"golang.org/x/net/websocket"
+
+	"github.com/restaurant-platform/kitchen-service/internal/application"
+)
+
+// displayHeartbeatInterval is how often an idle display connection is sent a heartbeat
+const displayHeartbeatInterval = 15 * time.Second
+
+// displayHeartbeatType is the update type of heartbeats; heartbeats carry no sequence
+const displayHeartbeatType = "heartbeat"
+
+// DisplayHandler streams kitchen updates to kitchen display screens
+type DisplayHandler struct {
+	hub            *application.KitchenDisplayHub
+	allowedOrigins map[string]bool
+}
+
+// NewDisplayHandler creates a new display handler. Browsers may open a display
+// WebSocket from the service's own host or from one of allowedOrigins.
+func NewDisplayHandler(hub *application.KitchenDisplayHub, allowedOrigins []string) *DisplayHandler {
+	origins := make(map[string]bool, len(allowedOrigins))
+	for _, origin := range allowedOrigins {
+		origins[strings.ToLower(strings.TrimRight(origin, "/"))] = true
+	}
+
+	return &DisplayHandler{
+		hub:            hub,
+		allowedOrigins: origins,
+	}
+}
+
+// StreamEvents streams kitchen updates as Server-Sent Events. Each event's id is its
+// sequence, so a reconnecting browser resumes through the Last-Event-ID header; the
+// after query parameter does the same for other clients.
+// GET /api/v1/kitchen/stream/events?stations=grill,fry&after=<sequence>
+func (h *DisplayHandler) StreamEvents(c *gin.Context) {
+	after := c.GetHeader("Last-Event-ID")
+	if after == "" {
+		after = c.Query("after")
+	}
+
+	sub, err := h.hub.Subscribe(c.Request.Context(), application.ParseStations(c.Query("stations")), after)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+	defer h.hub.Unsubscribe(sub)
+
+	// The stream outlives the server's write timeout
+	if err := http.NewResponseController(c.Writer).SetWriteDeadline(time.Time{}); err != nil {
+		log.Printf("Failed to clear write deadline for kitchen event stream: %v", err)
+	}
+
+	c.Header("Content-Type", "text/event-stream")
+	c.Header("Cache-Control", "no-cache")
+	c.Header("Connection", "keep-alive")
+	c.Header("X-Accel-Buffering", "no")
+	c.Status(http.StatusOK)
+	c.Writer.Flush()
+
+	heartbeat := time.NewTicker(displayHeartbeatInterval)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-c.Request.Context().Done():
+			return
+		case update, ok := <-sub.Updates():
+			if !ok {
+				return
+			}
+			payload, err := json.Marshal(update)
+			if err != nil {
+				log.Printf("Failed to encode kitchen display update %s: %v", update.Sequence, err)
+				continue
+			}
+			if _, err := fmt.Fprintf(c.Writer, "id: %s\nevent: %s\ndata: %s\n\n", update.Sequence, update.Type, payload); err != nil {
+				return
+			}
+			c.Writer.Flush()
+		case now := <-heartbeat.C:
+			if _, err := fmt.Fprintf(c.Writer, "event: %s\ndata: {\"timestamp\":%q}\n\n", displayHeartbeatType, now.Format(time.RFC3339)); err != nil {
+				return
+			}
+			c.Writer.Flush()
+		}
+	}
+}
+
+// StreamWebSocket streams kitchen updates over a WebSocket as JSON messages. A
+// reconnecting screen resumes by passing the last sequence it saw as after.
+// GET /api/v1/kitchen/stream/ws?stations=grill,fry&after=<sequence>
+func (h *DisplayHandler) StreamWebSocket(c *gin.Context) {
+	sub, err := h.hub.Subscribe(c.Request.Context(), application.ParseStations(c.Query("stations")), c.Query("after"))
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+	// Also unsubscribes when the handshake is rejected and the handler never runs
+	defer h.hub.Unsubscribe(sub)
+
+	server := websocket.Server{
+		Handshake: h.checkOrigin,
+		Handler: func(conn *websocket.Conn) {
+			streamToWebSocket(conn, sub)
+		},
+	}
+	server.ServeHTTP(c.Writer, c.Request)
+}
+
+// checkOrigin rejects WebSocket handshakes from web pages on other sites. CORS does
+// not apply to WebSockets, so without it any page could read the kitchen stream
+// through a browser on the kitchen network. Screens that are not browsers send no
+// Origin and are let through.
+func (h *DisplayHandler) checkOrigin(config *websocket.Config, req *http.Request) error {
+	origin, err := websocket.Origin(config, req)
+	if err != nil {
+		return err
+	}
+	if origin == nil {
+		return nil
+	}
+	if !strings.EqualFold(origin.Host, req.Host) && !h.allowedOrigins[strings.ToLower(origin.Scheme+"://"+origin.Host)] {
+		return fmt.Errorf("origin %s is not allowed to open a kitchen display stream", origin)
+	}
+	config.Origin = origin
+	return nil
+}
+
+// streamToWebSocket writes updates and heartbeats to a screen until either side goes away
+func streamToWebSocket(conn *websocket.Conn, sub *application.DisplaySubscription) {
+	defer conn.Close()
+
+	// The stream outlives the server's read and write timeouts
+	if err := conn.SetDeadline(time.Time{}); err != nil {
+		log.Printf("Failed to clear deadline for kitchen display websocket: %v", err)
+	}
+
+	// Screens do not send anything; reading only notices when they disconnect
+	gone := make(chan struct{})
+	go func() {
+		defer close(gone)
+		var discard []byte
+		for websocket.Message.Receive(conn, &discard) == nil {
+		}
+	}()
+
+	heartbeat := time.NewTicker(displayHeartbeatInterval)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-gone:
+			return
+		case update, ok := <-sub.Updates():
+			if !ok {
+				return
+			}
+			if err := websocket.JSON.Send(conn, update); err != nil {
+				return
+			}
+		case now := <-heartbeat.C:
+			if err := websocket.JSON.Send(conn, &application.DisplayUpdate{Type: displayHeartbeatType, OccurredAt: now}); err != nil {
+				return
+			}
+		}
+	}
+}
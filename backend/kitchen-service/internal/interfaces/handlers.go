@@ -1,13 +1,16 @@
 package interfaces
 
 import (
+	"context"
 	"net/http"
+	"strconv"
 	"time"
 
 	"github.com/gin-gonic/gin"
 
 	"github.com/restaurant-platform/kitchen-service/internal/application"
 	"github.com/restaurant-platform/kitchen-service/internal/domain"
+	"github.com/restaurant-platform/shared/pkg/concurrency"
 	"github.com/restaurant-platform/shared/pkg/errors"
 )
 
@@ -35,12 +38,13 @@ func (h *KitchenOrderHandler) CreateKitchenOrder(c *gin.Context) {
 		return
 	}
 
-	order, err := h.service.CreateKitchenOrder(c.Request.Context(), req.OrderID, req.TableID)
+	order, err := h.service.CreateKitchenOrder(c.Request.Context(), req.OrderID, req.TableID, nil)
 	if err != nil {
 		handleError(c, err)
 		return
 	}
 
+	concurrency.SetETag(c, order.Version)
 	c.JSON(http.StatusCreated, application.ToKitchenOrderResponse(order))
 }
 
@@ -55,6 +59,7 @@ func (h *KitchenOrderHandler) GetKitchenOrder(c *gin.Context) {
 		return
 	}
 
+	concurrency.SetETag(c, order.Version)
 	c.JSON(http.StatusOK, application.ToKitchenOrderResponse(order))
 }
 
@@ -69,6 +74,7 @@ func (h *KitchenOrderHandler) GetKitchenOrderByOrderID(c *gin.Context) {
 		return
 	}
 
+	concurrency.SetETag(c, order.Version)
 	c.JSON(http.StatusOK, application.ToKitchenOrderResponse(order))
 }
 
@@ -94,7 +100,9 @@ func (h *KitchenOrderHandler) AddKitchenItem(c *gin.Context) {
 		req.MenuItemID,
 		req.Name,
 		req.Quantity,
+		req.Course,
 		prepTime,
+		req.ToDomainModifiers(),
 		req.Modifications,
 		req.Notes,
 	)
@@ -106,6 +114,28 @@ func (h *KitchenOrderHandler) AddKitchenItem(c *gin.Context) {
 	c.JSON(http.StatusOK, gin.H{"message": "Item added successfully"})
 }
 
+// FireCourse releases a held course to the line
+// POST /api/v1/kitchen/orders/:id/courses/:course/fire
+func (h *KitchenOrderHandler) FireCourse(c *gin.Context) {
+	id := domain.KitchenOrderID(c.Param("id"))
+
+	course, err := strconv.Atoi(c.Param("course"))
+	if err != nil || course < 1 {
+		c.JSON(http.StatusBadRequest, application.ErrorResponse{
+			Error:   "Invalid course",
+			Message: "course must be a positive number",
+		})
+		return
+	}
+
+	if err := h.service.FireCourse(c.Request.Context(), id, course); err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Course fired successfully"})
+}
+
 // UpdateItemStatus updates the status of a kitchen item
 // PATCH /api/v1/kitchen/orders/:id/items/:itemID/status
 func (h *KitchenOrderHandler) UpdateItemStatus(c *gin.Context) {
@@ -194,6 +224,30 @@ func (h *KitchenOrderHandler) AssignToStation(c *gin.Context) {
 	c.JSON(http.StatusOK, gin.H{"message": "Order assigned to station successfully"})
 }
 
+// AssignItemToStation reroutes one item of a kitchen order to another station
+// PATCH /api/v1/kitchen/orders/:id/items/:itemID/station
+func (h *KitchenOrderHandler) AssignItemToStation(c *gin.Context) {
+	id := domain.KitchenOrderID(c.Param("id"))
+	itemID := c.Param("itemID")
+
+	var req application.AssignToStationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, application.ErrorResponse{
+			Error:   "Invalid request",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	err := h.service.AssignItemToStation(c.Request.Context(), id, itemID, req.StationID)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Item assigned to station successfully"})
+}
+
 // SetPriority sets the priority of a kitchen order
 // PATCH /api/v1/kitchen/orders/:id/priority
 func (h *KitchenOrderHandler) SetPriority(c *gin.Context) {
@@ -254,6 +308,73 @@ func (h *KitchenOrderHandler) CompleteKitchenOrder(c *gin.Context) {
 	c.JSON(http.StatusOK, gin.H{"message": "Order completed successfully"})
 }
 
+// BumpStation marks a station's items on a kitchen order as done
+// POST /api/v1/kitchen/orders/:id/stations/:stationID/bump
+func (h *KitchenOrderHandler) BumpStation(c *gin.Context) {
+	h.bump(c, "Station bumped successfully", func(ctx context.Context, id domain.KitchenOrderID, userID string) error {
+		return h.service.BumpStation(ctx, id, c.Param("stationID"), userID)
+	})
+}
+
+// RecallStation puts a station's last bumped items on a kitchen order back on its screen
+// POST /api/v1/kitchen/orders/:id/stations/:stationID/recall
+func (h *KitchenOrderHandler) RecallStation(c *gin.Context) {
+	h.bump(c, "Station bump recalled successfully", func(ctx context.Context, id domain.KitchenOrderID, userID string) error {
+		return h.service.RecallStation(ctx, id, c.Param("stationID"), userID)
+	})
+}
+
+// BumpExpo marks a ready kitchen order as served
+// POST /api/v1/kitchen/orders/:id/bump
+func (h *KitchenOrderHandler) BumpExpo(c *gin.Context) {
+	h.bump(c, "Order bumped successfully", h.service.BumpExpo)
+}
+
+// RecallExpo puts a served kitchen order back on the expo screen
+// POST /api/v1/kitchen/orders/:id/recall
+func (h *KitchenOrderHandler) RecallExpo(c *gin.Context) {
+	h.bump(c, "Order recalled successfully", h.service.RecallExpo)
+}
+
+// GetRecentlyBumped retrieves the kitchen orders a station, or expo without the station
+// query parameter, can still recall
+// GET /api/v1/kitchen/orders/recently-bumped?station=grill
+func (h *KitchenOrderHandler) GetRecentlyBumped(c *gin.Context) {
+	orders, err := h.service.GetRecentlyBumped(c.Request.Context(), c.Query("station"))
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	responses := make([]*application.KitchenOrderResponse, len(orders))
+	for i, order := range orders {
+		responses[i] = application.ToKitchenOrderResponse(order)
+	}
+
+	c.JSON(http.StatusOK, gin.H{"orders": responses})
+}
+
+// bump binds the user making a bump or recall and applies it to the kitchen order
+func (h *KitchenOrderHandler) bump(c *gin.Context, message string, apply func(ctx context.Context, id domain.KitchenOrderID, userID string) error) {
+	id := domain.KitchenOrderID(c.Param("id"))
+
+	var req application.BumpRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, application.ErrorResponse{
+			Error:   "Invalid request",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	if err := apply(c.Request.Context(), id, req.UserID); err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": message})
+}
+
 // GetActiveOrders retrieves all active kitchen orders
 // GET /api/v1/kitchen/orders/active
 func (h *KitchenOrderHandler) GetActiveOrders(c *gin.Context) {
@@ -299,8 +420,9 @@ func (h *KitchenOrderHandler) GetOrdersByStatus(c *gin.Context) {
 	c.JSON(http.StatusOK, gin.H{"orders": responses})
 }
 
-// GetOrdersByStation retrieves kitchen orders assigned to a specific station
+// GetOrdersByStation retrieves a station's queue of tickets, cut down to the station's items
 // GET /api/v1/kitchen/orders/station/:stationID
+// GET /api/v1/kitchen/stations/:stationID/queue
 func (h *KitchenOrderHandler) GetOrdersByStation(c *gin.Context) {
 	stationID := c.Param("stationID")
 
@@ -399,6 +521,45 @@ func (h *KitchenOrderHandler) ListKitchenOrders(c *gin.Context) {
 	c.JSON(http.StatusOK, response)
 }
 
+// GetCourseMetrics returns average course hold and fire-to-ready times
+// GET /api/v1/kitchen/metrics/courses?from=...&to=...
+func (h *KitchenOrderHandler) GetCourseMetrics(c *gin.Context) {
+	to := time.Now()
+	from := to.Add(-24 * time.Hour)
+
+	if v := c.Query("from"); v != "" {
+		parsed, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, application.ErrorResponse{
+				Error:   "Invalid from format",
+				Message: "Use RFC3339 format (e.g., 2023-01-01T00:00:00Z)",
+			})
+			return
+		}
+		from = parsed
+	}
+
+	if v := c.Query("to"); v != "" {
+		parsed, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, application.ErrorResponse{
+				Error:   "Invalid to format",
+				Message: "Use RFC3339 format (e.g., 2023-01-01T23:59:59Z)",
+			})
+			return
+		}
+		to = parsed
+	}
+
+	metrics, err := h.service.GetCourseMetrics(c.Request.Context(), from, to)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"courses": application.ToCourseMetricsResponses(metrics)})
+}
+
 // Health check handler
 // GET /health
 func (h *KitchenOrderHandler) Health(c *gin.Context) {
@@ -412,6 +573,16 @@ func (h *KitchenOrderHandler) Health(c *gin.Context) {
 // Error handling helper
 func handleError(c *gin.Context, err error) {
 	switch {
+	case errors.IsPreconditionFailed(err):
+		c.JSON(http.StatusPreconditionFailed, application.ErrorResponse{
+			Error:   "Precondition failed",
+			Message: err.Error(),
+		})
+	case errors.IsVersionConflict(err):
+		c.JSON(http.StatusConflict, application.ErrorResponse{
+			Error:   "Concurrent modification",
+			Message: err.Error(),
+		})
 	case errors.IsNotFound(err):
 		c.JSON(http.StatusNotFound, application.ErrorResponse{
 			Error:   "Not found",
@@ -0,0 +1,74 @@
+package interfaces
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/restaurant-platform/kitchen-service/internal/application"
+	"github.com/restaurant-platform/kitchen-service/internal/domain"
+)
+
+// PrepTimeHandler handles HTTP requests for the preparation times learned from past tickets
+type PrepTimeHandler struct {
+	service domain.PrepTimeService
+}
+
+// NewPrepTimeHandler creates a new prep time handler
+func NewPrepTimeHandler(service domain.PrepTimeService) *PrepTimeHandler {
+	return &PrepTimeHandler{
+		service: service,
+	}
+}
+
+// ListPrepTimeStats retrieves the learned preparation times, optionally of one menu item
+// GET /api/v1/kitchen/prep-times?menu_item_id=
+func (h *PrepTimeHandler) ListPrepTimeStats(c *gin.Context) {
+	stats, err := h.service.ListPrepTimeStats(c.Request.Context(), c.Query("menu_item_id"))
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"stats": application.ToPrepTimeStatResponses(stats)})
+}
+
+// EstimatePrepTime returns the preparation time a menu item is expected to take at a station,
+// in the given hour of the day or the current one
+// GET /api/v1/kitchen/prep-times/estimate?menu_item_id=&station=&hour=
+func (h *PrepTimeHandler) EstimatePrepTime(c *gin.Context) {
+	hour := time.Now().Hour()
+	if v := c.Query("hour"); v != "" {
+		parsed, err := strconv.Atoi(v)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, application.ErrorResponse{
+				Error:   "Invalid hour",
+				Message: "hour must be a number between 0 and 23",
+			})
+			return
+		}
+		hour = parsed
+	}
+
+	estimate, err := h.service.EstimatePrepTime(c.Request.Context(), c.Query("menu_item_id"), c.Query("station"), hour)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, application.ToPrepTimeEstimateResponse(estimate))
+}
+
+// RecomputePrepTimeStats learns preparation times from recent tickets now rather than on the next run
+// POST /api/v1/kitchen/prep-times/recompute
+func (h *PrepTimeHandler) RecomputePrepTimeStats(c *gin.Context) {
+	count, err := h.service.RecomputePrepTimeStats(c.Request.Context(), time.Now())
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"stats_count": count})
+}
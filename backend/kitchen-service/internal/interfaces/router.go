@@ -9,9 +9,10 @@ import (
 
 	"github.com/restaurant-platform/kitchen-service/internal/application"
 	"github.com/restaurant-platform/kitchen-service/internal/domain"
+	"github.com/restaurant-platform/shared/pkg/concurrency"
 )
 
-func SetupRouter(kitchenService domain.KitchenService) *gin.Engine {
+func SetupRouter(kitchenService domain.KitchenService, stationService domain.StationService, prepTimeService domain.PrepTimeService, slaService domain.TicketSLAService, displayHub *application.KitchenDisplayHub, displayOrigins []string) *gin.Engine {
 	router := gin.Default()
 
 	// CORS middleware
@@ -19,7 +20,7 @@ func SetupRouter(kitchenService domain.KitchenService) *gin.Engine {
 		AllowOrigins:     []string{"*"},
 		AllowMethods:     []string{"GET", "POST", "PUT", "PATCH", "DELETE", "OPTIONS"},
 		AllowHeaders:     []string{"*"},
-		ExposeHeaders:    []string{"Content-Length"},
+		ExposeHeaders:    []string{"Content-Length", "ETag"},
 		AllowCredentials: true,
 		MaxAge:           12 * time.Hour,
 	}))
@@ -35,6 +36,10 @@ func SetupRouter(kitchenService domain.KitchenService) *gin.Engine {
 
 	// Initialize handlers
 	kitchenHandler := NewKitchenOrderHandler(kitchenService)
+	stationHandler := NewStationHandler(stationService)
+	prepTimeHandler := NewPrepTimeHandler(prepTimeService)
+	slaHandler := NewSLAHandler(slaService)
+	displayHandler := NewDisplayHandler(displayHub, displayOrigins)
 
 	// API routes
 	v1 := router.Group("/api/v1")
@@ -44,10 +49,12 @@ func SetupRouter(kitchenService domain.KitchenService) *gin.Engine {
 		{
 			// Kitchen order management
 			orders := kitchen.Group("/orders")
+			orders.Use(concurrency.IfMatchMiddleware())
 			{
 				orders.POST("", kitchenHandler.CreateKitchenOrder)
 				orders.GET("", kitchenHandler.ListKitchenOrders)
 				orders.GET("/active", kitchenHandler.GetActiveOrders)
+				orders.GET("/recently-bumped", kitchenHandler.GetRecentlyBumped)
 				orders.GET("/status/:status", kitchenHandler.GetOrdersByStatus)
 				orders.GET("/station/:stationID", kitchenHandler.GetOrdersByStation)
 				orders.GET("/:id", kitchenHandler.GetKitchenOrder)
@@ -61,6 +68,59 @@ func SetupRouter(kitchenService domain.KitchenService) *gin.Engine {
 				// Kitchen item management
 				orders.POST("/:id/items", kitchenHandler.AddKitchenItem)
 				orders.PATCH("/:id/items/:itemID/status", kitchenHandler.UpdateItemStatus)
+				orders.PATCH("/:id/items/:itemID/station", kitchenHandler.AssignItemToStation)
+
+				// Course hold-and-fire
+				orders.POST("/:id/courses/:course/fire", kitchenHandler.FireCourse)
+
+				// Station and expo bump/recall
+				orders.POST("/:id/stations/:stationID/bump", kitchenHandler.BumpStation)
+				orders.POST("/:id/stations/:stationID/recall", kitchenHandler.RecallStation)
+				orders.POST("/:id/bump", kitchenHandler.BumpExpo)
+				orders.POST("/:id/recall", kitchenHandler.RecallExpo)
+			}
+
+			// Station registry and per-station queues
+			stations := kitchen.Group("/stations")
+			{
+				stations.GET("", stationHandler.ListStations)
+				stations.POST("", stationHandler.CreateStation)
+				stations.GET("/:stationID/queue", kitchenHandler.GetOrdersByStation)
+			}
+
+			// Routing of ticket items to stations
+			routingRules := kitchen.Group("/routing-rules")
+			{
+				routingRules.GET("", stationHandler.ListRoutingRules)
+				routingRules.POST("", stationHandler.CreateRoutingRule)
+				routingRules.DELETE("/:ruleID", stationHandler.DeleteRoutingRule)
+			}
+
+			// Preparation times learned from past tickets
+			prepTimes := kitchen.Group("/prep-times")
+			{
+				prepTimes.GET("", prepTimeHandler.ListPrepTimeStats)
+				prepTimes.GET("/estimate", prepTimeHandler.EstimatePrepTime)
+				prepTimes.POST("/recompute", prepTimeHandler.RecomputePrepTimeStats)
+			}
+
+			// Tickets past their SLA
+			alerts := kitchen.Group("/alerts")
+			{
+				alerts.GET("/sla", slaHandler.GetBreaches)
+			}
+
+			// Real-time push to kitchen display screens
+			stream := kitchen.Group("/stream")
+			{
+				stream.GET("/events", displayHandler.StreamEvents)
+				stream.GET("/ws", displayHandler.StreamWebSocket)
+			}
+
+			// Kitchen metrics
+			metrics := kitchen.Group("/metrics")
+			{
+				metrics.GET("/courses", kitchenHandler.GetCourseMetrics)
 			}
 		}
 	}
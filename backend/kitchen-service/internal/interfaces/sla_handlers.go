@@ -0,0 +1,36 @@
+package interfaces
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/restaurant-platform/kitchen-service/internal/application"
+	"github.com/restaurant-platform/kitchen-service/internal/domain"
+)
+
+// SLAHandler handles HTTP requests for kitchen order SLA breaches
+type SLAHandler struct {
+	slaService domain.TicketSLAService
+}
+
+// NewSLAHandler creates a new SLA handler
+func NewSLAHandler(slaService domain.TicketSLAService) *SLAHandler {
+	return &SLAHandler{
+		slaService: slaService,
+	}
+}
+
+// GetBreaches returns the active kitchen orders that are past their SLA
+// GET /api/v1/kitchen/alerts/sla
+func (h *SLAHandler) GetBreaches(c *gin.Context) {
+	now := time.Now()
+	breaches, err := h.slaService.GetBreaches(c.Request.Context(), now)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, application.ToTicketSLABreachResponses(breaches, now))
+}
@@ -0,0 +1,115 @@
+package interfaces
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/restaurant-platform/kitchen-service/internal/application"
+	"github.com/restaurant-platform/kitchen-service/internal/domain"
+)
+
+// StationHandler handles HTTP requests for the station registry and routing rules
+type StationHandler struct {
+	service domain.StationService
+}
+
+// NewStationHandler creates a new station handler
+func NewStationHandler(service domain.StationService) *StationHandler {
+	return &StationHandler{
+		service: service,
+	}
+}
+
+// ListStations retrieves every station
+// GET /api/v1/kitchen/stations
+func (h *StationHandler) ListStations(c *gin.Context) {
+	stations, err := h.service.ListStations(c.Request.Context())
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	responses := make([]*application.StationResponse, len(stations))
+	for i, station := range stations {
+		responses[i] = application.ToStationResponse(station)
+	}
+
+	c.JSON(http.StatusOK, gin.H{"stations": responses})
+}
+
+// CreateStation adds a station to the registry
+// POST /api/v1/kitchen/stations
+func (h *StationHandler) CreateStation(c *gin.Context) {
+	var req application.CreateStationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, application.ErrorResponse{
+			Error:   "Invalid request",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	station, err := h.service.CreateStation(c.Request.Context(), req.ID, req.Name, req.SortOrder)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, application.ToStationResponse(station))
+}
+
+// ListRoutingRules retrieves every routing rule
+// GET /api/v1/kitchen/routing-rules
+func (h *StationHandler) ListRoutingRules(c *gin.Context) {
+	rules, err := h.service.ListRoutingRules(c.Request.Context())
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	responses := make([]*application.RoutingRuleResponse, len(rules))
+	for i, rule := range rules {
+		responses[i] = application.ToRoutingRuleResponse(rule)
+	}
+
+	c.JSON(http.StatusOK, gin.H{"rules": responses})
+}
+
+// CreateRoutingRule routes items matching a category, menu item or modifier option to a station
+// POST /api/v1/kitchen/routing-rules
+func (h *StationHandler) CreateRoutingRule(c *gin.Context) {
+	var req application.CreateRoutingRuleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, application.ErrorResponse{
+			Error:   "Invalid request",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	match, err := application.ValidateRoutingMatch(req.Match)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	rule, err := h.service.AddRoutingRule(c.Request.Context(), req.StationID, match, req.Value)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, application.ToRoutingRuleResponse(rule))
+}
+
+// DeleteRoutingRule removes a routing rule
+// DELETE /api/v1/kitchen/routing-rules/:ruleID
+func (h *StationHandler) DeleteRoutingRule(c *gin.Context) {
+	if err := h.service.DeleteRoutingRule(c.Request.Context(), c.Param("ruleID")); err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Routing rule deleted successfully"})
+}
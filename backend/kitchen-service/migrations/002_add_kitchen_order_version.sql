@@ -0,0 +1,5 @@
+-- Kitchen Service Database Schema
+-- Database: kitchen_service_db
+
+-- Optimistic concurrency: updates only apply to the version they were loaded at
+ALTER TABLE kitchen_orders ADD COLUMN IF NOT EXISTS version INTEGER NOT NULL DEFAULT 1;
@@ -0,0 +1,16 @@
+-- Kitchen Service Database Schema
+-- Database: kitchen_service_db
+
+-- Local read model of menu items, kept current from menu.* events.
+-- Supplies the preparation time of each ticket item.
+CREATE TABLE IF NOT EXISTS menu_items (
+    id VARCHAR(255) PRIMARY KEY,
+    menu_id VARCHAR(255) NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    category_id VARCHAR(255),
+    category_name VARCHAR(255),
+    prep_time_seconds INTEGER NOT NULL DEFAULT 0 CHECK (prep_time_seconds >= 0),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_menu_items_menu_id ON menu_items(menu_id);
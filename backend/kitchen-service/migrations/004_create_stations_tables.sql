@@ -0,0 +1,31 @@
+-- Kitchen Service Database Schema
+-- Database: kitchen_service_db
+
+-- Registry of the stations of the line
+CREATE TABLE IF NOT EXISTS stations (
+    id VARCHAR(100) PRIMARY KEY,
+    name VARCHAR(255) NOT NULL,
+    sort_order INTEGER NOT NULL DEFAULT 0,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+INSERT INTO stations (id, name, sort_order) VALUES
+    ('grill', 'Grill', 1),
+    ('fry', 'Fry', 2),
+    ('saute', 'Sauté', 3),
+    ('cold', 'Cold', 4),
+    ('pastry', 'Pastry', 5),
+    ('bar', 'Bar', 6)
+ON CONFLICT (id) DO NOTHING;
+
+-- Rules routing ticket items to stations by menu category, menu item or modifier option
+CREATE TABLE IF NOT EXISTS routing_rules (
+    id VARCHAR(255) PRIMARY KEY,
+    station_id VARCHAR(100) NOT NULL REFERENCES stations(id) ON DELETE CASCADE,
+    match VARCHAR(20) NOT NULL CHECK (match IN ('CATEGORY', 'MENU_ITEM', 'MODIFIER')),
+    value VARCHAR(255) NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    UNIQUE (match, value, station_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_routing_rules_station_id ON routing_rules(station_id);
@@ -0,0 +1,15 @@
+-- Kitchen Service Database Schema
+-- Database: kitchen_service_db
+
+-- Expo workflow: a READY ticket is bumped to SERVED and can be recalled for a short while
+ALTER TABLE kitchen_orders DROP CONSTRAINT IF EXISTS kitchen_orders_status_check;
+ALTER TABLE kitchen_orders ADD CONSTRAINT kitchen_orders_status_check
+    CHECK (status IN ('NEW', 'PREPARING', 'READY', 'SERVED', 'COMPLETED', 'CANCELLED'));
+
+ALTER TABLE kitchen_orders ADD COLUMN IF NOT EXISTS served_at TIMESTAMP WITH TIME ZONE;
+
+-- History of station bumps, expo bumps and recalls with the user who made them
+ALTER TABLE kitchen_orders ADD COLUMN IF NOT EXISTS bumps JSONB NOT NULL DEFAULT '[]';
+
+-- Recently served tickets are listed for recall
+CREATE INDEX IF NOT EXISTS idx_kitchen_orders_served_at ON kitchen_orders(served_at) WHERE status = 'SERVED';
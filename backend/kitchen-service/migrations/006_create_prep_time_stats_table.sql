@@ -0,0 +1,16 @@
+-- Kitchen Service Database Schema
+-- Database: kitchen_service_db
+
+-- Preparation times learned from past kitchen orders, recomputed periodically.
+-- One row per menu item, station and hour of the day; hour -1 covers every hour.
+CREATE TABLE IF NOT EXISTS prep_time_stats (
+    menu_item_id VARCHAR(255) NOT NULL,
+    station VARCHAR(100) NOT NULL DEFAULT '',
+    hour INTEGER NOT NULL CHECK (hour BETWEEN -1 AND 23),
+    sample_count INTEGER NOT NULL CHECK (sample_count >= 0),
+    trimmed_count INTEGER NOT NULL DEFAULT 0 CHECK (trimmed_count >= 0),
+    median_seconds DOUBLE PRECISION NOT NULL,
+    p90_seconds DOUBLE PRECISION NOT NULL,
+    computed_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (menu_item_id, station, hour)
+);
@@ -28,20 +28,37 @@ type MenuCategoryResponse struct {
 }
 
 type MenuItemResponse struct {
-	ID              string        `json:"id"`
-	Name            string        `json:"name"`
-	Description     string        `json:"description,omitempty"`
-	Price           float64       `json:"price"`
-	CategoryID      string        `json:"category_id"`
-	IsAvailable     bool          `json:"is_available"`
-	PreparationTime time.Duration `json:"preparation_time"`
-	Ingredients     []string      `json:"ingredients,omitempty"`
-	Allergens       []string      `json:"allergens,omitempty"`
-	NutritionalInfo string        `json:"nutritional_info,omitempty"`
-	ImageURL        string        `json:"image_url,omitempty"`
-	DisplayOrder    int           `json:"display_order"`
-	CreatedAt       time.Time     `json:"created_at"`
-	UpdatedAt       time.Time     `json:"updated_at"`
+	ID              string                   `json:"id"`
+	Name            string                   `json:"name"`
+	Description     string                   `json:"description,omitempty"`
+	Price           float64                  `json:"price"`
+	CategoryID      string                   `json:"category_id"`
+	IsAvailable     bool                     `json:"is_available"`
+	PreparationTime time.Duration            `json:"preparation_time"`
+	Ingredients     []string                 `json:"ingredients,omitempty"`
+	Allergens       []string                 `json:"allergens,omitempty"`
+	NutritionalInfo string                   `json:"nutritional_info,omitempty"`
+	ImageURL        string                   `json:"image_url,omitempty"`
+	DisplayOrder    int                      `json:"display_order"`
+	ModifierGroups  []*ModifierGroupResponse `json:"modifier_groups,omitempty"`
+	CreatedAt       time.Time                `json:"created_at"`
+	UpdatedAt       time.Time                `json:"updated_at"`
+}
+
+type ModifierGroupResponse struct {
+	ID            string                    `json:"id"`
+	Name          string                    `json:"name"`
+	MinSelections int                       `json:"min_selections"`
+	MaxSelections int                       `json:"max_selections"`
+	Options       []*ModifierOptionResponse `json:"options"`
+	DisplayOrder  int                       `json:"display_order"`
+}
+
+type ModifierOptionResponse struct {
+	ID         string  `json:"id"`
+	Name       string  `json:"name"`
+	PriceDelta float64 `json:"price_delta"`
+	IsDefault  bool    `json:"is_default"`
 }
 
 type CreateMenuRequest struct {
@@ -67,6 +84,19 @@ type CreateMenuItemRequest struct {
 	DisplayOrder    int           `json:"display_order"`
 }
 
+type CreateModifierGroupRequest struct {
+	Name          string                        `json:"name" binding:"required"`
+	MinSelections int                           `json:"min_selections" binding:"min=0"`
+	MaxSelections int                           `json:"max_selections" binding:"required,min=1"`
+	Options       []CreateModifierOptionRequest `json:"options" binding:"required,min=1,dive"`
+}
+
+type CreateModifierOptionRequest struct {
+	Name       string  `json:"name" binding:"required"`
+	PriceDelta float64 `json:"price_delta"`
+	IsDefault  bool    `json:"is_default"`
+}
+
 type UpdateItemAvailabilityRequest struct {
 	IsAvailable bool `json:"is_available"`
 }
@@ -125,6 +155,11 @@ func MenuItemToResponse(i *menu.MenuItem) *MenuItemResponse {
 		return nil
 	}
 
+	groups := make([]*ModifierGroupResponse, len(i.ModifierGroups))
+	for idx, group := range i.ModifierGroups {
+		groups[idx] = ModifierGroupToResponse(group)
+	}
+
 	return &MenuItemResponse{
 		ID:              string(i.ID),
 		Name:            i.Name,
@@ -138,7 +173,33 @@ func MenuItemToResponse(i *menu.MenuItem) *MenuItemResponse {
 		NutritionalInfo: i.NutritionalInfo,
 		ImageURL:        i.ImageURL,
 		DisplayOrder:    i.DisplayOrder,
+		ModifierGroups:  groups,
 		CreatedAt:       i.CreatedAt,
 		UpdatedAt:       i.UpdatedAt,
 	}
+}
+
+func ModifierGroupToResponse(g *menu.ModifierGroup) *ModifierGroupResponse {
+	if g == nil {
+		return nil
+	}
+
+	options := make([]*ModifierOptionResponse, len(g.Options))
+	for i, option := range g.Options {
+		options[i] = &ModifierOptionResponse{
+			ID:         string(option.ID),
+			Name:       option.Name,
+			PriceDelta: option.PriceDelta,
+			IsDefault:  option.IsDefault,
+		}
+	}
+
+	return &ModifierGroupResponse{
+		ID:            string(g.ID),
+		Name:          g.Name,
+		MinSelections: g.MinSelections,
+		MaxSelections: g.MaxSelections,
+		Options:       options,
+		DisplayOrder:  g.DisplayOrder,
+	}
 }
\ No newline at end of file
@@ -5,6 +5,7 @@ import (
 	"log"
 	menu "github.com/restaurant-platform/menu-service/internal/domain"
 	"github.com/restaurant-platform/shared/events"
+	"github.com/restaurant-platform/shared/pkg/concurrency"
 )
 
 type MenuService struct {
@@ -75,17 +76,12 @@ func (s *MenuService) GetMenuItem(ctx context.Context, id menu.ItemID) (*menu.Me
 }
 
 func (s *MenuService) AddCategoryToMenu(ctx context.Context, menuID, name, description string, displayOrder int) (*menu.MenuCategory, error) {
-	m, err := s.menuRepo.GetByID(ctx, menu.MenuID(menuID))
-	if err != nil {
-		return nil, err
-	}
-
-	category, err := m.AddCategory(name, description, displayOrder)
-	if err != nil {
-		return nil, err
-	}
-
-	err = s.menuRepo.Update(ctx, m)
+	var category *menu.MenuCategory
+	_, err := s.modifyMenu(ctx, menuID, func(m *menu.Menu) error {
+		var err error
+		category, err = m.AddCategory(name, description, displayOrder)
+		return err
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -94,42 +90,83 @@ func (s *MenuService) AddCategoryToMenu(ctx context.Context, menuID, name, descr
 }
 
 func (s *MenuService) AddItemToCategory(ctx context.Context, menuID string, categoryID menu.CategoryID, name, description string, price float64) (*menu.MenuItem, error) {
-	m, err := s.menuRepo.GetByID(ctx, menu.MenuID(menuID))
+	var item *menu.MenuItem
+	m, err := s.modifyMenu(ctx, menuID, func(m *menu.Menu) error {
+		var err error
+		item, err = m.AddMenuItem(categoryID, name, description, price, 0, nil, nil, "", "", 0)
+		return err
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	item, err := m.AddMenuItem(categoryID, name, description, price, 0, nil, nil, "", "", 0)
+	// Publish menu item added event
+	eventData, err := events.ToEventData(toMenuItemData(m, item))
 	if err != nil {
+		log.Printf("Failed to convert event data to map: %v", err)
 		return nil, err
 	}
 
-	err = s.menuRepo.Update(ctx, m)
-	if err != nil {
-		return nil, err
+	event := events.NewDomainEvent(events.MenuItemAddedEvent, m.ID.String(), eventData).
+		WithMetadata("service", "menu-service").
+		WithMetadata("item_id", string(item.ID))
+
+	if err := s.eventPublisher.Publish(ctx, event); err != nil {
+		log.Printf("Failed to publish menu item added event: %v", err)
 	}
 
 	return item, nil
 }
 
-func (s *MenuService) SetItemAvailability(ctx context.Context, menuID string, itemID menu.ItemID, isAvailable bool) error {
-	m, err := s.menuRepo.GetByID(ctx, menu.MenuID(menuID))
+func (s *MenuService) AddModifierGroup(ctx context.Context, menuID string, itemID menu.ItemID, name string, minSelections, maxSelections int, options []*menu.ModifierOption) (*menu.ModifierGroup, error) {
+	var group *menu.ModifierGroup
+	m, err := s.modifyMenu(ctx, menuID, func(m *menu.Menu) error {
+		var err error
+		group, err = menu.NewModifierGroup(name, minSelections, maxSelections, options)
+		if err != nil {
+			return err
+		}
+		return m.AddModifierGroup(itemID, group)
+	})
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	// Get the item before updating to have the item name and category
 	item, err := m.FindItemByID(itemID)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	err = m.SetItemAvailability(itemID, isAvailable)
+	// Publish menu item updated event so read models pick up the new group
+	eventData, err := events.ToEventData(toMenuItemData(m, item))
 	if err != nil {
-		return err
+		log.Printf("Failed to convert event data to map: %v", err)
+		return nil, err
+	}
+
+	event := events.NewDomainEvent(events.MenuItemUpdatedEvent, m.ID.String(), eventData).
+		WithMetadata("service", "menu-service").
+		WithMetadata("item_id", string(itemID))
+
+	if err := s.eventPublisher.Publish(ctx, event); err != nil {
+		log.Printf("Failed to publish menu item updated event: %v", err)
 	}
 
-	err = s.menuRepo.Update(ctx, m)
+	return group, nil
+}
+
+func (s *MenuService) SetItemAvailability(ctx context.Context, menuID string, itemID menu.ItemID, isAvailable bool) error {
+	var item *menu.MenuItem
+	m, err := s.modifyMenu(ctx, menuID, func(m *menu.Menu) error {
+		// Get the item before updating to have the item name and category
+		var err error
+		item, err = m.FindItemByID(itemID)
+		if err != nil {
+			return err
+		}
+
+		return m.SetItemAvailability(itemID, isAvailable)
+	})
 	if err != nil {
 		return err
 	}
@@ -160,22 +197,25 @@ func (s *MenuService) SetItemAvailability(ctx context.Context, menuID string, it
 }
 
 func (s *MenuService) ActivateMenu(ctx context.Context, menuID string) error {
-	m, err := s.menuRepo.GetByID(ctx, menu.MenuID(menuID))
+	m, err := s.modifyMenu(ctx, menuID, func(m *menu.Menu) error {
+		m.Activate()
+		return nil
+	})
 	if err != nil {
 		return err
 	}
 
-	m.Activate()
-	err = s.menuRepo.Update(ctx, m)
-	if err != nil {
-		return err
+	// Publish menu activated event
+	items := make([]events.MenuItemData, 0)
+	for _, item := range m.GetAllItems() {
+		items = append(items, toMenuItemData(m, item))
 	}
 
-	// Publish menu activated event
 	eventData, err := events.ToEventData(events.MenuActivatedData{
 		MenuID:  m.ID.String(),
 		Name:    m.Name,
 		Version: m.Version,
+		Items:   items,
 	})
 
 	if err != nil {
@@ -194,11 +234,100 @@ func (s *MenuService) ActivateMenu(ctx context.Context, menuID string) error {
 }
 
 func (s *MenuService) DeactivateMenu(ctx context.Context, menuID string) error {
-	m, err := s.menuRepo.GetByID(ctx, menu.MenuID(menuID))
+	m, err := s.modifyMenu(ctx, menuID, func(m *menu.Menu) error {
+		m.Deactivate()
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	// Publish menu deactivated event
+	eventData, err := events.ToEventData(events.MenuDeactivatedData{
+		MenuID:  m.ID.String(),
+		Name:    m.Name,
+		Version: m.Version,
+	})
+
 	if err != nil {
+		log.Printf("Failed to convert event data to map: %v", err)
 		return err
 	}
 
-	m.Deactivate()
-	return s.menuRepo.Update(ctx, m)
+	event := events.NewDomainEvent(events.MenuDeactivatedEvent, m.ID.String(), eventData).
+		WithMetadata("service", "menu-service")
+
+	if err := s.eventPublisher.Publish(ctx, event); err != nil {
+		log.Printf("Failed to publish menu deactivated event: %v", err)
+	}
+
+	return nil
+}
+
+// modifyMenu loads a menu, applies change and saves it, starting over from a fresh
+// copy when another writer saved the menu in between
+func (s *MenuService) modifyMenu(ctx context.Context, menuID string, change func(m *menu.Menu) error) (*menu.Menu, error) {
+	var m *menu.Menu
+	err := concurrency.RetryOnConflict(ctx, func() error {
+		var err error
+		m, err = s.menuRepo.GetByID(ctx, menu.MenuID(menuID))
+		if err != nil {
+			return err
+		}
+
+		if err := concurrency.CheckVersion(ctx, "modifyMenu", "menu", menuID, m.Version); err != nil {
+			return err
+		}
+
+		if err := change(m); err != nil {
+			return err
+		}
+
+		return s.menuRepo.Update(ctx, m)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// toMenuItemData builds the event payload other services use to keep their menu read models current
+func toMenuItemData(m *menu.Menu, item *menu.MenuItem) events.MenuItemData {
+	var categoryName string
+	if category, err := m.GetCategory(item.CategoryID); err == nil {
+		categoryName = category.Name
+	}
+
+	groups := make([]events.ModifierGroupData, len(item.ModifierGroups))
+	for i, group := range item.ModifierGroups {
+		options := make([]events.ModifierOptionData, len(group.Options))
+		for j, option := range group.Options {
+			options[j] = events.ModifierOptionData{
+				OptionID:   string(option.ID),
+				Name:       option.Name,
+				PriceDelta: option.PriceDelta,
+				IsDefault:  option.IsDefault,
+			}
+		}
+		groups[i] = events.ModifierGroupData{
+			GroupID:       string(group.ID),
+			Name:          group.Name,
+			MinSelections: group.MinSelections,
+			MaxSelections: group.MaxSelections,
+			Options:       options,
+		}
+	}
+
+	return events.MenuItemData{
+		MenuID:          m.ID.String(),
+		ItemID:          string(item.ID),
+		Name:            item.Name,
+		Price:           item.Price,
+		CategoryID:      string(item.CategoryID),
+		CategoryName:    categoryName,
+		IsAvailable:     item.IsAvailable,
+		PrepTimeSeconds: int64(item.PreparationTime.Seconds()),
+		MenuActive:      m.IsActive,
+		ModifierGroups:  groups,
+	}
 }
\ No newline at end of file
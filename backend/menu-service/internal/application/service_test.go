@@ -11,6 +11,8 @@ import (
 	
 	menu "github.com/restaurant-platform/menu-service/internal/domain"
 	"github.com/restaurant-platform/shared/events"
+	"github.com/restaurant-platform/shared/pkg/concurrency"
+	sharedErrors "github.com/restaurant-platform/shared/pkg/errors"
 )
 
 // MockMenuRepository is a mock implementation of MenuRepository
@@ -411,6 +413,9 @@ func (suite *MenuServiceTestSuite) TestAddItemToCategory_Success() {
 
 	suite.mockRepo.On("GetByID", suite.ctx, testMenu.ID).Return(testMenu, nil)
 	suite.mockRepo.On("Update", suite.ctx, testMenu).Return(nil)
+	suite.mockPublisher.On("Publish", suite.ctx, mock.MatchedBy(func(e *events.DomainEvent) bool {
+		return e.Type == events.MenuItemAddedEvent && e.Data["price"] == price && e.Data["category_name"] == "Appetizers"
+	})).Return(nil)
 
 	// When
 	result, err := suite.service.AddItemToCategory(suite.ctx, string(testMenu.ID), category.ID, itemName, description, price)
@@ -425,6 +430,57 @@ func (suite *MenuServiceTestSuite) TestAddItemToCategory_Success() {
 	assert.Equal(category.ID, result.CategoryID)
 	
 	suite.mockRepo.AssertExpectations(suite.T())
+	suite.mockPublisher.AssertExpectations(suite.T())
+}
+
+// Test AddModifierGroup
+func (suite *MenuServiceTestSuite) TestAddModifierGroup_Success() {
+	// Given
+	testMenu, _ := menu.NewMenu("Test Menu")
+	category, _ := testMenu.AddCategory("Mains", "Description", 1)
+	item, _ := testMenu.AddMenuItem(category.ID, "Steak", "Ribeye", 29.00, 0, nil, nil, "", "", 1)
+	rare, _ := menu.NewModifierOption("Rare", 0, false)
+	medium, _ := menu.NewModifierOption("Medium", 0, true)
+
+	suite.mockRepo.On("GetByID", suite.ctx, testMenu.ID).Return(testMenu, nil)
+	suite.mockRepo.On("Update", suite.ctx, testMenu).Return(nil)
+	suite.mockPublisher.On("Publish", suite.ctx, mock.MatchedBy(func(event *events.DomainEvent) bool {
+		groups, ok := event.Data["modifier_groups"].([]interface{})
+		return event.Type == events.MenuItemUpdatedEvent && ok && len(groups) == 1
+	})).Return(nil)
+
+	// When
+	group, err := suite.service.AddModifierGroup(suite.ctx, string(testMenu.ID), item.ID, "Temperature", 1, 1, []*menu.ModifierOption{rare, medium})
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	assert.Equal("Temperature", group.Name)
+	assert.Len(item.ModifierGroups, 1)
+
+	suite.mockRepo.AssertExpectations(suite.T())
+	suite.mockPublisher.AssertExpectations(suite.T())
+}
+
+func (suite *MenuServiceTestSuite) TestAddModifierGroup_InvalidRules_ShouldFail() {
+	// Given
+	testMenu, _ := menu.NewMenu("Test Menu")
+	category, _ := testMenu.AddCategory("Mains", "Description", 1)
+	item, _ := testMenu.AddMenuItem(category.ID, "Steak", "Ribeye", 29.00, 0, nil, nil, "", "", 1)
+	rare, _ := menu.NewModifierOption("Rare", 0, false)
+
+	suite.mockRepo.On("GetByID", suite.ctx, testMenu.ID).Return(testMenu, nil)
+
+	// When
+	_, err := suite.service.AddModifierGroup(suite.ctx, string(testMenu.ID), item.ID, "Temperature", 2, 2, []*menu.ModifierOption{rare})
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.Error(err)
+	assert.Empty(item.ModifierGroups)
+
+	suite.mockRepo.AssertNotCalled(suite.T(), "Update", mock.Anything, mock.Anything)
+	suite.mockPublisher.AssertNotCalled(suite.T(), "Publish")
 }
 
 // Test SetItemAvailability
@@ -492,6 +548,49 @@ func (suite *MenuServiceTestSuite) TestActivateMenu_Success() {
 	suite.mockPublisher.AssertExpectations(suite.T())
 }
 
+func (suite *MenuServiceTestSuite) TestActivateMenu_VersionConflict_RetriesOnFreshCopy() {
+	// Given
+	staleMenu, _ := menu.NewMenu("Test Menu")
+	staleMenu.Deactivate()
+	freshMenu := *staleMenu
+	freshMenu.Version = 2
+	conflict := sharedErrors.WrapVersionConflict("MenuRepository.Update", "menu", string(staleMenu.ID), 1)
+
+	suite.mockRepo.On("GetByID", suite.ctx, staleMenu.ID).Return(staleMenu, nil).Once()
+	suite.mockRepo.On("Update", suite.ctx, staleMenu).Return(conflict).Once()
+	suite.mockRepo.On("GetByID", suite.ctx, staleMenu.ID).Return(&freshMenu, nil).Once()
+	suite.mockRepo.On("Update", suite.ctx, &freshMenu).Return(nil).Once()
+	suite.mockPublisher.On("Publish", suite.ctx, mock.AnythingOfType("*events.DomainEvent")).Return(nil)
+
+	// When
+	err := suite.service.ActivateMenu(suite.ctx, string(staleMenu.ID))
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	assert.True(freshMenu.IsActive)
+	suite.mockRepo.AssertExpectations(suite.T())
+}
+
+func (suite *MenuServiceTestSuite) TestActivateMenu_StaleIfMatch_ShouldFailWithoutSaving() {
+	// Given
+	testMenu, _ := menu.NewMenu("Test Menu")
+	testMenu.Deactivate()
+	testMenu.Version = 4
+	ctx := concurrency.WithExpectedVersion(suite.ctx, 3)
+
+	suite.mockRepo.On("GetByID", ctx, testMenu.ID).Return(testMenu, nil).Once()
+
+	// When
+	err := suite.service.ActivateMenu(ctx, string(testMenu.ID))
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.True(sharedErrors.IsPreconditionFailed(err))
+	assert.False(testMenu.IsActive)
+	suite.mockRepo.AssertNotCalled(suite.T(), "Update", mock.Anything, mock.Anything)
+}
+
 // Test DeactivateMenu
 func (suite *MenuServiceTestSuite) TestDeactivateMenu_Success() {
 	// Given
@@ -499,6 +598,9 @@ func (suite *MenuServiceTestSuite) TestDeactivateMenu_Success() {
 
 	suite.mockRepo.On("GetByID", suite.ctx, testMenu.ID).Return(testMenu, nil)
 	suite.mockRepo.On("Update", suite.ctx, testMenu).Return(nil)
+	suite.mockPublisher.On("Publish", suite.ctx, mock.MatchedBy(func(e *events.DomainEvent) bool {
+		return e.Type == events.MenuDeactivatedEvent
+	})).Return(nil)
 
 	// When
 	err := suite.service.DeactivateMenu(suite.ctx, string(testMenu.ID))
@@ -510,4 +612,5 @@ func (suite *MenuServiceTestSuite) TestDeactivateMenu_Success() {
 	assert.NotNil(testMenu.EndDate)
 	
 	suite.mockRepo.AssertExpectations(suite.T())
+	suite.mockPublisher.AssertExpectations(suite.T())
 }
\ No newline at end of file
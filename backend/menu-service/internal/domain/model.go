@@ -51,20 +51,21 @@ type MenuCategory struct {
 
 // MenuItem represents an item on the menu
 type MenuItem struct {
-	ID              ItemID        `json:"id"`
-	Name            string        `json:"name"`
-	Description     string        `json:"description,omitempty"`
-	Price           float64       `json:"price"`
-	CategoryID      CategoryID    `json:"category_id"`
-	IsAvailable     bool          `json:"is_available"`
-	PreparationTime time.Duration `json:"preparation_time"`
-	Ingredients     []string      `json:"ingredients,omitempty"`
-	Allergens       []string      `json:"allergens,omitempty"`
-	NutritionalInfo string        `json:"nutritional_info,omitempty"`
-	ImageURL        string        `json:"image_url,omitempty"`
-	DisplayOrder    int           `json:"display_order"`
-	CreatedAt       time.Time     `json:"created_at"`
-	UpdatedAt       time.Time     `json:"updated_at"`
+	ID              ItemID           `json:"id"`
+	Name            string           `json:"name"`
+	Description     string           `json:"description,omitempty"`
+	Price           float64          `json:"price"`
+	CategoryID      CategoryID       `json:"category_id"`
+	IsAvailable     bool             `json:"is_available"`
+	PreparationTime time.Duration    `json:"preparation_time"`
+	Ingredients     []string         `json:"ingredients,omitempty"`
+	Allergens       []string         `json:"allergens,omitempty"`
+	NutritionalInfo string           `json:"nutritional_info,omitempty"`
+	ImageURL        string           `json:"image_url,omitempty"`
+	DisplayOrder    int              `json:"display_order"`
+	ModifierGroups  []*ModifierGroup `json:"modifier_groups,omitempty"`
+	CreatedAt       time.Time        `json:"created_at"`
+	UpdatedAt       time.Time        `json:"updated_at"`
 }
 
 // NewMenu creates a new menu with validated fields using modern error handling
@@ -372,7 +373,7 @@ func (m *Menu) Clone(newName string) (*Menu, error) {
 		}
 
 		for _, item := range cat.Items {
-			_, err := newMenu.AddMenuItem(
+			newItem, err := newMenu.AddMenuItem(
 				newCat.ID,
 				item.Name,
 				item.Description,
@@ -387,6 +388,10 @@ func (m *Menu) Clone(newName string) (*Menu, error) {
 			if err != nil {
 				return nil, err
 			}
+
+			for _, group := range item.ModifierGroups {
+				newItem.ModifierGroups = append(newItem.ModifierGroups, group.clone())
+			}
 		}
 	}
 
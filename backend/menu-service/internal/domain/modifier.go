@@ -0,0 +1,140 @@
+package menu
+
+import (
+	"time"
+
+	"github.com/restaurant-platform/shared/pkg/errors"
+	"github.com/restaurant-platform/shared/pkg/types"
+)
+
+// Modifier entity markers for type-safe IDs
+type (
+	ModifierGroupEntity  struct{}
+	ModifierOptionEntity struct{}
+)
+
+// Implement EntityMarker interface
+func (ModifierGroupEntity) IsEntity()  {}
+func (ModifierOptionEntity) IsEntity() {}
+
+// Type-safe ID types using generics
+type (
+	ModifierGroupID  = types.ID[ModifierGroupEntity]
+	ModifierOptionID = types.ID[ModifierOptionEntity]
+)
+
+// ModifierGroup is a set of options a guest chooses from when ordering an item,
+// e.g. "Cooking temp: choose 1" or "Add-ons: up to 3"
+type ModifierGroup struct {
+	ID            ModifierGroupID   `json:"id"`
+	Name          string            `json:"name"`
+	MinSelections int               `json:"min_selections"`
+	MaxSelections int               `json:"max_selections"`
+	Options       []*ModifierOption `json:"options"`
+	DisplayOrder  int               `json:"display_order"`
+}
+
+// ModifierOption is a single choice within a modifier group
+type ModifierOption struct {
+	ID         ModifierOptionID `json:"id"`
+	Name       string           `json:"name"`
+	PriceDelta float64          `json:"price_delta"`
+	IsDefault  bool             `json:"is_default"`
+}
+
+// NewModifierOption creates a new modifier option
+func NewModifierOption(name string, priceDelta float64, isDefault bool) (*ModifierOption, error) {
+	if name == "" {
+		return nil, errors.WrapValidation("NewModifierOption", "name", "option name is required", nil)
+	}
+
+	return &ModifierOption{
+		ID:         types.NewID[ModifierOptionEntity]("mopt"),
+		Name:       name,
+		PriceDelta: priceDelta,
+		IsDefault:  isDefault,
+	}, nil
+}
+
+// NewModifierGroup creates a new modifier group with validated selection rules
+func NewModifierGroup(name string, minSelections, maxSelections int, options []*ModifierOption) (*ModifierGroup, error) {
+	if name == "" {
+		return nil, errors.WrapValidation("NewModifierGroup", "name", "group name is required", nil)
+	}
+	if minSelections < 0 {
+		return nil, errors.WrapValidation("NewModifierGroup", "minSelections", "minimum selections cannot be negative", nil)
+	}
+	if maxSelections < 1 || maxSelections < minSelections {
+		return nil, errors.WrapValidation("NewModifierGroup", "maxSelections", "maximum selections must be at least 1 and not below the minimum", nil)
+	}
+	if len(options) < minSelections {
+		return nil, errors.WrapValidation("NewModifierGroup", "options", "group has fewer options than its minimum selections", nil)
+	}
+
+	names := make(map[string]bool)
+	defaults := 0
+	for _, option := range options {
+		if names[option.Name] {
+			return nil, errors.WrapValidation("NewModifierGroup", "options", "duplicate option "+option.Name, nil)
+		}
+		names[option.Name] = true
+		if option.IsDefault {
+			defaults++
+		}
+	}
+	if defaults > maxSelections {
+		return nil, errors.WrapValidation("NewModifierGroup", "options", "more default options than maximum selections", nil)
+	}
+
+	return &ModifierGroup{
+		ID:            types.NewID[ModifierGroupEntity]("mgrp"),
+		Name:          name,
+		MinSelections: minSelections,
+		MaxSelections: maxSelections,
+		Options:       options,
+	}, nil
+}
+
+// clone copies the group with fresh IDs for a new menu version
+func (g *ModifierGroup) clone() *ModifierGroup {
+	options := make([]*ModifierOption, len(g.Options))
+	for i, option := range g.Options {
+		options[i] = &ModifierOption{
+			ID:         types.NewID[ModifierOptionEntity]("mopt"),
+			Name:       option.Name,
+			PriceDelta: option.PriceDelta,
+			IsDefault:  option.IsDefault,
+		}
+	}
+
+	return &ModifierGroup{
+		ID:            types.NewID[ModifierGroupEntity]("mgrp"),
+		Name:          g.Name,
+		MinSelections: g.MinSelections,
+		MaxSelections: g.MaxSelections,
+		Options:       options,
+		DisplayOrder:  g.DisplayOrder,
+	}
+}
+
+// AddModifierGroup attaches a modifier group to a menu item
+func (m *Menu) AddModifierGroup(itemID ItemID, group *ModifierGroup) error {
+	item, err := m.FindItemByID(itemID)
+	if err != nil {
+		return errors.WrapNotFound("AddModifierGroup", "menu item", string(itemID), errors.ErrNotFound)
+	}
+
+	for _, existing := range item.ModifierGroups {
+		if existing.Name == group.Name {
+			return errors.WrapConflict("AddModifierGroup", "modifier_group", "item already has a modifier group named "+group.Name, nil)
+		}
+	}
+
+	group.DisplayOrder = len(item.ModifierGroups)
+	item.ModifierGroups = append(item.ModifierGroups, group)
+
+	now := time.Now()
+	item.UpdatedAt = now
+	m.UpdatedAt = now
+	return nil
+}
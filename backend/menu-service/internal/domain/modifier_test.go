@@ -0,0 +1,141 @@
+package menu
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/restaurant-platform/shared/pkg/errors"
+)
+
+// ModifierTestSuite contains modifier group domain tests
+type ModifierTestSuite struct {
+	suite.Suite
+	menu *Menu
+	item *MenuItem
+}
+
+func (suite *ModifierTestSuite) SetupTest() {
+	suite.menu, _ = NewMenu("Dinner")
+	category, _ := suite.menu.AddCategory("Mains", "Main courses", 1)
+	suite.item, _ = suite.menu.AddMenuItem(category.ID, "Burger", "House burger", 14.50, 0, nil, nil, "", "", 1)
+}
+
+func TestModifierTestSuite(t *testing.T) {
+	suite.Run(t, new(ModifierTestSuite))
+}
+
+func (suite *ModifierTestSuite) options(defaults ...bool) []*ModifierOption {
+	names := []string{"Cheddar", "Swiss", "Blue"}
+	options := make([]*ModifierOption, len(defaults))
+	for i, isDefault := range defaults {
+		options[i], _ = NewModifierOption(names[i], 1.00, isDefault)
+	}
+	return options
+}
+
+func (suite *ModifierTestSuite) TestNewModifierGroup_Success() {
+	// When
+	group, err := NewModifierGroup("Cheese", 0, 2, suite.options(true, false, false))
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	assert.NotEmpty(group.ID)
+	assert.Equal(2, group.MaxSelections)
+	assert.Len(group.Options, 3)
+}
+
+func (suite *ModifierTestSuite) TestNewModifierGroup_InvalidRules_ShouldFail() {
+	assert := assert.New(suite.T())
+
+	cases := []struct {
+		name     string
+		min, max int
+		options  []*ModifierOption
+	}{
+		{"", 0, 1, suite.options(false)},
+		{"Cheese", -1, 1, suite.options(false)},
+		{"Cheese", 2, 1, suite.options(false, false)},
+		{"Cheese", 0, 0, suite.options(false)},
+		{"Cheese", 3, 3, suite.options(false, false)},
+		{"Cheese", 0, 1, suite.options(true, true)},
+	}
+
+	for _, tc := range cases {
+		_, err := NewModifierGroup(tc.name, tc.min, tc.max, tc.options)
+		assert.True(errors.IsValidationError(err), "min=%d max=%d", tc.min, tc.max)
+	}
+}
+
+func (suite *ModifierTestSuite) TestNewModifierGroup_DuplicateOption_ShouldFail() {
+	// Given
+	first, _ := NewModifierOption("Bacon", 2.00, false)
+	second, _ := NewModifierOption("Bacon", 2.50, false)
+
+	// When
+	_, err := NewModifierGroup("Add-ons", 0, 2, []*ModifierOption{first, second})
+
+	// Then
+	assert.True(suite.T(), errors.IsValidationError(err))
+}
+
+func (suite *ModifierTestSuite) TestAddModifierGroup_Success() {
+	// Given
+	cheese, _ := NewModifierGroup("Cheese", 0, 1, suite.options(false, false))
+	sauce, _ := NewModifierGroup("Sauce", 0, 1, suite.options(false))
+
+	// When
+	err1 := suite.menu.AddModifierGroup(suite.item.ID, cheese)
+	err2 := suite.menu.AddModifierGroup(suite.item.ID, sauce)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err1)
+	assert.NoError(err2)
+	assert.Len(suite.item.ModifierGroups, 2)
+	assert.Equal(1, sauce.DisplayOrder)
+}
+
+func (suite *ModifierTestSuite) TestAddModifierGroup_DuplicateName_ShouldFail() {
+	// Given
+	first, _ := NewModifierGroup("Cheese", 0, 1, suite.options(false))
+	second, _ := NewModifierGroup("Cheese", 0, 1, suite.options(false))
+	_ = suite.menu.AddModifierGroup(suite.item.ID, first)
+
+	// When
+	err := suite.menu.AddModifierGroup(suite.item.ID, second)
+
+	// Then
+	assert.True(suite.T(), errors.IsConflictError(err))
+}
+
+func (suite *ModifierTestSuite) TestAddModifierGroup_UnknownItem_ShouldFail() {
+	// Given
+	group, _ := NewModifierGroup("Cheese", 0, 1, suite.options(false))
+
+	// When
+	err := suite.menu.AddModifierGroup(ItemID("missing"), group)
+
+	// Then
+	assert.True(suite.T(), errors.IsNotFound(err))
+}
+
+func (suite *ModifierTestSuite) TestClone_CopiesModifierGroupsWithNewIDs() {
+	// Given
+	group, _ := NewModifierGroup("Cheese", 0, 1, suite.options(true))
+	_ = suite.menu.AddModifierGroup(suite.item.ID, group)
+
+	// When
+	cloned, err := suite.menu.Clone("Dinner v2")
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	clonedItem := cloned.Categories[0].Items[0]
+	assert.Len(clonedItem.ModifierGroups, 1)
+	assert.Equal("Cheese", clonedItem.ModifierGroups[0].Name)
+	assert.NotEqual(group.ID, clonedItem.ModifierGroups[0].ID)
+	assert.True(clonedItem.ModifierGroups[0].Options[0].IsDefault)
+}
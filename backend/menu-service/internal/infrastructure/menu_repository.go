@@ -113,15 +113,37 @@ func (r *MenuRepository) Update(ctx context.Context, m *menu.Menu) error {
 
 	query := `
 		UPDATE menus 
-		SET name = $2, version = $3, categories = $4, is_active = $5, 
+		SET name = $2, version = version + 1, categories = $4, is_active = $5, 
 		    start_date = $6, end_date = $7, updated_at = $8
-		WHERE id = $1`
+		WHERE id = $1 AND version = $3`
 
-	_, err = r.db.ExecContext(ctx, query,
+	result, err := r.db.ExecContext(ctx, query,
 		m.ID.String(), m.Name, m.Version, categoriesJSON, m.IsActive,
 		m.StartDate, nullTimeOrPointer(m.EndDate), m.UpdatedAt)
+	if err != nil {
+		return err
+	}
 
-	return err
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		// Either the menu is gone or another writer saved a newer version since it was loaded
+		var exists bool
+		err := r.db.QueryRowContext(ctx, `SELECT EXISTS(SELECT 1 FROM menus WHERE id = $1)`, m.ID.String()).Scan(&exists)
+		if err != nil {
+			return fmt.Errorf("failed to check menu %s: %w", m.ID.String(), err)
+		}
+		if !exists {
+			return sharedErrors.WrapNotFound("MenuRepository.Update", "menu", m.ID.String(), sql.ErrNoRows)
+		}
+		return sharedErrors.WrapVersionConflict("MenuRepository.Update", "menu", m.ID.String(), m.Version)
+	}
+
+	m.Version++
+	return nil
 }
 
 func (r *MenuRepository) Delete(ctx context.Context, id menu.MenuID) error {
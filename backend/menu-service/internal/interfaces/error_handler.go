@@ -36,8 +36,10 @@ func handleError(c *gin.Context, err error) {
 			statusCode = http.StatusNotFound
 		case "VALIDATION_FAILED":
 			statusCode = http.StatusBadRequest
-		case "CONFLICT":
+		case "CONFLICT", "VERSION_CONFLICT":
 			statusCode = http.StatusConflict
+		case "PRECONDITION_FAILED":
+			statusCode = http.StatusPreconditionFailed
 		default:
 			statusCode = http.StatusInternalServerError
 		}
@@ -5,6 +5,7 @@ import (
 	"net/http"
 	"github.com/restaurant-platform/menu-service/internal/application"
 	menu "github.com/restaurant-platform/menu-service/internal/domain"
+	"github.com/restaurant-platform/shared/pkg/concurrency"
 
 	"github.com/gin-gonic/gin"
 )
@@ -19,6 +20,7 @@ type MenuServiceInterface interface {
 	GetMenuItem(ctx context.Context, id menu.ItemID) (*menu.MenuItem, error)
 	AddCategoryToMenu(ctx context.Context, menuID, name, description string, displayOrder int) (*menu.MenuCategory, error)
 	AddItemToCategory(ctx context.Context, menuID string, categoryID menu.CategoryID, name, description string, price float64) (*menu.MenuItem, error)
+	AddModifierGroup(ctx context.Context, menuID string, itemID menu.ItemID, name string, minSelections, maxSelections int, options []*menu.ModifierOption) (*menu.ModifierGroup, error)
 	SetItemAvailability(ctx context.Context, menuID string, itemID menu.ItemID, isAvailable bool) error
 	ActivateMenu(ctx context.Context, menuID string) error
 	DeactivateMenu(ctx context.Context, menuID string) error
@@ -47,6 +49,7 @@ func (h *MenuHandler) CreateMenu(c *gin.Context) {
 		return
 	}
 
+	concurrency.SetETag(c, menu.Version)
 	handleCreated(c, application.MenuToResponse(menu))
 }
 
@@ -57,6 +60,7 @@ func (h *MenuHandler) GetActiveMenu(c *gin.Context) {
 		return
 	}
 
+	concurrency.SetETag(c, menu.Version)
 	handleOK(c, application.MenuToResponse(menu))
 }
 
@@ -99,6 +103,7 @@ func (h *MenuHandler) GetMenu(c *gin.Context) {
 		return
 	}
 
+	concurrency.SetETag(c, menu.Version)
 	c.JSON(http.StatusOK, application.MenuToResponse(menu))
 }
 
@@ -175,6 +180,35 @@ func (h *MenuHandler) AddMenuItem(c *gin.Context) {
 	c.JSON(http.StatusCreated, application.MenuItemToResponse(item))
 }
 
+func (h *MenuHandler) AddModifierGroup(c *gin.Context) {
+	menuID := c.Param("menuId")
+	itemID := menu.ItemID(c.Param("itemId"))
+
+	var req application.CreateModifierGroupRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleValidationError(c, err)
+		return
+	}
+
+	options := make([]*menu.ModifierOption, 0, len(req.Options))
+	for _, o := range req.Options {
+		option, err := menu.NewModifierOption(o.Name, o.PriceDelta, o.IsDefault)
+		if err != nil {
+			handleError(c, err)
+			return
+		}
+		options = append(options, option)
+	}
+
+	group, err := h.menuService.AddModifierGroup(c.Request.Context(), menuID, itemID, req.Name, req.MinSelections, req.MaxSelections, options)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	handleCreated(c, application.ModifierGroupToResponse(group))
+}
+
 func (h *MenuHandler) UpdateItemAvailability(c *gin.Context) {
 	menuID := c.Param("menuId")
 	itemID := menu.ItemID(c.Param("itemId"))
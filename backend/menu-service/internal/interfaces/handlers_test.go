@@ -87,6 +87,14 @@ func (m *MockMenuService) AddItemToCategory(ctx context.Context, menuID string,
 	return args.Get(0).(*menu.MenuItem), args.Error(1)
 }
 
+func (m *MockMenuService) AddModifierGroup(ctx context.Context, menuID string, itemID menu.ItemID, name string, minSelections, maxSelections int, options []*menu.ModifierOption) (*menu.ModifierGroup, error) {
+	args := m.Called(ctx, menuID, itemID, name, minSelections, maxSelections, options)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*menu.ModifierGroup), args.Error(1)
+}
+
 func (m *MockMenuService) SetItemAvailability(ctx context.Context, menuID string, itemID menu.ItemID, isAvailable bool) error {
 	args := m.Called(ctx, menuID, itemID, isAvailable)
 	return args.Error(0)
@@ -7,6 +7,8 @@ import (
 
 	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
+
+	"github.com/restaurant-platform/shared/pkg/concurrency"
 )
 
 func SetupRouter(menuService *application.MenuService) *gin.Engine {
@@ -17,7 +19,7 @@ func SetupRouter(menuService *application.MenuService) *gin.Engine {
 		AllowOrigins:     []string{"*"},
 		AllowMethods:     []string{"GET", "POST", "PUT", "PATCH", "DELETE", "OPTIONS"},
 		AllowHeaders:     []string{"*"},
-		ExposeHeaders:    []string{"Content-Length"},
+		ExposeHeaders:    []string{"Content-Length", "ETag"},
 		AllowCredentials: true,
 		MaxAge:           12 * time.Hour,
 	}))
@@ -35,6 +37,7 @@ func SetupRouter(menuService *application.MenuService) *gin.Engine {
 	{
 		// Menu routes
 		menus := v1.Group("/menus")
+		menus.Use(concurrency.IfMatchMiddleware())
 		{
 			menus.GET("", menuHandler.GetMenus)
 			menus.POST("", menuHandler.CreateMenu)
@@ -45,6 +48,7 @@ func SetupRouter(menuService *application.MenuService) *gin.Engine {
 			menus.POST("/:id/categories", menuHandler.AddCategory)
 			menus.POST("/:id/items", menuHandler.AddMenuItem)
 			menus.PATCH("/:menuId/items/:itemId/availability", menuHandler.UpdateItemAvailability)
+			menus.POST("/:menuId/items/:itemId/modifier-groups", menuHandler.AddModifierGroup)
 		}
 
 		// Item routes
@@ -96,13 +100,6 @@ func SetupRouter(menuService *application.MenuService) *gin.Engine {
 					"message": "Development stub - no kitchen metrics available",
 				})
 			})
-			kitchen.GET("/stations", func(c *gin.Context) {
-				c.JSON(http.StatusOK, gin.H{
-					"success": true,
-					"data": []interface{}{},
-					"message": "Development stub - no kitchen stations available",
-				})
-			})
 		}
 
 		// Reservations stub
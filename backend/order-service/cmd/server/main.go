@@ -11,10 +11,12 @@ import (
 	"time"
 
 	"github.com/restaurant-platform/order-service/internal/application"
+	"github.com/restaurant-platform/order-service/internal/domain"
 	"github.com/restaurant-platform/order-service/internal/infrastructure"
 	"github.com/restaurant-platform/order-service/internal/interfaces"
 	"github.com/restaurant-platform/shared/events"
 	"github.com/restaurant-platform/shared/pkg/config"
+	"github.com/restaurant-platform/shared/pkg/idempotency"
 )
 
 func main() {
@@ -39,11 +41,144 @@ func main() {
 	}
 	defer eventPublisher.Close()
 
+	// Setup idempotency store for retried write requests
+	idempotencyStore, err := idempotency.NewRedisStore(redisAddr, cfg.Redis.Password, cfg.Redis.DB, "order-service")
+	if err != nil {
+		log.Fatalf("Failed to create idempotency store: %v", err)
+	}
+	defer idempotencyStore.Close()
+
+	// Failed manager PIN attempts are counted in Redis so every instance enforces the lockout
+	pinAttemptStore, err := infrastructure.NewRedisPINAttemptStore(redisAddr, cfg.Redis.Password, cfg.Redis.DB, "order-service")
+	if err != nil {
+		log.Fatalf("Failed to create PIN attempt store: %v", err)
+	}
+	defer pinAttemptStore.Close()
+
 	// Initialize repositories
 	orderRepo := infrastructure.NewOrderRepository(db)
+	paymentRepo := infrastructure.NewPaymentRepository(db)
+	menuItemRepo := infrastructure.NewMenuItemRepository(db)
+	zoneRepo := infrastructure.NewDeliveryZoneRepository(db)
+	driverRepo := infrastructure.NewDriverRepository(db)
+	deliveryRepo := infrastructure.NewDeliveryRepository(db)
+	zReportRepo := infrastructure.NewZReportRepository(db)
+	adjustmentRepo := infrastructure.NewAdjustmentRepository(db)
+	slaAlertRepo := infrastructure.NewSLAAlertRepository(db)
+	itemMappingRepo := infrastructure.NewMarketplaceItemMappingRepository(db)
+	ingestedOrderRepo := infrastructure.NewIngestedOrderRepository(db)
+	loyaltyLedgerRepo := infrastructure.NewLoyaltyLedgerRepository(db)
+	giftCardRepo := infrastructure.NewGiftCardRepository(db)
+	drawerRepo := infrastructure.NewDrawerSessionRepository(db)
+	reservationRepo := infrastructure.NewReservationRepository(db)
+
+	// Initialize payment provider; without one, card tenders are rejected
+	var paymentProvider domain.PaymentProvider
+	switch cfg.Payment.Provider {
+	case "gateway":
+		paymentProvider, err = infrastructure.NewCardGatewayProvider(cfg.Payment.GatewayURL, cfg.Payment.APIKey, &http.Client{Timeout: cfg.Payment.Timeout})
+		if err != nil {
+			log.Fatalf("Failed to parse payment config: %v", err)
+		}
+	case "":
+		log.Printf("No payment provider configured, card tenders are disabled")
+	default:
+		log.Fatalf("Failed to parse payment config: unknown provider %q", cfg.Payment.Provider)
+	}
+
+	// Initialize geocoder for delivery addresses
+	var geocoder domain.Geocoder
+	switch cfg.Delivery.Geocoder {
+	case "nominatim":
+		geocoder, err = infrastructure.NewNominatimGeocoder(cfg.Delivery.GeocoderURL, cfg.Delivery.GeocoderUserAgent, &http.Client{Timeout: 10 * time.Second})
+		if err != nil {
+			log.Fatalf("Failed to parse delivery config: %v", err)
+		}
+	default:
+		log.Fatalf("Failed to parse delivery config: unknown geocoder %q", cfg.Delivery.Geocoder)
+	}
+	origin := domain.Coordinates{Lat: cfg.Delivery.OriginLat, Lng: cfg.Delivery.OriginLng}
+
+	// Initialize receipt renderer
+	receiptRenderer, err := infrastructure.NewReceiptRenderer(cfg.Receipt.TemplateDir)
+	if err != nil {
+		log.Fatalf("Failed to load receipt templates: %v", err)
+	}
+	restaurant := domain.RestaurantInfo{
+		Name:    cfg.Receipt.RestaurantName,
+		Address: cfg.Receipt.Address,
+		Phone:   cfg.Receipt.Phone,
+		TaxID:   cfg.Receipt.TaxID,
+		Footer:  cfg.Receipt.Footer,
+	}
+
+	// Business days run from the reporting cutoff to the same time the next day
+	businessDayCutoff, err := domain.ParseBusinessDayCutoff(cfg.Reporting.BusinessDayCutoff)
+	if err != nil {
+		log.Fatalf("Failed to parse reporting config: %v", err)
+	}
+
+	// Voids and refunds above the threshold need a manager's role or PIN override
+	if cfg.Approval.MaxPINAttempts <= 0 || cfg.Approval.PINLockout <= 0 {
+		log.Fatalf("Failed to parse approval config: PIN attempts and lockout must be positive")
+	}
+	pinVerifier := infrastructure.NewConfigPINVerifier(cfg.Approval.ManagerPINs, pinAttemptStore, cfg.Approval.MaxPINAttempts, cfg.Approval.PINLockout)
+	approvalPolicy := domain.ApprovalPolicy{Threshold: cfg.Approval.Threshold}
+
+	// Orders are monitored against per-status service levels
+	slaPolicy, err := domain.NewSLAPolicy(cfg.SLA.Thresholds, cfg.SLA.UnpaidTimeout)
+	if err != nil {
+		log.Fatalf("Failed to parse SLA config: %v", err)
+	}
+	if cfg.SLA.CheckInterval <= 0 {
+		log.Fatalf("Failed to parse SLA config: check interval must be positive")
+	}
+
+	// Delivery marketplaces send orders through the adapter for their format
+	marketplaceClient := &http.Client{Timeout: 10 * time.Second}
+	var adapters []domain.MarketplaceAdapter
+	for name, marketplace := range cfg.Marketplace.Marketplaces {
+		switch marketplace.Format {
+		case "generic":
+			adapter, err := infrastructure.NewGenericMarketplaceAdapter(name, marketplace.Secret, marketplace.StatusURL, marketplaceClient)
+			if err != nil {
+				log.Fatalf("Failed to parse marketplace config: %v", err)
+			}
+			adapters = append(adapters, adapter)
+		default:
+			log.Fatalf("Failed to parse marketplace config: unknown format %q for marketplace %s", marketplace.Format, name)
+		}
+	}
+	marketplaceAdapters := domain.NewMarketplaceAdapters(adapters...)
+	kitchenLoadPolicy := domain.KitchenLoadPolicy{MaxOrders: cfg.Marketplace.MaxKitchenLoad}
+
+	// Customers earn loyalty points on completed orders and redeem them as order discounts
+	loyaltyTiers := make([]domain.LoyaltyTier, len(cfg.Loyalty.Tiers))
+	for i, tier := range cfg.Loyalty.Tiers {
+		loyaltyTiers[i] = domain.LoyaltyTier{Name: tier.Name, MinPoints: tier.MinPoints, Multiplier: tier.Multiplier}
+	}
+	loyaltyProgram, err := domain.NewLoyaltyProgram(cfg.Loyalty.PointsPerDollar, cfg.Loyalty.CategoryRates, cfg.Loyalty.PointValue,
+		cfg.Loyalty.MinRedemption, cfg.Loyalty.Expiry, loyaltyTiers)
+	if err != nil {
+		log.Fatalf("Failed to parse loyalty config: %v", err)
+	}
+	if cfg.Loyalty.ExpiryCheckInterval <= 0 {
+		log.Fatalf("Failed to parse loyalty config: expiry check interval must be positive")
+	}
 
 	// Initialize services
-	orderService := application.NewOrderService(orderRepo, eventPublisher)
+	orderService := application.NewOrderService(orderRepo, menuItemRepo, eventPublisher)
+	giftCardService := application.NewGiftCardService(giftCardRepo)
+	paymentService := application.NewPaymentService(orderRepo, paymentRepo, paymentProvider, giftCardService, drawerRepo, eventPublisher)
+	deliveryService := application.NewDeliveryService(orderRepo, zoneRepo, driverRepo, deliveryRepo, geocoder, origin, eventPublisher)
+	receiptService := application.NewReceiptService(orderRepo, paymentRepo, receiptRenderer, restaurant)
+	reportService := application.NewReportService(orderRepo, paymentRepo, zReportRepo, businessDayCutoff)
+	tableService := application.NewTableService(orderRepo, paymentRepo, reservationRepo, eventPublisher)
+	adjustmentService := application.NewAdjustmentService(orderRepo, paymentRepo, adjustmentRepo, paymentProvider, giftCardService, drawerRepo, pinVerifier, approvalPolicy, eventPublisher)
+	slaService := application.NewSLAService(orderRepo, paymentRepo, slaAlertRepo, slaPolicy, eventPublisher)
+	marketplaceService := application.NewMarketplaceService(orderRepo, menuItemRepo, itemMappingRepo, ingestedOrderRepo, marketplaceAdapters, kitchenLoadPolicy, eventPublisher)
+	loyaltyService := application.NewLoyaltyService(orderRepo, loyaltyLedgerRepo, loyaltyProgram)
+	drawerService := application.NewDrawerService(drawerRepo, paymentRepo)
 
 	// Setup event consumer for kitchen events
 	redisConsumer, err := events.NewRedisStreamConsumer(
@@ -70,6 +205,119 @@ func main() {
 		log.Fatalf("Failed to subscribe to kitchen events: %v", err)
 	}
 
+	// Setup event consumer for menu events
+	menuConsumer, err := events.NewRedisStreamConsumer(
+		redisAddr,
+		cfg.Redis.Password,
+		cfg.Redis.DB,
+		events.MenuStream,
+		"order-service-group",
+		"order-service-consumer-1",
+	)
+	if err != nil {
+		log.Fatalf("Failed to create menu event consumer: %v", err)
+	}
+
+	// Keep the local menu read model current
+	menuEventHandler := application.NewMenuEventHandler(menuItemRepo)
+
+	// Load the active menu before the consumer catches up with menu events
+	menuCatalog, err := infrastructure.NewMenuServiceCatalog(cfg.MenuService.URL, &http.Client{Timeout: 10 * time.Second})
+	if err != nil {
+		log.Fatalf("Failed to parse menu service config: %v", err)
+	}
+	if err := menuEventHandler.LoadActiveMenu(context.Background(), menuCatalog); err != nil {
+		log.Printf("Failed to load active menu, waiting for menu events: %v", err)
+	}
+
+	err = menuConsumer.Subscribe(context.Background(), []events.EventType{
+		events.MenuActivatedEvent,
+		events.MenuDeactivatedEvent,
+		events.MenuItemAddedEvent,
+		events.MenuItemUpdatedEvent,
+		events.MenuItemRemovedEvent,
+		events.ItemAvailabilityChangedEvent,
+	}, menuEventHandler.HandleMenuEvent)
+	if err != nil {
+		log.Fatalf("Failed to subscribe to menu events: %v", err)
+	}
+
+	// Setup event consumer for reservation events
+	reservationConsumer, err := events.NewRedisStreamConsumer(
+		redisAddr,
+		cfg.Redis.Password,
+		cfg.Redis.DB,
+		events.ReservationStream,
+		"order-service-group",
+		"order-service-consumer-1",
+	)
+	if err != nil {
+		log.Fatalf("Failed to create reservation event consumer: %v", err)
+	}
+
+	// Keep the local reservation read model current, for guest counts on dine-in orders
+	reservationEventHandler := application.NewReservationEventHandler(reservationRepo)
+
+	err = reservationConsumer.Subscribe(context.Background(), []events.EventType{
+		events.ReservationCreatedEvent,
+		events.ReservationConfirmedEvent,
+		events.ReservationCancelledEvent,
+		events.ReservationCompletedEvent,
+		events.ReservationNoShowEvent,
+		events.ReservationUpdatedEvent,
+	}, reservationEventHandler.HandleReservationEvent)
+	if err != nil {
+		log.Fatalf("Failed to subscribe to reservation events: %v", err)
+	}
+
+	// Setup event consumer for our own order events, reporting progress back to marketplaces
+	marketplaceConsumer, err := events.NewRedisStreamConsumer(
+		redisAddr,
+		cfg.Redis.Password,
+		cfg.Redis.DB,
+		events.OrderStream,
+		"order-service-marketplace-group",
+		"order-service-consumer-1",
+	)
+	if err != nil {
+		log.Fatalf("Failed to create marketplace event consumer: %v", err)
+	}
+
+	err = marketplaceConsumer.Subscribe(context.Background(), []events.EventType{
+		events.OrderStatusChangedEvent,
+		events.OrderCompletedEvent,
+		events.OrderCancelledEvent,
+		events.DeliveryPickedUpEvent,
+		events.DeliveryDeliveredEvent,
+	}, marketplaceService.HandleOrderEvent)
+	if err != nil {
+		log.Fatalf("Failed to subscribe to order events: %v", err)
+	}
+
+	// Setup event consumer for our own order events, booking loyalty points
+	loyaltyConsumer, err := events.NewRedisStreamConsumer(
+		redisAddr,
+		cfg.Redis.Password,
+		cfg.Redis.DB,
+		events.OrderStream,
+		"order-service-loyalty-group",
+		"order-service-consumer-1",
+	)
+	if err != nil {
+		log.Fatalf("Failed to create loyalty event consumer: %v", err)
+	}
+
+	err = loyaltyConsumer.Subscribe(context.Background(), []events.EventType{
+		events.OrderCompletedEvent,
+		events.DeliveryDeliveredEvent,
+		events.OrderRefundedEvent,
+		events.OrderCancelledEvent,
+		events.OrderMergedEvent,
+	}, loyaltyService.HandleOrderEvent)
+	if err != nil {
+		log.Fatalf("Failed to subscribe to order events: %v", err)
+	}
+
 	// Start consuming events in the background
 	go func() {
 		if err := redisConsumer.Start(context.Background()); err != nil {
@@ -77,8 +325,98 @@ func main() {
 		}
 	}()
 
+	go func() {
+		if err := menuConsumer.Start(context.Background()); err != nil {
+			log.Printf("Menu event consumer error: %v", err)
+		}
+	}()
+
+	go func() {
+		if err := reservationConsumer.Start(context.Background()); err != nil {
+			log.Printf("Reservation event consumer error: %v", err)
+		}
+	}()
+
+	go func() {
+		if err := marketplaceConsumer.Start(context.Background()); err != nil {
+			log.Printf("Marketplace event consumer error: %v", err)
+		}
+	}()
+
+	go func() {
+		if err := loyaltyConsumer.Start(context.Background()); err != nil {
+			log.Printf("Loyalty event consumer error: %v", err)
+		}
+	}()
+
+	// Release scheduled orders to the kitchen as they come due
+	go func() {
+		ticker := time.NewTicker(1 * time.Minute)
+		defer ticker.Stop()
+
+		for range ticker.C {
+			released, err := orderService.ReleaseDueOrders(context.Background(), time.Now())
+			if err != nil {
+				log.Printf("Failed to release scheduled orders: %v", err)
+			} else if released > 0 {
+				log.Printf("Released %d scheduled orders to the kitchen", released)
+			}
+		}
+	}()
+
+	// Settle orders whose payment was taken in full but whose status change failed
+	go func() {
+		ticker := time.NewTicker(1 * time.Minute)
+		defer ticker.Stop()
+
+		for range ticker.C {
+			settled, err := paymentService.SettlePaidOrders(context.Background())
+			if err != nil {
+				log.Printf("Failed to settle paid orders: %v", err)
+			} else if settled > 0 {
+				log.Printf("Settled %d paid orders", settled)
+			}
+		}
+	}()
+
+	// Raise SLA alerts and cancel orders that were never paid
+	go func() {
+		ticker := time.NewTicker(cfg.SLA.CheckInterval)
+		defer ticker.Stop()
+
+		for range ticker.C {
+			now := time.Now()
+			if cancelled, err := slaService.CancelUnpaidOrders(context.Background(), now); err != nil {
+				log.Printf("Failed to cancel unpaid orders: %v", err)
+			} else if cancelled > 0 {
+				log.Printf("Cancelled %d unpaid orders", cancelled)
+			}
+
+			if breached, err := slaService.CheckOrders(context.Background(), now); err != nil {
+				log.Printf("Failed to check order SLAs: %v", err)
+			} else if breached > 0 {
+				log.Printf("%d orders breached their SLA", breached)
+			}
+		}
+	}()
+
+	// Write off loyalty points that reached their expiry date unspent
+	go func() {
+		ticker := time.NewTicker(cfg.Loyalty.ExpiryCheckInterval)
+		defer ticker.Stop()
+
+		for range ticker.C {
+			expired, err := loyaltyService.ExpirePoints(context.Background(), time.Now())
+			if err != nil {
+				log.Printf("Failed to expire loyalty points: %v", err)
+			} else if expired > 0 {
+				log.Printf("Expired loyalty points of %d customers", expired)
+			}
+		}
+	}()
+
 	// Setup router
-	router := interfaces.SetupRouter(orderService)
+	router := interfaces.SetupRouter(orderService, paymentService, deliveryService, receiptService, reportService, adjustmentService, tableService, slaService, marketplaceService, loyaltyService, giftCardService, drawerService, idempotencyStore, cfg.JWT.SecretKey)
 
 	// Create HTTP server
 	srv := &http.Server{
@@ -107,8 +445,12 @@ func main() {
 	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 	defer cancel()
 
-	// Stop event consumer
+	// Stop event consumers
 	redisConsumer.Stop()
+	menuConsumer.Stop()
+	reservationConsumer.Stop()
+	marketplaceConsumer.Stop()
+	loyaltyConsumer.Stop()
 
 	if err := srv.Shutdown(ctx); err != nil {
 		log.Fatalf("Order Service forced to shutdown: %v", err)
@@ -0,0 +1,323 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/restaurant-platform/order-service/internal/domain"
+	"github.com/restaurant-platform/shared/events"
+	"github.com/restaurant-platform/shared/pkg/auth"
+	"github.com/restaurant-platform/shared/pkg/concurrency"
+	"github.com/restaurant-platform/shared/pkg/errors"
+)
+
+// AdjustmentService voids unpaid orders and refunds paid ones, enforcing manager approval
+type AdjustmentService struct {
+	orderRepo      domain.OrderRepository
+	paymentRepo    domain.PaymentRepository
+	adjustmentRepo domain.AdjustmentRepository
+	tenders        tenderGateway
+	pinVerifier    domain.ManagerPINVerifier
+	policy         domain.ApprovalPolicy
+	eventPublisher events.EventPublisher
+}
+
+// NewAdjustmentService creates a new adjustment service
+func NewAdjustmentService(orderRepo domain.OrderRepository, paymentRepo domain.PaymentRepository, adjustmentRepo domain.AdjustmentRepository,
+	provider domain.PaymentProvider, giftCards domain.GiftCardProcessor, drawerRepo domain.DrawerSessionRepository, pinVerifier domain.ManagerPINVerifier,
+	policy domain.ApprovalPolicy, eventPublisher events.EventPublisher) *AdjustmentService {
+	return &AdjustmentService{
+		orderRepo:      orderRepo,
+		paymentRepo:    paymentRepo,
+		adjustmentRepo: adjustmentRepo,
+		tenders:        tenderGateway{provider: provider, giftCards: giftCards, drawers: drawerRepo},
+		pinVerifier:    pinVerifier,
+		policy:         policy,
+		eventPublisher: eventPublisher,
+	}
+}
+
+// VoidOrder cancels an unpaid order with a reason code, voiding any partial payment taken
+func (s *AdjustmentService) VoidOrder(ctx context.Context, orderID domain.OrderID, reason domain.AdjustmentReason, notes string, override *domain.ManagerOverride) (*domain.Adjustment, error) {
+	order, err := s.orderRepo.GetByID(ctx, orderID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get order: %w", err)
+	}
+
+	// The client's If-Match is checked before any tender is voided
+	if err := concurrency.CheckVersion(ctx, "VoidOrder", "order", orderID.String(), order.Version); err != nil {
+		return nil, err
+	}
+	ctx = concurrency.WithoutExpectedVersion(ctx)
+
+	adjustment, err := order.NewVoid(reason, notes, actorFromContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	if err := s.approve(ctx, adjustment, order.Status, 0, override); err != nil {
+		return nil, err
+	}
+
+	if err := s.voidPartialPayment(ctx, orderID, adjustment); err != nil {
+		return nil, err
+	}
+
+	previousStatus := order.Status
+	order, err = applyOrderChange(ctx, s.orderRepo, orderID, order, func(order *domain.Order) error {
+		return order.ApplyAdjustment(adjustment)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.adjustmentRepo.Create(ctx, adjustment); err != nil {
+		return nil, fmt.Errorf("failed to save adjustment: %w", err)
+	}
+
+	log.Printf("Voided order %s (%.2f, %s) by %s", orderID, adjustment.Amount, adjustment.Reason, adjustment.RequestedBy)
+
+	s.publishCancelled(ctx, order, previousStatus, adjustment)
+	s.publishAdjustment(ctx, events.OrderVoidedEvent, order, adjustment)
+
+	return adjustment, nil
+}
+
+// RefundOrder refunds a paid order in full, or only the listed items with their tax.
+// The refund is returned to the most recent tenders first.
+func (s *AdjustmentService) RefundOrder(ctx context.Context, orderID domain.OrderID, itemIDs []domain.OrderItemID, reason domain.AdjustmentReason, notes string, override *domain.ManagerOverride) (*domain.Adjustment, error) {
+	order, err := s.orderRepo.GetByID(ctx, orderID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get order: %w", err)
+	}
+
+	// The client's If-Match is checked before any tender is refunded
+	if err := concurrency.CheckVersion(ctx, "RefundOrder", "order", orderID.String(), order.Version); err != nil {
+		return nil, err
+	}
+	ctx = concurrency.WithoutExpectedVersion(ctx)
+
+	payment, err := s.paymentRepo.GetByOrderID(ctx, orderID)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil, errors.WrapConflict("RefundOrder", "payment", "order has no payment to refund", nil)
+		}
+		return nil, fmt.Errorf("failed to get payment: %w", err)
+	}
+
+	adjustment, err := order.NewRefund(itemIDs, payment.Refundable(), reason, notes, actorFromContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	if err := s.approve(ctx, adjustment, order.Status, payment.AmountRefunded, override); err != nil {
+		return nil, err
+	}
+
+	// The refund is reserved on the payment before any money moves, so a concurrent
+	// refund of the same order sees the balance as already taken
+	var refunds []*domain.Refund
+	payment, err = applyPaymentChange(ctx, s.paymentRepo, payment.ID, payment, func(payment *domain.Payment) error {
+		// A refund made meanwhile may have taken the order's refunds over the threshold
+		if adjustment.ApprovedBy == "" && s.policy.RequiresApproval(adjustment, order.Status, payment.AmountRefunded) {
+			return errors.WrapForbidden("RefundOrder", fmt.Sprintf("refunds of %.2f on the order require manager approval", payment.AmountRefunded+adjustment.Amount), nil)
+		}
+
+		allocations, err := payment.AllocateRefund(adjustment.Amount)
+		if err != nil {
+			return err
+		}
+
+		refunds = refunds[:0]
+		for _, allocation := range allocations {
+			refund, err := payment.BeginRefund(allocation.TenderID, allocation.Amount, adjustmentDescription(adjustment))
+			if err != nil {
+				return fmt.Errorf("failed to refund tender: %w", err)
+			}
+			refunds = append(refunds, refund)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Each refund is saved as soon as its money has been returned
+	paymentID := payment.ID
+	for i, refund := range refunds {
+		payment, err = completeRefund(ctx, s.paymentRepo, s.tenders, payment, refund)
+		if err != nil {
+			s.releaseRefunds(ctx, paymentID, refunds[i+1:])
+			return nil, err
+		}
+	}
+
+	// The money has been returned, so only recording the refund on the order is retried on a conflict
+	order, err = applyOrderChange(ctx, s.orderRepo, orderID, order, func(order *domain.Order) error {
+		return order.ApplyAdjustment(adjustment)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.adjustmentRepo.Create(ctx, adjustment); err != nil {
+		return nil, fmt.Errorf("failed to save adjustment: %w", err)
+	}
+
+	log.Printf("Refunded %.2f of order %s (%s) by %s", adjustment.Amount, orderID, adjustment.Reason, adjustment.RequestedBy)
+
+	s.publishAdjustment(ctx, events.OrderRefundedEvent, order, adjustment)
+
+	return adjustment, nil
+}
+
+// GetAdjustments retrieves the voids and refunds of an order
+func (s *AdjustmentService) GetAdjustments(ctx context.Context, orderID domain.OrderID) ([]*domain.Adjustment, error) {
+	if _, err := s.orderRepo.GetByID(ctx, orderID); err != nil {
+		return nil, fmt.Errorf("failed to get order: %w", err)
+	}
+
+	adjustments, err := s.adjustmentRepo.FindByOrder(ctx, orderID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get adjustments: %w", err)
+	}
+	return adjustments, nil
+}
+
+// Helper methods
+
+// approve records the manager approval the policy requires: the acting user's own
+// manager role, or else a manager's PIN override. alreadyRefunded is what has been
+// refunded on the order before this adjustment.
+func (s *AdjustmentService) approve(ctx context.Context, adjustment *domain.Adjustment, orderStatus domain.OrderStatus, alreadyRefunded float64, override *domain.ManagerOverride) error {
+	if !s.policy.RequiresApproval(adjustment, orderStatus, alreadyRefunded) {
+		return nil
+	}
+
+	if auth.IsManager(ctx) {
+		adjustment.Approve(&domain.Approval{ApprovedBy: actorFromContext(ctx), Method: domain.ApprovalMethodRole})
+		return nil
+	}
+
+	if override == nil || override.ManagerID == "" || override.PIN == "" {
+		return errors.WrapForbidden("approve", fmt.Sprintf("%s of %.2f requires manager approval", adjustment.Type, adjustment.Amount), nil)
+	}
+	if err := s.pinVerifier.VerifyPIN(ctx, override.ManagerID, override.PIN); err != nil {
+		return err
+	}
+
+	adjustment.Approve(&domain.Approval{ApprovedBy: override.ManagerID, Method: domain.ApprovalMethodPIN})
+	return nil
+}
+
+// releaseRefunds cancels refunds reserved on a payment that were never attempted
+func (s *AdjustmentService) releaseRefunds(ctx context.Context, paymentID domain.PaymentID, refunds []*domain.Refund) {
+	if len(refunds) == 0 {
+		return
+	}
+
+	_, err := applyPaymentChange(ctx, s.paymentRepo, paymentID, nil, func(payment *domain.Payment) error {
+		for _, refund := range refunds {
+			if err := payment.CancelRefund(refund.ID); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		log.Printf("Failed to release %d refunds on payment %s: %v", len(refunds), paymentID, err)
+	}
+}
+
+// voidPartialPayment releases tenders already taken towards an order that is being voided
+func (s *AdjustmentService) voidPartialPayment(ctx context.Context, orderID domain.OrderID, adjustment *domain.Adjustment) error {
+	payment, err := s.paymentRepo.GetByOrderID(ctx, orderID)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to get payment: %w", err)
+	}
+	if !payment.CanAcceptTender() {
+		return nil
+	}
+
+	payment, err = applyPaymentChange(ctx, s.paymentRepo, payment.ID, payment, func(payment *domain.Payment) error {
+		if err := payment.Void(adjustmentDescription(adjustment)); err != nil {
+			return fmt.Errorf("failed to void payment: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = voidTenders(ctx, s.paymentRepo, s.tenders, payment)
+	return err
+}
+
+func (s *AdjustmentService) publishCancelled(ctx context.Context, order *domain.Order, previousStatus domain.OrderStatus, adjustment *domain.Adjustment) {
+	eventData, err := events.ToEventData(events.OrderStatusChangedData{
+		OrderID:   string(order.ID),
+		OldStatus: string(previousStatus),
+		NewStatus: string(order.Status),
+		UpdatedBy: adjustment.RequestedBy,
+		Reason:    adjustmentDescription(adjustment),
+	})
+	if err != nil {
+		log.Printf("Failed to convert event data to map: %v", err)
+		return
+	}
+
+	event := events.NewDomainEvent(events.OrderCancelledEvent, string(order.ID), eventData).
+		WithMetadata("service", "order-service").
+		WithMetadata("customer_id", order.CustomerID)
+
+	if err := s.eventPublisher.Publish(ctx, event); err != nil {
+		log.Printf("Failed to publish order cancelled event: %v", err)
+	}
+}
+
+func (s *AdjustmentService) publishAdjustment(ctx context.Context, eventType events.EventType, order *domain.Order, adjustment *domain.Adjustment) {
+	lines := make([]events.OrderAdjustmentLineData, 0, len(adjustment.Lines))
+	for _, line := range adjustment.Lines {
+		lines = append(lines, events.OrderAdjustmentLineData{
+			ItemID:     string(line.ItemID),
+			MenuItemID: line.MenuItemID,
+			Name:       line.Name,
+			Quantity:   line.Quantity,
+			Amount:     line.Amount,
+		})
+	}
+
+	eventData, err := events.ToEventData(events.OrderAdjustedData{
+		OrderID:      string(order.ID),
+		AdjustmentID: string(adjustment.ID),
+		Type:         string(adjustment.Type),
+		Reason:       string(adjustment.Reason),
+		Notes:        adjustment.Notes,
+		Amount:       adjustment.Amount,
+		Lines:        lines,
+		RequestedBy:  adjustment.RequestedBy,
+		ApprovedBy:   adjustment.ApprovedBy,
+	})
+	if err != nil {
+		log.Printf("Failed to convert event data to map: %v", err)
+		return
+	}
+
+	event := events.NewDomainEvent(eventType, string(order.ID), eventData).
+		WithMetadata("service", "order-service").
+		WithMetadata("customer_id", order.CustomerID)
+
+	if err := s.eventPublisher.Publish(ctx, event); err != nil {
+		log.Printf("Failed to publish %s event: %v", eventType, err)
+	}
+}
+
+// adjustmentDescription is the reason recorded on the order history and payment
+func adjustmentDescription(adjustment *domain.Adjustment) string {
+	if adjustment.Notes == "" {
+		return string(adjustment.Reason)
+	}
+	return fmt.Sprintf("%s: %s", adjustment.Reason, adjustment.Notes)
+}
@@ -0,0 +1,517 @@
+package application
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/restaurant-platform/order-service/internal/domain"
+	"github.com/restaurant-platform/order-service/internal/infrastructure"
+	"github.com/restaurant-platform/shared/events"
+	"github.com/restaurant-platform/shared/pkg/auth"
+	"github.com/restaurant-platform/shared/pkg/concurrency"
+	sharedErrors "github.com/restaurant-platform/shared/pkg/errors"
+)
+
+// MockAdjustmentRepository is a mock implementation of AdjustmentRepository
+type MockAdjustmentRepository struct {
+	mock.Mock
+}
+
+func (m *MockAdjustmentRepository) Create(ctx context.Context, adjustment *domain.Adjustment) error {
+	args := m.Called(ctx, adjustment)
+	return args.Error(0)
+}
+
+func (m *MockAdjustmentRepository) FindByOrder(ctx context.Context, orderID domain.OrderID) ([]*domain.Adjustment, error) {
+	args := m.Called(ctx, orderID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.Adjustment), args.Error(1)
+}
+
+// MockPINVerifier is a mock implementation of ManagerPINVerifier
+type MockPINVerifier struct {
+	mock.Mock
+}
+
+func (m *MockPINVerifier) VerifyPIN(ctx context.Context, managerID, pin string) error {
+	args := m.Called(ctx, managerID, pin)
+	return args.Error(0)
+}
+
+// memoryPaymentRepository is an in-memory PaymentRepository that enforces the payment
+// version on Update the way the database does, so concurrent refunds can be raced
+type memoryPaymentRepository struct {
+	mu       sync.Mutex
+	payments map[domain.PaymentID][]byte
+}
+
+func newMemoryPaymentRepository(payments ...*domain.Payment) *memoryPaymentRepository {
+	r := &memoryPaymentRepository{payments: make(map[domain.PaymentID][]byte)}
+	for _, payment := range payments {
+		r.Create(context.Background(), payment)
+	}
+	return r
+}
+
+func (r *memoryPaymentRepository) Create(ctx context.Context, payment *domain.Payment) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stored, err := json.Marshal(payment)
+	r.payments[payment.ID] = stored
+	return err
+}
+
+func (r *memoryPaymentRepository) GetByID(ctx context.Context, id domain.PaymentID) (*domain.Payment, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stored, ok := r.payments[id]
+	if !ok {
+		return nil, sharedErrors.WrapNotFound("GetByID", "payment", id.String(), sharedErrors.ErrNotFound)
+	}
+	var payment domain.Payment
+	return &payment, json.Unmarshal(stored, &payment)
+}
+
+func (r *memoryPaymentRepository) GetByOrderID(ctx context.Context, orderID domain.OrderID) (*domain.Payment, error) {
+	payments, err := r.FindByOrderIDs(ctx, []domain.OrderID{orderID})
+	if err != nil || len(payments) == 0 {
+		return nil, sharedErrors.WrapNotFound("GetByOrderID", "payment", orderID.String(), sharedErrors.ErrNotFound)
+	}
+	return payments[0], nil
+}
+
+func (r *memoryPaymentRepository) FindByOrderIDs(ctx context.Context, orderIDs []domain.OrderID) ([]*domain.Payment, error) {
+	r.mu.Lock()
+	ids := make([]domain.PaymentID, 0, len(r.payments))
+	for id := range r.payments {
+		ids = append(ids, id)
+	}
+	r.mu.Unlock()
+
+	var payments []*domain.Payment
+	for _, id := range ids {
+		payment, err := r.GetByID(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		for _, orderID := range orderIDs {
+			if payment.OrderID == orderID && payment.Status != domain.PaymentStatusVoided {
+				payments = append(payments, payment)
+			}
+		}
+	}
+	return payments, nil
+}
+
+func (r *memoryPaymentRepository) FindByDrawerSession(ctx context.Context, sessionID domain.DrawerSessionID) ([]*domain.Payment, error) {
+	return nil, nil
+}
+
+func (r *memoryPaymentRepository) Update(ctx context.Context, payment *domain.Payment) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var current domain.Payment
+	if err := json.Unmarshal(r.payments[payment.ID], &current); err != nil {
+		return err
+	}
+	if current.Version != payment.Version {
+		return sharedErrors.WrapVersionConflict("Update", "payment", payment.ID.String(), payment.Version)
+	}
+
+	payment.Version++
+	stored, err := json.Marshal(payment)
+	r.payments[payment.ID] = stored
+	return err
+}
+
+// countingPaymentProvider counts the refunds that reach the payment provider
+type countingPaymentProvider struct {
+	domain.PaymentProvider
+	refunds int32
+}
+
+func (p *countingPaymentProvider) Refund(ctx context.Context, providerRef string, amount float64) (string, error) {
+	atomic.AddInt32(&p.refunds, 1)
+	return p.PaymentProvider.Refund(ctx, providerRef, amount)
+}
+
+// AdjustmentServiceTestSuite contains all void and refund tests
+type AdjustmentServiceTestSuite struct {
+	suite.Suite
+	service            *AdjustmentService
+	mockOrderRepo      *MockOrderRepository
+	mockPaymentRepo    *MockPaymentRepository
+	mockAdjustmentRepo *MockAdjustmentRepository
+	mockVerifier       *MockPINVerifier
+	mockPublisher      *MockEventPublisher
+	provider           *infrastructure.FakePaymentProvider
+	order              *domain.Order
+	ctx                context.Context
+}
+
+func (suite *AdjustmentServiceTestSuite) SetupTest() {
+	suite.mockOrderRepo = new(MockOrderRepository)
+	suite.mockPaymentRepo = new(MockPaymentRepository)
+	suite.mockAdjustmentRepo = new(MockAdjustmentRepository)
+	suite.mockVerifier = new(MockPINVerifier)
+	suite.mockPublisher = new(MockEventPublisher)
+	suite.provider = infrastructure.NewFakePaymentProvider()
+	suite.service = NewAdjustmentService(suite.mockOrderRepo, suite.mockPaymentRepo, suite.mockAdjustmentRepo,
+		suite.provider, NewGiftCardService(newMemoryGiftCardRepository()), new(MockDrawerSessionRepository), suite.mockVerifier,
+		domain.ApprovalPolicy{Threshold: 50.00}, suite.mockPublisher)
+	suite.ctx = auth.WithActor(context.Background(), "cashier-1")
+
+	// 2 x 10.00 + 1 x 5.00 plus 10% tax = 27.50
+	suite.order, _ = domain.NewOrder("customer-123", domain.OrderTypeTakeout)
+	suite.order.AddItem("burger", "Burger", 2, 10.00, nil, "")
+	suite.order.AddItem("fries", "Fries", 1, 5.00, nil, "")
+}
+
+func TestAdjustmentServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(AdjustmentServiceTestSuite))
+}
+
+func (suite *AdjustmentServiceTestSuite) notFound() error {
+	return sharedErrors.WrapNotFound("PaymentRepository.GetByOrderID", "payment", string(suite.order.ID), sharedErrors.ErrNotFound)
+}
+
+// paid settles the order with a card tender and returns its payment
+func (suite *AdjustmentServiceTestSuite) paid(status domain.OrderStatus) *domain.Payment {
+	suite.order.UpdateStatus(domain.OrderStatusPaid, "cashier-1", "")
+	if status != domain.OrderStatusPaid {
+		suite.order.Status = status
+	}
+	payment, _ := domain.NewPayment(suite.order.ID, suite.order.TotalAmount)
+	charge, _ := suite.provider.Charge(suite.ctx, domain.ChargeRequest{Amount: suite.order.TotalAmount})
+	payment.AddTender(domain.TenderTypeCard, suite.order.TotalAmount, 0, "", charge.ProviderRef)
+	return payment
+}
+
+// Test VoidOrder
+func (suite *AdjustmentServiceTestSuite) TestVoidOrder_BelowThreshold_Success() {
+	// Given
+	var published []*events.DomainEvent
+	suite.mockOrderRepo.On("GetByID", suite.ctx, suite.order.ID).Return(suite.order, nil)
+	suite.mockPaymentRepo.On("GetByOrderID", suite.ctx, suite.order.ID).Return(nil, suite.notFound())
+	suite.mockOrderRepo.On("Update", suite.ctx, suite.order).Return(nil)
+	suite.mockAdjustmentRepo.On("Create", suite.ctx, mock.AnythingOfType("*domain.Adjustment")).Return(nil)
+	suite.mockPublisher.On("Publish", suite.ctx, mock.AnythingOfType("*events.DomainEvent")).
+		Run(func(args mock.Arguments) { published = append(published, args.Get(1).(*events.DomainEvent)) }).
+		Return(nil)
+
+	// When
+	adjustment, err := suite.service.VoidOrder(suite.ctx, suite.order.ID, domain.AdjustmentReasonOrderEntryError, "", nil)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	assert.Equal(27.50, adjustment.Amount)
+	assert.Equal("cashier-1", adjustment.RequestedBy)
+	assert.Empty(adjustment.ApprovedBy)
+	assert.Equal(domain.OrderStatusCancelled, suite.order.Status)
+	assert.Len(published, 2)
+	assert.Equal(events.OrderCancelledEvent, published[0].Type)
+	assert.Equal(events.OrderVoidedEvent, published[1].Type)
+	assert.Equal("ORDER_ENTRY_ERROR", published[1].Data["reason"])
+	suite.mockAdjustmentRepo.AssertExpectations(suite.T())
+}
+
+func (suite *AdjustmentServiceTestSuite) TestVoidOrder_VoidsPartialPayment() {
+	// Given
+	payment, _ := domain.NewPayment(suite.order.ID, suite.order.TotalAmount)
+	charge, _ := suite.provider.Charge(suite.ctx, domain.ChargeRequest{Amount: 10.00})
+	payment.AddTender(domain.TenderTypeCard, 10.00, 0, "", charge.ProviderRef)
+
+	suite.mockOrderRepo.On("GetByID", suite.ctx, suite.order.ID).Return(suite.order, nil)
+	suite.mockPaymentRepo.On("GetByOrderID", suite.ctx, suite.order.ID).Return(payment, nil)
+	suite.mockPaymentRepo.On("Update", suite.ctx, payment).Return(nil)
+	suite.mockOrderRepo.On("Update", suite.ctx, suite.order).Return(nil)
+	suite.mockAdjustmentRepo.On("Create", suite.ctx, mock.AnythingOfType("*domain.Adjustment")).Return(nil)
+	suite.mockPublisher.On("Publish", suite.ctx, mock.AnythingOfType("*events.DomainEvent")).Return(nil)
+
+	// When
+	_, err := suite.service.VoidOrder(suite.ctx, suite.order.ID, domain.AdjustmentReasonCustomerRequest, "", nil)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	assert.Equal(domain.PaymentStatusVoided, payment.Status)
+	assert.Equal("CUSTOMER_REQUEST", payment.VoidReason)
+}
+
+func (suite *AdjustmentServiceTestSuite) TestVoidOrder_AboveThreshold_WithoutApproval_ShouldFail() {
+	// Given
+	suite.order.AddItem("steak", "Steak", 1, 60.00, nil, "")
+	suite.mockOrderRepo.On("GetByID", suite.ctx, suite.order.ID).Return(suite.order, nil)
+
+	// When
+	_, err := suite.service.VoidOrder(suite.ctx, suite.order.ID, domain.AdjustmentReasonCustomerRequest, "", nil)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.Error(err)
+	assert.True(sharedErrors.IsForbiddenError(err))
+	assert.Equal(domain.OrderStatusCreated, suite.order.Status)
+	suite.mockOrderRepo.AssertNotCalled(suite.T(), "Update", mock.Anything, mock.Anything)
+}
+
+func (suite *AdjustmentServiceTestSuite) TestVoidOrder_AboveThreshold_ManagerRole_IsApproved() {
+	// Given
+	ctx := auth.WithRole(auth.WithActor(context.Background(), "manager-1"), auth.RoleManager)
+	suite.order.AddItem("steak", "Steak", 1, 60.00, nil, "")
+	suite.mockOrderRepo.On("GetByID", ctx, suite.order.ID).Return(suite.order, nil)
+	suite.mockPaymentRepo.On("GetByOrderID", ctx, suite.order.ID).Return(nil, suite.notFound())
+	suite.mockOrderRepo.On("Update", ctx, suite.order).Return(nil)
+	suite.mockAdjustmentRepo.On("Create", ctx, mock.AnythingOfType("*domain.Adjustment")).Return(nil)
+	suite.mockPublisher.On("Publish", ctx, mock.AnythingOfType("*events.DomainEvent")).Return(nil)
+
+	// When
+	adjustment, err := suite.service.VoidOrder(ctx, suite.order.ID, domain.AdjustmentReasonCustomerRequest, "", nil)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	assert.Equal("manager-1", adjustment.ApprovedBy)
+	assert.Equal(domain.ApprovalMethodRole, adjustment.ApprovalMethod)
+	suite.mockVerifier.AssertNotCalled(suite.T(), "VerifyPIN", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *AdjustmentServiceTestSuite) TestVoidOrder_AboveThreshold_InvalidPIN_ShouldFail() {
+	// Given
+	suite.order.AddItem("steak", "Steak", 1, 60.00, nil, "")
+	suite.mockOrderRepo.On("GetByID", suite.ctx, suite.order.ID).Return(suite.order, nil)
+	suite.mockVerifier.On("VerifyPIN", suite.ctx, "manager-1", "0000").
+		Return(sharedErrors.WrapForbidden("VerifyPIN", "invalid manager PIN", nil))
+
+	// When
+	_, err := suite.service.VoidOrder(suite.ctx, suite.order.ID, domain.AdjustmentReasonCustomerRequest, "",
+		&domain.ManagerOverride{ManagerID: "manager-1", PIN: "0000"})
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.True(sharedErrors.IsForbiddenError(err))
+	suite.mockAdjustmentRepo.AssertNotCalled(suite.T(), "Create", mock.Anything, mock.Anything)
+}
+
+// Test RefundOrder
+func (suite *AdjustmentServiceTestSuite) TestRefundOrder_Items_RefundsTender() {
+	// Given
+	var published *events.DomainEvent
+	payment := suite.paid(domain.OrderStatusPreparing)
+	fries := suite.order.Items[1]
+
+	suite.mockOrderRepo.On("GetByID", suite.ctx, suite.order.ID).Return(suite.order, nil)
+	suite.mockPaymentRepo.On("GetByOrderID", suite.ctx, suite.order.ID).Return(payment, nil)
+	suite.mockPaymentRepo.On("Update", suite.ctx, payment).Return(nil)
+	suite.mockOrderRepo.On("Update", suite.ctx, suite.order).Return(nil)
+	suite.mockAdjustmentRepo.On("Create", suite.ctx, mock.AnythingOfType("*domain.Adjustment")).Return(nil)
+	suite.mockPublisher.On("Publish", suite.ctx, mock.AnythingOfType("*events.DomainEvent")).
+		Run(func(args mock.Arguments) { published = args.Get(1).(*events.DomainEvent) }).
+		Return(nil)
+
+	// When
+	adjustment, err := suite.service.RefundOrder(suite.ctx, suite.order.ID, []domain.OrderItemID{fries.ID},
+		domain.AdjustmentReasonQualityIssue, "cold", nil)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	assert.Equal(5.50, adjustment.Amount)
+	assert.Equal(domain.PaymentStatusPartiallyRefunded, payment.Status)
+	assert.Equal(5.50, payment.AmountRefunded)
+	assert.Equal("QUALITY_ISSUE: cold", payment.Refunds[0].Reason)
+	assert.NotEmpty(payment.Refunds[0].ProviderRef)
+	assert.True(fries.IsRefunded())
+	assert.Equal(events.OrderRefundedEvent, published.Type)
+	assert.Len(published.Data["lines"], 1)
+}
+
+func (suite *AdjustmentServiceTestSuite) TestRefundOrder_Concurrent_RefundsOnlyOnce() {
+	// Given two cashiers refunding the same order in full at the same time
+	payment := suite.paid(domain.OrderStatusPaid)
+	payments := newMemoryPaymentRepository(payment)
+	provider := &countingPaymentProvider{PaymentProvider: suite.provider}
+	service := NewAdjustmentService(suite.mockOrderRepo, payments, suite.mockAdjustmentRepo,
+		provider, NewGiftCardService(newMemoryGiftCardRepository()), new(MockDrawerSessionRepository), suite.mockVerifier,
+		domain.ApprovalPolicy{Threshold: 50.00}, suite.mockPublisher)
+
+	for i := 0; i < 2; i++ {
+		var order domain.Order
+		snapshot, _ := json.Marshal(suite.order)
+		json.Unmarshal(snapshot, &order)
+		suite.mockOrderRepo.On("GetByID", suite.ctx, suite.order.ID).Return(&order, nil).Once()
+	}
+	suite.mockOrderRepo.On("Update", suite.ctx, mock.AnythingOfType("*domain.Order")).Return(nil)
+	suite.mockAdjustmentRepo.On("Create", suite.ctx, mock.AnythingOfType("*domain.Adjustment")).Return(nil)
+	suite.mockPublisher.On("Publish", suite.ctx, mock.AnythingOfType("*events.DomainEvent")).Return(nil)
+
+	// When
+	var wg sync.WaitGroup
+	errs := make([]error, 2)
+	for i := range errs {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			_, errs[i] = service.RefundOrder(suite.ctx, suite.order.ID, nil, domain.AdjustmentReasonCustomerRequest, "", nil)
+		}(i)
+	}
+	wg.Wait()
+
+	// Then
+	assert := assert.New(suite.T())
+	failed := 0
+	for _, err := range errs {
+		if err != nil {
+			failed++
+		}
+	}
+	assert.Equal(1, failed)
+	assert.Equal(int32(1), atomic.LoadInt32(&provider.refunds))
+
+	stored, _ := payments.GetByID(suite.ctx, payment.ID)
+	assert.Equal(domain.PaymentStatusRefunded, stored.Status)
+	assert.Equal(27.50, stored.AmountRefunded)
+	assert.Len(stored.Refunds, 1)
+	assert.False(stored.Refunds[0].Pending)
+}
+
+func (suite *AdjustmentServiceTestSuite) TestRefundOrder_TenderRefundFails_ReleasesTheReservation() {
+	// Given a charge the provider no longer knows
+	payment, _ := domain.NewPayment(suite.order.ID, suite.order.TotalAmount)
+	suite.order.UpdateStatus(domain.OrderStatusPaid, "cashier-1", "")
+	payment.AddTender(domain.TenderTypeCard, suite.order.TotalAmount, 0, "", "ch_unknown")
+	payments := newMemoryPaymentRepository(payment)
+	service := NewAdjustmentService(suite.mockOrderRepo, payments, suite.mockAdjustmentRepo,
+		suite.provider, NewGiftCardService(newMemoryGiftCardRepository()), new(MockDrawerSessionRepository), suite.mockVerifier,
+		domain.ApprovalPolicy{Threshold: 50.00}, suite.mockPublisher)
+	suite.mockOrderRepo.On("GetByID", suite.ctx, suite.order.ID).Return(suite.order, nil)
+
+	// When
+	_, err := service.RefundOrder(suite.ctx, suite.order.ID, nil, domain.AdjustmentReasonCustomerRequest, "", nil)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.Error(err)
+	stored, _ := payments.GetByID(suite.ctx, payment.ID)
+	assert.Equal(domain.PaymentStatusPaid, stored.Status)
+	assert.Empty(stored.Refunds)
+	suite.mockAdjustmentRepo.AssertNotCalled(suite.T(), "Create", mock.Anything, mock.Anything)
+}
+
+func (suite *AdjustmentServiceTestSuite) TestRefundOrder_StaleIfMatch_ShouldFailBeforeRefunding() {
+	// Given the client last saw version 1 but the order is at version 2
+	ctx := concurrency.WithExpectedVersion(suite.ctx, 1)
+	payment := suite.paid(domain.OrderStatusPaid)
+	suite.order.Version = 2
+	suite.mockOrderRepo.On("GetByID", ctx, suite.order.ID).Return(suite.order, nil)
+
+	// When
+	_, err := suite.service.RefundOrder(ctx, suite.order.ID, nil, domain.AdjustmentReasonCustomerRequest, "", nil)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.True(sharedErrors.IsPreconditionFailed(err))
+	assert.Equal(domain.PaymentStatusPaid, payment.Status)
+	suite.mockPaymentRepo.AssertNotCalled(suite.T(), "GetByOrderID", mock.Anything, mock.Anything)
+	suite.mockPaymentRepo.AssertNotCalled(suite.T(), "Update", mock.Anything, mock.Anything)
+}
+
+func (suite *AdjustmentServiceTestSuite) TestVoidOrder_StaleIfMatch_ShouldFailBeforeVoidingTenders() {
+	// Given the client last saw version 1 but the order is at version 2
+	ctx := concurrency.WithExpectedVersion(suite.ctx, 1)
+	suite.order.Version = 2
+	suite.mockOrderRepo.On("GetByID", ctx, suite.order.ID).Return(suite.order, nil)
+
+	// When
+	_, err := suite.service.VoidOrder(ctx, suite.order.ID, domain.AdjustmentReasonOrderEntryError, "", nil)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.True(sharedErrors.IsPreconditionFailed(err))
+	assert.Equal(domain.OrderStatusCreated, suite.order.Status)
+	suite.mockPaymentRepo.AssertNotCalled(suite.T(), "GetByOrderID", mock.Anything, mock.Anything)
+}
+
+func (suite *AdjustmentServiceTestSuite) TestRefundOrder_CompletedOrder_RequiresApproval() {
+	// Given
+	payment := suite.paid(domain.OrderStatusCompleted)
+	suite.mockOrderRepo.On("GetByID", suite.ctx, suite.order.ID).Return(suite.order, nil)
+	suite.mockPaymentRepo.On("GetByOrderID", suite.ctx, suite.order.ID).Return(payment, nil)
+
+	// When
+	_, err := suite.service.RefundOrder(suite.ctx, suite.order.ID, nil, domain.AdjustmentReasonQualityIssue, "", nil)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.True(sharedErrors.IsForbiddenError(err))
+	assert.Equal(0.0, payment.AmountRefunded)
+	suite.mockPaymentRepo.AssertNotCalled(suite.T(), "Update", mock.Anything, mock.Anything)
+}
+
+func (suite *AdjustmentServiceTestSuite) TestRefundOrder_EarlierRefundsOverThreshold_RequiresApproval() {
+	// Given a 44.00 steak already refunded on a 71.50 order
+	suite.order.AddItem("steak", "Steak", 1, 40.00, nil, "")
+	payment := suite.paid(domain.OrderStatusPreparing)
+	payment.Refund(payment.Tenders[0].ID, 44.00, "QUALITY_ISSUE", "rf_1")
+	burger := suite.order.Items[0]
+	suite.mockOrderRepo.On("GetByID", suite.ctx, suite.order.ID).Return(suite.order, nil)
+	suite.mockPaymentRepo.On("GetByOrderID", suite.ctx, suite.order.ID).Return(payment, nil)
+
+	// When the burgers are refunded for 22.00, under the threshold on their own
+	_, err := suite.service.RefundOrder(suite.ctx, suite.order.ID, []domain.OrderItemID{burger.ID},
+		domain.AdjustmentReasonQualityIssue, "", nil)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.True(sharedErrors.IsForbiddenError(err))
+	assert.Equal(44.00, payment.AmountRefunded)
+	suite.mockPaymentRepo.AssertNotCalled(suite.T(), "Update", mock.Anything, mock.Anything)
+}
+
+func (suite *AdjustmentServiceTestSuite) TestRefundOrder_CompletedOrder_WithPIN_Success() {
+	// Given
+	payment := suite.paid(domain.OrderStatusCompleted)
+	suite.mockOrderRepo.On("GetByID", suite.ctx, suite.order.ID).Return(suite.order, nil)
+	suite.mockPaymentRepo.On("GetByOrderID", suite.ctx, suite.order.ID).Return(payment, nil)
+	suite.mockVerifier.On("VerifyPIN", suite.ctx, "manager-1", "1234").Return(nil)
+	suite.mockPaymentRepo.On("Update", suite.ctx, payment).Return(nil)
+	suite.mockOrderRepo.On("Update", suite.ctx, suite.order).Return(nil)
+	suite.mockAdjustmentRepo.On("Create", suite.ctx, mock.AnythingOfType("*domain.Adjustment")).Return(nil)
+	suite.mockPublisher.On("Publish", suite.ctx, mock.AnythingOfType("*events.DomainEvent")).Return(nil)
+
+	// When
+	adjustment, err := suite.service.RefundOrder(suite.ctx, suite.order.ID, nil, domain.AdjustmentReasonQualityIssue, "",
+		&domain.ManagerOverride{ManagerID: "manager-1", PIN: "1234"})
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	assert.Equal(27.50, adjustment.Amount)
+	assert.Equal("manager-1", adjustment.ApprovedBy)
+	assert.Equal(domain.ApprovalMethodPIN, adjustment.ApprovalMethod)
+	assert.Equal(domain.PaymentStatusRefunded, payment.Status)
+}
+
+func (suite *AdjustmentServiceTestSuite) TestRefundOrder_NoPayment_ShouldFail() {
+	// Given
+	suite.mockOrderRepo.On("GetByID", suite.ctx, suite.order.ID).Return(suite.order, nil)
+	suite.mockPaymentRepo.On("GetByOrderID", suite.ctx, suite.order.ID).Return(nil, suite.notFound())
+
+	// When
+	_, err := suite.service.RefundOrder(suite.ctx, suite.order.ID, nil, domain.AdjustmentReasonQualityIssue, "", nil)
+
+	// Then
+	assert.True(suite.T(), sharedErrors.IsConflictError(err))
+}
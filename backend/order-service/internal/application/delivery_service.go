@@ -0,0 +1,430 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/restaurant-platform/order-service/internal/domain"
+	"github.com/restaurant-platform/shared/events"
+	"github.com/restaurant-platform/shared/pkg/concurrency"
+	"github.com/restaurant-platform/shared/pkg/errors"
+)
+
+// DeliveryService implements the delivery dispatch business logic
+type DeliveryService struct {
+	orderRepo      domain.OrderRepository
+	zoneRepo       domain.DeliveryZoneRepository
+	driverRepo     domain.DriverRepository
+	deliveryRepo   domain.DeliveryRepository
+	geocoder       domain.Geocoder
+	origin         domain.Coordinates
+	eventPublisher events.EventPublisher
+}
+
+// NewDeliveryService creates a new delivery service dispatching from the given origin
+func NewDeliveryService(orderRepo domain.OrderRepository, zoneRepo domain.DeliveryZoneRepository, driverRepo domain.DriverRepository, deliveryRepo domain.DeliveryRepository, geocoder domain.Geocoder, origin domain.Coordinates, eventPublisher events.EventPublisher) *DeliveryService {
+	return &DeliveryService{
+		orderRepo:      orderRepo,
+		zoneRepo:       zoneRepo,
+		driverRepo:     driverRepo,
+		deliveryRepo:   deliveryRepo,
+		geocoder:       geocoder,
+		origin:         origin,
+		eventPublisher: eventPublisher,
+	}
+}
+
+// CreateZone defines a new delivery zone
+func (s *DeliveryService) CreateZone(ctx context.Context, name string, boundary []domain.Coordinates, fee, minimumOrder float64) (*domain.DeliveryZone, error) {
+	zone, err := domain.NewDeliveryZone(name, boundary, fee, minimumOrder)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create delivery zone: %w", err)
+	}
+
+	if err := s.zoneRepo.Create(ctx, zone); err != nil {
+		return nil, fmt.Errorf("failed to save delivery zone: %w", err)
+	}
+
+	log.Printf("Created delivery zone %s: %s", zone.ID, zone.Name)
+	return zone, nil
+}
+
+// SetZoneActive enables or disables a delivery zone
+func (s *DeliveryService) SetZoneActive(ctx context.Context, zoneID domain.DeliveryZoneID, isActive bool) (*domain.DeliveryZone, error) {
+	zone, err := s.zoneRepo.GetByID(ctx, zoneID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get delivery zone: %w", err)
+	}
+
+	zone.SetActive(isActive)
+
+	if err := s.zoneRepo.Update(ctx, zone); err != nil {
+		return nil, fmt.Errorf("failed to update delivery zone: %w", err)
+	}
+
+	return zone, nil
+}
+
+// ListZones retrieves all delivery zones
+func (s *DeliveryService) ListZones(ctx context.Context) ([]*domain.DeliveryZone, error) {
+	return s.zoneRepo.List(ctx)
+}
+
+// QuoteDelivery geocodes an address and returns the zone that serves it
+func (s *DeliveryService) QuoteDelivery(ctx context.Context, address string) (*domain.DeliveryZone, error) {
+	zone, _, err := s.locate(ctx, address)
+	return zone, err
+}
+
+// CreateDriver registers a new driver
+func (s *DeliveryService) CreateDriver(ctx context.Context, name, phone string) (*domain.Driver, error) {
+	driver, err := domain.NewDriver(name, phone)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create driver: %w", err)
+	}
+
+	if err := s.driverRepo.Create(ctx, driver); err != nil {
+		return nil, fmt.Errorf("failed to save driver: %w", err)
+	}
+
+	log.Printf("Registered driver %s: %s", driver.ID, driver.Name)
+	return driver, nil
+}
+
+// SetDriverStatus moves a driver between available and off duty
+func (s *DeliveryService) SetDriverStatus(ctx context.Context, driverID domain.DriverID, status domain.DriverStatus) (*domain.Driver, error) {
+	driver, err := s.driverRepo.GetByID(ctx, driverID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get driver: %w", err)
+	}
+
+	if err := driver.SetStatus(status); err != nil {
+		return nil, fmt.Errorf("failed to set driver status: %w", err)
+	}
+
+	if err := s.driverRepo.Update(ctx, driver); err != nil {
+		return nil, fmt.Errorf("failed to update driver: %w", err)
+	}
+
+	return driver, nil
+}
+
+// ListDrivers retrieves all drivers
+func (s *DeliveryService) ListDrivers(ctx context.Context) ([]*domain.Driver, error) {
+	return s.driverRepo.List(ctx)
+}
+
+// CreateDelivery books a delivery for an unpaid delivery order and charges the zone fee.
+// The order must meet the zone minimum before the fee is added.
+func (s *DeliveryService) CreateDelivery(ctx context.Context, orderID domain.OrderID) (*domain.Delivery, error) {
+	order, err := s.orderRepo.GetByID(ctx, orderID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get order: %w", err)
+	}
+
+	if order.Type != domain.OrderTypeDelivery {
+		return nil, errors.WrapConflict("CreateDelivery", "order_type", "only delivery orders can be dispatched", nil)
+	}
+	if order.DeliveryAddress == "" {
+		return nil, errors.WrapValidation("CreateDelivery", "delivery_address", "order has no delivery address", nil)
+	}
+
+	existing, err := s.deliveryRepo.GetByOrderID(ctx, orderID)
+	if err != nil && !errors.IsNotFound(err) {
+		return nil, fmt.Errorf("failed to get delivery: %w", err)
+	}
+	if existing != nil {
+		return nil, errors.WrapConflict("CreateDelivery", "delivery", "order already has a delivery", nil)
+	}
+
+	zone, location, err := s.locate(ctx, order.DeliveryAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	if order.Subtotal() < zone.MinimumOrder {
+		return nil, errors.WrapConflict("CreateDelivery", "minimum_order",
+			fmt.Sprintf("order subtotal is below the %.2f minimum for zone %s", zone.MinimumOrder, zone.Name), nil)
+	}
+
+	delivery, err := domain.NewDelivery(orderID, zone, order.DeliveryAddress, location)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create delivery: %w", err)
+	}
+
+	// Charge the fee on the latest copy of the order before booking, so a delivery is never
+	// saved for an order that could not take the fee
+	if _, err := modifyOrder(ctx, s.orderRepo, orderID, func(order *domain.Order) error {
+		if err := order.SetDeliveryFee(zone.Fee); err != nil {
+			return fmt.Errorf("failed to set delivery fee: %w", err)
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := s.deliveryRepo.Create(ctx, delivery); err != nil {
+		s.refundDeliveryFee(ctx, orderID)
+		return nil, fmt.Errorf("failed to save delivery: %w", err)
+	}
+
+	log.Printf("Booked delivery %s in zone %s for order: %s", delivery.ID, zone.Name, orderID)
+	return delivery, nil
+}
+
+// GetDeliveryForOrder retrieves the delivery booked for an order
+func (s *DeliveryService) GetDeliveryForOrder(ctx context.Context, orderID domain.OrderID) (*domain.Delivery, error) {
+	return s.deliveryRepo.GetByOrderID(ctx, orderID)
+}
+
+// AssignDriver hands a delivery to a driver, or to the longest idle available driver when none is given
+func (s *DeliveryService) AssignDriver(ctx context.Context, deliveryID domain.DeliveryID, driverID domain.DriverID) (*domain.Delivery, error) {
+	var delivery *domain.Delivery
+	var driver *domain.Driver
+
+	err := concurrency.RetryOnConflict(ctx, func() error {
+		var err error
+		if delivery, err = s.deliveryRepo.GetByID(ctx, deliveryID); err != nil {
+			return fmt.Errorf("failed to get delivery: %w", err)
+		}
+		if driver, err = s.selectDriver(ctx, driverID); err != nil {
+			return err
+		}
+
+		previous := *driver
+		eta := time.Now().Add(domain.EstimateTravelTime(s.origin, delivery.Location))
+		if err := delivery.Assign(driver, eta); err != nil {
+			return fmt.Errorf("failed to assign driver: %w", err)
+		}
+
+		// Claim the driver first: the versioned update fails if another dispatch took them since they were loaded
+		if err := s.driverRepo.Update(ctx, driver); err != nil {
+			return fmt.Errorf("failed to update driver: %w", err)
+		}
+		if err := s.deliveryRepo.Update(ctx, delivery); err != nil {
+			// Hand the driver back so they are not left on a delivery they never got
+			s.restoreDriver(ctx, driver, previous)
+			return fmt.Errorf("failed to update delivery: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	log.Printf("Assigned driver %s to delivery: %s", driver.ID, deliveryID)
+
+	s.publishDeliveryEvent(ctx, events.DeliveryAssignedEvent, delivery)
+	return delivery, nil
+}
+
+// PickUpDelivery records the driver leaving with a ready order and sends the order out for delivery.
+// The delivery is put back if the order cannot be sent out, so the pick-up can be retried.
+func (s *DeliveryService) PickUpDelivery(ctx context.Context, deliveryID domain.DeliveryID) (*domain.Delivery, error) {
+	var delivery *domain.Delivery
+	var previous domain.Delivery
+
+	err := concurrency.RetryOnConflict(ctx, func() error {
+		var err error
+		if delivery, err = s.deliveryRepo.GetByID(ctx, deliveryID); err != nil {
+			return fmt.Errorf("failed to get delivery: %w", err)
+		}
+
+		order, err := s.orderRepo.GetByID(ctx, delivery.OrderID)
+		if err != nil {
+			return fmt.Errorf("failed to get order: %w", err)
+		}
+		if order.Status != domain.OrderStatusReady {
+			return errors.WrapConflict("PickUpDelivery", "order_status", "order is not ready for pick-up", nil)
+		}
+
+		previous = *delivery
+		eta := time.Now().Add(domain.EstimateTravelTime(s.origin, delivery.Location))
+		if err := delivery.PickUp(eta); err != nil {
+			return fmt.Errorf("failed to pick up delivery: %w", err)
+		}
+
+		if err := s.deliveryRepo.Update(ctx, delivery); err != nil {
+			return fmt.Errorf("failed to update delivery: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := modifyOrder(ctx, s.orderRepo, delivery.OrderID, func(order *domain.Order) error {
+		if err := order.UpdateStatus(domain.OrderStatusOutForDelivery, actorFromContext(ctx), "picked up by driver "+string(delivery.DriverID)); err != nil {
+			return fmt.Errorf("failed to update order status: %w", err)
+		}
+		return nil
+	}); err != nil {
+		s.restoreDelivery(ctx, delivery, previous)
+		return nil, err
+	}
+
+	log.Printf("Order %s out for delivery with driver: %s", delivery.OrderID, delivery.DriverID)
+
+	s.publishDeliveryEvent(ctx, events.DeliveryPickedUpEvent, delivery)
+	return delivery, nil
+}
+
+// CompleteDelivery records the hand-over to the customer, frees the driver and completes the order.
+// The delivery and driver are put back if the order cannot be completed, so the hand-over can be retried.
+func (s *DeliveryService) CompleteDelivery(ctx context.Context, deliveryID domain.DeliveryID) (*domain.Delivery, error) {
+	var delivery *domain.Delivery
+	var driver *domain.Driver
+	var previousDelivery domain.Delivery
+	var previousDriver domain.Driver
+
+	err := concurrency.RetryOnConflict(ctx, func() error {
+		var err error
+		if delivery, err = s.deliveryRepo.GetByID(ctx, deliveryID); err != nil {
+			return fmt.Errorf("failed to get delivery: %w", err)
+		}
+		if delivery.DriverID.IsEmpty() {
+			return errors.WrapConflict("CompleteDelivery", "delivery_status", "delivery has no driver", nil)
+		}
+		if driver, err = s.driverRepo.GetByID(ctx, delivery.DriverID); err != nil {
+			return fmt.Errorf("failed to get driver: %w", err)
+		}
+
+		previousDelivery, previousDriver = *delivery, *driver
+		if err := delivery.Deliver(driver); err != nil {
+			return fmt.Errorf("failed to complete delivery: %w", err)
+		}
+
+		if err := s.deliveryRepo.Update(ctx, delivery); err != nil {
+			return fmt.Errorf("failed to update delivery: %w", err)
+		}
+		if err := s.driverRepo.Update(ctx, driver); err != nil {
+			s.restoreDelivery(ctx, delivery, previousDelivery)
+			return fmt.Errorf("failed to update driver: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := modifyOrder(ctx, s.orderRepo, delivery.OrderID, func(order *domain.Order) error {
+		if err := order.UpdateStatus(domain.OrderStatusCompleted, actorFromContext(ctx), "delivered"); err != nil {
+			return fmt.Errorf("failed to update order status: %w", err)
+		}
+		return nil
+	}); err != nil {
+		s.restoreDelivery(ctx, delivery, previousDelivery)
+		s.restoreDriver(ctx, driver, previousDriver)
+		return nil, err
+	}
+
+	log.Printf("Delivered order %s by driver: %s", delivery.OrderID, driver.ID)
+
+	s.publishDeliveryEvent(ctx, events.DeliveryDeliveredEvent, delivery)
+	return delivery, nil
+}
+
+// Helper methods
+
+// locate geocodes an address and finds the active zone containing it
+func (s *DeliveryService) locate(ctx context.Context, address string) (*domain.DeliveryZone, domain.Coordinates, error) {
+	if address == "" {
+		return nil, domain.Coordinates{}, errors.WrapValidation("QuoteDelivery", "address", "address is required", nil)
+	}
+
+	location, err := s.geocoder.Geocode(ctx, address)
+	if err != nil {
+		return nil, domain.Coordinates{}, fmt.Errorf("failed to geocode address: %w", err)
+	}
+
+	zones, err := s.zoneRepo.List(ctx)
+	if err != nil {
+		return nil, domain.Coordinates{}, fmt.Errorf("failed to list delivery zones: %w", err)
+	}
+
+	zone, err := domain.FindZone(zones, location)
+	if err != nil {
+		return nil, domain.Coordinates{}, err
+	}
+
+	return zone, location, nil
+}
+
+// restoreDelivery puts a delivery back to the state it was saved in before a change that
+// could not be carried through to the order
+func (s *DeliveryService) restoreDelivery(ctx context.Context, current *domain.Delivery, previous domain.Delivery) {
+	previous.Version = current.Version
+	if err := s.deliveryRepo.Update(ctx, &previous); err != nil {
+		log.Printf("Failed to restore delivery %s: %v", current.ID, err)
+	}
+}
+
+// restoreDriver puts a driver back to the state they were saved in before a change that
+// could not be carried through
+func (s *DeliveryService) restoreDriver(ctx context.Context, current *domain.Driver, previous domain.Driver) {
+	previous.Version = current.Version
+	if err := s.driverRepo.Update(ctx, &previous); err != nil {
+		log.Printf("Failed to restore driver %s: %v", current.ID, err)
+	}
+}
+
+// refundDeliveryFee takes the delivery fee back off an order whose delivery could not be booked,
+// unless a concurrent request booked one for it in the meantime
+func (s *DeliveryService) refundDeliveryFee(ctx context.Context, orderID domain.OrderID) {
+	if existing, err := s.deliveryRepo.GetByOrderID(ctx, orderID); err == nil && existing != nil {
+		return
+	}
+
+	// The fee was just charged, so the order has moved past any version the client expected
+	ctx = concurrency.WithoutExpectedVersion(ctx)
+	if _, err := modifyOrder(ctx, s.orderRepo, orderID, func(order *domain.Order) error {
+		return order.SetDeliveryFee(0)
+	}); err != nil {
+		log.Printf("Failed to remove delivery fee from order %s: %v", orderID, err)
+	}
+}
+
+func (s *DeliveryService) selectDriver(ctx context.Context, driverID domain.DriverID) (*domain.Driver, error) {
+	if !driverID.IsEmpty() {
+		driver, err := s.driverRepo.GetByID(ctx, driverID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get driver: %w", err)
+		}
+		return driver, nil
+	}
+
+	available, err := s.driverRepo.FindAvailable(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find available drivers: %w", err)
+	}
+	if len(available) == 0 {
+		return nil, errors.WrapConflict("AssignDriver", "driver", "no drivers are available", nil)
+	}
+	return available[0], nil
+}
+
+func (s *DeliveryService) publishDeliveryEvent(ctx context.Context, eventType events.EventType, delivery *domain.Delivery) {
+	eventData, err := events.ToEventData(events.DeliveryEventData{
+		DeliveryID:       string(delivery.ID),
+		OrderID:          string(delivery.OrderID),
+		ZoneID:           string(delivery.ZoneID),
+		DriverID:         string(delivery.DriverID),
+		Status:           string(delivery.Status),
+		Fee:              delivery.Fee,
+		EstimatedArrival: delivery.EstimatedArrival,
+	})
+	if err != nil {
+		log.Printf("Failed to convert event data to map: %v", err)
+		return
+	}
+
+	event := events.NewDomainEvent(eventType, string(delivery.OrderID), eventData).
+		WithMetadata("service", "order-service").
+		WithMetadata("delivery_id", string(delivery.ID))
+
+	if err := s.eventPublisher.Publish(ctx, event); err != nil {
+		log.Printf("Failed to publish %s event: %v", eventType, err)
+	}
+}
@@ -0,0 +1,556 @@
+package application
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/restaurant-platform/order-service/internal/domain"
+	"github.com/restaurant-platform/order-service/internal/infrastructure"
+	"github.com/restaurant-platform/shared/events"
+	sharedErrors "github.com/restaurant-platform/shared/pkg/errors"
+)
+
+// MockDeliveryZoneRepository is a mock implementation of DeliveryZoneRepository
+type MockDeliveryZoneRepository struct {
+	mock.Mock
+}
+
+func (m *MockDeliveryZoneRepository) Create(ctx context.Context, zone *domain.DeliveryZone) error {
+	args := m.Called(ctx, zone)
+	return args.Error(0)
+}
+
+func (m *MockDeliveryZoneRepository) GetByID(ctx context.Context, id domain.DeliveryZoneID) (*domain.DeliveryZone, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.DeliveryZone), args.Error(1)
+}
+
+func (m *MockDeliveryZoneRepository) Update(ctx context.Context, zone *domain.DeliveryZone) error {
+	args := m.Called(ctx, zone)
+	return args.Error(0)
+}
+
+func (m *MockDeliveryZoneRepository) List(ctx context.Context) ([]*domain.DeliveryZone, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]*domain.DeliveryZone), args.Error(1)
+}
+
+// MockDriverRepository is a mock implementation of DriverRepository
+type MockDriverRepository struct {
+	mock.Mock
+}
+
+func (m *MockDriverRepository) Create(ctx context.Context, driver *domain.Driver) error {
+	args := m.Called(ctx, driver)
+	return args.Error(0)
+}
+
+func (m *MockDriverRepository) GetByID(ctx context.Context, id domain.DriverID) (*domain.Driver, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Driver), args.Error(1)
+}
+
+func (m *MockDriverRepository) Update(ctx context.Context, driver *domain.Driver) error {
+	args := m.Called(ctx, driver)
+	return args.Error(0)
+}
+
+func (m *MockDriverRepository) List(ctx context.Context) ([]*domain.Driver, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]*domain.Driver), args.Error(1)
+}
+
+func (m *MockDriverRepository) FindAvailable(ctx context.Context) ([]*domain.Driver, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]*domain.Driver), args.Error(1)
+}
+
+// MockDeliveryRepository is a mock implementation of DeliveryRepository
+type MockDeliveryRepository struct {
+	mock.Mock
+}
+
+func (m *MockDeliveryRepository) Create(ctx context.Context, delivery *domain.Delivery) error {
+	args := m.Called(ctx, delivery)
+	return args.Error(0)
+}
+
+func (m *MockDeliveryRepository) GetByID(ctx context.Context, id domain.DeliveryID) (*domain.Delivery, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Delivery), args.Error(1)
+}
+
+func (m *MockDeliveryRepository) GetByOrderID(ctx context.Context, orderID domain.OrderID) (*domain.Delivery, error) {
+	args := m.Called(ctx, orderID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Delivery), args.Error(1)
+}
+
+func (m *MockDeliveryRepository) Update(ctx context.Context, delivery *domain.Delivery) error {
+	args := m.Called(ctx, delivery)
+	return args.Error(0)
+}
+
+func (m *MockDeliveryRepository) FindByDriver(ctx context.Context, driverID domain.DriverID) ([]*domain.Delivery, error) {
+	args := m.Called(ctx, driverID)
+	return args.Get(0).([]*domain.Delivery), args.Error(1)
+}
+
+// DeliveryServiceTestSuite contains all delivery service tests
+type DeliveryServiceTestSuite struct {
+	suite.Suite
+	service          *DeliveryService
+	mockOrderRepo    *MockOrderRepository
+	mockZoneRepo     *MockDeliveryZoneRepository
+	mockDriverRepo   *MockDriverRepository
+	mockDeliveryRepo *MockDeliveryRepository
+	mockPublisher    *MockEventPublisher
+	geocoder         *infrastructure.FakeGeocoder
+	zone             *domain.DeliveryZone
+	driver           *domain.Driver
+	order            *domain.Order
+	ctx              context.Context
+}
+
+func (suite *DeliveryServiceTestSuite) SetupTest() {
+	suite.mockOrderRepo = new(MockOrderRepository)
+	suite.mockZoneRepo = new(MockDeliveryZoneRepository)
+	suite.mockDriverRepo = new(MockDriverRepository)
+	suite.mockDeliveryRepo = new(MockDeliveryRepository)
+	suite.mockPublisher = new(MockEventPublisher)
+	suite.geocoder = infrastructure.NewFakeGeocoder()
+	suite.ctx = context.Background()
+
+	origin := domain.Coordinates{Lat: 40.7128, Lng: -74.0060}
+	suite.service = NewDeliveryService(suite.mockOrderRepo, suite.mockZoneRepo, suite.mockDriverRepo,
+		suite.mockDeliveryRepo, suite.geocoder, origin, suite.mockPublisher)
+
+	suite.zone, _ = domain.NewDeliveryZone("Downtown", []domain.Coordinates{
+		{Lat: 40.70, Lng: -74.02},
+		{Lat: 40.80, Lng: -74.02},
+		{Lat: 40.80, Lng: -73.92},
+		{Lat: 40.70, Lng: -73.92},
+	}, 4.99, 15.00)
+	suite.geocoder.Register("1 Main St", domain.Coordinates{Lat: 40.7628, Lng: -74.0060})
+	suite.geocoder.Register("99 Far Rd", domain.Coordinates{Lat: 41.5000, Lng: -74.0060})
+
+	suite.driver, _ = domain.NewDriver("Sam", "555-0100")
+	_ = suite.driver.SetStatus(domain.DriverStatusAvailable)
+
+	// 2 x 10.00 subtotal
+	suite.order, _ = domain.NewOrder("customer-123", domain.OrderTypeDelivery)
+	suite.order.AddItem("item-1", "Pizza", 2, 10.00, nil, "")
+	_ = suite.order.SetDeliveryAddress("1 Main St")
+}
+
+func TestDeliveryServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(DeliveryServiceTestSuite))
+}
+
+func (suite *DeliveryServiceTestSuite) notFound() error {
+	return sharedErrors.WrapNotFound("DeliveryRepository.GetByOrderID", "delivery", string(suite.order.ID), sharedErrors.ErrNotFound)
+}
+
+func (suite *DeliveryServiceTestSuite) newDelivery() *domain.Delivery {
+	delivery, _ := domain.NewDelivery(suite.order.ID, suite.zone, "1 Main St", domain.Coordinates{Lat: 40.7628, Lng: -74.0060})
+	return delivery
+}
+
+// Test QuoteDelivery
+func (suite *DeliveryServiceTestSuite) TestQuoteDelivery_Success() {
+	// Given
+	suite.mockZoneRepo.On("List", suite.ctx).Return([]*domain.DeliveryZone{suite.zone}, nil)
+
+	// When
+	zone, err := suite.service.QuoteDelivery(suite.ctx, "1 main st ")
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	assert.Equal(suite.zone.ID, zone.ID)
+	assert.Equal(4.99, zone.Fee)
+}
+
+func (suite *DeliveryServiceTestSuite) TestQuoteDelivery_OutsideZones_ShouldFail() {
+	// Given
+	suite.mockZoneRepo.On("List", suite.ctx).Return([]*domain.DeliveryZone{suite.zone}, nil)
+
+	// When
+	zone, err := suite.service.QuoteDelivery(suite.ctx, "99 Far Rd")
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.Nil(zone)
+	assert.True(sharedErrors.IsValidationError(err))
+}
+
+func (suite *DeliveryServiceTestSuite) TestQuoteDelivery_UnknownAddress_ShouldFail() {
+	// When
+	zone, err := suite.service.QuoteDelivery(suite.ctx, "Nowhere")
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.Nil(zone)
+	assert.True(sharedErrors.IsValidationError(err))
+	suite.mockZoneRepo.AssertNotCalled(suite.T(), "List", mock.Anything)
+}
+
+// Test CreateDelivery
+func (suite *DeliveryServiceTestSuite) TestCreateDelivery_ChargesZoneFee() {
+	// Given
+	suite.mockOrderRepo.On("GetByID", suite.ctx, suite.order.ID).Return(suite.order, nil)
+	suite.mockDeliveryRepo.On("GetByOrderID", suite.ctx, suite.order.ID).Return(nil, suite.notFound())
+	suite.mockZoneRepo.On("List", suite.ctx).Return([]*domain.DeliveryZone{suite.zone}, nil)
+	suite.mockDeliveryRepo.On("Create", suite.ctx, mock.AnythingOfType("*domain.Delivery")).Return(nil)
+	suite.mockOrderRepo.On("Update", suite.ctx, suite.order).Return(nil)
+
+	// When
+	delivery, err := suite.service.CreateDelivery(suite.ctx, suite.order.ID)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	assert.Equal(domain.DeliveryStatusPending, delivery.Status)
+	assert.Equal(suite.zone.ID, delivery.ZoneID)
+	assert.Equal(4.99, suite.order.DeliveryFee)
+	assert.InDelta(26.99, suite.order.TotalAmount, 0.001)
+	suite.mockDeliveryRepo.AssertExpectations(suite.T())
+	suite.mockOrderRepo.AssertExpectations(suite.T())
+}
+
+func (suite *DeliveryServiceTestSuite) TestCreateDelivery_BelowMinimum_ShouldFail() {
+	// Given
+	order, _ := domain.NewOrder("customer-123", domain.OrderTypeDelivery)
+	order.AddItem("item-2", "Soda", 1, 3.00, nil, "")
+	_ = order.SetDeliveryAddress("1 Main St")
+	suite.mockOrderRepo.On("GetByID", suite.ctx, order.ID).Return(order, nil)
+	suite.mockDeliveryRepo.On("GetByOrderID", suite.ctx, order.ID).Return(nil, suite.notFound())
+	suite.mockZoneRepo.On("List", suite.ctx).Return([]*domain.DeliveryZone{suite.zone}, nil)
+
+	// When
+	delivery, err := suite.service.CreateDelivery(suite.ctx, order.ID)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.Nil(delivery)
+	assert.True(sharedErrors.IsConflictError(err))
+	assert.Zero(order.DeliveryFee)
+	suite.mockDeliveryRepo.AssertNotCalled(suite.T(), "Create", mock.Anything, mock.Anything)
+}
+
+func (suite *DeliveryServiceTestSuite) TestCreateDelivery_NotDeliveryOrder_ShouldFail() {
+	// Given
+	order, _ := domain.NewOrder("customer-123", domain.OrderTypeTakeout)
+	suite.mockOrderRepo.On("GetByID", suite.ctx, order.ID).Return(order, nil)
+
+	// When
+	delivery, err := suite.service.CreateDelivery(suite.ctx, order.ID)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.Nil(delivery)
+	assert.True(sharedErrors.IsConflictError(err))
+}
+
+func (suite *DeliveryServiceTestSuite) TestCreateDelivery_AlreadyBooked_ShouldFail() {
+	// Given
+	suite.mockOrderRepo.On("GetByID", suite.ctx, suite.order.ID).Return(suite.order, nil)
+	suite.mockDeliveryRepo.On("GetByOrderID", suite.ctx, suite.order.ID).Return(suite.newDelivery(), nil)
+
+	// When
+	delivery, err := suite.service.CreateDelivery(suite.ctx, suite.order.ID)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.Nil(delivery)
+	assert.True(sharedErrors.IsConflictError(err))
+}
+
+func (suite *DeliveryServiceTestSuite) TestCreateDelivery_SaveFails_RemovesFee() {
+	// Given
+	suite.mockOrderRepo.On("GetByID", suite.ctx, suite.order.ID).Return(suite.order, nil)
+	suite.mockDeliveryRepo.On("GetByOrderID", suite.ctx, suite.order.ID).Return(nil, suite.notFound())
+	suite.mockZoneRepo.On("List", suite.ctx).Return([]*domain.DeliveryZone{suite.zone}, nil)
+	suite.mockDeliveryRepo.On("Create", suite.ctx, mock.AnythingOfType("*domain.Delivery")).Return(assert.AnError)
+	suite.mockOrderRepo.On("Update", suite.ctx, suite.order).Return(nil)
+
+	// When
+	delivery, err := suite.service.CreateDelivery(suite.ctx, suite.order.ID)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.Nil(delivery)
+	assert.Error(err)
+	assert.Zero(suite.order.DeliveryFee)
+	assert.InDelta(22.00, suite.order.TotalAmount, 0.001)
+	suite.mockOrderRepo.AssertNumberOfCalls(suite.T(), "Update", 2)
+}
+
+// Test AssignDriver
+func (suite *DeliveryServiceTestSuite) TestAssignDriver_PicksAvailableDriver() {
+	// Given
+	var published *events.DomainEvent
+	delivery := suite.newDelivery()
+	suite.mockDeliveryRepo.On("GetByID", suite.ctx, delivery.ID).Return(delivery, nil)
+	suite.mockDriverRepo.On("FindAvailable", suite.ctx).Return([]*domain.Driver{suite.driver}, nil)
+	suite.mockDeliveryRepo.On("Update", suite.ctx, delivery).Return(nil)
+	suite.mockDriverRepo.On("Update", suite.ctx, suite.driver).Return(nil)
+	suite.mockPublisher.On("Publish", suite.ctx, mock.AnythingOfType("*events.DomainEvent")).
+		Run(func(args mock.Arguments) { published = args.Get(1).(*events.DomainEvent) }).
+		Return(nil)
+
+	// When
+	result, err := suite.service.AssignDriver(suite.ctx, delivery.ID, "")
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	assert.Equal(domain.DeliveryStatusAssigned, result.Status)
+	assert.Equal(suite.driver.ID, result.DriverID)
+	assert.NotNil(result.EstimatedArrival)
+	assert.Equal(domain.DriverStatusOnDelivery, suite.driver.Status)
+	assert.NotNil(published)
+	assert.Equal(events.DeliveryAssignedEvent, published.Type)
+	assert.Equal(string(suite.driver.ID), published.Data["driver_id"])
+	suite.mockDriverRepo.AssertExpectations(suite.T())
+}
+
+func (suite *DeliveryServiceTestSuite) TestAssignDriver_NoDriversAvailable_ShouldFail() {
+	// Given
+	delivery := suite.newDelivery()
+	suite.mockDeliveryRepo.On("GetByID", suite.ctx, delivery.ID).Return(delivery, nil)
+	suite.mockDriverRepo.On("FindAvailable", suite.ctx).Return([]*domain.Driver{}, nil)
+
+	// When
+	result, err := suite.service.AssignDriver(suite.ctx, delivery.ID, "")
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.Nil(result)
+	assert.True(sharedErrors.IsConflictError(err))
+	suite.mockPublisher.AssertNotCalled(suite.T(), "Publish", mock.Anything, mock.Anything)
+}
+
+func (suite *DeliveryServiceTestSuite) TestAssignDriver_DriverTakenConcurrently_ShouldRetryWithNextDriver() {
+	// Given
+	delivery := suite.newDelivery()
+	reloaded := *delivery
+	other, _ := domain.NewDriver("Alex", "555-0101")
+	_ = other.SetStatus(domain.DriverStatusAvailable)
+	suite.mockDeliveryRepo.On("GetByID", suite.ctx, delivery.ID).Return(delivery, nil).Once()
+	suite.mockDeliveryRepo.On("GetByID", suite.ctx, delivery.ID).Return(&reloaded, nil).Once()
+	suite.mockDriverRepo.On("FindAvailable", suite.ctx).Return([]*domain.Driver{suite.driver}, nil).Once()
+	suite.mockDriverRepo.On("FindAvailable", suite.ctx).Return([]*domain.Driver{other}, nil).Once()
+	suite.mockDriverRepo.On("Update", suite.ctx, suite.driver).
+		Return(sharedErrors.WrapVersionConflict("DriverRepository.Update", "driver", string(suite.driver.ID), suite.driver.Version))
+	suite.mockDriverRepo.On("Update", suite.ctx, other).Return(nil)
+	suite.mockDeliveryRepo.On("Update", suite.ctx, &reloaded).Return(nil)
+	suite.mockPublisher.On("Publish", suite.ctx, mock.AnythingOfType("*events.DomainEvent")).Return(nil)
+
+	// When
+	result, err := suite.service.AssignDriver(suite.ctx, delivery.ID, "")
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	assert.Equal(other.ID, result.DriverID)
+	suite.mockDeliveryRepo.AssertNumberOfCalls(suite.T(), "Update", 1)
+}
+
+func (suite *DeliveryServiceTestSuite) TestAssignDriver_DeliveryUpdateFails_ShouldReleaseDriver() {
+	// Given
+	delivery := suite.newDelivery()
+	var released *domain.Driver
+	suite.mockDeliveryRepo.On("GetByID", suite.ctx, delivery.ID).Return(delivery, nil)
+	suite.mockDriverRepo.On("GetByID", suite.ctx, suite.driver.ID).Return(suite.driver, nil)
+	suite.mockDriverRepo.On("Update", suite.ctx, suite.driver).Return(nil)
+	suite.mockDriverRepo.On("Update", suite.ctx, mock.MatchedBy(func(driver *domain.Driver) bool { return driver != suite.driver })).
+		Run(func(args mock.Arguments) { released = args.Get(1).(*domain.Driver) }).
+		Return(nil)
+	suite.mockDeliveryRepo.On("Update", suite.ctx, delivery).Return(assert.AnError)
+
+	// When
+	result, err := suite.service.AssignDriver(suite.ctx, delivery.ID, suite.driver.ID)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.Nil(result)
+	assert.Error(err)
+	assert.NotNil(released)
+	assert.Equal(suite.driver.ID, released.ID)
+	assert.Equal(domain.DriverStatusAvailable, released.Status)
+	suite.mockPublisher.AssertNotCalled(suite.T(), "Publish", mock.Anything, mock.Anything)
+}
+
+// Test PickUpDelivery
+func (suite *DeliveryServiceTestSuite) TestPickUpDelivery_SendsOrderOutForDelivery() {
+	// Given
+	delivery := suite.newDelivery()
+	_ = delivery.Assign(suite.driver, suite.order.CreatedAt)
+	suite.order.Status = domain.OrderStatusReady
+	suite.mockDeliveryRepo.On("GetByID", suite.ctx, delivery.ID).Return(delivery, nil)
+	suite.mockOrderRepo.On("GetByID", suite.ctx, suite.order.ID).Return(suite.order, nil)
+	suite.mockDeliveryRepo.On("Update", suite.ctx, delivery).Return(nil)
+	suite.mockOrderRepo.On("Update", suite.ctx, suite.order).Return(nil)
+	suite.mockPublisher.On("Publish", suite.ctx, mock.AnythingOfType("*events.DomainEvent")).Return(nil)
+
+	// When
+	result, err := suite.service.PickUpDelivery(suite.ctx, delivery.ID)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	assert.Equal(domain.DeliveryStatusPickedUp, result.Status)
+	assert.True(result.EstimatedArrival.After(*result.PickedUpAt))
+	assert.Equal(domain.OrderStatusOutForDelivery, suite.order.Status)
+	suite.mockOrderRepo.AssertExpectations(suite.T())
+}
+
+func (suite *DeliveryServiceTestSuite) TestPickUpDelivery_DeliveryChangedConcurrently_ShouldRetry() {
+	// Given
+	delivery := suite.newDelivery()
+	_ = delivery.Assign(suite.driver, suite.order.CreatedAt)
+	reloaded := *delivery
+	suite.order.Status = domain.OrderStatusReady
+	suite.mockDeliveryRepo.On("GetByID", suite.ctx, delivery.ID).Return(delivery, nil).Once()
+	suite.mockDeliveryRepo.On("GetByID", suite.ctx, delivery.ID).Return(&reloaded, nil).Once()
+	suite.mockOrderRepo.On("GetByID", suite.ctx, suite.order.ID).Return(suite.order, nil)
+	suite.mockDeliveryRepo.On("Update", suite.ctx, mock.MatchedBy(func(d *domain.Delivery) bool { return d == delivery })).
+		Return(sharedErrors.WrapVersionConflict("DeliveryRepository.Update", "delivery", string(delivery.ID), delivery.Version)).Once()
+	suite.mockDeliveryRepo.On("Update", suite.ctx, mock.MatchedBy(func(d *domain.Delivery) bool { return d == &reloaded })).Return(nil).Once()
+	suite.mockOrderRepo.On("Update", suite.ctx, suite.order).Return(nil)
+	suite.mockPublisher.On("Publish", suite.ctx, mock.AnythingOfType("*events.DomainEvent")).Return(nil)
+
+	// When
+	result, err := suite.service.PickUpDelivery(suite.ctx, delivery.ID)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	assert.Same(&reloaded, result)
+	assert.Equal(domain.OrderStatusOutForDelivery, suite.order.Status)
+	suite.mockDeliveryRepo.AssertNumberOfCalls(suite.T(), "Update", 2)
+}
+
+func (suite *DeliveryServiceTestSuite) TestPickUpDelivery_OrderUpdateFails_ShouldRestoreDelivery() {
+	// Given
+	delivery := suite.newDelivery()
+	_ = delivery.Assign(suite.driver, suite.order.CreatedAt)
+	suite.order.Status = domain.OrderStatusReady
+	var restored *domain.Delivery
+	suite.mockDeliveryRepo.On("GetByID", suite.ctx, delivery.ID).Return(delivery, nil)
+	suite.mockOrderRepo.On("GetByID", suite.ctx, suite.order.ID).Return(suite.order, nil)
+	suite.mockDeliveryRepo.On("Update", suite.ctx, mock.MatchedBy(func(d *domain.Delivery) bool { return d == delivery })).Return(nil)
+	suite.mockDeliveryRepo.On("Update", suite.ctx, mock.MatchedBy(func(d *domain.Delivery) bool { return d != delivery })).
+		Run(func(args mock.Arguments) { restored = args.Get(1).(*domain.Delivery) }).
+		Return(nil)
+	suite.mockOrderRepo.On("Update", suite.ctx, suite.order).Return(assert.AnError)
+
+	// When
+	result, err := suite.service.PickUpDelivery(suite.ctx, delivery.ID)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.Nil(result)
+	assert.Error(err)
+	assert.NotNil(restored)
+	assert.Equal(delivery.ID, restored.ID)
+	assert.Equal(domain.DeliveryStatusAssigned, restored.Status)
+	assert.Nil(restored.PickedUpAt)
+	suite.mockPublisher.AssertNotCalled(suite.T(), "Publish", mock.Anything, mock.Anything)
+}
+
+func (suite *DeliveryServiceTestSuite) TestPickUpDelivery_OrderNotReady_ShouldFail() {
+	// Given
+	delivery := suite.newDelivery()
+	_ = delivery.Assign(suite.driver, suite.order.CreatedAt)
+	suite.order.Status = domain.OrderStatusPreparing
+	suite.mockDeliveryRepo.On("GetByID", suite.ctx, delivery.ID).Return(delivery, nil)
+	suite.mockOrderRepo.On("GetByID", suite.ctx, suite.order.ID).Return(suite.order, nil)
+
+	// When
+	result, err := suite.service.PickUpDelivery(suite.ctx, delivery.ID)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.Nil(result)
+	assert.True(sharedErrors.IsConflictError(err))
+	assert.Equal(domain.DeliveryStatusAssigned, delivery.Status)
+}
+
+// Test CompleteDelivery
+func (suite *DeliveryServiceTestSuite) TestCompleteDelivery_CompletesOrderAndFreesDriver() {
+	// Given
+	delivery := suite.newDelivery()
+	_ = delivery.Assign(suite.driver, suite.order.CreatedAt)
+	_ = delivery.PickUp(suite.order.CreatedAt)
+	suite.order.Status = domain.OrderStatusOutForDelivery
+	suite.mockDeliveryRepo.On("GetByID", suite.ctx, delivery.ID).Return(delivery, nil)
+	suite.mockDriverRepo.On("GetByID", suite.ctx, suite.driver.ID).Return(suite.driver, nil)
+	suite.mockOrderRepo.On("GetByID", suite.ctx, suite.order.ID).Return(suite.order, nil)
+	suite.mockDeliveryRepo.On("Update", suite.ctx, delivery).Return(nil)
+	suite.mockDriverRepo.On("Update", suite.ctx, suite.driver).Return(nil)
+	suite.mockOrderRepo.On("Update", suite.ctx, suite.order).Return(nil)
+	suite.mockPublisher.On("Publish", suite.ctx, mock.AnythingOfType("*events.DomainEvent")).Return(nil)
+
+	// When
+	result, err := suite.service.CompleteDelivery(suite.ctx, delivery.ID)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	assert.Equal(domain.DeliveryStatusDelivered, result.Status)
+	assert.Equal(domain.OrderStatusCompleted, suite.order.Status)
+	assert.Equal(domain.DriverStatusAvailable, suite.driver.Status)
+	suite.mockPublisher.AssertExpectations(suite.T())
+}
+
+func (suite *DeliveryServiceTestSuite) TestCompleteDelivery_OrderUpdateFails_ShouldRestoreDeliveryAndDriver() {
+	// Given
+	delivery := suite.newDelivery()
+	_ = delivery.Assign(suite.driver, suite.order.CreatedAt)
+	_ = delivery.PickUp(suite.order.CreatedAt)
+	suite.order.Status = domain.OrderStatusOutForDelivery
+	var restoredDelivery *domain.Delivery
+	var restoredDriver *domain.Driver
+	suite.mockDeliveryRepo.On("GetByID", suite.ctx, delivery.ID).Return(delivery, nil)
+	suite.mockDriverRepo.On("GetByID", suite.ctx, suite.driver.ID).Return(suite.driver, nil)
+	suite.mockOrderRepo.On("GetByID", suite.ctx, suite.order.ID).Return(suite.order, nil)
+	suite.mockDeliveryRepo.On("Update", suite.ctx, mock.MatchedBy(func(d *domain.Delivery) bool { return d == delivery })).Return(nil)
+	suite.mockDeliveryRepo.On("Update", suite.ctx, mock.MatchedBy(func(d *domain.Delivery) bool { return d != delivery })).
+		Run(func(args mock.Arguments) { restoredDelivery = args.Get(1).(*domain.Delivery) }).
+		Return(nil)
+	suite.mockDriverRepo.On("Update", suite.ctx, mock.MatchedBy(func(d *domain.Driver) bool { return d == suite.driver })).Return(nil)
+	suite.mockDriverRepo.On("Update", suite.ctx, mock.MatchedBy(func(d *domain.Driver) bool { return d != suite.driver })).
+		Run(func(args mock.Arguments) { restoredDriver = args.Get(1).(*domain.Driver) }).
+		Return(nil)
+	suite.mockOrderRepo.On("Update", suite.ctx, suite.order).Return(assert.AnError)
+
+	// When
+	result, err := suite.service.CompleteDelivery(suite.ctx, delivery.ID)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.Nil(result)
+	assert.Error(err)
+	assert.NotNil(restoredDelivery)
+	assert.Equal(domain.DeliveryStatusPickedUp, restoredDelivery.Status)
+	assert.NotNil(restoredDriver)
+	assert.Equal(domain.DriverStatusOnDelivery, restoredDriver.Status)
+	suite.mockPublisher.AssertNotCalled(suite.T(), "Publish", mock.Anything, mock.Anything)
+}
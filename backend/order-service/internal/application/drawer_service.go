@@ -0,0 +1,179 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/restaurant-platform/order-service/internal/domain"
+	"github.com/restaurant-platform/shared/pkg/auth"
+	"github.com/restaurant-platform/shared/pkg/concurrency"
+	"github.com/restaurant-platform/shared/pkg/errors"
+)
+
+// DrawerService runs cashier drawer sessions. Cash tenders and refunds are linked to
+// a session by the payment service; the session reconciles them with its paid-ins,
+// paid-outs and drops against the cash counted at close.
+type DrawerService struct {
+	drawerRepo  domain.DrawerSessionRepository
+	paymentRepo domain.PaymentRepository
+}
+
+// NewDrawerService creates a new drawer service
+func NewDrawerService(drawerRepo domain.DrawerSessionRepository, paymentRepo domain.PaymentRepository) *DrawerService {
+	return &DrawerService{
+		drawerRepo:  drawerRepo,
+		paymentRepo: paymentRepo,
+	}
+}
+
+// OpenSession opens a drawer session for the acting cashier with a starting float
+func (s *DrawerService) OpenSession(ctx context.Context, startingFloat float64) (*domain.DrawerSession, error) {
+	cashierID := actorFromContext(ctx)
+
+	if open, err := s.drawerRepo.GetOpenByCashier(ctx, cashierID); err == nil {
+		return nil, errors.WrapConflict("OpenSession", "cashier_id", "cashier already has open drawer session "+open.ID.String(), nil)
+	} else if !errors.IsNotFound(err) {
+		return nil, fmt.Errorf("failed to get drawer session: %w", err)
+	}
+
+	session, err := domain.NewDrawerSession(cashierID, startingFloat)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.drawerRepo.Create(ctx, session); err != nil {
+		return nil, fmt.Errorf("failed to create drawer session: %w", err)
+	}
+
+	log.Printf("Opened drawer session %s for cashier %s with a float of %.2f", session.ID, cashierID, session.StartingFloat)
+	return session, nil
+}
+
+// GetSession retrieves a drawer session with its report; an open session reports a running tally
+func (s *DrawerService) GetSession(ctx context.Context, id domain.DrawerSessionID) (*domain.DrawerSession, error) {
+	session, err := s.drawerRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return s.withRunningTally(ctx, session)
+}
+
+// GetCurrentSession retrieves the open drawer session of the acting cashier
+func (s *DrawerService) GetCurrentSession(ctx context.Context) (*domain.DrawerSession, error) {
+	session, err := s.drawerRepo.GetOpenByCashier(ctx, actorFromContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	return s.withRunningTally(ctx, session)
+}
+
+// ListSessions retrieves the drawer sessions in a status
+func (s *DrawerService) ListSessions(ctx context.Context, status domain.DrawerSessionStatus) ([]*domain.DrawerSession, error) {
+	switch status {
+	case domain.DrawerSessionOpen, domain.DrawerSessionClosed, domain.DrawerSessionSignedOff:
+	default:
+		return nil, errors.WrapValidation("ListSessions", "status", "invalid drawer session status", nil)
+	}
+	return s.drawerRepo.FindByStatus(ctx, status)
+}
+
+// RecordMovement records a paid-in, paid-out or drop on an open session
+func (s *DrawerService) RecordMovement(ctx context.Context, id domain.DrawerSessionID, movementType domain.DrawerMovementType, amount float64, reason string) (*domain.DrawerSession, error) {
+	actor := actorFromContext(ctx)
+
+	session, err := s.change(ctx, id, func(session *domain.DrawerSession) error {
+		_, err := session.RecordMovement(movementType, amount, reason, actor)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	log.Printf("Recorded %s of %.2f on drawer session %s", movementType, amount, id)
+	return s.withRunningTally(ctx, session)
+}
+
+// CloseSession counts the drawer and produces its over/short report
+func (s *DrawerService) CloseSession(ctx context.Context, id domain.DrawerSessionID, counted float64) (*domain.DrawerSession, error) {
+	session, err := s.change(ctx, id, func(session *domain.DrawerSession) error {
+		payments, err := s.paymentRepo.FindByDrawerSession(ctx, session.ID)
+		if err != nil {
+			return fmt.Errorf("failed to get drawer session payments: %w", err)
+		}
+		_, err = session.Close(counted, payments)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	log.Printf("Closed drawer session %s: expected %.2f, counted %.2f, over/short %.2f",
+		session.ID, session.Report.Expected, session.Report.Counted, session.Report.OverShort)
+	return session, nil
+}
+
+// SignOff ends a closed session with a manager's sign-off
+func (s *DrawerService) SignOff(ctx context.Context, id domain.DrawerSessionID, notes string) (*domain.DrawerSession, error) {
+	if !auth.IsManager(ctx) {
+		return nil, errors.WrapForbidden("SignOff", "only a manager can sign off a drawer session", nil)
+	}
+	manager := actorFromContext(ctx)
+
+	session, err := s.drawerRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := session.SignOff(manager, notes); err != nil {
+		return nil, err
+	}
+	if err := s.drawerRepo.Update(ctx, session); err != nil {
+		return nil, fmt.Errorf("failed to update drawer session: %w", err)
+	}
+
+	log.Printf("Drawer session %s signed off by %s", session.ID, manager)
+	return session, nil
+}
+
+// Helper methods
+
+// change applies a modification to a session run by the acting cashier, or any session
+// for a manager, starting over from a fresh copy when the session was saved in between
+func (s *DrawerService) change(ctx context.Context, id domain.DrawerSessionID, modify func(session *domain.DrawerSession) error) (*domain.DrawerSession, error) {
+	var session *domain.DrawerSession
+
+	err := concurrency.RetryOnConflict(ctx, func() error {
+		var err error
+		if session, err = s.drawerRepo.GetByID(ctx, id); err != nil {
+			return err
+		}
+		if session.CashierID != actorFromContext(ctx) && !auth.IsManager(ctx) {
+			return errors.WrapForbidden("DrawerSession", "drawer session belongs to another cashier", nil)
+		}
+		if err := modify(session); err != nil {
+			return err
+		}
+		if err := s.drawerRepo.Update(ctx, session); err != nil {
+			return fmt.Errorf("failed to update drawer session: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return session, nil
+}
+
+// withRunningTally reports the cash an open session should hold so far
+func (s *DrawerService) withRunningTally(ctx context.Context, session *domain.DrawerSession) (*domain.DrawerSession, error) {
+	if session.Status != domain.DrawerSessionOpen {
+		return session, nil
+	}
+
+	payments, err := s.paymentRepo.FindByDrawerSession(ctx, session.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get drawer session payments: %w", err)
+	}
+	report := session.Reconcile(payments)
+	session.Report = &report
+	return session, nil
+}
@@ -0,0 +1,241 @@
+package application
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/restaurant-platform/order-service/internal/domain"
+	"github.com/restaurant-platform/shared/pkg/auth"
+	sharedErrors "github.com/restaurant-platform/shared/pkg/errors"
+)
+
+// MockDrawerSessionRepository is a mock implementation of DrawerSessionRepository
+type MockDrawerSessionRepository struct {
+	mock.Mock
+}
+
+func (m *MockDrawerSessionRepository) Create(ctx context.Context, session *domain.DrawerSession) error {
+	args := m.Called(ctx, session)
+	return args.Error(0)
+}
+
+func (m *MockDrawerSessionRepository) GetByID(ctx context.Context, id domain.DrawerSessionID) (*domain.DrawerSession, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.DrawerSession), args.Error(1)
+}
+
+func (m *MockDrawerSessionRepository) GetOpenByCashier(ctx context.Context, cashierID string) (*domain.DrawerSession, error) {
+	args := m.Called(ctx, cashierID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.DrawerSession), args.Error(1)
+}
+
+func (m *MockDrawerSessionRepository) FindByStatus(ctx context.Context, status domain.DrawerSessionStatus) ([]*domain.DrawerSession, error) {
+	args := m.Called(ctx, status)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.DrawerSession), args.Error(1)
+}
+
+func (m *MockDrawerSessionRepository) Update(ctx context.Context, session *domain.DrawerSession) error {
+	args := m.Called(ctx, session)
+	return args.Error(0)
+}
+
+// DrawerServiceTestSuite contains drawer session, movement and reconciliation tests
+type DrawerServiceTestSuite struct {
+	suite.Suite
+	service         *DrawerService
+	mockDrawerRepo  *MockDrawerSessionRepository
+	mockPaymentRepo *MockPaymentRepository
+	session         *domain.DrawerSession
+	ctx             context.Context
+	managerCtx      context.Context
+}
+
+func (suite *DrawerServiceTestSuite) SetupTest() {
+	suite.mockDrawerRepo = new(MockDrawerSessionRepository)
+	suite.mockPaymentRepo = new(MockPaymentRepository)
+	suite.service = NewDrawerService(suite.mockDrawerRepo, suite.mockPaymentRepo)
+	suite.ctx = auth.WithRole(auth.WithActor(context.Background(), "cashier-1"), auth.RoleCashier)
+	suite.managerCtx = auth.WithRole(auth.WithActor(context.Background(), "manager-1"), auth.RoleManager)
+	suite.session, _ = domain.NewDrawerSession("cashier-1", 100.00)
+}
+
+func TestDrawerServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(DrawerServiceTestSuite))
+}
+
+func (suite *DrawerServiceTestSuite) noOpenSession() error {
+	return sharedErrors.WrapNotFound("GetOpenByCashier", "drawer session", "cashier-1", sharedErrors.ErrNotFound)
+}
+
+// cashPayment pays an order of the given total in cash into the session
+func (suite *DrawerServiceTestSuite) cashPayment(orderID domain.OrderID, total float64) *domain.Payment {
+	payment, _ := domain.NewPayment(orderID, total)
+	tender, _ := payment.AddTender(domain.TenderTypeCash, 0, total, "", "")
+	tender.DrawerSessionID = suite.session.ID
+	return payment
+}
+
+func (suite *DrawerServiceTestSuite) TestOpenSession_Success() {
+	// Given
+	suite.mockDrawerRepo.On("GetOpenByCashier", suite.ctx, "cashier-1").Return(nil, suite.noOpenSession())
+	suite.mockDrawerRepo.On("Create", suite.ctx, mock.AnythingOfType("*domain.DrawerSession")).Return(nil)
+
+	// When
+	session, err := suite.service.OpenSession(suite.ctx, 150.00)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	assert.Equal("cashier-1", session.CashierID)
+	assert.Equal(domain.DrawerSessionOpen, session.Status)
+	assert.Equal(150.00, session.StartingFloat)
+}
+
+func (suite *DrawerServiceTestSuite) TestOpenSession_AlreadyOpen_ShouldFail() {
+	// Given
+	suite.mockDrawerRepo.On("GetOpenByCashier", suite.ctx, "cashier-1").Return(suite.session, nil)
+
+	// When
+	_, err := suite.service.OpenSession(suite.ctx, 150.00)
+
+	// Then
+	assert.New(suite.T()).True(sharedErrors.IsConflictError(err))
+	suite.mockDrawerRepo.AssertNotCalled(suite.T(), "Create", mock.Anything, mock.Anything)
+}
+
+func (suite *DrawerServiceTestSuite) TestGetCurrentSession_ReportsRunningTally() {
+	// Given
+	suite.session.RecordMovement(domain.DrawerMovementDrop, 50.00, "", "cashier-1")
+	suite.mockDrawerRepo.On("GetOpenByCashier", suite.ctx, "cashier-1").Return(suite.session, nil)
+	suite.mockPaymentRepo.On("FindByDrawerSession", suite.ctx, suite.session.ID).
+		Return([]*domain.Payment{suite.cashPayment("ord_1", 22.00)}, nil)
+
+	// When
+	session, err := suite.service.GetCurrentSession(suite.ctx)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	assert.Equal(22.00, session.Report.CashSales)
+	assert.Equal(72.00, session.Report.Expected)
+}
+
+func (suite *DrawerServiceTestSuite) TestRecordMovement_OtherCashier_ShouldFail() {
+	// Given
+	otherCtx := auth.WithRole(auth.WithActor(context.Background(), "cashier-2"), auth.RoleCashier)
+	suite.mockDrawerRepo.On("GetByID", otherCtx, suite.session.ID).Return(suite.session, nil)
+
+	// When
+	_, err := suite.service.RecordMovement(otherCtx, suite.session.ID, domain.DrawerMovementPaidIn, 20.00, "")
+
+	// Then
+	assert.New(suite.T()).True(sharedErrors.IsForbiddenError(err))
+	suite.mockDrawerRepo.AssertNotCalled(suite.T(), "Update", mock.Anything, mock.Anything)
+}
+
+func (suite *DrawerServiceTestSuite) TestRecordMovement_RetriesOnVersionConflict() {
+	// Given: each attempt loads its own copy of the session, as the database does
+	stale, fresh := *suite.session, *suite.session
+	suite.mockDrawerRepo.On("GetByID", suite.ctx, suite.session.ID).Return(&stale, nil).Once()
+	suite.mockDrawerRepo.On("GetByID", suite.ctx, suite.session.ID).Return(&fresh, nil)
+	suite.mockDrawerRepo.On("Update", suite.ctx, &stale).
+		Return(sharedErrors.WrapVersionConflict("Update", "drawer session", suite.session.ID.String(), 1))
+	suite.mockDrawerRepo.On("Update", suite.ctx, &fresh).Return(nil)
+	suite.mockPaymentRepo.On("FindByDrawerSession", suite.ctx, suite.session.ID).Return([]*domain.Payment{}, nil)
+
+	// When
+	session, err := suite.service.RecordMovement(suite.ctx, suite.session.ID, domain.DrawerMovementPaidOut, 12.50, "window cleaner")
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	assert.Len(session.Movements, 1)
+	assert.Equal(87.50, session.Report.Expected)
+	suite.mockDrawerRepo.AssertNumberOfCalls(suite.T(), "Update", 2)
+}
+
+func (suite *DrawerServiceTestSuite) TestCloseSession_ComputesOverShort() {
+	// Given: 100.00 float + 22.00 and 30.00 cash sales - 5.00 cash refund - 40.00 drop
+	suite.session.RecordMovement(domain.DrawerMovementDrop, 40.00, "", "cashier-1")
+	refunded := suite.cashPayment("ord_2", 30.00)
+	refund, _ := refunded.Refund(refunded.Tenders[0].ID, 5.00, "cold", "")
+	refund.DrawerSessionID = suite.session.ID
+
+	suite.mockDrawerRepo.On("GetByID", suite.ctx, suite.session.ID).Return(suite.session, nil)
+	suite.mockPaymentRepo.On("FindByDrawerSession", suite.ctx, suite.session.ID).
+		Return([]*domain.Payment{suite.cashPayment("ord_1", 22.00), refunded}, nil)
+	suite.mockDrawerRepo.On("Update", suite.ctx, suite.session).Return(nil)
+
+	// When
+	session, err := suite.service.CloseSession(suite.ctx, suite.session.ID, 105.00)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	assert.Equal(domain.DrawerSessionClosed, session.Status)
+	assert.Equal(107.00, session.Report.Expected)
+	assert.Equal(105.00, session.Report.Counted)
+	assert.Equal(-2.00, session.Report.OverShort)
+	assert.Equal(2, session.Report.CashTenders)
+}
+
+func (suite *DrawerServiceTestSuite) TestCloseSession_OpenOrders_ShouldFail() {
+	// Given
+	open, _ := domain.NewPayment("ord_open", 40.00)
+	tender, _ := open.AddTender(domain.TenderTypeCash, 0, 10.00, "", "")
+	tender.DrawerSessionID = suite.session.ID
+
+	suite.mockDrawerRepo.On("GetByID", suite.ctx, suite.session.ID).Return(suite.session, nil)
+	suite.mockPaymentRepo.On("FindByDrawerSession", suite.ctx, suite.session.ID).Return([]*domain.Payment{open}, nil)
+
+	// When
+	_, err := suite.service.CloseSession(suite.ctx, suite.session.ID, 110.00)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.True(sharedErrors.IsConflictError(err))
+	assert.Contains(err.Error(), "ord_open")
+	suite.mockDrawerRepo.AssertNotCalled(suite.T(), "Update", mock.Anything, mock.Anything)
+}
+
+func (suite *DrawerServiceTestSuite) TestSignOff_Manager_Success() {
+	// Given
+	suite.session.Close(100.00, nil)
+	suite.mockDrawerRepo.On("GetByID", suite.managerCtx, suite.session.ID).Return(suite.session, nil)
+	suite.mockDrawerRepo.On("Update", suite.managerCtx, suite.session).Return(nil)
+
+	// When
+	session, err := suite.service.SignOff(suite.managerCtx, suite.session.ID, "counted twice")
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	assert.Equal(domain.DrawerSessionSignedOff, session.Status)
+	assert.Equal("manager-1", session.SignedOffBy)
+	assert.Equal("counted twice", session.SignOffNotes)
+}
+
+func (suite *DrawerServiceTestSuite) TestSignOff_Cashier_ShouldFail() {
+	// Given
+	suite.session.Close(100.00, nil)
+
+	// When
+	_, err := suite.service.SignOff(suite.ctx, suite.session.ID, "")
+
+	// Then
+	assert.New(suite.T()).True(sharedErrors.IsForbiddenError(err))
+	suite.mockDrawerRepo.AssertNotCalled(suite.T(), "GetByID", mock.Anything, mock.Anything)
+}
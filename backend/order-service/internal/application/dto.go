@@ -9,28 +9,67 @@ import (
 // Request DTOs
 
 type CreateOrderRequest struct {
-	CustomerID string            `json:"customer_id" binding:"required"`
-	Type       string            `json:"type" binding:"required"`
-	TableID    string            `json:"table_id,omitempty"`
-	Address    string            `json:"delivery_address,omitempty"`
-	Notes      string            `json:"notes,omitempty"`
+	CustomerID      string     `json:"customer_id" binding:"required"`
+	Type            string     `json:"type" binding:"required"`
+	TableID         string     `json:"table_id,omitempty"`
+	Address         string     `json:"delivery_address,omitempty"`
+	Notes           string     `json:"notes,omitempty"`
+	FulfillmentTime *time.Time `json:"fulfillment_time,omitempty"`
+	GuestCount      int        `json:"guest_count,omitempty" binding:"min=0"`
+	ReservationID   string     `json:"reservation_id,omitempty"`
 }
 
+// AddItemRequest identifies a menu item; its name and price are resolved server-side
 type AddItemRequest struct {
-	MenuItemID    string   `json:"menu_item_id" binding:"required"`
-	Name          string   `json:"name" binding:"required"`
-	Quantity      int      `json:"quantity" binding:"required,min=1"`
-	UnitPrice     float64  `json:"unit_price" binding:"required,min=0"`
-	Modifications []string `json:"modifications,omitempty"`
-	Notes         string   `json:"notes,omitempty"`
+	MenuItemID    string                     `json:"menu_item_id" binding:"required"`
+	Quantity      int                        `json:"quantity" binding:"required,min=1"`
+	Course        int                        `json:"course,omitempty" binding:"min=0"`
+	Seat          int                        `json:"seat,omitempty" binding:"min=0"`
+	Modifiers     []ModifierSelectionRequest `json:"modifiers,omitempty"`
+	Modifications []string                   `json:"modifications,omitempty"`
+	Notes         string                     `json:"notes,omitempty"`
+}
+
+// ModifierSelectionRequest lists the options chosen for one modifier group
+type ModifierSelectionRequest struct {
+	GroupID   string   `json:"group_id" binding:"required"`
+	OptionIDs []string `json:"option_ids"`
+}
+
+// ToModifierSelections converts the request's modifier selections to domain values
+func (r AddItemRequest) ToModifierSelections() []domain.ModifierSelection {
+	selections := make([]domain.ModifierSelection, len(r.Modifiers))
+	for i, m := range r.Modifiers {
+		selections[i] = domain.ModifierSelection{GroupID: m.GroupID, OptionIDs: m.OptionIDs}
+	}
+	return selections
 }
 
 type UpdateItemQuantityRequest struct {
 	Quantity int `json:"quantity" binding:"required,min=1"`
 }
 
+type RescheduleOrderRequest struct {
+	FulfillmentTime time.Time `json:"fulfillment_time" binding:"required"`
+}
+
+type ScheduledOrdersRequest struct {
+	From *string `form:"from"`
+	To   *string `form:"to"`
+}
+
+type StatusMetricsRequest struct {
+	From *string `form:"from"`
+	To   *string `form:"to"`
+}
+
+type VoidItemRequest struct {
+	Reason string `json:"reason" binding:"required"`
+}
+
 type UpdateOrderStatusRequest struct {
 	Status string `json:"status" binding:"required"`
+	Reason string `json:"reason,omitempty"`
 }
 
 type SetTableRequest struct {
@@ -41,6 +80,33 @@ type SetDeliveryAddressRequest struct {
 	Address string `json:"delivery_address" binding:"required"`
 }
 
+type TransferTableRequest struct {
+	TableID string `json:"table_id" binding:"required"`
+}
+
+type MergeOrdersRequest struct {
+	SourceOrderID string `json:"source_order_id" binding:"required"`
+}
+
+type MoveItemsRequest struct {
+	TargetOrderID string   `json:"target_order_id" binding:"required"`
+	ItemIDs       []string `json:"item_ids" binding:"required,min=1"`
+}
+
+// SeatGuestsRequest sets the guest count of a dine-in order; leaving it out takes the reservation's party size
+type SeatGuestsRequest struct {
+	GuestCount    int    `json:"guest_count" binding:"min=0"`
+	ReservationID string `json:"reservation_id,omitempty"`
+}
+
+type AssignSeatRequest struct {
+	Seat *int `json:"seat" binding:"required,min=0"`
+}
+
+type SetItemMappingRequest struct {
+	MenuItemID string `json:"menu_item_id" binding:"required"`
+}
+
 type AddNotesRequest struct {
 	Notes string `json:"notes" binding:"required"`
 }
@@ -61,29 +127,101 @@ type OrderListRequest struct {
 // Response DTOs
 
 type OrderResponse struct {
-	ID              string              `json:"id"`
-	CustomerID      string              `json:"customer_id"`
-	Type            string              `json:"type"`
-	Status          string              `json:"status"`
+	ID              string               `json:"id"`
+	CustomerID      string               `json:"customer_id"`
+	Type            string               `json:"type"`
+	Status          string               `json:"status"`
 	Items           []*OrderItemResponse `json:"items"`
-	TotalAmount     float64             `json:"total_amount"`
-	TaxAmount       float64             `json:"tax_amount"`
-	TableID         string              `json:"table_id,omitempty"`
-	DeliveryAddress string              `json:"delivery_address,omitempty"`
-	Notes           string              `json:"notes,omitempty"`
-	CreatedAt       time.Time           `json:"created_at"`
-	UpdatedAt       time.Time           `json:"updated_at"`
+	TotalAmount     float64              `json:"total_amount"`
+	TaxAmount       float64              `json:"tax_amount"`
+	TableID         string               `json:"table_id,omitempty"`
+	DeliveryAddress string               `json:"delivery_address,omitempty"`
+	DeliveryFee     float64              `json:"delivery_fee,omitempty"`
+	Notes           string               `json:"notes,omitempty"`
+	FulfillmentTime *time.Time           `json:"fulfillment_time,omitempty"`
+	ReleasedAt      *time.Time           `json:"released_at,omitempty"`
+	Discounts       []*DiscountResponse  `json:"discounts,omitempty"`
+	MergedInto      string               `json:"merged_into,omitempty"`
+	GuestCount      int                  `json:"guest_count,omitempty"`
+	ReservationID   string               `json:"reservation_id,omitempty"`
+	Version         int                  `json:"version"`
+	CreatedAt       time.Time            `json:"created_at"`
+	UpdatedAt       time.Time            `json:"updated_at"`
+}
+
+// ScheduledOrderResponse is a scheduled order awaiting release with its planned release time
+type ScheduledOrderResponse struct {
+	Order     *OrderResponse `json:"order"`
+	PrepTime  int            `json:"prep_time"` // in seconds
+	ReleaseAt time.Time      `json:"release_at"`
+}
+
+// StatusTransitionResponse is a single entry in an order's status history
+type StatusTransitionResponse struct {
+	From   string    `json:"from"`
+	To     string    `json:"to"`
+	Actor  string    `json:"actor"`
+	Reason string    `json:"reason,omitempty"`
+	At     time.Time `json:"at"`
+}
+
+// StatusHistoryResponse is an order's status history with the time spent in each status
+type StatusHistoryResponse struct {
+	OrderID      string                      `json:"order_id"`
+	Status       string                      `json:"status"`
+	Transitions  []*StatusTransitionResponse `json:"transitions"`
+	TimeInStatus map[string]int              `json:"time_in_status"` // in seconds
+}
+
+// StatusMetricResponse is the average and longest time orders spent in a status
+type StatusMetricResponse struct {
+	Status  string `json:"status"`
+	Orders  int    `json:"orders"`
+	Average int    `json:"average"` // in seconds
+	Max     int    `json:"max"`     // in seconds
 }
 
 type OrderItemResponse struct {
-	ID            string   `json:"id"`
-	MenuItemID    string   `json:"menu_item_id"`
-	Name          string   `json:"name"`
-	Quantity      int      `json:"quantity"`
-	UnitPrice     float64  `json:"unit_price"`
-	Modifications []string `json:"modifications,omitempty"`
-	Notes         string   `json:"notes,omitempty"`
-	Subtotal      float64  `json:"subtotal"`
+	ID            string                       `json:"id"`
+	MenuItemID    string                       `json:"menu_item_id"`
+	Name          string                       `json:"name"`
+	Quantity      int                          `json:"quantity"`
+	UnitPrice     float64                      `json:"unit_price"`
+	Modifiers     []*OrderItemModifierResponse `json:"modifiers,omitempty"`
+	Modifications []string                     `json:"modifications,omitempty"`
+	Notes         string                       `json:"notes,omitempty"`
+	Subtotal      float64                      `json:"subtotal"`
+	Course        int                          `json:"course"`
+	CourseStatus  string                       `json:"course_status"`
+	Seat          int                          `json:"seat,omitempty"`
+	FiredAt       *time.Time                   `json:"fired_at,omitempty"`
+	VoidedAt      *time.Time                   `json:"voided_at,omitempty"`
+	VoidReason    string                       `json:"void_reason,omitempty"`
+	RefundedAt    *time.Time                   `json:"refunded_at,omitempty"`
+}
+
+// SeatResponse lists what one seat of a dine-in check ordered; seat 0 holds shared items
+type SeatResponse struct {
+	Seat     int                  `json:"seat"`
+	Items    []*OrderItemResponse `json:"items"`
+	Subtotal float64              `json:"subtotal"`
+}
+
+// DiscountResponse is a discount line taken off the order subtotal
+type DiscountResponse struct {
+	ID          string  `json:"id"`
+	Type        string  `json:"type"`
+	Description string  `json:"description"`
+	Amount      float64 `json:"amount"`
+	Points      int     `json:"points,omitempty"`
+}
+
+type OrderItemModifierResponse struct {
+	GroupID    string  `json:"group_id"`
+	GroupName  string  `json:"group_name"`
+	OptionID   string  `json:"option_id"`
+	OptionName string  `json:"option_name"`
+	PriceDelta float64 `json:"price_delta"`
 }
 
 type OrderListResponse struct {
@@ -111,16 +249,18 @@ type HealthResponse struct {
 func ToOrderResponse(order *domain.Order) *OrderResponse {
 	items := make([]*OrderItemResponse, len(order.Items))
 	for i, item := range order.Items {
-		items[i] = &OrderItemResponse{
-			ID:            string(item.ID),
-			MenuItemID:    item.MenuItemID,
-			Name:          item.Name,
-			Quantity:      item.Quantity,
-			UnitPrice:     item.UnitPrice,
-			Modifications: item.Modifications,
-			Notes:         item.Notes,
-			Subtotal:      item.Subtotal,
-		}
+		items[i] = toOrderItemResponse(item)
+	}
+
+	var discounts []*DiscountResponse
+	for _, discount := range order.Discounts {
+		discounts = append(discounts, &DiscountResponse{
+			ID:          discount.ID.String(),
+			Type:        string(discount.Type),
+			Description: discount.Description,
+			Amount:      discount.Amount,
+			Points:      discount.Points,
+		})
 	}
 
 	return &OrderResponse{
@@ -133,12 +273,118 @@ func ToOrderResponse(order *domain.Order) *OrderResponse {
 		TaxAmount:       order.TaxAmount,
 		TableID:         order.TableID,
 		DeliveryAddress: order.DeliveryAddress,
+		DeliveryFee:     order.DeliveryFee,
 		Notes:           order.Notes,
+		FulfillmentTime: order.FulfillmentTime,
+		ReleasedAt:      order.ReleasedAt,
+		Discounts:       discounts,
+		MergedInto:      string(order.MergedInto),
+		GuestCount:      order.GuestCount,
+		ReservationID:   order.ReservationID,
+		Version:         order.Version,
 		CreatedAt:       order.CreatedAt,
 		UpdatedAt:       order.UpdatedAt,
 	}
 }
 
+func toOrderItemResponse(item *domain.OrderItem) *OrderItemResponse {
+	var modifiers []*OrderItemModifierResponse
+	for _, m := range item.Modifiers {
+		modifiers = append(modifiers, &OrderItemModifierResponse{
+			GroupID:    m.GroupID,
+			GroupName:  m.GroupName,
+			OptionID:   m.OptionID,
+			OptionName: m.OptionName,
+			PriceDelta: m.PriceDelta,
+		})
+	}
+	return &OrderItemResponse{
+		ID:            string(item.ID),
+		MenuItemID:    item.MenuItemID,
+		Name:          item.Name,
+		Quantity:      item.Quantity,
+		UnitPrice:     item.UnitPrice,
+		Modifiers:     modifiers,
+		Modifications: item.Modifications,
+		Notes:         item.Notes,
+		Subtotal:      item.Subtotal,
+		Course:        item.Course,
+		CourseStatus:  string(item.CourseStatus),
+		Seat:          item.Seat,
+		FiredAt:       item.FiredAt,
+		VoidedAt:      item.VoidedAt,
+		VoidReason:    item.VoidReason,
+		RefundedAt:    item.RefundedAt,
+	}
+}
+
+// ToSeatResponses converts the items of a check grouped by seat to response DTOs
+func ToSeatResponses(seats []*domain.SeatItems) []*SeatResponse {
+	responses := make([]*SeatResponse, len(seats))
+	for i, seat := range seats {
+		items := make([]*OrderItemResponse, len(seat.Items))
+		for j, item := range seat.Items {
+			items[j] = toOrderItemResponse(item)
+		}
+		responses[i] = &SeatResponse{
+			Seat:     seat.Seat,
+			Items:    items,
+			Subtotal: seat.Subtotal,
+		}
+	}
+	return responses
+}
+
+func ToScheduledOrderResponses(scheduled []*domain.ScheduledOrder) []*ScheduledOrderResponse {
+	responses := make([]*ScheduledOrderResponse, len(scheduled))
+	for i, s := range scheduled {
+		responses[i] = &ScheduledOrderResponse{
+			Order:     ToOrderResponse(s.Order),
+			PrepTime:  int(s.PrepTime.Seconds()),
+			ReleaseAt: s.ReleaseAt,
+		}
+	}
+	return responses
+}
+
+func ToStatusHistoryResponse(order *domain.Order, now time.Time) *StatusHistoryResponse {
+	transitions := make([]*StatusTransitionResponse, len(order.StatusHistory))
+	for i, t := range order.StatusHistory {
+		transitions[i] = &StatusTransitionResponse{
+			From:   string(t.From),
+			To:     string(t.To),
+			Actor:  t.Actor,
+			Reason: t.Reason,
+			At:     t.At,
+		}
+	}
+
+	timeInStatus := make(map[string]int)
+	for status, duration := range order.TimeInStatus(now) {
+		timeInStatus[string(status)] = int(duration.Seconds())
+	}
+
+	return &StatusHistoryResponse{
+		OrderID:      string(order.ID),
+		Status:       string(order.Status),
+		Transitions:  transitions,
+		TimeInStatus: timeInStatus,
+	}
+}
+
+func ToStatusMetricResponses(metrics []*domain.StatusMetric) []*StatusMetricResponse {
+	responses := make([]*StatusMetricResponse, len(metrics))
+	for i, m := range metrics {
+		responses[i] = &StatusMetricResponse{
+			Status:  string(m.Status),
+			Orders:  m.Orders,
+			Average: int(m.Average.Seconds()),
+			Max:     int(m.Max.Seconds()),
+		}
+	}
+	return responses
+}
+
 func ToOrderListResponse(orders []*domain.Order, total, offset, limit int) *OrderListResponse {
 	responses := make([]*OrderResponse, len(orders))
 	for i, order := range orders {
@@ -154,4 +400,411 @@ func ToOrderListResponse(orders []*domain.Order, total, offset, limit int) *Orde
 		Limit:   limit,
 		HasMore: hasMore,
 	}
-}
\ No newline at end of file
+}
+// Payment DTOs
+
+type AddTenderRequest struct {
+	Type           string  `json:"type" binding:"required"`
+	Amount         float64 `json:"amount" binding:"min=0"`
+	AmountTendered float64 `json:"amount_tendered,omitempty" binding:"min=0"`
+	Reference      string  `json:"reference,omitempty"` // the card code for gift card tenders
+}
+
+type RefundTenderRequest struct {
+	Amount float64 `json:"amount" binding:"required,gt=0"`
+	Reason string  `json:"reason" binding:"required"`
+}
+
+type VoidPaymentRequest struct {
+	Reason string `json:"reason" binding:"required"`
+}
+
+type PaymentResponse struct {
+	ID             string            `json:"id"`
+	OrderID        string            `json:"order_id"`
+	Status         string            `json:"status"`
+	AmountDue      float64           `json:"amount_due"`
+	AmountPaid     float64           `json:"amount_paid"`
+	AmountRefunded float64           `json:"amount_refunded"`
+	Remaining      float64           `json:"remaining"`
+	ChangeGiven    float64           `json:"change_given"`
+	Tenders        []*TenderResponse `json:"tenders"`
+	Refunds        []*RefundResponse `json:"refunds"`
+	VoidReason     string            `json:"void_reason,omitempty"`
+	CreatedAt      time.Time         `json:"created_at"`
+	UpdatedAt      time.Time         `json:"updated_at"`
+}
+
+type TenderResponse struct {
+	ID             string  `json:"id"`
+	Type           string  `json:"type"`
+	Status         string  `json:"status"`
+	Amount         float64 `json:"amount"`
+	AmountTendered float64 `json:"amount_tendered"`
+	Change         float64 `json:"change"`
+	AmountRefunded float64 `json:"amount_refunded"`
+	Reference      string  `json:"reference,omitempty"`
+}
+
+type RefundResponse struct {
+	ID        string    `json:"id"`
+	TenderID  string    `json:"tender_id"`
+	Amount    float64   `json:"amount"`
+	Reason    string    `json:"reason"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+func ToPaymentResponse(payment *domain.Payment) *PaymentResponse {
+	tenders := make([]*TenderResponse, len(payment.Tenders))
+	for i, tender := range payment.Tenders {
+		tenders[i] = &TenderResponse{
+			ID:             string(tender.ID),
+			Type:           string(tender.Type),
+			Status:         string(tender.Status),
+			Amount:         tender.Amount,
+			AmountTendered: tender.AmountTendered,
+			Change:         tender.Change,
+			AmountRefunded: tender.AmountRefunded,
+			Reference:      tender.Reference,
+		}
+	}
+
+	refunds := make([]*RefundResponse, len(payment.Refunds))
+	for i, refund := range payment.Refunds {
+		refunds[i] = &RefundResponse{
+			ID:        string(refund.ID),
+			TenderID:  string(refund.TenderID),
+			Amount:    refund.Amount,
+			Reason:    refund.Reason,
+			CreatedAt: refund.CreatedAt,
+		}
+	}
+
+	return &PaymentResponse{
+		ID:             string(payment.ID),
+		OrderID:        string(payment.OrderID),
+		Status:         string(payment.Status),
+		AmountDue:      payment.AmountDue,
+		AmountPaid:     payment.AmountPaid,
+		AmountRefunded: payment.AmountRefunded,
+		Remaining:      payment.Remaining(),
+		ChangeGiven:    payment.ChangeGiven,
+		Tenders:        tenders,
+		Refunds:        refunds,
+		VoidReason:     payment.VoidReason,
+		CreatedAt:      payment.CreatedAt,
+		UpdatedAt:      payment.UpdatedAt,
+	}
+}
+
+// Delivery DTOs
+
+type CoordinatesRequest struct {
+	Lat float64 `json:"lat" binding:"min=-90,max=90"`
+	Lng float64 `json:"lng" binding:"min=-180,max=180"`
+}
+
+type CreateDeliveryZoneRequest struct {
+	Name         string               `json:"name" binding:"required"`
+	Boundary     []CoordinatesRequest `json:"boundary" binding:"required,min=3,dive"`
+	Fee          float64              `json:"fee" binding:"min=0"`
+	MinimumOrder float64              `json:"minimum_order" binding:"min=0"`
+}
+
+// ToBoundary converts the request's boundary points to domain coordinates
+func (r CreateDeliveryZoneRequest) ToBoundary() []domain.Coordinates {
+	boundary := make([]domain.Coordinates, len(r.Boundary))
+	for i, point := range r.Boundary {
+		boundary[i] = domain.Coordinates{Lat: point.Lat, Lng: point.Lng}
+	}
+	return boundary
+}
+
+type SetZoneActiveRequest struct {
+	IsActive *bool `json:"is_active" binding:"required"`
+}
+
+type QuoteDeliveryRequest struct {
+	Address string `json:"address" binding:"required"`
+}
+
+type CreateDriverRequest struct {
+	Name  string `json:"name" binding:"required"`
+	Phone string `json:"phone" binding:"required"`
+}
+
+type SetDriverStatusRequest struct {
+	Status string `json:"status" binding:"required"`
+}
+
+type AssignDriverRequest struct {
+	DriverID string `json:"driver_id,omitempty"`
+}
+
+type CoordinatesResponse struct {
+	Lat float64 `json:"lat"`
+	Lng float64 `json:"lng"`
+}
+
+type DeliveryZoneResponse struct {
+	ID           string                 `json:"id"`
+	Name         string                 `json:"name"`
+	Boundary     []*CoordinatesResponse `json:"boundary"`
+	Fee          float64                `json:"fee"`
+	MinimumOrder float64                `json:"minimum_order"`
+	IsActive     bool                   `json:"is_active"`
+	CreatedAt    time.Time              `json:"created_at"`
+	UpdatedAt    time.Time              `json:"updated_at"`
+}
+
+type DeliveryQuoteResponse struct {
+	ZoneID       string  `json:"zone_id"`
+	ZoneName     string  `json:"zone_name"`
+	Fee          float64 `json:"fee"`
+	MinimumOrder float64 `json:"minimum_order"`
+}
+
+type DriverResponse struct {
+	ID        string    `json:"id"`
+	Name      string    `json:"name"`
+	Phone     string    `json:"phone"`
+	Status    string    `json:"status"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type DeliveryResponse struct {
+	ID               string               `json:"id"`
+	OrderID          string               `json:"order_id"`
+	ZoneID           string               `json:"zone_id"`
+	DriverID         string               `json:"driver_id,omitempty"`
+	Address          string               `json:"address"`
+	Location         *CoordinatesResponse `json:"location"`
+	Fee              float64              `json:"fee"`
+	Status           string               `json:"status"`
+	EstimatedArrival *time.Time           `json:"estimated_arrival,omitempty"`
+	AssignedAt       *time.Time           `json:"assigned_at,omitempty"`
+	PickedUpAt       *time.Time           `json:"picked_up_at,omitempty"`
+	DeliveredAt      *time.Time           `json:"delivered_at,omitempty"`
+	CreatedAt        time.Time            `json:"created_at"`
+	UpdatedAt        time.Time            `json:"updated_at"`
+}
+
+func ToDeliveryZoneResponse(zone *domain.DeliveryZone) *DeliveryZoneResponse {
+	boundary := make([]*CoordinatesResponse, len(zone.Boundary))
+	for i, point := range zone.Boundary {
+		boundary[i] = &CoordinatesResponse{Lat: point.Lat, Lng: point.Lng}
+	}
+
+	return &DeliveryZoneResponse{
+		ID:           string(zone.ID),
+		Name:         zone.Name,
+		Boundary:     boundary,
+		Fee:          zone.Fee,
+		MinimumOrder: zone.MinimumOrder,
+		IsActive:     zone.IsActive,
+		CreatedAt:    zone.CreatedAt,
+		UpdatedAt:    zone.UpdatedAt,
+	}
+}
+
+func ToDeliveryZoneResponses(zones []*domain.DeliveryZone) []*DeliveryZoneResponse {
+	responses := make([]*DeliveryZoneResponse, len(zones))
+	for i, zone := range zones {
+		responses[i] = ToDeliveryZoneResponse(zone)
+	}
+	return responses
+}
+
+func ToDeliveryQuoteResponse(zone *domain.DeliveryZone) *DeliveryQuoteResponse {
+	return &DeliveryQuoteResponse{
+		ZoneID:       string(zone.ID),
+		ZoneName:     zone.Name,
+		Fee:          zone.Fee,
+		MinimumOrder: zone.MinimumOrder,
+	}
+}
+
+func ToDriverResponse(driver *domain.Driver) *DriverResponse {
+	return &DriverResponse{
+		ID:        string(driver.ID),
+		Name:      driver.Name,
+		Phone:     driver.Phone,
+		Status:    string(driver.Status),
+		CreatedAt: driver.CreatedAt,
+		UpdatedAt: driver.UpdatedAt,
+	}
+}
+
+func ToDriverResponses(drivers []*domain.Driver) []*DriverResponse {
+	responses := make([]*DriverResponse, len(drivers))
+	for i, driver := range drivers {
+		responses[i] = ToDriverResponse(driver)
+	}
+	return responses
+}
+
+func ToDeliveryResponse(delivery *domain.Delivery) *DeliveryResponse {
+	return &DeliveryResponse{
+		ID:               string(delivery.ID),
+		OrderID:          string(delivery.OrderID),
+		ZoneID:           string(delivery.ZoneID),
+		DriverID:         string(delivery.DriverID),
+		Address:          delivery.Address,
+		Location:         &CoordinatesResponse{Lat: delivery.Location.Lat, Lng: delivery.Location.Lng},
+		Fee:              delivery.Fee,
+		Status:           string(delivery.Status),
+		EstimatedArrival: delivery.EstimatedArrival,
+		AssignedAt:       delivery.AssignedAt,
+		PickedUpAt:       delivery.PickedUpAt,
+		DeliveredAt:      delivery.DeliveredAt,
+		CreatedAt:        delivery.CreatedAt,
+		UpdatedAt:        delivery.UpdatedAt,
+	}
+}
+
+// Receipt DTOs
+
+type ReceiptRequest struct {
+	Format string `form:"format"`
+	Width  int    `form:"width"`
+}
+
+// Report DTOs
+
+type SalesReportRequest struct {
+	Date   string `form:"date"`
+	Format string `form:"format"`
+}
+
+type ZReportRequest struct {
+	Format string `form:"format"`
+}
+
+type CloseBusinessDayRequest struct {
+	BusinessDate string `json:"business_date"`
+}
+
+type ListZReportsRequest struct {
+	Limit int `form:"limit,default=30" binding:"min=1,max=366"`
+}
+
+// Adjustment DTOs
+
+// ManagerApprovalRequest is a manager's PIN override for a void or refund above the approval threshold
+type ManagerApprovalRequest struct {
+	ManagerID string `json:"manager_id" binding:"required"`
+	PIN       string `json:"pin" binding:"required"`
+}
+
+type VoidOrderRequest struct {
+	Reason   string                  `json:"reason" binding:"required"`
+	Notes    string                  `json:"notes"`
+	Approval *ManagerApprovalRequest `json:"approval"`
+}
+
+type RefundOrderRequest struct {
+	ItemIDs  []string                `json:"item_ids"`
+	Reason   string                  `json:"reason" binding:"required"`
+	Notes    string                  `json:"notes"`
+	Approval *ManagerApprovalRequest `json:"approval"`
+}
+
+// SLA DTOs
+
+type SLAAlertResponse struct {
+	ID               string    `json:"id"`
+	OrderID          string    `json:"order_id"`
+	OrderType        string    `json:"order_type"`
+	TableID          string    `json:"table_id,omitempty"`
+	Status           string    `json:"status"`
+	ThresholdSeconds int64     `json:"threshold_seconds"`
+	ElapsedSeconds   int64     `json:"elapsed_seconds"`
+	EnteredAt        time.Time `json:"entered_at"`
+	BreachedAt       time.Time `json:"breached_at"`
+}
+
+// ToSLAAlertResponses converts SLA alerts, with how long each order has been in the breached status as of now
+func ToSLAAlertResponses(alerts []*domain.SLAAlert, now time.Time) []*SLAAlertResponse {
+	responses := make([]*SLAAlertResponse, len(alerts))
+	for i, alert := range alerts {
+		responses[i] = &SLAAlertResponse{
+			ID:               alert.ID.String(),
+			OrderID:          alert.OrderID.String(),
+			OrderType:        string(alert.OrderType),
+			TableID:          alert.TableID,
+			Status:           string(alert.Status),
+			ThresholdSeconds: int64(alert.Threshold.Seconds()),
+			ElapsedSeconds:   int64(alert.Elapsed(now).Seconds()),
+			EnteredAt:        alert.EnteredAt,
+			BreachedAt:       alert.BreachedAt,
+		}
+	}
+	return responses
+}
+
+// Loyalty DTOs
+
+type RedeemPointsRequest struct {
+	Points int `json:"points" binding:"required,min=1"`
+}
+
+// Gift card DTOs
+
+type IssueGiftCardRequest struct {
+	Amount float64 `json:"amount" binding:"required,gt=0"`
+}
+
+type GiftCardCodeRequest struct {
+	Code string `json:"code" binding:"required"`
+}
+
+type ReloadGiftCardRequest struct {
+	Code   string  `json:"code" binding:"required"`
+	Amount float64 `json:"amount" binding:"required,gt=0"`
+}
+
+// GiftCardResponse is a gift card; the full code is only included when the card is issued
+type GiftCardResponse struct {
+	ID         string    `json:"id"`
+	Code       string    `json:"code,omitempty"`
+	MaskedCode string    `json:"masked_code"`
+	Balance    float64   `json:"balance"`
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+}
+
+func ToGiftCardResponse(card *domain.GiftCard) *GiftCardResponse {
+	return &GiftCardResponse{
+		ID:         card.ID.String(),
+		Code:       card.Code,
+		MaskedCode: card.MaskedCode,
+		Balance:    card.Balance,
+		CreatedAt:  card.CreatedAt,
+		UpdatedAt:  card.UpdatedAt,
+	}
+}
+
+// Drawer DTOs
+
+type OpenDrawerRequest struct {
+	StartingFloat float64 `json:"starting_float" binding:"min=0"`
+}
+
+type ListDrawerSessionsRequest struct {
+	Status string `form:"status,default=CLOSED"`
+}
+
+type DrawerMovementRequest struct {
+	Type   string  `json:"type" binding:"required,oneof=PAID_IN PAID_OUT DROP"`
+	Amount float64 `json:"amount" binding:"required,gt=0"`
+	Reason string  `json:"reason"`
+}
+
+type CloseDrawerRequest struct {
+	Counted float64 `json:"counted" binding:"min=0"`
+}
+
+type SignOffDrawerRequest struct {
+	Notes string `json:"notes"`
+}
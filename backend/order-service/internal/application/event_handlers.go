@@ -7,6 +7,7 @@ import (
 
 	"github.com/restaurant-platform/order-service/internal/domain"
 	"github.com/restaurant-platform/shared/events"
+	"github.com/restaurant-platform/shared/pkg/auth"
 )
 
 // EventHandler handles domain events from other services
@@ -21,8 +22,10 @@ func NewEventHandler(orderService domain.OrderService) *EventHandler {
 	}
 }
 
-// HandleKitchenEvent processes kitchen-related events
+// HandleKitchenEvent processes kitchen-related events, acting as the publishing service
 func (h *EventHandler) HandleKitchenEvent(ctx context.Context, event *events.DomainEvent) error {
+	ctx = auth.WithActor(ctx, eventActor(event, "kitchen-service"))
+
 	switch event.Type {
 	case events.KitchenOrderStatusChangedEvent:
 		return h.handleKitchenOrderStatusChanged(ctx, event)
@@ -64,7 +67,7 @@ func (h *EventHandler) handleKitchenOrderStatusChanged(ctx context.Context, even
 	switch eventData.NewStatus {
 	case "PREPARING":
 		// Update order to preparing when kitchen starts preparation
-		err = h.orderService.UpdateOrderStatus(ctx, orderID, domain.OrderStatusPreparing)
+		err = h.orderService.UpdateOrderStatus(ctx, orderID, domain.OrderStatusPreparing, "kitchen started preparation")
 		if err != nil {
 			log.Printf("Failed to update order %s to preparing: %v", eventData.OrderID, err)
 			return err
@@ -73,7 +76,7 @@ func (h *EventHandler) handleKitchenOrderStatusChanged(ctx context.Context, even
 
 	case "READY":
 		// Update order to ready when kitchen completes preparation
-		err = h.orderService.UpdateOrderStatus(ctx, orderID, domain.OrderStatusReady)
+		err = h.orderService.UpdateOrderStatus(ctx, orderID, domain.OrderStatusReady, "kitchen order ready")
 		if err != nil {
 			log.Printf("Failed to update order %s to ready: %v", eventData.OrderID, err)
 			return err
@@ -82,7 +85,7 @@ func (h *EventHandler) handleKitchenOrderStatusChanged(ctx context.Context, even
 
 	case "COMPLETED":
 		// Kitchen order completed - order is ready for serving/pickup/delivery
-		err = h.orderService.UpdateOrderStatus(ctx, orderID, domain.OrderStatusReady)
+		err = h.orderService.UpdateOrderStatus(ctx, orderID, domain.OrderStatusReady, "kitchen order completed")
 		if err != nil {
 			log.Printf("Failed to update order %s to ready: %v", eventData.OrderID, err)
 			return err
@@ -91,7 +94,7 @@ func (h *EventHandler) handleKitchenOrderStatusChanged(ctx context.Context, even
 
 	case "CANCELLED":
 		// Kitchen cancelled the order
-		err = h.orderService.CancelOrder(ctx, orderID)
+		err = h.orderService.CancelOrder(ctx, orderID, "kitchen cancelled the order")
 		if err != nil {
 			log.Printf("Failed to cancel order %s: %v", eventData.OrderID, err)
 			return err
@@ -125,7 +128,7 @@ func (h *EventHandler) handleKitchenOrderCompleted(ctx context.Context, event *e
 
 	// Mark order as ready when kitchen completes preparation
 	orderID := domain.OrderID(eventData.OrderID)
-	err = h.orderService.UpdateOrderStatus(ctx, orderID, domain.OrderStatusReady)
+	err = h.orderService.UpdateOrderStatus(ctx, orderID, domain.OrderStatusReady, "kitchen order completed")
 	if err != nil {
 		log.Printf("Failed to update order %s to ready: %v", eventData.OrderID, err)
 		return err
@@ -133,4 +136,12 @@ func (h *EventHandler) handleKitchenOrderCompleted(ctx context.Context, event *e
 
 	log.Printf("Order %s is ready for serving/pickup/delivery", eventData.OrderID)
 	return nil
-}
\ No newline at end of file
+}
+
+// eventActor returns the service that published an event, as recorded in its metadata
+func eventActor(event *events.DomainEvent, fallback string) string {
+	if service, ok := event.Metadata["service"].(string); ok && service != "" {
+		return service
+	}
+	return fallback
+}
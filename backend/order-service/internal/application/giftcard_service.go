@@ -0,0 +1,152 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/restaurant-platform/order-service/internal/domain"
+	"github.com/restaurant-platform/shared/pkg/concurrency"
+	"github.com/restaurant-platform/shared/pkg/errors"
+)
+
+// GiftCardService issues stored-value gift cards and moves their balances. Every
+// movement is saved together with the card balance under the card version, so
+// concurrent redemptions of one card can never spend more than it holds.
+type GiftCardService struct {
+	giftCardRepo domain.GiftCardRepository
+}
+
+// NewGiftCardService creates a new gift card service
+func NewGiftCardService(giftCardRepo domain.GiftCardRepository) *GiftCardService {
+	return &GiftCardService{
+		giftCardRepo: giftCardRepo,
+	}
+}
+
+// Issue creates a gift card with an opening balance; the returned card carries its code
+func (s *GiftCardService) Issue(ctx context.Context, amount float64) (*domain.GiftCard, error) {
+	code, err := domain.GenerateGiftCardCode()
+	if err != nil {
+		return nil, err
+	}
+
+	card, issue, err := domain.NewGiftCard(code, amount, actorFromContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	if err := s.giftCardRepo.Create(ctx, card, issue); err != nil {
+		return nil, fmt.Errorf("failed to create gift card: %w", err)
+	}
+
+	log.Printf("Issued gift card %s (%s) with a balance of %.2f", card.ID, card.MaskedCode, card.Balance)
+	return card, nil
+}
+
+// Reload adds money to the card with the given code
+func (s *GiftCardService) Reload(ctx context.Context, code string, amount float64) (*domain.GiftCard, error) {
+	actor := actorFromContext(ctx)
+
+	card, _, err := s.change(ctx, s.byCode(code), func(card *domain.GiftCard) (*domain.GiftCardTransaction, error) {
+		return card.Reload(amount, actor)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	log.Printf("Reloaded gift card %s with %.2f", card.ID, amount)
+	return card, nil
+}
+
+// CheckBalance retrieves the card with the given code
+func (s *GiftCardService) CheckBalance(ctx context.Context, code string) (*domain.GiftCard, error) {
+	return s.byCode(code)(ctx)
+}
+
+// GetTransactions retrieves the balance ledger of a card
+func (s *GiftCardService) GetTransactions(ctx context.Context, id domain.GiftCardID) ([]*domain.GiftCardTransaction, error) {
+	if _, err := s.giftCardRepo.GetByID(ctx, id); err != nil {
+		return nil, err
+	}
+	return s.giftCardRepo.FindTransactions(ctx, id)
+}
+
+// Redeem debits up to the given amount from the card with the given code for an
+// order; the transaction holds the amount actually debited
+func (s *GiftCardService) Redeem(ctx context.Context, code string, amount float64, orderID domain.OrderID) (*domain.GiftCardTransaction, error) {
+	actor := actorFromContext(ctx)
+
+	card, redemption, err := s.change(ctx, s.byCode(code), func(card *domain.GiftCard) (*domain.GiftCardTransaction, error) {
+		return card.Redeem(amount, orderID, actor)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	log.Printf("Redeemed %.2f from gift card %s for order: %s", -redemption.Amount, card.ID, orderID)
+	return redemption, nil
+}
+
+// Refund credits back part or all of a redemption
+func (s *GiftCardService) Refund(ctx context.Context, redemptionID domain.GiftCardTransactionID, amount float64) (*domain.GiftCardTransaction, error) {
+	redemption, err := s.giftCardRepo.GetTransaction(ctx, redemptionID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get gift card redemption: %w", err)
+	}
+
+	actor := actorFromContext(ctx)
+	load := func(ctx context.Context) (*domain.GiftCard, error) {
+		return s.giftCardRepo.GetByID(ctx, redemption.GiftCardID)
+	}
+
+	card, refund, err := s.change(ctx, load, func(card *domain.GiftCard) (*domain.GiftCardTransaction, error) {
+		transactions, err := s.giftCardRepo.FindTransactions(ctx, card.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get gift card transactions: %w", err)
+		}
+		return card.Refund(redemption, transactions, amount, actor)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	log.Printf("Refunded %.2f to gift card %s for order: %s", refund.Amount, card.ID, redemption.OrderID)
+	return refund, nil
+}
+
+// Helper methods
+
+func (s *GiftCardService) byCode(code string) func(ctx context.Context) (*domain.GiftCard, error) {
+	return func(ctx context.Context) (*domain.GiftCard, error) {
+		if domain.NormalizeGiftCardCode(code) == "" {
+			return nil, errors.WrapValidation("GiftCard", "code", "gift card code is required", nil)
+		}
+		return s.giftCardRepo.GetByCodeHash(ctx, domain.HashGiftCardCode(code))
+	}
+}
+
+// change loads a card, books a transaction on it and saves both, starting over
+// from a fresh copy of the card when another transaction was saved first
+func (s *GiftCardService) change(ctx context.Context, load func(ctx context.Context) (*domain.GiftCard, error),
+	book func(card *domain.GiftCard) (*domain.GiftCardTransaction, error)) (*domain.GiftCard, *domain.GiftCardTransaction, error) {
+	var card *domain.GiftCard
+	var transaction *domain.GiftCardTransaction
+
+	err := concurrency.RetryOnConflict(ctx, func() error {
+		var err error
+		if card, err = load(ctx); err != nil {
+			return err
+		}
+		if transaction, err = book(card); err != nil {
+			return err
+		}
+		if err := s.giftCardRepo.Save(ctx, card, transaction); err != nil {
+			return fmt.Errorf("failed to save gift card: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return card, transaction, nil
+}
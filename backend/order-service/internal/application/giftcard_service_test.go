@@ -0,0 +1,276 @@
+package application
+
+import (
+	"context"
+	"math"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/restaurant-platform/order-service/internal/domain"
+	"github.com/restaurant-platform/shared/pkg/auth"
+	sharedErrors "github.com/restaurant-platform/shared/pkg/errors"
+)
+
+// memoryGiftCardRepository is an in-memory GiftCardRepository that enforces the card
+// version on Save the way the database does, so concurrent redemptions can be raced
+type memoryGiftCardRepository struct {
+	mu           sync.Mutex
+	cards        map[domain.GiftCardID]domain.GiftCard
+	transactions []*domain.GiftCardTransaction
+}
+
+func newMemoryGiftCardRepository() *memoryGiftCardRepository {
+	return &memoryGiftCardRepository{cards: make(map[domain.GiftCardID]domain.GiftCard)}
+}
+
+func (r *memoryGiftCardRepository) Create(ctx context.Context, card *domain.GiftCard, issue *domain.GiftCardTransaction) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stored := *card
+	stored.Code = ""
+	r.cards[card.ID] = stored
+	r.transactions = append(r.transactions, issue)
+	return nil
+}
+
+func (r *memoryGiftCardRepository) GetByID(ctx context.Context, id domain.GiftCardID) (*domain.GiftCard, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	card, ok := r.cards[id]
+	if !ok {
+		return nil, sharedErrors.WrapNotFound("GetByID", "gift card", id.String(), sharedErrors.ErrNotFound)
+	}
+	return &card, nil
+}
+
+func (r *memoryGiftCardRepository) GetByCodeHash(ctx context.Context, codeHash string) (*domain.GiftCard, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, card := range r.cards {
+		if card.CodeHash == codeHash {
+			return &card, nil
+		}
+	}
+	return nil, sharedErrors.WrapNotFound("GetByCodeHash", "gift card", "code", sharedErrors.ErrNotFound)
+}
+
+func (r *memoryGiftCardRepository) Save(ctx context.Context, card *domain.GiftCard, transaction *domain.GiftCardTransaction) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.cards[card.ID].Version != card.Version {
+		return sharedErrors.WrapVersionConflict("Save", "gift card", card.ID.String(), card.Version)
+	}
+
+	card.Version++
+	r.cards[card.ID] = *card
+	r.transactions = append(r.transactions, transaction)
+	return nil
+}
+
+func (r *memoryGiftCardRepository) GetTransaction(ctx context.Context, id domain.GiftCardTransactionID) (*domain.GiftCardTransaction, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, transaction := range r.transactions {
+		if transaction.ID == id {
+			return transaction, nil
+		}
+	}
+	return nil, sharedErrors.WrapNotFound("GetTransaction", "gift card transaction", id.String(), sharedErrors.ErrNotFound)
+}
+
+func (r *memoryGiftCardRepository) FindTransactions(ctx context.Context, cardID domain.GiftCardID) ([]*domain.GiftCardTransaction, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var transactions []*domain.GiftCardTransaction
+	for _, transaction := range r.transactions {
+		if transaction.GiftCardID == cardID {
+			transactions = append(transactions, transaction)
+		}
+	}
+	return transactions, nil
+}
+
+// racingGiftCardRepository holds the first lookups of a card until the given number of
+// callers have all loaded it, so that each of them starts from the same balance
+type racingGiftCardRepository struct {
+	*memoryGiftCardRepository
+	mu      sync.Mutex
+	waiting int
+	start   chan struct{}
+}
+
+func newRacingGiftCardRepository(racers int) *racingGiftCardRepository {
+	return &racingGiftCardRepository{memoryGiftCardRepository: newMemoryGiftCardRepository(), waiting: racers, start: make(chan struct{})}
+}
+
+func (r *racingGiftCardRepository) GetByCodeHash(ctx context.Context, codeHash string) (*domain.GiftCard, error) {
+	card, err := r.memoryGiftCardRepository.GetByCodeHash(ctx, codeHash)
+
+	r.mu.Lock()
+	if r.waiting > 0 {
+		r.waiting--
+		if r.waiting == 0 {
+			close(r.start)
+		}
+	}
+	r.mu.Unlock()
+
+	<-r.start
+	return card, err
+}
+
+// GiftCardServiceTestSuite contains gift card issuance, ledger and redemption tests
+type GiftCardServiceTestSuite struct {
+	suite.Suite
+	service *GiftCardService
+	repo    *memoryGiftCardRepository
+	ctx     context.Context
+}
+
+func (suite *GiftCardServiceTestSuite) SetupTest() {
+	suite.repo = newMemoryGiftCardRepository()
+	suite.service = NewGiftCardService(suite.repo)
+	suite.ctx = auth.WithActor(context.Background(), "cashier-1")
+}
+
+func TestGiftCardServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(GiftCardServiceTestSuite))
+}
+
+func (suite *GiftCardServiceTestSuite) TestIssue_ReturnsCodeOnceAndStoresItsHash() {
+	// When
+	card, err := suite.service.Issue(suite.ctx, 50.00)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	assert.Len(card.Code, 19)
+	assert.Equal(50.00, card.Balance)
+	assert.Equal("cashier-1", card.IssuedBy)
+
+	stored, _ := suite.repo.GetByID(suite.ctx, card.ID)
+	assert.Empty(stored.Code)
+	assert.Equal(domain.HashGiftCardCode(card.Code), stored.CodeHash)
+
+	transactions, _ := suite.service.GetTransactions(suite.ctx, card.ID)
+	assert.Len(transactions, 1)
+	assert.Equal(domain.GiftCardTransactionIssue, transactions[0].Type)
+	assert.Equal(50.00, transactions[0].BalanceAfter)
+}
+
+func (suite *GiftCardServiceTestSuite) TestReload_AcceptsCodeAsTyped() {
+	// Given
+	card, _ := suite.service.Issue(suite.ctx, 20.00)
+
+	// When
+	reloaded, err := suite.service.Reload(suite.ctx, " "+card.Code[:4]+" "+card.Code[5:9]+card.Code[9:], 30.00)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	assert.Equal(50.00, reloaded.Balance)
+	assert.Equal(2, reloaded.Version)
+}
+
+func (suite *GiftCardServiceTestSuite) TestCheckBalance_UnknownCode_ShouldReturnNotFound() {
+	// When
+	_, err := suite.service.CheckBalance(suite.ctx, "AAAA-BBBB-CCCC-DDDD")
+
+	// Then
+	assert.New(suite.T()).True(sharedErrors.IsNotFound(err))
+}
+
+func (suite *GiftCardServiceTestSuite) TestRedeem_PaysWhatTheCardHolds() {
+	// Given
+	card, _ := suite.service.Issue(suite.ctx, 15.00)
+
+	// When
+	redemption, err := suite.service.Redeem(suite.ctx, card.Code, 22.00, "ord_1")
+	_, emptyErr := suite.service.Redeem(suite.ctx, card.Code, 5.00, "ord_2")
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	assert.Equal(-15.00, redemption.Amount)
+	assert.Zero(redemption.BalanceAfter)
+	assert.True(sharedErrors.IsConflictError(emptyErr))
+}
+
+func (suite *GiftCardServiceTestSuite) TestRefund_CreditsBackUpToTheRedemption() {
+	// Given
+	card, _ := suite.service.Issue(suite.ctx, 40.00)
+	redemption, _ := suite.service.Redeem(suite.ctx, card.Code, 25.00, "ord_1")
+
+	// When
+	partial, err := suite.service.Refund(suite.ctx, redemption.ID, 10.00)
+	_, overErr := suite.service.Refund(suite.ctx, redemption.ID, 20.00)
+	rest, restErr := suite.service.Refund(suite.ctx, redemption.ID, 15.00)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	assert.Equal(25.00, partial.BalanceAfter)
+	assert.Equal(redemption.ID, partial.RedemptionID)
+	assert.True(sharedErrors.IsValidationError(overErr))
+	assert.NoError(restErr)
+	assert.Equal(40.00, rest.BalanceAfter)
+}
+
+func (suite *GiftCardServiceTestSuite) TestRedeem_ConcurrentRedemptionsNeverOverspend() {
+	// Given: a 100.00 card raced by 20 tills each trying to take 15.00
+	repo := newRacingGiftCardRepository(20)
+	service := NewGiftCardService(repo)
+	card, _ := service.Issue(suite.ctx, 100.00)
+
+	var wg sync.WaitGroup
+	results := make(chan *domain.GiftCardTransaction, 20)
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			redemption, err := service.Redeem(suite.ctx, card.Code, 15.00, "ord_1")
+			if err == nil {
+				results <- redemption
+				return
+			}
+			// Losing the race is reported, never silently booked
+			suite.True(sharedErrors.IsConflictError(err) || sharedErrors.IsVersionConflict(err), err.Error())
+		}()
+	}
+
+	// When
+	wg.Wait()
+	close(results)
+
+	// Then
+	assert := assert.New(suite.T())
+	var redeemed float64
+	for redemption := range results {
+		assert.True(redemption.Amount < 0)
+		redeemed -= redemption.Amount
+	}
+
+	stored, _ := service.CheckBalance(suite.ctx, card.Code)
+	assert.Greater(redeemed, 0.0)
+	assert.GreaterOrEqual(stored.Balance, 0.0)
+	assert.LessOrEqual(redeemed, 100.00)
+	assert.InDelta(100.00, redeemed+stored.Balance, 0.001)
+
+	// The ledger agrees with the stored balance
+	transactions, _ := service.GetTransactions(suite.ctx, card.ID)
+	var ledger float64
+	for _, transaction := range transactions {
+		ledger += transaction.Amount
+	}
+	assert.Equal(stored.Balance, math.Round(ledger*100)/100)
+	assert.Equal(len(transactions), stored.Version)
+}
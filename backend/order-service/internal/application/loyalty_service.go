@@ -0,0 +1,236 @@
+package application
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/restaurant-platform/order-service/internal/domain"
+	"github.com/restaurant-platform/shared/events"
+	"github.com/restaurant-platform/shared/pkg/errors"
+)
+
+// LoyaltyService runs the loyalty points program: customers earn points on completed
+// orders and spend them on order discounts, with every movement booked in a ledger
+type LoyaltyService struct {
+	orderRepo  domain.OrderRepository
+	ledgerRepo domain.LoyaltyLedgerRepository
+	program    domain.LoyaltyProgram
+}
+
+// NewLoyaltyService creates a new loyalty service
+func NewLoyaltyService(orderRepo domain.OrderRepository, ledgerRepo domain.LoyaltyLedgerRepository, program domain.LoyaltyProgram) *LoyaltyService {
+	return &LoyaltyService{
+		orderRepo:  orderRepo,
+		ledgerRepo: ledgerRepo,
+		program:    program,
+	}
+}
+
+// GetAccount retrieves the points balance and tier of a customer
+func (s *LoyaltyService) GetAccount(ctx context.Context, customerID string) (*domain.LoyaltyAccount, error) {
+	if customerID == "" {
+		return nil, errors.WrapValidation("GetAccount", "customer_id", "customer ID is required", nil)
+	}
+	return s.account(ctx, customerID)
+}
+
+// GetHistory retrieves the points ledger of a customer, newest first
+func (s *LoyaltyService) GetHistory(ctx context.Context, customerID string) ([]*domain.LoyaltyEntry, error) {
+	account, err := s.GetAccount(ctx, customerID)
+	if err != nil {
+		return nil, err
+	}
+	return account.History(), nil
+}
+
+// RedeemPoints spends points of the order's customer on a loyalty discount on the unpaid
+// order. The points are booked before the discount is applied, so they cannot be spent
+// twice; if the discount cannot be applied they are booked back.
+func (s *LoyaltyService) RedeemPoints(ctx context.Context, orderID domain.OrderID, points int) (*domain.Order, error) {
+	order, err := s.orderRepo.GetByID(ctx, orderID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get order: %w", err)
+	}
+	if !domain.IsLoyaltyCustomer(order.CustomerID) {
+		return nil, errors.WrapConflict("RedeemPoints", "customer_id", "orders placed through a marketplace cannot redeem loyalty points", nil)
+	}
+
+	account, err := s.account(ctx, order.CustomerID)
+	if err != nil {
+		return nil, err
+	}
+
+	discount := domain.NewLoyaltyDiscount(points, s.program.RedemptionValue(points), actorFromContext(ctx))
+	now := time.Now()
+	redeemed, err := account.Redeem(order.ID, discount, now)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.ledgerRepo.Append(ctx, redeemed); err != nil {
+		return nil, fmt.Errorf("failed to book redemption: %w", err)
+	}
+	account.Apply(redeemed)
+
+	updated, err := applyOrderChange(ctx, s.orderRepo, order.ID, order, func(order *domain.Order) error {
+		return order.ApplyDiscount(discount)
+	})
+	if err != nil {
+		if restored := account.Restore(order.ID, discount, time.Now()); restored != nil {
+			if restoreErr := s.ledgerRepo.Append(ctx, restored); restoreErr != nil {
+				log.Printf("Failed to restore %d points of customer %s after a failed redemption: %v", points, order.CustomerID, restoreErr)
+			}
+		}
+		return nil, err
+	}
+
+	log.Printf("Redeemed %d points of customer %s for a %.2f discount on order %s", points, order.CustomerID, discount.Amount, order.ID)
+	return updated, nil
+}
+
+// HandleOrderEvent books points for order events: points are earned when an order
+// completes, reversed when its items are refunded and restored when an order a
+// redemption was made on is cancelled or merged away. Every booking has a unique
+// ledger reference, so redelivered events are ignored.
+func (s *LoyaltyService) HandleOrderEvent(ctx context.Context, event *events.DomainEvent) error {
+	var eventData struct {
+		OrderID      string `json:"order_id"`
+		FromOrderID  string `json:"from_order_id"`
+		AdjustmentID string `json:"adjustment_id"`
+		Lines        []struct {
+			Amount float64 `json:"amount"`
+		} `json:"lines"`
+	}
+
+	dataBytes, err := json.Marshal(event.Data)
+	if err != nil {
+		return err
+	}
+
+	if err := json.Unmarshal(dataBytes, &eventData); err != nil {
+		return err
+	}
+
+	switch event.Type {
+	case events.OrderCompletedEvent, events.DeliveryDeliveredEvent:
+		return s.earn(ctx, domain.OrderID(eventData.OrderID))
+	case events.OrderRefundedEvent:
+		var refunded float64
+		for _, line := range eventData.Lines {
+			refunded += line.Amount
+		}
+		return s.reverse(ctx, domain.OrderID(eventData.OrderID), eventData.AdjustmentID, refunded)
+	case events.OrderCancelledEvent:
+		return s.restore(ctx, domain.OrderID(eventData.OrderID))
+	case events.OrderMergedEvent:
+		return s.restore(ctx, domain.OrderID(eventData.FromOrderID))
+	}
+	return nil
+}
+
+// ExpirePoints books the expiry of the points that reached their expiry date unspent.
+// It returns the number of customers whose points expired.
+func (s *LoyaltyService) ExpirePoints(ctx context.Context, now time.Time) (int, error) {
+	customerIDs, err := s.ledgerRepo.FindCustomersWithExpiringPoints(ctx, now)
+	if err != nil {
+		return 0, fmt.Errorf("failed to find expiring points: %w", err)
+	}
+
+	expired := 0
+	for _, customerID := range customerIDs {
+		account, err := s.account(ctx, customerID)
+		if err != nil {
+			log.Printf("Failed to expire points of customer %s: %v", customerID, err)
+			continue
+		}
+
+		entry := account.Expire(now)
+		if entry == nil {
+			continue
+		}
+		if err := s.book(ctx, entry); err != nil {
+			log.Printf("Failed to expire points of customer %s: %v", customerID, err)
+			continue
+		}
+		expired++
+	}
+
+	return expired, nil
+}
+
+// Helper methods
+
+func (s *LoyaltyService) earn(ctx context.Context, orderID domain.OrderID) error {
+	order, account, err := s.orderAccount(ctx, orderID)
+	if err != nil || account == nil {
+		return err
+	}
+	if order.Status != domain.OrderStatusCompleted {
+		return nil
+	}
+	return s.book(ctx, account.Earn(order, time.Now()))
+}
+
+func (s *LoyaltyService) reverse(ctx context.Context, orderID domain.OrderID, adjustmentID string, refunded float64) error {
+	_, account, err := s.orderAccount(ctx, orderID)
+	if err != nil || account == nil {
+		return err
+	}
+	return s.book(ctx, account.ReverseEarned(orderID, adjustmentID, refunded, time.Now()))
+}
+
+func (s *LoyaltyService) restore(ctx context.Context, orderID domain.OrderID) error {
+	order, account, err := s.orderAccount(ctx, orderID)
+	if err != nil || account == nil {
+		return err
+	}
+	discount := order.LoyaltyDiscount()
+	if discount == nil || order.Status != domain.OrderStatusCancelled {
+		return nil
+	}
+	return s.book(ctx, account.Restore(order.ID, discount, time.Now()))
+}
+
+// orderAccount loads an order and the account of its customer; the account is
+// nil for orders whose customer does not collect points
+func (s *LoyaltyService) orderAccount(ctx context.Context, orderID domain.OrderID) (*domain.Order, *domain.LoyaltyAccount, error) {
+	order, err := s.orderRepo.GetByID(ctx, orderID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get order: %w", err)
+	}
+	if !domain.IsLoyaltyCustomer(order.CustomerID) {
+		return order, nil, nil
+	}
+
+	account, err := s.account(ctx, order.CustomerID)
+	if err != nil {
+		return nil, nil, err
+	}
+	return order, account, nil
+}
+
+func (s *LoyaltyService) account(ctx context.Context, customerID string) (*domain.LoyaltyAccount, error) {
+	entries, err := s.ledgerRepo.FindByCustomer(ctx, customerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get loyalty ledger: %w", err)
+	}
+	return domain.NewLoyaltyAccount(customerID, entries, s.program), nil
+}
+
+// book appends an entry to the ledger; an entry already booked is not an error
+func (s *LoyaltyService) book(ctx context.Context, entry *domain.LoyaltyEntry) error {
+	if entry == nil {
+		return nil
+	}
+	if err := s.ledgerRepo.Append(ctx, entry); err != nil {
+		if errors.IsConflictError(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to book loyalty points: %w", err)
+	}
+
+	log.Printf("Booked %s of %d points for customer %s (%s)", entry.Type, entry.Points, entry.CustomerID, entry.Reference)
+	return nil
+}
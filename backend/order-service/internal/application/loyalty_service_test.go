@@ -0,0 +1,249 @@
+package application
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/restaurant-platform/order-service/internal/domain"
+	"github.com/restaurant-platform/shared/events"
+	sharedErrors "github.com/restaurant-platform/shared/pkg/errors"
+)
+
+// MockLoyaltyLedgerRepository is a mock implementation of LoyaltyLedgerRepository
+type MockLoyaltyLedgerRepository struct {
+	mock.Mock
+}
+
+func (m *MockLoyaltyLedgerRepository) Append(ctx context.Context, entry *domain.LoyaltyEntry) error {
+	args := m.Called(ctx, entry)
+	return args.Error(0)
+}
+
+func (m *MockLoyaltyLedgerRepository) FindByCustomer(ctx context.Context, customerID string) ([]*domain.LoyaltyEntry, error) {
+	args := m.Called(ctx, customerID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.LoyaltyEntry), args.Error(1)
+}
+
+func (m *MockLoyaltyLedgerRepository) FindCustomersWithExpiringPoints(ctx context.Context, asOf time.Time) ([]string, error) {
+	args := m.Called(ctx, asOf)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]string), args.Error(1)
+}
+
+// LoyaltyServiceTestSuite contains loyalty points booking and redemption tests
+type LoyaltyServiceTestSuite struct {
+	suite.Suite
+	service        *LoyaltyService
+	mockOrderRepo  *MockOrderRepository
+	mockLedgerRepo *MockLoyaltyLedgerRepository
+	order          *domain.Order
+	ctx            context.Context
+}
+
+func (suite *LoyaltyServiceTestSuite) SetupTest() {
+	suite.mockOrderRepo = new(MockOrderRepository)
+	suite.mockLedgerRepo = new(MockLoyaltyLedgerRepository)
+	program, _ := domain.NewLoyaltyProgram(1, nil, 0.01, 100, 365*24*time.Hour, nil)
+	suite.service = NewLoyaltyService(suite.mockOrderRepo, suite.mockLedgerRepo, program)
+	suite.ctx = context.Background()
+
+	suite.order, _ = domain.NewOrder("customer-123", domain.OrderTypeTakeout)
+	suite.order.AddItem("burger", "Burger", 2, 10.00, nil, "")
+	suite.mockOrderRepo.On("GetByID", mock.Anything, suite.order.ID).Return(suite.order, nil)
+}
+
+func TestLoyaltyServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(LoyaltyServiceTestSuite))
+}
+
+func (suite *LoyaltyServiceTestSuite) complete() {
+	suite.order.UpdateStatus(domain.OrderStatusPaid, "cashier-1", "")
+	suite.order.UpdateStatus(domain.OrderStatusPreparing, "kitchen-service", "")
+	suite.order.UpdateStatus(domain.OrderStatusReady, "kitchen-service", "")
+	suite.order.UpdateStatus(domain.OrderStatusCompleted, "cashier-1", "")
+}
+
+func (suite *LoyaltyServiceTestSuite) event(eventType events.EventType, data interface{}) *events.DomainEvent {
+	var eventData map[string]interface{}
+	switch d := data.(type) {
+	case events.OrderStatusChangedData:
+		eventData, _ = events.ToEventData(d)
+	case events.OrderAdjustedData:
+		eventData, _ = events.ToEventData(d)
+	}
+	return events.NewDomainEvent(eventType, suite.order.ID.String(), eventData)
+}
+
+func entryOfType(entryType domain.LoyaltyEntryType, points int) interface{} {
+	return mock.MatchedBy(func(entry *domain.LoyaltyEntry) bool {
+		return entry.Type == entryType && entry.Points == points
+	})
+}
+
+// Test HandleOrderEvent
+func (suite *LoyaltyServiceTestSuite) TestHandleOrderEvent_Completed_EarnsPoints() {
+	// Given
+	suite.complete()
+	suite.mockLedgerRepo.On("FindByCustomer", mock.Anything, "customer-123").Return([]*domain.LoyaltyEntry{}, nil)
+	suite.mockLedgerRepo.On("Append", mock.Anything, entryOfType(domain.LoyaltyEntryEarn, 20)).Return(nil)
+
+	// When
+	err := suite.service.HandleOrderEvent(suite.ctx, suite.event(events.OrderCompletedEvent,
+		events.OrderStatusChangedData{OrderID: suite.order.ID.String(), NewStatus: "COMPLETED"}))
+
+	// Then
+	assert.New(suite.T()).NoError(err)
+	suite.mockLedgerRepo.AssertExpectations(suite.T())
+}
+
+func (suite *LoyaltyServiceTestSuite) TestHandleOrderEvent_Redelivered_IsIgnored() {
+	// Given
+	suite.complete()
+	suite.mockLedgerRepo.On("FindByCustomer", mock.Anything, "customer-123").Return([]*domain.LoyaltyEntry{}, nil)
+	suite.mockLedgerRepo.On("Append", mock.Anything, mock.Anything).
+		Return(sharedErrors.WrapConflict("LoyaltyLedgerRepository.Append", "reference", "already booked", nil))
+
+	// When
+	err := suite.service.HandleOrderEvent(suite.ctx, suite.event(events.OrderCompletedEvent,
+		events.OrderStatusChangedData{OrderID: suite.order.ID.String(), NewStatus: "COMPLETED"}))
+
+	// Then
+	assert.New(suite.T()).NoError(err)
+}
+
+func (suite *LoyaltyServiceTestSuite) TestHandleOrderEvent_MarketplaceOrder_EarnsNothing() {
+	// Given
+	suite.complete()
+	suite.order.CustomerID = domain.MarketplaceActor("generic")
+
+	// When
+	err := suite.service.HandleOrderEvent(suite.ctx, suite.event(events.OrderCompletedEvent,
+		events.OrderStatusChangedData{OrderID: suite.order.ID.String(), NewStatus: "COMPLETED"}))
+
+	// Then
+	assert.New(suite.T()).NoError(err)
+	suite.mockLedgerRepo.AssertNotCalled(suite.T(), "Append", mock.Anything, mock.Anything)
+}
+
+func (suite *LoyaltyServiceTestSuite) TestHandleOrderEvent_Refunded_ReversesEarnedPoints() {
+	// Given
+	suite.complete()
+	earned := domain.NewLoyaltyAccount("customer-123", nil, suite.service.program).Earn(suite.order, time.Now())
+	suite.mockLedgerRepo.On("FindByCustomer", mock.Anything, "customer-123").Return([]*domain.LoyaltyEntry{earned}, nil)
+	suite.mockLedgerRepo.On("Append", mock.Anything, mock.MatchedBy(func(entry *domain.LoyaltyEntry) bool {
+		return entry.Type == domain.LoyaltyEntryReversal && entry.Points == -10 && entry.Reference == "reverse:adj_1"
+	})).Return(nil)
+
+	// When
+	err := suite.service.HandleOrderEvent(suite.ctx, suite.event(events.OrderRefundedEvent, events.OrderAdjustedData{
+		OrderID:      suite.order.ID.String(),
+		AdjustmentID: "adj_1",
+		Type:         "REFUND",
+		Amount:       11.00,
+		Lines:        []events.OrderAdjustmentLineData{{ItemID: "item_1", Quantity: 1, Amount: 10.00}},
+	}))
+
+	// Then
+	assert.New(suite.T()).NoError(err)
+	suite.mockLedgerRepo.AssertExpectations(suite.T())
+}
+
+func (suite *LoyaltyServiceTestSuite) TestHandleOrderEvent_Cancelled_RestoresRedeemedPoints() {
+	// Given
+	discount := domain.NewLoyaltyDiscount(500, 5.00, "cashier-1")
+	suite.order.ApplyDiscount(discount)
+	suite.order.Cancel("cashier-1", "customer left")
+	redeemed := &domain.LoyaltyEntry{CustomerID: "customer-123", Type: domain.LoyaltyEntryRedeem, Points: -500, Reference: "redeem:" + discount.ID.String()}
+	suite.mockLedgerRepo.On("FindByCustomer", mock.Anything, "customer-123").Return([]*domain.LoyaltyEntry{redeemed}, nil)
+	suite.mockLedgerRepo.On("Append", mock.Anything, entryOfType(domain.LoyaltyEntryRestore, 500)).Return(nil)
+
+	// When
+	err := suite.service.HandleOrderEvent(suite.ctx, suite.event(events.OrderCancelledEvent,
+		events.OrderStatusChangedData{OrderID: suite.order.ID.String(), NewStatus: "CANCELLED"}))
+
+	// Then
+	assert.New(suite.T()).NoError(err)
+	suite.mockLedgerRepo.AssertExpectations(suite.T())
+}
+
+// Test RedeemPoints
+func (suite *LoyaltyServiceTestSuite) TestRedeemPoints_BooksPointsAndDiscountsOrder() {
+	// Given
+	suite.mockLedgerRepo.On("FindByCustomer", mock.Anything, "customer-123").Return([]*domain.LoyaltyEntry{
+		{CustomerID: "customer-123", Type: domain.LoyaltyEntryEarn, Points: 800, Reference: "earn:ord_0"},
+	}, nil)
+	suite.mockLedgerRepo.On("Append", mock.Anything, entryOfType(domain.LoyaltyEntryRedeem, -500)).Return(nil)
+	suite.mockOrderRepo.On("Update", mock.Anything, suite.order).Return(nil)
+
+	// When
+	order, err := suite.service.RedeemPoints(suite.ctx, suite.order.ID, 500)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	assert.Equal(5.00, order.LoyaltyDiscount().Amount)
+	assert.Equal(500, order.LoyaltyDiscount().Points)
+	assert.InDelta(16.50, order.TotalAmount, 0.001)
+	suite.mockLedgerRepo.AssertExpectations(suite.T())
+}
+
+func (suite *LoyaltyServiceTestSuite) TestRedeemPoints_DiscountRejected_RestoresPoints() {
+	// Given
+	suite.mockLedgerRepo.On("FindByCustomer", mock.Anything, "customer-123").Return([]*domain.LoyaltyEntry{
+		{CustomerID: "customer-123", Type: domain.LoyaltyEntryEarn, Points: 5000, Reference: "earn:ord_0"},
+	}, nil)
+	suite.mockLedgerRepo.On("Append", mock.Anything, entryOfType(domain.LoyaltyEntryRedeem, -3000)).Return(nil)
+	suite.mockLedgerRepo.On("Append", mock.Anything, entryOfType(domain.LoyaltyEntryRestore, 3000)).Return(nil)
+
+	// When
+	_, err := suite.service.RedeemPoints(suite.ctx, suite.order.ID, 3000)
+
+	// Then
+	assert.New(suite.T()).True(sharedErrors.IsValidationError(err))
+	suite.mockLedgerRepo.AssertExpectations(suite.T())
+	suite.mockOrderRepo.AssertNotCalled(suite.T(), "Update", mock.Anything, mock.Anything)
+}
+
+func (suite *LoyaltyServiceTestSuite) TestRedeemPoints_InsufficientBalance_ShouldConflict() {
+	// Given
+	suite.mockLedgerRepo.On("FindByCustomer", mock.Anything, "customer-123").Return([]*domain.LoyaltyEntry{}, nil)
+
+	// When
+	_, err := suite.service.RedeemPoints(suite.ctx, suite.order.ID, 500)
+
+	// Then
+	assert.New(suite.T()).True(sharedErrors.IsConflictError(err))
+	suite.mockLedgerRepo.AssertNotCalled(suite.T(), "Append", mock.Anything, mock.Anything)
+}
+
+// Test ExpirePoints
+func (suite *LoyaltyServiceTestSuite) TestExpirePoints_BooksExpiredPoints() {
+	// Given
+	now := time.Now()
+	expired := now.Add(-time.Hour)
+	suite.mockLedgerRepo.On("FindCustomersWithExpiringPoints", mock.Anything, now).Return([]string{"customer-123"}, nil)
+	suite.mockLedgerRepo.On("FindByCustomer", mock.Anything, "customer-123").Return([]*domain.LoyaltyEntry{
+		{ID: "lpt_1", CustomerID: "customer-123", Type: domain.LoyaltyEntryEarn, Points: 300, Reference: "earn:ord_0", ExpiresAt: &expired},
+	}, nil)
+	suite.mockLedgerRepo.On("Append", mock.Anything, mock.MatchedBy(func(entry *domain.LoyaltyEntry) bool {
+		return entry.Type == domain.LoyaltyEntryExpire && entry.Points == -300 && entry.Reference == "expire:lpt_1"
+	})).Return(nil)
+
+	// When
+	count, err := suite.service.ExpirePoints(suite.ctx, now)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	assert.Equal(1, count)
+}
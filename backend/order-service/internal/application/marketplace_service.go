@@ -0,0 +1,383 @@
+package application
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/restaurant-platform/order-service/internal/domain"
+	"github.com/restaurant-platform/shared/events"
+	"github.com/restaurant-platform/shared/pkg/errors"
+)
+
+// MarketplaceService ingests orders placed on delivery marketplaces, accepting them as paid
+// orders when their items are on our menu and the kitchen has capacity, and reports the
+// progress of accepted orders back to the marketplace
+type MarketplaceService struct {
+	orderRepo      domain.OrderRepository
+	menuItemRepo   domain.MenuItemRepository
+	mappingRepo    domain.MarketplaceItemMappingRepository
+	ingestedRepo   domain.IngestedOrderRepository
+	adapters       domain.MarketplaceAdapters
+	loadPolicy     domain.KitchenLoadPolicy
+	eventPublisher events.EventPublisher
+}
+
+// NewMarketplaceService creates a new marketplace service
+func NewMarketplaceService(orderRepo domain.OrderRepository, menuItemRepo domain.MenuItemRepository, mappingRepo domain.MarketplaceItemMappingRepository,
+	ingestedRepo domain.IngestedOrderRepository, adapters domain.MarketplaceAdapters, loadPolicy domain.KitchenLoadPolicy, eventPublisher events.EventPublisher) *MarketplaceService {
+	return &MarketplaceService{
+		orderRepo:      orderRepo,
+		menuItemRepo:   menuItemRepo,
+		mappingRepo:    mappingRepo,
+		ingestedRepo:   ingestedRepo,
+		adapters:       adapters,
+		loadPolicy:     loadPolicy,
+		eventPublisher: eventPublisher,
+	}
+}
+
+// SignatureHeader returns the request header a marketplace signs its webhooks in
+func (s *MarketplaceService) SignatureHeader(marketplace string) (string, error) {
+	adapter, err := s.adapters.Get(marketplace)
+	if err != nil {
+		return "", err
+	}
+	return adapter.SignatureHeader(), nil
+}
+
+// IngestOrder verifies and parses an order webhook, then accepts the order or rejects it if
+// any of its items is unknown or unavailable or the kitchen is at capacity. The decision is
+// pushed back to the marketplace. Marketplaces retry webhooks, so an order that was already
+// received returns the original decision.
+func (s *MarketplaceService) IngestOrder(ctx context.Context, marketplace string, payload []byte, signature string) (*domain.IngestedOrder, error) {
+	adapter, err := s.adapters.Get(marketplace)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := adapter.VerifySignature(payload, signature); err != nil {
+		return nil, err
+	}
+
+	placed, err := adapter.ParseOrder(payload)
+	if err != nil {
+		return nil, err
+	}
+	placed.Marketplace = adapter.Name()
+	if err := placed.Validate(); err != nil {
+		return nil, err
+	}
+
+	existing, err := s.ingestedRepo.FindByExternalID(ctx, placed.Marketplace, placed.ExternalID)
+	if err == nil {
+		log.Printf("Marketplace %s redelivered order %s, already %s", placed.Marketplace, placed.ExternalID, existing.Status)
+		return existing, nil
+	}
+	if !errors.IsNotFound(err) {
+		return nil, fmt.Errorf("failed to get marketplace order: %w", err)
+	}
+
+	now := time.Now()
+
+	menuItems, reason, err := s.resolveItems(ctx, placed)
+	if err != nil {
+		return nil, err
+	}
+	if reason == "" {
+		if reason, err = s.checkKitchenLoad(ctx); err != nil {
+			return nil, err
+		}
+	}
+	if reason != "" {
+		return s.reject(ctx, adapter, placed, reason, now)
+	}
+
+	return s.accept(ctx, adapter, placed, menuItems, now)
+}
+
+// SetItemMapping maps a marketplace item ID to a menu item from the menu read model
+func (s *MarketplaceService) SetItemMapping(ctx context.Context, marketplace, externalItemID, menuItemID string) (*domain.MarketplaceItemMapping, error) {
+	if _, err := s.adapters.Get(marketplace); err != nil {
+		return nil, err
+	}
+	if externalItemID == "" {
+		return nil, errors.WrapValidation("SetItemMapping", "external_item_id", "marketplace item ID is required", nil)
+	}
+	if menuItemID == "" {
+		return nil, errors.WrapValidation("SetItemMapping", "menu_item_id", "menu item ID is required", nil)
+	}
+
+	if _, err := s.menuItemRepo.GetByID(ctx, menuItemID); err != nil {
+		if errors.IsNotFound(err) {
+			return nil, errors.WrapValidation("SetItemMapping", "menu_item_id", "unknown menu item "+menuItemID, nil)
+		}
+		return nil, fmt.Errorf("failed to get menu item: %w", err)
+	}
+
+	mapping := &domain.MarketplaceItemMapping{
+		Marketplace:    marketplace,
+		ExternalItemID: externalItemID,
+		MenuItemID:     menuItemID,
+		UpdatedAt:      time.Now(),
+	}
+	if err := s.mappingRepo.Save(ctx, mapping); err != nil {
+		return nil, fmt.Errorf("failed to save item mapping: %w", err)
+	}
+
+	log.Printf("Mapped %s item %s to menu item %s", marketplace, externalItemID, menuItemID)
+	return mapping, nil
+}
+
+// GetItemMappings retrieves the item mappings of a marketplace
+func (s *MarketplaceService) GetItemMappings(ctx context.Context, marketplace string) ([]*domain.MarketplaceItemMapping, error) {
+	if _, err := s.adapters.Get(marketplace); err != nil {
+		return nil, err
+	}
+
+	mappings, err := s.mappingRepo.FindByMarketplace(ctx, marketplace)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get item mappings: %w", err)
+	}
+	return mappings, nil
+}
+
+// HandleOrderEvent reports the progress of orders accepted from a marketplace back to it.
+// A failed push is returned so that the event is redelivered.
+func (s *MarketplaceService) HandleOrderEvent(ctx context.Context, event *events.DomainEvent) error {
+	var eventData struct {
+		OrderID   string `json:"order_id"`
+		NewStatus string `json:"new_status"`
+		Reason    string `json:"reason"`
+	}
+
+	dataBytes, err := json.Marshal(event.Data)
+	if err != nil {
+		return err
+	}
+
+	if err := json.Unmarshal(dataBytes, &eventData); err != nil {
+		return err
+	}
+
+	var orderStatus domain.OrderStatus
+	switch event.Type {
+	case events.OrderStatusChangedEvent, events.OrderCompletedEvent, events.OrderCancelledEvent:
+		orderStatus = domain.OrderStatus(eventData.NewStatus)
+	case events.DeliveryPickedUpEvent:
+		orderStatus = domain.OrderStatusOutForDelivery
+	case events.DeliveryDeliveredEvent:
+		orderStatus = domain.OrderStatusCompleted
+	default:
+		return nil
+	}
+
+	status, ok := domain.MarketplaceStatusFor(orderStatus)
+	if !ok {
+		return nil
+	}
+
+	ingested, err := s.ingestedRepo.FindByOrderID(ctx, domain.OrderID(eventData.OrderID))
+	if errors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get marketplace order: %w", err)
+	}
+
+	if !ingested.Advance(status, eventData.Reason, time.Now()) {
+		return nil
+	}
+
+	adapter, err := s.adapters.Get(ingested.Marketplace)
+	if err != nil {
+		log.Printf("Not reporting %s of order %s: marketplace %s is no longer configured", status, eventData.OrderID, ingested.Marketplace)
+		return nil
+	}
+
+	if err := adapter.PushStatus(ctx, ingested.ExternalID, status, ingested.Reason); err != nil {
+		return fmt.Errorf("failed to push %s to %s for order %s: %w", status, ingested.Marketplace, ingested.ExternalID, err)
+	}
+
+	if err := s.ingestedRepo.Update(ctx, ingested); err != nil {
+		return fmt.Errorf("failed to update marketplace order: %w", err)
+	}
+
+	log.Printf("Reported %s order %s as %s", ingested.Marketplace, ingested.ExternalID, status)
+	return nil
+}
+
+// Helper methods
+
+// resolveItems looks up the menu item of every line. It returns a rejection reason
+// naming the items that are not mapped or cannot currently be ordered.
+func (s *MarketplaceService) resolveItems(ctx context.Context, placed *domain.MarketplaceOrder) (map[string]*domain.MenuItem, string, error) {
+	mappings, err := s.mappingRepo.FindByMarketplace(ctx, placed.Marketplace)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to get item mappings: %w", err)
+	}
+
+	menuItemIDs := make(map[string]string, len(mappings))
+	for _, mapping := range mappings {
+		menuItemIDs[mapping.ExternalItemID] = mapping.MenuItemID
+	}
+
+	menuItems := make(map[string]*domain.MenuItem)
+	var unknown, unavailable []string
+	for _, externalID := range placed.ExternalItemIDs() {
+		menuItemID, ok := menuItemIDs[externalID]
+		if !ok {
+			unknown = append(unknown, externalID)
+			continue
+		}
+
+		menuItem, err := s.menuItemRepo.GetByID(ctx, menuItemID)
+		if errors.IsNotFound(err) {
+			unknown = append(unknown, externalID)
+			continue
+		}
+		if err != nil {
+			return nil, "", fmt.Errorf("failed to get menu item: %w", err)
+		}
+		if menuItem.EnsureOrderable() != nil {
+			unavailable = append(unavailable, menuItem.Name)
+			continue
+		}
+		menuItems[externalID] = menuItem
+	}
+
+	var reasons []string
+	if len(unknown) > 0 {
+		sort.Strings(unknown)
+		reasons = append(reasons, "unknown items: "+strings.Join(unknown, ", "))
+	}
+	if len(unavailable) > 0 {
+		sort.Strings(unavailable)
+		reasons = append(reasons, "unavailable items: "+strings.Join(unavailable, ", "))
+	}
+	return menuItems, strings.Join(reasons, "; "), nil
+}
+
+// checkKitchenLoad returns a rejection reason if the kitchen cannot take another order
+func (s *MarketplaceService) checkKitchenLoad(ctx context.Context) (string, error) {
+	orders, err := s.orderRepo.GetActiveOrders(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to get active orders: %w", err)
+	}
+
+	load := domain.KitchenLoad(orders)
+	if s.loadPolicy.Admits(load) {
+		return "", nil
+	}
+	return fmt.Sprintf("kitchen is at capacity with %d orders in progress", load), nil
+}
+
+// accept saves the marketplace order as a paid order and sends it to the kitchen
+func (s *MarketplaceService) accept(ctx context.Context, adapter domain.MarketplaceAdapter, placed *domain.MarketplaceOrder,
+	menuItems map[string]*domain.MenuItem, now time.Time) (*domain.IngestedOrder, error) {
+	order, err := placed.ToOrder(menuItems)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.orderRepo.Create(ctx, order); err != nil {
+		return nil, fmt.Errorf("failed to save order: %w", err)
+	}
+
+	ingested := domain.AcceptIngestedOrder(placed.Marketplace, placed.ExternalID, order.ID, now)
+	if err := s.ingestedRepo.Create(ctx, ingested); err != nil {
+		// Nothing has been published yet, so the order is cancelled before anyone sees it
+		s.discard(ctx, order)
+		if errors.IsConflictError(err) {
+			// The same webhook was delivered concurrently and the other delivery was recorded first
+			return s.ingestedRepo.FindByExternalID(ctx, placed.Marketplace, placed.ExternalID)
+		}
+		return nil, fmt.Errorf("failed to record marketplace order: %w", err)
+	}
+
+	log.Printf("Accepted %s order %s as order %s", placed.Marketplace, placed.ExternalID, order.ID)
+
+	s.publishAccepted(ctx, order, placed.Marketplace)
+	s.pushDecision(ctx, adapter, ingested)
+	return ingested, nil
+}
+
+// reject records a marketplace order that was turned down and tells the marketplace why
+func (s *MarketplaceService) reject(ctx context.Context, adapter domain.MarketplaceAdapter, placed *domain.MarketplaceOrder,
+	reason string, now time.Time) (*domain.IngestedOrder, error) {
+	ingested := domain.RejectIngestedOrder(placed.Marketplace, placed.ExternalID, reason, now)
+	if err := s.ingestedRepo.Create(ctx, ingested); err != nil {
+		if errors.IsConflictError(err) {
+			return s.ingestedRepo.FindByExternalID(ctx, placed.Marketplace, placed.ExternalID)
+		}
+		return nil, fmt.Errorf("failed to record marketplace order: %w", err)
+	}
+
+	log.Printf("Rejected %s order %s: %s", placed.Marketplace, placed.ExternalID, reason)
+
+	s.pushDecision(ctx, adapter, ingested)
+	return ingested, nil
+}
+
+// discard cancels an order saved for a marketplace order that could not be recorded
+func (s *MarketplaceService) discard(ctx context.Context, order *domain.Order) {
+	_, err := applyOrderChange(ctx, s.orderRepo, order.ID, order, func(order *domain.Order) error {
+		return order.Cancel(domain.SystemActor, "duplicate marketplace order")
+	})
+	if err != nil {
+		log.Printf("Failed to cancel duplicate marketplace order %s: %v", order.ID, err)
+	}
+}
+
+// pushDecision reports an acceptance or rejection to the marketplace. The webhook
+// response carries the decision too, so a failed push is only logged.
+func (s *MarketplaceService) pushDecision(ctx context.Context, adapter domain.MarketplaceAdapter, ingested *domain.IngestedOrder) {
+	if err := adapter.PushStatus(ctx, ingested.ExternalID, ingested.Status, ingested.Reason); err != nil {
+		log.Printf("Failed to push %s to %s for order %s: %v", ingested.Status, ingested.Marketplace, ingested.ExternalID, err)
+	}
+}
+
+// publishAccepted publishes an OrderCreatedEvent and an OrderPaidEvent so the kitchen
+// tickets the order and starts preparing it
+func (s *MarketplaceService) publishAccepted(ctx context.Context, order *domain.Order, marketplace string) {
+	createdData, err := events.ToEventData(events.OrderCreatedData{
+		OrderID:     string(order.ID),
+		CustomerID:  order.CustomerID,
+		OrderType:   string(order.Type),
+		TotalAmount: order.TotalAmount,
+		Status:      string(order.Status),
+		Items:       toOrderLineData(order),
+	})
+	if err != nil {
+		log.Printf("Failed to convert event data to map: %v", err)
+		return
+	}
+	s.publish(ctx, events.OrderCreatedEvent, order, createdData)
+
+	paidData, err := events.ToEventData(events.OrderStatusChangedData{
+		OrderID:   string(order.ID),
+		OldStatus: string(domain.OrderStatusCreated),
+		NewStatus: string(order.Status),
+		UpdatedBy: order.CustomerID,
+		Reason:    "paid on " + marketplace,
+	})
+	if err != nil {
+		log.Printf("Failed to convert event data to map: %v", err)
+		return
+	}
+	s.publish(ctx, events.OrderPaidEvent, order, paidData)
+}
+
+func (s *MarketplaceService) publish(ctx context.Context, eventType events.EventType, order *domain.Order, eventData map[string]interface{}) {
+	event := events.NewDomainEvent(eventType, string(order.ID), eventData).
+		WithMetadata("service", "order-service").
+		WithMetadata("customer_id", order.CustomerID)
+
+	if err := s.eventPublisher.Publish(ctx, event); err != nil {
+		log.Printf("Failed to publish %s event: %v", eventType, err)
+	}
+}
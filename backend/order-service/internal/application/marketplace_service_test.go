@@ -0,0 +1,303 @@
+package application
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/restaurant-platform/order-service/internal/domain"
+	"github.com/restaurant-platform/order-service/internal/infrastructure"
+	"github.com/restaurant-platform/shared/events"
+	sharedErrors "github.com/restaurant-platform/shared/pkg/errors"
+)
+
+// MockMarketplaceItemMappingRepository is a mock implementation of MarketplaceItemMappingRepository
+type MockMarketplaceItemMappingRepository struct {
+	mock.Mock
+}
+
+func (m *MockMarketplaceItemMappingRepository) Save(ctx context.Context, mapping *domain.MarketplaceItemMapping) error {
+	args := m.Called(ctx, mapping)
+	return args.Error(0)
+}
+
+func (m *MockMarketplaceItemMappingRepository) FindByMarketplace(ctx context.Context, marketplace string) ([]*domain.MarketplaceItemMapping, error) {
+	args := m.Called(ctx, marketplace)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.MarketplaceItemMapping), args.Error(1)
+}
+
+// MockIngestedOrderRepository is a mock implementation of IngestedOrderRepository
+type MockIngestedOrderRepository struct {
+	mock.Mock
+}
+
+func (m *MockIngestedOrderRepository) Create(ctx context.Context, ingested *domain.IngestedOrder) error {
+	args := m.Called(ctx, ingested)
+	return args.Error(0)
+}
+
+func (m *MockIngestedOrderRepository) Update(ctx context.Context, ingested *domain.IngestedOrder) error {
+	args := m.Called(ctx, ingested)
+	return args.Error(0)
+}
+
+func (m *MockIngestedOrderRepository) FindByExternalID(ctx context.Context, marketplace, externalID string) (*domain.IngestedOrder, error) {
+	args := m.Called(ctx, marketplace, externalID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.IngestedOrder), args.Error(1)
+}
+
+func (m *MockIngestedOrderRepository) FindByOrderID(ctx context.Context, orderID domain.OrderID) (*domain.IngestedOrder, error) {
+	args := m.Called(ctx, orderID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.IngestedOrder), args.Error(1)
+}
+
+// MarketplaceServiceTestSuite contains marketplace order ingestion and status push-back tests
+type MarketplaceServiceTestSuite struct {
+	suite.Suite
+	service          *MarketplaceService
+	marketplace      *infrastructure.FakeMarketplace
+	mockOrderRepo    *MockOrderRepository
+	mockMenuItemRepo *MockMenuItemRepository
+	mockMappingRepo  *MockMarketplaceItemMappingRepository
+	mockIngestedRepo *MockIngestedOrderRepository
+	mockPublisher    *MockEventPublisher
+	payload          []byte
+	ctx              context.Context
+}
+
+func (suite *MarketplaceServiceTestSuite) SetupTest() {
+	suite.marketplace = infrastructure.NewFakeMarketplace("fake", "s3cret")
+	suite.mockOrderRepo = new(MockOrderRepository)
+	suite.mockMenuItemRepo = new(MockMenuItemRepository)
+	suite.mockMappingRepo = new(MockMarketplaceItemMappingRepository)
+	suite.mockIngestedRepo = new(MockIngestedOrderRepository)
+	suite.mockPublisher = new(MockEventPublisher)
+	suite.service = NewMarketplaceService(suite.mockOrderRepo, suite.mockMenuItemRepo, suite.mockMappingRepo, suite.mockIngestedRepo,
+		domain.NewMarketplaceAdapters(suite.marketplace), domain.KitchenLoadPolicy{MaxOrders: 2}, suite.mockPublisher)
+	suite.ctx = context.Background()
+
+	suite.payload, _ = json.Marshal(domain.MarketplaceOrder{
+		ExternalID: "A-1001",
+		Type:       domain.OrderTypeTakeout,
+		Items: []*domain.MarketplaceOrderItem{
+			{ExternalItemID: "mk-burger", Quantity: 2, UnitPrice: 12.50},
+		},
+	})
+	suite.mockMappingRepo.On("FindByMarketplace", suite.ctx, "fake").Return([]*domain.MarketplaceItemMapping{
+		{Marketplace: "fake", ExternalItemID: "mk-burger", MenuItemID: "burger"},
+	}, nil)
+}
+
+func TestMarketplaceServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(MarketplaceServiceTestSuite))
+}
+
+// newOrder expects a marketplace order that has not been received before
+func (suite *MarketplaceServiceTestSuite) newOrder() {
+	suite.mockIngestedRepo.On("FindByExternalID", suite.ctx, "fake", "A-1001").
+		Return(nil, sharedErrors.WrapNotFound("FindByExternalID", "marketplace_order", "A-1001", sharedErrors.ErrNotFound)).Once()
+}
+
+// paidOrders returns n orders waiting on the kitchen
+func (suite *MarketplaceServiceTestSuite) paidOrders(n int) []*domain.Order {
+	orders := make([]*domain.Order, n)
+	for i := range orders {
+		orders[i], _ = domain.NewOrder("customer-123", domain.OrderTypeTakeout)
+		orders[i].UpdateStatus(domain.OrderStatusPaid, "cashier-1", "")
+	}
+	return orders
+}
+
+// Test IngestOrder
+func (suite *MarketplaceServiceTestSuite) TestIngestOrder_Accepted_CreatesPaidOrderAndPublishes() {
+	// Given
+	suite.newOrder()
+	suite.mockMenuItemRepo.On("GetByID", suite.ctx, "burger").
+		Return(&domain.MenuItem{ID: "burger", Name: "Burger", Price: 10.00, IsAvailable: true}, nil)
+	suite.mockOrderRepo.On("GetActiveOrders", suite.ctx).Return(suite.paidOrders(1), nil)
+	suite.mockOrderRepo.On("Create", suite.ctx, mock.MatchedBy(func(order *domain.Order) bool {
+		return order.Status == domain.OrderStatusPaid && order.CustomerID == "marketplace:fake" &&
+			order.Items[0].MenuItemID == "burger" && order.Items[0].UnitPrice == 12.50
+	})).Return(nil)
+	suite.mockIngestedRepo.On("Create", suite.ctx, mock.MatchedBy(func(ingested *domain.IngestedOrder) bool {
+		return ingested.Status == domain.MarketplaceOrderStatusAccepted && ingested.IsAccepted()
+	})).Return(nil)
+	suite.mockPublisher.On("Publish", suite.ctx, mock.MatchedBy(func(event *events.DomainEvent) bool {
+		return event.Type == events.OrderCreatedEvent
+	})).Return(nil)
+	suite.mockPublisher.On("Publish", suite.ctx, mock.MatchedBy(func(event *events.DomainEvent) bool {
+		return event.Type == events.OrderPaidEvent && event.Data["new_status"] == string(domain.OrderStatusPaid)
+	})).Return(nil)
+
+	// When
+	ingested, err := suite.service.IngestOrder(suite.ctx, "fake", suite.payload, "s3cret")
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	assert.Equal(domain.MarketplaceOrderStatusAccepted, ingested.Status)
+	assert.Equal([]infrastructure.FakeMarketplacePush{{ExternalID: "A-1001", Status: domain.MarketplaceOrderStatusAccepted}}, suite.marketplace.Pushed())
+	suite.mockOrderRepo.AssertExpectations(suite.T())
+	suite.mockPublisher.AssertExpectations(suite.T())
+}
+
+func (suite *MarketplaceServiceTestSuite) TestIngestOrder_KitchenAtCapacity_IsRejected() {
+	// Given
+	suite.newOrder()
+	suite.mockMenuItemRepo.On("GetByID", suite.ctx, "burger").
+		Return(&domain.MenuItem{ID: "burger", Name: "Burger", Price: 10.00, IsAvailable: true}, nil)
+	suite.mockOrderRepo.On("GetActiveOrders", suite.ctx).Return(suite.paidOrders(2), nil)
+	suite.mockIngestedRepo.On("Create", suite.ctx, mock.MatchedBy(func(ingested *domain.IngestedOrder) bool {
+		return ingested.Status == domain.MarketplaceOrderStatusRejected && !ingested.IsAccepted()
+	})).Return(nil)
+
+	// When
+	ingested, err := suite.service.IngestOrder(suite.ctx, "fake", suite.payload, "s3cret")
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	assert.Equal(domain.MarketplaceOrderStatusRejected, ingested.Status)
+	assert.Equal("kitchen is at capacity with 2 orders in progress", ingested.Reason)
+	assert.Equal(domain.MarketplaceOrderStatusRejected, suite.marketplace.Pushed()[0].Status)
+	suite.mockOrderRepo.AssertNotCalled(suite.T(), "Create", mock.Anything, mock.Anything)
+	suite.mockPublisher.AssertNotCalled(suite.T(), "Publish", mock.Anything, mock.Anything)
+}
+
+func (suite *MarketplaceServiceTestSuite) TestIngestOrder_UnmappedItem_IsRejected() {
+	// Given
+	suite.payload, _ = json.Marshal(domain.MarketplaceOrder{
+		ExternalID: "A-1001",
+		Type:       domain.OrderTypeTakeout,
+		Items:      []*domain.MarketplaceOrderItem{{ExternalItemID: "mk-pizza", Quantity: 1, UnitPrice: 15.00}},
+	})
+	suite.newOrder()
+	suite.mockIngestedRepo.On("Create", suite.ctx, mock.Anything).Return(nil)
+
+	// When
+	ingested, err := suite.service.IngestOrder(suite.ctx, "fake", suite.payload, "s3cret")
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	assert.Equal(domain.MarketplaceOrderStatusRejected, ingested.Status)
+	assert.Equal("unknown items: mk-pizza", ingested.Reason)
+	suite.mockOrderRepo.AssertNotCalled(suite.T(), "GetActiveOrders", mock.Anything)
+}
+
+func (suite *MarketplaceServiceTestSuite) TestIngestOrder_Redelivered_ReturnsOriginalDecision() {
+	// Given
+	existing := domain.AcceptIngestedOrder("fake", "A-1001", "ord_1", time.Now())
+	suite.mockIngestedRepo.On("FindByExternalID", suite.ctx, "fake", "A-1001").Return(existing, nil)
+
+	// When
+	ingested, err := suite.service.IngestOrder(suite.ctx, "fake", suite.payload, "s3cret")
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	assert.Same(existing, ingested)
+	assert.Empty(suite.marketplace.Pushed())
+	suite.mockOrderRepo.AssertNotCalled(suite.T(), "Create", mock.Anything, mock.Anything)
+}
+
+func (suite *MarketplaceServiceTestSuite) TestIngestOrder_BadSignature_ShouldBeUnauthorized() {
+	// When
+	_, err := suite.service.IngestOrder(suite.ctx, "fake", suite.payload, "forged")
+
+	// Then
+	assert.New(suite.T()).True(sharedErrors.IsUnauthorizedError(err))
+	suite.mockIngestedRepo.AssertNotCalled(suite.T(), "FindByExternalID", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *MarketplaceServiceTestSuite) TestIngestOrder_UnknownMarketplace_ShouldBeNotFound() {
+	// When
+	_, err := suite.service.IngestOrder(suite.ctx, "other", suite.payload, "s3cret")
+
+	// Then
+	assert.New(suite.T()).True(sharedErrors.IsNotFound(err))
+}
+
+// Test HandleOrderEvent
+func (suite *MarketplaceServiceTestSuite) TestHandleOrderEvent_PushesStatusOfMarketplaceOrder() {
+	// Given
+	ingested := domain.AcceptIngestedOrder("fake", "A-1001", "ord_1", time.Now())
+	suite.mockIngestedRepo.On("FindByOrderID", suite.ctx, domain.OrderID("ord_1")).Return(ingested, nil)
+	suite.mockIngestedRepo.On("Update", suite.ctx, ingested).Return(nil)
+	event := events.NewDomainEvent(events.OrderStatusChangedEvent, "ord_1", map[string]interface{}{
+		"order_id": "ord_1", "old_status": "PREPARING", "new_status": "READY",
+	})
+
+	// When
+	err := suite.service.HandleOrderEvent(suite.ctx, event)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	assert.Equal(domain.MarketplaceOrderStatusReady, ingested.Status)
+	assert.Equal([]infrastructure.FakeMarketplacePush{{ExternalID: "A-1001", Status: domain.MarketplaceOrderStatusReady}}, suite.marketplace.Pushed())
+	suite.mockIngestedRepo.AssertExpectations(suite.T())
+}
+
+func (suite *MarketplaceServiceTestSuite) TestHandleOrderEvent_PushFails_ShouldReturnErrorForRedelivery() {
+	// Given
+	ingested := domain.AcceptIngestedOrder("fake", "A-1001", "ord_1", time.Now())
+	suite.mockIngestedRepo.On("FindByOrderID", suite.ctx, domain.OrderID("ord_1")).Return(ingested, nil)
+	suite.marketplace.FailPushes(fmt.Errorf("marketplace unavailable"))
+	event := events.NewDomainEvent(events.OrderCancelledEvent, "ord_1", map[string]interface{}{
+		"order_id": "ord_1", "old_status": "PAID", "new_status": "CANCELLED", "reason": "out of buns",
+	})
+
+	// When
+	err := suite.service.HandleOrderEvent(suite.ctx, event)
+
+	// Then
+	assert.New(suite.T()).Error(err)
+	suite.mockIngestedRepo.AssertNotCalled(suite.T(), "Update", mock.Anything, mock.Anything)
+}
+
+func (suite *MarketplaceServiceTestSuite) TestHandleOrderEvent_OtherOrder_IsIgnored() {
+	// Given
+	suite.mockIngestedRepo.On("FindByOrderID", suite.ctx, domain.OrderID("ord_2")).
+		Return(nil, sharedErrors.WrapNotFound("FindByOrderID", "marketplace_order", "ord_2", sharedErrors.ErrNotFound))
+	event := events.NewDomainEvent(events.OrderCompletedEvent, "ord_2", map[string]interface{}{
+		"order_id": "ord_2", "old_status": "READY", "new_status": "COMPLETED",
+	})
+
+	// When
+	err := suite.service.HandleOrderEvent(suite.ctx, event)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	assert.Empty(suite.marketplace.Pushed())
+}
+
+// Test SetItemMapping
+func (suite *MarketplaceServiceTestSuite) TestSetItemMapping_UnknownMenuItem_ShouldFailValidation() {
+	// Given
+	suite.mockMenuItemRepo.On("GetByID", suite.ctx, "pizza").
+		Return(nil, sharedErrors.WrapNotFound("GetByID", "menu_item", "pizza", sharedErrors.ErrNotFound))
+
+	// When
+	_, err := suite.service.SetItemMapping(suite.ctx, "fake", "mk-pizza", "pizza")
+
+	// Then
+	assert.New(suite.T()).True(sharedErrors.IsValidationError(err))
+	suite.mockMappingRepo.AssertNotCalled(suite.T(), "Save", mock.Anything, mock.Anything)
+}
@@ -0,0 +1,178 @@
+package application
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/restaurant-platform/order-service/internal/domain"
+	"github.com/restaurant-platform/shared/events"
+	sharedErrors "github.com/restaurant-platform/shared/pkg/errors"
+)
+
+// MockMenuCatalog is a mock implementation of MenuCatalog
+type MockMenuCatalog struct {
+	mock.Mock
+}
+
+func (m *MockMenuCatalog) ActiveMenu(ctx context.Context) (string, []*domain.MenuItem, error) {
+	args := m.Called(ctx)
+	if args.Get(1) == nil {
+		return args.String(0), nil, args.Error(2)
+	}
+	return args.String(0), args.Get(1).([]*domain.MenuItem), args.Error(2)
+}
+
+// MenuEventHandlerTestSuite contains menu read model projection tests
+type MenuEventHandlerTestSuite struct {
+	suite.Suite
+	handler      *MenuEventHandler
+	mockMenuRepo *MockMenuItemRepository
+	ctx          context.Context
+}
+
+func (suite *MenuEventHandlerTestSuite) SetupTest() {
+	suite.mockMenuRepo = new(MockMenuItemRepository)
+	suite.handler = NewMenuEventHandler(suite.mockMenuRepo)
+	suite.ctx = context.Background()
+}
+
+func TestMenuEventHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(MenuEventHandlerTestSuite))
+}
+
+func (suite *MenuEventHandlerTestSuite) newEvent(eventType events.EventType, data interface{}) *events.DomainEvent {
+	var eventData map[string]interface{}
+	switch d := data.(type) {
+	case events.MenuItemData:
+		eventData, _ = events.ToEventData(d)
+	case events.MenuActivatedData:
+		eventData, _ = events.ToEventData(d)
+	case events.ItemAvailabilityChangedData:
+		eventData, _ = events.ToEventData(d)
+	}
+	return events.NewDomainEvent(eventType, "menu-1", eventData)
+}
+
+func (suite *MenuEventHandlerTestSuite) TestMenuItemAdded_StoresItem() {
+	// Given
+	event := suite.newEvent(events.MenuItemAddedEvent, events.MenuItemData{
+		MenuID: "menu-1", ItemID: "item-1", Name: "Burger", Price: 14.50,
+		IsAvailable: true, PrepTimeSeconds: 600, MenuActive: true,
+	})
+	suite.mockMenuRepo.On("Upsert", suite.ctx, mock.MatchedBy(func(item *domain.MenuItem) bool {
+		return item.ID == "item-1" && item.Price == 14.50 && item.PrepTime == 10*time.Minute
+	})).Return(nil)
+
+	// When
+	err := suite.handler.HandleMenuEvent(suite.ctx, event)
+
+	// Then
+	assert.New(suite.T()).NoError(err)
+	suite.mockMenuRepo.AssertExpectations(suite.T())
+}
+
+func (suite *MenuEventHandlerTestSuite) TestMenuItemUpdated_StoresModifierGroups() {
+	// Given
+	event := suite.newEvent(events.MenuItemUpdatedEvent, events.MenuItemData{
+		MenuID: "menu-1", ItemID: "item-1", Name: "Burger", Price: 14.50, IsAvailable: true, MenuActive: true,
+		ModifierGroups: []events.ModifierGroupData{{
+			GroupID: "mgrp_temp", Name: "Temperature", MinSelections: 1, MaxSelections: 1,
+			Options: []events.ModifierOptionData{{OptionID: "mopt_medium", Name: "Medium", IsDefault: true}},
+		}},
+	})
+	suite.mockMenuRepo.On("Upsert", suite.ctx, mock.MatchedBy(func(item *domain.MenuItem) bool {
+		return len(item.ModifierGroups) == 1 &&
+			item.ModifierGroups[0].MaxSelections == 1 &&
+			item.ModifierGroups[0].Options[0].IsDefault
+	})).Return(nil)
+
+	// When
+	err := suite.handler.HandleMenuEvent(suite.ctx, event)
+
+	// Then
+	assert.New(suite.T()).NoError(err)
+	suite.mockMenuRepo.AssertExpectations(suite.T())
+}
+
+func (suite *MenuEventHandlerTestSuite) TestMenuItemAdded_InactiveMenu_Ignored() {
+	// Given
+	event := suite.newEvent(events.MenuItemAddedEvent, events.MenuItemData{
+		MenuID: "menu-2", ItemID: "item-9", Name: "Brunch Special", Price: 11.00, MenuActive: false,
+	})
+
+	// When
+	err := suite.handler.HandleMenuEvent(suite.ctx, event)
+
+	// Then
+	assert.New(suite.T()).NoError(err)
+	suite.mockMenuRepo.AssertNotCalled(suite.T(), "Upsert", mock.Anything, mock.Anything)
+}
+
+func (suite *MenuEventHandlerTestSuite) TestMenuActivated_ReplacesMenuItems() {
+	// Given
+	event := suite.newEvent(events.MenuActivatedEvent, events.MenuActivatedData{
+		MenuID: "menu-1",
+		Items: []events.MenuItemData{
+			{MenuID: "menu-1", ItemID: "item-1", Name: "Burger", Price: 14.50, IsAvailable: true},
+			{MenuID: "menu-1", ItemID: "item-2", Name: "Fries", Price: 4.00, IsAvailable: true},
+		},
+	})
+	suite.mockMenuRepo.On("ReplaceMenu", suite.ctx, "menu-1", mock.MatchedBy(func(items []*domain.MenuItem) bool {
+		return len(items) == 2 && items[1].Name == "Fries"
+	})).Return(nil)
+
+	// When
+	err := suite.handler.HandleMenuEvent(suite.ctx, event)
+
+	// Then
+	assert.New(suite.T()).NoError(err)
+	suite.mockMenuRepo.AssertExpectations(suite.T())
+}
+
+func (suite *MenuEventHandlerTestSuite) TestLoadActiveMenu_ReplacesMenuItems() {
+	// Given
+	catalog := new(MockMenuCatalog)
+	items := []*domain.MenuItem{{ID: "item-1", MenuID: "menu-1", Name: "Burger", Price: 14.50, IsAvailable: true}}
+	catalog.On("ActiveMenu", suite.ctx).Return("menu-1", items, nil)
+	suite.mockMenuRepo.On("ReplaceMenu", suite.ctx, "menu-1", items).Return(nil)
+
+	// When
+	err := suite.handler.LoadActiveMenu(suite.ctx, catalog)
+
+	// Then
+	assert.New(suite.T()).NoError(err)
+	suite.mockMenuRepo.AssertExpectations(suite.T())
+}
+
+func (suite *MenuEventHandlerTestSuite) TestLoadActiveMenu_NoActiveMenu_LoadsNothing() {
+	// Given
+	catalog := new(MockMenuCatalog)
+	catalog.On("ActiveMenu", suite.ctx).Return("", nil, sharedErrors.WrapNotFound("ActiveMenu", "menu", "active", sharedErrors.ErrNotFound))
+
+	// When
+	err := suite.handler.LoadActiveMenu(suite.ctx, catalog)
+
+	// Then
+	assert.New(suite.T()).NoError(err)
+	suite.mockMenuRepo.AssertNotCalled(suite.T(), "ReplaceMenu", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *MenuEventHandlerTestSuite) TestItemAvailabilityChanged_UpdatesAvailability() {
+	// Given
+	event := suite.newEvent(events.ItemAvailabilityChangedEvent, events.ItemAvailabilityChangedData{
+		MenuID: "menu-1", ItemID: "item-1", IsAvailable: false,
+	})
+	suite.mockMenuRepo.On("SetAvailability", suite.ctx, "item-1", false).Return(nil)
+
+	// When
+	err := suite.handler.HandleMenuEvent(suite.ctx, event)
+
+	// Then
+	assert.New(suite.T()).NoError(err)
+	suite.mockMenuRepo.AssertExpectations(suite.T())
+}
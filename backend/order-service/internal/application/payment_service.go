@@ -0,0 +1,500 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/restaurant-platform/order-service/internal/domain"
+	"github.com/restaurant-platform/shared/events"
+	"github.com/restaurant-platform/shared/pkg/concurrency"
+	"github.com/restaurant-platform/shared/pkg/errors"
+)
+
+// PaymentService implements the payment business logic
+type PaymentService struct {
+	orderRepo      domain.OrderRepository
+	paymentRepo    domain.PaymentRepository
+	tenders        tenderGateway
+	eventPublisher events.EventPublisher
+}
+
+// NewPaymentService creates a new payment service.
+// Without a payment provider, card tenders are rejected.
+func NewPaymentService(orderRepo domain.OrderRepository, paymentRepo domain.PaymentRepository, provider domain.PaymentProvider, giftCards domain.GiftCardProcessor,
+	drawerRepo domain.DrawerSessionRepository, eventPublisher events.EventPublisher) *PaymentService {
+	return &PaymentService{
+		orderRepo:      orderRepo,
+		paymentRepo:    paymentRepo,
+		tenders:        tenderGateway{provider: provider, giftCards: giftCards, drawers: drawerRepo},
+		eventPublisher: eventPublisher,
+	}
+}
+
+// AddTender applies a tender to an order, settling it once fully paid.
+// Cash is taken into the open drawer session of the acting cashier. A gift card tender carries the card code as its reference and pays as much of
+// the amount as the card holds; without an amount it pays the remaining balance.
+func (s *PaymentService) AddTender(ctx context.Context, orderID domain.OrderID, tenderType domain.TenderType, amount, amountTendered float64, reference string) (*domain.Payment, error) {
+	order, err := s.orderRepo.GetByID(ctx, orderID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get order: %w", err)
+	}
+
+	// The client's If-Match is checked before any money is taken
+	if err := concurrency.CheckVersion(ctx, "AddTender", "order", orderID.String(), order.Version); err != nil {
+		return nil, err
+	}
+	ctx = concurrency.WithoutExpectedVersion(ctx)
+
+	// Items added to a paid order reopen its check until the order is completed
+	if order.Status != domain.OrderStatusCreated && (!order.IsSettled() || order.Status == domain.OrderStatusCompleted) {
+		return nil, errors.WrapConflict("AddTender", "order_status", "only open orders can be paid", nil)
+	}
+	if len(order.Items) == 0 {
+		return nil, errors.WrapConflict("AddTender", "order", "cannot pay an order without items", nil)
+	}
+
+	payment, isNew, err := s.getOrCreatePayment(ctx, order)
+	if err != nil {
+		return nil, err
+	}
+
+	if payment.Status == domain.PaymentStatusPaid {
+		if order.Status != domain.OrderStatusCreated {
+			return nil, errors.WrapConflict("AddTender", "payment_status", "order has already been paid in full", nil)
+		}
+		// An earlier tender paid the order in full but the order could not be settled then
+		if err := s.settleOrder(ctx, order, payment); err != nil {
+			return nil, err
+		}
+		return payment, nil
+	}
+
+	var providerRef string
+	var drawerSessionID domain.DrawerSessionID
+	var redemption *domain.GiftCardTransaction
+	switch tenderType {
+	case domain.TenderTypeCash:
+		if drawerSessionID, err = s.tenders.cashDrawer(ctx); err != nil {
+			return nil, err
+		}
+	case domain.TenderTypeCard:
+		provider, err := s.tenders.cardProvider()
+		if err != nil {
+			return nil, err
+		}
+		if amount <= 0 {
+			return nil, errors.WrapValidation("AddTender", "amount", "amount must be positive", nil)
+		}
+		if amount > payment.Remaining() {
+			return nil, errors.WrapValidation("AddTender", "amount", "amount exceeds remaining balance", nil)
+		}
+
+		result, err := provider.Charge(ctx, domain.ChargeRequest{
+			OrderID:    orderID,
+			TenderType: tenderType,
+			Amount:     amount,
+			Reference:  reference,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to charge tender: %w", err)
+		}
+		if !result.Approved {
+			return nil, errors.WrapConflict("AddTender", "tender", fmt.Sprintf("charge declined: %s", result.Message), nil)
+		}
+		providerRef = result.ProviderRef
+	case domain.TenderTypeGiftCard:
+		if !payment.CanAcceptTender() {
+			return nil, errors.WrapConflict("AddTender", "payment_status", "payment does not accept further tenders", nil)
+		}
+		if amount == 0 {
+			amount = payment.Remaining()
+		}
+		if amount > payment.Remaining() {
+			return nil, errors.WrapValidation("AddTender", "amount", "amount exceeds remaining balance", nil)
+		}
+
+		redemption, err = s.tenders.giftCards.Redeem(ctx, reference, amount, orderID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to redeem gift card: %w", err)
+		}
+		amount = -redemption.Amount
+		reference = domain.MaskGiftCardCode(reference)
+		providerRef = redemption.ID.String()
+	}
+
+	payment, tender, err := s.recordTender(ctx, order, payment, isNew, tenderType, amount, amountTendered, reference, providerRef, drawerSessionID)
+	if err != nil {
+		// The money was taken for a tender that was never recorded, so give it back
+		switch {
+		case redemption != nil:
+			if _, refundErr := s.tenders.giftCards.Refund(ctx, redemption.ID, amount); refundErr != nil {
+				log.Printf("Failed to return %.2f to gift card %s after a failed tender: %v", amount, redemption.GiftCardID, refundErr)
+			}
+		case providerRef != "":
+			if voidErr := s.tenders.provider.Void(ctx, providerRef); voidErr != nil {
+				log.Printf("Failed to void charge %s of %.2f after a failed tender: %v", providerRef, amount, voidErr)
+			}
+		}
+		return nil, err
+	}
+
+	log.Printf("Applied %s tender %s of %.2f to order: %s", tender.Type, tender.ID, tender.Amount, orderID)
+
+	// A reopened check is paid off without settling the order again
+	if payment.IsFullyPaid() && order.Status == domain.OrderStatusCreated {
+		// The tender is kept; settling is retried on the next read or by SettlePaidOrders
+		if err := s.settleOrder(ctx, order, payment); err != nil {
+			return nil, err
+		}
+	}
+
+	return payment, nil
+}
+
+// GetPaymentForOrder retrieves the payment recorded for an order, settling the order
+// if it was paid in full but could not be settled at the time
+func (s *PaymentService) GetPaymentForOrder(ctx context.Context, orderID domain.OrderID) (*domain.Payment, error) {
+	payment, err := s.paymentRepo.GetByOrderID(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+	if payment.Status != domain.PaymentStatusPaid {
+		return payment, nil
+	}
+
+	order, err := s.orderRepo.GetByID(ctx, orderID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get order: %w", err)
+	}
+	// Items added since the payment was taken are still owed
+	if order.Status == domain.OrderStatusCreated && order.TotalAmount <= payment.AmountDue {
+		if err := s.settleOrder(ctx, order, payment); err != nil {
+			return nil, err
+		}
+	}
+	return payment, nil
+}
+
+// SettlePaidOrders settles the created orders whose payment was taken in full but
+// whose status change failed, returning how many were settled
+func (s *PaymentService) SettlePaidOrders(ctx context.Context) (int, error) {
+	orders, err := s.orderRepo.FindByStatus(ctx, domain.OrderStatusCreated)
+	if err != nil {
+		return 0, fmt.Errorf("failed to find created orders: %w", err)
+	}
+	if len(orders) == 0 {
+		return 0, nil
+	}
+
+	byID := make(map[domain.OrderID]*domain.Order, len(orders))
+	orderIDs := make([]domain.OrderID, len(orders))
+	for i, order := range orders {
+		byID[order.ID] = order
+		orderIDs[i] = order.ID
+	}
+
+	payments, err := s.paymentRepo.FindByOrderIDs(ctx, orderIDs)
+	if err != nil {
+		return 0, fmt.Errorf("failed to find payments: %w", err)
+	}
+
+	settled := 0
+	for _, payment := range payments {
+		order := byID[payment.OrderID]
+		if payment.Status != domain.PaymentStatusPaid || order.TotalAmount > payment.AmountDue {
+			continue
+		}
+		if err := s.settleOrder(ctx, order, payment); err != nil {
+			log.Printf("Failed to settle paid order %s: %v", payment.OrderID, err)
+			continue
+		}
+		settled++
+	}
+	return settled, nil
+}
+
+// RefundTender returns part or all of a tender. The refund is reserved on the payment
+// before the money is returned, so concurrent refunds cannot return more than was taken.
+func (s *PaymentService) RefundTender(ctx context.Context, paymentID domain.PaymentID, tenderID domain.TenderID, amount float64, reason string) (*domain.Payment, error) {
+	var refund *domain.Refund
+	payment, err := applyPaymentChange(ctx, s.paymentRepo, paymentID, nil, func(payment *domain.Payment) error {
+		var err error
+		refund, err = payment.BeginRefund(tenderID, amount, reason)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	payment, err = completeRefund(ctx, s.paymentRepo, s.tenders, payment, refund)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Printf("Refunded %.2f of tender %s on payment: %s", refund.Amount, tenderID, paymentID)
+
+	s.publishAdjustment(ctx, events.PaymentRefundedEvent, payment, events.PaymentAdjustedData{
+		PaymentID: string(payment.ID),
+		OrderID:   string(payment.OrderID),
+		TenderID:  string(tenderID),
+		Amount:    refund.Amount,
+		Reason:    reason,
+		Status:    string(payment.Status),
+	})
+
+	return payment, nil
+}
+
+// VoidPayment cancels all tenders of an unsettled payment. Voiding a payment whose
+// tenders could not all be returned the first time returns the rest.
+func (s *PaymentService) VoidPayment(ctx context.Context, paymentID domain.PaymentID, reason string) (*domain.Payment, error) {
+	var amountVoided float64
+	payment, err := applyPaymentChange(ctx, s.paymentRepo, paymentID, nil, func(payment *domain.Payment) error {
+		amountVoided = payment.AmountPaid
+		if payment.Status == domain.PaymentStatusVoided && len(payment.UnvoidedTenders()) > 0 {
+			return nil
+		}
+		if err := payment.Void(reason); err != nil {
+			return fmt.Errorf("failed to void payment: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if payment, err = voidTenders(ctx, s.paymentRepo, s.tenders, payment); err != nil {
+		return nil, err
+	}
+
+	log.Printf("Voided payment %s for order: %s", paymentID, payment.OrderID)
+
+	s.publishAdjustment(ctx, events.PaymentVoidedEvent, payment, events.PaymentAdjustedData{
+		PaymentID: string(payment.ID),
+		OrderID:   string(payment.OrderID),
+		Amount:    amountVoided,
+		Reason:    payment.VoidReason,
+		Status:    string(payment.Status),
+	})
+
+	return payment, nil
+}
+
+// Helper methods
+
+func (s *PaymentService) getOrCreatePayment(ctx context.Context, order *domain.Order) (*domain.Payment, bool, error) {
+	payment, err := s.paymentRepo.GetByOrderID(ctx, order.ID)
+	if err != nil && !errors.IsNotFound(err) {
+		return nil, false, fmt.Errorf("failed to get payment: %w", err)
+	}
+
+	if payment == nil {
+		payment, err = domain.NewPayment(order.ID, order.TotalAmount)
+		if err != nil {
+			return nil, false, fmt.Errorf("failed to create payment: %w", err)
+		}
+		return payment, true, nil
+	}
+
+	// Items may have changed since the payment was opened
+	if len(payment.Tenders) == 0 && payment.AmountDue != order.TotalAmount {
+		if err := payment.SetAmountDue(order.TotalAmount); err != nil {
+			return nil, false, fmt.Errorf("failed to update amount due: %w", err)
+		}
+	}
+	// Items added after the order was paid in full are charged on the same payment
+	if payment.Status == domain.PaymentStatusPaid && order.TotalAmount > payment.AmountDue {
+		if err := payment.Reopen(order.TotalAmount); err != nil {
+			return nil, false, fmt.Errorf("failed to reopen payment: %w", err)
+		}
+	}
+
+	return payment, false, nil
+}
+
+// recordTender adds a tender whose money has already been taken to the payment. Only
+// recording is retried on a conflict, against the payment as another writer left it.
+func (s *PaymentService) recordTender(ctx context.Context, order *domain.Order, payment *domain.Payment, isNew bool, tenderType domain.TenderType, amount, amountTendered float64,
+	reference, providerRef string, drawerSessionID domain.DrawerSessionID) (*domain.Payment, *domain.Tender, error) {
+	var tender *domain.Tender
+	err := concurrency.RetryOnConflict(ctx, func() error {
+		if payment == nil {
+			var err error
+			if payment, isNew, err = s.getOrCreatePayment(ctx, order); err != nil {
+				return err
+			}
+		}
+
+		var err error
+		tender, err = payment.AddTender(tenderType, amount, amountTendered, reference, providerRef)
+		if err != nil {
+			return fmt.Errorf("failed to add tender: %w", err)
+		}
+		tender.DrawerSessionID = drawerSessionID
+
+		if isNew {
+			err = s.paymentRepo.Create(ctx, payment)
+		} else {
+			err = s.paymentRepo.Update(ctx, payment)
+		}
+		if err != nil {
+			payment = nil
+			return fmt.Errorf("failed to save payment: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return payment, tender, nil
+}
+
+func (s *PaymentService) settleOrder(ctx context.Context, order *domain.Order, payment *domain.Payment) error {
+	previousStatus := order.Status
+	actor := actorFromContext(ctx)
+
+	// The tender has already been taken, so only the status change is retried on a conflict
+	order, err := applyOrderChange(ctx, s.orderRepo, order.ID, order, func(order *domain.Order) error {
+		if err := order.UpdateStatus(domain.OrderStatusPaid, actor, "payment settled"); err != nil {
+			return fmt.Errorf("failed to update order status: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	log.Printf("Order %s fully paid with payment: %s", order.ID, payment.ID)
+
+	tenders := make([]events.PaymentTenderData, 0, len(payment.Tenders))
+	for _, tender := range payment.Tenders {
+		tenders = append(tenders, events.PaymentTenderData{
+			TenderID:       string(tender.ID),
+			Type:           string(tender.Type),
+			Amount:         tender.Amount,
+			AmountTendered: tender.AmountTendered,
+			Change:         tender.Change,
+			Reference:      tender.Reference,
+		})
+	}
+
+	eventData, err := events.ToEventData(events.OrderPaidData{
+		OrderID:     string(order.ID),
+		OldStatus:   string(previousStatus),
+		NewStatus:   string(order.Status),
+		UpdatedBy:   actor,
+		PaymentID:   string(payment.ID),
+		AmountDue:   payment.AmountDue,
+		AmountPaid:  payment.AmountPaid,
+		ChangeGiven: payment.ChangeGiven,
+		Tenders:     tenders,
+	})
+	if err != nil {
+		log.Printf("Failed to convert event data to map: %v", err)
+		return fmt.Errorf("failed to convert event data: %w", err)
+	}
+
+	event := events.NewDomainEvent(events.OrderPaidEvent, string(order.ID), eventData).
+		WithMetadata("service", "order-service").
+		WithMetadata("customer_id", order.CustomerID)
+
+	if err := s.eventPublisher.Publish(ctx, event); err != nil {
+		log.Printf("Failed to publish order paid event: %v", err)
+	}
+
+	return nil
+}
+
+func (s *PaymentService) publishAdjustment(ctx context.Context, eventType events.EventType, payment *domain.Payment, data events.PaymentAdjustedData) {
+	eventData, err := events.ToEventData(data)
+	if err != nil {
+		log.Printf("Failed to convert event data to map: %v", err)
+		return
+	}
+
+	event := events.NewDomainEvent(eventType, string(payment.OrderID), eventData).
+		WithMetadata("service", "order-service").
+		WithMetadata("payment_id", string(payment.ID))
+
+	if err := s.eventPublisher.Publish(ctx, event); err != nil {
+		log.Printf("Failed to publish %s event: %v", eventType, err)
+	}
+}
+
+// applyPaymentChange loads a payment, applies a change and saves it, re-running the
+// change against a fresh copy if another writer saved the payment in between. A payment
+// the caller already loaded is used for the first attempt.
+func applyPaymentChange(ctx context.Context, paymentRepo domain.PaymentRepository, paymentID domain.PaymentID, loaded *domain.Payment, change func(payment *domain.Payment) error) (*domain.Payment, error) {
+	var payment *domain.Payment
+	err := concurrency.RetryOnConflict(ctx, func() error {
+		payment, loaded = loaded, nil
+		if payment == nil {
+			var err error
+			payment, err = paymentRepo.GetByID(ctx, paymentID)
+			if err != nil {
+				return fmt.Errorf("failed to get payment: %w", err)
+			}
+		}
+
+		if err := change(payment); err != nil {
+			return err
+		}
+
+		if err := paymentRepo.Update(ctx, payment); err != nil {
+			return fmt.Errorf("failed to update payment: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return payment, nil
+}
+
+// completeRefund returns the money of a refund reserved on the payment and records where
+// it went, or releases the reservation if the money could not be returned
+func completeRefund(ctx context.Context, paymentRepo domain.PaymentRepository, tenders tenderGateway, payment *domain.Payment, refund *domain.Refund) (*domain.Payment, error) {
+	tender, err := payment.GetTender(refund.TenderID)
+	if err != nil {
+		return nil, err
+	}
+
+	returned, err := tenders.refund(ctx, tender, refund.Amount)
+	if err != nil {
+		if _, cancelErr := applyPaymentChange(ctx, paymentRepo, payment.ID, payment, func(payment *domain.Payment) error {
+			return payment.CancelRefund(refund.ID)
+		}); cancelErr != nil {
+			log.Printf("Failed to release refund %s of %.2f on payment %s: %v", refund.ID, refund.Amount, payment.ID, cancelErr)
+		}
+		return nil, fmt.Errorf("failed to refund tender: %w", err)
+	}
+
+	payment, err = applyPaymentChange(ctx, paymentRepo, payment.ID, payment, func(payment *domain.Payment) error {
+		return payment.CompleteRefund(refund.ID, returned.providerRef, returned.drawerSessionID)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to record refund %s: %w", refund.ID, err)
+	}
+	return payment, nil
+}
+
+// voidTenders returns the money of the tenders of a voided payment, saving each tender
+// as soon as it has been voided so that a failure part way leaves the rest to retry
+func voidTenders(ctx context.Context, paymentRepo domain.PaymentRepository, tenders tenderGateway, payment *domain.Payment) (*domain.Payment, error) {
+	for _, tender := range payment.UnvoidedTenders() {
+		if err := tenders.void(ctx, tender); err != nil {
+			return nil, fmt.Errorf("failed to void tender %s: %w", tender.ID, err)
+		}
+
+		tenderID := tender.ID
+		var err error
+		payment, err = applyPaymentChange(ctx, paymentRepo, payment.ID, payment, func(payment *domain.Payment) error {
+			return payment.VoidTender(tenderID)
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to record void of tender %s: %w", tenderID, err)
+		}
+	}
+	return payment, nil
+}
@@ -0,0 +1,651 @@
+package application
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/restaurant-platform/order-service/internal/domain"
+	"github.com/restaurant-platform/order-service/internal/infrastructure"
+	"github.com/restaurant-platform/shared/events"
+	"github.com/restaurant-platform/shared/pkg/auth"
+	"github.com/restaurant-platform/shared/pkg/concurrency"
+	sharedErrors "github.com/restaurant-platform/shared/pkg/errors"
+)
+
+// MockPaymentRepository is a mock implementation of PaymentRepository
+type MockPaymentRepository struct {
+	mock.Mock
+}
+
+func (m *MockPaymentRepository) Create(ctx context.Context, payment *domain.Payment) error {
+	args := m.Called(ctx, payment)
+	return args.Error(0)
+}
+
+func (m *MockPaymentRepository) GetByID(ctx context.Context, id domain.PaymentID) (*domain.Payment, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Payment), args.Error(1)
+}
+
+func (m *MockPaymentRepository) GetByOrderID(ctx context.Context, orderID domain.OrderID) (*domain.Payment, error) {
+	args := m.Called(ctx, orderID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Payment), args.Error(1)
+}
+
+func (m *MockPaymentRepository) FindByOrderIDs(ctx context.Context, orderIDs []domain.OrderID) ([]*domain.Payment, error) {
+	args := m.Called(ctx, orderIDs)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.Payment), args.Error(1)
+}
+
+func (m *MockPaymentRepository) FindByDrawerSession(ctx context.Context, sessionID domain.DrawerSessionID) ([]*domain.Payment, error) {
+	args := m.Called(ctx, sessionID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.Payment), args.Error(1)
+}
+
+func (m *MockPaymentRepository) Update(ctx context.Context, payment *domain.Payment) error {
+	args := m.Called(ctx, payment)
+	return args.Error(0)
+}
+
+// PaymentServiceTestSuite contains all payment service tests
+type PaymentServiceTestSuite struct {
+	suite.Suite
+	service         *PaymentService
+	mockOrderRepo   *MockOrderRepository
+	mockPaymentRepo *MockPaymentRepository
+	mockDrawerRepo  *MockDrawerSessionRepository
+	mockPublisher   *MockEventPublisher
+	provider        *infrastructure.FakePaymentProvider
+	giftCards       *GiftCardService
+	drawer          *domain.DrawerSession
+	order           *domain.Order
+	ctx             context.Context
+}
+
+func (suite *PaymentServiceTestSuite) SetupTest() {
+	suite.mockOrderRepo = new(MockOrderRepository)
+	suite.mockPaymentRepo = new(MockPaymentRepository)
+	suite.mockDrawerRepo = new(MockDrawerSessionRepository)
+	suite.mockPublisher = new(MockEventPublisher)
+	suite.provider = infrastructure.NewFakePaymentProvider()
+	suite.giftCards = NewGiftCardService(newMemoryGiftCardRepository())
+	suite.service = NewPaymentService(suite.mockOrderRepo, suite.mockPaymentRepo, suite.provider, suite.giftCards, suite.mockDrawerRepo, suite.mockPublisher)
+	suite.ctx = auth.WithActor(context.Background(), "cashier-1")
+	suite.drawer, _ = domain.NewDrawerSession("cashier-1", 100.00)
+
+	// 2 x 10.00 plus 10% tax = 22.00
+	suite.order, _ = domain.NewOrder("customer-123", domain.OrderTypeDineIn)
+	suite.order.AddItem("item-1", "Burger", 2, 10.00, nil, "")
+}
+
+func TestPaymentServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(PaymentServiceTestSuite))
+}
+
+func (suite *PaymentServiceTestSuite) notFound() error {
+	return sharedErrors.WrapNotFound("PaymentRepository.GetByOrderID", "payment", string(suite.order.ID), sharedErrors.ErrNotFound)
+}
+
+// drawerOpen gives the acting cashier an open drawer session to handle cash in
+func (suite *PaymentServiceTestSuite) drawerOpen() {
+	suite.mockDrawerRepo.On("GetOpenByCashier", suite.ctx, "cashier-1").Return(suite.drawer, nil)
+}
+
+// Test AddTender
+func (suite *PaymentServiceTestSuite) TestAddTender_CashSettlesOrder() {
+	// Given
+	var published *events.DomainEvent
+	suite.drawerOpen()
+	suite.mockOrderRepo.On("GetByID", suite.ctx, suite.order.ID).Return(suite.order, nil)
+	suite.mockPaymentRepo.On("GetByOrderID", suite.ctx, suite.order.ID).Return(nil, suite.notFound())
+	suite.mockPaymentRepo.On("Create", suite.ctx, mock.AnythingOfType("*domain.Payment")).Return(nil)
+	suite.mockOrderRepo.On("Update", suite.ctx, suite.order).Return(nil)
+	suite.mockPublisher.On("Publish", suite.ctx, mock.AnythingOfType("*events.DomainEvent")).
+		Run(func(args mock.Arguments) { published = args.Get(1).(*events.DomainEvent) }).
+		Return(nil)
+
+	// When
+	payment, err := suite.service.AddTender(suite.ctx, suite.order.ID, domain.TenderTypeCash, 0, 30.00, "")
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	assert.Equal(domain.PaymentStatusPaid, payment.Status)
+	assert.Equal(8.00, payment.ChangeGiven)
+	assert.Equal(suite.drawer.ID, payment.Tenders[0].DrawerSessionID)
+	assert.Equal(domain.OrderStatusPaid, suite.order.Status)
+	assert.NotNil(published)
+	assert.Equal(events.OrderPaidEvent, published.Type)
+	assert.Equal(string(payment.ID), published.Data["payment_id"])
+	assert.Len(published.Data["tenders"], 1)
+	suite.mockPaymentRepo.AssertExpectations(suite.T())
+	suite.mockOrderRepo.AssertExpectations(suite.T())
+}
+
+func (suite *PaymentServiceTestSuite) TestAddTender_CashWithoutOpenDrawer_ShouldFail() {
+	// Given
+	suite.mockOrderRepo.On("GetByID", suite.ctx, suite.order.ID).Return(suite.order, nil)
+	suite.mockPaymentRepo.On("GetByOrderID", suite.ctx, suite.order.ID).Return(nil, suite.notFound())
+	suite.mockDrawerRepo.On("GetOpenByCashier", suite.ctx, "cashier-1").
+		Return(nil, sharedErrors.WrapNotFound("GetOpenByCashier", "drawer session", "cashier-1", sharedErrors.ErrNotFound))
+
+	// When
+	_, err := suite.service.AddTender(suite.ctx, suite.order.ID, domain.TenderTypeCash, 0, 30.00, "")
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.True(sharedErrors.IsConflictError(err))
+	assert.Contains(err.Error(), "open a cash drawer session")
+	suite.mockPaymentRepo.AssertNotCalled(suite.T(), "Create", mock.Anything, mock.Anything)
+}
+
+func (suite *PaymentServiceTestSuite) TestAddTender_PartialCard_DoesNotSettle() {
+	// Given
+	suite.mockOrderRepo.On("GetByID", suite.ctx, suite.order.ID).Return(suite.order, nil)
+	suite.mockPaymentRepo.On("GetByOrderID", suite.ctx, suite.order.ID).Return(nil, suite.notFound())
+	suite.mockPaymentRepo.On("Create", suite.ctx, mock.AnythingOfType("*domain.Payment")).Return(nil)
+
+	// When
+	payment, err := suite.service.AddTender(suite.ctx, suite.order.ID, domain.TenderTypeCard, 12.00, 0, "visa-4242")
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	assert.Equal(domain.PaymentStatusPartiallyPaid, payment.Status)
+	assert.Equal(10.00, payment.Remaining())
+	assert.Equal("fake_ch_000001", payment.Tenders[0].ProviderRef)
+	assert.Equal(domain.OrderStatusCreated, suite.order.Status)
+	suite.mockOrderRepo.AssertNotCalled(suite.T(), "Update", mock.Anything, mock.Anything)
+	suite.mockPublisher.AssertNotCalled(suite.T(), "Publish", mock.Anything, mock.Anything)
+}
+
+func (suite *PaymentServiceTestSuite) TestAddTender_StaleIfMatch_ShouldFailBeforeCharging() {
+	// Given the client last saw version 1 but the order is at version 2
+	ctx := concurrency.WithExpectedVersion(suite.ctx, 1)
+	suite.order.Version = 2
+	suite.mockOrderRepo.On("GetByID", ctx, suite.order.ID).Return(suite.order, nil)
+
+	// When
+	payment, err := suite.service.AddTender(ctx, suite.order.ID, domain.TenderTypeCard, 22.00, 0, "visa-4242")
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.Nil(payment)
+	assert.True(sharedErrors.IsPreconditionFailed(err))
+	assert.Zero(suite.provider.ChargeCount())
+	suite.mockPaymentRepo.AssertNotCalled(suite.T(), "GetByOrderID", mock.Anything, mock.Anything)
+	suite.mockPaymentRepo.AssertNotCalled(suite.T(), "Create", mock.Anything, mock.Anything)
+}
+
+func (suite *PaymentServiceTestSuite) TestAddTender_CardDeclined_ShouldFail() {
+	// Given
+	suite.provider.Decline("visa-0002")
+	suite.mockOrderRepo.On("GetByID", suite.ctx, suite.order.ID).Return(suite.order, nil)
+	suite.mockPaymentRepo.On("GetByOrderID", suite.ctx, suite.order.ID).Return(nil, suite.notFound())
+
+	// When
+	payment, err := suite.service.AddTender(suite.ctx, suite.order.ID, domain.TenderTypeCard, 22.00, 0, "visa-0002")
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.Error(err)
+	assert.Nil(payment)
+	assert.True(sharedErrors.IsConflictError(err))
+	suite.mockPaymentRepo.AssertNotCalled(suite.T(), "Create", mock.Anything, mock.Anything)
+}
+
+func (suite *PaymentServiceTestSuite) TestAddTender_CardWithoutProvider_ShouldFail() {
+	// Given
+	service := NewPaymentService(suite.mockOrderRepo, suite.mockPaymentRepo, nil, suite.giftCards, suite.mockDrawerRepo, suite.mockPublisher)
+	suite.mockOrderRepo.On("GetByID", suite.ctx, suite.order.ID).Return(suite.order, nil)
+	suite.mockPaymentRepo.On("GetByOrderID", suite.ctx, suite.order.ID).Return(nil, suite.notFound())
+
+	// When
+	payment, err := service.AddTender(suite.ctx, suite.order.ID, domain.TenderTypeCard, 22.00, 0, "visa-0001")
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.Nil(payment)
+	assert.True(sharedErrors.IsValidationError(err))
+	suite.mockPaymentRepo.AssertNotCalled(suite.T(), "Create", mock.Anything, mock.Anything)
+}
+
+func (suite *PaymentServiceTestSuite) TestAddTender_CardSaveFails_VoidsTheCharge() {
+	// Given
+	suite.mockOrderRepo.On("GetByID", suite.ctx, suite.order.ID).Return(suite.order, nil)
+	suite.mockPaymentRepo.On("GetByOrderID", suite.ctx, suite.order.ID).Return(nil, suite.notFound())
+	suite.mockPaymentRepo.On("Create", suite.ctx, mock.AnythingOfType("*domain.Payment")).Return(assert.AnError)
+
+	// When
+	payment, err := suite.service.AddTender(suite.ctx, suite.order.ID, domain.TenderTypeCard, 22.00, 0, "visa-4242")
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.Error(err)
+	assert.Nil(payment)
+	assert.True(suite.provider.IsVoided("fake_ch_000001"))
+	assert.Equal(domain.OrderStatusCreated, suite.order.Status)
+	suite.mockPublisher.AssertNotCalled(suite.T(), "Publish", mock.Anything, mock.Anything)
+}
+
+func (suite *PaymentServiceTestSuite) TestAddTender_ConcurrentTender_RecordsAgainstTheSavedPayment() {
+	// Given another cashier saved a tender since the payment was loaded
+	stale, _ := domain.NewPayment(suite.order.ID, 22.00)
+	stale.AddTender(domain.TenderTypeCard, 5.00, 0, "", "ch_other")
+	saved, _ := domain.NewPayment(suite.order.ID, 22.00)
+	saved.ID = stale.ID
+	saved.AddTender(domain.TenderTypeCard, 5.00, 0, "", "ch_other")
+	saved.AddTender(domain.TenderTypeCard, 7.00, 0, "", "ch_another")
+	conflict := sharedErrors.WrapVersionConflict("PaymentRepository.Update", "payment", string(stale.ID), stale.Version)
+
+	suite.mockOrderRepo.On("GetByID", suite.ctx, suite.order.ID).Return(suite.order, nil)
+	suite.mockPaymentRepo.On("GetByOrderID", suite.ctx, suite.order.ID).Return(stale, nil).Once()
+	suite.mockPaymentRepo.On("GetByOrderID", suite.ctx, suite.order.ID).Return(saved, nil).Once()
+	suite.mockPaymentRepo.On("Update", suite.ctx, stale).Return(conflict).Once()
+	suite.mockPaymentRepo.On("Update", suite.ctx, saved).Return(nil).Once()
+	suite.mockOrderRepo.On("Update", suite.ctx, suite.order).Return(nil)
+	suite.mockPublisher.On("Publish", suite.ctx, mock.AnythingOfType("*events.DomainEvent")).Return(nil)
+
+	// When
+	payment, err := suite.service.AddTender(suite.ctx, suite.order.ID, domain.TenderTypeCard, 10.00, 0, "visa-4242")
+
+	// Then both tenders are kept
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	assert.Len(payment.Tenders, 3)
+	assert.Equal(22.00, payment.AmountPaid)
+	assert.False(suite.provider.IsVoided("fake_ch_000001"))
+}
+
+func (suite *PaymentServiceTestSuite) TestAddTender_ConcurrentTenderCoveredTheBalance_VoidsTheCharge() {
+	// Given another cashier settled the balance since the payment was loaded
+	stale, _ := domain.NewPayment(suite.order.ID, 22.00)
+	saved, _ := domain.NewPayment(suite.order.ID, 22.00)
+	saved.ID = stale.ID
+	saved.AddTender(domain.TenderTypeCard, 22.00, 0, "", "ch_other")
+	stale.AddTender(domain.TenderTypeCard, 2.00, 0, "", "ch_first")
+	conflict := sharedErrors.WrapVersionConflict("PaymentRepository.Update", "payment", string(stale.ID), stale.Version)
+
+	suite.mockOrderRepo.On("GetByID", suite.ctx, suite.order.ID).Return(suite.order, nil)
+	suite.mockPaymentRepo.On("GetByOrderID", suite.ctx, suite.order.ID).Return(stale, nil).Once()
+	suite.mockPaymentRepo.On("GetByOrderID", suite.ctx, suite.order.ID).Return(saved, nil).Once()
+	suite.mockPaymentRepo.On("Update", suite.ctx, stale).Return(conflict).Once()
+
+	// When
+	payment, err := suite.service.AddTender(suite.ctx, suite.order.ID, domain.TenderTypeCard, 20.00, 0, "visa-4242")
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.Error(err)
+	assert.Nil(payment)
+	assert.True(suite.provider.IsVoided("fake_ch_000001"))
+}
+
+func (suite *PaymentServiceTestSuite) TestAddTender_CardOverpayment_ShouldFail() {
+	// Given
+	suite.mockOrderRepo.On("GetByID", suite.ctx, suite.order.ID).Return(suite.order, nil)
+	suite.mockPaymentRepo.On("GetByOrderID", suite.ctx, suite.order.ID).Return(nil, suite.notFound())
+
+	// When
+	_, err := suite.service.AddTender(suite.ctx, suite.order.ID, domain.TenderTypeCard, 25.00, 0, "")
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.Error(err)
+	assert.True(sharedErrors.IsValidationError(err))
+}
+
+func (suite *PaymentServiceTestSuite) TestAddTender_EmptyOrder_ShouldFail() {
+	// Given
+	emptyOrder, _ := domain.NewOrder("customer-123", domain.OrderTypeTakeout)
+	suite.mockOrderRepo.On("GetByID", suite.ctx, emptyOrder.ID).Return(emptyOrder, nil)
+
+	// When
+	_, err := suite.service.AddTender(suite.ctx, emptyOrder.ID, domain.TenderTypeCash, 0, 10.00, "")
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.Error(err)
+	assert.Contains(err.Error(), "without items")
+}
+
+func (suite *PaymentServiceTestSuite) TestAddTender_GiftCardShortOfTotal_LeavesRemainderForAnotherTender() {
+	// Given
+	card, _ := suite.giftCards.Issue(suite.ctx, 15.00)
+	suite.mockOrderRepo.On("GetByID", suite.ctx, suite.order.ID).Return(suite.order, nil)
+	suite.mockPaymentRepo.On("GetByOrderID", suite.ctx, suite.order.ID).Return(nil, suite.notFound()).Once()
+	suite.mockPaymentRepo.On("Create", suite.ctx, mock.AnythingOfType("*domain.Payment")).Return(nil)
+
+	// When
+	payment, err := suite.service.AddTender(suite.ctx, suite.order.ID, domain.TenderTypeGiftCard, 0, 0, card.Code)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	assert.Equal(domain.PaymentStatusPartiallyPaid, payment.Status)
+	assert.Equal(15.00, payment.Tenders[0].Amount)
+	assert.Equal(7.00, payment.Remaining())
+	assert.Equal(card.MaskedCode, payment.Tenders[0].Reference)
+	assert.NotContains(payment.Tenders[0].Reference, card.Code[:4])
+
+	drained, _ := suite.giftCards.CheckBalance(suite.ctx, card.Code)
+	assert.Zero(drained.Balance)
+
+	// And the rest is paid in cash
+	suite.drawerOpen()
+	suite.mockPaymentRepo.On("GetByOrderID", suite.ctx, suite.order.ID).Return(payment, nil)
+	suite.mockPaymentRepo.On("Update", suite.ctx, payment).Return(nil)
+	suite.mockOrderRepo.On("Update", suite.ctx, suite.order).Return(nil)
+	suite.mockPublisher.On("Publish", suite.ctx, mock.AnythingOfType("*events.DomainEvent")).Return(nil)
+
+	payment, err = suite.service.AddTender(suite.ctx, suite.order.ID, domain.TenderTypeCash, 0, 10.00, "")
+	assert.NoError(err)
+	assert.Equal(domain.PaymentStatusPaid, payment.Status)
+	assert.Equal(3.00, payment.ChangeGiven)
+}
+
+func (suite *PaymentServiceTestSuite) TestAddTender_GiftCardSaveFails_ReturnsTheRedemption() {
+	// Given
+	card, _ := suite.giftCards.Issue(suite.ctx, 50.00)
+	suite.mockOrderRepo.On("GetByID", suite.ctx, suite.order.ID).Return(suite.order, nil)
+	suite.mockPaymentRepo.On("GetByOrderID", suite.ctx, suite.order.ID).Return(nil, suite.notFound())
+	suite.mockPaymentRepo.On("Create", suite.ctx, mock.AnythingOfType("*domain.Payment")).Return(assert.AnError)
+
+	// When
+	_, err := suite.service.AddTender(suite.ctx, suite.order.ID, domain.TenderTypeGiftCard, 0, 0, card.Code)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.Error(err)
+	restored, _ := suite.giftCards.CheckBalance(suite.ctx, card.Code)
+	assert.Equal(50.00, restored.Balance)
+}
+
+func (suite *PaymentServiceTestSuite) TestAddTender_UnknownGiftCard_ShouldReturnNotFound() {
+	// Given
+	suite.mockOrderRepo.On("GetByID", suite.ctx, suite.order.ID).Return(suite.order, nil)
+	suite.mockPaymentRepo.On("GetByOrderID", suite.ctx, suite.order.ID).Return(nil, suite.notFound())
+
+	// When
+	_, err := suite.service.AddTender(suite.ctx, suite.order.ID, domain.TenderTypeGiftCard, 10.00, 0, "AAAA-BBBB-CCCC-DDDD")
+
+	// Then
+	assert.New(suite.T()).True(sharedErrors.IsNotFound(err))
+	suite.mockPaymentRepo.AssertNotCalled(suite.T(), "Create", mock.Anything, mock.Anything)
+}
+
+// Test settlement retries
+func (suite *PaymentServiceTestSuite) paidPayment() *domain.Payment {
+	payment, _ := domain.NewPayment(suite.order.ID, 22.00)
+	payment.AddTender(domain.TenderTypeCard, 22.00, 0, "", "ch_1")
+	return payment
+}
+
+func (suite *PaymentServiceTestSuite) TestAddTender_PaidButUnsettled_SettlesWithoutCharging() {
+	// Given the order update failed after the last tender
+	payment := suite.paidPayment()
+	suite.mockOrderRepo.On("GetByID", suite.ctx, suite.order.ID).Return(suite.order, nil)
+	suite.mockPaymentRepo.On("GetByOrderID", suite.ctx, suite.order.ID).Return(payment, nil)
+	suite.mockOrderRepo.On("Update", suite.ctx, suite.order).Return(nil)
+	suite.mockPublisher.On("Publish", suite.ctx, mock.AnythingOfType("*events.DomainEvent")).Return(nil)
+
+	// When the cashier retries
+	result, err := suite.service.AddTender(suite.ctx, suite.order.ID, domain.TenderTypeCard, 22.00, 0, "visa-4242")
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	assert.Len(result.Tenders, 1)
+	assert.Equal(domain.OrderStatusPaid, suite.order.Status)
+	suite.mockPaymentRepo.AssertNotCalled(suite.T(), "Update", mock.Anything, mock.Anything)
+}
+
+func (suite *PaymentServiceTestSuite) TestAddTender_AddOnAfterPaid_ChargesTheDifference() {
+	// Given a dessert added while the paid order is being prepared
+	payment := suite.paidPayment()
+	suite.order.Status = domain.OrderStatusPreparing
+	suite.order.AddItem("item-2", "Cheesecake", 1, 8.00, nil, "")
+	suite.mockOrderRepo.On("GetByID", suite.ctx, suite.order.ID).Return(suite.order, nil)
+	suite.mockPaymentRepo.On("GetByOrderID", suite.ctx, suite.order.ID).Return(payment, nil)
+	suite.mockPaymentRepo.On("Update", suite.ctx, payment).Return(nil)
+
+	// When
+	result, err := suite.service.AddTender(suite.ctx, suite.order.ID, domain.TenderTypeCard, 8.80, 0, "visa-4242")
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	assert.Equal(domain.PaymentStatusPaid, result.Status)
+	assert.Equal(30.80, result.AmountDue)
+	assert.Len(result.Tenders, 2)
+	assert.Equal(domain.OrderStatusPreparing, suite.order.Status)
+	suite.mockOrderRepo.AssertNotCalled(suite.T(), "Update", mock.Anything, mock.Anything)
+}
+
+func (suite *PaymentServiceTestSuite) TestAddTender_PaidOrderWithNothingOwed_ShouldFail() {
+	// Given
+	payment := suite.paidPayment()
+	suite.order.Status = domain.OrderStatusPreparing
+	suite.mockOrderRepo.On("GetByID", suite.ctx, suite.order.ID).Return(suite.order, nil)
+	suite.mockPaymentRepo.On("GetByOrderID", suite.ctx, suite.order.ID).Return(payment, nil)
+
+	// When
+	_, err := suite.service.AddTender(suite.ctx, suite.order.ID, domain.TenderTypeCard, 5.00, 0, "visa-4242")
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.True(sharedErrors.IsConflictError(err))
+	suite.mockPaymentRepo.AssertNotCalled(suite.T(), "Update", mock.Anything, mock.Anything)
+}
+
+func (suite *PaymentServiceTestSuite) TestGetPaymentForOrder_PaidButUnsettled_SettlesTheOrder() {
+	// Given
+	payment := suite.paidPayment()
+	suite.mockPaymentRepo.On("GetByOrderID", suite.ctx, suite.order.ID).Return(payment, nil)
+	suite.mockOrderRepo.On("GetByID", suite.ctx, suite.order.ID).Return(suite.order, nil)
+	suite.mockOrderRepo.On("Update", suite.ctx, suite.order).Return(nil)
+	suite.mockPublisher.On("Publish", suite.ctx, mock.AnythingOfType("*events.DomainEvent")).Return(nil)
+
+	// When
+	_, err := suite.service.GetPaymentForOrder(suite.ctx, suite.order.ID)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	assert.Equal(domain.OrderStatusPaid, suite.order.Status)
+}
+
+func (suite *PaymentServiceTestSuite) TestSettlePaidOrders_SettlesOnlyFullyPaidOrders() {
+	// Given
+	partial, _ := domain.NewOrder("customer-456", domain.OrderTypeTakeout)
+	partial.AddItem("item-2", "Fries", 1, 5.00, nil, "")
+	partialPayment, _ := domain.NewPayment(partial.ID, partial.TotalAmount)
+	partialPayment.AddTender(domain.TenderTypeCard, 1.00, 0, "", "ch_2")
+
+	suite.mockOrderRepo.On("FindByStatus", suite.ctx, domain.OrderStatusCreated).Return([]*domain.Order{suite.order, partial}, nil)
+	suite.mockPaymentRepo.On("FindByOrderIDs", suite.ctx, []domain.OrderID{suite.order.ID, partial.ID}).
+		Return([]*domain.Payment{suite.paidPayment(), partialPayment}, nil)
+	suite.mockOrderRepo.On("Update", suite.ctx, suite.order).Return(nil)
+	suite.mockPublisher.On("Publish", suite.ctx, mock.AnythingOfType("*events.DomainEvent")).Return(nil)
+
+	// When
+	settled, err := suite.service.SettlePaidOrders(suite.ctx)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	assert.Equal(1, settled)
+	assert.Equal(domain.OrderStatusPaid, suite.order.Status)
+	assert.Equal(domain.OrderStatusCreated, partial.Status)
+}
+
+// Test RefundTender
+func (suite *PaymentServiceTestSuite) TestRefundTender_Success() {
+	// Given
+	payment, _ := domain.NewPayment(suite.order.ID, 22.00)
+	charge, _ := suite.provider.Charge(suite.ctx, domain.ChargeRequest{Amount: 22.00})
+	tender, _ := payment.AddTender(domain.TenderTypeCard, 22.00, 0, "", charge.ProviderRef)
+
+	suite.mockPaymentRepo.On("GetByID", suite.ctx, payment.ID).Return(payment, nil)
+	suite.mockPaymentRepo.On("Update", suite.ctx, payment).Return(nil)
+	suite.mockPublisher.On("Publish", suite.ctx, mock.AnythingOfType("*events.DomainEvent")).Return(nil)
+
+	// When
+	result, err := suite.service.RefundTender(suite.ctx, payment.ID, tender.ID, 5.00, "missing side")
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	assert.Equal(domain.PaymentStatusPartiallyRefunded, result.Status)
+	assert.Equal(5.00, result.AmountRefunded)
+	assert.Equal("fake_rf_000002", result.Refunds[0].ProviderRef)
+	suite.mockPublisher.AssertCalled(suite.T(), "Publish", suite.ctx, mock.MatchedBy(func(e *events.DomainEvent) bool {
+		return e.Type == events.PaymentRefundedEvent
+	}))
+}
+
+func (suite *PaymentServiceTestSuite) TestRefundTender_ExceedsBalance_ShouldFail() {
+	// Given
+	payment, _ := domain.NewPayment(suite.order.ID, 22.00)
+	tender, _ := payment.AddTender(domain.TenderTypeCash, 0, 22.00, "", "")
+	suite.mockPaymentRepo.On("GetByID", suite.ctx, payment.ID).Return(payment, nil)
+
+	// When
+	_, err := suite.service.RefundTender(suite.ctx, payment.ID, tender.ID, 30.00, "error")
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.Error(err)
+	assert.True(sharedErrors.IsValidationError(err))
+	suite.mockPaymentRepo.AssertNotCalled(suite.T(), "Update", mock.Anything, mock.Anything)
+}
+
+func (suite *PaymentServiceTestSuite) TestRefundTender_Cash_PaidOutOfTheCashiersDrawer() {
+	// Given: cash taken in an earlier shift is refunded from the current drawer
+	payment, _ := domain.NewPayment(suite.order.ID, 22.00)
+	tender, _ := payment.AddTender(domain.TenderTypeCash, 0, 22.00, "", "")
+	tender.DrawerSessionID = "drw_earlier"
+	suite.drawerOpen()
+	suite.mockPaymentRepo.On("GetByID", suite.ctx, payment.ID).Return(payment, nil)
+	suite.mockPaymentRepo.On("Update", suite.ctx, payment).Return(nil)
+	suite.mockPublisher.On("Publish", suite.ctx, mock.AnythingOfType("*events.DomainEvent")).Return(nil)
+
+	// When
+	result, err := suite.service.RefundTender(suite.ctx, payment.ID, tender.ID, 6.00, "cold food")
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	assert.Equal(suite.drawer.ID, result.Refunds[0].DrawerSessionID)
+	assert.Empty(result.Refunds[0].ProviderRef)
+}
+
+func (suite *PaymentServiceTestSuite) TestRefundTender_GiftCard_CreditsTheCard() {
+	// Given
+	card, _ := suite.giftCards.Issue(suite.ctx, 30.00)
+	redemption, _ := suite.giftCards.Redeem(suite.ctx, card.Code, 22.00, suite.order.ID)
+	payment, _ := domain.NewPayment(suite.order.ID, 22.00)
+	tender, _ := payment.AddTender(domain.TenderTypeGiftCard, 22.00, 0, card.MaskedCode, redemption.ID.String())
+
+	suite.mockPaymentRepo.On("GetByID", suite.ctx, payment.ID).Return(payment, nil)
+	suite.mockPaymentRepo.On("Update", suite.ctx, payment).Return(nil)
+	suite.mockPublisher.On("Publish", suite.ctx, mock.AnythingOfType("*events.DomainEvent")).Return(nil)
+
+	// When
+	result, err := suite.service.RefundTender(suite.ctx, payment.ID, tender.ID, 5.00, "missing side")
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	assert.Equal(5.00, result.AmountRefunded)
+	assert.NotEmpty(result.Refunds[0].ProviderRef)
+
+	credited, _ := suite.giftCards.CheckBalance(suite.ctx, card.Code)
+	assert.Equal(13.00, credited.Balance)
+}
+
+// Test VoidPayment
+func (suite *PaymentServiceTestSuite) TestVoidPayment_Success() {
+	// Given
+	payment, _ := domain.NewPayment(suite.order.ID, 22.00)
+	charge, _ := suite.provider.Charge(suite.ctx, domain.ChargeRequest{Amount: 10.00})
+	payment.AddTender(domain.TenderTypeCard, 10.00, 0, "", charge.ProviderRef)
+
+	suite.mockPaymentRepo.On("GetByID", suite.ctx, payment.ID).Return(payment, nil)
+	suite.mockPaymentRepo.On("Update", suite.ctx, payment).Return(nil)
+	suite.mockPublisher.On("Publish", suite.ctx, mock.AnythingOfType("*events.DomainEvent")).Return(nil)
+
+	// When
+	result, err := suite.service.VoidPayment(suite.ctx, payment.ID, "customer walked out")
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	assert.Equal(domain.PaymentStatusVoided, result.Status)
+	assert.Equal(domain.TenderStatusVoided, result.Tenders[0].Status)
+
+	_, refundErr := suite.provider.Refund(suite.ctx, charge.ProviderRef, 1.00)
+	assert.Error(refundErr)
+}
+
+func (suite *PaymentServiceTestSuite) TestVoidPayment_TenderVoidFails_RecordsTheTendersAlreadyVoided() {
+	// Given a second charge the provider no longer knows
+	payment, _ := domain.NewPayment(suite.order.ID, 22.00)
+	charge, _ := suite.provider.Charge(suite.ctx, domain.ChargeRequest{Amount: 10.00})
+	first, _ := payment.AddTender(domain.TenderTypeCard, 10.00, 0, "", charge.ProviderRef)
+	second, _ := payment.AddTender(domain.TenderTypeCard, 5.00, 0, "", "ch_unknown")
+
+	suite.mockPaymentRepo.On("GetByID", suite.ctx, payment.ID).Return(payment, nil)
+	suite.mockPaymentRepo.On("Update", suite.ctx, payment).Return(nil)
+
+	// When
+	_, err := suite.service.VoidPayment(suite.ctx, payment.ID, "customer walked out")
+
+	// Then the voided charge is saved and the other is left to retry
+	assert := assert.New(suite.T())
+	assert.Error(err)
+	assert.Equal(domain.PaymentStatusVoided, payment.Status)
+	assert.Equal(domain.TenderStatusVoided, first.Status)
+	assert.Equal(domain.TenderStatusCaptured, second.Status)
+	suite.mockPaymentRepo.AssertNumberOfCalls(suite.T(), "Update", 2)
+	suite.mockPublisher.AssertNotCalled(suite.T(), "Publish", mock.Anything, mock.Anything)
+}
+
+func (suite *PaymentServiceTestSuite) TestVoidPayment_GiftCard_ReturnsTheRedemption() {
+	// Given
+	card, _ := suite.giftCards.Issue(suite.ctx, 10.00)
+	redemption, _ := suite.giftCards.Redeem(suite.ctx, card.Code, 10.00, suite.order.ID)
+	payment, _ := domain.NewPayment(suite.order.ID, 22.00)
+	payment.AddTender(domain.TenderTypeGiftCard, 10.00, 0, card.MaskedCode, redemption.ID.String())
+
+	suite.mockPaymentRepo.On("GetByID", suite.ctx, payment.ID).Return(payment, nil)
+	suite.mockPaymentRepo.On("Update", suite.ctx, payment).Return(nil)
+	suite.mockPublisher.On("Publish", suite.ctx, mock.AnythingOfType("*events.DomainEvent")).Return(nil)
+
+	// When
+	result, err := suite.service.VoidPayment(suite.ctx, payment.ID, "customer walked out")
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	assert.Equal(domain.PaymentStatusVoided, result.Status)
+
+	restored, _ := suite.giftCards.CheckBalance(suite.ctx, card.Code)
+	assert.Equal(10.00, restored.Balance)
+}
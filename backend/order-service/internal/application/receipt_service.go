@@ -0,0 +1,73 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/restaurant-platform/order-service/internal/domain"
+	"github.com/restaurant-platform/shared/pkg/errors"
+)
+
+// ReceiptService renders guest checks and receipts for orders
+type ReceiptService struct {
+	orderRepo   domain.OrderRepository
+	paymentRepo domain.PaymentRepository
+	renderer    domain.ReceiptRenderer
+	restaurant  domain.RestaurantInfo
+}
+
+// NewReceiptService creates a new receipt service
+func NewReceiptService(orderRepo domain.OrderRepository, paymentRepo domain.PaymentRepository, renderer domain.ReceiptRenderer, restaurant domain.RestaurantInfo) *ReceiptService {
+	return &ReceiptService{
+		orderRepo:   orderRepo,
+		paymentRepo: paymentRepo,
+		renderer:    renderer,
+		restaurant:  restaurant,
+	}
+}
+
+// GetReceipt renders the guest check or receipt of an order
+func (s *ReceiptService) GetReceipt(ctx context.Context, orderID domain.OrderID, format domain.ReceiptFormat, width int) (*domain.RenderedReceipt, error) {
+	return s.render(ctx, orderID, format, width, false)
+}
+
+// ReprintReceipt renders the guest check or receipt of an order marked as a reprint
+func (s *ReceiptService) ReprintReceipt(ctx context.Context, orderID domain.OrderID, format domain.ReceiptFormat, width int) (*domain.RenderedReceipt, error) {
+	rendered, err := s.render(ctx, orderID, format, width, true)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Printf("Reprinted %s receipt for order %s by %s", format, orderID, actorFromContext(ctx))
+	return rendered, nil
+}
+
+func (s *ReceiptService) render(ctx context.Context, orderID domain.OrderID, format domain.ReceiptFormat, width int, reprint bool) (*domain.RenderedReceipt, error) {
+	if err := domain.ValidateReceiptFormat(format, width); err != nil {
+		return nil, err
+	}
+
+	order, err := s.orderRepo.GetByID(ctx, orderID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get order: %w", err)
+	}
+
+	payment, err := s.paymentRepo.GetByOrderID(ctx, orderID)
+	if err != nil && !errors.IsNotFound(err) {
+		return nil, fmt.Errorf("failed to get payment: %w", err)
+	}
+
+	receipt, err := domain.NewReceipt(order, payment, s.restaurant, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	receipt.Reprint = reprint
+
+	rendered, err := s.renderer.Render(receipt, format, width)
+	if err != nil {
+		return nil, fmt.Errorf("failed to render receipt: %w", err)
+	}
+	return rendered, nil
+}
@@ -0,0 +1,127 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/restaurant-platform/order-service/internal/domain"
+)
+
+// ReportService builds sales reports and closes business days
+type ReportService struct {
+	orderRepo   domain.OrderRepository
+	paymentRepo domain.PaymentRepository
+	zReportRepo domain.ZReportRepository
+	cutoff      time.Duration
+	location    *time.Location
+}
+
+// NewReportService creates a new report service. Business days start at cutoff past midnight local time.
+func NewReportService(orderRepo domain.OrderRepository, paymentRepo domain.PaymentRepository, zReportRepo domain.ZReportRepository, cutoff time.Duration) *ReportService {
+	return &ReportService{
+		orderRepo:   orderRepo,
+		paymentRepo: paymentRepo,
+		zReportRepo: zReportRepo,
+		cutoff:      cutoff,
+		location:    time.Local,
+	}
+}
+
+// GetSalesReport builds the live sales report of a business day, defaulting to the current one
+func (s *ReportService) GetSalesReport(ctx context.Context, businessDate string) (*domain.SalesReport, error) {
+	day, err := s.businessDay(businessDate)
+	if err != nil {
+		return nil, err
+	}
+	return s.buildReport(ctx, day)
+}
+
+// CloseBusinessDay takes the Z-report of a business day that has ended, defaulting to the previous one
+func (s *ReportService) CloseBusinessDay(ctx context.Context, businessDate string) (*domain.ZReport, error) {
+	var day domain.BusinessDay
+	if businessDate == "" {
+		current := domain.BusinessDayAt(time.Now().In(s.location), s.cutoff)
+		day = domain.BusinessDayAt(current.Start.Add(-time.Nanosecond), s.cutoff)
+	} else {
+		var err error
+		if day, err = s.businessDay(businessDate); err != nil {
+			return nil, err
+		}
+	}
+
+	report, err := s.buildReport(ctx, day)
+	if err != nil {
+		return nil, err
+	}
+
+	actor := actorFromContext(ctx)
+	zReport, err := domain.NewZReport(report, actor, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.zReportRepo.Create(ctx, zReport); err != nil {
+		return nil, fmt.Errorf("failed to save z-report: %w", err)
+	}
+
+	log.Printf("Closed business day %s by %s: %d orders, net sales %.2f", day.Date, actor, report.Orders, report.NetSales)
+	return zReport, nil
+}
+
+// GetZReport retrieves the Z-report of a closed business day
+func (s *ReportService) GetZReport(ctx context.Context, businessDate string) (*domain.ZReport, error) {
+	if _, err := domain.ParseBusinessDate(businessDate); err != nil {
+		return nil, err
+	}
+
+	zReport, err := s.zReportRepo.GetByDate(ctx, businessDate)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get z-report: %w", err)
+	}
+	return zReport, nil
+}
+
+// ListZReports retrieves the most recent Z-reports, newest first
+func (s *ReportService) ListZReports(ctx context.Context, limit int) ([]*domain.ZReport, error) {
+	zReports, err := s.zReportRepo.List(ctx, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list z-reports: %w", err)
+	}
+	return zReports, nil
+}
+
+func (s *ReportService) businessDay(businessDate string) (domain.BusinessDay, error) {
+	if businessDate == "" {
+		return domain.BusinessDayAt(time.Now().In(s.location), s.cutoff), nil
+	}
+
+	date, err := domain.ParseBusinessDate(businessDate)
+	if err != nil {
+		return domain.BusinessDay{}, err
+	}
+	return domain.NewBusinessDay(date, s.cutoff, s.location), nil
+}
+
+func (s *ReportService) buildReport(ctx context.Context, day domain.BusinessDay) (*domain.SalesReport, error) {
+	// FindByDateRange includes its end; stop just short of the next business day
+	orders, err := s.orderRepo.FindByDateRange(ctx, day.Start, day.End.Add(-time.Microsecond))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get orders: %w", err)
+	}
+
+	orderIDs := make([]domain.OrderID, 0, len(orders))
+	for _, order := range orders {
+		if order.IsSettled() {
+			orderIDs = append(orderIDs, order.ID)
+		}
+	}
+
+	payments, err := s.paymentRepo.FindByOrderIDs(ctx, orderIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get payments: %w", err)
+	}
+
+	return domain.BuildSalesReport(day, orders, payments, time.Now()), nil
+}
@@ -0,0 +1,178 @@
+package application
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/restaurant-platform/order-service/internal/domain"
+	"github.com/restaurant-platform/shared/pkg/auth"
+	sharedErrors "github.com/restaurant-platform/shared/pkg/errors"
+)
+
+// MockZReportRepository is a mock implementation of ZReportRepository
+type MockZReportRepository struct {
+	mock.Mock
+}
+
+func (m *MockZReportRepository) Create(ctx context.Context, report *domain.ZReport) error {
+	args := m.Called(ctx, report)
+	return args.Error(0)
+}
+
+func (m *MockZReportRepository) GetByDate(ctx context.Context, businessDate string) (*domain.ZReport, error) {
+	args := m.Called(ctx, businessDate)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.ZReport), args.Error(1)
+}
+
+func (m *MockZReportRepository) List(ctx context.Context, limit int) ([]*domain.ZReport, error) {
+	args := m.Called(ctx, limit)
+	return args.Get(0).([]*domain.ZReport), args.Error(1)
+}
+
+// ReportServiceTestSuite contains all report service tests
+type ReportServiceTestSuite struct {
+	suite.Suite
+	service         *ReportService
+	mockOrderRepo   *MockOrderRepository
+	mockPaymentRepo *MockPaymentRepository
+	mockZReportRepo *MockZReportRepository
+	order           *domain.Order
+	payment         *domain.Payment
+	start           time.Time
+	end             time.Time
+	ctx             context.Context
+}
+
+func (suite *ReportServiceTestSuite) SetupTest() {
+	suite.mockOrderRepo = new(MockOrderRepository)
+	suite.mockPaymentRepo = new(MockPaymentRepository)
+	suite.mockZReportRepo = new(MockZReportRepository)
+	suite.service = NewReportService(suite.mockOrderRepo, suite.mockPaymentRepo, suite.mockZReportRepo, 4*time.Hour)
+	suite.ctx = context.Background()
+
+	suite.start = time.Date(2024, 3, 8, 4, 0, 0, 0, time.Local)
+	suite.end = time.Date(2024, 3, 9, 4, 0, 0, 0, time.Local).Add(-time.Microsecond)
+
+	suite.order, _ = domain.NewOrder("customer-123", domain.OrderTypeTakeout)
+	suite.order.AddItem("item-1", "Pizza", 2, 10.00, nil, "")
+	suite.order.CreatedAt = suite.start.Add(15 * time.Hour)
+	suite.order.UpdateStatus(domain.OrderStatusPaid, "cashier-1", "")
+
+	suite.payment, _ = domain.NewPayment(suite.order.ID, suite.order.TotalAmount)
+	suite.payment.AddTender(domain.TenderTypeCash, 0, 25.00, "", "")
+}
+
+func TestReportServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(ReportServiceTestSuite))
+}
+
+func (suite *ReportServiceTestSuite) expectDay() {
+	suite.mockOrderRepo.On("FindByDateRange", suite.ctx, suite.start, suite.end).Return([]*domain.Order{suite.order}, nil)
+	suite.mockPaymentRepo.On("FindByOrderIDs", suite.ctx, []domain.OrderID{suite.order.ID}).Return([]*domain.Payment{suite.payment}, nil)
+}
+
+// Test GetSalesReport
+func (suite *ReportServiceTestSuite) TestGetSalesReport_Success() {
+	// Given
+	suite.expectDay()
+
+	// When
+	report, err := suite.service.GetSalesReport(suite.ctx, "2024-03-08")
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	assert.Equal("2024-03-08", report.BusinessDate)
+	assert.Equal(1, report.Orders)
+	assert.Equal(20.00, report.NetSales)
+	assert.Equal(2.00, report.TaxCollected)
+	assert.Equal("cashier-1", report.ByStaff[0].Key)
+	assert.Equal(22.00, report.Tenders[0].Net)
+	suite.mockOrderRepo.AssertExpectations(suite.T())
+	suite.mockPaymentRepo.AssertExpectations(suite.T())
+}
+
+func (suite *ReportServiceTestSuite) TestGetSalesReport_InvalidDate_ShouldFail() {
+	// When
+	report, err := suite.service.GetSalesReport(suite.ctx, "yesterday")
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.Nil(report)
+	assert.True(sharedErrors.IsValidationError(err))
+	suite.mockOrderRepo.AssertNotCalled(suite.T(), "FindByDateRange", mock.Anything, mock.Anything, mock.Anything)
+}
+
+// Test CloseBusinessDay
+func (suite *ReportServiceTestSuite) TestCloseBusinessDay_Success() {
+	// Given
+	ctx := auth.WithActor(suite.ctx, "manager-1")
+	suite.mockOrderRepo.On("FindByDateRange", ctx, suite.start, suite.end).Return([]*domain.Order{suite.order}, nil)
+	suite.mockPaymentRepo.On("FindByOrderIDs", ctx, []domain.OrderID{suite.order.ID}).Return([]*domain.Payment{suite.payment}, nil)
+	suite.mockZReportRepo.On("Create", ctx, mock.AnythingOfType("*domain.ZReport")).Return(nil)
+
+	// When
+	zReport, err := suite.service.CloseBusinessDay(ctx, "2024-03-08")
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	assert.Equal("2024-03-08", zReport.BusinessDate)
+	assert.Equal("manager-1", zReport.ClosedBy)
+	assert.Equal(20.00, zReport.Report.NetSales)
+	suite.mockZReportRepo.AssertExpectations(suite.T())
+}
+
+func (suite *ReportServiceTestSuite) TestCloseBusinessDay_AlreadyClosed_ShouldFail() {
+	// Given
+	suite.expectDay()
+	suite.mockZReportRepo.On("Create", suite.ctx, mock.AnythingOfType("*domain.ZReport")).
+		Return(sharedErrors.WrapConflict("ZReportRepository.Create", "business_date", "business day 2024-03-08 is already closed", nil))
+
+	// When
+	zReport, err := suite.service.CloseBusinessDay(suite.ctx, "2024-03-08")
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.Nil(zReport)
+	assert.True(sharedErrors.IsConflictError(err))
+}
+
+func (suite *ReportServiceTestSuite) TestCloseBusinessDay_CurrentDay_ShouldFail() {
+	// Given
+	suite.mockOrderRepo.On("FindByDateRange", suite.ctx, mock.Anything, mock.Anything).Return([]*domain.Order{}, nil)
+	suite.mockPaymentRepo.On("FindByOrderIDs", suite.ctx, []domain.OrderID{}).Return([]*domain.Payment{}, nil)
+	today := domain.BusinessDayAt(time.Now(), 4*time.Hour).Date
+
+	// When
+	zReport, err := suite.service.CloseBusinessDay(suite.ctx, today)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.Nil(zReport)
+	assert.True(sharedErrors.IsConflictError(err))
+	suite.mockZReportRepo.AssertNotCalled(suite.T(), "Create", mock.Anything, mock.Anything)
+}
+
+// Test GetZReport
+func (suite *ReportServiceTestSuite) TestGetZReport_NotClosed_ShouldReturnNotFound() {
+	// Given
+	suite.mockZReportRepo.On("GetByDate", suite.ctx, "2024-03-08").
+		Return(nil, sharedErrors.WrapNotFound("ZReportRepository.GetByDate", "z_report", "2024-03-08", sharedErrors.ErrNotFound))
+
+	// When
+	zReport, err := suite.service.GetZReport(suite.ctx, "2024-03-08")
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.Nil(zReport)
+	assert.True(sharedErrors.IsNotFound(err))
+}
@@ -0,0 +1,74 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/restaurant-platform/order-service/internal/domain"
+	"github.com/restaurant-platform/shared/events"
+)
+
+// ReservationEventHandler keeps the local reservation read model current from reservation events
+type ReservationEventHandler struct {
+	reservationRepo domain.ReservationRepository
+}
+
+// NewReservationEventHandler creates a new reservation event handler
+func NewReservationEventHandler(reservationRepo domain.ReservationRepository) *ReservationEventHandler {
+	return &ReservationEventHandler{
+		reservationRepo: reservationRepo,
+	}
+}
+
+// reservationEventData covers both the created and the status changed reservation events
+type reservationEventData struct {
+	ReservationID string `json:"reservation_id"`
+	TableID       string `json:"table_id"`
+	PartySize     int    `json:"party_size"`
+	DateTime      string `json:"date_time"`
+	Status        string `json:"status"`
+	NewStatus     string `json:"new_status"`
+}
+
+// HandleReservationEvent stores the reservation carried by a reservation event
+func (h *ReservationEventHandler) HandleReservationEvent(ctx context.Context, event *events.DomainEvent) error {
+	switch event.Type {
+	case events.ReservationCreatedEvent, events.ReservationConfirmedEvent, events.ReservationCancelledEvent,
+		events.ReservationCompletedEvent, events.ReservationNoShowEvent, events.ReservationUpdatedEvent:
+	default:
+		log.Printf("Unhandled reservation event type: %s", event.Type)
+		return nil
+	}
+
+	var eventData reservationEventData
+	if err := decodeEventData(event, &eventData); err != nil {
+		return err
+	}
+
+	dateTime, err := time.Parse(time.RFC3339, eventData.DateTime)
+	if err != nil {
+		return fmt.Errorf("invalid reservation date time %q: %w", eventData.DateTime, err)
+	}
+	status := eventData.NewStatus
+	if status == "" {
+		status = eventData.Status
+	}
+
+	reservation := &domain.Reservation{
+		ID:        eventData.ReservationID,
+		TableID:   eventData.TableID,
+		PartySize: eventData.PartySize,
+		DateTime:  dateTime,
+		Status:    domain.ReservationStatus(status),
+		UpdatedAt: event.OccurredAt,
+	}
+	if err := h.reservationRepo.Upsert(ctx, reservation); err != nil {
+		log.Printf("Failed to store reservation %s: %v", reservation.ID, err)
+		return err
+	}
+
+	log.Printf("Reservation %s for %d at table %s stored as %s", reservation.ID, reservation.PartySize, reservation.TableID, reservation.Status)
+	return nil
+}
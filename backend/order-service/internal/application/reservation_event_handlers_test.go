@@ -0,0 +1,98 @@
+package application
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/restaurant-platform/order-service/internal/domain"
+	"github.com/restaurant-platform/shared/events"
+)
+
+// MockReservationRepository is a mock implementation of ReservationRepository
+type MockReservationRepository struct {
+	mock.Mock
+}
+
+func (m *MockReservationRepository) GetByID(ctx context.Context, id string) (*domain.Reservation, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Reservation), args.Error(1)
+}
+
+func (m *MockReservationRepository) FindSeatable(ctx context.Context, tableID string, at time.Time) (*domain.Reservation, error) {
+	args := m.Called(ctx, tableID, at)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Reservation), args.Error(1)
+}
+
+func (m *MockReservationRepository) Upsert(ctx context.Context, reservation *domain.Reservation) error {
+	args := m.Called(ctx, reservation)
+	return args.Error(0)
+}
+
+// ReservationEventHandlerTestSuite contains reservation read model projection tests
+type ReservationEventHandlerTestSuite struct {
+	suite.Suite
+	handler         *ReservationEventHandler
+	mockReservation *MockReservationRepository
+	ctx             context.Context
+}
+
+func (suite *ReservationEventHandlerTestSuite) SetupTest() {
+	suite.mockReservation = new(MockReservationRepository)
+	suite.handler = NewReservationEventHandler(suite.mockReservation)
+	suite.ctx = context.Background()
+}
+
+func TestReservationEventHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(ReservationEventHandlerTestSuite))
+}
+
+func (suite *ReservationEventHandlerTestSuite) TestReservationCreated_StoresReservation() {
+	// Given
+	eventData, _ := events.ToEventData(events.ReservationCreatedData{
+		ReservationID: "res-1", TableID: "table-4", PartySize: 6,
+		DateTime: "2030-05-01T19:30:00Z", Status: "PENDING",
+	})
+	event := events.NewDomainEvent(events.ReservationCreatedEvent, "res-1", eventData)
+	suite.mockReservation.On("Upsert", suite.ctx, mock.MatchedBy(func(reservation *domain.Reservation) bool {
+		return reservation.ID == "res-1" && reservation.PartySize == 6 &&
+			reservation.Status == domain.ReservationStatusPending &&
+			reservation.DateTime.Equal(time.Date(2030, 5, 1, 19, 30, 0, 0, time.UTC))
+	})).Return(nil)
+
+	// When
+	err := suite.handler.HandleReservationEvent(suite.ctx, event)
+
+	// Then
+	assert.New(suite.T()).NoError(err)
+	suite.mockReservation.AssertExpectations(suite.T())
+}
+
+func (suite *ReservationEventHandlerTestSuite) TestReservationConfirmed_TakesNewStatus() {
+	// Given
+	eventData, _ := events.ToEventData(events.ReservationStatusChangedData{
+		ReservationID: "res-1", TableID: "table-4", PartySize: 6,
+		DateTime: "2030-05-01T19:30:00Z", OldStatus: "PENDING", NewStatus: "CONFIRMED",
+	})
+	event := events.NewDomainEvent(events.ReservationConfirmedEvent, "res-1", eventData)
+	suite.mockReservation.On("Upsert", suite.ctx, mock.MatchedBy(func(reservation *domain.Reservation) bool {
+		return reservation.Status == domain.ReservationStatusConfirmed && reservation.UpdatedAt.Equal(event.OccurredAt)
+	})).Return(nil)
+
+	// When
+	err := suite.handler.HandleReservationEvent(suite.ctx, event)
+
+	// Then
+	assert.New(suite.T()).NoError(err)
+	suite.mockReservation.AssertExpectations(suite.T())
+}
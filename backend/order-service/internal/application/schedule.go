@@ -0,0 +1,132 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/restaurant-platform/order-service/internal/domain"
+	"github.com/restaurant-platform/shared/events"
+)
+
+// releaseLookahead bounds how far ahead the scheduler looks for scheduled orders.
+// It only needs to cover the longest prep time plus the release buffer.
+const releaseLookahead = 24 * time.Hour
+
+// RescheduleOrder moves the fulfillment time of a scheduled order that has not been released
+func (s *OrderService) RescheduleOrder(ctx context.Context, orderID domain.OrderID, fulfillmentTime time.Time) (*domain.Order, error) {
+	order, err := modifyOrder(ctx, s.orderRepo, orderID, func(order *domain.Order) error {
+		return order.Reschedule(fulfillmentTime, time.Now())
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	log.Printf("Rescheduled order %s for %s", orderID, fulfillmentTime.Format(time.RFC3339))
+	return order, nil
+}
+
+// GetScheduledOrders returns scheduled orders awaiting release with a fulfillment time in the range,
+// each with the time it is due to go to the kitchen
+func (s *OrderService) GetScheduledOrders(ctx context.Context, from, to time.Time) ([]*domain.ScheduledOrder, error) {
+	orders, err := s.orderRepo.FindScheduled(ctx, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get scheduled orders: %w", err)
+	}
+
+	scheduled := make([]*domain.ScheduledOrder, len(orders))
+	for i, order := range orders {
+		prepTime := s.estimatePrepTime(ctx, order)
+		scheduled[i] = &domain.ScheduledOrder{
+			Order:     order,
+			PrepTime:  prepTime,
+			ReleaseAt: order.ReleaseTime(prepTime, domain.DefaultReleaseBuffer),
+		}
+	}
+
+	return scheduled, nil
+}
+
+// ReleaseDueOrders releases every scheduled order whose release time has passed and
+// publishes an OrderReleasedEvent for each. It returns the number of orders released.
+func (s *OrderService) ReleaseDueOrders(ctx context.Context, now time.Time) (int, error) {
+	orders, err := s.orderRepo.FindScheduled(ctx, time.Time{}, now.Add(releaseLookahead))
+	if err != nil {
+		return 0, fmt.Errorf("failed to get scheduled orders: %w", err)
+	}
+
+	released := 0
+	for _, order := range orders {
+		prepTime := s.estimatePrepTime(ctx, order)
+		if !order.IsDueForRelease(prepTime, domain.DefaultReleaseBuffer, now) {
+			continue
+		}
+
+		if err := s.releaseOrder(ctx, order, now); err != nil {
+			log.Printf("Failed to release scheduled order %s: %v", order.ID, err)
+			continue
+		}
+		released++
+	}
+
+	return released, nil
+}
+
+// releaseOrder marks a scheduled order as released and publishes an OrderReleasedEvent
+func (s *OrderService) releaseOrder(ctx context.Context, order *domain.Order, now time.Time) error {
+	order, err := applyOrderChange(ctx, s.orderRepo, order.ID, order, func(order *domain.Order) error {
+		return order.Release(now)
+	})
+	if err != nil {
+		return err
+	}
+
+	log.Printf("Released scheduled order %s to the kitchen for %s", order.ID, order.FulfillmentTime.Format(time.RFC3339))
+
+	eventData, err := events.ToEventData(events.OrderReleasedData{
+		OrderID:         string(order.ID),
+		CustomerID:      order.CustomerID,
+		TableID:         order.TableID,
+		OrderType:       string(order.Type),
+		Status:          string(order.Status),
+		FulfillmentTime: *order.FulfillmentTime,
+		ReleasedAt:      now,
+		Items:           toOrderLineData(order),
+	})
+	if err != nil {
+		log.Printf("Failed to convert event data to map: %v", err)
+		return fmt.Errorf("failed to convert event data: %w", err)
+	}
+
+	event := events.NewDomainEvent(events.OrderReleasedEvent, string(order.ID), eventData).
+		WithMetadata("service", "order-service").
+		WithMetadata("customer_id", order.CustomerID)
+
+	if err := s.eventPublisher.Publish(ctx, event); err != nil {
+		log.Printf("Failed to publish order released event: %v", err)
+	}
+
+	return nil
+}
+
+// estimatePrepTime returns the longest prep time among the order's items, looked up in the
+// menu read model. Items are prepared in parallel, so the slowest one sets the pace.
+func (s *OrderService) estimatePrepTime(ctx context.Context, order *domain.Order) time.Duration {
+	var prepTime time.Duration
+	for _, item := range order.Items {
+		if item.IsVoided() {
+			continue
+		}
+
+		menuItem, err := s.menuItemRepo.GetByID(ctx, item.MenuItemID)
+		if err != nil {
+			log.Printf("Failed to get prep time for menu item %s: %v", item.MenuItemID, err)
+			continue
+		}
+		if menuItem.PrepTime > prepTime {
+			prepTime = menuItem.PrepTime
+		}
+	}
+	return prepTime
+}
@@ -0,0 +1,145 @@
+package application
+
+import (
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/restaurant-platform/order-service/internal/domain"
+	"github.com/restaurant-platform/shared/events"
+	sharedErrors "github.com/restaurant-platform/shared/pkg/errors"
+)
+
+func scheduledOrder(fulfillmentTime time.Time, menuItemID string) *domain.Order {
+	order, _ := domain.NewScheduledOrder("customer-123", domain.OrderTypeTakeout, fulfillmentTime, fulfillmentTime.Add(-48*time.Hour))
+	_ = order.AddItem(menuItemID, "Lasagne tray", 1, 60.00, nil, "")
+	return order
+}
+
+// Test CreateScheduledOrder
+func (suite *OrderServiceTestSuite) TestCreateScheduledOrder_PublishesFulfillmentTime() {
+	// Given
+	fulfillmentTime := time.Now().Add(30 * time.Hour).UTC().Truncate(time.Second)
+
+	suite.mockRepo.On("Create", suite.ctx, mock.AnythingOfType("*domain.Order")).Return(nil)
+	suite.mockPublisher.On("Publish", suite.ctx, mock.MatchedBy(func(event *events.DomainEvent) bool {
+		return event.Type == events.OrderCreatedEvent &&
+			event.Data["fulfillment_time"] == fulfillmentTime.Format(time.RFC3339)
+	})).Return(nil)
+
+	// When
+	order, err := suite.service.CreateScheduledOrder(suite.ctx, "customer-123", domain.OrderTypeTakeout, fulfillmentTime)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	assert.True(order.IsAwaitingRelease())
+	suite.mockPublisher.AssertExpectations(suite.T())
+}
+
+func (suite *OrderServiceTestSuite) TestCreateScheduledOrder_PastTime_ShouldFail() {
+	// When
+	order, err := suite.service.CreateScheduledOrder(suite.ctx, "customer-123", domain.OrderTypeTakeout, time.Now().Add(-time.Hour))
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.Nil(order)
+	assert.True(sharedErrors.IsValidationError(err))
+	suite.mockRepo.AssertNotCalled(suite.T(), "Create", mock.Anything, mock.Anything)
+}
+
+// Test RescheduleOrder
+func (suite *OrderServiceTestSuite) TestRescheduleOrder_Success() {
+	// Given
+	order := scheduledOrder(time.Now().Add(30*time.Hour), "lasagne-1")
+	later := order.FulfillmentTime.Add(2 * time.Hour)
+
+	suite.mockRepo.On("GetByID", suite.ctx, order.ID).Return(order, nil)
+	suite.mockRepo.On("Update", suite.ctx, order).Return(nil)
+
+	// When
+	result, err := suite.service.RescheduleOrder(suite.ctx, order.ID, later)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	assert.Equal(later, *result.FulfillmentTime)
+	suite.mockRepo.AssertExpectations(suite.T())
+}
+
+// Test GetScheduledOrders
+func (suite *OrderServiceTestSuite) TestGetScheduledOrders_IncludesReleaseTime() {
+	// Given
+	from := time.Now()
+	to := from.Add(48 * time.Hour)
+	order := scheduledOrder(from.Add(30*time.Hour), "lasagne-1")
+	menuItem := testMenuItem("lasagne-1", "Lasagne tray", 60.00)
+	menuItem.PrepTime = 50 * time.Minute
+
+	suite.mockRepo.On("FindScheduled", suite.ctx, from, to).Return([]*domain.Order{order}, nil)
+	suite.mockMenuRepo.On("GetByID", suite.ctx, "lasagne-1").Return(menuItem, nil)
+
+	// When
+	scheduled, err := suite.service.GetScheduledOrders(suite.ctx, from, to)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	assert.Len(scheduled, 1)
+	assert.Equal(50*time.Minute, scheduled[0].PrepTime)
+	assert.Equal(order.FulfillmentTime.Add(-time.Hour), scheduled[0].ReleaseAt)
+}
+
+// Test ReleaseDueOrders
+func (suite *OrderServiceTestSuite) TestReleaseDueOrders_ReleasesOnlyDueOrders() {
+	// Given
+	now := time.Now()
+	due := scheduledOrder(now.Add(30*time.Minute), "lasagne-1")
+	notDue := scheduledOrder(now.Add(3*time.Hour), "lasagne-1")
+	menuItem := testMenuItem("lasagne-1", "Lasagne tray", 60.00)
+	menuItem.PrepTime = 25 * time.Minute
+
+	suite.mockRepo.On("FindScheduled", suite.ctx, time.Time{}, now.Add(releaseLookahead)).Return([]*domain.Order{due, notDue}, nil)
+	suite.mockMenuRepo.On("GetByID", suite.ctx, "lasagne-1").Return(menuItem, nil)
+	suite.mockRepo.On("Update", suite.ctx, due).Return(nil)
+	suite.mockPublisher.On("Publish", suite.ctx, mock.MatchedBy(func(event *events.DomainEvent) bool {
+		items, _ := event.Data["items"].([]interface{})
+		if event.Type != events.OrderReleasedEvent || event.AggregateID != string(due.ID) || len(items) != 1 {
+			return false
+		}
+		line, _ := items[0].(map[string]interface{})
+		return line["fired"] == true
+	})).Return(nil)
+
+	// When
+	released, err := suite.service.ReleaseDueOrders(suite.ctx, now)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	assert.Equal(1, released)
+	assert.NotNil(due.ReleasedAt)
+	assert.Nil(notDue.ReleasedAt)
+	suite.mockRepo.AssertNotCalled(suite.T(), "Update", suite.ctx, notDue)
+	suite.mockPublisher.AssertExpectations(suite.T())
+}
+
+func (suite *OrderServiceTestSuite) TestReleaseDueOrders_UpdateError_SkipsOrder() {
+	// Given
+	now := time.Now()
+	due := scheduledOrder(now.Add(5*time.Minute), "lasagne-1")
+
+	suite.mockRepo.On("FindScheduled", suite.ctx, time.Time{}, now.Add(releaseLookahead)).Return([]*domain.Order{due}, nil)
+	suite.mockMenuRepo.On("GetByID", suite.ctx, "lasagne-1").Return(testMenuItem("lasagne-1", "Lasagne tray", 60.00), nil)
+	suite.mockRepo.On("Update", suite.ctx, due).Return(assert.AnError)
+
+	// When
+	released, err := suite.service.ReleaseDueOrders(suite.ctx, now)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	assert.Zero(released)
+	suite.mockPublisher.AssertNotCalled(suite.T(), "Publish", mock.Anything, mock.Anything)
+}
@@ -4,21 +4,27 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/restaurant-platform/order-service/internal/domain"
 	"github.com/restaurant-platform/shared/events"
+	"github.com/restaurant-platform/shared/pkg/auth"
+	"github.com/restaurant-platform/shared/pkg/concurrency"
+	"github.com/restaurant-platform/shared/pkg/errors"
 )
 
 // OrderService implements the order business logic
 type OrderService struct {
 	orderRepo      domain.OrderRepository
+	menuItemRepo   domain.MenuItemRepository
 	eventPublisher events.EventPublisher
 }
 
 // NewOrderService creates a new order service
-func NewOrderService(orderRepo domain.OrderRepository, eventPublisher events.EventPublisher) *OrderService {
+func NewOrderService(orderRepo domain.OrderRepository, menuItemRepo domain.MenuItemRepository, eventPublisher events.EventPublisher) *OrderService {
 	return &OrderService{
 		orderRepo:      orderRepo,
+		menuItemRepo:   menuItemRepo,
 		eventPublisher: eventPublisher,
 	}
 }
@@ -30,20 +36,38 @@ func (s *OrderService) CreateOrder(ctx context.Context, customerID string, order
 		return nil, fmt.Errorf("failed to create order: %w", err)
 	}
 
+	return s.saveNewOrder(ctx, order)
+}
+
+// CreateScheduledOrder creates an order for a future fulfillment time. The kitchen does not
+// receive it until the scheduler releases it.
+func (s *OrderService) CreateScheduledOrder(ctx context.Context, customerID string, orderType domain.OrderType, fulfillmentTime time.Time) (*domain.Order, error) {
+	order, err := domain.NewScheduledOrder(customerID, orderType, fulfillmentTime, time.Now())
+	if err != nil {
+		return nil, fmt.Errorf("failed to create order: %w", err)
+	}
+
+	return s.saveNewOrder(ctx, order)
+}
+
+// saveNewOrder persists a new order and publishes an OrderCreatedEvent
+func (s *OrderService) saveNewOrder(ctx context.Context, order *domain.Order) (*domain.Order, error) {
 	if err := s.orderRepo.Create(ctx, order); err != nil {
 		return nil, fmt.Errorf("failed to save order: %w", err)
 	}
 
-	log.Printf("Created order: %s for customer: %s", order.ID, customerID)
+	log.Printf("Created order: %s for customer: %s", order.ID, order.CustomerID)
 
 	// Publish OrderCreatedEvent
 	eventData, err := events.ToEventData(events.OrderCreatedData{
-		OrderID:     string(order.ID),
-		CustomerID:  order.CustomerID,
-		TableID:     order.TableID,
-		OrderType:   string(order.Type),
-		TotalAmount: order.TotalAmount,
-		Status:      string(order.Status),
+		OrderID:         string(order.ID),
+		CustomerID:      order.CustomerID,
+		TableID:         order.TableID,
+		OrderType:       string(order.Type),
+		TotalAmount:     order.TotalAmount,
+		Status:          string(order.Status),
+		FulfillmentTime: order.FulfillmentTime,
+		Items:           toOrderLineData(order),
 	})
 
 	if err != nil {
@@ -53,7 +77,7 @@ func (s *OrderService) CreateOrder(ctx context.Context, customerID string, order
 
 	event := events.NewDomainEvent(events.OrderCreatedEvent, string(order.ID), eventData).
 		WithMetadata("service", "order-service").
-		WithMetadata("customer_id", customerID)
+		WithMetadata("customer_id", order.CustomerID)
 
 	if err := s.eventPublisher.Publish(ctx, event); err != nil {
 		log.Printf("Failed to publish order created event: %v", err)
@@ -67,88 +91,292 @@ func (s *OrderService) GetOrderByID(ctx context.Context, id domain.OrderID) (*do
 	return s.orderRepo.GetByID(ctx, id)
 }
 
-// AddItemToOrder adds an item to an existing order
-func (s *OrderService) AddItemToOrder(ctx context.Context, orderID domain.OrderID, menuItemID, name string, quantity int, unitPrice float64, modifications []string, notes string) error {
-	order, err := s.orderRepo.GetByID(ctx, orderID)
+// AddItemToOrder adds a menu item to an existing order.
+// The name, price and modifier prices are resolved from the menu read model and snapshotted onto the order line.
+// Seat 0 shares the item with the table.
+func (s *OrderService) AddItemToOrder(ctx context.Context, orderID domain.OrderID, menuItemID string, quantity, course, seat int, modifiers []domain.ModifierSelection, modifications []string, notes string) error {
+	var menuItem *domain.MenuItem
+	order, err := modifyOrder(ctx, s.orderRepo, orderID, func(order *domain.Order) error {
+		var err error
+		menuItem, err = s.resolveMenuItem(ctx, menuItemID)
+		if err != nil {
+			return err
+		}
+
+		resolved, err := menuItem.ResolveModifiers(modifiers)
+		if err != nil {
+			return err
+		}
+
+		if err := order.AddCourseItem(course, menuItem.ID, menuItem.Name, quantity, menuItem.Price, resolved, modifications, notes); err != nil {
+			return fmt.Errorf("failed to add item to order: %w", err)
+		}
+		item := order.Items[len(order.Items)-1]
+		// Snapshot the category so sales reports don't depend on later menu changes
+		item.Category = menuItem.CategoryName
+		if seat != domain.SharedSeat {
+			if _, err := order.AssignSeat(item.ID, seat); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
 	if err != nil {
-		return fmt.Errorf("failed to get order: %w", err)
+		return err
 	}
 
-	if err := order.AddItem(menuItemID, name, quantity, unitPrice, modifications, notes); err != nil {
-		return fmt.Errorf("failed to add item to order: %w", err)
+	log.Printf("Added item %s at %.2f to order: %s", menuItem.Name, menuItem.Price, orderID)
+
+	s.publishItemAdded(ctx, order, order.Items[len(order.Items)-1])
+	return nil
+}
+
+// publishItemAdded publishes an OrderItemAddedEvent so the kitchen can keep the ticket in step
+// with the order, or ticket an amendment once the order has been sent
+func (s *OrderService) publishItemAdded(ctx context.Context, order *domain.Order, item *domain.OrderItem) {
+	eventData, err := events.ToEventData(events.OrderItemAddedData{
+		OrderID:       string(order.ID),
+		TableID:       order.TableID,
+		ItemID:        string(item.ID),
+		MenuItemID:    item.MenuItemID,
+		Name:          item.Name,
+		Quantity:      item.Quantity,
+		Course:        item.Course,
+		Seat:          item.Seat,
+		Modifiers:     toOrderItemModifierData(item),
+		Modifications: item.Modifications,
+		Notes:         item.Notes,
+	})
+	if err != nil {
+		log.Printf("Failed to convert event data to map: %v", err)
+		return
+	}
+
+	event := events.NewDomainEvent(events.OrderItemAddedEvent, string(order.ID), eventData).
+		WithMetadata("service", "order-service").
+		WithMetadata("customer_id", order.CustomerID)
+
+	if err := s.eventPublisher.Publish(ctx, event); err != nil {
+		log.Printf("Failed to publish order item added event: %v", err)
+	}
+}
+
+// VoidItem voids an item on an order already sent to the kitchen and publishes an OrderItemVoidedEvent
+func (s *OrderService) VoidItem(ctx context.Context, orderID domain.OrderID, itemID domain.OrderItemID, reason string) error {
+	var item *domain.OrderItem
+	order, err := modifyOrder(ctx, s.orderRepo, orderID, func(order *domain.Order) error {
+		var err error
+		item, err = order.VoidItem(itemID, reason)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	log.Printf("Voided item %s from order %s: %s", itemID, orderID, reason)
+
+	eventData, err := events.ToEventData(events.OrderItemVoidedData{
+		OrderID:    string(order.ID),
+		TableID:    order.TableID,
+		ItemID:     string(item.ID),
+		MenuItemID: item.MenuItemID,
+		Name:       item.Name,
+		Quantity:   item.Quantity,
+		Reason:     reason,
+	})
+	if err != nil {
+		log.Printf("Failed to convert event data to map: %v", err)
+		return fmt.Errorf("failed to convert event data: %w", err)
 	}
 
-	if err := s.orderRepo.Update(ctx, order); err != nil {
-		return fmt.Errorf("failed to update order: %w", err)
+	event := events.NewDomainEvent(events.OrderItemVoidedEvent, string(order.ID), eventData).
+		WithMetadata("service", "order-service").
+		WithMetadata("customer_id", order.CustomerID)
+
+	if err := s.eventPublisher.Publish(ctx, event); err != nil {
+		log.Printf("Failed to publish order item voided event: %v", err)
 	}
 
-	log.Printf("Added item %s to order: %s", name, orderID)
 	return nil
 }
 
-// RemoveItemFromOrder removes an item from an order
-func (s *OrderService) RemoveItemFromOrder(ctx context.Context, orderID domain.OrderID, itemID domain.OrderItemID) error {
-	order, err := s.orderRepo.GetByID(ctx, orderID)
+// FireCourse releases a held course to the kitchen and publishes an OrderCourseFiredEvent
+func (s *OrderService) FireCourse(ctx context.Context, orderID domain.OrderID, course int) (*domain.Order, error) {
+	var fired []*domain.OrderItem
+	order, err := modifyOrder(ctx, s.orderRepo, orderID, func(order *domain.Order) error {
+		var err error
+		fired, err = order.FireCourse(course)
+		return err
+	})
 	if err != nil {
-		return fmt.Errorf("failed to get order: %w", err)
+		return nil, err
 	}
 
-	if err := order.RemoveItem(itemID); err != nil {
-		return fmt.Errorf("failed to remove item from order: %w", err)
+	log.Printf("Fired course %d of order %s (%d items)", course, orderID, len(fired))
+
+	menuItemIDs := make([]string, len(fired))
+	for i, item := range fired {
+		menuItemIDs[i] = item.MenuItemID
 	}
 
-	if err := s.orderRepo.Update(ctx, order); err != nil {
-		return fmt.Errorf("failed to update order: %w", err)
+	eventData, err := events.ToEventData(events.OrderCourseFiredData{
+		OrderID:     string(order.ID),
+		TableID:     order.TableID,
+		Course:      course,
+		MenuItemIDs: menuItemIDs,
+	})
+	if err != nil {
+		log.Printf("Failed to convert event data to map: %v", err)
+		return nil, fmt.Errorf("failed to convert event data: %w", err)
 	}
 
-	log.Printf("Removed item %s from order: %s", itemID, orderID)
-	return nil
+	event := events.NewDomainEvent(events.OrderCourseFiredEvent, string(order.ID), eventData).
+		WithMetadata("service", "order-service").
+		WithMetadata("customer_id", order.CustomerID)
+
+	if err := s.eventPublisher.Publish(ctx, event); err != nil {
+		log.Printf("Failed to publish order course fired event: %v", err)
+	}
+
+	return order, nil
 }
 
-// UpdateItemQuantity updates the quantity of an item in an order
-func (s *OrderService) UpdateItemQuantity(ctx context.Context, orderID domain.OrderID, itemID domain.OrderItemID, quantity int) error {
-	order, err := s.orderRepo.GetByID(ctx, orderID)
+// resolveMenuItem looks up a menu item and checks it can currently be ordered
+func (s *OrderService) resolveMenuItem(ctx context.Context, menuItemID string) (*domain.MenuItem, error) {
+	if menuItemID == "" {
+		return nil, errors.WrapValidation("AddItemToOrder", "menuItemID", "menu item ID is required", nil)
+	}
+
+	menuItem, err := s.menuItemRepo.GetByID(ctx, menuItemID)
 	if err != nil {
-		return fmt.Errorf("failed to get order: %w", err)
+		if errors.IsNotFound(err) {
+			return nil, errors.WrapValidation("AddItemToOrder", "menuItemID", "unknown menu item "+menuItemID, nil)
+		}
+		return nil, fmt.Errorf("failed to get menu item: %w", err)
 	}
 
-	if err := order.UpdateItemQuantity(itemID, quantity); err != nil {
-		return fmt.Errorf("failed to update item quantity: %w", err)
+	if err := menuItem.EnsureOrderable(); err != nil {
+		return nil, err
 	}
 
-	if err := s.orderRepo.Update(ctx, order); err != nil {
-		return fmt.Errorf("failed to update order: %w", err)
+	return menuItem, nil
+}
+
+// RemoveItemFromOrder removes an item from an order and publishes an OrderItemRemovedEvent
+func (s *OrderService) RemoveItemFromOrder(ctx context.Context, orderID domain.OrderID, itemID domain.OrderItemID) error {
+	var removed *domain.OrderItem
+	order, err := modifyOrder(ctx, s.orderRepo, orderID, func(order *domain.Order) error {
+		removed = findOrderItem(order, itemID)
+		if err := order.RemoveItem(itemID); err != nil {
+			return fmt.Errorf("failed to remove item from order: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
 	}
 
-	log.Printf("Updated item %s quantity to %d in order: %s", itemID, quantity, orderID)
+	log.Printf("Removed item %s from order: %s", itemID, orderID)
+
+	eventData, err := events.ToEventData(events.OrderItemRemovedData{
+		OrderID:    string(order.ID),
+		TableID:    order.TableID,
+		ItemID:     string(removed.ID),
+		MenuItemID: removed.MenuItemID,
+		Name:       removed.Name,
+	})
+	if err != nil {
+		log.Printf("Failed to convert event data to map: %v", err)
+		return nil
+	}
+
+	event := events.NewDomainEvent(events.OrderItemRemovedEvent, string(order.ID), eventData).
+		WithMetadata("service", "order-service").
+		WithMetadata("customer_id", order.CustomerID)
+
+	if err := s.eventPublisher.Publish(ctx, event); err != nil {
+		log.Printf("Failed to publish order item removed event: %v", err)
+	}
 	return nil
 }
 
-// UpdateOrderStatus changes the status of an order
-func (s *OrderService) UpdateOrderStatus(ctx context.Context, orderID domain.OrderID, status domain.OrderStatus) error {
-	order, err := s.orderRepo.GetByID(ctx, orderID)
+// UpdateItemQuantity updates the quantity of an item in an order and publishes an OrderItemUpdatedEvent
+func (s *OrderService) UpdateItemQuantity(ctx context.Context, orderID domain.OrderID, itemID domain.OrderItemID, quantity int) error {
+	var updated *domain.OrderItem
+	var oldQuantity int
+	order, err := modifyOrder(ctx, s.orderRepo, orderID, func(order *domain.Order) error {
+		updated = findOrderItem(order, itemID)
+		if updated != nil {
+			oldQuantity = updated.Quantity
+		}
+		if err := order.UpdateItemQuantity(itemID, quantity); err != nil {
+			return fmt.Errorf("failed to update item quantity: %w", err)
+		}
+		return nil
+	})
 	if err != nil {
-		return fmt.Errorf("failed to get order: %w", err)
+		return err
 	}
 
-	previousStatus := order.Status
+	log.Printf("Updated item %s quantity to %d in order: %s", itemID, quantity, orderID)
 
-	if err := order.UpdateStatus(status); err != nil {
-		return fmt.Errorf("failed to update order status: %w", err)
+	eventData, err := events.ToEventData(events.OrderItemUpdatedData{
+		OrderID:     string(order.ID),
+		TableID:     order.TableID,
+		ItemID:      string(updated.ID),
+		Name:        updated.Name,
+		OldQuantity: oldQuantity,
+		Quantity:    updated.Quantity,
+	})
+	if err != nil {
+		log.Printf("Failed to convert event data to map: %v", err)
+		return nil
 	}
 
-	if err := s.orderRepo.Update(ctx, order); err != nil {
-		return fmt.Errorf("failed to update order: %w", err)
+	event := events.NewDomainEvent(events.OrderItemUpdatedEvent, string(order.ID), eventData).
+		WithMetadata("service", "order-service").
+		WithMetadata("customer_id", order.CustomerID)
+
+	if err := s.eventPublisher.Publish(ctx, event); err != nil {
+		log.Printf("Failed to publish order item updated event: %v", err)
 	}
+	return nil
+}
 
-	log.Printf("Updated order %s status from %s to %s", orderID, previousStatus, status)
+// UpdateOrderStatus changes the status of an order on behalf of the actor on the context.
+// Orders only become PAID by settling their payment, so that every paid order has its tenders.
+func (s *OrderService) UpdateOrderStatus(ctx context.Context, orderID domain.OrderID, status domain.OrderStatus, reason string) error {
+	if status == domain.OrderStatusPaid {
+		return errors.WrapValidation("UpdateOrderStatus", "status", "orders are paid by settling a payment", nil)
+	}
+
+	actor := actorFromContext(ctx)
+
+	var previousStatus domain.OrderStatus
+	order, err := modifyOrder(ctx, s.orderRepo, orderID, func(order *domain.Order) error {
+		previousStatus = order.Status
+		// Cancelling a paid order would keep the customer's money; it is refunded instead
+		if status == domain.OrderStatusCancelled && order.IsSettled() {
+			return errors.WrapConflict("UpdateOrderStatus", "status", "paid orders cannot be cancelled; refund them instead", nil)
+		}
+		if err := order.UpdateStatus(status, actor, reason); err != nil {
+			return fmt.Errorf("failed to update order status: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	log.Printf("Updated order %s status from %s to %s by %s", orderID, previousStatus, status, actor)
 
 	// Publish OrderStatusChangedEvent
 	eventData, err := events.ToEventData(events.OrderStatusChangedData{
 		OrderID:   string(order.ID),
 		OldStatus: string(previousStatus),
 		NewStatus: string(status),
-		UpdatedBy: "order-service",
+		UpdatedBy: actor,
+		Reason:    reason,
 	})
 
 	if err != nil {
@@ -158,8 +386,6 @@ func (s *OrderService) UpdateOrderStatus(ctx context.Context, orderID domain.Ord
 
 	var eventType events.EventType
 	switch status {
-	case domain.OrderStatusPaid:
-		eventType = events.OrderPaidEvent
 	case domain.OrderStatusCancelled:
 		eventType = events.OrderCancelledEvent
 	case domain.OrderStatusCompleted:
@@ -181,17 +407,14 @@ func (s *OrderService) UpdateOrderStatus(ctx context.Context, orderID domain.Ord
 
 // SetTableForOrder sets the table ID for a dine-in order
 func (s *OrderService) SetTableForOrder(ctx context.Context, orderID domain.OrderID, tableID string) error {
-	order, err := s.orderRepo.GetByID(ctx, orderID)
+	_, err := modifyOrder(ctx, s.orderRepo, orderID, func(order *domain.Order) error {
+		if err := order.SetTableID(tableID); err != nil {
+			return fmt.Errorf("failed to set table for order: %w", err)
+		}
+		return nil
+	})
 	if err != nil {
-		return fmt.Errorf("failed to get order: %w", err)
-	}
-
-	if err := order.SetTableID(tableID); err != nil {
-		return fmt.Errorf("failed to set table for order: %w", err)
-	}
-
-	if err := s.orderRepo.Update(ctx, order); err != nil {
-		return fmt.Errorf("failed to update order: %w", err)
+		return err
 	}
 
 	log.Printf("Set table %s for order: %s", tableID, orderID)
@@ -200,17 +423,14 @@ func (s *OrderService) SetTableForOrder(ctx context.Context, orderID domain.Orde
 
 // SetDeliveryAddress sets the delivery address for a delivery order
 func (s *OrderService) SetDeliveryAddress(ctx context.Context, orderID domain.OrderID, address string) error {
-	order, err := s.orderRepo.GetByID(ctx, orderID)
+	_, err := modifyOrder(ctx, s.orderRepo, orderID, func(order *domain.Order) error {
+		if err := order.SetDeliveryAddress(address); err != nil {
+			return fmt.Errorf("failed to set delivery address for order: %w", err)
+		}
+		return nil
+	})
 	if err != nil {
-		return fmt.Errorf("failed to get order: %w", err)
-	}
-
-	if err := order.SetDeliveryAddress(address); err != nil {
-		return fmt.Errorf("failed to set delivery address for order: %w", err)
-	}
-
-	if err := s.orderRepo.Update(ctx, order); err != nil {
-		return fmt.Errorf("failed to update order: %w", err)
+		return err
 	}
 
 	log.Printf("Set delivery address for order: %s", orderID)
@@ -219,44 +439,43 @@ func (s *OrderService) SetDeliveryAddress(ctx context.Context, orderID domain.Or
 
 // AddOrderNotes adds notes to an order
 func (s *OrderService) AddOrderNotes(ctx context.Context, orderID domain.OrderID, notes string) error {
-	order, err := s.orderRepo.GetByID(ctx, orderID)
+	_, err := modifyOrder(ctx, s.orderRepo, orderID, func(order *domain.Order) error {
+		order.AddNotes(notes)
+		return nil
+	})
 	if err != nil {
-		return fmt.Errorf("failed to get order: %w", err)
-	}
-
-	order.AddNotes(notes)
-
-	if err := s.orderRepo.Update(ctx, order); err != nil {
-		return fmt.Errorf("failed to update order: %w", err)
+		return err
 	}
 
 	log.Printf("Added notes to order: %s", orderID)
 	return nil
 }
 
-// CancelOrder cancels an order
-func (s *OrderService) CancelOrder(ctx context.Context, orderID domain.OrderID) error {
-	order, err := s.orderRepo.GetByID(ctx, orderID)
+// CancelOrder cancels an order on behalf of the actor on the context
+func (s *OrderService) CancelOrder(ctx context.Context, orderID domain.OrderID, reason string) error {
+	actor := actorFromContext(ctx)
+
+	var previousStatus domain.OrderStatus
+	order, err := modifyOrder(ctx, s.orderRepo, orderID, func(order *domain.Order) error {
+		previousStatus = order.Status
+		if err := order.Cancel(actor, reason); err != nil {
+			return fmt.Errorf("failed to cancel order: %w", err)
+		}
+		return nil
+	})
 	if err != nil {
-		return fmt.Errorf("failed to get order: %w", err)
-	}
-
-	if err := order.Cancel(); err != nil {
-		return fmt.Errorf("failed to cancel order: %w", err)
+		return err
 	}
 
-	if err := s.orderRepo.Update(ctx, order); err != nil {
-		return fmt.Errorf("failed to update order: %w", err)
-	}
-
-	log.Printf("Cancelled order: %s", orderID)
+	log.Printf("Cancelled order %s by %s", orderID, actor)
 
 	// Publish OrderCancelledEvent
 	eventData, err := events.ToEventData(events.OrderStatusChangedData{
 		OrderID:   string(order.ID),
-		OldStatus: string(domain.OrderStatusCreated), // Could be any previous status
+		OldStatus: string(previousStatus),
 		NewStatus: string(domain.OrderStatusCancelled),
-		UpdatedBy: "order-service",
+		UpdatedBy: actor,
+		Reason:    reason,
 	})
 
 	if err != nil {
@@ -275,11 +494,6 @@ func (s *OrderService) CancelOrder(ctx context.Context, orderID domain.OrderID)
 	return nil
 }
 
-// PayOrder marks an order as paid
-func (s *OrderService) PayOrder(ctx context.Context, orderID domain.OrderID) error {
-	return s.UpdateOrderStatus(ctx, orderID, domain.OrderStatusPaid)
-}
-
 // GetOrdersByCustomer retrieves orders for a specific customer
 func (s *OrderService) GetOrdersByCustomer(ctx context.Context, customerID string) ([]*domain.Order, error) {
 	return s.orderRepo.FindByCustomer(ctx, customerID)
@@ -303,4 +517,107 @@ func (s *OrderService) GetActiveOrders(ctx context.Context) ([]*domain.Order, er
 // ListOrders retrieves orders with pagination and filters
 func (s *OrderService) ListOrders(ctx context.Context, offset, limit int, filters domain.OrderFilters) ([]*domain.Order, int, error) {
 	return s.orderRepo.List(ctx, offset, limit, filters)
-}
\ No newline at end of file
+}
+
+// GetStatusMetrics summarizes how long orders created in the range spent in each status
+func (s *OrderService) GetStatusMetrics(ctx context.Context, from, to time.Time) ([]*domain.StatusMetric, error) {
+	orders, err := s.orderRepo.FindByDateRange(ctx, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get orders: %w", err)
+	}
+
+	return domain.SummarizeTimeInStatus(orders, time.Now()), nil
+}
+
+// modifyOrder loads an order, applies a change and saves it. When a concurrent writer saves
+// the order first, the change is reapplied to a fresh copy. A version the client sent with
+// If-Match must match the loaded order.
+func modifyOrder(ctx context.Context, orderRepo domain.OrderRepository, orderID domain.OrderID, change func(order *domain.Order) error) (*domain.Order, error) {
+	return applyOrderChange(ctx, orderRepo, orderID, nil, change)
+}
+
+// applyOrderChange is modifyOrder for a caller that already holds a copy of the order;
+// the order is only reloaded if saving that copy loses a concurrent update
+func applyOrderChange(ctx context.Context, orderRepo domain.OrderRepository, orderID domain.OrderID, loaded *domain.Order, change func(order *domain.Order) error) (*domain.Order, error) {
+	var order *domain.Order
+	err := concurrency.RetryOnConflict(ctx, func() error {
+		order, loaded = loaded, nil
+		if order == nil {
+			var err error
+			order, err = orderRepo.GetByID(ctx, orderID)
+			if err != nil {
+				return fmt.Errorf("failed to get order: %w", err)
+			}
+		}
+
+		if err := concurrency.CheckVersion(ctx, "modifyOrder", "order", orderID.String(), order.Version); err != nil {
+			return err
+		}
+
+		if err := change(order); err != nil {
+			return err
+		}
+
+		if err := orderRepo.Update(ctx, order); err != nil {
+			return fmt.Errorf("failed to update order: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return order, nil
+}
+
+// actorFromContext returns the user or service the request is acting for,
+// falling back to order-service itself for internal changes
+func actorFromContext(ctx context.Context) string {
+	if actor, ok := auth.ActorFromContext(ctx); ok {
+		return actor
+	}
+	return domain.SystemActor
+}
+
+// findOrderItem returns the item of an order with the given ID, or nil
+func findOrderItem(order *domain.Order, itemID domain.OrderItemID) *domain.OrderItem {
+	for _, item := range order.Items {
+		if item.ID == itemID {
+			return item
+		}
+	}
+	return nil
+}
+
+// toOrderLineData snapshots the live items of an order for events that carry the whole order
+func toOrderLineData(order *domain.Order) []events.OrderLineData {
+	lines := make([]events.OrderLineData, 0, len(order.Items))
+	for _, item := range order.Items {
+		if item.IsVoided() {
+			continue
+		}
+		lines = append(lines, events.OrderLineData{
+			ItemID:        string(item.ID),
+			MenuItemID:    item.MenuItemID,
+			Name:          item.Name,
+			Quantity:      item.Quantity,
+			Course:        item.Course,
+			Fired:         item.CourseStatus == domain.CourseStatusFired,
+			Seat:          item.Seat,
+			Modifiers:     toOrderItemModifierData(item),
+			Modifications: item.Modifications,
+			Notes:         item.Notes,
+		})
+	}
+	return lines
+}
+
+func toOrderItemModifierData(item *domain.OrderItem) []events.OrderItemModifierData {
+	modifiers := make([]events.OrderItemModifierData, len(item.Modifiers))
+	for i, modifier := range item.Modifiers {
+		modifiers[i] = events.OrderItemModifierData{
+			Group:  modifier.GroupName,
+			Option: modifier.OptionName,
+		}
+	}
+	return modifiers
+}
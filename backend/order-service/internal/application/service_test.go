@@ -12,6 +12,9 @@ import (
 
 	"github.com/restaurant-platform/order-service/internal/domain"
 	"github.com/restaurant-platform/shared/events"
+	"github.com/restaurant-platform/shared/pkg/auth"
+	"github.com/restaurant-platform/shared/pkg/concurrency"
+	sharedErrors "github.com/restaurant-platform/shared/pkg/errors"
 )
 
 // MockOrderRepository is a mock implementation of OrderRepository
@@ -37,6 +40,11 @@ func (m *MockOrderRepository) Update(ctx context.Context, order *domain.Order) e
 	return args.Error(0)
 }
 
+func (m *MockOrderRepository) UpdateAll(ctx context.Context, orders ...*domain.Order) error {
+	args := m.Called(ctx, orders)
+	return args.Error(0)
+}
+
 func (m *MockOrderRepository) Delete(ctx context.Context, id domain.OrderID) error {
 	args := m.Called(ctx, id)
 	return args.Error(0)
@@ -103,6 +111,14 @@ func (m *MockOrderRepository) GetActiveOrders(ctx context.Context) ([]*domain.Or
 	return args.Get(0).([]*domain.Order), args.Error(1)
 }
 
+func (m *MockOrderRepository) FindScheduled(ctx context.Context, from, to time.Time) ([]*domain.Order, error) {
+	args := m.Called(ctx, from, to)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.Order), args.Error(1)
+}
+
 // MockEventPublisher is a mock implementation of EventPublisher
 type MockEventPublisher struct {
 	mock.Mock
@@ -118,22 +134,66 @@ func (m *MockEventPublisher) Close() error {
 	return args.Error(0)
 }
 
+// MockMenuItemRepository is a mock implementation of MenuItemRepository
+type MockMenuItemRepository struct {
+	mock.Mock
+}
+
+func (m *MockMenuItemRepository) GetByID(ctx context.Context, id string) (*domain.MenuItem, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.MenuItem), args.Error(1)
+}
+
+func (m *MockMenuItemRepository) Upsert(ctx context.Context, item *domain.MenuItem) error {
+	args := m.Called(ctx, item)
+	return args.Error(0)
+}
+
+func (m *MockMenuItemRepository) SetAvailability(ctx context.Context, id string, isAvailable bool) error {
+	args := m.Called(ctx, id, isAvailable)
+	return args.Error(0)
+}
+
+func (m *MockMenuItemRepository) Delete(ctx context.Context, id string) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockMenuItemRepository) ReplaceMenu(ctx context.Context, menuID string, items []*domain.MenuItem) error {
+	args := m.Called(ctx, menuID, items)
+	return args.Error(0)
+}
+
+func (m *MockMenuItemRepository) DeleteByMenu(ctx context.Context, menuID string) error {
+	args := m.Called(ctx, menuID)
+	return args.Error(0)
+}
+
 // OrderServiceTestSuite contains all service layer tests
 type OrderServiceTestSuite struct {
 	suite.Suite
 	service      *OrderService
 	mockRepo     *MockOrderRepository
+	mockMenuRepo *MockMenuItemRepository
 	mockPublisher *MockEventPublisher
 	ctx          context.Context
 }
 
 func (suite *OrderServiceTestSuite) SetupTest() {
 	suite.mockRepo = new(MockOrderRepository)
+	suite.mockMenuRepo = new(MockMenuItemRepository)
 	suite.mockPublisher = new(MockEventPublisher)
-	suite.service = NewOrderService(suite.mockRepo, suite.mockPublisher)
+	suite.service = NewOrderService(suite.mockRepo, suite.mockMenuRepo, suite.mockPublisher)
 	suite.ctx = context.Background()
 }
 
+func testMenuItem(id, name string, price float64) *domain.MenuItem {
+	return &domain.MenuItem{ID: id, MenuID: "menu-1", Name: name, Price: price, IsAvailable: true}
+}
+
 func TestOrderServiceTestSuite(t *testing.T) {
 	suite.Run(t, new(OrderServiceTestSuite))
 }
@@ -279,19 +339,194 @@ func (suite *OrderServiceTestSuite) TestAddItemToOrder_Success() {
 	notes := "extra dressing"
 	
 	suite.mockRepo.On("GetByID", suite.ctx, orderID).Return(existingOrder, nil)
+	suite.mockMenuRepo.On("GetByID", suite.ctx, menuItemID).Return(testMenuItem(menuItemID, name, unitPrice), nil)
 	suite.mockRepo.On("Update", suite.ctx, existingOrder).Return(nil)
+	suite.mockPublisher.On("Publish", suite.ctx, mock.AnythingOfType("*events.DomainEvent")).Return(nil)
 
 	// When
-	err := suite.service.AddItemToOrder(suite.ctx, orderID, menuItemID, name, quantity, unitPrice, modifications, notes)
+	err := suite.service.AddItemToOrder(suite.ctx, orderID, menuItemID, quantity, 0, 0, nil, modifications, notes)
 
 	// Then
 	assert := assert.New(suite.T())
 	assert.NoError(err)
 	assert.Len(existingOrder.Items, 1)
 	assert.Equal(name, existingOrder.Items[0].Name)
+	assert.Equal(unitPrice, existingOrder.Items[0].UnitPrice)
 	assert.Equal(quantity, existingOrder.Items[0].Quantity)
 	
 	suite.mockRepo.AssertExpectations(suite.T())
+	suite.mockMenuRepo.AssertExpectations(suite.T())
+}
+
+func (suite *OrderServiceTestSuite) TestAddItemToOrder_PriceSnapshotSurvivesMenuChange() {
+	// Given
+	orderID := domain.OrderID("ord_123")
+	existingOrder, _ := domain.NewOrder("customer-123", domain.OrderTypeDineIn)
+	existingOrder.ID = orderID
+	menuItem := testMenuItem("steak-1", "Ribeye", 34.00)
+
+	suite.mockRepo.On("GetByID", suite.ctx, orderID).Return(existingOrder, nil)
+	suite.mockMenuRepo.On("GetByID", suite.ctx, "steak-1").Return(menuItem, nil)
+	suite.mockRepo.On("Update", suite.ctx, existingOrder).Return(nil)
+	suite.mockPublisher.On("Publish", suite.ctx, mock.AnythingOfType("*events.DomainEvent")).Return(nil)
+
+	// When
+	err := suite.service.AddItemToOrder(suite.ctx, orderID, "steak-1", 1, 0, 0, nil, nil, "")
+	menuItem.Price = 38.00
+	menuItem.Name = "Dry-aged Ribeye"
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	assert.Equal(34.00, existingOrder.Items[0].UnitPrice)
+	assert.Equal("Ribeye", existingOrder.Items[0].Name)
+}
+
+func (suite *OrderServiceTestSuite) TestAddItemToOrder_WithModifiers_PricesSelections() {
+	// Given
+	orderID := domain.OrderID("ord_123")
+	existingOrder, _ := domain.NewOrder("customer-123", domain.OrderTypeDineIn)
+	existingOrder.ID = orderID
+	menuItem := testMenuItem("burger-1", "Burger", 12.00)
+	menuItem.ModifierGroups = []*domain.MenuModifierGroup{{
+		ID: "mgrp_addons", Name: "Add-ons", MinSelections: 0, MaxSelections: 2,
+		Options: []*domain.MenuModifierOption{
+			{ID: "mopt_bacon", Name: "Bacon", PriceDelta: 2.50},
+			{ID: "mopt_egg", Name: "Fried Egg", PriceDelta: 1.50},
+		},
+	}}
+
+	suite.mockRepo.On("GetByID", suite.ctx, orderID).Return(existingOrder, nil)
+	suite.mockMenuRepo.On("GetByID", suite.ctx, "burger-1").Return(menuItem, nil)
+	suite.mockRepo.On("Update", suite.ctx, existingOrder).Return(nil)
+	suite.mockPublisher.On("Publish", suite.ctx, mock.AnythingOfType("*events.DomainEvent")).Return(nil)
+
+	// When
+	selections := []domain.ModifierSelection{{GroupID: "mgrp_addons", OptionIDs: []string{"mopt_bacon", "mopt_egg"}}}
+	err := suite.service.AddItemToOrder(suite.ctx, orderID, "burger-1", 2, 0, 0, selections, nil, "")
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	assert.Len(existingOrder.Items[0].Modifiers, 2)
+	assert.Equal(32.00, existingOrder.Items[0].Subtotal)
+}
+
+func (suite *OrderServiceTestSuite) TestAddItemToOrder_InvalidModifiers_ShouldFail() {
+	// Given
+	orderID := domain.OrderID("ord_123")
+	existingOrder, _ := domain.NewOrder("customer-123", domain.OrderTypeDineIn)
+	existingOrder.ID = orderID
+	menuItem := testMenuItem("steak-1", "Ribeye", 34.00)
+	menuItem.ModifierGroups = []*domain.MenuModifierGroup{{
+		ID: "mgrp_temp", Name: "Temperature", MinSelections: 1, MaxSelections: 1,
+		Options: []*domain.MenuModifierOption{{ID: "mopt_rare", Name: "Rare"}},
+	}}
+
+	suite.mockRepo.On("GetByID", suite.ctx, orderID).Return(existingOrder, nil)
+	suite.mockMenuRepo.On("GetByID", suite.ctx, "steak-1").Return(menuItem, nil)
+
+	// When
+	err := suite.service.AddItemToOrder(suite.ctx, orderID, "steak-1", 1, 0, 0, nil, nil, "")
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.True(sharedErrors.IsValidationError(err))
+	assert.Empty(existingOrder.Items)
+	suite.mockRepo.AssertNotCalled(suite.T(), "Update", mock.Anything, mock.Anything)
+}
+
+// Test FireCourse
+func (suite *OrderServiceTestSuite) TestFireCourse_Success() {
+	// Given
+	orderID := domain.OrderID("ord_123")
+	existingOrder, _ := domain.NewOrder("customer-123", domain.OrderTypeDineIn)
+	existingOrder.ID = orderID
+	existingOrder.TableID = "table-4"
+	_ = existingOrder.AddCourseItem(1, "soup-1", "Soup", 2, 7.00, nil, nil, "")
+	_ = existingOrder.AddCourseItem(2, "steak-1", "Ribeye", 2, 34.00, nil, nil, "")
+
+	suite.mockRepo.On("GetByID", suite.ctx, orderID).Return(existingOrder, nil)
+	suite.mockRepo.On("Update", suite.ctx, existingOrder).Return(nil)
+	suite.mockPublisher.On("Publish", suite.ctx, mock.MatchedBy(func(event *events.DomainEvent) bool {
+		return event.Type == events.OrderCourseFiredEvent &&
+			event.Data["course"] == float64(2) &&
+			event.Data["table_id"] == "table-4"
+	})).Return(nil)
+
+	// When
+	result, err := suite.service.FireCourse(suite.ctx, orderID, 2)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	assert.Equal(domain.CourseStatusFired, result.CourseStatus(2))
+
+	suite.mockRepo.AssertExpectations(suite.T())
+	suite.mockPublisher.AssertExpectations(suite.T())
+}
+
+func (suite *OrderServiceTestSuite) TestFireCourse_AlreadyFired_ShouldFail() {
+	// Given
+	orderID := domain.OrderID("ord_123")
+	existingOrder, _ := domain.NewOrder("customer-123", domain.OrderTypeDineIn)
+	existingOrder.ID = orderID
+	_ = existingOrder.AddCourseItem(1, "soup-1", "Soup", 1, 7.00, nil, nil, "")
+
+	suite.mockRepo.On("GetByID", suite.ctx, orderID).Return(existingOrder, nil)
+
+	// When
+	_, err := suite.service.FireCourse(suite.ctx, orderID, 1)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.True(sharedErrors.IsConflictError(err))
+	suite.mockRepo.AssertNotCalled(suite.T(), "Update", mock.Anything, mock.Anything)
+	suite.mockPublisher.AssertNotCalled(suite.T(), "Publish", mock.Anything, mock.Anything)
+}
+
+func (suite *OrderServiceTestSuite) TestAddItemToOrder_UnknownMenuItem_ShouldFail() {
+	// Given
+	orderID := domain.OrderID("ord_123")
+	existingOrder, _ := domain.NewOrder("customer-123", domain.OrderTypeDineIn)
+	existingOrder.ID = orderID
+	notFound := sharedErrors.WrapNotFound("MenuItemRepository.GetByID", "menu_item", "ghost", sharedErrors.ErrNotFound)
+
+	suite.mockRepo.On("GetByID", suite.ctx, orderID).Return(existingOrder, nil)
+	suite.mockMenuRepo.On("GetByID", suite.ctx, "ghost").Return(nil, notFound)
+
+	// When
+	err := suite.service.AddItemToOrder(suite.ctx, orderID, "ghost", 1, 0, 0, nil, nil, "")
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.Error(err)
+	assert.True(sharedErrors.IsValidationError(err))
+	assert.Contains(err.Error(), "unknown menu item")
+	assert.Empty(existingOrder.Items)
+	suite.mockRepo.AssertNotCalled(suite.T(), "Update", mock.Anything, mock.Anything)
+}
+
+func (suite *OrderServiceTestSuite) TestAddItemToOrder_UnavailableMenuItem_ShouldFail() {
+	// Given
+	orderID := domain.OrderID("ord_123")
+	existingOrder, _ := domain.NewOrder("customer-123", domain.OrderTypeDineIn)
+	existingOrder.ID = orderID
+	menuItem := testMenuItem("soup-1", "Soup of the Day", 7.50)
+	menuItem.IsAvailable = false
+
+	suite.mockRepo.On("GetByID", suite.ctx, orderID).Return(existingOrder, nil)
+	suite.mockMenuRepo.On("GetByID", suite.ctx, "soup-1").Return(menuItem, nil)
+
+	// When
+	err := suite.service.AddItemToOrder(suite.ctx, orderID, "soup-1", 1, 0, 0, nil, nil, "")
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.Error(err)
+	assert.True(sharedErrors.IsConflictError(err))
+	assert.Contains(err.Error(), "currently unavailable")
+	suite.mockRepo.AssertNotCalled(suite.T(), "Update", mock.Anything, mock.Anything)
 }
 
 func (suite *OrderServiceTestSuite) TestAddItemToOrder_OrderNotFound_ShouldFail() {
@@ -302,7 +537,7 @@ func (suite *OrderServiceTestSuite) TestAddItemToOrder_OrderNotFound_ShouldFail(
 	suite.mockRepo.On("GetByID", suite.ctx, orderID).Return(nil, repoError)
 
 	// When
-	err := suite.service.AddItemToOrder(suite.ctx, orderID, "item-1", "Item", 1, 10.99, nil, "")
+	err := suite.service.AddItemToOrder(suite.ctx, orderID, "item-1", 1, 0, 0, nil, nil, "")
 
 	// Then
 	assert := assert.New(suite.T())
@@ -313,6 +548,102 @@ func (suite *OrderServiceTestSuite) TestAddItemToOrder_OrderNotFound_ShouldFail(
 	suite.mockRepo.AssertNotCalled(suite.T(), "Update")
 }
 
+func (suite *OrderServiceTestSuite) TestAddItemToOrder_SentToKitchen_PublishesItemAdded() {
+	// Given
+	orderID := domain.OrderID("ord_123")
+	existingOrder, _ := domain.NewOrder("customer-123", domain.OrderTypeDineIn)
+	existingOrder.ID = orderID
+	existingOrder.Status = domain.OrderStatusPreparing
+
+	suite.mockRepo.On("GetByID", suite.ctx, orderID).Return(existingOrder, nil)
+	suite.mockMenuRepo.On("GetByID", suite.ctx, "cake-1").Return(testMenuItem("cake-1", "Cheesecake", 8.00), nil)
+	suite.mockRepo.On("Update", suite.ctx, existingOrder).Return(nil)
+	suite.mockPublisher.On("Publish", suite.ctx, mock.MatchedBy(func(event *events.DomainEvent) bool {
+		return event.Type == events.OrderItemAddedEvent &&
+			event.Data["item_id"] == string(existingOrder.Items[0].ID) &&
+			event.Data["name"] == "Cheesecake"
+	})).Return(nil)
+
+	// When
+	err := suite.service.AddItemToOrder(suite.ctx, orderID, "cake-1", 1, 0, 0, nil, nil, "")
+
+	// Then
+	assert.NoError(suite.T(), err)
+	suite.mockPublisher.AssertExpectations(suite.T())
+}
+
+func (suite *OrderServiceTestSuite) TestAddItemToOrder_NotSentToKitchen_PublishesItemAdded() {
+	// Given
+	orderID := domain.OrderID("ord_123")
+	existingOrder, _ := domain.NewOrder("customer-123", domain.OrderTypeDineIn)
+	existingOrder.ID = orderID
+
+	suite.mockRepo.On("GetByID", suite.ctx, orderID).Return(existingOrder, nil)
+	suite.mockMenuRepo.On("GetByID", suite.ctx, "cake-1").Return(testMenuItem("cake-1", "Cheesecake", 8.00), nil)
+	suite.mockRepo.On("Update", suite.ctx, existingOrder).Return(nil)
+	suite.mockPublisher.On("Publish", suite.ctx, mock.MatchedBy(func(event *events.DomainEvent) bool {
+		return event.Type == events.OrderItemAddedEvent && event.Data["menu_item_id"] == "cake-1"
+	})).Return(nil)
+
+	// When
+	err := suite.service.AddItemToOrder(suite.ctx, orderID, "cake-1", 1, 0, 0, nil, nil, "")
+
+	// Then
+	assert.NoError(suite.T(), err)
+	suite.mockPublisher.AssertExpectations(suite.T())
+}
+
+// Test VoidItem
+func (suite *OrderServiceTestSuite) TestVoidItem_Success() {
+	// Given
+	orderID := domain.OrderID("ord_123")
+	existingOrder, _ := domain.NewOrder("customer-123", domain.OrderTypeDineIn)
+	existingOrder.ID = orderID
+	existingOrder.AddItem("item-1", "Salad", 1, 10.00, nil, "")
+	existingOrder.Status = domain.OrderStatusPreparing
+	itemID := existingOrder.Items[0].ID
+
+	suite.mockRepo.On("GetByID", suite.ctx, orderID).Return(existingOrder, nil)
+	suite.mockRepo.On("Update", suite.ctx, existingOrder).Return(nil)
+	suite.mockPublisher.On("Publish", suite.ctx, mock.MatchedBy(func(event *events.DomainEvent) bool {
+		return event.Type == events.OrderItemVoidedEvent &&
+			event.Data["item_id"] == string(itemID) &&
+			event.Data["reason"] == "sent to wrong table"
+	})).Return(nil)
+
+	// When
+	err := suite.service.VoidItem(suite.ctx, orderID, itemID, "sent to wrong table")
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	assert.True(existingOrder.Items[0].IsVoided())
+	assert.Zero(existingOrder.TotalAmount)
+
+	suite.mockRepo.AssertExpectations(suite.T())
+	suite.mockPublisher.AssertExpectations(suite.T())
+}
+
+func (suite *OrderServiceTestSuite) TestVoidItem_NotSentToKitchen_ShouldFail() {
+	// Given
+	orderID := domain.OrderID("ord_123")
+	existingOrder, _ := domain.NewOrder("customer-123", domain.OrderTypeDineIn)
+	existingOrder.ID = orderID
+	existingOrder.AddItem("item-1", "Salad", 1, 10.00, nil, "")
+
+	suite.mockRepo.On("GetByID", suite.ctx, orderID).Return(existingOrder, nil)
+
+	// When
+	err := suite.service.VoidItem(suite.ctx, orderID, existingOrder.Items[0].ID, "sent to wrong table")
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.True(sharedErrors.IsConflictError(err))
+
+	suite.mockRepo.AssertNotCalled(suite.T(), "Update")
+	suite.mockPublisher.AssertNotCalled(suite.T(), "Publish", mock.Anything, mock.Anything)
+}
+
 // Test RemoveItemFromOrder
 func (suite *OrderServiceTestSuite) TestRemoveItemFromOrder_Success() {
 	// Given
@@ -324,6 +655,11 @@ func (suite *OrderServiceTestSuite) TestRemoveItemFromOrder_Success() {
 	
 	suite.mockRepo.On("GetByID", suite.ctx, orderID).Return(existingOrder, nil)
 	suite.mockRepo.On("Update", suite.ctx, existingOrder).Return(nil)
+	suite.mockPublisher.On("Publish", suite.ctx, mock.MatchedBy(func(event *events.DomainEvent) bool {
+		return event.Type == events.OrderItemRemovedEvent &&
+			event.Data["item_id"] == string(itemToRemove) &&
+			event.Data["menu_item_id"] == "item-1"
+	})).Return(nil)
 
 	// When
 	err := suite.service.RemoveItemFromOrder(suite.ctx, orderID, itemToRemove)
@@ -334,6 +670,7 @@ func (suite *OrderServiceTestSuite) TestRemoveItemFromOrder_Success() {
 	assert.Empty(existingOrder.Items)
 	
 	suite.mockRepo.AssertExpectations(suite.T())
+	suite.mockPublisher.AssertExpectations(suite.T())
 }
 
 // Test UpdateItemQuantity
@@ -348,6 +685,11 @@ func (suite *OrderServiceTestSuite) TestUpdateItemQuantity_Success() {
 	
 	suite.mockRepo.On("GetByID", suite.ctx, orderID).Return(existingOrder, nil)
 	suite.mockRepo.On("Update", suite.ctx, existingOrder).Return(nil)
+	suite.mockPublisher.On("Publish", suite.ctx, mock.MatchedBy(func(event *events.DomainEvent) bool {
+		return event.Type == events.OrderItemUpdatedEvent &&
+			event.Data["old_quantity"] == float64(1) &&
+			event.Data["quantity"] == float64(newQuantity)
+	})).Return(nil)
 
 	// When
 	err := suite.service.UpdateItemQuantity(suite.ctx, orderID, itemID, newQuantity)
@@ -358,6 +700,7 @@ func (suite *OrderServiceTestSuite) TestUpdateItemQuantity_Success() {
 	assert.Equal(newQuantity, existingOrder.Items[0].Quantity)
 	
 	suite.mockRepo.AssertExpectations(suite.T())
+	suite.mockPublisher.AssertExpectations(suite.T())
 }
 
 // Test UpdateOrderStatus
@@ -366,14 +709,15 @@ func (suite *OrderServiceTestSuite) TestUpdateOrderStatus_Success() {
 	orderID := domain.OrderID("ord_123")
 	existingOrder, _ := domain.NewOrder("customer-123", domain.OrderTypeDineIn)
 	existingOrder.ID = orderID
-	newStatus := domain.OrderStatusPaid
+	existingOrder.Status = domain.OrderStatusPaid
+	newStatus := domain.OrderStatusPreparing
 	
 	suite.mockRepo.On("GetByID", suite.ctx, orderID).Return(existingOrder, nil)
 	suite.mockRepo.On("Update", suite.ctx, existingOrder).Return(nil)
 	suite.mockPublisher.On("Publish", suite.ctx, mock.AnythingOfType("*events.DomainEvent")).Return(nil)
 
 	// When
-	err := suite.service.UpdateOrderStatus(suite.ctx, orderID, newStatus)
+	err := suite.service.UpdateOrderStatus(suite.ctx, orderID, newStatus, "")
 
 	// Then
 	assert := assert.New(suite.T())
@@ -390,12 +734,12 @@ func (suite *OrderServiceTestSuite) TestUpdateOrderStatus_InvalidTransition_Shou
 	existingOrder, _ := domain.NewOrder("customer-123", domain.OrderTypeDineIn)
 	existingOrder.ID = orderID
 	existingOrder.Status = domain.OrderStatusCompleted // Cannot change from completed
-	newStatus := domain.OrderStatusPaid
+	newStatus := domain.OrderStatusPreparing
 	
 	suite.mockRepo.On("GetByID", suite.ctx, orderID).Return(existingOrder, nil)
 
 	// When
-	err := suite.service.UpdateOrderStatus(suite.ctx, orderID, newStatus)
+	err := suite.service.UpdateOrderStatus(suite.ctx, orderID, newStatus, "")
 
 	// Then
 	assert := assert.New(suite.T())
@@ -408,6 +752,136 @@ func (suite *OrderServiceTestSuite) TestUpdateOrderStatus_InvalidTransition_Shou
 	suite.mockPublisher.AssertNotCalled(suite.T(), "Publish")
 }
 
+func (suite *OrderServiceTestSuite) TestUpdateOrderStatus_Paid_ShouldFail() {
+	// Given
+	orderID := domain.OrderID("ord_123")
+
+	// When
+	err := suite.service.UpdateOrderStatus(suite.ctx, orderID, domain.OrderStatusPaid, "paid at counter")
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.True(sharedErrors.IsValidationError(err))
+	suite.mockRepo.AssertNotCalled(suite.T(), "GetByID", mock.Anything, mock.Anything)
+	suite.mockPublisher.AssertNotCalled(suite.T(), "Publish", mock.Anything, mock.Anything)
+}
+
+func (suite *OrderServiceTestSuite) TestUpdateOrderStatus_CancelPaidOrder_ShouldFail() {
+	// Given
+	orderID := domain.OrderID("ord_123")
+	existingOrder, _ := domain.NewOrder("customer-123", domain.OrderTypeDineIn)
+	existingOrder.ID = orderID
+	existingOrder.Status = domain.OrderStatusPreparing
+
+	suite.mockRepo.On("GetByID", suite.ctx, orderID).Return(existingOrder, nil)
+
+	// When
+	err := suite.service.UpdateOrderStatus(suite.ctx, orderID, domain.OrderStatusCancelled, "customer left")
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.True(sharedErrors.IsConflictError(err))
+	assert.Equal(domain.OrderStatusPreparing, existingOrder.Status)
+	suite.mockRepo.AssertNotCalled(suite.T(), "Update", mock.Anything, mock.Anything)
+	suite.mockPublisher.AssertNotCalled(suite.T(), "Publish", mock.Anything, mock.Anything)
+}
+
+func (suite *OrderServiceTestSuite) TestUpdateOrderStatus_RecordsActorAndReason() {
+	// Given
+	ctx := auth.WithActor(suite.ctx, "user-42")
+	orderID := domain.OrderID("ord_123")
+	existingOrder, _ := domain.NewOrder("customer-123", domain.OrderTypeDineIn)
+	existingOrder.ID = orderID
+
+	suite.mockRepo.On("GetByID", ctx, orderID).Return(existingOrder, nil)
+	suite.mockRepo.On("Update", ctx, existingOrder).Return(nil)
+	suite.mockPublisher.On("Publish", ctx, mock.MatchedBy(func(event *events.DomainEvent) bool {
+		return event.Type == events.OrderCancelledEvent &&
+			event.Data["updated_by"] == "user-42" &&
+			event.Data["reason"] == "customer left"
+	})).Return(nil)
+
+	// When
+	err := suite.service.UpdateOrderStatus(ctx, orderID, domain.OrderStatusCancelled, "customer left")
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	assert.Len(existingOrder.StatusHistory, 1)
+	assert.Equal(domain.OrderStatusCreated, existingOrder.StatusHistory[0].From)
+	assert.Equal("user-42", existingOrder.StatusHistory[0].Actor)
+	assert.Equal("customer left", existingOrder.StatusHistory[0].Reason)
+	suite.mockPublisher.AssertExpectations(suite.T())
+}
+
+func (suite *OrderServiceTestSuite) TestUpdateOrderStatus_VersionConflict_RetriesOnFreshCopy() {
+	// Given a kitchen event saved the order between our load and save
+	orderID := domain.OrderID("ord_123")
+	stale, _ := domain.NewOrder("customer-123", domain.OrderTypeDineIn)
+	stale.ID = orderID
+	fresh, _ := domain.NewOrder("customer-123", domain.OrderTypeDineIn)
+	fresh.ID = orderID
+	fresh.Version = 2
+
+	suite.mockRepo.On("GetByID", suite.ctx, orderID).Return(stale, nil).Once()
+	suite.mockRepo.On("GetByID", suite.ctx, orderID).Return(fresh, nil).Once()
+	suite.mockRepo.On("Update", suite.ctx, stale).
+		Return(sharedErrors.WrapVersionConflict("OrderRepository.Update", "order", string(orderID), 1)).Once()
+	suite.mockRepo.On("Update", suite.ctx, fresh).Return(nil).Once()
+	suite.mockPublisher.On("Publish", suite.ctx, mock.AnythingOfType("*events.DomainEvent")).Return(nil)
+
+	// When
+	err := suite.service.UpdateOrderStatus(suite.ctx, orderID, domain.OrderStatusCancelled, "")
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	assert.Equal(domain.OrderStatusCancelled, fresh.Status)
+	suite.mockRepo.AssertExpectations(suite.T())
+	suite.mockPublisher.AssertNumberOfCalls(suite.T(), "Publish", 1)
+}
+
+func (suite *OrderServiceTestSuite) TestAddOrderNotes_PersistentConflict_ShouldFail() {
+	// Given
+	orderID := domain.OrderID("ord_123")
+	existingOrder, _ := domain.NewOrder("customer-123", domain.OrderTypeDineIn)
+	existingOrder.ID = orderID
+
+	suite.mockRepo.On("GetByID", suite.ctx, orderID).Return(existingOrder, nil)
+	suite.mockRepo.On("Update", suite.ctx, existingOrder).
+		Return(sharedErrors.WrapVersionConflict("OrderRepository.Update", "order", string(orderID), 1))
+
+	// When
+	err := suite.service.AddOrderNotes(suite.ctx, orderID, "window seat")
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.True(sharedErrors.IsVersionConflict(err))
+	suite.mockRepo.AssertNumberOfCalls(suite.T(), "GetByID", concurrency.MaxAttempts)
+	suite.mockRepo.AssertNumberOfCalls(suite.T(), "Update", concurrency.MaxAttempts)
+}
+
+func (suite *OrderServiceTestSuite) TestSetTableForOrder_StaleIfMatch_ShouldFailWithoutSaving() {
+	// Given the client last saw version 1 but the order is at version 3
+	ctx := concurrency.WithExpectedVersion(suite.ctx, 1)
+	orderID := domain.OrderID("ord_123")
+	existingOrder, _ := domain.NewOrder("customer-123", domain.OrderTypeDineIn)
+	existingOrder.ID = orderID
+	existingOrder.Version = 3
+
+	suite.mockRepo.On("GetByID", ctx, orderID).Return(existingOrder, nil)
+
+	// When
+	err := suite.service.SetTableForOrder(ctx, orderID, "table-9")
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.True(sharedErrors.IsPreconditionFailed(err))
+	assert.Empty(existingOrder.TableID)
+	suite.mockRepo.AssertNumberOfCalls(suite.T(), "GetByID", 1)
+	suite.mockRepo.AssertNotCalled(suite.T(), "Update")
+}
+
 // Test SetTableForOrder
 func (suite *OrderServiceTestSuite) TestSetTableForOrder_Success() {
 	// Given
@@ -487,7 +961,7 @@ func (suite *OrderServiceTestSuite) TestCancelOrder_Success() {
 	suite.mockPublisher.On("Publish", suite.ctx, mock.AnythingOfType("*events.DomainEvent")).Return(nil)
 
 	// When
-	err := suite.service.CancelOrder(suite.ctx, orderID)
+	err := suite.service.CancelOrder(suite.ctx, orderID, "")
 
 	// Then
 	assert := assert.New(suite.T())
@@ -498,26 +972,30 @@ func (suite *OrderServiceTestSuite) TestCancelOrder_Success() {
 	suite.mockPublisher.AssertExpectations(suite.T())
 }
 
-// Test PayOrder
-func (suite *OrderServiceTestSuite) TestPayOrder_Success() {
+func (suite *OrderServiceTestSuite) TestCancelOrder_WithoutActor_RecordsSystemAndPreviousStatus() {
 	// Given
 	orderID := domain.OrderID("ord_123")
 	existingOrder, _ := domain.NewOrder("customer-123", domain.OrderTypeDineIn)
 	existingOrder.ID = orderID
-	
+	existingOrder.Status = domain.OrderStatusPreparing
+
 	suite.mockRepo.On("GetByID", suite.ctx, orderID).Return(existingOrder, nil)
 	suite.mockRepo.On("Update", suite.ctx, existingOrder).Return(nil)
-	suite.mockPublisher.On("Publish", suite.ctx, mock.AnythingOfType("*events.DomainEvent")).Return(nil)
+	suite.mockPublisher.On("Publish", suite.ctx, mock.MatchedBy(func(event *events.DomainEvent) bool {
+		return event.Type == events.OrderCancelledEvent &&
+			event.Data["old_status"] == "PREPARING" &&
+			event.Data["updated_by"] == domain.SystemActor
+	})).Return(nil)
 
 	// When
-	err := suite.service.PayOrder(suite.ctx, orderID)
+	err := suite.service.CancelOrder(suite.ctx, orderID, "out of stock")
 
 	// Then
 	assert := assert.New(suite.T())
 	assert.NoError(err)
-	assert.Equal(domain.OrderStatusPaid, existingOrder.Status)
-	
-	suite.mockRepo.AssertExpectations(suite.T())
+	assert.Len(existingOrder.StatusHistory, 1)
+	assert.Equal(domain.SystemActor, existingOrder.StatusHistory[0].Actor)
+	assert.Equal("out of stock", existingOrder.StatusHistory[0].Reason)
 	suite.mockPublisher.AssertExpectations(suite.T())
 }
 
@@ -653,9 +1131,10 @@ func (suite *OrderServiceTestSuite) TestAddItemToOrder_InvalidQuantity_ShouldFai
 	existingOrder.ID = orderID
 	
 	suite.mockRepo.On("GetByID", suite.ctx, orderID).Return(existingOrder, nil)
+	suite.mockMenuRepo.On("GetByID", suite.ctx, "item-1").Return(testMenuItem("item-1", "Item", 10.99), nil)
 
 	// When - Try to add item with invalid quantity
-	err := suite.service.AddItemToOrder(suite.ctx, orderID, "item-1", "Item", 0, 10.99, nil, "")
+	err := suite.service.AddItemToOrder(suite.ctx, orderID, "item-1", 0, 0, 0, nil, nil, "")
 
 	// Then
 	assert := assert.New(suite.T())
@@ -674,10 +1153,11 @@ func (suite *OrderServiceTestSuite) TestAddItemToOrder_UpdateError_ShouldFail()
 	updateError := errors.New("database update failed")
 	
 	suite.mockRepo.On("GetByID", suite.ctx, orderID).Return(existingOrder, nil)
+	suite.mockMenuRepo.On("GetByID", suite.ctx, "item-1").Return(testMenuItem("item-1", "Item", 10.99), nil)
 	suite.mockRepo.On("Update", suite.ctx, existingOrder).Return(updateError)
 
 	// When
-	err := suite.service.AddItemToOrder(suite.ctx, orderID, "item-1", "Item", 1, 10.99, nil, "")
+	err := suite.service.AddItemToOrder(suite.ctx, orderID, "item-1", 1, 0, 0, nil, nil, "")
 
 	// Then
 	assert := assert.New(suite.T())
@@ -835,7 +1315,7 @@ func (suite *OrderServiceTestSuite) TestCancelOrder_AlreadyCancelled_ShouldFail(
 	suite.mockRepo.On("GetByID", suite.ctx, orderID).Return(existingOrder, nil)
 
 	// When
-	err := suite.service.CancelOrder(suite.ctx, orderID)
+	err := suite.service.CancelOrder(suite.ctx, orderID, "")
 
 	// Then
 	assert := assert.New(suite.T())
@@ -894,15 +1374,9 @@ func (suite *OrderServiceTestSuite) TestUpdateOrderStatus_DifferentEventTypes()
 		newStatus     domain.OrderStatus
 		expectedEvent events.EventType
 	}{
-		{
-			name:          "Order Paid Event",
-			initialStatus: domain.OrderStatusCreated,
-			newStatus:     domain.OrderStatusPaid,
-			expectedEvent: events.OrderPaidEvent,
-		},
 		{
 			name:          "Order Cancelled Event",
-			initialStatus: domain.OrderStatusPaid,
+			initialStatus: domain.OrderStatusCreated,
 			newStatus:     domain.OrderStatusCancelled,
 			expectedEvent: events.OrderCancelledEvent,
 		},
@@ -931,7 +1405,7 @@ func (suite *OrderServiceTestSuite) TestUpdateOrderStatus_DifferentEventTypes()
 			// Create new mocks for this test
 			mockRepo := new(MockOrderRepository)
 			mockPublisher := new(MockEventPublisher)
-			service := NewOrderService(mockRepo, mockPublisher)
+			service := NewOrderService(mockRepo, new(MockMenuItemRepository), mockPublisher)
 			
 			mockRepo.On("GetByID", suite.ctx, orderID).Return(existingOrder, nil)
 			mockRepo.On("Update", suite.ctx, existingOrder).Return(nil)
@@ -940,7 +1414,7 @@ func (suite *OrderServiceTestSuite) TestUpdateOrderStatus_DifferentEventTypes()
 			})).Return(nil)
 
 			// When
-			err := service.UpdateOrderStatus(suite.ctx, orderID, tc.newStatus)
+			err := service.UpdateOrderStatus(suite.ctx, orderID, tc.newStatus, "")
 
 			// Then
 			assert.NoError(t, err)
@@ -996,4 +1470,30 @@ func (suite *OrderServiceTestSuite) TestAddOrderNotes_EmptyNotes() {
 	assert.Equal(emptyNotes, existingOrder.Notes) // Notes should be overwritten even if empty
 	
 	suite.mockRepo.AssertExpectations(suite.T())
-}
\ No newline at end of file
+}
+
+func (suite *OrderServiceTestSuite) TestGetStatusMetrics_SummarizesOrdersInRange() {
+	// Given
+	from := time.Now().Add(-2 * time.Hour)
+	to := time.Now()
+	order, _ := domain.NewOrder("customer-123", domain.OrderTypeTakeout)
+	order.CreatedAt = from
+	_ = order.UpdateStatus(domain.OrderStatusPaid, "", "")
+	order.StatusHistory[0].At = from.Add(10 * time.Minute)
+	_ = order.Cancel("", "")
+	order.StatusHistory[1].At = from.Add(30 * time.Minute)
+
+	suite.mockRepo.On("FindByDateRange", suite.ctx, from, to).Return([]*domain.Order{order}, nil)
+
+	// When
+	metrics, err := suite.service.GetStatusMetrics(suite.ctx, from, to)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	assert.Len(metrics, 2)
+	assert.Equal(domain.OrderStatusCreated, metrics[0].Status)
+	assert.Equal(10*time.Minute, metrics[0].Average)
+	assert.Equal(domain.OrderStatusPaid, metrics[1].Status)
+	assert.Equal(20*time.Minute, metrics[1].Max)
+}
@@ -0,0 +1,203 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/restaurant-platform/order-service/internal/domain"
+	"github.com/restaurant-platform/shared/events"
+	"github.com/restaurant-platform/shared/pkg/errors"
+)
+
+// SLAService monitors active orders against their service levels, raising alerts for
+// orders left too long in a status and cancelling orders that were never paid
+type SLAService struct {
+	orderRepo      domain.OrderRepository
+	paymentRepo    domain.PaymentRepository
+	alertRepo      domain.SLAAlertRepository
+	policy         domain.SLAPolicy
+	eventPublisher events.EventPublisher
+}
+
+// NewSLAService creates a new SLA service
+func NewSLAService(orderRepo domain.OrderRepository, paymentRepo domain.PaymentRepository, alertRepo domain.SLAAlertRepository,
+	policy domain.SLAPolicy, eventPublisher events.EventPublisher) *SLAService {
+	return &SLAService{
+		orderRepo:      orderRepo,
+		paymentRepo:    paymentRepo,
+		alertRepo:      alertRepo,
+		policy:         policy,
+		eventPublisher: eventPublisher,
+	}
+}
+
+// CheckOrders raises an alert and publishes an OrderSLABreachedEvent for each active order
+// that has stayed in its status past the threshold, once per order and status. Open alerts
+// of orders that have since moved on are resolved. It returns the number of new breaches.
+func (s *SLAService) CheckOrders(ctx context.Context, now time.Time) (int, error) {
+	orders, err := s.orderRepo.GetActiveOrders(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get active orders: %w", err)
+	}
+
+	openAlerts, err := s.alertRepo.FindOpen(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get open alerts: %w", err)
+	}
+
+	active := make(map[domain.OrderID]*domain.Order, len(orders))
+	for _, order := range orders {
+		active[order.ID] = order
+	}
+
+	alerted := make(map[domain.OrderID]domain.OrderStatus, len(openAlerts))
+	for _, alert := range openAlerts {
+		if order, ok := active[alert.OrderID]; ok && order.Status == alert.Status {
+			alerted[alert.OrderID] = alert.Status
+			continue
+		}
+
+		alert.Resolve(now)
+		if err := s.alertRepo.Update(ctx, alert); err != nil {
+			log.Printf("Failed to resolve SLA alert %s: %v", alert.ID, err)
+		}
+	}
+
+	breached := 0
+	for _, order := range orders {
+		if status, ok := alerted[order.ID]; ok && status == order.Status {
+			continue
+		}
+
+		alert := s.policy.Evaluate(order, now)
+		if alert == nil {
+			continue
+		}
+
+		if err := s.alertRepo.Create(ctx, alert); err != nil {
+			if !errors.IsConflictError(err) {
+				log.Printf("Failed to record SLA alert for order %s: %v", order.ID, err)
+			}
+			continue
+		}
+
+		log.Printf("Order %s breached its %s SLA of %s", order.ID, order.Status, alert.Threshold)
+		s.publishBreach(ctx, order, alert, now)
+		breached++
+	}
+
+	return breached, nil
+}
+
+// CancelUnpaidOrders cancels every order left CREATED past the unpaid timeout and publishes
+// an OrderCancelledEvent for each. Orders a tender has already been taken for are left alone.
+// It returns the number of orders cancelled.
+func (s *SLAService) CancelUnpaidOrders(ctx context.Context, now time.Time) (int, error) {
+	if s.policy.UnpaidTimeout <= 0 {
+		return 0, nil
+	}
+
+	orders, err := s.orderRepo.GetActiveOrders(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get active orders: %w", err)
+	}
+
+	cancelled := 0
+	for _, order := range orders {
+		if !s.policy.IsUnpaidTooLong(order, now) {
+			continue
+		}
+
+		if err := s.cancelUnpaidOrder(ctx, order, now); err != nil {
+			log.Printf("Failed to cancel unpaid order %s: %v", order.ID, err)
+			continue
+		}
+		cancelled++
+	}
+
+	return cancelled, nil
+}
+
+// GetOpenAlerts retrieves the SLA breaches that have not been resolved
+func (s *SLAService) GetOpenAlerts(ctx context.Context) ([]*domain.SLAAlert, error) {
+	alerts, err := s.alertRepo.FindOpen(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get open alerts: %w", err)
+	}
+	return alerts, nil
+}
+
+// Helper methods
+
+// cancelUnpaidOrder cancels an order that was never paid, unless it was paid or a
+// tender was taken for it while the monitor was running
+func (s *SLAService) cancelUnpaidOrder(ctx context.Context, order *domain.Order, now time.Time) error {
+	payment, err := s.paymentRepo.GetByOrderID(ctx, order.ID)
+	if err != nil && !errors.IsNotFound(err) {
+		return fmt.Errorf("failed to get payment: %w", err)
+	}
+	if err == nil && payment.Status != domain.PaymentStatusVoided && len(payment.Tenders) > 0 {
+		return errors.WrapConflict("cancelUnpaidOrder", "payment", "order is being paid", nil)
+	}
+
+	reason := fmt.Sprintf("unpaid for more than %s", s.policy.UnpaidTimeout)
+	order, err = applyOrderChange(ctx, s.orderRepo, order.ID, order, func(order *domain.Order) error {
+		if !s.policy.IsUnpaidTooLong(order, now) {
+			return errors.WrapConflict("cancelUnpaidOrder", "order_status", "order is no longer unpaid", nil)
+		}
+		return order.Cancel(domain.SystemActor, reason)
+	})
+	if err != nil {
+		return err
+	}
+
+	log.Printf("Cancelled order %s: %s", order.ID, reason)
+
+	eventData, err := events.ToEventData(events.OrderStatusChangedData{
+		OrderID:   string(order.ID),
+		OldStatus: string(domain.OrderStatusCreated),
+		NewStatus: string(order.Status),
+		UpdatedBy: domain.SystemActor,
+		Reason:    reason,
+	})
+	if err != nil {
+		log.Printf("Failed to convert event data to map: %v", err)
+		return nil
+	}
+
+	event := events.NewDomainEvent(events.OrderCancelledEvent, string(order.ID), eventData).
+		WithMetadata("service", "order-service").
+		WithMetadata("customer_id", order.CustomerID)
+
+	if err := s.eventPublisher.Publish(ctx, event); err != nil {
+		log.Printf("Failed to publish order cancelled event: %v", err)
+	}
+
+	return nil
+}
+
+func (s *SLAService) publishBreach(ctx context.Context, order *domain.Order, alert *domain.SLAAlert, now time.Time) {
+	eventData, err := events.ToEventData(events.OrderSLABreachedData{
+		OrderID:          string(order.ID),
+		OrderType:        string(order.Type),
+		TableID:          order.TableID,
+		Status:           string(alert.Status),
+		ThresholdSeconds: int64(alert.Threshold.Seconds()),
+		ElapsedSeconds:   int64(alert.Elapsed(now).Seconds()),
+		BreachedAt:       alert.BreachedAt,
+	})
+	if err != nil {
+		log.Printf("Failed to convert event data to map: %v", err)
+		return
+	}
+
+	event := events.NewDomainEvent(events.OrderSLABreachedEvent, string(order.ID), eventData).
+		WithMetadata("service", "order-service").
+		WithMetadata("customer_id", order.CustomerID)
+
+	if err := s.eventPublisher.Publish(ctx, event); err != nil {
+		log.Printf("Failed to publish order SLA breached event: %v", err)
+	}
+}
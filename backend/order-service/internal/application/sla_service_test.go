@@ -0,0 +1,185 @@
+package application
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/restaurant-platform/order-service/internal/domain"
+	"github.com/restaurant-platform/shared/events"
+	sharedErrors "github.com/restaurant-platform/shared/pkg/errors"
+)
+
+// MockSLAAlertRepository is a mock implementation of SLAAlertRepository
+type MockSLAAlertRepository struct {
+	mock.Mock
+}
+
+func (m *MockSLAAlertRepository) Create(ctx context.Context, alert *domain.SLAAlert) error {
+	args := m.Called(ctx, alert)
+	return args.Error(0)
+}
+
+func (m *MockSLAAlertRepository) Update(ctx context.Context, alert *domain.SLAAlert) error {
+	args := m.Called(ctx, alert)
+	return args.Error(0)
+}
+
+func (m *MockSLAAlertRepository) FindOpen(ctx context.Context) ([]*domain.SLAAlert, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.SLAAlert), args.Error(1)
+}
+
+// SLAServiceTestSuite contains SLA monitoring and unpaid order cancellation tests
+type SLAServiceTestSuite struct {
+	suite.Suite
+	service         *SLAService
+	mockOrderRepo   *MockOrderRepository
+	mockPaymentRepo *MockPaymentRepository
+	mockAlertRepo   *MockSLAAlertRepository
+	mockPublisher   *MockEventPublisher
+	order           *domain.Order
+	now             time.Time
+	ctx             context.Context
+}
+
+func (suite *SLAServiceTestSuite) SetupTest() {
+	suite.mockOrderRepo = new(MockOrderRepository)
+	suite.mockPaymentRepo = new(MockPaymentRepository)
+	suite.mockAlertRepo = new(MockSLAAlertRepository)
+	suite.mockPublisher = new(MockEventPublisher)
+	policy, _ := domain.NewSLAPolicy(map[string]map[string]time.Duration{
+		"paid": {"default": 20 * time.Minute},
+	}, 2*time.Hour)
+	suite.service = NewSLAService(suite.mockOrderRepo, suite.mockPaymentRepo, suite.mockAlertRepo, policy, suite.mockPublisher)
+	suite.ctx = context.Background()
+
+	suite.now = time.Now()
+	suite.order, _ = domain.NewOrder("customer-123", domain.OrderTypeTakeout)
+	suite.order.AddItem("burger", "Burger", 1, 10.00, nil, "")
+}
+
+func TestSLAServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(SLAServiceTestSuite))
+}
+
+// paidAgo marks the order as paid the given time before now
+func (suite *SLAServiceTestSuite) paidAgo(ago time.Duration) {
+	suite.order.UpdateStatus(domain.OrderStatusPaid, "cashier-1", "")
+	suite.order.StatusHistory[0].At = suite.now.Add(-ago)
+}
+
+// Test CheckOrders
+func (suite *SLAServiceTestSuite) TestCheckOrders_Breach_RaisesAlertAndPublishes() {
+	// Given
+	suite.paidAgo(25 * time.Minute)
+	suite.mockOrderRepo.On("GetActiveOrders", suite.ctx).Return([]*domain.Order{suite.order}, nil)
+	suite.mockAlertRepo.On("FindOpen", suite.ctx).Return([]*domain.SLAAlert{}, nil)
+	suite.mockAlertRepo.On("Create", suite.ctx, mock.MatchedBy(func(alert *domain.SLAAlert) bool {
+		return alert.OrderID == suite.order.ID && alert.Status == domain.OrderStatusPaid
+	})).Return(nil)
+	suite.mockPublisher.On("Publish", suite.ctx, mock.MatchedBy(func(event *events.DomainEvent) bool {
+		return event.Type == events.OrderSLABreachedEvent &&
+			event.Data["status"] == string(domain.OrderStatusPaid) &&
+			event.Data["threshold_seconds"] == float64(1200) &&
+			event.Data["elapsed_seconds"] == float64(1500)
+	})).Return(nil)
+
+	// When
+	breached, err := suite.service.CheckOrders(suite.ctx, suite.now)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	assert.Equal(1, breached)
+	suite.mockAlertRepo.AssertExpectations(suite.T())
+	suite.mockPublisher.AssertExpectations(suite.T())
+}
+
+func (suite *SLAServiceTestSuite) TestCheckOrders_AlreadyAlerted_IsNotRepeated() {
+	// Given
+	suite.paidAgo(45 * time.Minute)
+	open := &domain.SLAAlert{ID: "sla_1", OrderID: suite.order.ID, Status: domain.OrderStatusPaid}
+	suite.mockOrderRepo.On("GetActiveOrders", suite.ctx).Return([]*domain.Order{suite.order}, nil)
+	suite.mockAlertRepo.On("FindOpen", suite.ctx).Return([]*domain.SLAAlert{open}, nil)
+
+	// When
+	breached, err := suite.service.CheckOrders(suite.ctx, suite.now)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	assert.Equal(0, breached)
+	assert.False(open.IsResolved())
+	suite.mockAlertRepo.AssertNotCalled(suite.T(), "Create", mock.Anything, mock.Anything)
+	suite.mockPublisher.AssertNotCalled(suite.T(), "Publish", mock.Anything, mock.Anything)
+}
+
+func (suite *SLAServiceTestSuite) TestCheckOrders_OrderMovedOn_ResolvesAlert() {
+	// Given
+	suite.paidAgo(25 * time.Minute)
+	suite.order.UpdateStatus(domain.OrderStatusPreparing, "kitchen-service", "")
+	open := &domain.SLAAlert{ID: "sla_1", OrderID: suite.order.ID, Status: domain.OrderStatusPaid}
+	suite.mockOrderRepo.On("GetActiveOrders", suite.ctx).Return([]*domain.Order{suite.order}, nil)
+	suite.mockAlertRepo.On("FindOpen", suite.ctx).Return([]*domain.SLAAlert{open}, nil)
+	suite.mockAlertRepo.On("Update", suite.ctx, open).Return(nil)
+
+	// When
+	breached, err := suite.service.CheckOrders(suite.ctx, suite.now)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	assert.Equal(0, breached)
+	assert.True(open.IsResolved())
+	suite.mockAlertRepo.AssertExpectations(suite.T())
+}
+
+// Test CancelUnpaidOrders
+func (suite *SLAServiceTestSuite) TestCancelUnpaidOrders_CancelsAndPublishes() {
+	// Given
+	suite.order.CreatedAt = suite.now.Add(-3 * time.Hour)
+	suite.mockOrderRepo.On("GetActiveOrders", suite.ctx).Return([]*domain.Order{suite.order}, nil)
+	suite.mockPaymentRepo.On("GetByOrderID", suite.ctx, suite.order.ID).
+		Return(nil, sharedErrors.WrapNotFound("PaymentRepository.GetByOrderID", "payment", string(suite.order.ID), sharedErrors.ErrNotFound))
+	suite.mockOrderRepo.On("Update", suite.ctx, suite.order).Return(nil)
+	suite.mockPublisher.On("Publish", suite.ctx, mock.MatchedBy(func(event *events.DomainEvent) bool {
+		return event.Type == events.OrderCancelledEvent && event.Data["updated_by"] == domain.SystemActor
+	})).Return(nil)
+
+	// When
+	cancelled, err := suite.service.CancelUnpaidOrders(suite.ctx, suite.now)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	assert.Equal(1, cancelled)
+	assert.Equal(domain.OrderStatusCancelled, suite.order.Status)
+	suite.mockPublisher.AssertExpectations(suite.T())
+}
+
+func (suite *SLAServiceTestSuite) TestCancelUnpaidOrders_TenderTaken_IsSkipped() {
+	// Given
+	suite.order.CreatedAt = suite.now.Add(-3 * time.Hour)
+	payment, _ := domain.NewPayment(suite.order.ID, suite.order.TotalAmount)
+	payment.AddTender(domain.TenderTypeCash, 0, 5.00, "", "")
+	suite.mockOrderRepo.On("GetActiveOrders", suite.ctx).Return([]*domain.Order{suite.order}, nil)
+	suite.mockPaymentRepo.On("GetByOrderID", suite.ctx, suite.order.ID).Return(payment, nil)
+
+	// When
+	cancelled, err := suite.service.CancelUnpaidOrders(suite.ctx, suite.now)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	assert.Equal(0, cancelled)
+	assert.Equal(domain.OrderStatusCreated, suite.order.Status)
+	suite.mockOrderRepo.AssertNotCalled(suite.T(), "Update", mock.Anything, mock.Anything)
+}
@@ -0,0 +1,285 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/restaurant-platform/order-service/internal/domain"
+	"github.com/restaurant-platform/shared/events"
+	"github.com/restaurant-platform/shared/pkg/concurrency"
+	"github.com/restaurant-platform/shared/pkg/errors"
+)
+
+// TableService seats guests at dine-in checks, transfers checks between tables, merges checks
+// and moves items between them
+type TableService struct {
+	orderRepo       domain.OrderRepository
+	paymentRepo     domain.PaymentRepository
+	reservationRepo domain.ReservationRepository
+	eventPublisher  events.EventPublisher
+}
+
+// NewTableService creates a new table service
+func NewTableService(orderRepo domain.OrderRepository, paymentRepo domain.PaymentRepository, reservationRepo domain.ReservationRepository, eventPublisher events.EventPublisher) *TableService {
+	return &TableService{
+		orderRepo:       orderRepo,
+		paymentRepo:     paymentRepo,
+		reservationRepo: reservationRepo,
+		eventPublisher:  eventPublisher,
+	}
+}
+
+// SeatGuests sets the guest count of a dine-in order. A count of 0 takes the party size of
+// the given reservation or, without one, of the reservation booked at the order's table.
+func (s *TableService) SeatGuests(ctx context.Context, orderID domain.OrderID, guestCount int, reservationID string) (*domain.Order, error) {
+	order, err := modifyOrder(ctx, s.orderRepo, orderID, func(order *domain.Order) error {
+		if guestCount > 0 && reservationID == "" {
+			return order.SeatGuests(guestCount, "")
+		}
+
+		reservation, err := s.findReservation(ctx, order, reservationID)
+		if err != nil {
+			return err
+		}
+		count := guestCount
+		if count == 0 {
+			count = reservation.PartySize
+		}
+		return order.SeatGuests(count, reservation.ID)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	log.Printf("Seated %d guests at order %s (reservation: %q)", order.GuestCount, orderID, order.ReservationID)
+	return order, nil
+}
+
+// AssignSeat moves an item of a dine-in order to a seat, updating its kitchen ticket once the order is in the kitchen
+func (s *TableService) AssignSeat(ctx context.Context, orderID domain.OrderID, itemID domain.OrderItemID, seat int) (*domain.Order, error) {
+	var item *domain.OrderItem
+	order, err := modifyOrder(ctx, s.orderRepo, orderID, func(order *domain.Order) error {
+		var err error
+		item, err = order.AssignSeat(itemID, seat)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	actor := actorFromContext(ctx)
+	log.Printf("Moved item %s of order %s to seat %d by %s", itemID, orderID, seat, actor)
+
+	if !order.IsSentToKitchen() {
+		return order, nil
+	}
+	eventData, err := events.ToEventData(events.OrderItemSeatChangedData{
+		OrderID:   string(order.ID),
+		TableID:   order.TableID,
+		ItemID:    string(item.ID),
+		Name:      item.Name,
+		Seat:      item.Seat,
+		ChangedBy: actor,
+	})
+	if err != nil {
+		log.Printf("Failed to convert event data to map: %v", err)
+		return order, nil
+	}
+	s.publish(ctx, events.OrderItemSeatChangedEvent, order, eventData)
+
+	return order, nil
+}
+
+// GetSeats retrieves the items of a dine-in order grouped by seat
+func (s *TableService) GetSeats(ctx context.Context, orderID domain.OrderID) ([]*domain.SeatItems, error) {
+	order, err := s.orderRepo.GetByID(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+	if order.Type != domain.OrderTypeDineIn {
+		return nil, errors.WrapConflict("GetSeats", "order_type", "only dine-in orders have seats", nil)
+	}
+	return order.ItemsBySeat(), nil
+}
+
+// TransferTable moves an open dine-in order to another table
+func (s *TableService) TransferTable(ctx context.Context, orderID domain.OrderID, tableID string) (*domain.Order, error) {
+	var previousTable string
+	order, err := modifyOrder(ctx, s.orderRepo, orderID, func(order *domain.Order) error {
+		var err error
+		previousTable, err = order.TransferTable(tableID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	actor := actorFromContext(ctx)
+	log.Printf("Transferred order %s from table %s to %s by %s", orderID, previousTable, tableID, actor)
+
+	eventData, err := events.ToEventData(events.OrderTableChangedData{
+		OrderID:    string(order.ID),
+		OldTableID: previousTable,
+		NewTableID: order.TableID,
+		ChangedBy:  actor,
+	})
+	if err != nil {
+		log.Printf("Failed to convert event data to map: %v", err)
+		return order, nil
+	}
+	s.publish(ctx, events.OrderTableChangedEvent, order, eventData)
+
+	return order, nil
+}
+
+// MergeOrders moves every item of the source check onto the target check and closes the source
+func (s *TableService) MergeOrders(ctx context.Context, targetID, sourceID domain.OrderID) (*domain.Order, error) {
+	actor := actorFromContext(ctx)
+
+	var moved []*domain.OrderItem
+	target, source, err := s.rearrange(ctx, targetID, sourceID, func(target, source *domain.Order) error {
+		var err error
+		moved, err = source.MergeInto(target, actor)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	log.Printf("Merged order %s (table %s) into order %s (table %s) by %s", source.ID, source.TableID, target.ID, target.TableID, actor)
+
+	s.publishItemsMoved(ctx, events.OrderMergedEvent, source, target, moved, actor)
+	return target, nil
+}
+
+// MoveItems moves items from one open check to another
+func (s *TableService) MoveItems(ctx context.Context, sourceID, targetID domain.OrderID, itemIDs []domain.OrderItemID) (*domain.Order, *domain.Order, error) {
+	var moved []*domain.OrderItem
+	source, target, err := s.rearrange(ctx, sourceID, targetID, func(source, target *domain.Order) error {
+		var err error
+		moved, err = source.MoveItemsTo(target, itemIDs)
+		return err
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	actor := actorFromContext(ctx)
+	log.Printf("Moved %d items from order %s to order %s by %s", len(moved), source.ID, target.ID, actor)
+
+	s.publishItemsMoved(ctx, events.OrderItemsMovedEvent, source, target, moved, actor)
+	return source, target, nil
+}
+
+// Helper methods
+
+// rearrange applies a change to two orders and saves both atomically, retrying on a
+// version conflict. An If-Match version applies to the first order, the one the request addressed.
+func (s *TableService) rearrange(ctx context.Context, firstID, secondID domain.OrderID, change func(first, second *domain.Order) error) (*domain.Order, *domain.Order, error) {
+	var first, second *domain.Order
+	err := concurrency.RetryOnConflict(ctx, func() error {
+		var err error
+		if first, err = s.orderRepo.GetByID(ctx, firstID); err != nil {
+			return fmt.Errorf("failed to get order: %w", err)
+		}
+		if second, err = s.orderRepo.GetByID(ctx, secondID); err != nil {
+			return fmt.Errorf("failed to get order: %w", err)
+		}
+
+		if err := concurrency.CheckVersion(ctx, "rearrange", "order", firstID.String(), first.Version); err != nil {
+			return err
+		}
+
+		if err := change(first, second); err != nil {
+			return err
+		}
+
+		// Checked after the domain rules so that payments are only looked up for open unpaid checks
+		for _, order := range []*domain.Order{first, second} {
+			if err := s.ensureNoTenders(ctx, order.ID); err != nil {
+				return err
+			}
+		}
+
+		if err := s.orderRepo.UpdateAll(ctx, first, second); err != nil {
+			return fmt.Errorf("failed to update orders: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return first, second, nil
+}
+
+// findReservation returns the reservation a party is seated from: the given one, or the one
+// booked at the order's table around now
+func (s *TableService) findReservation(ctx context.Context, order *domain.Order, reservationID string) (*domain.Reservation, error) {
+	var reservation *domain.Reservation
+	var err error
+	switch {
+	case reservationID != "":
+		reservation, err = s.reservationRepo.GetByID(ctx, reservationID)
+	case order.TableID != "":
+		reservation, err = s.reservationRepo.FindSeatable(ctx, order.TableID, time.Now())
+	default:
+		return nil, errors.WrapValidation("SeatGuests", "guest_count", "guest count is required for an order without a table or reservation", nil)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if !reservation.IsSeatable() {
+		return nil, errors.WrapConflict("SeatGuests", "reservation", "reservation "+reservation.ID+" is "+string(reservation.Status), nil)
+	}
+	return reservation, nil
+}
+
+// ensureNoTenders rejects moving items of a check that has been partially paid,
+// since the tenders already taken would no longer match its items
+func (s *TableService) ensureNoTenders(ctx context.Context, orderID domain.OrderID) error {
+	payment, err := s.paymentRepo.GetByOrderID(ctx, orderID)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to get payment: %w", err)
+	}
+	if payment.Status != domain.PaymentStatusVoided && len(payment.Tenders) > 0 {
+		return errors.WrapConflict("ensureNoTenders", "payment", "order "+orderID.String()+" has been partially paid; void the payment first", nil)
+	}
+	return nil
+}
+
+func (s *TableService) publishItemsMoved(ctx context.Context, eventType events.EventType, source, target *domain.Order, moved []*domain.OrderItem, actor string) {
+	itemIDs := make([]string, len(moved))
+	for i, item := range moved {
+		itemIDs[i] = string(item.ID)
+	}
+
+	eventData, err := events.ToEventData(events.OrderItemsMovedData{
+		FromOrderID: string(source.ID),
+		FromTableID: source.TableID,
+		ToOrderID:   string(target.ID),
+		ToTableID:   target.TableID,
+		ItemIDs:     itemIDs,
+		MovedBy:     actor,
+	})
+	if err != nil {
+		log.Printf("Failed to convert event data to map: %v", err)
+		return
+	}
+	s.publish(ctx, eventType, target, eventData)
+}
+
+func (s *TableService) publish(ctx context.Context, eventType events.EventType, order *domain.Order, eventData map[string]interface{}) {
+	event := events.NewDomainEvent(eventType, string(order.ID), eventData).
+		WithMetadata("service", "order-service").
+		WithMetadata("customer_id", order.CustomerID)
+
+	if err := s.eventPublisher.Publish(ctx, event); err != nil {
+		log.Printf("Failed to publish %s event: %v", eventType, err)
+	}
+}
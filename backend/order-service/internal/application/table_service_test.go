@@ -0,0 +1,247 @@
+package application
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/restaurant-platform/order-service/internal/domain"
+	"github.com/restaurant-platform/shared/events"
+	"github.com/restaurant-platform/shared/pkg/auth"
+	sharedErrors "github.com/restaurant-platform/shared/pkg/errors"
+)
+
+// TableServiceTestSuite contains table transfer, merge and item move tests
+type TableServiceTestSuite struct {
+	suite.Suite
+	service         *TableService
+	mockOrderRepo   *MockOrderRepository
+	mockPaymentRepo *MockPaymentRepository
+	mockReservation *MockReservationRepository
+	mockPublisher   *MockEventPublisher
+	source          *domain.Order
+	target          *domain.Order
+	ctx             context.Context
+}
+
+func (suite *TableServiceTestSuite) SetupTest() {
+	suite.mockOrderRepo = new(MockOrderRepository)
+	suite.mockPaymentRepo = new(MockPaymentRepository)
+	suite.mockReservation = new(MockReservationRepository)
+	suite.mockPublisher = new(MockEventPublisher)
+	suite.service = NewTableService(suite.mockOrderRepo, suite.mockPaymentRepo, suite.mockReservation, suite.mockPublisher)
+	suite.ctx = auth.WithActor(context.Background(), "waiter-1")
+
+	suite.source, _ = domain.NewOrder("customer-123", domain.OrderTypeDineIn)
+	suite.source.SetTableID("table-4")
+	suite.source.AddItem("burger", "Burger", 2, 10.00, nil, "")
+	suite.source.AddItem("fries", "Fries", 1, 5.00, nil, "")
+
+	suite.target, _ = domain.NewOrder("customer-456", domain.OrderTypeDineIn)
+	suite.target.SetTableID("table-7")
+	suite.target.AddItem("soup", "Soup", 1, 6.00, nil, "")
+}
+
+func TestTableServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(TableServiceTestSuite))
+}
+
+func (suite *TableServiceTestSuite) noPayment(order *domain.Order) {
+	suite.mockPaymentRepo.On("GetByOrderID", suite.ctx, order.ID).
+		Return(nil, sharedErrors.WrapNotFound("PaymentRepository.GetByOrderID", "payment", string(order.ID), sharedErrors.ErrNotFound))
+}
+
+func (suite *TableServiceTestSuite) TestTransferTable_Success() {
+	// Given
+	suite.mockOrderRepo.On("GetByID", suite.ctx, suite.source.ID).Return(suite.source, nil)
+	suite.mockOrderRepo.On("Update", suite.ctx, suite.source).Return(nil)
+	suite.mockPublisher.On("Publish", suite.ctx, mock.MatchedBy(func(event *events.DomainEvent) bool {
+		return event.Type == events.OrderTableChangedEvent &&
+			event.Data["old_table_id"] == "table-4" &&
+			event.Data["new_table_id"] == "table-9" &&
+			event.Data["changed_by"] == "waiter-1"
+	})).Return(nil)
+
+	// When
+	order, err := suite.service.TransferTable(suite.ctx, suite.source.ID, "table-9")
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	assert.Equal("table-9", order.TableID)
+	suite.mockOrderRepo.AssertExpectations(suite.T())
+	suite.mockPublisher.AssertExpectations(suite.T())
+}
+
+func (suite *TableServiceTestSuite) TestMergeOrders_Success() {
+	// Given
+	suite.mockOrderRepo.On("GetByID", suite.ctx, suite.target.ID).Return(suite.target, nil)
+	suite.mockOrderRepo.On("GetByID", suite.ctx, suite.source.ID).Return(suite.source, nil)
+	suite.noPayment(suite.target)
+	suite.noPayment(suite.source)
+	suite.mockOrderRepo.On("UpdateAll", suite.ctx, []*domain.Order{suite.target, suite.source}).Return(nil)
+	suite.mockPublisher.On("Publish", suite.ctx, mock.MatchedBy(func(event *events.DomainEvent) bool {
+		return event.Type == events.OrderMergedEvent &&
+			event.AggregateID == string(suite.target.ID) &&
+			event.Data["from_order_id"] == string(suite.source.ID)
+	})).Return(nil)
+
+	// When
+	order, err := suite.service.MergeOrders(suite.ctx, suite.target.ID, suite.source.ID)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	assert.Len(order.Items, 3)
+	assert.Equal(domain.OrderStatusCancelled, suite.source.Status)
+	assert.Equal(suite.target.ID, suite.source.MergedInto)
+	suite.mockOrderRepo.AssertExpectations(suite.T())
+	suite.mockPublisher.AssertExpectations(suite.T())
+}
+
+func (suite *TableServiceTestSuite) TestMergeOrders_PartiallyPaid_ShouldFail() {
+	// Given
+	payment, _ := domain.NewPayment(suite.source.ID, suite.source.TotalAmount)
+	payment.AddTender(domain.TenderTypeCash, 0, 10.00, "", "")
+	suite.mockOrderRepo.On("GetByID", suite.ctx, suite.target.ID).Return(suite.target, nil)
+	suite.mockOrderRepo.On("GetByID", suite.ctx, suite.source.ID).Return(suite.source, nil)
+	suite.noPayment(suite.target)
+	suite.mockPaymentRepo.On("GetByOrderID", suite.ctx, suite.source.ID).Return(payment, nil)
+
+	// When
+	_, err := suite.service.MergeOrders(suite.ctx, suite.target.ID, suite.source.ID)
+
+	// Then
+	assert.True(suite.T(), sharedErrors.IsConflictError(err))
+	suite.mockOrderRepo.AssertNotCalled(suite.T(), "UpdateAll", mock.Anything, mock.Anything)
+	suite.mockPublisher.AssertNotCalled(suite.T(), "Publish", mock.Anything, mock.Anything)
+}
+
+func (suite *TableServiceTestSuite) TestMoveItems_Success() {
+	// Given
+	fries := suite.source.Items[1]
+	suite.mockOrderRepo.On("GetByID", suite.ctx, suite.source.ID).Return(suite.source, nil)
+	suite.mockOrderRepo.On("GetByID", suite.ctx, suite.target.ID).Return(suite.target, nil)
+	suite.noPayment(suite.source)
+	suite.noPayment(suite.target)
+	suite.mockOrderRepo.On("UpdateAll", suite.ctx, []*domain.Order{suite.source, suite.target}).Return(nil)
+	suite.mockPublisher.On("Publish", suite.ctx, mock.MatchedBy(func(event *events.DomainEvent) bool {
+		itemIDs, _ := event.Data["item_ids"].([]interface{})
+		return event.Type == events.OrderItemsMovedEvent &&
+			event.Data["to_table_id"] == "table-7" &&
+			len(itemIDs) == 1 && itemIDs[0] == string(fries.ID)
+	})).Return(nil)
+
+	// When
+	source, target, err := suite.service.MoveItems(suite.ctx, suite.source.ID, suite.target.ID, []domain.OrderItemID{fries.ID})
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	assert.Len(source.Items, 1)
+	assert.Len(target.Items, 2)
+	suite.mockOrderRepo.AssertExpectations(suite.T())
+	suite.mockPublisher.AssertExpectations(suite.T())
+}
+
+func (suite *TableServiceTestSuite) reservation(status domain.ReservationStatus) *domain.Reservation {
+	return &domain.Reservation{ID: "res-1", TableID: "table-4", PartySize: 4, DateTime: time.Now(), Status: status}
+}
+
+func (suite *TableServiceTestSuite) TestSeatGuests_DefaultsFromTableReservation() {
+	// Given
+	suite.mockOrderRepo.On("GetByID", suite.ctx, suite.source.ID).Return(suite.source, nil)
+	suite.mockReservation.On("FindSeatable", suite.ctx, "table-4", mock.AnythingOfType("time.Time")).
+		Return(suite.reservation(domain.ReservationStatusConfirmed), nil)
+	suite.mockOrderRepo.On("Update", suite.ctx, suite.source).Return(nil)
+
+	// When
+	order, err := suite.service.SeatGuests(suite.ctx, suite.source.ID, 0, "")
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	assert.Equal(4, order.GuestCount)
+	assert.Equal("res-1", order.ReservationID)
+}
+
+func (suite *TableServiceTestSuite) TestSeatGuests_ExplicitCount_SkipsReservationLookup() {
+	// Given
+	suite.mockOrderRepo.On("GetByID", suite.ctx, suite.source.ID).Return(suite.source, nil)
+	suite.mockOrderRepo.On("Update", suite.ctx, suite.source).Return(nil)
+
+	// When
+	order, err := suite.service.SeatGuests(suite.ctx, suite.source.ID, 3, "")
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	assert.Equal(3, order.GuestCount)
+	assert.Empty(order.ReservationID)
+	suite.mockReservation.AssertNotCalled(suite.T(), "FindSeatable", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *TableServiceTestSuite) TestSeatGuests_CancelledReservation_ShouldFail() {
+	// Given
+	suite.mockOrderRepo.On("GetByID", suite.ctx, suite.source.ID).Return(suite.source, nil)
+	suite.mockReservation.On("GetByID", suite.ctx, "res-1").Return(suite.reservation(domain.ReservationStatusCancelled), nil)
+
+	// When
+	_, err := suite.service.SeatGuests(suite.ctx, suite.source.ID, 0, "res-1")
+
+	// Then
+	assert.New(suite.T()).True(sharedErrors.IsConflictError(err))
+	suite.mockOrderRepo.AssertNotCalled(suite.T(), "Update", mock.Anything, mock.Anything)
+}
+
+func (suite *TableServiceTestSuite) TestAssignSeat_SentToKitchen_PublishesSeatChange() {
+	// Given
+	suite.source.Status = domain.OrderStatusPaid
+	itemID := suite.source.Items[0].ID
+	suite.mockOrderRepo.On("GetByID", suite.ctx, suite.source.ID).Return(suite.source, nil)
+	suite.mockOrderRepo.On("Update", suite.ctx, suite.source).Return(nil)
+	suite.mockPublisher.On("Publish", suite.ctx, mock.MatchedBy(func(event *events.DomainEvent) bool {
+		return event.Type == events.OrderItemSeatChangedEvent &&
+			event.Data["item_id"] == string(itemID) &&
+			event.Data["seat"] == float64(2)
+	})).Return(nil)
+
+	// When
+	order, err := suite.service.AssignSeat(suite.ctx, suite.source.ID, itemID, 2)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	assert.Equal(2, order.Items[0].Seat)
+	suite.mockPublisher.AssertExpectations(suite.T())
+}
+
+func (suite *TableServiceTestSuite) TestAssignSeat_OpenCheck_DoesNotPublish() {
+	// Given
+	itemID := suite.source.Items[0].ID
+	suite.mockOrderRepo.On("GetByID", suite.ctx, suite.source.ID).Return(suite.source, nil)
+	suite.mockOrderRepo.On("Update", suite.ctx, suite.source).Return(nil)
+
+	// When
+	_, err := suite.service.AssignSeat(suite.ctx, suite.source.ID, itemID, 1)
+
+	// Then
+	assert.New(suite.T()).NoError(err)
+	suite.mockPublisher.AssertNotCalled(suite.T(), "Publish", mock.Anything, mock.Anything)
+}
+
+func (suite *TableServiceTestSuite) TestGetSeats_Takeout_ShouldFail() {
+	// Given
+	order, _ := domain.NewOrder("customer-123", domain.OrderTypeTakeout)
+	suite.mockOrderRepo.On("GetByID", suite.ctx, order.ID).Return(order, nil)
+
+	// When
+	_, err := suite.service.GetSeats(suite.ctx, order.ID)
+
+	// Then
+	assert.New(suite.T()).True(sharedErrors.IsConflictError(err))
+}
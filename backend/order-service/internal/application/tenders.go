@@ -0,0 +1,86 @@
+package application
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/restaurant-platform/order-service/internal/domain"
+	"github.com/restaurant-platform/shared/pkg/errors"
+)
+
+// tenderGateway moves the money of tenders: cash through the acting cashier's drawer,
+// card tenders through the payment provider and gift card tenders on the card they
+// were redeemed from
+type tenderGateway struct {
+	provider  domain.PaymentProvider
+	giftCards domain.GiftCardProcessor
+	drawers   domain.DrawerSessionRepository
+}
+
+// cardProvider returns the payment provider card tenders are charged through
+func (g tenderGateway) cardProvider() (domain.PaymentProvider, error) {
+	if g.provider == nil {
+		return nil, errors.WrapValidation("cardProvider", "tender_type", "card payments are not configured", nil)
+	}
+	return g.provider, nil
+}
+
+// tenderReturn records where the money of a refund was returned from
+type tenderReturn struct {
+	providerRef     string
+	drawerSessionID domain.DrawerSessionID
+}
+
+// cashDrawer returns the open drawer session of the acting cashier, which cash is
+// taken into and paid out of
+func (g tenderGateway) cashDrawer(ctx context.Context) (domain.DrawerSessionID, error) {
+	session, err := g.drawers.GetOpenByCashier(ctx, actorFromContext(ctx))
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return "", errors.WrapConflict("cashDrawer", "drawer_session", "open a cash drawer session before handling cash", nil)
+		}
+		return "", fmt.Errorf("failed to get drawer session: %w", err)
+	}
+	return session.ID, nil
+}
+
+// refund returns part of a tender
+func (g tenderGateway) refund(ctx context.Context, tender *domain.Tender, amount float64) (tenderReturn, error) {
+	switch {
+	case tender.Type == domain.TenderTypeCash:
+		drawerSessionID, err := g.cashDrawer(ctx)
+		return tenderReturn{drawerSessionID: drawerSessionID}, err
+	case tender.ProviderRef == "":
+		return tenderReturn{}, nil
+	case tender.Type == domain.TenderTypeGiftCard:
+		credit, err := g.giftCards.Refund(ctx, domain.GiftCardTransactionID(tender.ProviderRef), amount)
+		if err != nil {
+			return tenderReturn{}, err
+		}
+		return tenderReturn{providerRef: credit.ID.String()}, nil
+	default:
+		provider, err := g.cardProvider()
+		if err != nil {
+			return tenderReturn{}, err
+		}
+		providerRef, err := provider.Refund(ctx, tender.ProviderRef, amount)
+		return tenderReturn{providerRef: providerRef}, err
+	}
+}
+
+// void cancels a tender of an unsettled payment; a gift card gets its whole redemption
+// back and voided cash leaves the drawer's tally
+func (g tenderGateway) void(ctx context.Context, tender *domain.Tender) error {
+	if tender.ProviderRef == "" {
+		return nil
+	}
+	if tender.Type == domain.TenderTypeGiftCard {
+		_, err := g.giftCards.Refund(ctx, domain.GiftCardTransactionID(tender.ProviderRef), tender.Amount)
+		return err
+	}
+	provider, err := g.cardProvider()
+	if err != nil {
+		return err
+	}
+	return provider.Void(ctx, tender.ProviderRef)
+}
@@ -0,0 +1,267 @@
+package domain
+
+import (
+	"context"
+	"time"
+
+	"github.com/restaurant-platform/shared/pkg/errors"
+	"github.com/restaurant-platform/shared/pkg/types"
+)
+
+// AdjustmentEntity marks adjustment IDs
+type AdjustmentEntity struct{}
+
+func (AdjustmentEntity) IsEntity() {}
+
+// AdjustmentID is the type-safe ID of a void or refund
+type AdjustmentID = types.ID[AdjustmentEntity]
+
+// AdjustmentType distinguishes voids of unpaid orders from refunds of paid ones
+type AdjustmentType string
+
+const (
+	AdjustmentTypeVoid   AdjustmentType = "VOID"
+	AdjustmentTypeRefund AdjustmentType = "REFUND"
+)
+
+// AdjustmentReason is the mandatory reason code of a void or refund
+type AdjustmentReason string
+
+const (
+	AdjustmentReasonCustomerRequest AdjustmentReason = "CUSTOMER_REQUEST"
+	AdjustmentReasonOrderEntryError AdjustmentReason = "ORDER_ENTRY_ERROR"
+	AdjustmentReasonQualityIssue    AdjustmentReason = "QUALITY_ISSUE"
+	AdjustmentReasonLongWait        AdjustmentReason = "LONG_WAIT"
+	AdjustmentReasonDuplicateOrder  AdjustmentReason = "DUPLICATE_ORDER"
+	AdjustmentReasonOther           AdjustmentReason = "OTHER"
+)
+
+// ApprovalMethod records how a manager approved an adjustment
+type ApprovalMethod string
+
+const (
+	// ApprovalMethodRole means the adjustment was made by a user with the manager role
+	ApprovalMethodRole ApprovalMethod = "ROLE"
+	// ApprovalMethodPIN means a manager approved the adjustment of another user with their PIN
+	ApprovalMethodPIN ApprovalMethod = "PIN"
+)
+
+// DefaultApprovalThreshold is the adjustment amount above which manager approval is required
+const DefaultApprovalThreshold = 50.00
+
+// AdjustmentLine is an order item covered by a void or refund
+type AdjustmentLine struct {
+	ItemID     OrderItemID `json:"item_id"`
+	MenuItemID string      `json:"menu_item_id"`
+	Name       string      `json:"name"`
+	Quantity   int         `json:"quantity"`
+	Amount     float64     `json:"amount"`
+}
+
+// Adjustment is a void or refund of an order with its reason and approval
+type Adjustment struct {
+	ID             AdjustmentID      `json:"id"`
+	OrderID        OrderID           `json:"order_id"`
+	Type           AdjustmentType    `json:"type"`
+	Reason         AdjustmentReason  `json:"reason"`
+	Notes          string            `json:"notes,omitempty"`
+	Amount         float64           `json:"amount"`
+	Lines          []*AdjustmentLine `json:"lines"`
+	RequestedBy    string            `json:"requested_by"`
+	ApprovedBy     string            `json:"approved_by,omitempty"`
+	ApprovalMethod ApprovalMethod    `json:"approval_method,omitempty"`
+	CreatedAt      time.Time         `json:"created_at"`
+}
+
+// Approval is a manager's sign-off on an adjustment
+type Approval struct {
+	ApprovedBy string
+	Method     ApprovalMethod
+}
+
+// ApprovalPolicy decides which adjustments need manager approval
+type ApprovalPolicy struct {
+	// Threshold is the amount above which any void or refund needs approval
+	Threshold float64
+}
+
+// RequiresApproval reports whether the adjustment needs a manager's approval.
+// Refunds of completed orders always do. Other refunds are held to the threshold
+// together with what has already been refunded on the order, so a large refund
+// cannot be split into several that each stay under it.
+func (p ApprovalPolicy) RequiresApproval(adjustment *Adjustment, orderStatus OrderStatus, alreadyRefunded float64) bool {
+	if adjustment.Type == AdjustmentTypeRefund {
+		if orderStatus == OrderStatusCompleted {
+			return true
+		}
+		return roundCents(alreadyRefunded+adjustment.Amount) > p.Threshold
+	}
+	return adjustment.Amount > p.Threshold
+}
+
+// ManagerPINVerifier checks manager PIN overrides
+type ManagerPINVerifier interface {
+	// VerifyPIN returns nil if pin is the override PIN of the manager
+	VerifyPIN(ctx context.Context, managerID, pin string) error
+}
+
+// ValidateAdjustmentReason checks the reason code; OTHER must be explained in notes
+func ValidateAdjustmentReason(reason AdjustmentReason, notes string) error {
+	switch reason {
+	case AdjustmentReasonCustomerRequest, AdjustmentReasonOrderEntryError, AdjustmentReasonQualityIssue,
+		AdjustmentReasonLongWait, AdjustmentReasonDuplicateOrder:
+		return nil
+	case AdjustmentReasonOther:
+		if notes == "" {
+			return errors.WrapValidation("ValidateAdjustmentReason", "notes", "notes are required for reason OTHER", nil)
+		}
+		return nil
+	}
+	return errors.WrapValidation("ValidateAdjustmentReason", "reason", "invalid reason code", nil)
+}
+
+// IsRefunded reports whether the item has been refunded
+func (i *OrderItem) IsRefunded() bool {
+	return i.RefundedAt != nil
+}
+
+// NewVoid prepares the void of an order that has not been paid yet
+func (o *Order) NewVoid(reason AdjustmentReason, notes, requestedBy string) (*Adjustment, error) {
+	if err := ValidateAdjustmentReason(reason, notes); err != nil {
+		return nil, err
+	}
+	if o.Status != OrderStatusCreated {
+		return nil, errors.WrapConflict("NewVoid", "order_status", "only unpaid orders can be voided; refund paid orders instead", nil)
+	}
+
+	var lines []*AdjustmentLine
+	for _, item := range o.Items {
+		if item.IsVoided() {
+			continue
+		}
+		lines = append(lines, newAdjustmentLine(item))
+	}
+
+	return newAdjustment(o.ID, AdjustmentTypeVoid, reason, notes, o.TotalAmount, lines, requestedBy), nil
+}
+
+// NewRefund prepares the refund of a paid order. With no item IDs the remaining
+// refundable balance is returned; otherwise the listed items, less their share of
+// any discounts, with their tax.
+func (o *Order) NewRefund(itemIDs []OrderItemID, refundable float64, reason AdjustmentReason, notes, requestedBy string) (*Adjustment, error) {
+	if err := ValidateAdjustmentReason(reason, notes); err != nil {
+		return nil, err
+	}
+	if !o.IsSettled() {
+		return nil, errors.WrapConflict("NewRefund", "order_status", "only paid orders can be refunded; void unpaid orders instead", nil)
+	}
+	if refundable <= 0 {
+		return nil, errors.WrapConflict("NewRefund", "payment", "order has nothing left to refund", nil)
+	}
+
+	if len(itemIDs) == 0 {
+		var lines []*AdjustmentLine
+		for _, item := range o.Items {
+			if item.IsVoided() || item.IsRefunded() {
+				continue
+			}
+			lines = append(lines, newAdjustmentLine(item))
+		}
+		return newAdjustment(o.ID, AdjustmentTypeRefund, reason, notes, roundCents(refundable), lines, requestedBy), nil
+	}
+
+	lines := make([]*AdjustmentLine, 0, len(itemIDs))
+	seen := make(map[OrderItemID]bool, len(itemIDs))
+	var subtotal float64
+	for _, itemID := range itemIDs {
+		if seen[itemID] {
+			return nil, errors.WrapValidation("NewRefund", "item_ids", "items can only be listed once", nil)
+		}
+		seen[itemID] = true
+
+		item := o.findItem(itemID)
+		if item == nil {
+			return nil, errors.WrapNotFound("NewRefund", "order_item", itemID.String(), errors.ErrNotFound)
+		}
+		if item.IsVoided() || item.IsRefunded() {
+			return nil, errors.WrapConflict("NewRefund", "order_item", "item has already been voided or refunded", nil)
+		}
+		lines = append(lines, newAdjustmentLine(item))
+		subtotal += item.Subtotal
+	}
+
+	amount := roundCents(o.discounted(subtotal) * (1 + TaxRate))
+	if amount > refundable {
+		amount = roundCents(refundable)
+	}
+	return newAdjustment(o.ID, AdjustmentTypeRefund, reason, notes, amount, lines, requestedBy), nil
+}
+
+// ApplyAdjustment records an approved void or refund on the order. A void
+// cancels the order; a refund marks its items as refunded.
+func (o *Order) ApplyAdjustment(adjustment *Adjustment) error {
+	now := time.Now()
+	reason := string(adjustment.Reason)
+	if adjustment.Notes != "" {
+		reason += ": " + adjustment.Notes
+	}
+
+	switch adjustment.Type {
+	case AdjustmentTypeVoid:
+		if o.Status != OrderStatusCreated {
+			return errors.WrapConflict("ApplyAdjustment", "order_status", "only unpaid orders can be voided", nil)
+		}
+		return o.Cancel(adjustment.RequestedBy, reason)
+	case AdjustmentTypeRefund:
+		for _, line := range adjustment.Lines {
+			if item := o.findItem(line.ItemID); item != nil && item.RefundedAt == nil {
+				item.RefundedAt = &now
+			}
+		}
+		o.UpdatedAt = now
+		return nil
+	}
+	return errors.WrapValidation("ApplyAdjustment", "type", "invalid adjustment type", nil)
+}
+
+// Approve records the manager approval of the adjustment
+func (a *Adjustment) Approve(approval *Approval) {
+	a.ApprovedBy = approval.ApprovedBy
+	a.ApprovalMethod = approval.Method
+}
+
+func (o *Order) findItem(itemID OrderItemID) *OrderItem {
+	for _, item := range o.Items {
+		if item.ID == itemID {
+			return item
+		}
+	}
+	return nil
+}
+
+func newAdjustment(orderID OrderID, adjustmentType AdjustmentType, reason AdjustmentReason, notes string, amount float64, lines []*AdjustmentLine, requestedBy string) *Adjustment {
+	if requestedBy == "" {
+		requestedBy = SystemActor
+	}
+	return &Adjustment{
+		ID:          types.NewID[AdjustmentEntity]("adj"),
+		OrderID:     orderID,
+		Type:        adjustmentType,
+		Reason:      reason,
+		Notes:       notes,
+		Amount:      amount,
+		Lines:       lines,
+		RequestedBy: requestedBy,
+		CreatedAt:   time.Now(),
+	}
+}
+
+func newAdjustmentLine(item *OrderItem) *AdjustmentLine {
+	return &AdjustmentLine{
+		ItemID:     item.ID,
+		MenuItemID: item.MenuItemID,
+		Name:       item.Name,
+		Quantity:   item.Quantity,
+		Amount:     item.Subtotal,
+	}
+}
@@ -0,0 +1,206 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/restaurant-platform/shared/pkg/errors"
+)
+
+// AdjustmentTestSuite contains void, refund and approval policy tests
+type AdjustmentTestSuite struct {
+	suite.Suite
+	order *Order
+}
+
+func TestAdjustmentTestSuite(t *testing.T) {
+	suite.Run(t, new(AdjustmentTestSuite))
+}
+
+func (suite *AdjustmentTestSuite) SetupTest() {
+	// 2 x 10.00 + 1 x 5.00 plus 10% tax = 27.50
+	suite.order, _ = NewOrder("customer-123", OrderTypeTakeout)
+	suite.order.AddItem("burger", "Burger", 2, 10.00, nil, "")
+	suite.order.AddItem("fries", "Fries", 1, 5.00, nil, "")
+}
+
+func (suite *AdjustmentTestSuite) paid() *Payment {
+	suite.order.UpdateStatus(OrderStatusPaid, "cashier-1", "")
+	payment, _ := NewPayment(suite.order.ID, suite.order.TotalAmount)
+	payment.AddTender(TenderTypeCash, 0, 20.00, "", "")
+	payment.AddTender(TenderTypeCard, 7.50, 0, "", "ch_1")
+	return payment
+}
+
+func (suite *AdjustmentTestSuite) TestNewVoid_UnpaidOrder() {
+	// When
+	adjustment, err := suite.order.NewVoid(AdjustmentReasonOrderEntryError, "", "cashier-1")
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	assert.Equal(AdjustmentTypeVoid, adjustment.Type)
+	assert.Equal(27.50, adjustment.Amount)
+	assert.Len(adjustment.Lines, 2)
+	assert.Equal("cashier-1", adjustment.RequestedBy)
+}
+
+func (suite *AdjustmentTestSuite) TestNewVoid_PaidOrder_ShouldFail() {
+	// Given
+	suite.paid()
+
+	// When
+	_, err := suite.order.NewVoid(AdjustmentReasonOrderEntryError, "", "cashier-1")
+
+	// Then
+	assert.True(suite.T(), errors.IsConflictError(err))
+}
+
+func (suite *AdjustmentTestSuite) TestNewVoid_InvalidReason_ShouldFail() {
+	// When
+	_, err := suite.order.NewVoid("CHANGED_MIND", "", "cashier-1")
+
+	// Then
+	assert.True(suite.T(), errors.IsValidationError(err))
+}
+
+func (suite *AdjustmentTestSuite) TestNewVoid_OtherWithoutNotes_ShouldFail() {
+	// When
+	_, err := suite.order.NewVoid(AdjustmentReasonOther, "", "cashier-1")
+
+	// Then
+	assert.True(suite.T(), errors.IsValidationError(err))
+}
+
+func (suite *AdjustmentTestSuite) TestApplyAdjustment_Void_CancelsOrder() {
+	// Given
+	adjustment, _ := suite.order.NewVoid(AdjustmentReasonOther, "test order", "cashier-1")
+
+	// When
+	err := suite.order.ApplyAdjustment(adjustment)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	assert.Equal(OrderStatusCancelled, suite.order.Status)
+	last := suite.order.StatusHistory[len(suite.order.StatusHistory)-1]
+	assert.Equal("cashier-1", last.Actor)
+	assert.Equal("OTHER: test order", last.Reason)
+}
+
+func (suite *AdjustmentTestSuite) TestNewRefund_FullRefund() {
+	// Given
+	payment := suite.paid()
+
+	// When
+	adjustment, err := suite.order.NewRefund(nil, payment.Refundable(), AdjustmentReasonQualityIssue, "", "cashier-1")
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	assert.Equal(AdjustmentTypeRefund, adjustment.Type)
+	assert.Equal(27.50, adjustment.Amount)
+	assert.Len(adjustment.Lines, 2)
+}
+
+func (suite *AdjustmentTestSuite) TestNewRefund_Items_IncludesTax() {
+	// Given
+	payment := suite.paid()
+	fries := suite.order.Items[1]
+
+	// When
+	adjustment, err := suite.order.NewRefund([]OrderItemID{fries.ID}, payment.Refundable(), AdjustmentReasonQualityIssue, "", "cashier-1")
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	assert.Equal(5.50, adjustment.Amount)
+	assert.Len(adjustment.Lines, 1)
+	assert.Equal(fries.ID, adjustment.Lines[0].ItemID)
+}
+
+func (suite *AdjustmentTestSuite) TestNewRefund_RefundedItem_ShouldFail() {
+	// Given
+	payment := suite.paid()
+	fries := suite.order.Items[1]
+	adjustment, _ := suite.order.NewRefund([]OrderItemID{fries.ID}, payment.Refundable(), AdjustmentReasonQualityIssue, "", "cashier-1")
+	suite.order.ApplyAdjustment(adjustment)
+
+	// When
+	_, err := suite.order.NewRefund([]OrderItemID{fries.ID}, payment.Refundable(), AdjustmentReasonQualityIssue, "", "cashier-1")
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.True(fries.IsRefunded())
+	assert.True(errors.IsConflictError(err))
+}
+
+func (suite *AdjustmentTestSuite) TestNewRefund_UnknownItem_ShouldFail() {
+	// Given
+	payment := suite.paid()
+
+	// When
+	_, err := suite.order.NewRefund([]OrderItemID{"itm_unknown"}, payment.Refundable(), AdjustmentReasonQualityIssue, "", "cashier-1")
+
+	// Then
+	assert.True(suite.T(), errors.IsNotFound(err))
+}
+
+func (suite *AdjustmentTestSuite) TestNewRefund_UnpaidOrder_ShouldFail() {
+	// When
+	_, err := suite.order.NewRefund(nil, 27.50, AdjustmentReasonQualityIssue, "", "cashier-1")
+
+	// Then
+	assert.True(suite.T(), errors.IsConflictError(err))
+}
+
+func (suite *AdjustmentTestSuite) TestAllocateRefund_MostRecentTenderFirst() {
+	// Given
+	payment := suite.paid()
+
+	// When
+	allocations, err := payment.AllocateRefund(10.00)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	assert.Len(allocations, 2)
+	assert.Equal(payment.Tenders[1].ID, allocations[0].TenderID)
+	assert.Equal(7.50, allocations[0].Amount)
+	assert.Equal(payment.Tenders[0].ID, allocations[1].TenderID)
+	assert.Equal(2.50, allocations[1].Amount)
+}
+
+func (suite *AdjustmentTestSuite) TestAllocateRefund_ExceedsRefundable_ShouldFail() {
+	// Given
+	payment := suite.paid()
+
+	// When
+	_, err := payment.AllocateRefund(30.00)
+
+	// Then
+	assert.True(suite.T(), errors.IsValidationError(err))
+}
+
+func (suite *AdjustmentTestSuite) TestApprovalPolicy_RequiresApproval() {
+	policy := ApprovalPolicy{Threshold: 50.00}
+	small := &Adjustment{Type: AdjustmentTypeRefund, Amount: 20.00}
+	large := &Adjustment{Type: AdjustmentTypeVoid, Amount: 75.00}
+
+	assert := assert.New(suite.T())
+	assert.False(policy.RequiresApproval(small, OrderStatusPreparing, 0))
+	assert.True(policy.RequiresApproval(small, OrderStatusCompleted, 0))
+	assert.True(policy.RequiresApproval(large, OrderStatusCreated, 0))
+	assert.False(policy.RequiresApproval(&Adjustment{Type: AdjustmentTypeVoid, Amount: 50.00}, OrderStatusCreated, 0))
+}
+
+func (suite *AdjustmentTestSuite) TestApprovalPolicy_RequiresApproval_CountsEarlierRefunds() {
+	policy := ApprovalPolicy{Threshold: 50.00}
+	refund := &Adjustment{Type: AdjustmentTypeRefund, Amount: 20.00}
+
+	assert := assert.New(suite.T())
+	assert.False(policy.RequiresApproval(refund, OrderStatusPreparing, 30.00))
+	assert.True(policy.RequiresApproval(refund, OrderStatusPreparing, 30.01))
+}
@@ -0,0 +1,63 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/restaurant-platform/shared/pkg/errors"
+)
+
+// IsSentToKitchen reports whether the order has been released to the kitchen.
+// From then on the order can only be amended: items are added or voided, never edited.
+// Scheduled orders are not sent until they are released.
+func (o *Order) IsSentToKitchen() bool {
+	if o.IsAwaitingRelease() {
+		return false
+	}
+	return o.Status == OrderStatusPaid || o.Status == OrderStatusPreparing || o.Status == OrderStatusReady ||
+		o.Status == OrderStatusOutForDelivery
+}
+
+// IsVoided reports whether the item has been voided
+func (i *OrderItem) IsVoided() bool {
+	return i.VoidedAt != nil
+}
+
+// VoidItem voids an item on an order that has been sent to the kitchen.
+// The line is kept on the order for the record but no longer counts towards the total.
+func (o *Order) VoidItem(itemID OrderItemID, reason string) (*OrderItem, error) {
+	if !o.IsSentToKitchen() {
+		return nil, errors.WrapConflict("VoidItem", "status", "only items sent to the kitchen can be voided; remove the item instead", nil)
+	}
+	if reason == "" {
+		return nil, errors.WrapValidation("VoidItem", "reason", "void reason is required", nil)
+	}
+
+	for _, item := range o.Items {
+		if item.ID != itemID {
+			continue
+		}
+		if item.IsVoided() {
+			return nil, errors.WrapConflict("VoidItem", "item", "item has already been voided", nil)
+		}
+
+		now := time.Now()
+		item.VoidedAt = &now
+		item.VoidReason = reason
+
+		o.recalculateTotal()
+		o.UpdatedAt = now
+		return item, nil
+	}
+	return nil, errors.WrapNotFound("VoidItem", "item", string(itemID), errors.ErrNotFound)
+}
+
+// ensureEditable rejects in-place item edits once the order has left the CREATED state
+func (o *Order) ensureEditable(op string) error {
+	if o.Status == OrderStatusCompleted || o.Status == OrderStatusCancelled {
+		return errors.WrapConflict(op, "status", "cannot change items of a completed or cancelled order", nil)
+	}
+	if o.IsSentToKitchen() {
+		return errors.WrapConflict(op, "status", "order has been sent to the kitchen; add or void items instead", nil)
+	}
+	return nil
+}
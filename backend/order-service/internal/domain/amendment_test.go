@@ -0,0 +1,155 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/restaurant-platform/shared/pkg/errors"
+)
+
+// AmendmentTestSuite contains tests for amending orders already sent to the kitchen
+type AmendmentTestSuite struct {
+	suite.Suite
+	order *Order
+}
+
+func TestAmendmentTestSuite(t *testing.T) {
+	suite.Run(t, new(AmendmentTestSuite))
+}
+
+func (suite *AmendmentTestSuite) SetupTest() {
+	suite.order, _ = NewOrder("customer-123", OrderTypeDineIn)
+	_ = suite.order.AddItem("burger-1", "Burger", 2, 15.00, nil, "")
+	_ = suite.order.AddItem("soda-1", "Soda", 1, 3.00, nil, "")
+	_ = suite.order.UpdateStatus(OrderStatusPaid, "", "")
+	_ = suite.order.UpdateStatus(OrderStatusPreparing, "", "")
+}
+
+func (suite *AmendmentTestSuite) TestIsSentToKitchen() {
+	testCases := []struct {
+		status   OrderStatus
+		expected bool
+	}{
+		{OrderStatusCreated, false},
+		{OrderStatusPaid, true},
+		{OrderStatusPreparing, true},
+		{OrderStatusReady, true},
+		{OrderStatusCompleted, false},
+		{OrderStatusCancelled, false},
+	}
+
+	for _, tc := range testCases {
+		suite.order.Status = tc.status
+		assert.Equal(suite.T(), tc.expected, suite.order.IsSentToKitchen(), string(tc.status))
+	}
+}
+
+func (suite *AmendmentTestSuite) TestAddItem_PreparingOrder_Succeeds() {
+	// When
+	err := suite.order.AddItem("cake-1", "Cheesecake", 1, 8.00, nil, "")
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	assert.Len(suite.order.Items, 3)
+	assert.InDelta(41.00*1.10, suite.order.TotalAmount, 0.001)
+}
+
+func (suite *AmendmentTestSuite) TestAddItem_CompletedOrder_ShouldFail() {
+	// Given
+	suite.order.Status = OrderStatusCompleted
+
+	// When
+	err := suite.order.AddItem("cake-1", "Cheesecake", 1, 8.00, nil, "")
+
+	// Then
+	assert.True(suite.T(), errors.IsConflictError(err))
+}
+
+func (suite *AmendmentTestSuite) TestAddItem_CancelledOrder_ShouldFail() {
+	// Given
+	suite.order.Status = OrderStatusCancelled
+	total := suite.order.TotalAmount
+
+	// When
+	err := suite.order.AddCourseItem(DefaultCourse, "cake-1", "Cheesecake", 1, 8.00, nil, nil, "")
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.True(errors.IsConflictError(err))
+	assert.Equal(total, suite.order.TotalAmount)
+}
+
+func (suite *AmendmentTestSuite) TestVoidItem_Success() {
+	// Given
+	itemID := suite.order.Items[1].ID
+
+	// When
+	item, err := suite.order.VoidItem(itemID, "guest changed mind")
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	assert.True(item.IsVoided())
+	assert.Equal("guest changed mind", item.VoidReason)
+	assert.Len(suite.order.Items, 2) // voided lines stay on the order
+	assert.InDelta(30.00*1.10, suite.order.TotalAmount, 0.001)
+}
+
+func (suite *AmendmentTestSuite) TestVoidItem_AlreadyVoided_ShouldFail() {
+	// Given
+	itemID := suite.order.Items[0].ID
+	_, _ = suite.order.VoidItem(itemID, "wrong table")
+
+	// When
+	_, err := suite.order.VoidItem(itemID, "wrong table")
+
+	// Then
+	assert.True(suite.T(), errors.IsConflictError(err))
+}
+
+func (suite *AmendmentTestSuite) TestVoidItem_RequiresReason() {
+	// When
+	_, err := suite.order.VoidItem(suite.order.Items[0].ID, "")
+
+	// Then
+	assert.True(suite.T(), errors.IsValidationError(err))
+}
+
+func (suite *AmendmentTestSuite) TestVoidItem_NotSentToKitchen_ShouldFail() {
+	// Given
+	order, _ := NewOrder("customer-123", OrderTypeTakeout)
+	_ = order.AddItem("burger-1", "Burger", 1, 15.00, nil, "")
+
+	// When
+	_, err := order.VoidItem(order.Items[0].ID, "guest changed mind")
+
+	// Then
+	assert.True(suite.T(), errors.IsConflictError(err))
+}
+
+func (suite *AmendmentTestSuite) TestVoidItem_UnknownItem_ShouldFail() {
+	// When
+	_, err := suite.order.VoidItem(OrderItemID("item_missing"), "guest changed mind")
+
+	// Then
+	assert.True(suite.T(), errors.IsNotFound(err))
+}
+
+func (suite *AmendmentTestSuite) TestEditItems_SentToKitchen_ShouldFail() {
+	// Given
+	itemID := suite.order.Items[0].ID
+
+	// When
+	removeErr := suite.order.RemoveItem(itemID)
+	quantityErr := suite.order.UpdateItemQuantity(itemID, 3)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.True(errors.IsConflictError(removeErr))
+	assert.True(errors.IsConflictError(quantityErr))
+	assert.Len(suite.order.Items, 2)
+	assert.Equal(2, suite.order.Items[0].Quantity)
+}
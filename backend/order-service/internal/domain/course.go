@@ -0,0 +1,97 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/restaurant-platform/shared/pkg/errors"
+)
+
+// DefaultCourse is the course items belong to when none is given.
+// It is sent to the kitchen with the order; later courses are held until fired.
+const DefaultCourse = 1
+
+// CourseStatus represents whether a course has been released to the kitchen
+type CourseStatus string
+
+const (
+	CourseStatusHeld  CourseStatus = "HELD"
+	CourseStatusFired CourseStatus = "FIRED"
+)
+
+// AddCourseItem adds an item to a course of the order and recalculates the total.
+// Items on the first course, or on a course that has already been fired, are fired
+// immediately; items on later courses are held until FireCourse is called.
+func (o *Order) AddCourseItem(course int, menuItemID, name string, quantity int, unitPrice float64, modifiers []*OrderItemModifier, mods []string, notes string) error {
+	if course == 0 {
+		course = DefaultCourse
+	}
+	if course < DefaultCourse {
+		return errors.WrapValidation("AddCourseItem", "course", "course must be positive", nil)
+	}
+	if course > DefaultCourse && o.Type != OrderTypeDineIn {
+		return errors.WrapValidation("AddCourseItem", "course", "only dine-in orders can hold courses", nil)
+	}
+
+	fire := course == DefaultCourse || o.CourseStatus(course) == CourseStatusFired
+
+	item, err := o.addItem(menuItemID, name, quantity, unitPrice, modifiers, mods, notes)
+	if err != nil {
+		return err
+	}
+
+	item.Course = course
+	item.CourseStatus = CourseStatusHeld
+	if fire {
+		now := time.Now()
+		item.CourseStatus = CourseStatusFired
+		item.FiredAt = &now
+	}
+	return nil
+}
+
+// CourseStatus reports the state of a course. A course is fired once any of its items
+// has been sent to the kitchen; a course without fired items is held.
+func (o *Order) CourseStatus(course int) CourseStatus {
+	for _, item := range o.Items {
+		if item.Course == course && item.CourseStatus == CourseStatusFired {
+			return CourseStatusFired
+		}
+	}
+	return CourseStatusHeld
+}
+
+// FireCourse releases the held items of a course to the kitchen and returns them
+func (o *Order) FireCourse(course int) ([]*OrderItem, error) {
+	if o.Status == OrderStatusCompleted || o.Status == OrderStatusCancelled {
+		return nil, errors.WrapConflict("FireCourse", "status", "cannot fire a course on a completed or cancelled order", nil)
+	}
+
+	var held []*OrderItem
+	found := false
+	for _, item := range o.Items {
+		if item.Course != course {
+			continue
+		}
+		found = true
+		if item.CourseStatus == CourseStatusHeld && !item.IsVoided() {
+			held = append(held, item)
+		}
+	}
+
+	if !found {
+		return nil, errors.WrapNotFound("FireCourse", "course", fmt.Sprintf("%d", course), errors.ErrNotFound)
+	}
+	if len(held) == 0 {
+		return nil, errors.WrapConflict("FireCourse", "course", fmt.Sprintf("course %d has already been fired", course), nil)
+	}
+
+	now := time.Now()
+	for _, item := range held {
+		item.CourseStatus = CourseStatusFired
+		item.FiredAt = &now
+	}
+
+	o.UpdatedAt = now
+	return held, nil
+}
@@ -0,0 +1,114 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/restaurant-platform/shared/pkg/errors"
+)
+
+// CourseTestSuite contains hold-and-fire course tests
+type CourseTestSuite struct {
+	suite.Suite
+	order *Order
+}
+
+func TestCourseTestSuite(t *testing.T) {
+	suite.Run(t, new(CourseTestSuite))
+}
+
+func (suite *CourseTestSuite) SetupTest() {
+	suite.order, _ = NewOrder("customer-123", OrderTypeDineIn)
+	_ = suite.order.AddCourseItem(1, "calamari-1", "Calamari", 1, 11.00, nil, nil, "")
+	_ = suite.order.AddCourseItem(2, "steak-1", "Ribeye", 2, 34.00, nil, nil, "")
+	_ = suite.order.AddCourseItem(3, "tiramisu-1", "Tiramisu", 1, 9.00, nil, nil, "")
+}
+
+func (suite *CourseTestSuite) TestAddCourseItem_FirstCourseFiresLaterCoursesHeld() {
+	// Then
+	assert := assert.New(suite.T())
+	assert.Equal(CourseStatusFired, suite.order.Items[0].CourseStatus)
+	assert.NotNil(suite.order.Items[0].FiredAt)
+	assert.Equal(CourseStatusHeld, suite.order.Items[1].CourseStatus)
+	assert.Nil(suite.order.Items[1].FiredAt)
+	assert.Equal(CourseStatusHeld, suite.order.CourseStatus(3))
+}
+
+func (suite *CourseTestSuite) TestAddCourseItem_DefaultsToFirstCourse() {
+	// When
+	err := suite.order.AddItem("bread-1", "Bread", 1, 4.00, nil, "")
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	assert.Equal(DefaultCourse, suite.order.Items[3].Course)
+	assert.Equal(CourseStatusFired, suite.order.Items[3].CourseStatus)
+}
+
+func (suite *CourseTestSuite) TestAddCourseItem_NonDineInCannotHold_ShouldFail() {
+	// Given
+	takeout, _ := NewOrder("customer-123", OrderTypeTakeout)
+
+	// When
+	err := takeout.AddCourseItem(2, "steak-1", "Ribeye", 1, 34.00, nil, nil, "")
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.True(errors.IsValidationError(err))
+	assert.Empty(takeout.Items)
+}
+
+func (suite *CourseTestSuite) TestFireCourse_Success() {
+	// When
+	fired, err := suite.order.FireCourse(2)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	assert.Len(fired, 1)
+	assert.Equal(CourseStatusFired, suite.order.Items[1].CourseStatus)
+	assert.NotNil(suite.order.Items[1].FiredAt)
+	assert.Equal(CourseStatusHeld, suite.order.Items[2].CourseStatus)
+}
+
+func (suite *CourseTestSuite) TestFireCourse_ItemsAddedAfterFireAreFired() {
+	// Given
+	_, _ = suite.order.FireCourse(2)
+
+	// When
+	err := suite.order.AddCourseItem(2, "fries-1", "Fries", 1, 5.00, nil, nil, "")
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	assert.Equal(CourseStatusFired, suite.order.Items[3].CourseStatus)
+}
+
+func (suite *CourseTestSuite) TestFireCourse_AlreadyFired_ShouldFail() {
+	// When
+	_, err := suite.order.FireCourse(1)
+
+	// Then
+	assert.True(suite.T(), errors.IsConflictError(err))
+}
+
+func (suite *CourseTestSuite) TestFireCourse_UnknownCourse_ShouldFail() {
+	// When
+	_, err := suite.order.FireCourse(5)
+
+	// Then
+	assert.True(suite.T(), errors.IsNotFound(err))
+}
+
+func (suite *CourseTestSuite) TestFireCourse_CancelledOrder_ShouldFail() {
+	// Given
+	_ = suite.order.Cancel("", "")
+
+	// When
+	_, err := suite.order.FireCourse(2)
+
+	// Then
+	assert.True(suite.T(), errors.IsConflictError(err))
+}
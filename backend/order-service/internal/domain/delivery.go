@@ -0,0 +1,300 @@
+package domain
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"github.com/restaurant-platform/shared/pkg/errors"
+	"github.com/restaurant-platform/shared/pkg/types"
+)
+
+// Delivery domain entity markers for type-safe IDs
+type (
+	DeliveryZoneEntity struct{}
+	DriverEntity       struct{}
+	DeliveryEntity     struct{}
+)
+
+// Implement EntityMarker interface
+func (DeliveryZoneEntity) IsEntity() {}
+func (DriverEntity) IsEntity()       {}
+func (DeliveryEntity) IsEntity()     {}
+
+// Type-safe ID types using generics
+type (
+	DeliveryZoneID = types.ID[DeliveryZoneEntity]
+	DriverID       = types.ID[DriverEntity]
+	DeliveryID     = types.ID[DeliveryEntity]
+)
+
+// AverageDriverSpeedKmh is the average speed used to estimate travel time
+const AverageDriverSpeedKmh = 25.0
+
+// DriverStatus represents the availability of a driver
+type DriverStatus string
+
+const (
+	DriverStatusAvailable  DriverStatus = "AVAILABLE"
+	DriverStatusOnDelivery DriverStatus = "ON_DELIVERY"
+	DriverStatusOffDuty    DriverStatus = "OFF_DUTY"
+)
+
+// DeliveryStatus represents the possible states of a delivery
+type DeliveryStatus string
+
+const (
+	DeliveryStatusPending   DeliveryStatus = "PENDING"
+	DeliveryStatusAssigned  DeliveryStatus = "ASSIGNED"
+	DeliveryStatusPickedUp  DeliveryStatus = "PICKED_UP"
+	DeliveryStatusDelivered DeliveryStatus = "DELIVERED"
+)
+
+// Coordinates is a geographic point in decimal degrees
+type Coordinates struct {
+	Lat float64 `json:"lat"`
+	Lng float64 `json:"lng"`
+}
+
+// DeliveryZone is an area the restaurant delivers to, with its own fee and minimum order
+type DeliveryZone struct {
+	ID           DeliveryZoneID `json:"id"`
+	Name         string         `json:"name"`
+	Boundary     []Coordinates  `json:"boundary"`
+	Fee          float64        `json:"fee"`
+	MinimumOrder float64        `json:"minimum_order"`
+	IsActive     bool           `json:"is_active"`
+	CreatedAt    time.Time      `json:"created_at"`
+	UpdatedAt    time.Time      `json:"updated_at"`
+}
+
+// Driver is a member of staff who delivers orders
+type Driver struct {
+	ID        DriverID     `json:"id"`
+	Name      string       `json:"name"`
+	Phone     string       `json:"phone"`
+	Status    DriverStatus `json:"status"`
+	Version   int          `json:"version"`
+	CreatedAt time.Time    `json:"created_at"`
+	UpdatedAt time.Time    `json:"updated_at"`
+}
+
+// Delivery tracks a delivery order from driver assignment to hand-over
+type Delivery struct {
+	ID               DeliveryID     `json:"id"`
+	OrderID          OrderID        `json:"order_id"`
+	ZoneID           DeliveryZoneID `json:"zone_id"`
+	DriverID         DriverID       `json:"driver_id,omitempty"`
+	Address          string         `json:"address"`
+	Location         Coordinates    `json:"location"`
+	Fee              float64        `json:"fee"`
+	Status           DeliveryStatus `json:"status"`
+	EstimatedArrival *time.Time     `json:"estimated_arrival,omitempty"`
+	AssignedAt       *time.Time     `json:"assigned_at,omitempty"`
+	PickedUpAt       *time.Time     `json:"picked_up_at,omitempty"`
+	DeliveredAt      *time.Time     `json:"delivered_at,omitempty"`
+	Version          int            `json:"version"`
+	CreatedAt        time.Time      `json:"created_at"`
+	UpdatedAt        time.Time      `json:"updated_at"`
+}
+
+// NewDeliveryZone creates a new active delivery zone
+func NewDeliveryZone(name string, boundary []Coordinates, fee, minimumOrder float64) (*DeliveryZone, error) {
+	if name == "" {
+		return nil, errors.WrapValidation("NewDeliveryZone", "name", "zone name is required", nil)
+	}
+	if len(boundary) < 3 {
+		return nil, errors.WrapValidation("NewDeliveryZone", "boundary", "zone boundary needs at least three points", nil)
+	}
+	if fee < 0 {
+		return nil, errors.WrapValidation("NewDeliveryZone", "fee", "fee cannot be negative", nil)
+	}
+	if minimumOrder < 0 {
+		return nil, errors.WrapValidation("NewDeliveryZone", "minimumOrder", "minimum order cannot be negative", nil)
+	}
+
+	now := time.Now()
+	return &DeliveryZone{
+		ID:           types.NewID[DeliveryZoneEntity]("zone"),
+		Name:         name,
+		Boundary:     boundary,
+		Fee:          roundCents(fee),
+		MinimumOrder: roundCents(minimumOrder),
+		IsActive:     true,
+		CreatedAt:    now,
+		UpdatedAt:    now,
+	}, nil
+}
+
+// Contains reports whether a point lies inside the zone boundary, using ray casting
+func (z *DeliveryZone) Contains(point Coordinates) bool {
+	inside := false
+	for i, j := 0, len(z.Boundary)-1; i < len(z.Boundary); j, i = i, i+1 {
+		a, b := z.Boundary[i], z.Boundary[j]
+		if (a.Lat > point.Lat) != (b.Lat > point.Lat) &&
+			point.Lng < (b.Lng-a.Lng)*(point.Lat-a.Lat)/(b.Lat-a.Lat)+a.Lng {
+			inside = !inside
+		}
+	}
+	return inside
+}
+
+// FindZone returns the first active zone containing the point
+func FindZone(zones []*DeliveryZone, point Coordinates) (*DeliveryZone, error) {
+	for _, zone := range zones {
+		if zone.IsActive && zone.Contains(point) {
+			return zone, nil
+		}
+	}
+	return nil, errors.WrapValidation("FindZone", "address", "address is outside every delivery zone", nil)
+}
+
+// SetActive enables or disables the zone
+func (z *DeliveryZone) SetActive(isActive bool) {
+	z.IsActive = isActive
+	z.UpdatedAt = time.Now()
+}
+
+// NewDriver creates a new driver who is off duty until they clock in
+func NewDriver(name, phone string) (*Driver, error) {
+	if name == "" {
+		return nil, errors.WrapValidation("NewDriver", "name", "driver name is required", nil)
+	}
+	if phone == "" {
+		return nil, errors.WrapValidation("NewDriver", "phone", "driver phone is required", nil)
+	}
+
+	now := time.Now()
+	return &Driver{
+		ID:        types.NewID[DriverEntity]("drv"),
+		Name:      name,
+		Phone:     phone,
+		Status:    DriverStatusOffDuty,
+		Version:   1,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}, nil
+}
+
+// SetStatus moves a driver between available and off duty.
+// Drivers on a delivery are released by completing the delivery.
+func (d *Driver) SetStatus(status DriverStatus) error {
+	if status != DriverStatusAvailable && status != DriverStatusOffDuty {
+		return errors.WrapValidation("SetStatus", "status", "driver status must be AVAILABLE or OFF_DUTY", nil)
+	}
+	if d.Status == DriverStatusOnDelivery {
+		return errors.WrapConflict("SetStatus", "driver_status", "driver is out on a delivery", nil)
+	}
+
+	d.Status = status
+	d.UpdatedAt = time.Now()
+	return nil
+}
+
+// NewDelivery creates a pending delivery for an order at a geocoded address inside a zone
+func NewDelivery(orderID OrderID, zone *DeliveryZone, address string, location Coordinates) (*Delivery, error) {
+	if orderID.IsEmpty() {
+		return nil, errors.WrapValidation("NewDelivery", "orderID", "order ID is required", nil)
+	}
+	if address == "" {
+		return nil, errors.WrapValidation("NewDelivery", "address", "delivery address is required", nil)
+	}
+	if !zone.Contains(location) {
+		return nil, errors.WrapValidation("NewDelivery", "address", "address is outside the delivery zone", nil)
+	}
+
+	now := time.Now()
+	return &Delivery{
+		ID:        types.NewID[DeliveryEntity]("dlv"),
+		OrderID:   orderID,
+		ZoneID:    zone.ID,
+		Address:   address,
+		Location:  location,
+		Fee:       zone.Fee,
+		Status:    DeliveryStatusPending,
+		Version:   1,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}, nil
+}
+
+// Assign hands a pending delivery to an available driver
+func (d *Delivery) Assign(driver *Driver, eta time.Time) error {
+	if d.Status != DeliveryStatusPending {
+		return errors.WrapConflict("Assign", "delivery_status", "only pending deliveries can be assigned", nil)
+	}
+	if driver.Status != DriverStatusAvailable {
+		return errors.WrapConflict("Assign", "driver_status", "driver is not available", nil)
+	}
+
+	now := time.Now()
+	d.DriverID = driver.ID
+	d.Status = DeliveryStatusAssigned
+	d.AssignedAt = &now
+	d.EstimatedArrival = &eta
+	d.UpdatedAt = now
+
+	driver.Status = DriverStatusOnDelivery
+	driver.UpdatedAt = now
+	return nil
+}
+
+// PickUp records the driver leaving with the order and refreshes the ETA
+func (d *Delivery) PickUp(eta time.Time) error {
+	if d.Status != DeliveryStatusAssigned {
+		return errors.WrapConflict("PickUp", "delivery_status", "only assigned deliveries can be picked up", nil)
+	}
+
+	now := time.Now()
+	d.Status = DeliveryStatusPickedUp
+	d.PickedUpAt = &now
+	d.EstimatedArrival = &eta
+	d.UpdatedAt = now
+	return nil
+}
+
+// Deliver records the hand-over to the customer and frees the driver
+func (d *Delivery) Deliver(driver *Driver) error {
+	if d.Status != DeliveryStatusPickedUp {
+		return errors.WrapConflict("Deliver", "delivery_status", "only picked up deliveries can be delivered", nil)
+	}
+	if driver.ID != d.DriverID {
+		return errors.WrapConflict("Deliver", "driver", "delivery is assigned to another driver", nil)
+	}
+
+	now := time.Now()
+	d.Status = DeliveryStatusDelivered
+	d.DeliveredAt = &now
+	d.UpdatedAt = now
+
+	driver.Status = DriverStatusAvailable
+	driver.UpdatedAt = now
+	return nil
+}
+
+// EstimateTravelTime estimates the driving time between two points from the
+// great-circle distance at the average driver speed
+func EstimateTravelTime(from, to Coordinates) time.Duration {
+	hours := DistanceKm(from, to) / AverageDriverSpeedKmh
+	return time.Duration(hours * float64(time.Hour)).Round(time.Minute)
+}
+
+// DistanceKm returns the great-circle distance between two points using the haversine formula
+func DistanceKm(from, to Coordinates) float64 {
+	const earthRadiusKm = 6371.0
+
+	lat1 := from.Lat * math.Pi / 180
+	lat2 := to.Lat * math.Pi / 180
+	dLat := (to.Lat - from.Lat) * math.Pi / 180
+	dLng := (to.Lng - from.Lng) * math.Pi / 180
+
+	a := math.Sin(dLat/2)*math.Sin(dLat/2) +
+		math.Cos(lat1)*math.Cos(lat2)*math.Sin(dLng/2)*math.Sin(dLng/2)
+	return earthRadiusKm * 2 * math.Atan2(math.Sqrt(a), math.Sqrt(1-a))
+}
+
+// Geocoder resolves free-text addresses to coordinates
+type Geocoder interface {
+	// Geocode returns the coordinates of an address
+	Geocode(ctx context.Context, address string) (Coordinates, error)
+}
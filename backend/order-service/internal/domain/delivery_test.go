@@ -0,0 +1,227 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/restaurant-platform/shared/pkg/errors"
+)
+
+// DeliveryTestSuite contains delivery zone, driver and delivery tests
+type DeliveryTestSuite struct {
+	suite.Suite
+	zone   *DeliveryZone
+	driver *Driver
+}
+
+func TestDeliveryTestSuite(t *testing.T) {
+	suite.Run(t, new(DeliveryTestSuite))
+}
+
+func (suite *DeliveryTestSuite) SetupTest() {
+	// A 0.1 x 0.1 degree square zone
+	suite.zone, _ = NewDeliveryZone("Downtown", []Coordinates{
+		{Lat: 40.70, Lng: -74.02},
+		{Lat: 40.80, Lng: -74.02},
+		{Lat: 40.80, Lng: -73.92},
+		{Lat: 40.70, Lng: -73.92},
+	}, 4.99, 15.00)
+
+	suite.driver, _ = NewDriver("Sam", "555-0100")
+	_ = suite.driver.SetStatus(DriverStatusAvailable)
+}
+
+func (suite *DeliveryTestSuite) newDelivery() *Delivery {
+	delivery, _ := NewDelivery(OrderID("ord_1"), suite.zone, "1 Main St", Coordinates{Lat: 40.75, Lng: -73.97})
+	return delivery
+}
+
+func (suite *DeliveryTestSuite) TestNewDeliveryZone_TooFewPoints_ShouldFail() {
+	// When
+	zone, err := NewDeliveryZone("Line", []Coordinates{{Lat: 1, Lng: 1}, {Lat: 2, Lng: 2}}, 0, 0)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.Nil(zone)
+	assert.True(errors.IsValidationError(err))
+}
+
+func (suite *DeliveryTestSuite) TestContains() {
+	// Then
+	assert := assert.New(suite.T())
+	assert.True(suite.zone.Contains(Coordinates{Lat: 40.75, Lng: -73.97}))
+	assert.False(suite.zone.Contains(Coordinates{Lat: 40.85, Lng: -73.97}))
+	assert.False(suite.zone.Contains(Coordinates{Lat: 40.75, Lng: -73.90}))
+}
+
+func (suite *DeliveryTestSuite) TestContains_ConcavePolygon() {
+	// Given an L-shaped zone missing its north-east quadrant
+	zone, _ := NewDeliveryZone("L", []Coordinates{
+		{Lat: 0, Lng: 0},
+		{Lat: 2, Lng: 0},
+		{Lat: 2, Lng: 1},
+		{Lat: 1, Lng: 1},
+		{Lat: 1, Lng: 2},
+		{Lat: 0, Lng: 2},
+	}, 0, 0)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.True(zone.Contains(Coordinates{Lat: 0.5, Lng: 1.5}))
+	assert.True(zone.Contains(Coordinates{Lat: 1.5, Lng: 0.5}))
+	assert.False(zone.Contains(Coordinates{Lat: 1.5, Lng: 1.5}))
+}
+
+func (suite *DeliveryTestSuite) TestFindZone_SkipsInactiveZones() {
+	// Given
+	suite.zone.SetActive(false)
+
+	// When
+	zone, err := FindZone([]*DeliveryZone{suite.zone}, Coordinates{Lat: 40.75, Lng: -73.97})
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.Nil(zone)
+	assert.True(errors.IsValidationError(err))
+}
+
+func (suite *DeliveryTestSuite) TestNewDelivery_OutsideZone_ShouldFail() {
+	// When
+	delivery, err := NewDelivery(OrderID("ord_1"), suite.zone, "Far Away", Coordinates{Lat: 41.50, Lng: -73.97})
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.Nil(delivery)
+	assert.True(errors.IsValidationError(err))
+}
+
+func (suite *DeliveryTestSuite) TestDeliveryLifecycle() {
+	// Given
+	delivery := suite.newDelivery()
+	eta := time.Now().Add(20 * time.Minute)
+
+	// When
+	assignErr := delivery.Assign(suite.driver, eta)
+	pickUpErr := delivery.PickUp(eta)
+	deliverErr := delivery.Deliver(suite.driver)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(assignErr)
+	assert.NoError(pickUpErr)
+	assert.NoError(deliverErr)
+	assert.Equal(DeliveryStatusDelivered, delivery.Status)
+	assert.Equal(suite.driver.ID, delivery.DriverID)
+	assert.Equal(4.99, delivery.Fee)
+	assert.NotNil(delivery.AssignedAt)
+	assert.NotNil(delivery.PickedUpAt)
+	assert.NotNil(delivery.DeliveredAt)
+	assert.Equal(DriverStatusAvailable, suite.driver.Status)
+}
+
+func (suite *DeliveryTestSuite) TestAssign_DriverOnDelivery_ShouldFail() {
+	// Given
+	_ = suite.newDelivery().Assign(suite.driver, time.Now())
+
+	// When
+	err := suite.newDelivery().Assign(suite.driver, time.Now())
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.True(errors.IsConflictError(err))
+	assert.Equal(DriverStatusOnDelivery, suite.driver.Status)
+}
+
+func (suite *DeliveryTestSuite) TestPickUp_Unassigned_ShouldFail() {
+	// When
+	err := suite.newDelivery().PickUp(time.Now())
+
+	// Then
+	assert.True(suite.T(), errors.IsConflictError(err))
+}
+
+func (suite *DeliveryTestSuite) TestSetStatus_OnDelivery_ShouldFail() {
+	// Given
+	_ = suite.newDelivery().Assign(suite.driver, time.Now())
+
+	// When
+	err := suite.driver.SetStatus(DriverStatusOffDuty)
+
+	// Then
+	assert.True(suite.T(), errors.IsConflictError(err))
+}
+
+func (suite *DeliveryTestSuite) TestEstimateTravelTime() {
+	// Given roughly 5.5 km apart
+	from := Coordinates{Lat: 40.7128, Lng: -74.0060}
+	to := Coordinates{Lat: 40.7628, Lng: -74.0060}
+
+	// When
+	travel := EstimateTravelTime(from, to)
+
+	// Then 5.56 km at 25 km/h
+	assert.Equal(suite.T(), 13*time.Minute, travel)
+}
+
+func (suite *DeliveryTestSuite) TestOrderStatus_OutForDelivery() {
+	// Given
+	order, _ := NewOrder("customer-123", OrderTypeDelivery)
+	order.Status = OrderStatusReady
+
+	// When
+	outErr := order.UpdateStatus(OrderStatusOutForDelivery, "", "")
+	completeErr := order.UpdateStatus(OrderStatusCompleted, "", "")
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(outErr)
+	assert.NoError(completeErr)
+	assert.Equal(OrderStatusCompleted, order.Status)
+}
+
+func (suite *DeliveryTestSuite) TestOrderStatus_OutForDelivery_NonDelivery_ShouldFail() {
+	// Given
+	order, _ := NewOrder("customer-123", OrderTypeTakeout)
+	order.Status = OrderStatusReady
+
+	// When
+	err := order.UpdateStatus(OrderStatusOutForDelivery, "", "")
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.True(errors.IsConflictError(err))
+	assert.Equal(OrderStatusReady, order.Status)
+}
+
+func (suite *DeliveryTestSuite) TestSetDeliveryFee_AddedToTotalUntaxed() {
+	// Given
+	order, _ := NewOrder("customer-123", OrderTypeDelivery)
+	_ = order.AddItem("item-1", "Pizza", 2, 10.00, nil, "")
+
+	// When
+	err := order.SetDeliveryFee(4.99)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	assert.Equal(20.00, order.Subtotal())
+	assert.InDelta(2.00, order.TaxAmount, 0.001)
+	assert.InDelta(26.99, order.TotalAmount, 0.001)
+}
+
+func (suite *DeliveryTestSuite) TestSetDeliveryFee_PaidOrder_ShouldFail() {
+	// Given
+	order, _ := NewOrder("customer-123", OrderTypeDelivery)
+	order.Status = OrderStatusPaid
+
+	// When
+	err := order.SetDeliveryFee(4.99)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.True(errors.IsConflictError(err))
+	assert.Zero(order.DeliveryFee)
+}
@@ -0,0 +1,109 @@
+package domain
+
+import (
+	"math"
+	"time"
+
+	"github.com/restaurant-platform/shared/pkg/errors"
+	"github.com/restaurant-platform/shared/pkg/types"
+)
+
+// DiscountEntity marks order discount IDs
+type DiscountEntity struct{}
+
+func (DiscountEntity) IsEntity() {}
+
+// DiscountID is the type-safe ID of an order discount line
+type DiscountID = types.ID[DiscountEntity]
+
+// DiscountType identifies what an order discount was given for
+type DiscountType string
+
+const (
+	// DiscountTypeLoyalty is a discount paid for with loyalty points
+	DiscountTypeLoyalty DiscountType = "LOYALTY"
+)
+
+// OrderDiscount is a discount line taken off the order subtotal before tax
+type OrderDiscount struct {
+	ID          DiscountID   `json:"id"`
+	Type        DiscountType `json:"type"`
+	Description string       `json:"description"`
+	Amount      float64      `json:"amount"`
+	// Points is the number of loyalty points redeemed for a loyalty discount
+	Points    int       `json:"points,omitempty"`
+	AppliedBy string    `json:"applied_by"`
+	AppliedAt time.Time `json:"applied_at"`
+}
+
+// NewLoyaltyDiscount creates the discount line for a redemption of loyalty points
+func NewLoyaltyDiscount(points int, amount float64, appliedBy string) *OrderDiscount {
+	if appliedBy == "" {
+		appliedBy = SystemActor
+	}
+	return &OrderDiscount{
+		ID:          types.NewID[DiscountEntity]("dsc"),
+		Type:        DiscountTypeLoyalty,
+		Description: "Loyalty reward",
+		Amount:      roundCents(amount),
+		Points:      points,
+		AppliedBy:   appliedBy,
+		AppliedAt:   time.Now(),
+	}
+}
+
+// ApplyDiscount adds a discount line to an unpaid order. An order takes one
+// discount of each type, and discounts cannot exceed the subtotal.
+func (o *Order) ApplyDiscount(discount *OrderDiscount) error {
+	if o.Status != OrderStatusCreated {
+		return errors.WrapConflict("ApplyDiscount", "order_status", "discounts can only be applied before the order is paid", nil)
+	}
+	if discount.Amount <= 0 {
+		return errors.WrapValidation("ApplyDiscount", "amount", "discount amount must be positive", nil)
+	}
+	if o.findDiscount(discount.Type) != nil {
+		return errors.WrapConflict("ApplyDiscount", "discount", "the order already has a "+string(discount.Type)+" discount", nil)
+	}
+	if roundCents(o.DiscountTotal()+discount.Amount) > roundCents(o.Subtotal()) {
+		return errors.WrapValidation("ApplyDiscount", "amount", "discounts cannot exceed the order subtotal", nil)
+	}
+
+	o.Discounts = append(o.Discounts, discount)
+	o.recalculateTotal()
+	o.UpdatedAt = time.Now()
+	return nil
+}
+
+// DiscountTotal returns the amount of the discount lines, never more than the
+// subtotal, so removing items from a discounted order cannot make it negative
+func (o *Order) DiscountTotal() float64 {
+	var total float64
+	for _, discount := range o.Discounts {
+		total += discount.Amount
+	}
+	return math.Min(total, o.Subtotal())
+}
+
+// LoyaltyDiscount returns the loyalty discount line of the order, if any
+func (o *Order) LoyaltyDiscount() *OrderDiscount {
+	return o.findDiscount(DiscountTypeLoyalty)
+}
+
+// discounted returns the part of an amount of the subtotal left after the
+// order's discounts, which are spread over the items in proportion to their value
+func (o *Order) discounted(amount float64) float64 {
+	subtotal := o.Subtotal()
+	if subtotal <= 0 {
+		return amount
+	}
+	return amount * (1 - o.DiscountTotal()/subtotal)
+}
+
+func (o *Order) findDiscount(discountType DiscountType) *OrderDiscount {
+	for _, discount := range o.Discounts {
+		if discount.Type == discountType {
+			return discount
+		}
+	}
+	return nil
+}
@@ -0,0 +1,100 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/restaurant-platform/shared/pkg/errors"
+)
+
+// DiscountTestSuite contains order discount line tests
+type DiscountTestSuite struct {
+	suite.Suite
+	order *Order
+}
+
+func TestDiscountTestSuite(t *testing.T) {
+	suite.Run(t, new(DiscountTestSuite))
+}
+
+func (suite *DiscountTestSuite) SetupTest() {
+	suite.order, _ = NewOrder("customer-123", OrderTypeTakeout)
+	suite.order.AddItem("pizza", "Margherita Pizza", 2, 10.00, nil, "")
+	suite.order.AddItem("salad", "Caesar Salad", 1, 5.00, nil, "")
+}
+
+func (suite *DiscountTestSuite) TestApplyDiscount_TaxesDiscountedSubtotal() {
+	// When
+	err := suite.order.ApplyDiscount(NewLoyaltyDiscount(500, 5.00, "cashier-1"))
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	assert.Equal(25.00, suite.order.Subtotal())
+	assert.Equal(5.00, suite.order.DiscountTotal())
+	assert.InDelta(2.00, suite.order.TaxAmount, 0.001)
+	assert.InDelta(22.00, suite.order.TotalAmount, 0.001)
+	assert.Equal(500, suite.order.LoyaltyDiscount().Points)
+}
+
+func (suite *DiscountTestSuite) TestApplyDiscount_SecondLoyaltyDiscount_ShouldConflict() {
+	// Given
+	suite.order.ApplyDiscount(NewLoyaltyDiscount(500, 5.00, "cashier-1"))
+
+	// When
+	err := suite.order.ApplyDiscount(NewLoyaltyDiscount(500, 5.00, "cashier-1"))
+
+	// Then
+	assert.New(suite.T()).True(errors.IsConflictError(err))
+}
+
+func (suite *DiscountTestSuite) TestApplyDiscount_AboveSubtotal_ShouldFail() {
+	// When
+	err := suite.order.ApplyDiscount(NewLoyaltyDiscount(3000, 30.00, "cashier-1"))
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.True(errors.IsValidationError(err))
+	assert.Empty(suite.order.Discounts)
+}
+
+func (suite *DiscountTestSuite) TestApplyDiscount_PaidOrder_ShouldConflict() {
+	// Given
+	suite.order.UpdateStatus(OrderStatusPaid, "cashier-1", "")
+
+	// When
+	err := suite.order.ApplyDiscount(NewLoyaltyDiscount(500, 5.00, "cashier-1"))
+
+	// Then
+	assert.New(suite.T()).True(errors.IsConflictError(err))
+}
+
+func (suite *DiscountTestSuite) TestDiscountTotal_CappedAtSubtotalWhenItemsAreRemoved() {
+	// Given
+	suite.order.ApplyDiscount(NewLoyaltyDiscount(2000, 20.00, "cashier-1"))
+
+	// When
+	suite.order.RemoveItem(suite.order.Items[0].ID)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.Equal(5.00, suite.order.DiscountTotal())
+	assert.Zero(suite.order.TotalAmount)
+}
+
+func (suite *DiscountTestSuite) TestNewRefund_ItemsRefundedNetOfDiscount() {
+	// Given
+	suite.order.ApplyDiscount(NewLoyaltyDiscount(500, 5.00, "cashier-1"))
+	suite.order.UpdateStatus(OrderStatusPaid, "cashier-1", "")
+
+	// When
+	refund, err := suite.order.NewRefund([]OrderItemID{suite.order.Items[1].ID}, suite.order.TotalAmount,
+		AdjustmentReasonQualityIssue, "", "cashier-1")
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	assert.Equal(4.40, refund.Amount)
+}
@@ -0,0 +1,254 @@
+package domain
+
+import (
+	"strings"
+	"time"
+
+	"github.com/restaurant-platform/shared/pkg/errors"
+	"github.com/restaurant-platform/shared/pkg/types"
+)
+
+// Cash drawer domain entity markers for type-safe IDs
+type (
+	DrawerSessionEntity  struct{}
+	DrawerMovementEntity struct{}
+)
+
+func (DrawerSessionEntity) IsEntity()  {}
+func (DrawerMovementEntity) IsEntity() {}
+
+type (
+	DrawerSessionID  = types.ID[DrawerSessionEntity]
+	DrawerMovementID = types.ID[DrawerMovementEntity]
+)
+
+// DrawerSessionStatus represents the possible states of a cashier's drawer session
+type DrawerSessionStatus string
+
+const (
+	// DrawerSessionOpen takes cash tenders and movements
+	DrawerSessionOpen DrawerSessionStatus = "OPEN"
+	// DrawerSessionClosed has been counted and awaits a manager's sign-off
+	DrawerSessionClosed DrawerSessionStatus = "CLOSED"
+	// DrawerSessionSignedOff has been reviewed by a manager and is final
+	DrawerSessionSignedOff DrawerSessionStatus = "SIGNED_OFF"
+)
+
+// DrawerMovementType is cash put into or taken out of a drawer other than for orders
+type DrawerMovementType string
+
+const (
+	// DrawerMovementPaidIn is cash added to the drawer, such as extra change
+	DrawerMovementPaidIn DrawerMovementType = "PAID_IN"
+	// DrawerMovementPaidOut is cash paid out of the drawer, such as a supplier on delivery
+	DrawerMovementPaidOut DrawerMovementType = "PAID_OUT"
+	// DrawerMovementDrop is cash moved from the drawer to the safe
+	DrawerMovementDrop DrawerMovementType = "DROP"
+)
+
+// DrawerMovement is a paid-in, paid-out or drop recorded on a drawer session
+type DrawerMovement struct {
+	ID         DrawerMovementID   `json:"id"`
+	Type       DrawerMovementType `json:"type"`
+	Amount     float64            `json:"amount"`
+	Reason     string             `json:"reason,omitempty"`
+	RecordedBy string             `json:"recorded_by"`
+	CreatedAt  time.Time          `json:"created_at"`
+}
+
+// DrawerReport reconciles the cash a drawer should hold with the cash counted in it.
+// OverShort is positive when the drawer holds more than expected.
+type DrawerReport struct {
+	StartingFloat float64 `json:"starting_float"`
+	CashSales     float64 `json:"cash_sales"`
+	CashRefunds   float64 `json:"cash_refunds"`
+	PaidIns       float64 `json:"paid_ins"`
+	PaidOuts      float64 `json:"paid_outs"`
+	Drops         float64 `json:"drops"`
+	Expected      float64 `json:"expected"`
+	Counted       float64 `json:"counted"`
+	OverShort     float64 `json:"over_short"`
+	CashTenders   int     `json:"cash_tenders"`
+}
+
+// DrawerSession is a cashier's shift on a cash drawer, from the starting float
+// to the counted close and a manager's sign-off. Cash tenders and refunds are
+// linked to the session they were taken in or paid out of.
+type DrawerSession struct {
+	ID            DrawerSessionID     `json:"id"`
+	CashierID     string              `json:"cashier_id"`
+	Status        DrawerSessionStatus `json:"status"`
+	StartingFloat float64             `json:"starting_float"`
+	Movements     []*DrawerMovement   `json:"movements"`
+	// Report is the over/short report fixed when the session was closed
+	Report       *DrawerReport `json:"report,omitempty"`
+	OpenedAt     time.Time     `json:"opened_at"`
+	ClosedAt     *time.Time    `json:"closed_at,omitempty"`
+	SignedOffBy  string        `json:"signed_off_by,omitempty"`
+	SignedOffAt  *time.Time    `json:"signed_off_at,omitempty"`
+	SignOffNotes string        `json:"sign_off_notes,omitempty"`
+	Version      int           `json:"version"`
+	UpdatedAt    time.Time     `json:"updated_at"`
+}
+
+// NewDrawerSession opens a drawer session for a cashier with a starting float
+func NewDrawerSession(cashierID string, startingFloat float64) (*DrawerSession, error) {
+	if cashierID == "" {
+		return nil, errors.WrapValidation("NewDrawerSession", "cashier_id", "cashier is required", nil)
+	}
+	if startingFloat = roundCents(startingFloat); startingFloat < 0 {
+		return nil, errors.WrapValidation("NewDrawerSession", "starting_float", "starting float cannot be negative", nil)
+	}
+
+	now := time.Now()
+	return &DrawerSession{
+		ID:            types.NewID[DrawerSessionEntity]("drw"),
+		CashierID:     cashierID,
+		Status:        DrawerSessionOpen,
+		StartingFloat: startingFloat,
+		Movements:     make([]*DrawerMovement, 0),
+		OpenedAt:      now,
+		Version:       1,
+		UpdatedAt:     now,
+	}, nil
+}
+
+// RecordMovement records a paid-in, paid-out or drop on an open session.
+// Cash taken out of the drawer needs a reason.
+func (s *DrawerSession) RecordMovement(movementType DrawerMovementType, amount float64, reason, actor string) (*DrawerMovement, error) {
+	if s.Status != DrawerSessionOpen {
+		return nil, errors.WrapConflict("RecordMovement", "status", "drawer session is not open", nil)
+	}
+	switch movementType {
+	case DrawerMovementPaidIn, DrawerMovementDrop:
+	case DrawerMovementPaidOut:
+		if reason == "" {
+			return nil, errors.WrapValidation("RecordMovement", "reason", "a paid-out needs a reason", nil)
+		}
+	default:
+		return nil, errors.WrapValidation("RecordMovement", "type", "invalid drawer movement type", nil)
+	}
+	if amount = roundCents(amount); amount <= 0 {
+		return nil, errors.WrapValidation("RecordMovement", "amount", "amount must be positive", nil)
+	}
+
+	now := time.Now()
+	movement := &DrawerMovement{
+		ID:         types.NewID[DrawerMovementEntity]("dmv"),
+		Type:       movementType,
+		Amount:     amount,
+		Reason:     reason,
+		RecordedBy: actor,
+		CreatedAt:  now,
+	}
+
+	s.Movements = append(s.Movements, movement)
+	s.UpdatedAt = now
+	return movement, nil
+}
+
+// Reconcile totals the cash that went through the session: its movements and the
+// live cash tenders and cash refunds of the given payments linked to it. The
+// counted amount is left at zero; on an open session this is a running tally.
+func (s *DrawerSession) Reconcile(payments []*Payment) DrawerReport {
+	report := DrawerReport{StartingFloat: s.StartingFloat}
+
+	for _, movement := range s.Movements {
+		switch movement.Type {
+		case DrawerMovementPaidIn:
+			report.PaidIns += movement.Amount
+		case DrawerMovementPaidOut:
+			report.PaidOuts += movement.Amount
+		case DrawerMovementDrop:
+			report.Drops += movement.Amount
+		}
+	}
+
+	for _, payment := range payments {
+		for _, tender := range payment.Tenders {
+			if tender.Type == TenderTypeCash && tender.DrawerSessionID == s.ID && tender.Status != TenderStatusVoided {
+				report.CashSales += tender.Amount
+				report.CashTenders++
+			}
+		}
+		for _, refund := range payment.Refunds {
+			if refund.DrawerSessionID == s.ID {
+				report.CashRefunds += refund.Amount
+			}
+		}
+	}
+
+	report.CashSales = roundCents(report.CashSales)
+	report.CashRefunds = roundCents(report.CashRefunds)
+	report.PaidIns = roundCents(report.PaidIns)
+	report.PaidOuts = roundCents(report.PaidOuts)
+	report.Drops = roundCents(report.Drops)
+	report.Expected = roundCents(report.StartingFloat + report.CashSales - report.CashRefunds +
+		report.PaidIns - report.PaidOuts - report.Drops)
+	return report
+}
+
+// OpenOrders returns the orders whose payment took cash into the session but has
+// not been settled or voided yet
+func (s *DrawerSession) OpenOrders(payments []*Payment) []OrderID {
+	var open []OrderID
+	for _, payment := range payments {
+		if !payment.CanAcceptTender() {
+			continue
+		}
+		for _, tender := range payment.Tenders {
+			if tender.DrawerSessionID == s.ID {
+				open = append(open, payment.OrderID)
+				break
+			}
+		}
+	}
+	return open
+}
+
+// Close counts the drawer and fixes its over/short report. A drawer cannot be
+// closed while orders it took cash for are still open.
+func (s *DrawerSession) Close(counted float64, payments []*Payment) (*DrawerReport, error) {
+	if s.Status != DrawerSessionOpen {
+		return nil, errors.WrapConflict("Close", "status", "drawer session is not open", nil)
+	}
+	if counted = roundCents(counted); counted < 0 {
+		return nil, errors.WrapValidation("Close", "counted", "counted amount cannot be negative", nil)
+	}
+	if open := s.OpenOrders(payments); len(open) > 0 {
+		ids := make([]string, len(open))
+		for i, id := range open {
+			ids[i] = id.String()
+		}
+		return nil, errors.WrapConflict("Close", "orders", "drawer still has open orders: "+strings.Join(ids, ", "), nil)
+	}
+
+	report := s.Reconcile(payments)
+	report.Counted = counted
+	report.OverShort = roundCents(counted - report.Expected)
+
+	now := time.Now()
+	s.Report = &report
+	s.Status = DrawerSessionClosed
+	s.ClosedAt = &now
+	s.UpdatedAt = now
+	return s.Report, nil
+}
+
+// SignOff ends a closed session. The cashier who ran the drawer cannot sign it off.
+func (s *DrawerSession) SignOff(manager, notes string) error {
+	if s.Status != DrawerSessionClosed {
+		return errors.WrapConflict("SignOff", "status", "only closed drawer sessions can be signed off", nil)
+	}
+	if manager == s.CashierID {
+		return errors.WrapConflict("SignOff", "signed_off_by", "a drawer session cannot be signed off by its own cashier", nil)
+	}
+
+	now := time.Now()
+	s.Status = DrawerSessionSignedOff
+	s.SignedOffBy = manager
+	s.SignedOffAt = &now
+	s.SignOffNotes = notes
+	s.UpdatedAt = now
+	return nil
+}
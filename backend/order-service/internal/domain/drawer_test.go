@@ -0,0 +1,138 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/restaurant-platform/shared/pkg/errors"
+)
+
+// DrawerSessionTestSuite contains drawer movement, reconciliation and sign-off tests
+type DrawerSessionTestSuite struct {
+	suite.Suite
+	session *DrawerSession
+}
+
+func TestDrawerSessionTestSuite(t *testing.T) {
+	suite.Run(t, new(DrawerSessionTestSuite))
+}
+
+func (suite *DrawerSessionTestSuite) SetupTest() {
+	suite.session, _ = NewDrawerSession("cashier-1", 100.00)
+}
+
+// cashPayment pays an order in full with cash taken into the given session
+func cashPayment(orderID OrderID, total float64, sessionID DrawerSessionID) *Payment {
+	payment, _ := NewPayment(orderID, total)
+	tender, _ := payment.AddTender(TenderTypeCash, 0, total, "", "")
+	tender.DrawerSessionID = sessionID
+	return payment
+}
+
+func (suite *DrawerSessionTestSuite) TestNewDrawerSession_Invalid_ShouldFail() {
+	assert := assert.New(suite.T())
+
+	_, err := NewDrawerSession("", 100.00)
+	assert.True(errors.IsValidationError(err))
+
+	_, err = NewDrawerSession("cashier-1", -1.00)
+	assert.True(errors.IsValidationError(err))
+}
+
+func (suite *DrawerSessionTestSuite) TestRecordMovement_PaidOutNeedsReason() {
+	assert := assert.New(suite.T())
+
+	_, err := suite.session.RecordMovement(DrawerMovementPaidOut, 10.00, "", "cashier-1")
+	assert.True(errors.IsValidationError(err))
+
+	movement, err := suite.session.RecordMovement(DrawerMovementPaidOut, 10.00, "milk", "cashier-1")
+	assert.NoError(err)
+	assert.Equal("cashier-1", movement.RecordedBy)
+	assert.Len(suite.session.Movements, 1)
+
+	_, err = suite.session.RecordMovement(DrawerMovementPaidIn, 0, "", "cashier-1")
+	assert.True(errors.IsValidationError(err))
+}
+
+func (suite *DrawerSessionTestSuite) TestReconcile_CountsOnlyThisSessionsLiveCash() {
+	// Given
+	suite.session.RecordMovement(DrawerMovementPaidIn, 20.00, "", "cashier-1")
+	suite.session.RecordMovement(DrawerMovementPaidOut, 7.50, "milk", "cashier-1")
+	suite.session.RecordMovement(DrawerMovementDrop, 50.00, "", "cashier-1")
+
+	sale := cashPayment("ord_1", 30.00, suite.session.ID)
+	refund, _ := sale.Refund(sale.Tenders[0].ID, 4.00, "cold", "")
+	refund.DrawerSessionID = suite.session.ID
+
+	otherDrawer := cashPayment("ord_2", 12.00, "drw_other")
+
+	voided, _ := NewPayment("ord_3", 40.00)
+	tender, _ := voided.AddTender(TenderTypeCash, 0, 10.00, "", "")
+	tender.DrawerSessionID = suite.session.ID
+	voided.Void("customer left")
+	voided.VoidTender(tender.ID)
+
+	// When
+	report := suite.session.Reconcile([]*Payment{sale, otherDrawer, voided})
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.Equal(30.00, report.CashSales)
+	assert.Equal(1, report.CashTenders)
+	assert.Equal(4.00, report.CashRefunds)
+	assert.Equal(20.00, report.PaidIns)
+	assert.Equal(7.50, report.PaidOuts)
+	assert.Equal(50.00, report.Drops)
+	assert.Equal(88.50, report.Expected)
+}
+
+func (suite *DrawerSessionTestSuite) TestClose_FixesReportAndBlocksMovements() {
+	// Given
+	payments := []*Payment{cashPayment("ord_1", 22.00, suite.session.ID)}
+
+	// When
+	report, err := suite.session.Close(125.00, payments)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	assert.Equal(122.00, report.Expected)
+	assert.Equal(3.00, report.OverShort)
+	assert.Equal(DrawerSessionClosed, suite.session.Status)
+	assert.NotNil(suite.session.ClosedAt)
+
+	_, err = suite.session.RecordMovement(DrawerMovementPaidIn, 5.00, "", "cashier-1")
+	assert.True(errors.IsConflictError(err))
+}
+
+func (suite *DrawerSessionTestSuite) TestClose_OpenOrders_ShouldFail() {
+	// Given
+	open, _ := NewPayment("ord_open", 40.00)
+	tender, _ := open.AddTender(TenderTypeCash, 0, 10.00, "", "")
+	tender.DrawerSessionID = suite.session.ID
+
+	// When
+	_, err := suite.session.Close(110.00, []*Payment{open})
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.True(errors.IsConflictError(err))
+	assert.Contains(err.Error(), "ord_open")
+	assert.Equal(DrawerSessionOpen, suite.session.Status)
+}
+
+func (suite *DrawerSessionTestSuite) TestSignOff_RequiresClosedSessionAndAnotherUser() {
+	assert := assert.New(suite.T())
+
+	assert.True(errors.IsConflictError(suite.session.SignOff("manager-1", "")))
+
+	suite.session.Close(100.00, nil)
+	assert.True(errors.IsConflictError(suite.session.SignOff("cashier-1", "")))
+
+	assert.NoError(suite.session.SignOff("manager-1", "ok"))
+	assert.Equal(DrawerSessionSignedOff, suite.session.Status)
+	assert.Equal("manager-1", suite.session.SignedOffBy)
+	assert.NotNil(suite.session.SignedOffAt)
+}
@@ -0,0 +1,210 @@
+package domain
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/restaurant-platform/shared/pkg/errors"
+	"github.com/restaurant-platform/shared/pkg/types"
+)
+
+// Gift card domain entity markers for type-safe IDs
+type (
+	GiftCardEntity            struct{}
+	GiftCardTransactionEntity struct{}
+)
+
+func (GiftCardEntity) IsEntity()            {}
+func (GiftCardTransactionEntity) IsEntity() {}
+
+type (
+	GiftCardID            = types.ID[GiftCardEntity]
+	GiftCardTransactionID = types.ID[GiftCardTransactionEntity]
+)
+
+// giftCardCodeAlphabet leaves out characters that are easily misread (0/O, 1/I)
+const giftCardCodeAlphabet = "ABCDEFGHJKLMNPQRSTUVWXYZ23456789"
+
+// giftCardCodeLength is the number of characters in a code; with 32 symbols
+// 16 characters carry 80 random bits
+const giftCardCodeLength = 16
+
+// GiftCardTransactionType is the kind of balance movement a transaction records
+type GiftCardTransactionType string
+
+const (
+	// GiftCardTransactionIssue credits the opening balance of a new card
+	GiftCardTransactionIssue GiftCardTransactionType = "ISSUE"
+	// GiftCardTransactionReload credits money added to a card
+	GiftCardTransactionReload GiftCardTransactionType = "RELOAD"
+	// GiftCardTransactionRedeem debits a card tendered for an order
+	GiftCardTransactionRedeem GiftCardTransactionType = "REDEEM"
+	// GiftCardTransactionRefund credits back part or all of a redemption
+	GiftCardTransactionRefund GiftCardTransactionType = "REFUND"
+)
+
+// GiftCard is a stored-value card. Only a hash of its code is kept; the code
+// itself is known to the holder and returned once, when the card is issued.
+type GiftCard struct {
+	ID         GiftCardID `json:"id"`
+	Code       string     `json:"code,omitempty"`
+	CodeHash   string     `json:"-"`
+	MaskedCode string     `json:"masked_code"`
+	Balance    float64    `json:"balance"`
+	IssuedBy   string     `json:"issued_by"`
+	Version    int        `json:"version"`
+	CreatedAt  time.Time  `json:"created_at"`
+	UpdatedAt  time.Time  `json:"updated_at"`
+}
+
+// GiftCardTransaction is a line of a card's balance ledger. Credits are positive
+// and debits negative; BalanceAfter is the card balance once it was booked.
+type GiftCardTransaction struct {
+	ID           GiftCardTransactionID   `json:"id"`
+	GiftCardID   GiftCardID              `json:"gift_card_id"`
+	Type         GiftCardTransactionType `json:"type"`
+	Amount       float64                 `json:"amount"`
+	BalanceAfter float64                 `json:"balance_after"`
+	OrderID      OrderID                 `json:"order_id,omitempty"`
+	// RedemptionID is the redemption a refund credits back
+	RedemptionID GiftCardTransactionID `json:"redemption_id,omitempty"`
+	CreatedBy    string                `json:"created_by"`
+	CreatedAt    time.Time             `json:"created_at"`
+}
+
+// GenerateGiftCardCode creates a random card code formatted in groups of four
+func GenerateGiftCardCode() (string, error) {
+	random := make([]byte, giftCardCodeLength)
+	if _, err := rand.Read(random); err != nil {
+		return "", fmt.Errorf("failed to generate gift card code: %w", err)
+	}
+
+	var code strings.Builder
+	for i, b := range random {
+		if i > 0 && i%4 == 0 {
+			code.WriteByte('-')
+		}
+		code.WriteByte(giftCardCodeAlphabet[int(b)%len(giftCardCodeAlphabet)])
+	}
+	return code.String(), nil
+}
+
+// NormalizeGiftCardCode strips separators and case from a code as typed or scanned
+func NormalizeGiftCardCode(code string) string {
+	return strings.Map(func(r rune) rune {
+		if r == '-' || r == ' ' {
+			return -1
+		}
+		return r
+	}, strings.ToUpper(strings.TrimSpace(code)))
+}
+
+// HashGiftCardCode returns the hash a card is looked up by
+func HashGiftCardCode(code string) string {
+	sum := sha256.Sum256([]byte(NormalizeGiftCardCode(code)))
+	return hex.EncodeToString(sum[:])
+}
+
+// MaskGiftCardCode hides all but the last four characters of a code, for
+// receipts and tender references
+func MaskGiftCardCode(code string) string {
+	normalized := NormalizeGiftCardCode(code)
+	if len(normalized) <= 4 {
+		return normalized
+	}
+	return "****-" + normalized[len(normalized)-4:]
+}
+
+// NewGiftCard issues a card with the given code and opening balance
+func NewGiftCard(code string, amount float64, issuedBy string) (*GiftCard, *GiftCardTransaction, error) {
+	if len(NormalizeGiftCardCode(code)) != giftCardCodeLength {
+		return nil, nil, errors.WrapValidation("NewGiftCard", "code", "gift card code is malformed", nil)
+	}
+	if amount = roundCents(amount); amount <= 0 {
+		return nil, nil, errors.WrapValidation("NewGiftCard", "amount", "amount must be positive", nil)
+	}
+
+	now := time.Now()
+	card := &GiftCard{
+		ID:         types.NewID[GiftCardEntity]("gft"),
+		Code:       code,
+		CodeHash:   HashGiftCardCode(code),
+		MaskedCode: MaskGiftCardCode(code),
+		IssuedBy:   issuedBy,
+		Version:    1,
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}
+	return card, card.book(GiftCardTransactionIssue, amount, "", issuedBy, now), nil
+}
+
+// Reload adds money to the card
+func (c *GiftCard) Reload(amount float64, actor string) (*GiftCardTransaction, error) {
+	if amount = roundCents(amount); amount <= 0 {
+		return nil, errors.WrapValidation("Reload", "amount", "amount must be positive", nil)
+	}
+	return c.book(GiftCardTransactionReload, amount, "", actor, time.Now()), nil
+}
+
+// Redeem debits up to the given amount for an order. A card whose balance does
+// not cover the amount pays what it holds and the rest is left for another tender.
+func (c *GiftCard) Redeem(amount float64, orderID OrderID, actor string) (*GiftCardTransaction, error) {
+	if amount = roundCents(amount); amount <= 0 {
+		return nil, errors.WrapValidation("Redeem", "amount", "amount must be positive", nil)
+	}
+	if c.Balance <= 0 {
+		return nil, errors.WrapConflict("Redeem", "balance", "gift card has no balance left", nil)
+	}
+
+	if amount > c.Balance {
+		amount = c.Balance
+	}
+	return c.book(GiftCardTransactionRedeem, -amount, orderID, actor, time.Now()), nil
+}
+
+// Refund credits back part or all of a redemption. The transactions of the card
+// are needed to find how much of the redemption was already refunded.
+func (c *GiftCard) Refund(redemption *GiftCardTransaction, transactions []*GiftCardTransaction, amount float64, actor string) (*GiftCardTransaction, error) {
+	if redemption.GiftCardID != c.ID || redemption.Type != GiftCardTransactionRedeem {
+		return nil, errors.WrapValidation("Refund", "redemption", "transaction is not a redemption of this gift card", nil)
+	}
+
+	refundable := -redemption.Amount
+	for _, transaction := range transactions {
+		if transaction.Type == GiftCardTransactionRefund && transaction.RedemptionID == redemption.ID {
+			refundable -= transaction.Amount
+		}
+	}
+
+	if amount = roundCents(amount); amount <= 0 {
+		return nil, errors.WrapValidation("Refund", "amount", "amount must be positive", nil)
+	}
+	if amount > roundCents(refundable) {
+		return nil, errors.WrapValidation("Refund", "amount", "amount exceeds refundable balance of redemption", nil)
+	}
+
+	refund := c.book(GiftCardTransactionRefund, amount, redemption.OrderID, actor, time.Now())
+	refund.RedemptionID = redemption.ID
+	return refund, nil
+}
+
+// book applies a signed amount to the balance and records it as a transaction
+func (c *GiftCard) book(transactionType GiftCardTransactionType, amount float64, orderID OrderID, actor string, at time.Time) *GiftCardTransaction {
+	c.Balance = roundCents(c.Balance + amount)
+	c.UpdatedAt = at
+
+	return &GiftCardTransaction{
+		ID:           types.NewID[GiftCardTransactionEntity]("gct"),
+		GiftCardID:   c.ID,
+		Type:         transactionType,
+		Amount:       amount,
+		BalanceAfter: c.Balance,
+		OrderID:      orderID,
+		CreatedBy:    actor,
+		CreatedAt:    at,
+	}
+}
@@ -0,0 +1,127 @@
+package domain
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/restaurant-platform/shared/pkg/errors"
+)
+
+// GiftCardTestSuite contains gift card code and balance tests
+type GiftCardTestSuite struct {
+	suite.Suite
+	code string
+	card *GiftCard
+}
+
+func TestGiftCardTestSuite(t *testing.T) {
+	suite.Run(t, new(GiftCardTestSuite))
+}
+
+func (suite *GiftCardTestSuite) SetupTest() {
+	suite.code, _ = GenerateGiftCardCode()
+	suite.card, _, _ = NewGiftCard(suite.code, 40.00, "cashier-1")
+}
+
+func (suite *GiftCardTestSuite) TestGenerateGiftCardCode_FormatAndUniqueness() {
+	assert := assert.New(suite.T())
+	format := regexp.MustCompile(`^[A-HJ-NP-Z2-9]{4}(-[A-HJ-NP-Z2-9]{4}){3}$`)
+
+	seen := make(map[string]bool)
+	for i := 0; i < 1000; i++ {
+		code, err := GenerateGiftCardCode()
+		assert.NoError(err)
+		assert.Regexp(format, code)
+		assert.False(seen[code])
+		seen[code] = true
+	}
+}
+
+func (suite *GiftCardTestSuite) TestCodeHelpers_IgnoreCaseAndSeparators() {
+	assert := assert.New(suite.T())
+	typed := " " + strings.ToLower(NormalizeGiftCardCode(suite.code)[:8]) + " " + NormalizeGiftCardCode(suite.code)[8:]
+	other := "AAAA-AAAA-AAAA-AAAA"
+	if suite.code == other {
+		other = "BBBB-BBBB-BBBB-BBBB"
+	}
+
+	assert.Equal(HashGiftCardCode(suite.code), HashGiftCardCode(typed))
+	assert.NotEqual(HashGiftCardCode(suite.code), HashGiftCardCode(other))
+	assert.Equal("****-"+suite.code[15:], MaskGiftCardCode(suite.code))
+}
+
+func (suite *GiftCardTestSuite) TestNewGiftCard_BooksIssue() {
+	// When
+	card, issue, err := NewGiftCard(suite.code, 25.00, "cashier-1")
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	assert.Equal(25.00, card.Balance)
+	assert.Equal(1, card.Version)
+	assert.Equal(suite.code, card.Code)
+	assert.Equal(HashGiftCardCode(suite.code), card.CodeHash)
+	assert.Equal(GiftCardTransactionIssue, issue.Type)
+	assert.Equal(25.00, issue.Amount)
+	assert.Equal(card.ID, issue.GiftCardID)
+}
+
+func (suite *GiftCardTestSuite) TestNewGiftCard_Invalid_ShouldFail() {
+	assert := assert.New(suite.T())
+
+	_, _, err := NewGiftCard(suite.code, 0, "cashier-1")
+	assert.True(errors.IsValidationError(err))
+
+	_, _, err = NewGiftCard("ABCD-1234", 25.00, "cashier-1")
+	assert.True(errors.IsValidationError(err))
+}
+
+func (suite *GiftCardTestSuite) TestRedeem_PartialBalance() {
+	// When
+	first, err := suite.card.Redeem(30.00, "ord_1", "cashier-1")
+	second, secondErr := suite.card.Redeem(30.00, "ord_2", "cashier-1")
+	_, emptyErr := suite.card.Redeem(5.00, "ord_3", "cashier-1")
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	assert.Equal(-30.00, first.Amount)
+	assert.Equal(10.00, first.BalanceAfter)
+	assert.NoError(secondErr)
+	assert.Equal(-10.00, second.Amount)
+	assert.Zero(suite.card.Balance)
+	assert.True(errors.IsConflictError(emptyErr))
+}
+
+func (suite *GiftCardTestSuite) TestRefund_CappedAtRedemption() {
+	// Given
+	redemption, _ := suite.card.Redeem(30.00, "ord_1", "cashier-1")
+	partial, _ := suite.card.Refund(redemption, nil, 20.00, "cashier-1")
+
+	// When
+	_, err := suite.card.Refund(redemption, []*GiftCardTransaction{redemption, partial}, 15.00, "cashier-1")
+	rest, restErr := suite.card.Refund(redemption, []*GiftCardTransaction{redemption, partial}, 10.00, "cashier-1")
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.True(errors.IsValidationError(err))
+	assert.NoError(restErr)
+	assert.Equal(redemption.ID, rest.RedemptionID)
+	assert.Equal(OrderID("ord_1"), rest.OrderID)
+	assert.Equal(40.00, suite.card.Balance)
+}
+
+func (suite *GiftCardTestSuite) TestRefund_NotARedemption_ShouldFail() {
+	// Given
+	reload, _ := suite.card.Reload(10.00, "cashier-1")
+
+	// When
+	_, err := suite.card.Refund(reload, nil, 5.00, "cashier-1")
+
+	// Then
+	assert.New(suite.T()).True(errors.IsValidationError(err))
+}
@@ -0,0 +1,407 @@
+package domain
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/restaurant-platform/shared/pkg/errors"
+	"github.com/restaurant-platform/shared/pkg/types"
+)
+
+// LoyaltyEntryEntity marks loyalty ledger entry IDs
+type LoyaltyEntryEntity struct{}
+
+func (LoyaltyEntryEntity) IsEntity() {}
+
+// LoyaltyEntryID is the type-safe ID of a loyalty ledger entry
+type LoyaltyEntryID = types.ID[LoyaltyEntryEntity]
+
+// LoyaltyEntryType is the kind of movement of points a ledger entry records
+type LoyaltyEntryType string
+
+const (
+	// LoyaltyEntryEarn credits the points earned on a completed order
+	LoyaltyEntryEarn LoyaltyEntryType = "EARN"
+	// LoyaltyEntryRedeem debits the points spent on an order discount
+	LoyaltyEntryRedeem LoyaltyEntryType = "REDEEM"
+	// LoyaltyEntryReversal debits the points earned on items that were refunded
+	LoyaltyEntryReversal LoyaltyEntryType = "REVERSAL"
+	// LoyaltyEntryRestore credits back the points of a redemption whose order was cancelled
+	LoyaltyEntryRestore LoyaltyEntryType = "RESTORE"
+	// LoyaltyEntryExpire debits points that were not used before they expired
+	LoyaltyEntryExpire LoyaltyEntryType = "EXPIRE"
+)
+
+// LoyaltyEntry is a line of a customer's points ledger. Credits are positive and
+// debits negative; the balance is their sum. The reference identifies what the
+// entry was booked for and is unique, so replaying an event cannot book it twice.
+type LoyaltyEntry struct {
+	ID         LoyaltyEntryID   `json:"id"`
+	CustomerID string           `json:"customer_id"`
+	Type       LoyaltyEntryType `json:"type"`
+	Points     int              `json:"points"`
+	OrderID    OrderID          `json:"order_id,omitempty"`
+	Reference  string           `json:"reference"`
+	// Amount is the order amount the points were earned, reversed or redeemed for
+	Amount      float64    `json:"amount,omitempty"`
+	Description string     `json:"description"`
+	ExpiresAt   *time.Time `json:"expires_at,omitempty"`
+	CreatedAt   time.Time  `json:"created_at"`
+}
+
+// Ledger references of the entries booked for orders, refunds and redemptions
+func earnReference(orderID OrderID) string            { return "earn:" + orderID.String() }
+func reversalReference(adjustmentID string) string    { return "reverse:" + adjustmentID }
+func redeemReference(discountID DiscountID) string    { return "redeem:" + discountID.String() }
+func restoreReference(discountID DiscountID) string   { return "restore:" + discountID.String() }
+func expireReference(lastEntry LoyaltyEntryID) string { return "expire:" + lastEntry.String() }
+
+// LoyaltyTier is a membership level reached with lifetime points; points earned
+// in a tier are multiplied by its multiplier
+type LoyaltyTier struct {
+	Name       string  `json:"name"`
+	MinPoints  int     `json:"min_points"`
+	Multiplier float64 `json:"multiplier"`
+}
+
+// LoyaltyProgram holds the rules for earning and redeeming points
+type LoyaltyProgram struct {
+	// PointsPerDollar is the points earned per dollar spent on items
+	PointsPerDollar float64
+	// CategoryRates overrides the points per dollar for menu categories, keyed in lower case
+	CategoryRates map[string]float64
+	// PointValue is the discount in dollars a point is worth when redeemed
+	PointValue float64
+	// MinRedemption is the fewest points that can be redeemed at once
+	MinRedemption int
+	// Expiry is how long earned points last; zero means they never expire
+	Expiry time.Duration
+	// Tiers are the membership levels ordered by their lifetime points
+	Tiers []LoyaltyTier
+}
+
+// NewLoyaltyProgram builds the program rules from configuration. Category names are case-insensitive.
+func NewLoyaltyProgram(pointsPerDollar float64, categoryRates map[string]float64, pointValue float64, minRedemption int, expiry time.Duration, tiers []LoyaltyTier) (LoyaltyProgram, error) {
+	if pointsPerDollar < 0 {
+		return LoyaltyProgram{}, fmt.Errorf("invalid points per dollar %v: must not be negative", pointsPerDollar)
+	}
+	if pointValue <= 0 {
+		return LoyaltyProgram{}, fmt.Errorf("invalid point value %v: must be positive", pointValue)
+	}
+	if minRedemption < 0 {
+		return LoyaltyProgram{}, fmt.Errorf("invalid minimum redemption %d: must not be negative", minRedemption)
+	}
+	if expiry < 0 {
+		return LoyaltyProgram{}, fmt.Errorf("invalid points expiry %s: must not be negative", expiry)
+	}
+
+	program := LoyaltyProgram{
+		PointsPerDollar: pointsPerDollar,
+		CategoryRates:   make(map[string]float64, len(categoryRates)),
+		PointValue:      pointValue,
+		MinRedemption:   minRedemption,
+		Expiry:          expiry,
+		Tiers:           append([]LoyaltyTier(nil), tiers...),
+	}
+	for category, rate := range categoryRates {
+		if rate < 0 {
+			return LoyaltyProgram{}, fmt.Errorf("invalid points per dollar %v for category %q: must not be negative", rate, category)
+		}
+		program.CategoryRates[strings.ToLower(category)] = rate
+	}
+	for _, tier := range program.Tiers {
+		if tier.Name == "" {
+			return LoyaltyProgram{}, fmt.Errorf("invalid loyalty tier: name is required")
+		}
+		if tier.MinPoints < 0 || tier.Multiplier <= 0 {
+			return LoyaltyProgram{}, fmt.Errorf("invalid loyalty tier %q: minimum points must not be negative and the multiplier must be positive", tier.Name)
+		}
+	}
+	sort.SliceStable(program.Tiers, func(i, j int) bool {
+		return program.Tiers[i].MinPoints < program.Tiers[j].MinPoints
+	})
+
+	return program, nil
+}
+
+// TierFor returns the highest tier reached with the lifetime points, if any
+func (p LoyaltyProgram) TierFor(lifetimePoints int) *LoyaltyTier {
+	var reached *LoyaltyTier
+	for i := range p.Tiers {
+		if p.Tiers[i].MinPoints <= lifetimePoints {
+			reached = &p.Tiers[i]
+		}
+	}
+	return reached
+}
+
+// RateFor returns the points per dollar earned on items of a menu category
+func (p LoyaltyProgram) RateFor(category string) float64 {
+	if rate, ok := p.CategoryRates[strings.ToLower(category)]; ok {
+		return rate
+	}
+	return p.PointsPerDollar
+}
+
+// RedemptionValue returns the discount the points are worth
+func (p LoyaltyProgram) RedemptionValue(points int) float64 {
+	return roundCents(float64(points) * p.PointValue)
+}
+
+// IsLoyaltyCustomer reports whether a customer ID belongs to a guest who can
+// collect points, rather than a marketplace the order was placed through
+func IsLoyaltyCustomer(customerID string) bool {
+	return customerID != "" && !strings.HasPrefix(customerID, marketplaceActorPrefix)
+}
+
+// LoyaltyAccount is a customer's points position, derived from their ledger
+type LoyaltyAccount struct {
+	CustomerID string `json:"customer_id"`
+	Balance    int    `json:"balance"`
+	// LifetimePoints is the points earned less those reversed by refunds; it decides the tier
+	LifetimePoints int     `json:"lifetime_points"`
+	Tier           string  `json:"tier,omitempty"`
+	BalanceValue   float64 `json:"balance_value"`
+	// ExpiringPoints are the points that expire next, at NextExpiry
+	ExpiringPoints int        `json:"expiring_points,omitempty"`
+	NextExpiry     *time.Time `json:"next_expiry,omitempty"`
+
+	program LoyaltyProgram
+	entries []*LoyaltyEntry
+}
+
+// NewLoyaltyAccount derives the account of a customer from their ledger entries in booking order
+func NewLoyaltyAccount(customerID string, entries []*LoyaltyEntry, program LoyaltyProgram) *LoyaltyAccount {
+	account := &LoyaltyAccount{
+		CustomerID: customerID,
+		program:    program,
+		entries:    entries,
+	}
+	for _, entry := range entries {
+		account.Balance += entry.Points
+		if entry.Type == LoyaltyEntryEarn || entry.Type == LoyaltyEntryReversal {
+			account.LifetimePoints += entry.Points
+		}
+	}
+	if tier := program.TierFor(account.LifetimePoints); tier != nil {
+		account.Tier = tier.Name
+	}
+	account.BalanceValue = program.RedemptionValue(max(account.Balance, 0))
+
+	for _, lot := range account.openLots() {
+		if lot.expiresAt == nil {
+			break
+		}
+		if account.NextExpiry == nil {
+			account.NextExpiry = lot.expiresAt
+		}
+		if !lot.expiresAt.Equal(*account.NextExpiry) {
+			break
+		}
+		account.ExpiringPoints += lot.points
+	}
+	return account
+}
+
+// Earn books the points earned on a completed order: the points per dollar of
+// the items' category on what was paid for each item that was not voided or
+// refunded, times the multiplier of the customer's tier. It returns nil if the
+// order earns no points.
+func (a *LoyaltyAccount) Earn(order *Order, now time.Time) *LoyaltyEntry {
+	var basis, points float64
+	for _, item := range order.Items {
+		if item.IsVoided() || item.IsRefunded() {
+			continue
+		}
+		basis += item.Subtotal
+		points += order.discounted(item.Subtotal) * a.program.RateFor(item.Category)
+	}
+	if tier := a.program.TierFor(a.LifetimePoints); tier != nil {
+		points *= tier.Multiplier
+	}
+
+	earned := int(math.Floor(points + 1e-9))
+	if earned <= 0 {
+		return nil
+	}
+
+	entry := a.newEntry(LoyaltyEntryEarn, earned, earnReference(order.ID), now)
+	entry.OrderID = order.ID
+	entry.Amount = roundCents(basis)
+	entry.Description = fmt.Sprintf("Earned on order %s", order.ID)
+	if a.program.Expiry > 0 {
+		expiresAt := now.Add(a.program.Expiry)
+		entry.ExpiresAt = &expiresAt
+	}
+	return entry
+}
+
+// ReverseEarned books the reversal of the points earned on refunded items, in
+// proportion to the share of the order's earning basis that was refunded. It
+// returns nil if nothing was earned on the order or it has all been reversed.
+func (a *LoyaltyAccount) ReverseEarned(orderID OrderID, adjustmentID string, refunded float64, now time.Time) *LoyaltyEntry {
+	var earned *LoyaltyEntry
+	remaining := 0
+	for _, entry := range a.entries {
+		if entry.OrderID != orderID {
+			continue
+		}
+		switch entry.Type {
+		case LoyaltyEntryEarn:
+			earned = entry
+			remaining += entry.Points
+		case LoyaltyEntryReversal:
+			remaining += entry.Points
+		}
+	}
+	if earned == nil || remaining <= 0 || earned.Amount <= 0 {
+		return nil
+	}
+
+	points := int(math.Round(float64(earned.Points) * refunded / earned.Amount))
+	points = min(points, remaining)
+	if points <= 0 {
+		return nil
+	}
+
+	entry := a.newEntry(LoyaltyEntryReversal, -points, reversalReference(adjustmentID), now)
+	entry.OrderID = orderID
+	entry.Amount = roundCents(refunded)
+	entry.Description = fmt.Sprintf("Reversed for refund on order %s", orderID)
+	return entry
+}
+
+// Redeem books the points spent on a loyalty discount. The points must meet
+// the program minimum and be covered by the balance.
+func (a *LoyaltyAccount) Redeem(orderID OrderID, discount *OrderDiscount, now time.Time) (*LoyaltyEntry, error) {
+	if discount.Points <= 0 {
+		return nil, errors.WrapValidation("Redeem", "points", "points to redeem must be positive", nil)
+	}
+	if discount.Points < a.program.MinRedemption {
+		return nil, errors.WrapValidation("Redeem", "points", fmt.Sprintf("at least %d points must be redeemed at once", a.program.MinRedemption), nil)
+	}
+	if discount.Points > a.Balance {
+		return nil, errors.WrapConflict("Redeem", "balance", fmt.Sprintf("the balance of %d points does not cover %d points", a.Balance, discount.Points), nil)
+	}
+
+	entry := a.newEntry(LoyaltyEntryRedeem, -discount.Points, redeemReference(discount.ID), now)
+	entry.OrderID = orderID
+	entry.Amount = discount.Amount
+	entry.Description = fmt.Sprintf("Redeemed on order %s", orderID)
+	return entry, nil
+}
+
+// Restore books the return of the points of a redemption whose discount never
+// went through or whose order was cancelled. Restored points expire afresh.
+// It returns nil if the points of the discount were never redeemed or are already back.
+func (a *LoyaltyAccount) Restore(orderID OrderID, discount *OrderDiscount, now time.Time) *LoyaltyEntry {
+	var redeemed *LoyaltyEntry
+	for _, entry := range a.entries {
+		switch entry.Reference {
+		case redeemReference(discount.ID):
+			redeemed = entry
+		case restoreReference(discount.ID):
+			return nil
+		}
+	}
+	if redeemed == nil {
+		return nil
+	}
+
+	entry := a.newEntry(LoyaltyEntryRestore, -redeemed.Points, restoreReference(discount.ID), now)
+	entry.OrderID = orderID
+	entry.Amount = redeemed.Amount
+	entry.Description = fmt.Sprintf("Restored from order %s", orderID)
+	if a.program.Expiry > 0 {
+		expiresAt := now.Add(a.program.Expiry)
+		entry.ExpiresAt = &expiresAt
+	}
+	return entry
+}
+
+// Expire books the expiry of the points that reached their expiry date unspent.
+// Debits use up the points that expire soonest first. It returns nil if no points have expired.
+func (a *LoyaltyAccount) Expire(now time.Time) *LoyaltyEntry {
+	expired := 0
+	for _, lot := range a.openLots() {
+		if lot.expiresAt == nil || lot.expiresAt.After(now) {
+			break
+		}
+		expired += lot.points
+	}
+	if expired == 0 {
+		return nil
+	}
+
+	entry := a.newEntry(LoyaltyEntryExpire, -expired, expireReference(a.entries[len(a.entries)-1].ID), now)
+	entry.Description = "Points expired"
+	return entry
+}
+
+// History returns the ledger entries, newest first
+func (a *LoyaltyAccount) History() []*LoyaltyEntry {
+	history := make([]*LoyaltyEntry, 0, len(a.entries))
+	for i := len(a.entries) - 1; i >= 0; i-- {
+		history = append(history, a.entries[i])
+	}
+	return history
+}
+
+// Apply adds a booked entry to the account
+func (a *LoyaltyAccount) Apply(entry *LoyaltyEntry) {
+	*a = *NewLoyaltyAccount(a.CustomerID, append(a.entries, entry), a.program)
+}
+
+func (a *LoyaltyAccount) newEntry(entryType LoyaltyEntryType, points int, reference string, now time.Time) *LoyaltyEntry {
+	return &LoyaltyEntry{
+		ID:         types.NewID[LoyaltyEntryEntity]("lpt"),
+		CustomerID: a.CustomerID,
+		Type:       entryType,
+		Points:     points,
+		Reference:  reference,
+		CreatedAt:  now,
+	}
+}
+
+// pointLot is what is left of a credit of points, which expire together
+type pointLot struct {
+	points    int
+	expiresAt *time.Time
+}
+
+// openLots returns the credits not yet used up by debits, soonest to expire
+// first and those that never expire last. Debits that exceed the credits before
+// them, such as a reversal of points already spent, are settled from later credits.
+func (a *LoyaltyAccount) openLots() []*pointLot {
+	var lots []*pointLot
+	owed := 0
+	for _, entry := range a.entries {
+		if entry.Points < 0 {
+			owed -= entry.Points
+		} else {
+			lots = append(lots, &pointLot{points: entry.Points, expiresAt: entry.ExpiresAt})
+			sort.SliceStable(lots, func(i, j int) bool {
+				return expiresBefore(lots[i].expiresAt, lots[j].expiresAt)
+			})
+		}
+		for len(lots) > 0 && owed > 0 {
+			used := min(owed, lots[0].points)
+			lots[0].points -= used
+			owed -= used
+			if lots[0].points == 0 {
+				lots = lots[1:]
+			}
+		}
+	}
+	return lots
+}
+
+func expiresBefore(a, b *time.Time) bool {
+	if a == nil {
+		return false
+	}
+	return b == nil || a.Before(*b)
+}
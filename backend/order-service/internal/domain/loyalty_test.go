@@ -0,0 +1,216 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/restaurant-platform/shared/pkg/errors"
+)
+
+// LoyaltyTestSuite contains loyalty program, ledger and account tests
+type LoyaltyTestSuite struct {
+	suite.Suite
+	program LoyaltyProgram
+	order   *Order
+	now     time.Time
+}
+
+func TestLoyaltyTestSuite(t *testing.T) {
+	suite.Run(t, new(LoyaltyTestSuite))
+}
+
+func (suite *LoyaltyTestSuite) SetupTest() {
+	program, err := NewLoyaltyProgram(1, map[string]float64{"Drinks": 2}, 0.01, 100, 365*24*time.Hour, []LoyaltyTier{
+		{Name: "Gold", MinPoints: 1000, Multiplier: 2},
+		{Name: "Bronze", MinPoints: 0, Multiplier: 1},
+	})
+	suite.Require().NoError(err)
+	suite.program = program
+
+	suite.order, _ = NewOrder("customer-123", OrderTypeTakeout)
+	suite.order.AddItem("pizza", "Margherita Pizza", 2, 10.00, nil, "")
+	suite.order.AddItem("lemonade", "Lemonade", 1, 5.00, nil, "")
+	suite.order.Items[0].Category = "Mains"
+	suite.order.Items[1].Category = "drinks"
+	suite.now = time.Now()
+}
+
+func (suite *LoyaltyTestSuite) entry(entryType LoyaltyEntryType, points int, createdAt time.Time, expiresAt *time.Time) *LoyaltyEntry {
+	account := NewLoyaltyAccount("customer-123", nil, suite.program)
+	entry := account.newEntry(entryType, points, string(entryType)+createdAt.String(), createdAt)
+	entry.ExpiresAt = expiresAt
+	return entry
+}
+
+func (suite *LoyaltyTestSuite) TestNewLoyaltyProgram_Invalid_ShouldFail() {
+	assert := assert.New(suite.T())
+
+	_, err := NewLoyaltyProgram(1, nil, 0, 100, 0, nil)
+	assert.Error(err)
+
+	_, err = NewLoyaltyProgram(1, map[string]float64{"drinks": -1}, 0.01, 100, 0, nil)
+	assert.Error(err)
+
+	_, err = NewLoyaltyProgram(1, nil, 0.01, 100, 0, []LoyaltyTier{{Name: "Gold", MinPoints: 1000}})
+	assert.Error(err)
+}
+
+func (suite *LoyaltyTestSuite) TestEarn_AppliesCategoryRatesToDiscountedItems() {
+	// Given
+	suite.order.ApplyDiscount(NewLoyaltyDiscount(500, 5.00, "cashier-1"))
+	account := NewLoyaltyAccount("customer-123", nil, suite.program)
+
+	// When
+	entry := account.Earn(suite.order, suite.now)
+
+	// Then: pizza 20.00 and lemonade 5.00 are paid at 80% after the discount;
+	// 16 points for the pizza and 4.00 at double points for the lemonade
+	assert := assert.New(suite.T())
+	assert.Equal(LoyaltyEntryEarn, entry.Type)
+	assert.Equal(24, entry.Points)
+	assert.Equal(25.00, entry.Amount)
+	assert.Equal(suite.order.ID, entry.OrderID)
+	assert.Equal("earn:"+suite.order.ID.String(), entry.Reference)
+	assert.Equal(suite.now.Add(365*24*time.Hour), *entry.ExpiresAt)
+}
+
+func (suite *LoyaltyTestSuite) TestEarn_SkipsVoidedItemsAndAppliesTierMultiplier() {
+	// Given
+	voidedAt := suite.now
+	suite.order.Items[1].VoidedAt = &voidedAt
+	account := NewLoyaltyAccount("customer-123", []*LoyaltyEntry{
+		suite.entry(LoyaltyEntryEarn, 1000, suite.now.Add(-time.Hour), nil),
+	}, suite.program)
+
+	// When
+	entry := account.Earn(suite.order, suite.now)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.Equal("Gold", account.Tier)
+	assert.Equal(40, entry.Points)
+	assert.Equal(20.00, entry.Amount)
+}
+
+func (suite *LoyaltyTestSuite) TestReverseEarned_ReversesRefundedShareUpToWhatWasEarned() {
+	// Given
+	account := NewLoyaltyAccount("customer-123", nil, suite.program)
+	account.Apply(account.Earn(suite.order, suite.now))
+
+	// When
+	partial := account.ReverseEarned(suite.order.ID, "adj_1", 5.00, suite.now)
+	account.Apply(partial)
+	rest := account.ReverseEarned(suite.order.ID, "adj_2", 25.00, suite.now)
+	account.Apply(rest)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.Equal(-6, partial.Points)
+	assert.Equal("reverse:adj_1", partial.Reference)
+	assert.Equal(-24, rest.Points)
+	assert.Zero(account.Balance)
+	assert.Zero(account.LifetimePoints)
+	assert.Nil(account.ReverseEarned(suite.order.ID, "adj_3", 5.00, suite.now))
+}
+
+func (suite *LoyaltyTestSuite) TestReverseEarned_NothingEarned_ReturnsNil() {
+	// Given
+	account := NewLoyaltyAccount("customer-123", nil, suite.program)
+
+	// Then
+	assert.New(suite.T()).Nil(account.ReverseEarned(suite.order.ID, "adj_1", 5.00, suite.now))
+}
+
+func (suite *LoyaltyTestSuite) TestRedeem_ChecksMinimumAndBalance() {
+	// Given
+	account := NewLoyaltyAccount("customer-123", []*LoyaltyEntry{
+		suite.entry(LoyaltyEntryEarn, 300, suite.now, nil),
+	}, suite.program)
+
+	// When
+	_, belowMinimum := account.Redeem(suite.order.ID, NewLoyaltyDiscount(50, 0.50, ""), suite.now)
+	_, aboveBalance := account.Redeem(suite.order.ID, NewLoyaltyDiscount(400, 4.00, ""), suite.now)
+	discount := NewLoyaltyDiscount(200, 2.00, "")
+	entry, err := account.Redeem(suite.order.ID, discount, suite.now)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.True(errors.IsValidationError(belowMinimum))
+	assert.True(errors.IsConflictError(aboveBalance))
+	assert.NoError(err)
+	assert.Equal(-200, entry.Points)
+	assert.Equal("redeem:"+discount.ID.String(), entry.Reference)
+}
+
+func (suite *LoyaltyTestSuite) TestRestore_ReturnsRedeemedPointsOnce() {
+	// Given
+	account := NewLoyaltyAccount("customer-123", []*LoyaltyEntry{
+		suite.entry(LoyaltyEntryEarn, 300, suite.now, nil),
+	}, suite.program)
+	discount := NewLoyaltyDiscount(200, 2.00, "")
+	redeemed, _ := account.Redeem(suite.order.ID, discount, suite.now)
+	account.Apply(redeemed)
+
+	// When
+	restored := account.Restore(suite.order.ID, discount, suite.now)
+	account.Apply(restored)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.Equal(200, restored.Points)
+	assert.Equal(300, account.Balance)
+	assert.Nil(account.Restore(suite.order.ID, discount, suite.now))
+	assert.Nil(account.Restore(suite.order.ID, NewLoyaltyDiscount(200, 2.00, ""), suite.now))
+}
+
+func (suite *LoyaltyTestSuite) TestExpire_SpendsSoonestExpiringPointsFirst() {
+	// Given
+	start := suite.now.Add(-48 * time.Hour)
+	firstExpiry := start.Add(24 * time.Hour)
+	secondExpiry := start.Add(10 * 24 * time.Hour)
+	account := NewLoyaltyAccount("customer-123", []*LoyaltyEntry{
+		suite.entry(LoyaltyEntryEarn, 100, start, &firstExpiry),
+		suite.entry(LoyaltyEntryEarn, 50, start.Add(time.Hour), &secondExpiry),
+		suite.entry(LoyaltyEntryRedeem, -60, start.Add(2*time.Hour), nil),
+	}, suite.program)
+
+	// When
+	entry := account.Expire(suite.now)
+	account.Apply(entry)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.Equal(LoyaltyEntryExpire, entry.Type)
+	assert.Equal(-40, entry.Points)
+	assert.Equal(50, account.Balance)
+	assert.Equal(50, account.ExpiringPoints)
+	assert.Equal(secondExpiry, *account.NextExpiry)
+	assert.Nil(account.Expire(suite.now))
+}
+
+func (suite *LoyaltyTestSuite) TestNewLoyaltyAccount_DerivesBalanceAndTier() {
+	// When
+	account := NewLoyaltyAccount("customer-123", []*LoyaltyEntry{
+		suite.entry(LoyaltyEntryEarn, 1200, suite.now, nil),
+		suite.entry(LoyaltyEntryRedeem, -500, suite.now, nil),
+	}, suite.program)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.Equal(700, account.Balance)
+	assert.Equal(1200, account.LifetimePoints)
+	assert.Equal("Gold", account.Tier)
+	assert.Equal(7.00, account.BalanceValue)
+	assert.Len(account.History(), 2)
+	assert.Equal(LoyaltyEntryRedeem, account.History()[0].Type)
+}
+
+func (suite *LoyaltyTestSuite) TestIsLoyaltyCustomer() {
+	assert := assert.New(suite.T())
+	assert.True(IsLoyaltyCustomer("customer-123"))
+	assert.False(IsLoyaltyCustomer(MarketplaceActor("generic")))
+	assert.False(IsLoyaltyCustomer(""))
+}
@@ -0,0 +1,299 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/restaurant-platform/shared/pkg/errors"
+	"github.com/restaurant-platform/shared/pkg/types"
+)
+
+// IngestedOrderEntity marks marketplace order IDs
+type IngestedOrderEntity struct{}
+
+func (IngestedOrderEntity) IsEntity() {}
+
+// IngestedOrderID is the type-safe ID of an order received from a marketplace
+type IngestedOrderID = types.ID[IngestedOrderEntity]
+
+// MarketplaceOrderStatus is the status of a marketplace order as reported back to the marketplace
+type MarketplaceOrderStatus string
+
+const (
+	MarketplaceOrderStatusAccepted       MarketplaceOrderStatus = "ACCEPTED"
+	MarketplaceOrderStatusRejected       MarketplaceOrderStatus = "REJECTED"
+	MarketplaceOrderStatusPreparing      MarketplaceOrderStatus = "PREPARING"
+	MarketplaceOrderStatusReady          MarketplaceOrderStatus = "READY"
+	MarketplaceOrderStatusOutForDelivery MarketplaceOrderStatus = "OUT_FOR_DELIVERY"
+	MarketplaceOrderStatusCompleted      MarketplaceOrderStatus = "COMPLETED"
+	MarketplaceOrderStatusCancelled      MarketplaceOrderStatus = "CANCELLED"
+)
+
+// MarketplaceStatusFor returns the marketplace status reported when an accepted order
+// reaches the given status; statuses the marketplace is not told about return false
+func MarketplaceStatusFor(status OrderStatus) (MarketplaceOrderStatus, bool) {
+	switch status {
+	case OrderStatusPreparing:
+		return MarketplaceOrderStatusPreparing, true
+	case OrderStatusReady:
+		return MarketplaceOrderStatusReady, true
+	case OrderStatusOutForDelivery:
+		return MarketplaceOrderStatusOutForDelivery, true
+	case OrderStatusCompleted:
+		return MarketplaceOrderStatusCompleted, true
+	case OrderStatusCancelled:
+		return MarketplaceOrderStatusCancelled, true
+	default:
+		return "", false
+	}
+}
+
+// MarketplaceAdapter translates between a delivery marketplace's API and our orders
+type MarketplaceAdapter interface {
+	// Name identifies the marketplace in webhook URLs and on the orders it places
+	Name() string
+
+	// SignatureHeader is the request header carrying the webhook signature
+	SignatureHeader() string
+
+	// VerifySignature checks the signature of a raw webhook payload
+	VerifySignature(payload []byte, signature string) error
+
+	// ParseOrder normalises an order webhook payload
+	ParseOrder(payload []byte) (*MarketplaceOrder, error)
+
+	// PushStatus reports the status of an order back to the marketplace
+	PushStatus(ctx context.Context, externalID string, status MarketplaceOrderStatus, reason string) error
+}
+
+// MarketplaceAdapters holds the configured marketplace adapters by name
+type MarketplaceAdapters map[string]MarketplaceAdapter
+
+// NewMarketplaceAdapters registers adapters under their names
+func NewMarketplaceAdapters(adapters ...MarketplaceAdapter) MarketplaceAdapters {
+	registry := make(MarketplaceAdapters, len(adapters))
+	for _, adapter := range adapters {
+		registry[adapter.Name()] = adapter
+	}
+	return registry
+}
+
+// Get returns the adapter of a marketplace
+func (a MarketplaceAdapters) Get(marketplace string) (MarketplaceAdapter, error) {
+	adapter, ok := a[marketplace]
+	if !ok {
+		return nil, errors.WrapNotFound("MarketplaceAdapters.Get", "marketplace", marketplace, errors.ErrNotFound)
+	}
+	return adapter, nil
+}
+
+// MarketplaceOrder is an order placed through a delivery marketplace, normalised by its adapter.
+// Marketplace orders are paid on the marketplace before they reach us.
+type MarketplaceOrder struct {
+	Marketplace     string                  `json:"marketplace"`
+	ExternalID      string                  `json:"external_id"`
+	Type            OrderType               `json:"type"`
+	CustomerName    string                  `json:"customer_name,omitempty"`
+	CustomerPhone   string                  `json:"customer_phone,omitempty"`
+	DeliveryAddress string                  `json:"delivery_address,omitempty"`
+	Notes           string                  `json:"notes,omitempty"`
+	Items           []*MarketplaceOrderItem `json:"items"`
+	PlacedAt        time.Time               `json:"placed_at"`
+}
+
+// MarketplaceOrderItem is a line of a marketplace order, identified by the marketplace's item ID
+type MarketplaceOrderItem struct {
+	ExternalItemID string   `json:"external_item_id"`
+	Name           string   `json:"name,omitempty"`
+	Quantity       int      `json:"quantity"`
+	UnitPrice      float64  `json:"unit_price"`
+	Modifications  []string `json:"modifications,omitempty"`
+	Notes          string   `json:"notes,omitempty"`
+}
+
+// Validate checks that the order can be fulfilled as a takeout or delivery order
+func (m *MarketplaceOrder) Validate() error {
+	if m.ExternalID == "" {
+		return errors.WrapValidation("MarketplaceOrder.Validate", "external_id", "marketplace order ID is required", nil)
+	}
+	switch m.Type {
+	case OrderTypeTakeout:
+	case OrderTypeDelivery:
+		if m.DeliveryAddress == "" {
+			return errors.WrapValidation("MarketplaceOrder.Validate", "delivery_address", "delivery address is required for delivery orders", nil)
+		}
+	default:
+		return errors.WrapValidation("MarketplaceOrder.Validate", "type", "marketplace orders must be TAKEOUT or DELIVERY", nil)
+	}
+	if len(m.Items) == 0 {
+		return errors.WrapValidation("MarketplaceOrder.Validate", "items", "at least one item is required", nil)
+	}
+	for _, item := range m.Items {
+		if item.ExternalItemID == "" {
+			return errors.WrapValidation("MarketplaceOrder.Validate", "items", "item ID is required", nil)
+		}
+		if item.Quantity <= 0 {
+			return errors.WrapValidation("MarketplaceOrder.Validate", "items", "quantity of item "+item.ExternalItemID+" must be positive", nil)
+		}
+		if item.UnitPrice < 0 {
+			return errors.WrapValidation("MarketplaceOrder.Validate", "items", "unit price of item "+item.ExternalItemID+" cannot be negative", nil)
+		}
+	}
+	return nil
+}
+
+// ExternalItemIDs returns the distinct marketplace item IDs on the order
+func (m *MarketplaceOrder) ExternalItemIDs() []string {
+	seen := make(map[string]bool, len(m.Items))
+	var ids []string
+	for _, item := range m.Items {
+		if !seen[item.ExternalItemID] {
+			seen[item.ExternalItemID] = true
+			ids = append(ids, item.ExternalItemID)
+		}
+	}
+	return ids
+}
+
+// ToOrder builds a paid order from the marketplace order, taking each line's name and
+// category from our menu item and its price from the marketplace, which is what the
+// customer was charged. menuItems maps every external item ID to our menu item.
+func (m *MarketplaceOrder) ToOrder(menuItems map[string]*MenuItem) (*Order, error) {
+	order, err := NewOrder(MarketplaceActor(m.Marketplace), m.Type)
+	if err != nil {
+		return nil, err
+	}
+
+	if m.Type == OrderTypeDelivery {
+		if err := order.SetDeliveryAddress(m.DeliveryAddress); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, item := range m.Items {
+		menuItem, ok := menuItems[item.ExternalItemID]
+		if !ok {
+			return nil, errors.WrapValidation("MarketplaceOrder.ToOrder", "items", "item "+item.ExternalItemID+" is not mapped to a menu item", nil)
+		}
+		if err := order.AddItem(menuItem.ID, menuItem.Name, item.Quantity, item.UnitPrice, item.Modifications, item.Notes); err != nil {
+			return nil, err
+		}
+		order.Items[len(order.Items)-1].Category = menuItem.CategoryName
+	}
+
+	order.AddNotes(m.orderNotes())
+
+	if err := order.UpdateStatus(OrderStatusPaid, MarketplaceActor(m.Marketplace), "paid on "+m.Marketplace); err != nil {
+		return nil, err
+	}
+	return order, nil
+}
+
+// orderNotes tells staff where the order came from and who to hand it to
+func (m *MarketplaceOrder) orderNotes() string {
+	parts := []string{fmt.Sprintf("%s order %s", m.Marketplace, m.ExternalID)}
+	if customer := strings.TrimSpace(m.CustomerName + " " + m.CustomerPhone); customer != "" {
+		parts = append(parts, "customer: "+customer)
+	}
+	if m.Notes != "" {
+		parts = append(parts, m.Notes)
+	}
+	return strings.Join(parts, "; ")
+}
+
+// marketplaceActorPrefix starts the customer and actor of orders placed through a marketplace
+const marketplaceActorPrefix = "marketplace:"
+
+// MarketplaceActor is the customer and actor recorded on orders placed through a marketplace
+func MarketplaceActor(marketplace string) string {
+	return marketplaceActorPrefix + marketplace
+}
+
+// MarketplaceItemMapping maps a marketplace's item ID to our menu item
+type MarketplaceItemMapping struct {
+	Marketplace    string    `json:"marketplace"`
+	ExternalItemID string    `json:"external_item_id"`
+	MenuItemID     string    `json:"menu_item_id"`
+	UpdatedAt      time.Time `json:"updated_at"`
+}
+
+// KitchenLoadPolicy decides whether the kitchen can take on another marketplace order
+type KitchenLoadPolicy struct {
+	// MaxOrders is the number of paid orders waiting on or being prepared by the kitchen at
+	// which marketplace orders are rejected; zero accepts every order
+	MaxOrders int
+}
+
+// KitchenLoad counts the orders the kitchen has yet to finish
+func KitchenLoad(orders []*Order) int {
+	load := 0
+	for _, order := range orders {
+		if (order.Status == OrderStatusPaid || order.Status == OrderStatusPreparing) && !order.IsAwaitingRelease() {
+			load++
+		}
+	}
+	return load
+}
+
+// Admits reports whether another order can be accepted at the given kitchen load
+func (p KitchenLoadPolicy) Admits(load int) bool {
+	return p.MaxOrders <= 0 || load < p.MaxOrders
+}
+
+// IngestedOrder records an order received from a marketplace, the order it became if it
+// was accepted, and the last status reported back to the marketplace
+type IngestedOrder struct {
+	ID          IngestedOrderID        `json:"id"`
+	Marketplace string                 `json:"marketplace"`
+	ExternalID  string                 `json:"external_id"`
+	OrderID     OrderID                `json:"order_id,omitempty"`
+	Status      MarketplaceOrderStatus `json:"status"`
+	Reason      string                 `json:"reason,omitempty"`
+	ReceivedAt  time.Time              `json:"received_at"`
+	UpdatedAt   time.Time              `json:"updated_at"`
+}
+
+// AcceptIngestedOrder records a marketplace order accepted as the given order
+func AcceptIngestedOrder(marketplace, externalID string, orderID OrderID, now time.Time) *IngestedOrder {
+	return &IngestedOrder{
+		ID:          types.NewID[IngestedOrderEntity]("mko"),
+		Marketplace: marketplace,
+		ExternalID:  externalID,
+		OrderID:     orderID,
+		Status:      MarketplaceOrderStatusAccepted,
+		ReceivedAt:  now,
+		UpdatedAt:   now,
+	}
+}
+
+// RejectIngestedOrder records a marketplace order that was turned down
+func RejectIngestedOrder(marketplace, externalID, reason string, now time.Time) *IngestedOrder {
+	return &IngestedOrder{
+		ID:          types.NewID[IngestedOrderEntity]("mko"),
+		Marketplace: marketplace,
+		ExternalID:  externalID,
+		Status:      MarketplaceOrderStatusRejected,
+		Reason:      reason,
+		ReceivedAt:  now,
+		UpdatedAt:   now,
+	}
+}
+
+// IsAccepted reports whether the marketplace order became one of our orders
+func (i *IngestedOrder) IsAccepted() bool {
+	return i.OrderID != ""
+}
+
+// Advance records a new status to report to the marketplace. It returns false if the
+// marketplace already has that status or the order was rejected.
+func (i *IngestedOrder) Advance(status MarketplaceOrderStatus, reason string, now time.Time) bool {
+	if !i.IsAccepted() || i.Status == status {
+		return false
+	}
+	i.Status = status
+	i.Reason = reason
+	i.UpdatedAt = now
+	return true
+}
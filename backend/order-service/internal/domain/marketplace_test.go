@@ -0,0 +1,147 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+// MarketplaceTestSuite contains marketplace order normalisation and kitchen load tests
+type MarketplaceTestSuite struct {
+	suite.Suite
+	placed    *MarketplaceOrder
+	menuItems map[string]*MenuItem
+}
+
+func TestMarketplaceTestSuite(t *testing.T) {
+	suite.Run(t, new(MarketplaceTestSuite))
+}
+
+func (suite *MarketplaceTestSuite) SetupTest() {
+	suite.placed = &MarketplaceOrder{
+		Marketplace:     "generic",
+		ExternalID:      "A-1001",
+		Type:            OrderTypeDelivery,
+		CustomerName:    "Ada",
+		CustomerPhone:   "555-0100",
+		DeliveryAddress: "1 Main St",
+		Items: []*MarketplaceOrderItem{
+			{ExternalItemID: "mk-burger", Name: "Big Burger", Quantity: 2, UnitPrice: 12.50, Modifications: []string{"no onions"}},
+		},
+	}
+	suite.menuItems = map[string]*MenuItem{
+		"mk-burger": {ID: "burger", Name: "Burger", Price: 10.00, CategoryName: "Mains", IsAvailable: true},
+	}
+}
+
+func (suite *MarketplaceTestSuite) TestValidate_DeliveryWithoutAddress_ShouldFail() {
+	// Given
+	suite.placed.DeliveryAddress = ""
+
+	// When
+	err := suite.placed.Validate()
+
+	// Then
+	assert.New(suite.T()).Error(err)
+}
+
+func (suite *MarketplaceTestSuite) TestValidate_DineIn_ShouldFail() {
+	// Given
+	suite.placed.Type = OrderTypeDineIn
+
+	// When
+	err := suite.placed.Validate()
+
+	// Then
+	assert.New(suite.T()).Error(err)
+}
+
+func (suite *MarketplaceTestSuite) TestToOrder_BuildsPaidOrderAtMarketplacePrices() {
+	// When
+	order, err := suite.placed.ToOrder(suite.menuItems)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	assert.Equal(OrderStatusPaid, order.Status)
+	assert.Equal("marketplace:generic", order.CustomerID)
+	assert.Equal("1 Main St", order.DeliveryAddress)
+	assert.Len(order.Items, 1)
+	assert.Equal("burger", order.Items[0].MenuItemID)
+	assert.Equal("Burger", order.Items[0].Name)
+	assert.Equal("Mains", order.Items[0].Category)
+	assert.Equal(12.50, order.Items[0].UnitPrice)
+	assert.Equal(25.00, order.Subtotal())
+	assert.Equal("generic order A-1001; customer: Ada 555-0100", order.Notes)
+	assert.Equal("marketplace:generic", order.StatusHistory[0].Actor)
+}
+
+func (suite *MarketplaceTestSuite) TestToOrder_UnmappedItem_ShouldFail() {
+	// When
+	_, err := suite.placed.ToOrder(map[string]*MenuItem{})
+
+	// Then
+	assert.New(suite.T()).Error(err)
+}
+
+func (suite *MarketplaceTestSuite) TestExternalItemIDs_AreDistinct() {
+	// Given
+	suite.placed.Items = append(suite.placed.Items,
+		&MarketplaceOrderItem{ExternalItemID: "mk-fries", Quantity: 1},
+		&MarketplaceOrderItem{ExternalItemID: "mk-burger", Quantity: 1})
+
+	// Then
+	assert.New(suite.T()).Equal([]string{"mk-burger", "mk-fries"}, suite.placed.ExternalItemIDs())
+}
+
+func (suite *MarketplaceTestSuite) TestKitchenLoad_CountsPaidAndPreparingOrders() {
+	// Given
+	created, _ := NewOrder("customer-1", OrderTypeTakeout)
+	paid, _ := NewOrder("customer-2", OrderTypeTakeout)
+	paid.UpdateStatus(OrderStatusPaid, "cashier-1", "")
+	preparing, _ := NewOrder("customer-3", OrderTypeTakeout)
+	preparing.UpdateStatus(OrderStatusPaid, "cashier-1", "")
+	preparing.UpdateStatus(OrderStatusPreparing, "kitchen-service", "")
+	ready, _ := NewOrder("customer-4", OrderTypeTakeout)
+	ready.UpdateStatus(OrderStatusPaid, "cashier-1", "")
+	ready.UpdateStatus(OrderStatusPreparing, "kitchen-service", "")
+	ready.UpdateStatus(OrderStatusReady, "kitchen-service", "")
+
+	// When
+	load := KitchenLoad([]*Order{created, paid, preparing, ready})
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.Equal(2, load)
+	assert.True(KitchenLoadPolicy{MaxOrders: 3}.Admits(load))
+	assert.False(KitchenLoadPolicy{MaxOrders: 2}.Admits(load))
+	assert.True(KitchenLoadPolicy{}.Admits(load))
+}
+
+func (suite *MarketplaceTestSuite) TestIngestedOrder_Advance() {
+	// Given
+	now := time.Now()
+	accepted := AcceptIngestedOrder("generic", "A-1001", "ord_1", now)
+	rejected := RejectIngestedOrder("generic", "A-1002", "kitchen is at capacity", now)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.True(accepted.Advance(MarketplaceOrderStatusPreparing, "", now))
+	assert.False(accepted.Advance(MarketplaceOrderStatusPreparing, "", now))
+	assert.Equal(MarketplaceOrderStatusPreparing, accepted.Status)
+	assert.False(rejected.Advance(MarketplaceOrderStatusCancelled, "", now))
+	assert.Equal(MarketplaceOrderStatusRejected, rejected.Status)
+}
+
+func (suite *MarketplaceTestSuite) TestMarketplaceStatusFor() {
+	assert := assert.New(suite.T())
+
+	status, ok := MarketplaceStatusFor(OrderStatusReady)
+	assert.True(ok)
+	assert.Equal(MarketplaceOrderStatusReady, status)
+
+	_, ok = MarketplaceStatusFor(OrderStatusPaid)
+	assert.False(ok)
+}
@@ -0,0 +1,146 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/restaurant-platform/shared/pkg/errors"
+)
+
+// MenuItem is order-service's local read model of an orderable menu item.
+// It is kept current from menu.* events and is the source of truth for the
+// name and price snapshotted onto each order line.
+type MenuItem struct {
+	ID             string               `json:"id"`
+	MenuID         string               `json:"menu_id"`
+	Name           string               `json:"name"`
+	Price          float64              `json:"price"`
+	CategoryID     string               `json:"category_id"`
+	CategoryName   string               `json:"category_name"`
+	IsAvailable    bool                 `json:"is_available"`
+	PrepTime       time.Duration        `json:"prep_time"`
+	ModifierGroups []*MenuModifierGroup `json:"modifier_groups,omitempty"`
+	UpdatedAt      time.Time            `json:"updated_at"`
+}
+
+// MenuModifierGroup is a choice the guest makes when ordering a menu item
+type MenuModifierGroup struct {
+	ID            string                `json:"id"`
+	Name          string                `json:"name"`
+	MinSelections int                   `json:"min_selections"`
+	MaxSelections int                   `json:"max_selections"`
+	Options       []*MenuModifierOption `json:"options"`
+}
+
+// MenuModifierOption is a single choice within a modifier group
+type MenuModifierOption struct {
+	ID         string  `json:"id"`
+	Name       string  `json:"name"`
+	PriceDelta float64 `json:"price_delta"`
+	IsDefault  bool    `json:"is_default"`
+}
+
+// ModifierSelection is the set of options chosen for one modifier group
+type ModifierSelection struct {
+	GroupID   string
+	OptionIDs []string
+}
+
+// EnsureOrderable checks that the menu item may currently be sold
+func (m *MenuItem) EnsureOrderable() error {
+	if !m.IsAvailable {
+		return errors.WrapConflict("EnsureOrderable", "menu_item", "menu item "+m.Name+" is currently unavailable", nil)
+	}
+	if m.Price < 0 {
+		return errors.WrapConflict("EnsureOrderable", "menu_item", "menu item "+m.Name+" has no valid price", nil)
+	}
+	return nil
+}
+
+// ResolveModifiers validates the guest's selections against the item's modifier
+// groups and returns the chosen options. Groups without a selection fall back to
+// their default options.
+func (m *MenuItem) ResolveModifiers(selections []ModifierSelection) ([]*OrderItemModifier, error) {
+	selected := make(map[string][]string, len(selections))
+	for _, selection := range selections {
+		if m.findModifierGroup(selection.GroupID) == nil {
+			return nil, errors.WrapValidation("ResolveModifiers", "modifiers", "unknown modifier group "+selection.GroupID, nil)
+		}
+		if _, exists := selected[selection.GroupID]; exists {
+			return nil, errors.WrapValidation("ResolveModifiers", "modifiers", "modifier group "+selection.GroupID+" selected more than once", nil)
+		}
+		selected[selection.GroupID] = selection.OptionIDs
+	}
+
+	var modifiers []*OrderItemModifier
+	for _, group := range m.ModifierGroups {
+		optionIDs, ok := selected[group.ID]
+		if !ok {
+			optionIDs = group.defaultOptionIDs()
+		}
+
+		if len(optionIDs) < group.MinSelections {
+			return nil, errors.WrapValidation("ResolveModifiers", "modifiers", fmt.Sprintf("%s requires at least %d selection(s)", group.Name, group.MinSelections), nil)
+		}
+		if len(optionIDs) > group.MaxSelections {
+			return nil, errors.WrapValidation("ResolveModifiers", "modifiers", fmt.Sprintf("%s allows at most %d selection(s)", group.Name, group.MaxSelections), nil)
+		}
+
+		seen := make(map[string]bool, len(optionIDs))
+		for _, optionID := range optionIDs {
+			option := group.findOption(optionID)
+			if option == nil {
+				return nil, errors.WrapValidation("ResolveModifiers", "modifiers", "unknown option "+optionID+" for "+group.Name, nil)
+			}
+			if seen[optionID] {
+				return nil, errors.WrapValidation("ResolveModifiers", "modifiers", "option "+option.Name+" selected more than once", nil)
+			}
+			seen[optionID] = true
+
+			modifiers = append(modifiers, &OrderItemModifier{
+				GroupID:    group.ID,
+				GroupName:  group.Name,
+				OptionID:   option.ID,
+				OptionName: option.Name,
+				PriceDelta: option.PriceDelta,
+			})
+		}
+	}
+
+	return modifiers, nil
+}
+
+func (m *MenuItem) findModifierGroup(groupID string) *MenuModifierGroup {
+	for _, group := range m.ModifierGroups {
+		if group.ID == groupID {
+			return group
+		}
+	}
+	return nil
+}
+
+func (g *MenuModifierGroup) findOption(optionID string) *MenuModifierOption {
+	for _, option := range g.Options {
+		if option.ID == optionID {
+			return option
+		}
+	}
+	return nil
+}
+
+func (g *MenuModifierGroup) defaultOptionIDs() []string {
+	var ids []string
+	for _, option := range g.Options {
+		if option.IsDefault {
+			ids = append(ids, option.ID)
+		}
+	}
+	return ids
+}
+
+// MenuCatalog reads the menu on sale from the menu service
+type MenuCatalog interface {
+	// ActiveMenu returns the ID and items of the active menu, or a not found error when no menu is active
+	ActiveMenu(ctx context.Context) (string, []*MenuItem, error)
+}
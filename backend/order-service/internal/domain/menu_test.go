@@ -0,0 +1,124 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/restaurant-platform/shared/pkg/errors"
+)
+
+// MenuItemTestSuite contains menu read model tests
+type MenuItemTestSuite struct {
+	suite.Suite
+	item *MenuItem
+}
+
+func TestMenuItemTestSuite(t *testing.T) {
+	suite.Run(t, new(MenuItemTestSuite))
+}
+
+func (suite *MenuItemTestSuite) SetupTest() {
+	suite.item = &MenuItem{
+		ID: "burger-1", Name: "Burger", Price: 12.00, IsAvailable: true,
+		ModifierGroups: []*MenuModifierGroup{
+			{
+				ID: "mgrp_temp", Name: "Temperature", MinSelections: 1, MaxSelections: 1,
+				Options: []*MenuModifierOption{
+					{ID: "mopt_rare", Name: "Rare"},
+					{ID: "mopt_medium", Name: "Medium", IsDefault: true},
+				},
+			},
+			{
+				ID: "mgrp_addons", Name: "Add-ons", MinSelections: 0, MaxSelections: 2,
+				Options: []*MenuModifierOption{
+					{ID: "mopt_bacon", Name: "Bacon", PriceDelta: 2.50},
+					{ID: "mopt_egg", Name: "Fried Egg", PriceDelta: 1.50},
+					{ID: "mopt_avocado", Name: "Avocado", PriceDelta: 2.00},
+				},
+			},
+		},
+	}
+}
+
+func (suite *MenuItemTestSuite) TestResolveModifiers_AppliesDefaults() {
+	// When
+	modifiers, err := suite.item.ResolveModifiers(nil)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	assert.Len(modifiers, 1)
+	assert.Equal("Medium", modifiers[0].OptionName)
+	assert.Equal("Temperature", modifiers[0].GroupName)
+}
+
+func (suite *MenuItemTestSuite) TestResolveModifiers_ExplicitSelections() {
+	// When
+	modifiers, err := suite.item.ResolveModifiers([]ModifierSelection{
+		{GroupID: "mgrp_temp", OptionIDs: []string{"mopt_rare"}},
+		{GroupID: "mgrp_addons", OptionIDs: []string{"mopt_bacon", "mopt_egg"}},
+	})
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	assert.Len(modifiers, 3)
+	assert.Equal("Rare", modifiers[0].OptionName)
+	assert.Equal(2.50, modifiers[1].PriceDelta)
+}
+
+func (suite *MenuItemTestSuite) TestResolveModifiers_InvalidSelections_ShouldFail() {
+	assert := assert.New(suite.T())
+
+	cases := map[string][]ModifierSelection{
+		"below minimum":    {{GroupID: "mgrp_temp", OptionIDs: []string{}}},
+		"above maximum":    {{GroupID: "mgrp_addons", OptionIDs: []string{"mopt_bacon", "mopt_egg", "mopt_avocado"}}},
+		"unknown group":    {{GroupID: "mgrp_sauce", OptionIDs: []string{"mopt_bbq"}}},
+		"unknown option":   {{GroupID: "mgrp_temp", OptionIDs: []string{"mopt_bacon"}}},
+		"duplicate option": {{GroupID: "mgrp_addons", OptionIDs: []string{"mopt_bacon", "mopt_bacon"}}},
+		"duplicate group": {
+			{GroupID: "mgrp_addons", OptionIDs: []string{"mopt_bacon"}},
+			{GroupID: "mgrp_addons", OptionIDs: []string{"mopt_egg"}},
+		},
+	}
+
+	for name, selections := range cases {
+		_, err := suite.item.ResolveModifiers(selections)
+		assert.True(errors.IsValidationError(err), name)
+	}
+}
+
+func (suite *MenuItemTestSuite) TestAddCourseItem_PricesSubtotal() {
+	// Given
+	order, _ := NewOrder("customer-123", OrderTypeDineIn)
+	modifiers, _ := suite.item.ResolveModifiers([]ModifierSelection{
+		{GroupID: "mgrp_addons", OptionIDs: []string{"mopt_bacon", "mopt_egg"}},
+	})
+
+	// When
+	err := order.AddCourseItem(DefaultCourse, suite.item.ID, suite.item.Name, 2, suite.item.Price, modifiers, nil, "")
+	_ = order.UpdateItemQuantity(order.Items[0].ID, 3)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	assert.Equal(16.00, order.Items[0].LinePrice())
+	assert.Equal(48.00, order.Items[0].Subtotal)
+	assert.InDelta(52.80, order.TotalAmount, 0.001)
+}
+
+func (suite *MenuItemTestSuite) TestAddCourseItem_NegativeLinePrice_ShouldFail() {
+	// Given
+	order, _ := NewOrder("customer-123", OrderTypeDineIn)
+	discount := []*OrderItemModifier{{GroupID: "mgrp_size", OptionID: "mopt_kids", OptionName: "Kids", PriceDelta: -15.00}}
+
+	// When
+	err := order.AddCourseItem(DefaultCourse, suite.item.ID, suite.item.Name, 1, suite.item.Price, discount, nil, "")
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.True(errors.IsValidationError(err))
+	assert.Empty(order.Items)
+}
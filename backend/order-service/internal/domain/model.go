@@ -22,6 +22,9 @@ type (
 	OrderItemID = types.ID[OrderItemEntity]
 )
 
+// TaxRate is the sales tax charged on the order subtotal
+const TaxRate = 0.10
+
 // OrderType represents the different types of orders
 type OrderType string
 
@@ -35,40 +38,78 @@ const (
 type OrderStatus string
 
 const (
-	OrderStatusCreated   OrderStatus = "CREATED"
-	OrderStatusPaid      OrderStatus = "PAID"
-	OrderStatusPreparing OrderStatus = "PREPARING"
-	OrderStatusReady     OrderStatus = "READY"
-	OrderStatusCompleted OrderStatus = "COMPLETED"
-	OrderStatusCancelled OrderStatus = "CANCELLED"
+	OrderStatusCreated        OrderStatus = "CREATED"
+	OrderStatusPaid           OrderStatus = "PAID"
+	OrderStatusPreparing      OrderStatus = "PREPARING"
+	OrderStatusReady          OrderStatus = "READY"
+	OrderStatusOutForDelivery OrderStatus = "OUT_FOR_DELIVERY"
+	OrderStatusCompleted      OrderStatus = "COMPLETED"
+	OrderStatusCancelled      OrderStatus = "CANCELLED"
 )
 
 // Order is the aggregate root for the order domain
 type Order struct {
-	ID              OrderID      `json:"id"`
-	CustomerID      string       `json:"customer_id"`
-	Type            OrderType    `json:"type"`
-	Status          OrderStatus  `json:"status"`
-	Items           []*OrderItem `json:"items"`
-	TotalAmount     float64      `json:"total_amount"`
-	TaxAmount       float64      `json:"tax_amount"`
-	TableID         string       `json:"table_id,omitempty"`
-	DeliveryAddress string       `json:"delivery_address,omitempty"`
-	Notes           string       `json:"notes,omitempty"`
-	CreatedAt       time.Time    `json:"created_at"`
-	UpdatedAt       time.Time    `json:"updated_at"`
+	ID              OrderID             `json:"id"`
+	CustomerID      string              `json:"customer_id"`
+	Type            OrderType           `json:"type"`
+	Status          OrderStatus         `json:"status"`
+	Items           []*OrderItem        `json:"items"`
+	TotalAmount     float64             `json:"total_amount"`
+	TaxAmount       float64             `json:"tax_amount"`
+	TableID         string              `json:"table_id,omitempty"`
+	DeliveryAddress string              `json:"delivery_address,omitempty"`
+	DeliveryFee     float64             `json:"delivery_fee,omitempty"`
+	Notes           string              `json:"notes,omitempty"`
+	FulfillmentTime *time.Time          `json:"fulfillment_time,omitempty"`
+	ReleasedAt      *time.Time          `json:"released_at,omitempty"`
+	Discounts       []*OrderDiscount    `json:"discounts,omitempty"`
+	MergedInto      OrderID             `json:"merged_into,omitempty"`
+	GuestCount      int                 `json:"guest_count,omitempty"`
+	ReservationID   string              `json:"reservation_id,omitempty"`
+	StatusHistory   []*StatusTransition `json:"status_history"`
+	Version         int                 `json:"version"`
+	CreatedAt       time.Time           `json:"created_at"`
+	UpdatedAt       time.Time           `json:"updated_at"`
 }
 
 // OrderItem represents an item in an order
 type OrderItem struct {
-	ID            OrderItemID `json:"id"`
-	MenuItemID    string      `json:"menu_item_id"`
-	Name          string      `json:"name"`
-	Quantity      int         `json:"quantity"`
-	UnitPrice     float64     `json:"unit_price"`
-	Modifications []string    `json:"modifications,omitempty"`
-	Notes         string      `json:"notes,omitempty"`
-	Subtotal      float64     `json:"subtotal"`
+	ID            OrderItemID          `json:"id"`
+	MenuItemID    string               `json:"menu_item_id"`
+	Name          string               `json:"name"`
+	Category      string               `json:"category,omitempty"`
+	Quantity      int                  `json:"quantity"`
+	UnitPrice     float64              `json:"unit_price"`
+	Modifiers     []*OrderItemModifier `json:"modifiers,omitempty"`
+	Modifications []string             `json:"modifications,omitempty"`
+	Notes         string               `json:"notes,omitempty"`
+	Subtotal      float64              `json:"subtotal"`
+	Course        int                  `json:"course"`
+	CourseStatus  CourseStatus         `json:"course_status"`
+	Seat          int                  `json:"seat,omitempty"`
+	FiredAt       *time.Time           `json:"fired_at,omitempty"`
+	VoidedAt      *time.Time           `json:"voided_at,omitempty"`
+	VoidReason    string               `json:"void_reason,omitempty"`
+	RefundedAt    *time.Time           `json:"refunded_at,omitempty"`
+}
+
+// OrderItemModifier is a priced modifier option chosen for an order line,
+// snapshotted from the menu at the time the item was added
+type OrderItemModifier struct {
+	GroupID    string  `json:"group_id"`
+	GroupName  string  `json:"group_name"`
+	OptionID   string  `json:"option_id"`
+	OptionName string  `json:"option_name"`
+	PriceDelta float64 `json:"price_delta"`
+}
+
+// LinePrice returns the price of a single unit including its modifiers
+func (i *OrderItem) LinePrice() float64 {
+	price := i.UnitPrice
+	for _, modifier := range i.Modifiers {
+		price += modifier.PriceDelta
+	}
+	return price
 }
 
 // OrderFilters defines filtering options for order queries
@@ -91,28 +132,38 @@ func NewOrder(customerID string, orderType OrderType) (*Order, error) {
 
 	now := time.Now()
 	return &Order{
-		ID:          types.NewID[OrderEntity]("ord"),
-		CustomerID:  customerID,
-		Type:        orderType,
-		Status:      OrderStatusCreated,
-		Items:       make([]*OrderItem, 0),
-		TotalAmount: 0,
-		TaxAmount:   0,
-		CreatedAt:   now,
-		UpdatedAt:   now,
+		ID:            types.NewID[OrderEntity]("ord"),
+		CustomerID:    customerID,
+		Type:          orderType,
+		Status:        OrderStatusCreated,
+		Items:         make([]*OrderItem, 0),
+		StatusHistory: make([]*StatusTransition, 0),
+		TotalAmount:   0,
+		TaxAmount:     0,
+		Version:       1,
+		CreatedAt:     now,
+		UpdatedAt:     now,
 	}, nil
 }
 
-// AddItem adds an item to the order and recalculates the total
+// AddItem adds an item to the first course of the order and recalculates the total
 func (o *Order) AddItem(menuItemID, name string, quantity int, unitPrice float64, mods []string, notes string) error {
+	return o.AddCourseItem(DefaultCourse, menuItemID, name, quantity, unitPrice, nil, mods, notes)
+}
+
+// addItem adds an item with priced modifier options and recalculates the total
+func (o *Order) addItem(menuItemID, name string, quantity int, unitPrice float64, modifiers []*OrderItemModifier, mods []string, notes string) (*OrderItem, error) {
+	if o.Status == OrderStatusCompleted || o.Status == OrderStatusCancelled {
+		return nil, errors.WrapConflict("AddItem", "status", "cannot add items to a completed or cancelled order", nil)
+	}
 	if menuItemID == "" {
-		return errors.WrapValidation("AddItem", "menuItemID", "menu item ID is required", nil)
+		return nil, errors.WrapValidation("AddItem", "menuItemID", "menu item ID is required", nil)
 	}
 	if quantity <= 0 {
-		return errors.WrapValidation("AddItem", "quantity", "quantity must be positive", nil)
+		return nil, errors.WrapValidation("AddItem", "quantity", "quantity must be positive", nil)
 	}
 	if unitPrice < 0 {
-		return errors.WrapValidation("AddItem", "unitPrice", "unit price cannot be negative", nil)
+		return nil, errors.WrapValidation("AddItem", "unitPrice", "unit price cannot be negative", nil)
 	}
 
 	// Create a new item
@@ -122,10 +173,14 @@ func (o *Order) AddItem(menuItemID, name string, quantity int, unitPrice float64
 		Name:          name,
 		Quantity:      quantity,
 		UnitPrice:     unitPrice,
+		Modifiers:     modifiers,
 		Modifications: mods,
 		Notes:         notes,
-		Subtotal:      float64(quantity) * unitPrice,
 	}
+	if item.LinePrice() < 0 {
+		return nil, errors.WrapValidation("AddItem", "modifiers", "modifiers cannot make the item price negative", nil)
+	}
+	item.Subtotal = float64(quantity) * item.LinePrice()
 
 	// Add to items
 	o.Items = append(o.Items, item)
@@ -134,11 +189,15 @@ func (o *Order) AddItem(menuItemID, name string, quantity int, unitPrice float64
 	o.recalculateTotal()
 
 	o.UpdatedAt = time.Now()
-	return nil
+	return item, nil
 }
 
 // RemoveItem removes an item from the order and recalculates the total
 func (o *Order) RemoveItem(itemID OrderItemID) error {
+	if err := o.ensureEditable("RemoveItem"); err != nil {
+		return err
+	}
+
 	for i, item := range o.Items {
 		if item.ID == itemID {
 			// Remove the item
@@ -159,12 +218,15 @@ func (o *Order) UpdateItemQuantity(itemID OrderItemID, quantity int) error {
 	if quantity <= 0 {
 		return errors.WrapValidation("UpdateItemQuantity", "quantity", "quantity must be positive", nil)
 	}
+	if err := o.ensureEditable("UpdateItemQuantity"); err != nil {
+		return err
+	}
 
 	for _, item := range o.Items {
 		if item.ID == itemID {
 			// Update quantity
 			item.Quantity = quantity
-			item.Subtotal = float64(quantity) * item.UnitPrice
+			item.Subtotal = float64(quantity) * item.LinePrice()
 
 			// Recalculate total
 			o.recalculateTotal()
@@ -178,19 +240,15 @@ func (o *Order) UpdateItemQuantity(itemID OrderItemID, quantity int) error {
 
 // recalculateTotal updates the total amount of the order
 func (o *Order) recalculateTotal() {
-	var total float64
-	for _, item := range o.Items {
-		total += item.Subtotal
-	}
+	total := o.Subtotal() - o.DiscountTotal()
 
-	// Simple tax calculation (10%)
-	taxRate := 0.10
-	o.TaxAmount = total * taxRate
-	o.TotalAmount = total + o.TaxAmount
+	// Simple tax calculation (10%) on the discounted subtotal
+	o.TaxAmount = total * TaxRate
+	o.TotalAmount = total + o.TaxAmount + o.DeliveryFee
 }
 
-// UpdateStatus changes the order status
-func (o *Order) UpdateStatus(status OrderStatus) error {
+// UpdateStatus changes the order status, recording who made the change and why
+func (o *Order) UpdateStatus(status OrderStatus, actor, reason string) error {
 	// Validate status transition
 	switch o.Status {
 	case OrderStatusCreated:
@@ -206,15 +264,24 @@ func (o *Order) UpdateStatus(status OrderStatus) error {
 			return errors.WrapConflict("UpdateStatus", "status_transition", "invalid status transition from PREPARING", nil)
 		}
 	case OrderStatusReady:
-		if status != OrderStatusCompleted && status != OrderStatusCancelled {
+		if status == OrderStatusOutForDelivery && o.Type != OrderTypeDelivery {
+			return errors.WrapConflict("UpdateStatus", "status_transition", "only delivery orders can go out for delivery", nil)
+		}
+		if status != OrderStatusCompleted && status != OrderStatusOutForDelivery && status != OrderStatusCancelled {
 			return errors.WrapConflict("UpdateStatus", "status_transition", "invalid status transition from READY", nil)
 		}
+	case OrderStatusOutForDelivery:
+		if status != OrderStatusCompleted && status != OrderStatusCancelled {
+			return errors.WrapConflict("UpdateStatus", "status_transition", "invalid status transition from OUT_FOR_DELIVERY", nil)
+		}
 	case OrderStatusCompleted, OrderStatusCancelled:
 		return errors.WrapConflict("UpdateStatus", "status_transition", "cannot change status of completed or cancelled order", nil)
 	}
 
+	now := time.Now()
+	o.recordTransition(o.Status, status, actor, reason, now)
 	o.Status = status
-	o.UpdatedAt = time.Now()
+	o.UpdatedAt = now
 	return nil
 }
 
@@ -246,6 +313,36 @@ func (o *Order) SetDeliveryAddress(address string) error {
 	return nil
 }
 
+// SetDeliveryFee charges the delivery zone fee on an unpaid delivery order
+func (o *Order) SetDeliveryFee(fee float64) error {
+	if o.Type != OrderTypeDelivery {
+		return errors.WrapConflict("SetDeliveryFee", "order_type", "delivery fee can only be set for delivery orders", nil)
+	}
+	if o.Status != OrderStatusCreated {
+		return errors.WrapConflict("SetDeliveryFee", "order_status", "delivery fee can only change before the order is paid", nil)
+	}
+	if fee < 0 {
+		return errors.WrapValidation("SetDeliveryFee", "fee", "delivery fee cannot be negative", nil)
+	}
+
+	o.DeliveryFee = fee
+	o.recalculateTotal()
+	o.UpdatedAt = time.Now()
+	return nil
+}
+
+// Subtotal returns the value of the non-voided items before tax and fees
+func (o *Order) Subtotal() float64 {
+	var total float64
+	for _, item := range o.Items {
+		if item.IsVoided() {
+			continue
+		}
+		total += item.Subtotal
+	}
+	return total
+}
+
 // AddNotes adds notes to the order
 func (o *Order) AddNotes(notes string) {
 	o.Notes = notes
@@ -257,14 +354,16 @@ func (o *Order) CanCancel() bool {
 	return o.Status != OrderStatusCompleted && o.Status != OrderStatusCancelled
 }
 
-// Cancel cancels the order
-func (o *Order) Cancel() error {
+// Cancel cancels the order, recording who cancelled it and why
+func (o *Order) Cancel(actor, reason string) error {
 	if !o.CanCancel() {
 		return errors.WrapConflict("Cancel", "order_status", "cannot cancel completed or already cancelled order", nil)
 	}
 
+	now := time.Now()
+	o.recordTransition(o.Status, OrderStatusCancelled, actor, reason, now)
 	o.Status = OrderStatusCancelled
-	o.UpdatedAt = time.Now()
+	o.UpdatedAt = now
 	return nil
 }
 
@@ -276,7 +276,7 @@ func (suite *OrderTestSuite) TestUpdateStatus_ValidTransitions() {
 			order.Status = tc.fromStatus
 
 			// When
-			err := order.UpdateStatus(tc.toStatus)
+			err := order.UpdateStatus(tc.toStatus, "", "")
 
 			// Then
 			if tc.shouldError {
@@ -316,7 +316,7 @@ func (suite *OrderTestSuite) TestUpdateStatus_InvalidTransitions() {
 			order.Status = tc.fromStatus
 
 			// When
-			err := order.UpdateStatus(tc.toStatus)
+			err := order.UpdateStatus(tc.toStatus, "", "")
 
 			// Then
 			assert.Error(t, err)
@@ -465,7 +465,7 @@ func (suite *OrderTestSuite) TestCancel_Success() {
 	order.Status = OrderStatusPaid
 
 	// When
-	err := order.Cancel()
+	err := order.Cancel("", "")
 
 	// Then
 	assert := assert.New(suite.T())
@@ -479,7 +479,7 @@ func (suite *OrderTestSuite) TestCancel_CompletedOrder_ShouldFail() {
 	order.Status = OrderStatusCompleted
 
 	// When
-	err := order.Cancel()
+	err := order.Cancel("", "")
 
 	// Then
 	assert := assert.New(suite.T())
@@ -751,13 +751,13 @@ func (suite *OrderTestSuite) TestOrderStateConsistency_AfterCancel() {
 	order, _ := NewOrder("customer-123", OrderTypeDineIn)
 	order.SetTableID("table-5")
 	order.AddItem("item-1", "Salad", 1, 10.00, nil, "")
-	order.UpdateStatus(OrderStatusPaid)
+	order.UpdateStatus(OrderStatusPaid, "", "")
 	
 	originalTotal := order.TotalAmount
 	originalItemCount := len(order.Items)
 	
 	// When
-	err := order.Cancel()
+	err := order.Cancel("", "")
 	
 	// Then
 	assert := assert.New(suite.T())
@@ -824,23 +824,23 @@ func (suite *OrderTestSuite) TestCompleteOrderLifecycle() {
 	assert.NoError(order.Validate())
 	
 	// Payment
-	assert.NoError(order.UpdateStatus(OrderStatusPaid))
+	assert.NoError(order.UpdateStatus(OrderStatusPaid, "", ""))
 	assert.Equal(OrderStatusPaid, order.Status)
 	
 	// Kitchen preparation
-	assert.NoError(order.UpdateStatus(OrderStatusPreparing))
+	assert.NoError(order.UpdateStatus(OrderStatusPreparing, "", ""))
 	assert.Equal(OrderStatusPreparing, order.Status)
 	
 	// Ready for service
-	assert.NoError(order.UpdateStatus(OrderStatusReady))
+	assert.NoError(order.UpdateStatus(OrderStatusReady, "", ""))
 	assert.Equal(OrderStatusReady, order.Status)
 	
 	// Completed
-	assert.NoError(order.UpdateStatus(OrderStatusCompleted))
+	assert.NoError(order.UpdateStatus(OrderStatusCompleted, "", ""))
 	assert.Equal(OrderStatusCompleted, order.Status)
 	
 	// Cannot modify completed order
-	assert.Error(order.UpdateStatus(OrderStatusPaid))
-	assert.Error(order.Cancel())
+	assert.Error(order.UpdateStatus(OrderStatusPaid, "", ""))
+	assert.Error(order.Cancel("", ""))
 	assert.Equal(OrderStatusCompleted, order.Status)
 }
\ No newline at end of file
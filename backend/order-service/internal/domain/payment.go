@@ -0,0 +1,519 @@
+package domain
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"github.com/restaurant-platform/shared/pkg/errors"
+	"github.com/restaurant-platform/shared/pkg/types"
+)
+
+// Payment domain entity markers for type-safe IDs
+type (
+	PaymentEntity struct{}
+	TenderEntity  struct{}
+	RefundEntity  struct{}
+)
+
+// Implement EntityMarker interface
+func (PaymentEntity) IsEntity() {}
+func (TenderEntity) IsEntity()  {}
+func (RefundEntity) IsEntity()  {}
+
+// Type-safe ID types using generics
+type (
+	PaymentID = types.ID[PaymentEntity]
+	TenderID  = types.ID[TenderEntity]
+	RefundID  = types.ID[RefundEntity]
+)
+
+// TenderType represents the ways a customer can pay
+type TenderType string
+
+const (
+	TenderTypeCash     TenderType = "CASH"
+	TenderTypeCard     TenderType = "CARD"
+	TenderTypeGiftCard TenderType = "GIFT_CARD"
+)
+
+// PaymentStatus represents the possible states of an order payment
+type PaymentStatus string
+
+const (
+	PaymentStatusPending           PaymentStatus = "PENDING"
+	PaymentStatusPartiallyPaid     PaymentStatus = "PARTIALLY_PAID"
+	PaymentStatusPaid              PaymentStatus = "PAID"
+	PaymentStatusPartiallyRefunded PaymentStatus = "PARTIALLY_REFUNDED"
+	PaymentStatusRefunded          PaymentStatus = "REFUNDED"
+	PaymentStatusVoided            PaymentStatus = "VOIDED"
+)
+
+// TenderStatus represents the possible states of a single tender
+type TenderStatus string
+
+const (
+	TenderStatusCaptured TenderStatus = "CAPTURED"
+	TenderStatusRefunded TenderStatus = "REFUNDED"
+	TenderStatusVoided   TenderStatus = "VOIDED"
+)
+
+// Payment is the aggregate root recording how an order was paid
+type Payment struct {
+	ID             PaymentID     `json:"id"`
+	OrderID        OrderID       `json:"order_id"`
+	Status         PaymentStatus `json:"status"`
+	AmountDue      float64       `json:"amount_due"`
+	AmountPaid     float64       `json:"amount_paid"`
+	AmountRefunded float64       `json:"amount_refunded"`
+	ChangeGiven    float64       `json:"change_given"`
+	Tenders        []*Tender     `json:"tenders"`
+	Refunds        []*Refund     `json:"refunds"`
+	VoidReason     string        `json:"void_reason,omitempty"`
+	Version        int           `json:"version"`
+	CreatedAt      time.Time     `json:"created_at"`
+	UpdatedAt      time.Time     `json:"updated_at"`
+}
+
+// Tender represents a single means of payment applied to an order
+type Tender struct {
+	ID             TenderID     `json:"id"`
+	Type           TenderType   `json:"type"`
+	Status         TenderStatus `json:"status"`
+	Amount         float64      `json:"amount"`
+	AmountTendered float64      `json:"amount_tendered"`
+	Change         float64      `json:"change"`
+	AmountRefunded float64      `json:"amount_refunded"`
+	Reference      string       `json:"reference,omitempty"`
+	ProviderRef    string       `json:"provider_ref,omitempty"`
+	// DrawerSessionID is the cash drawer session a cash tender was taken into
+	DrawerSessionID DrawerSessionID `json:"drawer_session_id,omitempty"`
+	CreatedAt       time.Time       `json:"created_at"`
+}
+
+// Refund records money returned against a tender
+type Refund struct {
+	ID          RefundID `json:"id"`
+	TenderID    TenderID `json:"tender_id"`
+	Amount      float64  `json:"amount"`
+	Reason      string   `json:"reason"`
+	ProviderRef string   `json:"provider_ref,omitempty"`
+	// DrawerSessionID is the cash drawer session a cash refund was paid out of
+	DrawerSessionID DrawerSessionID `json:"drawer_session_id,omitempty"`
+	// Pending marks a refund reserved against its tender while the money is being returned
+	Pending   bool      `json:"pending,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// NewPayment creates a new pending payment for the given order amount
+func NewPayment(orderID OrderID, amountDue float64) (*Payment, error) {
+	if orderID.IsEmpty() {
+		return nil, errors.WrapValidation("NewPayment", "orderID", "order ID is required", nil)
+	}
+	if amountDue <= 0 {
+		return nil, errors.WrapValidation("NewPayment", "amountDue", "amount due must be positive", nil)
+	}
+
+	now := time.Now()
+	return &Payment{
+		ID:        types.NewID[PaymentEntity]("pay"),
+		OrderID:   orderID,
+		Status:    PaymentStatusPending,
+		AmountDue: roundCents(amountDue),
+		Tenders:   make([]*Tender, 0),
+		Refunds:   make([]*Refund, 0),
+		Version:   1,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}, nil
+}
+
+// Remaining returns the balance still owed on the payment
+func (p *Payment) Remaining() float64 {
+	remaining := roundCents(p.AmountDue - p.AmountPaid)
+	if remaining < 0 {
+		return 0
+	}
+	return remaining
+}
+
+// IsFullyPaid checks if the captured tenders cover the amount due
+func (p *Payment) IsFullyPaid() bool {
+	return p.AmountPaid > 0 && p.Remaining() == 0
+}
+
+// CanAcceptTender checks if further tenders may be applied
+func (p *Payment) CanAcceptTender() bool {
+	return p.Status == PaymentStatusPending || p.Status == PaymentStatusPartiallyPaid
+}
+
+// SetAmountDue re-bases the amount due while no tender has been applied
+func (p *Payment) SetAmountDue(amountDue float64) error {
+	if len(p.Tenders) > 0 {
+		return errors.WrapConflict("SetAmountDue", "payment", "amount due cannot change once a tender has been applied", nil)
+	}
+	if amountDue <= 0 {
+		return errors.WrapValidation("SetAmountDue", "amountDue", "amount due must be positive", nil)
+	}
+
+	p.AmountDue = roundCents(amountDue)
+	p.UpdatedAt = time.Now()
+	return nil
+}
+
+// Reopen raises the amount due of a payment that was paid in full, so items added to
+// the order after it was paid can be charged for
+func (p *Payment) Reopen(amountDue float64) error {
+	if p.Status != PaymentStatusPaid {
+		return errors.WrapConflict("Reopen", "payment_status", "only a payment paid in full can be reopened", nil)
+	}
+	amountDue = roundCents(amountDue)
+	if amountDue <= p.AmountDue {
+		return errors.WrapValidation("Reopen", "amountDue", "amount due must exceed the amount already due", nil)
+	}
+
+	p.AmountDue = amountDue
+	p.Status = PaymentStatusPartiallyPaid
+	p.UpdatedAt = time.Now()
+	return nil
+}
+
+// AddTender applies a tender to the payment.
+// Cash may be over-tendered and the difference is returned as change;
+// card and gift card tenders must not exceed the remaining balance.
+func (p *Payment) AddTender(tenderType TenderType, amount, amountTendered float64, reference, providerRef string) (*Tender, error) {
+	if !p.CanAcceptTender() {
+		return nil, errors.WrapConflict("AddTender", "payment_status", "payment does not accept further tenders", nil)
+	}
+
+	amount = roundCents(amount)
+	remaining := p.Remaining()
+
+	tender := &Tender{
+		ID:          types.NewID[TenderEntity]("tnd"),
+		Type:        tenderType,
+		Status:      TenderStatusCaptured,
+		Reference:   reference,
+		ProviderRef: providerRef,
+		CreatedAt:   time.Now(),
+	}
+
+	switch tenderType {
+	case TenderTypeCash:
+		amountTendered = roundCents(amountTendered)
+		if amountTendered == 0 {
+			amountTendered = amount
+		}
+		if amountTendered <= 0 {
+			return nil, errors.WrapValidation("AddTender", "amountTendered", "cash tendered must be positive", nil)
+		}
+		if amount <= 0 || amount > amountTendered {
+			amount = amountTendered
+		}
+		if amount > remaining {
+			amount = remaining
+		}
+		tender.Amount = amount
+		tender.AmountTendered = amountTendered
+		tender.Change = roundCents(amountTendered - amount)
+	case TenderTypeCard, TenderTypeGiftCard:
+		if amount <= 0 {
+			return nil, errors.WrapValidation("AddTender", "amount", "amount must be positive", nil)
+		}
+		if amount > remaining {
+			return nil, errors.WrapValidation("AddTender", "amount", "amount exceeds remaining balance", nil)
+		}
+		tender.Amount = amount
+		tender.AmountTendered = amount
+	default:
+		return nil, errors.WrapValidation("AddTender", "type", "invalid tender type", nil)
+	}
+
+	p.Tenders = append(p.Tenders, tender)
+	p.AmountPaid = roundCents(p.AmountPaid + tender.Amount)
+	p.ChangeGiven = roundCents(p.ChangeGiven + tender.Change)
+
+	if p.IsFullyPaid() {
+		p.Status = PaymentStatusPaid
+	} else {
+		p.Status = PaymentStatusPartiallyPaid
+	}
+
+	p.UpdatedAt = time.Now()
+	return tender, nil
+}
+
+// GetTender retrieves a tender by ID
+func (p *Payment) GetTender(id TenderID) (*Tender, error) {
+	for _, tender := range p.Tenders {
+		if tender.ID == id {
+			return tender, nil
+		}
+	}
+	return nil, errors.WrapNotFound("GetTender", "tender", string(id), errors.ErrNotFound)
+}
+
+// Refund returns part or all of a captured tender
+func (p *Payment) Refund(tenderID TenderID, amount float64, reason, providerRef string) (*Refund, error) {
+	refund, err := p.BeginRefund(tenderID, amount, reason)
+	if err != nil {
+		return nil, err
+	}
+	return refund, p.CompleteRefund(refund.ID, providerRef, "")
+}
+
+// BeginRefund reserves part or all of a captured tender for a refund. The refund stays
+// pending, and counts against the tender, until the money has been returned and it is
+// completed, or it is cancelled.
+func (p *Payment) BeginRefund(tenderID TenderID, amount float64, reason string) (*Refund, error) {
+	if reason == "" {
+		return nil, errors.WrapValidation("Refund", "reason", "refund reason is required", nil)
+	}
+	if p.Status != PaymentStatusPaid && p.Status != PaymentStatusPartiallyRefunded {
+		return nil, errors.WrapConflict("Refund", "payment_status", "only settled payments can be refunded", nil)
+	}
+
+	tender, err := p.GetTender(tenderID)
+	if err != nil {
+		return nil, err
+	}
+	if tender.Status != TenderStatusCaptured {
+		return nil, errors.WrapConflict("Refund", "tender_status", "tender has already been refunded or voided", nil)
+	}
+
+	amount = roundCents(amount)
+	refundable := roundCents(tender.Amount - tender.AmountRefunded)
+	if amount <= 0 {
+		return nil, errors.WrapValidation("Refund", "amount", "amount must be positive", nil)
+	}
+	if amount > refundable {
+		return nil, errors.WrapValidation("Refund", "amount", "amount exceeds refundable balance of tender", nil)
+	}
+
+	refund := &Refund{
+		ID:        types.NewID[RefundEntity]("rfd"),
+		TenderID:  tenderID,
+		Amount:    amount,
+		Reason:    reason,
+		Pending:   true,
+		CreatedAt: time.Now(),
+	}
+
+	p.Refunds = append(p.Refunds, refund)
+	p.applyRefunded(tender, amount)
+	return refund, nil
+}
+
+// CompleteRefund records that the money of a pending refund has been returned
+func (p *Payment) CompleteRefund(refundID RefundID, providerRef string, drawerSessionID DrawerSessionID) error {
+	refund, err := p.pendingRefund(refundID)
+	if err != nil {
+		return err
+	}
+
+	refund.Pending = false
+	refund.ProviderRef = providerRef
+	refund.DrawerSessionID = drawerSessionID
+	p.UpdatedAt = time.Now()
+	return nil
+}
+
+// CancelRefund releases a pending refund whose money could not be returned
+func (p *Payment) CancelRefund(refundID RefundID) error {
+	refund, err := p.pendingRefund(refundID)
+	if err != nil {
+		return err
+	}
+	tender, err := p.GetTender(refund.TenderID)
+	if err != nil {
+		return err
+	}
+
+	for i, r := range p.Refunds {
+		if r == refund {
+			p.Refunds = append(p.Refunds[:i], p.Refunds[i+1:]...)
+			break
+		}
+	}
+	p.applyRefunded(tender, -refund.Amount)
+	return nil
+}
+
+// GetRefund retrieves a refund by ID
+func (p *Payment) GetRefund(id RefundID) (*Refund, error) {
+	for _, refund := range p.Refunds {
+		if refund.ID == id {
+			return refund, nil
+		}
+	}
+	return nil, errors.WrapNotFound("GetRefund", "refund", string(id), errors.ErrNotFound)
+}
+
+func (p *Payment) pendingRefund(id RefundID) (*Refund, error) {
+	refund, err := p.GetRefund(id)
+	if err != nil {
+		return nil, err
+	}
+	if !refund.Pending {
+		return nil, errors.WrapConflict("Refund", "refund_status", "refund is no longer pending", nil)
+	}
+	return refund, nil
+}
+
+// applyRefunded adds a refunded amount, negative to release one, to a tender and the
+// payment and updates their statuses
+func (p *Payment) applyRefunded(tender *Tender, amount float64) {
+	tender.AmountRefunded = roundCents(tender.AmountRefunded + amount)
+	if tender.AmountRefunded == tender.Amount {
+		tender.Status = TenderStatusRefunded
+	} else {
+		tender.Status = TenderStatusCaptured
+	}
+
+	p.AmountRefunded = roundCents(p.AmountRefunded + amount)
+	switch {
+	case p.AmountRefunded == 0:
+		p.Status = PaymentStatusPaid
+	case p.AmountRefunded == p.AmountPaid:
+		p.Status = PaymentStatusRefunded
+	default:
+		p.Status = PaymentStatusPartiallyRefunded
+	}
+
+	p.UpdatedAt = time.Now()
+}
+
+// Refundable returns the amount of the captured tenders not yet refunded
+func (p *Payment) Refundable() float64 {
+	if p.Status != PaymentStatusPaid && p.Status != PaymentStatusPartiallyRefunded {
+		return 0
+	}
+
+	var refundable float64
+	for _, tender := range p.Tenders {
+		if tender.Status == TenderStatusCaptured {
+			refundable += tender.Amount - tender.AmountRefunded
+		}
+	}
+	return roundCents(refundable)
+}
+
+// RefundAllocation is the part of an order refund returned to one tender
+type RefundAllocation struct {
+	TenderID TenderID
+	Amount   float64
+}
+
+// AllocateRefund splits a refund across the captured tenders, most recent first
+func (p *Payment) AllocateRefund(amount float64) ([]RefundAllocation, error) {
+	amount = roundCents(amount)
+	if amount <= 0 {
+		return nil, errors.WrapValidation("AllocateRefund", "amount", "amount must be positive", nil)
+	}
+	if amount > p.Refundable() {
+		return nil, errors.WrapValidation("AllocateRefund", "amount", "amount exceeds refundable balance of payment", nil)
+	}
+
+	var allocations []RefundAllocation
+	for i := len(p.Tenders) - 1; i >= 0 && amount > 0; i-- {
+		tender := p.Tenders[i]
+		if tender.Status != TenderStatusCaptured {
+			continue
+		}
+		share := math.Min(amount, roundCents(tender.Amount-tender.AmountRefunded))
+		allocations = append(allocations, RefundAllocation{TenderID: tender.ID, Amount: share})
+		amount = roundCents(amount - share)
+	}
+	return allocations, nil
+}
+
+// Void cancels a payment that has not been settled yet. Its tenders stay captured
+// until their money has been returned and each is marked with VoidTender.
+func (p *Payment) Void(reason string) error {
+	if reason == "" {
+		return errors.WrapValidation("Void", "reason", "void reason is required", nil)
+	}
+	if !p.CanAcceptTender() {
+		return errors.WrapConflict("Void", "payment_status", "only unsettled payments can be voided; refund instead", nil)
+	}
+
+	p.Status = PaymentStatusVoided
+	p.VoidReason = reason
+	p.UpdatedAt = time.Now()
+	return nil
+}
+
+// VoidTender records that the money of a tender on a voided payment has been returned
+func (p *Payment) VoidTender(tenderID TenderID) error {
+	if p.Status != PaymentStatusVoided {
+		return errors.WrapConflict("VoidTender", "payment_status", "only tenders of a voided payment can be voided", nil)
+	}
+
+	tender, err := p.GetTender(tenderID)
+	if err != nil {
+		return err
+	}
+
+	tender.Status = TenderStatusVoided
+	p.UpdatedAt = time.Now()
+	return nil
+}
+
+// UnvoidedTenders returns the tenders of a voided payment whose money has not been returned yet
+func (p *Payment) UnvoidedTenders() []*Tender {
+	if p.Status != PaymentStatusVoided {
+		return nil
+	}
+
+	var tenders []*Tender
+	for _, tender := range p.Tenders {
+		if tender.Status == TenderStatusCaptured {
+			tenders = append(tenders, tender)
+		}
+	}
+	return tenders
+}
+
+// TotalsByTender returns the net amount collected per tender type
+func (p *Payment) TotalsByTender() map[TenderType]float64 {
+	totals := make(map[TenderType]float64)
+	for _, tender := range p.Tenders {
+		if tender.Status == TenderStatusVoided {
+			continue
+		}
+		totals[tender.Type] = roundCents(totals[tender.Type] + tender.Amount - tender.AmountRefunded)
+	}
+	return totals
+}
+
+// roundCents rounds a monetary amount to two decimal places
+func roundCents(amount float64) float64 {
+	return math.Round(amount*100) / 100
+}
+
+// ChargeRequest describes a charge sent to an external payment provider
+type ChargeRequest struct {
+	OrderID    OrderID
+	TenderType TenderType
+	Amount     float64
+	Reference  string
+}
+
+// ChargeResult is the outcome of a successful provider charge
+type ChargeResult struct {
+	ProviderRef string
+	Approved    bool
+	Message     string
+}
+
+// PaymentProvider abstracts the processor used for non-cash tenders
+type PaymentProvider interface {
+	// Charge captures funds for a tender
+	Charge(ctx context.Context, req ChargeRequest) (*ChargeResult, error)
+
+	// Refund returns funds for a previously captured charge
+	Refund(ctx context.Context, providerRef string, amount float64) (string, error)
+
+	// Void cancels a previously captured charge before settlement
+	Void(ctx context.Context, providerRef string) error
+}
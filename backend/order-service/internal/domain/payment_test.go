@@ -0,0 +1,291 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/restaurant-platform/shared/pkg/errors"
+)
+
+// PaymentTestSuite contains all payment domain tests
+type PaymentTestSuite struct {
+	suite.Suite
+	payment *Payment
+}
+
+func TestPaymentTestSuite(t *testing.T) {
+	suite.Run(t, new(PaymentTestSuite))
+}
+
+func (suite *PaymentTestSuite) SetupTest() {
+	suite.payment, _ = NewPayment(OrderID("order-123"), 42.50)
+}
+
+// Test Payment Creation
+func (suite *PaymentTestSuite) TestNewPayment_Success() {
+	// Then
+	assert := assert.New(suite.T())
+	assert.NotEmpty(suite.payment.ID)
+	assert.Equal(PaymentStatusPending, suite.payment.Status)
+	assert.Equal(42.50, suite.payment.AmountDue)
+	assert.Equal(42.50, suite.payment.Remaining())
+	assert.Empty(suite.payment.Tenders)
+}
+
+func (suite *PaymentTestSuite) TestNewPayment_InvalidAmount_ShouldFail() {
+	// When
+	payment, err := NewPayment(OrderID("order-123"), 0)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.Error(err)
+	assert.Nil(payment)
+	assert.Contains(err.Error(), "amount due must be positive")
+}
+
+// Test Tenders
+func (suite *PaymentTestSuite) TestAddTender_CashWithChange() {
+	// When
+	tender, err := suite.payment.AddTender(TenderTypeCash, 0, 50.00, "", "")
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	assert.Equal(42.50, tender.Amount)
+	assert.Equal(50.00, tender.AmountTendered)
+	assert.Equal(7.50, tender.Change)
+	assert.Equal(7.50, suite.payment.ChangeGiven)
+	assert.Equal(PaymentStatusPaid, suite.payment.Status)
+	assert.True(suite.payment.IsFullyPaid())
+}
+
+func (suite *PaymentTestSuite) TestAddTender_SplitCardAndCash() {
+	// When
+	_, err := suite.payment.AddTender(TenderTypeCard, 20.00, 0, "visa-4242", "ch_1")
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	assert.Equal(PaymentStatusPartiallyPaid, suite.payment.Status)
+	assert.Equal(22.50, suite.payment.Remaining())
+
+	_, err = suite.payment.AddTender(TenderTypeCash, 22.50, 22.50, "", "")
+
+	// Then
+	assert.NoError(err)
+	assert.Equal(PaymentStatusPaid, suite.payment.Status)
+	assert.Equal(float64(0), suite.payment.ChangeGiven)
+	assert.Equal(map[TenderType]float64{TenderTypeCard: 20.00, TenderTypeCash: 22.50}, suite.payment.TotalsByTender())
+}
+
+func (suite *PaymentTestSuite) TestAddTender_CardOverpayment_ShouldFail() {
+	// When
+	tender, err := suite.payment.AddTender(TenderTypeCard, 50.00, 0, "", "ch_1")
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.Error(err)
+	assert.Nil(tender)
+	assert.Contains(err.Error(), "amount exceeds remaining balance")
+	assert.Equal(PaymentStatusPending, suite.payment.Status)
+}
+
+func (suite *PaymentTestSuite) TestAddTender_CashUnderTendered_StaysPartial() {
+	// When
+	tender, err := suite.payment.AddTender(TenderTypeCash, 0, 20.00, "", "")
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	assert.Equal(20.00, tender.Amount)
+	assert.Equal(float64(0), tender.Change)
+	assert.Equal(PaymentStatusPartiallyPaid, suite.payment.Status)
+	assert.Equal(22.50, suite.payment.Remaining())
+}
+
+func (suite *PaymentTestSuite) TestAddTender_AfterSettled_ShouldFail() {
+	// Given
+	suite.payment.AddTender(TenderTypeCash, 0, 42.50, "", "")
+
+	// When
+	_, err := suite.payment.AddTender(TenderTypeCash, 0, 5.00, "", "")
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.Error(err)
+	assert.Contains(err.Error(), "does not accept further tenders")
+}
+
+func (suite *PaymentTestSuite) TestSetAmountDue_AfterTender_ShouldFail() {
+	// Given
+	suite.payment.AddTender(TenderTypeCash, 0, 10.00, "", "")
+
+	// When
+	err := suite.payment.SetAmountDue(60.00)
+
+	// Then
+	assert.New(suite.T()).Error(err)
+}
+
+func (suite *PaymentTestSuite) TestReopen_PaidPayment_AcceptsTenderForTheAddOn() {
+	// Given
+	suite.payment.AddTender(TenderTypeCard, 42.50, 0, "", "ch_1")
+
+	// When
+	err := suite.payment.Reopen(51.30)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	assert.Equal(PaymentStatusPartiallyPaid, suite.payment.Status)
+	assert.Equal(8.80, suite.payment.Remaining())
+	_, err = suite.payment.AddTender(TenderTypeCard, 8.80, 0, "", "ch_2")
+	assert.NoError(err)
+	assert.Equal(PaymentStatusPaid, suite.payment.Status)
+}
+
+func (suite *PaymentTestSuite) TestReopen_UnpaidPayment_ShouldFail() {
+	// When
+	err := suite.payment.Reopen(60.00)
+
+	// Then
+	assert.New(suite.T()).True(errors.IsConflictError(err))
+}
+
+// Test Refunds
+func (suite *PaymentTestSuite) TestRefund_PartialThenFull() {
+	// Given
+	tender, _ := suite.payment.AddTender(TenderTypeCard, 42.50, 0, "", "ch_1")
+
+	// When
+	_, err := suite.payment.Refund(tender.ID, 10.00, "cold food", "rf_1")
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	assert.Equal(PaymentStatusPartiallyRefunded, suite.payment.Status)
+	assert.Equal(TenderStatusCaptured, tender.Status)
+
+	_, err = suite.payment.Refund(tender.ID, 32.50, "customer left", "rf_2")
+
+	// Then
+	assert.NoError(err)
+	assert.Equal(PaymentStatusRefunded, suite.payment.Status)
+	assert.Equal(TenderStatusRefunded, tender.Status)
+	assert.Equal(42.50, suite.payment.AmountRefunded)
+	assert.Len(suite.payment.Refunds, 2)
+}
+
+func (suite *PaymentTestSuite) TestRefund_ExceedsTender_ShouldFail() {
+	// Given
+	tender, _ := suite.payment.AddTender(TenderTypeCard, 42.50, 0, "", "ch_1")
+
+	// When
+	_, err := suite.payment.Refund(tender.ID, 50.00, "overcharge", "")
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.Error(err)
+	assert.Contains(err.Error(), "exceeds refundable balance")
+}
+
+func (suite *PaymentTestSuite) TestRefund_MissingReason_ShouldFail() {
+	// Given
+	tender, _ := suite.payment.AddTender(TenderTypeCard, 42.50, 0, "", "ch_1")
+
+	// When
+	_, err := suite.payment.Refund(tender.ID, 5.00, "", "")
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.Error(err)
+	assert.Contains(err.Error(), "refund reason is required")
+}
+
+func (suite *PaymentTestSuite) TestBeginRefund_ReservesTheTenderUntilCancelled() {
+	// Given
+	tender, _ := suite.payment.AddTender(TenderTypeCard, 42.50, 0, "", "ch_1")
+
+	// When
+	refund, err := suite.payment.BeginRefund(tender.ID, 42.50, "cold food")
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	assert.True(refund.Pending)
+	assert.Equal(PaymentStatusRefunded, suite.payment.Status)
+	assert.Zero(suite.payment.Refundable())
+	_, err = suite.payment.BeginRefund(tender.ID, 1.00, "cold food")
+	assert.Error(err)
+
+	// When the money could not be returned
+	assert.NoError(suite.payment.CancelRefund(refund.ID))
+
+	// Then
+	assert.Equal(PaymentStatusPaid, suite.payment.Status)
+	assert.Equal(TenderStatusCaptured, tender.Status)
+	assert.Equal(42.50, suite.payment.Refundable())
+	assert.Empty(suite.payment.Refunds)
+}
+
+func (suite *PaymentTestSuite) TestCompleteRefund_RecordsWhereTheMoneyWent() {
+	// Given
+	tender, _ := suite.payment.AddTender(TenderTypeCard, 42.50, 0, "", "ch_1")
+	refund, _ := suite.payment.BeginRefund(tender.ID, 10.00, "cold food")
+
+	// When
+	err := suite.payment.CompleteRefund(refund.ID, "rf_1", "")
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	assert.False(refund.Pending)
+	assert.Equal("rf_1", refund.ProviderRef)
+	assert.Error(suite.payment.CancelRefund(refund.ID))
+}
+
+// Test Voids
+func (suite *PaymentTestSuite) TestVoid_PartialPayment() {
+	// Given
+	tender, _ := suite.payment.AddTender(TenderTypeCard, 20.00, 0, "", "ch_1")
+
+	// When
+	err := suite.payment.Void("wrong order")
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	assert.Equal(PaymentStatusVoided, suite.payment.Status)
+	assert.Equal("wrong order", suite.payment.VoidReason)
+	assert.Equal([]*Tender{tender}, suite.payment.UnvoidedTenders())
+
+	// When the charge has been voided
+	assert.NoError(suite.payment.VoidTender(tender.ID))
+
+	// Then
+	assert.Equal(TenderStatusVoided, tender.Status)
+	assert.Empty(suite.payment.UnvoidedTenders())
+	assert.Empty(suite.payment.TotalsByTender())
+}
+
+func (suite *PaymentTestSuite) TestVoidTender_UnvoidedPayment_ShouldFail() {
+	// Given
+	tender, _ := suite.payment.AddTender(TenderTypeCard, 20.00, 0, "", "ch_1")
+
+	// When
+	err := suite.payment.VoidTender(tender.ID)
+
+	// Then
+	assert.New(suite.T()).Error(err)
+}
+
+func (suite *PaymentTestSuite) TestVoid_SettledPayment_ShouldFail() {
+	// Given
+	suite.payment.AddTender(TenderTypeCash, 0, 42.50, "", "")
+
+	// When
+	err := suite.payment.Void("changed mind")
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.Error(err)
+	assert.Contains(err.Error(), "refund instead")
+}
@@ -0,0 +1,239 @@
+package domain
+
+import (
+	"math"
+	"time"
+
+	"github.com/restaurant-platform/shared/pkg/errors"
+)
+
+// ReceiptFormat is an output format a receipt can be rendered to
+type ReceiptFormat string
+
+const (
+	ReceiptFormatESCPOS ReceiptFormat = "escpos"
+	ReceiptFormatText   ReceiptFormat = "text"
+	ReceiptFormatHTML   ReceiptFormat = "html"
+)
+
+// Receipt paper widths in characters
+const (
+	ReceiptWidthNarrow = 40
+	ReceiptWidthWide   = 48
+)
+
+// ReceiptKind tells a guest check, presented before payment, from a receipt for a paid order
+type ReceiptKind string
+
+const (
+	ReceiptKindGuestCheck ReceiptKind = "GUEST_CHECK"
+	ReceiptKindReceipt    ReceiptKind = "RECEIPT"
+)
+
+// SuggestedTipPercents are the tip amounts offered on guest checks
+var SuggestedTipPercents = []int{15, 18, 20}
+
+// RestaurantInfo is the header and footer printed on every receipt
+type RestaurantInfo struct {
+	Name    string `json:"name"`
+	Address string `json:"address,omitempty"`
+	Phone   string `json:"phone,omitempty"`
+	TaxID   string `json:"tax_id,omitempty"`
+	Footer  string `json:"footer,omitempty"`
+}
+
+// Receipt is a printable snapshot of an order and its payment
+type Receipt struct {
+	Kind          ReceiptKind        `json:"kind"`
+	Restaurant    RestaurantInfo     `json:"restaurant"`
+	OrderID       OrderID            `json:"order_id"`
+	OrderType     OrderType          `json:"order_type"`
+	TableID       string             `json:"table_id,omitempty"`
+	CustomerID    string             `json:"customer_id"`
+	Lines         []*ReceiptLine     `json:"lines"`
+	Subtotal      float64            `json:"subtotal"`
+	Discounts     []*ReceiptDiscount `json:"discounts,omitempty"`
+	Taxes         []*ReceiptTax      `json:"taxes"`
+	DeliveryFee   float64            `json:"delivery_fee,omitempty"`
+	Total         float64            `json:"total"`
+	SuggestedTips []*SuggestedTip    `json:"suggested_tips,omitempty"`
+	Tenders       []*ReceiptTender   `json:"tenders,omitempty"`
+	AmountPaid    float64            `json:"amount_paid"`
+	ChangeGiven   float64            `json:"change_given,omitempty"`
+	Refunded      float64            `json:"refunded,omitempty"`
+	BalanceDue    float64            `json:"balance_due"`
+	Reprint       bool               `json:"reprint"`
+	OrderedAt     time.Time          `json:"ordered_at"`
+	PrintedAt     time.Time          `json:"printed_at"`
+}
+
+// ReceiptLine is a non-voided order item as printed
+type ReceiptLine struct {
+	Quantity      int                `json:"quantity"`
+	Name          string             `json:"name"`
+	Amount        float64            `json:"amount"`
+	Modifiers     []*ReceiptModifier `json:"modifiers,omitempty"`
+	Modifications []string           `json:"modifications,omitempty"`
+}
+
+// ReceiptModifier is a modifier option printed under its line, priced for the whole line
+type ReceiptModifier struct {
+	Name   string  `json:"name"`
+	Amount float64 `json:"amount"`
+}
+
+// ReceiptDiscount is a discount line printed below the subtotal
+type ReceiptDiscount struct {
+	Description string  `json:"description"`
+	Amount      float64 `json:"amount"`
+}
+
+// ReceiptTax is one entry of the tax breakdown
+type ReceiptTax struct {
+	Label  string  `json:"label"`
+	Rate   float64 `json:"rate"`
+	Amount float64 `json:"amount"`
+}
+
+// SuggestedTip is a tip amount offered on a guest check, with the total it would make
+type SuggestedTip struct {
+	Percent int     `json:"percent"`
+	Amount  float64 `json:"amount"`
+	Total   float64 `json:"total"`
+}
+
+// ReceiptTender is a captured, refunded or voided tender as printed
+type ReceiptTender struct {
+	Type      TenderType   `json:"type"`
+	Status    TenderStatus `json:"status"`
+	Amount    float64      `json:"amount"`
+	Tendered  float64      `json:"tendered"`
+	Change    float64      `json:"change,omitempty"`
+	Reference string       `json:"reference,omitempty"`
+}
+
+// RenderedReceipt is a receipt rendered to one output format
+type RenderedReceipt struct {
+	Format      ReceiptFormat
+	ContentType string
+	Content     []byte
+}
+
+// ReceiptRenderer turns receipts into printable or mailable documents
+type ReceiptRenderer interface {
+	// Render renders a receipt; width is the paper width in characters and is ignored for HTML
+	Render(receipt *Receipt, format ReceiptFormat, width int) (*RenderedReceipt, error)
+}
+
+// NewReceipt builds a receipt for an order and its payment, if any. Orders
+// that are not yet paid get a guest check with suggested tips.
+func NewReceipt(order *Order, payment *Payment, restaurant RestaurantInfo, printedAt time.Time) (*Receipt, error) {
+	if order == nil {
+		return nil, errors.WrapValidation("NewReceipt", "order", "order is required", nil)
+	}
+	if order.IsEmpty() {
+		return nil, errors.WrapConflict("NewReceipt", "order", "cannot print a receipt for an order without items", nil)
+	}
+
+	receipt := &Receipt{
+		Kind:        ReceiptKindGuestCheck,
+		Restaurant:  restaurant,
+		OrderID:     order.ID,
+		OrderType:   order.Type,
+		TableID:     order.TableID,
+		CustomerID:  order.CustomerID,
+		Lines:       make([]*ReceiptLine, 0, len(order.Items)),
+		Subtotal:    roundCents(order.Subtotal()),
+		DeliveryFee: roundCents(order.DeliveryFee),
+		Total:       roundCents(order.TotalAmount),
+		OrderedAt:   order.CreatedAt,
+		PrintedAt:   printedAt,
+	}
+
+	for _, item := range order.Items {
+		if item.IsVoided() {
+			continue
+		}
+		line := &ReceiptLine{
+			Quantity:      item.Quantity,
+			Name:          item.Name,
+			Amount:        roundCents(item.UnitPrice * float64(item.Quantity)),
+			Modifications: item.Modifications,
+		}
+		for _, modifier := range item.Modifiers {
+			line.Modifiers = append(line.Modifiers, &ReceiptModifier{
+				Name:   modifier.OptionName,
+				Amount: roundCents(modifier.PriceDelta * float64(item.Quantity)),
+			})
+		}
+		receipt.Lines = append(receipt.Lines, line)
+	}
+
+	for _, discount := range order.Discounts {
+		receipt.Discounts = append(receipt.Discounts, &ReceiptDiscount{
+			Description: discount.Description,
+			Amount:      roundCents(discount.Amount),
+		})
+	}
+
+	receipt.Taxes = []*ReceiptTax{{
+		Label:  "Sales tax",
+		Rate:   TaxRate,
+		Amount: roundCents(order.TaxAmount),
+	}}
+
+	if payment != nil && payment.Status != PaymentStatusVoided {
+		for _, tender := range payment.Tenders {
+			receipt.Tenders = append(receipt.Tenders, &ReceiptTender{
+				Type:      tender.Type,
+				Status:    tender.Status,
+				Amount:    tender.Amount,
+				Tendered:  tender.AmountTendered,
+				Change:    tender.Change,
+				Reference: tender.Reference,
+			})
+		}
+		receipt.AmountPaid = payment.AmountPaid
+		receipt.ChangeGiven = payment.ChangeGiven
+		receipt.Refunded = payment.AmountRefunded
+		switch payment.Status {
+		case PaymentStatusPaid, PaymentStatusPartiallyRefunded, PaymentStatusRefunded:
+			receipt.Kind = ReceiptKindReceipt
+		}
+	}
+	if receipt.Kind == ReceiptKindGuestCheck {
+		receipt.BalanceDue = math.Max(roundCents(receipt.Total-receipt.AmountPaid), 0)
+	}
+
+	if receipt.Kind == ReceiptKindGuestCheck {
+		for _, percent := range SuggestedTipPercents {
+			tip := roundCents(receipt.Subtotal * float64(percent) / 100)
+			receipt.SuggestedTips = append(receipt.SuggestedTips, &SuggestedTip{
+				Percent: percent,
+				Amount:  tip,
+				Total:   roundCents(receipt.Total + tip),
+			})
+		}
+	}
+
+	return receipt, nil
+}
+
+// IsGuestCheck reports whether the receipt is presented before payment
+func (r *Receipt) IsGuestCheck() bool {
+	return r.Kind == ReceiptKindGuestCheck
+}
+
+// ValidateReceiptFormat checks that a receipt can be rendered in format at width
+func ValidateReceiptFormat(format ReceiptFormat, width int) error {
+	switch format {
+	case ReceiptFormatESCPOS, ReceiptFormatText:
+		if width != ReceiptWidthNarrow && width != ReceiptWidthWide {
+			return errors.WrapValidation("ValidateReceiptFormat", "width", "width must be 40 or 48 columns", nil)
+		}
+	case ReceiptFormatHTML:
+	default:
+		return errors.WrapValidation("ValidateReceiptFormat", "format", "format must be escpos, text or html", nil)
+	}
+	return nil
+}
@@ -0,0 +1,156 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/restaurant-platform/shared/pkg/errors"
+)
+
+// ReceiptTestSuite contains receipt building tests
+type ReceiptTestSuite struct {
+	suite.Suite
+	order      *Order
+	restaurant RestaurantInfo
+	printedAt  time.Time
+}
+
+func TestReceiptTestSuite(t *testing.T) {
+	suite.Run(t, new(ReceiptTestSuite))
+}
+
+func (suite *ReceiptTestSuite) SetupTest() {
+	suite.order, _ = NewOrder("customer-123", OrderTypeDineIn)
+	suite.order.SetTableID("T12")
+	suite.order.addItem("pizza", "Margherita Pizza", 2, 12.99, []*OrderItemModifier{
+		{GroupID: "size", GroupName: "Size", OptionID: "large", OptionName: "Large", PriceDelta: 3.00},
+	}, []string{"no basil"}, "")
+	suite.order.AddItem("salad", "Caesar Salad", 1, 9.50, nil, "")
+
+	suite.restaurant = RestaurantInfo{Name: "Trattoria Verde", Footer: "Thank you!"}
+	suite.printedAt = time.Date(2024, 3, 8, 20, 15, 0, 0, time.UTC)
+}
+
+func (suite *ReceiptTestSuite) TestNewReceipt_UnpaidOrder_IsGuestCheckWithTips() {
+	// When
+	receipt, err := NewReceipt(suite.order, nil, suite.restaurant, suite.printedAt)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	assert.Equal(ReceiptKindGuestCheck, receipt.Kind)
+	assert.True(receipt.IsGuestCheck())
+	assert.Equal("T12", receipt.TableID)
+	assert.Len(receipt.Lines, 2)
+
+	pizza := receipt.Lines[0]
+	assert.Equal(25.98, pizza.Amount)
+	assert.Len(pizza.Modifiers, 1)
+	assert.Equal("Large", pizza.Modifiers[0].Name)
+	assert.Equal(6.00, pizza.Modifiers[0].Amount)
+	assert.Equal([]string{"no basil"}, pizza.Modifications)
+
+	assert.Equal(41.48, receipt.Subtotal)
+	assert.Len(receipt.Taxes, 1)
+	assert.Equal(TaxRate, receipt.Taxes[0].Rate)
+	assert.Equal(4.15, receipt.Taxes[0].Amount)
+	assert.Equal(45.63, receipt.Total)
+	assert.Equal(45.63, receipt.BalanceDue)
+
+	assert.Len(receipt.SuggestedTips, 3)
+	assert.Equal(15, receipt.SuggestedTips[0].Percent)
+	assert.Equal(6.22, receipt.SuggestedTips[0].Amount)
+	assert.Equal(51.85, receipt.SuggestedTips[0].Total)
+}
+
+func (suite *ReceiptTestSuite) TestNewReceipt_PaidOrder_IsReceiptWithTenders() {
+	// Given
+	payment, _ := NewPayment(suite.order.ID, suite.order.TotalAmount)
+	payment.AddTender(TenderTypeCash, 20.00, 20.00, "", "")
+	payment.AddTender(TenderTypeCash, payment.Remaining(), 30.00, "", "")
+
+	// When
+	receipt, err := NewReceipt(suite.order, payment, suite.restaurant, suite.printedAt)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	assert.Equal(ReceiptKindReceipt, receipt.Kind)
+	assert.Len(receipt.Tenders, 2)
+	assert.Equal(payment.AmountPaid, receipt.AmountPaid)
+	assert.Equal(payment.ChangeGiven, receipt.ChangeGiven)
+	assert.Zero(receipt.BalanceDue)
+	assert.Empty(receipt.SuggestedTips)
+}
+
+func (suite *ReceiptTestSuite) TestNewReceipt_PartiallyPaid_ShowsBalanceDue() {
+	// Given
+	payment, _ := NewPayment(suite.order.ID, suite.order.TotalAmount)
+	payment.AddTender(TenderTypeCash, 20.00, 20.00, "", "")
+
+	// When
+	receipt, err := NewReceipt(suite.order, payment, suite.restaurant, suite.printedAt)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	assert.Equal(ReceiptKindGuestCheck, receipt.Kind)
+	assert.Equal(25.63, receipt.BalanceDue)
+}
+
+func (suite *ReceiptTestSuite) TestNewReceipt_SkipsVoidedItems() {
+	// Given
+	now := time.Now()
+	suite.order.Items[1].VoidedAt = &now
+	suite.order.recalculateTotal()
+
+	// When
+	receipt, err := NewReceipt(suite.order, nil, suite.restaurant, suite.printedAt)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	assert.Len(receipt.Lines, 1)
+	assert.Equal(31.98, receipt.Subtotal)
+}
+
+func (suite *ReceiptTestSuite) TestNewReceipt_PrintsDiscounts() {
+	// Given
+	suite.order.ApplyDiscount(NewLoyaltyDiscount(500, 5.00, "cashier-1"))
+
+	// When
+	receipt, err := NewReceipt(suite.order, nil, suite.restaurant, suite.printedAt)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	assert.Equal(41.48, receipt.Subtotal)
+	assert.Equal([]*ReceiptDiscount{{Description: "Loyalty reward", Amount: 5.00}}, receipt.Discounts)
+	assert.Equal(3.65, receipt.Taxes[0].Amount)
+	assert.Equal(40.13, receipt.Total)
+}
+
+func (suite *ReceiptTestSuite) TestNewReceipt_EmptyOrder_ShouldFail() {
+	// Given
+	order, _ := NewOrder("customer-123", OrderTypeTakeout)
+
+	// When
+	receipt, err := NewReceipt(order, nil, suite.restaurant, suite.printedAt)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.Nil(receipt)
+	assert.True(errors.IsConflictError(err))
+}
+
+func (suite *ReceiptTestSuite) TestValidateReceiptFormat() {
+	assert := assert.New(suite.T())
+	assert.NoError(ValidateReceiptFormat(ReceiptFormatText, ReceiptWidthNarrow))
+	assert.NoError(ValidateReceiptFormat(ReceiptFormatESCPOS, ReceiptWidthWide))
+	assert.NoError(ValidateReceiptFormat(ReceiptFormatHTML, 0))
+	assert.True(errors.IsValidationError(ValidateReceiptFormat(ReceiptFormatText, 80)))
+	assert.True(errors.IsValidationError(ValidateReceiptFormat("pdf", ReceiptWidthWide)))
+}
@@ -0,0 +1,335 @@
+package domain
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/restaurant-platform/shared/pkg/errors"
+)
+
+// BusinessDateLayout is the format of business dates in reports and URLs
+const BusinessDateLayout = "2006-01-02"
+
+// UncategorizedLabel groups order lines added before categories were recorded
+const UncategorizedLabel = "Uncategorized"
+
+// BusinessDay is a trading day running from the business-day cutoff on its
+// date to the cutoff on the next date, so that late-night sales count
+// towards the day they started on
+type BusinessDay struct {
+	Date  string    `json:"date"`
+	Start time.Time `json:"start"`
+	End   time.Time `json:"end"`
+}
+
+// NewBusinessDay returns the business day for a date in loc
+func NewBusinessDay(date time.Time, cutoff time.Duration, loc *time.Location) BusinessDay {
+	midnight := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, loc)
+	return BusinessDay{
+		Date:  midnight.Format(BusinessDateLayout),
+		Start: midnight.Add(cutoff),
+		End:   midnight.AddDate(0, 0, 1).Add(cutoff),
+	}
+}
+
+// BusinessDayAt returns the business day that t falls in
+func BusinessDayAt(t time.Time, cutoff time.Duration) BusinessDay {
+	day := NewBusinessDay(t, cutoff, t.Location())
+	if t.Before(day.Start) {
+		return NewBusinessDay(t.AddDate(0, 0, -1), cutoff, t.Location())
+	}
+	return day
+}
+
+// ParseBusinessDate parses a YYYY-MM-DD business date
+func ParseBusinessDate(date string) (time.Time, error) {
+	parsed, err := time.Parse(BusinessDateLayout, date)
+	if err != nil {
+		return time.Time{}, errors.WrapValidation("ParseBusinessDate", "date", "business date must be formatted as YYYY-MM-DD", err)
+	}
+	return parsed, nil
+}
+
+// ParseBusinessDayCutoff parses an HH:MM cutoff into the offset from midnight
+func ParseBusinessDayCutoff(cutoff string) (time.Duration, error) {
+	parsed, err := time.Parse("15:04", cutoff)
+	if err != nil {
+		return 0, fmt.Errorf("invalid business day cutoff %q: use HH:MM", cutoff)
+	}
+	return time.Duration(parsed.Hour())*time.Hour + time.Duration(parsed.Minute())*time.Minute, nil
+}
+
+// SalesReport summarizes the sales of one business day. Orders are attributed
+// to the business day they were opened on, and refunds to the day of the order
+// they were made against.
+type SalesReport struct {
+	BusinessDate string    `json:"business_date"`
+	From         time.Time `json:"from"`
+	To           time.Time `json:"to"`
+	GeneratedAt  time.Time `json:"generated_at"`
+
+	Orders int `json:"orders"`
+	// GrossSales is the value of the items sold, before discounts
+	GrossSales float64 `json:"gross_sales"`
+	// Discounts are the order discount lines, such as loyalty rewards
+	Discounts float64 `json:"discounts"`
+	// NetSales is gross sales less discounts
+	NetSales     float64 `json:"net_sales"`
+	TaxCollected float64 `json:"tax_collected"`
+	DeliveryFees float64 `json:"delivery_fees"`
+	Refunds      float64 `json:"refunds"`
+	AverageCheck float64 `json:"average_check"`
+	// Covers are the guests seated at settled dine-in checks; AveragePerGuest
+	// is the net sales of those checks per guest. Checks without a guest
+	// count are left out of both.
+	Covers          int     `json:"covers"`
+	AveragePerGuest float64 `json:"average_per_guest"`
+
+	Voids      VoidSummary `json:"voids"`
+	OpenChecks int         `json:"open_checks"`
+	OpenAmount float64     `json:"open_amount"`
+
+	ByOrderType []*SalesBreakdown `json:"by_order_type"`
+	ByHour      []*SalesBreakdown `json:"by_hour"`
+	ByCategory  []*SalesBreakdown `json:"by_category"`
+	ByMenuItem  []*SalesBreakdown `json:"by_menu_item"`
+	ByStaff     []*SalesBreakdown `json:"by_staff"`
+	Tenders     []*TenderTotal    `json:"tenders"`
+}
+
+// VoidSummary counts the items voided on settled orders and the orders cancelled outright
+type VoidSummary struct {
+	Items           int     `json:"items"`
+	ItemAmount      float64 `json:"item_amount"`
+	CancelledOrders int     `json:"cancelled_orders"`
+	CancelledAmount float64 `json:"cancelled_amount"`
+}
+
+// SalesBreakdown is the net sales of one group in a report breakdown
+type SalesBreakdown struct {
+	Key      string  `json:"key"`
+	Orders   int     `json:"orders,omitempty"`
+	Quantity int     `json:"quantity,omitempty"`
+	Amount   float64 `json:"amount"`
+}
+
+// TenderTotal sums the tenders of one type taken during a business day
+type TenderTotal struct {
+	Type     TenderType `json:"type"`
+	Count    int        `json:"count"`
+	Amount   float64    `json:"amount"`
+	Refunded float64    `json:"refunded"`
+	Net      float64    `json:"net"`
+}
+
+// IsSettled reports whether the order has been paid for
+func (o *Order) IsSettled() bool {
+	switch o.Status {
+	case OrderStatusPaid, OrderStatusPreparing, OrderStatusReady, OrderStatusOutForDelivery, OrderStatusCompleted:
+		return true
+	}
+	return false
+}
+
+// SettledBy returns the staff member who settled the order
+func (o *Order) SettledBy() string {
+	for _, transition := range o.StatusHistory {
+		if transition.To == OrderStatusPaid {
+			return transition.Actor
+		}
+	}
+	return SystemActor
+}
+
+// BuildSalesReport summarizes the orders opened during a business day and their payments
+func BuildSalesReport(day BusinessDay, orders []*Order, payments []*Payment, now time.Time) *SalesReport {
+	report := &SalesReport{
+		BusinessDate: day.Date,
+		From:         day.Start,
+		To:           day.End,
+		GeneratedAt:  now,
+	}
+
+	byType := newBreakdown()
+	byHour := newBreakdown()
+	byCategory := newBreakdown()
+	byMenuItem := newBreakdown()
+	byStaff := newBreakdown()
+	var coveredSales float64
+
+	for _, order := range orders {
+		switch {
+		case order.IsMerged():
+			// Its items are reported on the order it was merged into
+			continue
+		case order.Status == OrderStatusCancelled:
+			report.Voids.CancelledOrders++
+			report.Voids.CancelledAmount += order.Subtotal()
+			continue
+		case !order.IsSettled():
+			report.OpenChecks++
+			report.OpenAmount += order.TotalAmount
+			continue
+		}
+
+		sales := order.Subtotal()
+		report.Orders++
+		report.GrossSales += sales
+		report.Discounts += order.DiscountTotal()
+		report.TaxCollected += order.TaxAmount
+		report.DeliveryFees += order.DeliveryFee
+		if order.Type == OrderTypeDineIn && order.GuestCount > 0 {
+			report.Covers += order.GuestCount
+			coveredSales += sales - order.DiscountTotal()
+		}
+
+		byType.add(string(order.Type), 1, 0, sales)
+		byHour.add(order.CreatedAt.In(day.Start.Location()).Format("15:00"), 1, 0, sales)
+		byStaff.add(order.SettledBy(), 1, 0, sales)
+
+		for _, item := range order.Items {
+			if item.IsVoided() {
+				report.Voids.Items += item.Quantity
+				report.Voids.ItemAmount += item.Subtotal
+				continue
+			}
+			category := item.Category
+			if category == "" {
+				category = UncategorizedLabel
+			}
+			byCategory.add(category, 0, item.Quantity, item.Subtotal)
+			byMenuItem.add(item.Name, 0, item.Quantity, item.Subtotal)
+		}
+	}
+
+	tenders := make(map[TenderType]*TenderTotal)
+	for _, payment := range payments {
+		if payment.Status == PaymentStatusVoided {
+			continue
+		}
+		report.Refunds += payment.AmountRefunded
+		for _, tender := range payment.Tenders {
+			if tender.Status == TenderStatusVoided {
+				continue
+			}
+			total, ok := tenders[tender.Type]
+			if !ok {
+				total = &TenderTotal{Type: tender.Type}
+				tenders[tender.Type] = total
+			}
+			total.Count++
+			total.Amount += tender.Amount
+			total.Refunded += tender.AmountRefunded
+		}
+	}
+	for _, total := range tenders {
+		total.Amount = roundCents(total.Amount)
+		total.Refunded = roundCents(total.Refunded)
+		total.Net = roundCents(total.Amount - total.Refunded)
+		report.Tenders = append(report.Tenders, total)
+	}
+	sort.Slice(report.Tenders, func(i, j int) bool {
+		return report.Tenders[i].Type < report.Tenders[j].Type
+	})
+
+	report.NetSales = roundCents(report.GrossSales - report.Discounts)
+	report.GrossSales = roundCents(report.GrossSales)
+	report.Discounts = roundCents(report.Discounts)
+	report.TaxCollected = roundCents(report.TaxCollected)
+	report.DeliveryFees = roundCents(report.DeliveryFees)
+	report.Refunds = roundCents(report.Refunds)
+	report.OpenAmount = roundCents(report.OpenAmount)
+	report.Voids.ItemAmount = roundCents(report.Voids.ItemAmount)
+	report.Voids.CancelledAmount = roundCents(report.Voids.CancelledAmount)
+	if report.Orders > 0 {
+		report.AverageCheck = roundCents(report.NetSales / float64(report.Orders))
+	}
+	if report.Covers > 0 {
+		report.AveragePerGuest = roundCents(coveredSales / float64(report.Covers))
+	}
+
+	report.ByOrderType = byType.byKey()
+	report.ByHour = byHour.byKey()
+	report.ByCategory = byCategory.byAmount()
+	report.ByMenuItem = byMenuItem.byAmount()
+	report.ByStaff = byStaff.byAmount()
+
+	return report
+}
+
+// ZReport is the immutable end-of-day snapshot taken when a business day is closed
+type ZReport struct {
+	BusinessDate string       `json:"business_date"`
+	Report       *SalesReport `json:"report"`
+	ClosedBy     string       `json:"closed_by"`
+	ClosedAt     time.Time    `json:"closed_at"`
+}
+
+// NewZReport closes a business day with its final sales report
+func NewZReport(report *SalesReport, closedBy string, now time.Time) (*ZReport, error) {
+	if now.Before(report.To) {
+		return nil, errors.WrapConflict("NewZReport", "business_date", "a business day can only be closed once it has ended", nil)
+	}
+	if report.OpenChecks > 0 {
+		return nil, errors.WrapConflict("NewZReport", "open_checks",
+			fmt.Sprintf("%d checks are still open; settle or cancel them before closing the day", report.OpenChecks), nil)
+	}
+	if closedBy == "" {
+		closedBy = SystemActor
+	}
+
+	return &ZReport{
+		BusinessDate: report.BusinessDate,
+		Report:       report,
+		ClosedBy:     closedBy,
+		ClosedAt:     now,
+	}, nil
+}
+
+// breakdown accumulates sales per group
+type breakdown map[string]*SalesBreakdown
+
+func newBreakdown() breakdown {
+	return make(breakdown)
+}
+
+func (b breakdown) add(key string, orders, quantity int, amount float64) {
+	entry, ok := b[key]
+	if !ok {
+		entry = &SalesBreakdown{Key: key}
+		b[key] = entry
+	}
+	entry.Orders += orders
+	entry.Quantity += quantity
+	entry.Amount += amount
+}
+
+func (b breakdown) entries() []*SalesBreakdown {
+	entries := make([]*SalesBreakdown, 0, len(b))
+	for _, entry := range b {
+		entry.Amount = roundCents(entry.Amount)
+		entries = append(entries, entry)
+	}
+	return entries
+}
+
+func (b breakdown) byKey() []*SalesBreakdown {
+	entries := b.entries()
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].Key < entries[j].Key
+	})
+	return entries
+}
+
+// byAmount lists the best-selling groups first
+func (b breakdown) byAmount() []*SalesBreakdown {
+	entries := b.entries()
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].Amount != entries[j].Amount {
+			return entries[i].Amount > entries[j].Amount
+		}
+		return entries[i].Key < entries[j].Key
+	})
+	return entries
+}
@@ -0,0 +1,242 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/restaurant-platform/shared/pkg/errors"
+)
+
+// ReportTestSuite contains sales report and business day tests
+type ReportTestSuite struct {
+	suite.Suite
+	day      BusinessDay
+	orders   []*Order
+	payments []*Payment
+}
+
+func TestReportTestSuite(t *testing.T) {
+	suite.Run(t, new(ReportTestSuite))
+}
+
+func (suite *ReportTestSuite) SetupTest() {
+	suite.day = NewBusinessDay(time.Date(2024, 3, 8, 0, 0, 0, 0, time.UTC), 4*time.Hour, time.UTC)
+
+	dineIn := suite.order(OrderTypeDineIn, 19)
+	dineIn.addItem("pizza", "Margherita Pizza", 2, 12.99, nil, nil, "")
+	dineIn.addItem("salad", "Caesar Salad", 1, 9.50, nil, nil, "")
+	dineIn.Items[0].Category = "Mains"
+	dineIn.Items[1].Category = "Starters"
+	dineIn.recalculateTotal()
+	dineIn.UpdateStatus(OrderStatusPaid, "alice", "")
+
+	takeout := suite.order(OrderTypeTakeout, 25) // 01:00 the next morning, still the same business day
+	takeout.addItem("pizza", "Margherita Pizza", 1, 12.99, nil, nil, "")
+	takeout.addItem("wine", "House Red", 1, 8.00, nil, nil, "")
+	takeout.Items[0].Category = "Mains"
+	takeout.recalculateTotal()
+	takeout.UpdateStatus(OrderStatusPaid, "bob", "")
+	takeout.UpdateStatus(OrderStatusPreparing, SystemActor, "")
+	voidedAt := takeout.CreatedAt.Add(time.Minute)
+	takeout.Items[1].VoidedAt = &voidedAt
+	takeout.recalculateTotal()
+
+	cancelled := suite.order(OrderTypeTakeout, 20)
+	cancelled.addItem("salad", "Caesar Salad", 2, 9.50, nil, nil, "")
+	cancelled.Cancel("alice", "customer left")
+
+	open := suite.order(OrderTypeDineIn, 21)
+	open.addItem("salad", "Caesar Salad", 1, 9.50, nil, nil, "")
+	open.recalculateTotal()
+
+	suite.orders = []*Order{dineIn, takeout, cancelled, open}
+
+	cash, _ := NewPayment(dineIn.ID, dineIn.TotalAmount)
+	cash.AddTender(TenderTypeCash, 0, 50.00, "", "")
+	card, _ := NewPayment(takeout.ID, takeout.TotalAmount)
+	tender, _ := card.AddTender(TenderTypeCard, takeout.TotalAmount, 0, "", "ch_1")
+	card.Refund(tender.ID, 2.00, "cold pizza", "re_1")
+	suite.payments = []*Payment{cash, card}
+}
+
+func (suite *ReportTestSuite) order(orderType OrderType, hour int) *Order {
+	order, _ := NewOrder("customer-123", orderType)
+	order.CreatedAt = time.Date(2024, 3, 8, hour, 30, 0, 0, time.UTC)
+	return order
+}
+
+func (suite *ReportTestSuite) TestBuildSalesReport_Totals() {
+	// When
+	report := BuildSalesReport(suite.day, suite.orders, suite.payments, suite.day.End)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.Equal("2024-03-08", report.BusinessDate)
+	assert.Equal(2, report.Orders)
+	assert.Equal(48.47, report.GrossSales)
+	assert.Zero(report.Discounts)
+	assert.Equal(48.47, report.NetSales)
+	assert.Equal(4.85, report.TaxCollected)
+	assert.Equal(2.00, report.Refunds)
+	assert.Equal(24.24, report.AverageCheck)
+
+	assert.Equal(1, report.Voids.Items)
+	assert.Equal(8.00, report.Voids.ItemAmount)
+	assert.Equal(1, report.Voids.CancelledOrders)
+	assert.Equal(19.00, report.Voids.CancelledAmount)
+	assert.Equal(1, report.OpenChecks)
+	assert.Equal(10.45, report.OpenAmount)
+}
+
+func (suite *ReportTestSuite) TestBuildSalesReport_Discounts() {
+	// Given
+	discounted := suite.order(OrderTypeTakeout, 22)
+	discounted.addItem("pizza", "Margherita Pizza", 1, 12.99, nil, nil, "")
+	discounted.ApplyDiscount(NewLoyaltyDiscount(300, 3.00, "alice"))
+	discounted.UpdateStatus(OrderStatusPaid, "alice", "")
+	orders := append(suite.orders, discounted)
+
+	// When
+	report := BuildSalesReport(suite.day, orders, suite.payments, suite.day.End)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.Equal(61.46, report.GrossSales)
+	assert.Equal(3.00, report.Discounts)
+	assert.Equal(58.46, report.NetSales)
+}
+
+func (suite *ReportTestSuite) TestBuildSalesReport_Covers() {
+	// Given
+	suite.orders[0].GuestCount = 2
+	suite.orders[3].GuestCount = 1 // still open, so not a cover yet
+
+	// When
+	report := BuildSalesReport(suite.day, suite.orders, suite.payments, suite.day.End)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.Equal(2, report.Covers)
+	assert.Equal(17.74, report.AveragePerGuest)
+}
+
+func (suite *ReportTestSuite) TestBuildSalesReport_Breakdowns() {
+	// When
+	report := BuildSalesReport(suite.day, suite.orders, suite.payments, suite.day.End)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.Equal([]*SalesBreakdown{
+		{Key: "DINE_IN", Orders: 1, Amount: 35.48},
+		{Key: "TAKEOUT", Orders: 1, Amount: 12.99},
+	}, report.ByOrderType)
+	assert.Equal([]*SalesBreakdown{
+		{Key: "01:00", Orders: 1, Amount: 12.99},
+		{Key: "19:00", Orders: 1, Amount: 35.48},
+	}, report.ByHour)
+	assert.Equal([]*SalesBreakdown{
+		{Key: "Mains", Quantity: 3, Amount: 38.97},
+		{Key: "Starters", Quantity: 1, Amount: 9.50},
+	}, report.ByCategory)
+	assert.Equal([]*SalesBreakdown{
+		{Key: "Margherita Pizza", Quantity: 3, Amount: 38.97},
+		{Key: "Caesar Salad", Quantity: 1, Amount: 9.50},
+	}, report.ByMenuItem)
+	assert.Equal([]*SalesBreakdown{
+		{Key: "alice", Orders: 1, Amount: 35.48},
+		{Key: "bob", Orders: 1, Amount: 12.99},
+	}, report.ByStaff)
+}
+
+func (suite *ReportTestSuite) TestBuildSalesReport_Tenders() {
+	// When
+	report := BuildSalesReport(suite.day, suite.orders, suite.payments, suite.day.End)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.Len(report.Tenders, 2)
+	assert.Equal(&TenderTotal{Type: TenderTypeCard, Count: 1, Amount: 14.29, Refunded: 2.00, Net: 12.29}, report.Tenders[0])
+	assert.Equal(&TenderTotal{Type: TenderTypeCash, Count: 1, Amount: 39.03, Refunded: 0, Net: 39.03}, report.Tenders[1])
+}
+
+func (suite *ReportTestSuite) TestBuildSalesReport_UncategorizedItems() {
+	// Given
+	suite.orders[0].Items[1].Category = ""
+
+	// When
+	report := BuildSalesReport(suite.day, suite.orders, nil, suite.day.End)
+
+	// Then
+	assert.Contains(suite.T(), report.ByCategory, &SalesBreakdown{Key: UncategorizedLabel, Quantity: 1, Amount: 9.50})
+}
+
+func (suite *ReportTestSuite) TestBusinessDayAt_BeforeCutoff_BelongsToPreviousDay() {
+	// When
+	day := BusinessDayAt(time.Date(2024, 3, 9, 3, 59, 0, 0, time.UTC), 4*time.Hour)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.Equal("2024-03-08", day.Date)
+	assert.Equal(time.Date(2024, 3, 8, 4, 0, 0, 0, time.UTC), day.Start)
+	assert.Equal(time.Date(2024, 3, 9, 4, 0, 0, 0, time.UTC), day.End)
+	assert.Equal("2024-03-09", BusinessDayAt(time.Date(2024, 3, 9, 4, 0, 0, 0, time.UTC), 4*time.Hour).Date)
+}
+
+func (suite *ReportTestSuite) TestParseBusinessDayCutoff() {
+	assert := assert.New(suite.T())
+	cutoff, err := ParseBusinessDayCutoff("04:30")
+	assert.NoError(err)
+	assert.Equal(4*time.Hour+30*time.Minute, cutoff)
+
+	_, err = ParseBusinessDayCutoff("4am")
+	assert.Error(err)
+}
+
+func (suite *ReportTestSuite) TestParseBusinessDate_Invalid_ShouldFail() {
+	_, err := ParseBusinessDate("08/03/2024")
+	assert.True(suite.T(), errors.IsValidationError(err))
+}
+
+func (suite *ReportTestSuite) TestNewZReport_Success() {
+	// Given
+	report := BuildSalesReport(suite.day, suite.orders[:3], suite.payments, suite.day.End)
+
+	// When
+	zReport, err := NewZReport(report, "manager-1", suite.day.End.Add(time.Hour))
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	assert.Equal("2024-03-08", zReport.BusinessDate)
+	assert.Equal("manager-1", zReport.ClosedBy)
+	assert.Same(report, zReport.Report)
+}
+
+func (suite *ReportTestSuite) TestNewZReport_DayNotEnded_ShouldFail() {
+	// Given
+	report := BuildSalesReport(suite.day, suite.orders[:3], suite.payments, suite.day.End)
+
+	// When
+	zReport, err := NewZReport(report, "manager-1", suite.day.End.Add(-time.Minute))
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.Nil(zReport)
+	assert.True(errors.IsConflictError(err))
+}
+
+func (suite *ReportTestSuite) TestNewZReport_OpenChecks_ShouldFail() {
+	// Given
+	report := BuildSalesReport(suite.day, suite.orders, suite.payments, suite.day.End)
+
+	// When
+	zReport, err := NewZReport(report, "manager-1", suite.day.End)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.Nil(zReport)
+	assert.True(errors.IsConflictError(err))
+}
@@ -16,6 +16,9 @@ type OrderRepository interface {
 	// Update updates an existing order
 	Update(ctx context.Context, order *Order) error
 
+	// UpdateAll updates several orders atomically; a version conflict on any of them saves none
+	UpdateAll(ctx context.Context, orders ...*Order) error
+
 	// Delete removes an order from the repository
 	Delete(ctx context.Context, id OrderID) error
 
@@ -42,6 +45,9 @@ type OrderRepository interface {
 
 	// GetActiveOrders retrieves all orders that are not completed or cancelled
 	GetActiveOrders(ctx context.Context) ([]*Order, error)
+
+	// FindScheduled retrieves scheduled orders awaiting release with a fulfillment time in the range, soonest first
+	FindScheduled(ctx context.Context, from, to time.Time) ([]*Order, error)
 }
 
 // OrderService defines the interface for order business logic
@@ -49,11 +55,30 @@ type OrderService interface {
 	// CreateOrder creates a new order
 	CreateOrder(ctx context.Context, customerID string, orderType OrderType) (*Order, error)
 
+	// CreateScheduledOrder creates an order for a future fulfillment time, held back from the kitchen until released
+	CreateScheduledOrder(ctx context.Context, customerID string, orderType OrderType, fulfillmentTime time.Time) (*Order, error)
+
+	// RescheduleOrder moves the fulfillment time of a scheduled order that has not been released
+	RescheduleOrder(ctx context.Context, orderID OrderID, fulfillmentTime time.Time) (*Order, error)
+
+	// GetScheduledOrders retrieves scheduled orders awaiting release with a fulfillment time in the range
+	GetScheduledOrders(ctx context.Context, from, to time.Time) ([]*ScheduledOrder, error)
+
+	// ReleaseDueOrders releases scheduled orders whose release time has passed to the kitchen
+	ReleaseDueOrders(ctx context.Context, now time.Time) (int, error)
+
 	// GetOrderByID retrieves an order by ID
 	GetOrderByID(ctx context.Context, id OrderID) (*Order, error)
 
-	// AddItemToOrder adds an item to an existing order
-	AddItemToOrder(ctx context.Context, orderID OrderID, menuItemID, name string, quantity int, unitPrice float64, modifications []string, notes string) error
+	// AddItemToOrder adds a menu item to a course and seat of an existing order at its current menu price,
+	// pricing in the selected modifier options
+	AddItemToOrder(ctx context.Context, orderID OrderID, menuItemID string, quantity, course, seat int, modifiers []ModifierSelection, modifications []string, notes string) error
+
+	// FireCourse releases a held course of a dine-in order to the kitchen
+	FireCourse(ctx context.Context, orderID OrderID, course int) (*Order, error)
+
+	// VoidItem voids an item on an order already sent to the kitchen
+	VoidItem(ctx context.Context, orderID OrderID, itemID OrderItemID, reason string) error
 
 	// RemoveItemFromOrder removes an item from an order
 	RemoveItemFromOrder(ctx context.Context, orderID OrderID, itemID OrderItemID) error
@@ -61,8 +86,8 @@ type OrderService interface {
 	// UpdateItemQuantity updates the quantity of an item in an order
 	UpdateItemQuantity(ctx context.Context, orderID OrderID, itemID OrderItemID, quantity int) error
 
-	// UpdateOrderStatus changes the status of an order
-	UpdateOrderStatus(ctx context.Context, orderID OrderID, status OrderStatus) error
+	// UpdateOrderStatus changes the status of an order, recording the actor on the context and the reason
+	UpdateOrderStatus(ctx context.Context, orderID OrderID, status OrderStatus, reason string) error
 
 	// SetTableForOrder sets the table ID for a dine-in order
 	SetTableForOrder(ctx context.Context, orderID OrderID, tableID string) error
@@ -73,11 +98,8 @@ type OrderService interface {
 	// AddOrderNotes adds notes to an order
 	AddOrderNotes(ctx context.Context, orderID OrderID, notes string) error
 
-	// CancelOrder cancels an order
-	CancelOrder(ctx context.Context, orderID OrderID) error
-
-	// PayOrder marks an order as paid
-	PayOrder(ctx context.Context, orderID OrderID) error
+	// CancelOrder cancels an order, recording the actor on the context and the reason
+	CancelOrder(ctx context.Context, orderID OrderID, reason string) error
 
 	// GetOrdersByCustomer retrieves orders for a specific customer
 	GetOrdersByCustomer(ctx context.Context, customerID string) ([]*Order, error)
@@ -93,4 +115,432 @@ type OrderService interface {
 
 	// ListOrders retrieves orders with pagination and filters
 	ListOrders(ctx context.Context, offset, limit int, filters OrderFilters) ([]*Order, int, error)
-}
\ No newline at end of file
+
+	// GetStatusMetrics summarizes how long orders created in the range spent in each status
+	GetStatusMetrics(ctx context.Context, from, to time.Time) ([]*StatusMetric, error)
+}
+
+// MenuItemRepository defines the interface for the local menu read model
+type MenuItemRepository interface {
+	// GetByID retrieves a menu item by its menu-service ID
+	GetByID(ctx context.Context, id string) (*MenuItem, error)
+
+	// Upsert creates or replaces a menu item
+	Upsert(ctx context.Context, item *MenuItem) error
+
+	// SetAvailability marks a menu item as available or unavailable
+	SetAvailability(ctx context.Context, id string, isAvailable bool) error
+
+	// Delete removes a menu item
+	Delete(ctx context.Context, id string) error
+
+	// ReplaceMenu replaces every item belonging to a menu with the given snapshot
+	ReplaceMenu(ctx context.Context, menuID string, items []*MenuItem) error
+
+	// DeleteByMenu removes every item belonging to a menu
+	DeleteByMenu(ctx context.Context, menuID string) error
+}
+
+// ReservationRepository defines the interface for the local reservation read model
+type ReservationRepository interface {
+	// GetByID retrieves a reservation by its reservation-service ID
+	GetByID(ctx context.Context, id string) (*Reservation, error)
+
+	// FindSeatable retrieves the pending or confirmed reservation for a table booked
+	// closest to the given time, within the seating window
+	FindSeatable(ctx context.Context, tableID string, at time.Time) (*Reservation, error)
+
+	// Upsert creates or replaces a reservation, ignoring changes older than the stored one
+	Upsert(ctx context.Context, reservation *Reservation) error
+}
+
+// PaymentRepository defines the interface for payment data access
+type PaymentRepository interface {
+	// Create adds a new payment to the repository
+	Create(ctx context.Context, payment *Payment) error
+
+	// GetByID retrieves a payment by its ID
+	GetByID(ctx context.Context, id PaymentID) (*Payment, error)
+
+	// GetByOrderID retrieves the payment recorded for an order
+	GetByOrderID(ctx context.Context, orderID OrderID) (*Payment, error)
+
+	// FindByOrderIDs retrieves the payments that have not been voided for a set of orders
+	FindByOrderIDs(ctx context.Context, orderIDs []OrderID) ([]*Payment, error)
+
+	// FindByDrawerSession retrieves the payments, voided ones included, with a cash tender
+	// taken into or a cash refund paid out of a drawer session
+	FindByDrawerSession(ctx context.Context, sessionID DrawerSessionID) ([]*Payment, error)
+
+	// Update updates an existing payment
+	Update(ctx context.Context, payment *Payment) error
+}
+
+// PaymentService defines the interface for payment business logic
+type PaymentService interface {
+	// AddTender applies a tender to an order, settling it once fully paid
+	AddTender(ctx context.Context, orderID OrderID, tenderType TenderType, amount, amountTendered float64, reference string) (*Payment, error)
+
+	// GetPaymentForOrder retrieves the payment recorded for an order
+	GetPaymentForOrder(ctx context.Context, orderID OrderID) (*Payment, error)
+
+	// RefundTender returns part or all of a tender
+	RefundTender(ctx context.Context, paymentID PaymentID, tenderID TenderID, amount float64, reason string) (*Payment, error)
+
+	// VoidPayment cancels all tenders of an unsettled payment
+	VoidPayment(ctx context.Context, paymentID PaymentID, reason string) (*Payment, error)
+}
+
+// DeliveryZoneRepository defines the interface for delivery zone data access
+type DeliveryZoneRepository interface {
+	// Create adds a new delivery zone to the repository
+	Create(ctx context.Context, zone *DeliveryZone) error
+
+	// GetByID retrieves a delivery zone by its ID
+	GetByID(ctx context.Context, id DeliveryZoneID) (*DeliveryZone, error)
+
+	// Update updates an existing delivery zone
+	Update(ctx context.Context, zone *DeliveryZone) error
+
+	// List retrieves all delivery zones
+	List(ctx context.Context) ([]*DeliveryZone, error)
+}
+
+// DriverRepository defines the interface for driver data access
+type DriverRepository interface {
+	// Create adds a new driver to the repository
+	Create(ctx context.Context, driver *Driver) error
+
+	// GetByID retrieves a driver by its ID
+	GetByID(ctx context.Context, id DriverID) (*Driver, error)
+
+	// Update updates an existing driver
+	Update(ctx context.Context, driver *Driver) error
+
+	// List retrieves all drivers
+	List(ctx context.Context) ([]*Driver, error)
+
+	// FindAvailable retrieves available drivers, longest idle first
+	FindAvailable(ctx context.Context) ([]*Driver, error)
+}
+
+// DeliveryRepository defines the interface for delivery data access
+type DeliveryRepository interface {
+	// Create adds a new delivery to the repository
+	Create(ctx context.Context, delivery *Delivery) error
+
+	// GetByID retrieves a delivery by its ID
+	GetByID(ctx context.Context, id DeliveryID) (*Delivery, error)
+
+	// GetByOrderID retrieves the delivery booked for an order
+	GetByOrderID(ctx context.Context, orderID OrderID) (*Delivery, error)
+
+	// Update updates an existing delivery
+	Update(ctx context.Context, delivery *Delivery) error
+
+	// FindByDriver retrieves the deliveries assigned to a driver, newest first
+	FindByDriver(ctx context.Context, driverID DriverID) ([]*Delivery, error)
+}
+
+// DeliveryService defines the interface for delivery dispatch business logic
+type DeliveryService interface {
+	// CreateZone defines a new delivery zone
+	CreateZone(ctx context.Context, name string, boundary []Coordinates, fee, minimumOrder float64) (*DeliveryZone, error)
+
+	// SetZoneActive enables or disables a delivery zone
+	SetZoneActive(ctx context.Context, zoneID DeliveryZoneID, isActive bool) (*DeliveryZone, error)
+
+	// ListZones retrieves all delivery zones
+	ListZones(ctx context.Context) ([]*DeliveryZone, error)
+
+	// QuoteDelivery geocodes an address and returns the zone that serves it
+	QuoteDelivery(ctx context.Context, address string) (*DeliveryZone, error)
+
+	// CreateDriver registers a new driver
+	CreateDriver(ctx context.Context, name, phone string) (*Driver, error)
+
+	// SetDriverStatus moves a driver between available and off duty
+	SetDriverStatus(ctx context.Context, driverID DriverID, status DriverStatus) (*Driver, error)
+
+	// ListDrivers retrieves all drivers
+	ListDrivers(ctx context.Context) ([]*Driver, error)
+
+	// CreateDelivery books a delivery for an unpaid delivery order and charges the zone fee
+	CreateDelivery(ctx context.Context, orderID OrderID) (*Delivery, error)
+
+	// GetDeliveryForOrder retrieves the delivery booked for an order
+	GetDeliveryForOrder(ctx context.Context, orderID OrderID) (*Delivery, error)
+
+	// AssignDriver hands a delivery to a driver, or to the longest idle available driver when none is given
+	AssignDriver(ctx context.Context, deliveryID DeliveryID, driverID DriverID) (*Delivery, error)
+
+	// PickUpDelivery records the driver leaving with a ready order
+	PickUpDelivery(ctx context.Context, deliveryID DeliveryID) (*Delivery, error)
+
+	// CompleteDelivery records the hand-over to the customer and completes the order
+	CompleteDelivery(ctx context.Context, deliveryID DeliveryID) (*Delivery, error)
+}
+
+// ReceiptService defines the interface for rendering guest checks and receipts
+type ReceiptService interface {
+	// GetReceipt renders the guest check or receipt of an order
+	GetReceipt(ctx context.Context, orderID OrderID, format ReceiptFormat, width int) (*RenderedReceipt, error)
+
+	// ReprintReceipt renders the guest check or receipt of an order marked as a reprint
+	ReprintReceipt(ctx context.Context, orderID OrderID, format ReceiptFormat, width int) (*RenderedReceipt, error)
+}
+
+// ZReportRepository defines the interface for end-of-day Z-report data access.
+// Z-reports are immutable once created.
+type ZReportRepository interface {
+	// Create stores the Z-report of a business day, failing with a conflict if the day is already closed
+	Create(ctx context.Context, report *ZReport) error
+
+	// GetByDate retrieves the Z-report of a business day
+	GetByDate(ctx context.Context, businessDate string) (*ZReport, error)
+
+	// List retrieves the most recent Z-reports, newest first
+	List(ctx context.Context, limit int) ([]*ZReport, error)
+}
+
+// ReportService defines the interface for sales reporting and the end-of-day close
+type ReportService interface {
+	// GetSalesReport builds the live sales report of a business day
+	GetSalesReport(ctx context.Context, businessDate string) (*SalesReport, error)
+
+	// CloseBusinessDay takes the Z-report of a business day that has ended
+	CloseBusinessDay(ctx context.Context, businessDate string) (*ZReport, error)
+
+	// GetZReport retrieves the Z-report of a closed business day
+	GetZReport(ctx context.Context, businessDate string) (*ZReport, error)
+
+	// ListZReports retrieves the most recent Z-reports, newest first
+	ListZReports(ctx context.Context, limit int) ([]*ZReport, error)
+}
+
+// AdjustmentRepository defines the interface for void and refund data access
+type AdjustmentRepository interface {
+	// Create records a void or refund
+	Create(ctx context.Context, adjustment *Adjustment) error
+
+	// FindByOrder retrieves the voids and refunds of an order, oldest first
+	FindByOrder(ctx context.Context, orderID OrderID) ([]*Adjustment, error)
+}
+
+// ManagerOverride is a manager's PIN entered to approve another user's void or refund
+type ManagerOverride struct {
+	ManagerID string
+	PIN       string
+}
+
+// AdjustmentService defines the interface for voids and refunds with manager approval
+type AdjustmentService interface {
+	// VoidOrder cancels an unpaid order with a reason code
+	VoidOrder(ctx context.Context, orderID OrderID, reason AdjustmentReason, notes string, override *ManagerOverride) (*Adjustment, error)
+
+	// RefundOrder refunds a paid order in full, or only the listed items, with a reason code
+	RefundOrder(ctx context.Context, orderID OrderID, itemIDs []OrderItemID, reason AdjustmentReason, notes string, override *ManagerOverride) (*Adjustment, error)
+
+	// GetAdjustments retrieves the voids and refunds of an order
+	GetAdjustments(ctx context.Context, orderID OrderID) ([]*Adjustment, error)
+}
+
+// TableService defines the interface for seating dine-in checks and moving them between tables
+type TableService interface {
+	// SeatGuests sets the guest count of a dine-in order; a count of 0 takes the party size of its reservation
+	SeatGuests(ctx context.Context, orderID OrderID, guestCount int, reservationID string) (*Order, error)
+
+	// AssignSeat moves an item of a dine-in order to a seat
+	AssignSeat(ctx context.Context, orderID OrderID, itemID OrderItemID, seat int) (*Order, error)
+
+	// GetSeats retrieves the items of a dine-in order grouped by seat
+	GetSeats(ctx context.Context, orderID OrderID) ([]*SeatItems, error)
+
+	// TransferTable moves an open dine-in order to another table
+	TransferTable(ctx context.Context, orderID OrderID, tableID string) (*Order, error)
+
+	// MergeOrders moves every item of the source check onto the target check and closes the source
+	MergeOrders(ctx context.Context, targetID, sourceID OrderID) (*Order, error)
+
+	// MoveItems moves items from one open check to another
+	MoveItems(ctx context.Context, sourceID, targetID OrderID, itemIDs []OrderItemID) (*Order, *Order, error)
+}
+
+// SLAAlertRepository defines the interface for SLA alert persistence
+type SLAAlertRepository interface {
+	// Create records a new SLA breach; an order has at most one open alert per status
+	Create(ctx context.Context, alert *SLAAlert) error
+
+	// Update saves the resolution of an alert
+	Update(ctx context.Context, alert *SLAAlert) error
+
+	// FindOpen retrieves the alerts that have not been resolved, oldest breach first
+	FindOpen(ctx context.Context) ([]*SLAAlert, error)
+}
+
+// SLAService defines the interface for monitoring active orders against their service levels
+type SLAService interface {
+	// CheckOrders raises an alert for each active order that breached its SLA and resolves
+	// alerts of orders that have moved on. It returns the number of new breaches.
+	CheckOrders(ctx context.Context, now time.Time) (int, error)
+
+	// CancelUnpaidOrders cancels orders left unpaid past the timeout and returns how many were cancelled
+	CancelUnpaidOrders(ctx context.Context, now time.Time) (int, error)
+
+	// GetOpenAlerts retrieves the SLA breaches that have not been resolved
+	GetOpenAlerts(ctx context.Context) ([]*SLAAlert, error)
+}
+
+// MarketplaceItemMappingRepository defines the interface for the mapping of marketplace items to menu items
+type MarketplaceItemMappingRepository interface {
+	// Save creates or replaces the mapping of a marketplace item
+	Save(ctx context.Context, mapping *MarketplaceItemMapping) error
+
+	// FindByMarketplace retrieves the item mappings of a marketplace
+	FindByMarketplace(ctx context.Context, marketplace string) ([]*MarketplaceItemMapping, error)
+}
+
+// IngestedOrderRepository defines the interface for orders received from marketplaces
+type IngestedOrderRepository interface {
+	// Create records a received marketplace order; each marketplace order is recorded once
+	Create(ctx context.Context, ingested *IngestedOrder) error
+
+	// Update saves the status last reported to the marketplace
+	Update(ctx context.Context, ingested *IngestedOrder) error
+
+	// FindByExternalID retrieves a received order by the marketplace's order ID
+	FindByExternalID(ctx context.Context, marketplace, externalID string) (*IngestedOrder, error)
+
+	// FindByOrderID retrieves the marketplace order an order was created from
+	FindByOrderID(ctx context.Context, orderID OrderID) (*IngestedOrder, error)
+}
+
+// MarketplaceService defines the interface for ingesting orders from delivery marketplaces
+type MarketplaceService interface {
+	// SignatureHeader returns the request header a marketplace signs its webhooks in
+	SignatureHeader(marketplace string) (string, error)
+
+	// IngestOrder verifies an order webhook and accepts or rejects the order, reporting the
+	// decision back to the marketplace. A redelivered order returns the original decision.
+	IngestOrder(ctx context.Context, marketplace string, payload []byte, signature string) (*IngestedOrder, error)
+
+	// SetItemMapping maps a marketplace item ID to a menu item
+	SetItemMapping(ctx context.Context, marketplace, externalItemID, menuItemID string) (*MarketplaceItemMapping, error)
+
+	// GetItemMappings retrieves the item mappings of a marketplace
+	GetItemMappings(ctx context.Context, marketplace string) ([]*MarketplaceItemMapping, error)
+}
+
+// LoyaltyLedgerRepository defines the interface for the loyalty points ledger
+type LoyaltyLedgerRepository interface {
+	// Append books a ledger entry; an entry whose reference was already booked is a conflict
+	Append(ctx context.Context, entry *LoyaltyEntry) error
+
+	// FindByCustomer retrieves the ledger entries of a customer in booking order
+	FindByCustomer(ctx context.Context, customerID string) ([]*LoyaltyEntry, error)
+
+	// FindCustomersWithExpiringPoints retrieves the customers with credits that expire by the given time
+	FindCustomersWithExpiringPoints(ctx context.Context, asOf time.Time) ([]string, error)
+}
+
+// LoyaltyService defines the interface for the loyalty points program
+type LoyaltyService interface {
+	// GetAccount retrieves the points balance and tier of a customer
+	GetAccount(ctx context.Context, customerID string) (*LoyaltyAccount, error)
+
+	// GetHistory retrieves the points ledger of a customer, newest first
+	GetHistory(ctx context.Context, customerID string) ([]*LoyaltyEntry, error)
+
+	// RedeemPoints spends points of the order's customer on a discount on the unpaid order
+	RedeemPoints(ctx context.Context, orderID OrderID, points int) (*Order, error)
+}
+
+// GiftCardRepository defines the interface for gift card data access
+type GiftCardRepository interface {
+	// Create adds a newly issued gift card together with its issue transaction
+	Create(ctx context.Context, card *GiftCard, issue *GiftCardTransaction) error
+
+	// GetByID retrieves a gift card by its ID
+	GetByID(ctx context.Context, id GiftCardID) (*GiftCard, error)
+
+	// GetByCodeHash retrieves a gift card by the hash of its code
+	GetByCodeHash(ctx context.Context, codeHash string) (*GiftCard, error)
+
+	// Save books a transaction together with the card balance it results in; saving a
+	// card that was modified since it was loaded is a version conflict
+	Save(ctx context.Context, card *GiftCard, transaction *GiftCardTransaction) error
+
+	// GetTransaction retrieves a gift card transaction by its ID
+	GetTransaction(ctx context.Context, id GiftCardTransactionID) (*GiftCardTransaction, error)
+
+	// FindTransactions retrieves the transactions of a gift card in booking order
+	FindTransactions(ctx context.Context, cardID GiftCardID) ([]*GiftCardTransaction, error)
+}
+
+// GiftCardProcessor captures and returns gift card tenders on the card ledger
+type GiftCardProcessor interface {
+	// Redeem debits up to the given amount from the card with the given code for an order
+	Redeem(ctx context.Context, code string, amount float64, orderID OrderID) (*GiftCardTransaction, error)
+
+	// Refund credits back part or all of a redemption
+	Refund(ctx context.Context, redemptionID GiftCardTransactionID, amount float64) (*GiftCardTransaction, error)
+}
+
+// GiftCardService defines the interface for stored-value gift cards
+type GiftCardService interface {
+	GiftCardProcessor
+
+	// Issue creates a gift card with an opening balance; the returned card carries its code
+	Issue(ctx context.Context, amount float64) (*GiftCard, error)
+
+	// Reload adds money to the card with the given code
+	Reload(ctx context.Context, code string, amount float64) (*GiftCard, error)
+
+	// CheckBalance retrieves the card with the given code
+	CheckBalance(ctx context.Context, code string) (*GiftCard, error)
+
+	// GetTransactions retrieves the balance ledger of a card
+	GetTransactions(ctx context.Context, id GiftCardID) ([]*GiftCardTransaction, error)
+}
+
+// DrawerSessionRepository defines the interface for cash drawer session data access
+type DrawerSessionRepository interface {
+	// Create adds a new drawer session; a cashier may only have one open session
+	Create(ctx context.Context, session *DrawerSession) error
+
+	// GetByID retrieves a drawer session by its ID
+	GetByID(ctx context.Context, id DrawerSessionID) (*DrawerSession, error)
+
+	// GetOpenByCashier retrieves the open drawer session of a cashier
+	GetOpenByCashier(ctx context.Context, cashierID string) (*DrawerSession, error)
+
+	// FindByStatus retrieves the drawer sessions in a status, newest first
+	FindByStatus(ctx context.Context, status DrawerSessionStatus) ([]*DrawerSession, error)
+
+	// Update saves a drawer session; saving a session modified since it was loaded is a version conflict
+	Update(ctx context.Context, session *DrawerSession) error
+}
+
+// DrawerService defines the interface for cashier shifts and cash drawer reconciliation
+type DrawerService interface {
+	// OpenSession opens a drawer session for the acting cashier with a starting float
+	OpenSession(ctx context.Context, startingFloat float64) (*DrawerSession, error)
+
+	// GetSession retrieves a drawer session with its report; an open session reports a running tally
+	GetSession(ctx context.Context, id DrawerSessionID) (*DrawerSession, error)
+
+	// GetCurrentSession retrieves the open drawer session of the acting cashier
+	GetCurrentSession(ctx context.Context) (*DrawerSession, error)
+
+	// ListSessions retrieves the drawer sessions in a status
+	ListSessions(ctx context.Context, status DrawerSessionStatus) ([]*DrawerSession, error)
+
+	// RecordMovement records a paid-in, paid-out or drop on an open session
+	RecordMovement(ctx context.Context, id DrawerSessionID, movementType DrawerMovementType, amount float64, reason string) (*DrawerSession, error)
+
+	// CloseSession counts the drawer and produces its over/short report
+	CloseSession(ctx context.Context, id DrawerSessionID, counted float64) (*DrawerSession, error)
+
+	// SignOff ends a closed session with a manager's sign-off
+	SignOff(ctx context.Context, id DrawerSessionID, notes string) (*DrawerSession, error)
+}
@@ -0,0 +1,35 @@
+package domain
+
+import "time"
+
+// ReservationSeatingWindow is how far either side of its booked time a reservation
+// is taken to be the one being seated at its table
+const ReservationSeatingWindow = 2 * time.Hour
+
+// ReservationStatus mirrors the status of a reservation in reservation-service
+type ReservationStatus string
+
+const (
+	ReservationStatusPending   ReservationStatus = "PENDING"
+	ReservationStatusConfirmed ReservationStatus = "CONFIRMED"
+	ReservationStatusCancelled ReservationStatus = "CANCELLED"
+	ReservationStatusCompleted ReservationStatus = "COMPLETED"
+	ReservationStatusNoShow    ReservationStatus = "NO_SHOW"
+)
+
+// Reservation is order-service's local read model of a table reservation.
+// It is kept current from reservation.* events so that a dine-in check's
+// guest count can default to the party size.
+type Reservation struct {
+	ID        string            `json:"id"`
+	TableID   string            `json:"table_id"`
+	PartySize int               `json:"party_size"`
+	DateTime  time.Time         `json:"date_time"`
+	Status    ReservationStatus `json:"status"`
+	UpdatedAt time.Time         `json:"updated_at"`
+}
+
+// IsSeatable reports whether the party may still be seated on this reservation
+func (r *Reservation) IsSeatable() bool {
+	return r.Status == ReservationStatusPending || r.Status == ReservationStatusConfirmed
+}
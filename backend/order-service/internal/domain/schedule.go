@@ -0,0 +1,86 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/restaurant-platform/shared/pkg/errors"
+)
+
+// DefaultReleaseBuffer is the slack added on top of the estimated prep time when
+// deciding when a scheduled order goes to the kitchen
+const DefaultReleaseBuffer = 10 * time.Minute
+
+// ScheduledOrder pairs a scheduled order awaiting release with its estimated prep time
+// and the time it is due to go to the kitchen
+type ScheduledOrder struct {
+	Order     *Order
+	PrepTime  time.Duration
+	ReleaseAt time.Time
+}
+
+// NewScheduledOrder creates an order for a requested fulfillment time. The order is
+// held back from the kitchen until it is released.
+func NewScheduledOrder(customerID string, orderType OrderType, fulfillmentTime, now time.Time) (*Order, error) {
+	order, err := NewOrder(customerID, orderType)
+	if err != nil {
+		return nil, err
+	}
+
+	if !fulfillmentTime.After(now) {
+		return nil, errors.WrapValidation("NewScheduledOrder", "fulfillmentTime", "fulfillment time must be in the future", nil)
+	}
+
+	order.FulfillmentTime = &fulfillmentTime
+	return order, nil
+}
+
+// IsScheduled reports whether the order was placed for a future fulfillment time
+func (o *Order) IsScheduled() bool {
+	return o.FulfillmentTime != nil
+}
+
+// IsAwaitingRelease reports whether a scheduled order is still held back from the kitchen.
+// Until release it can be edited or cancelled freely.
+func (o *Order) IsAwaitingRelease() bool {
+	return o.IsScheduled() && o.ReleasedAt == nil &&
+		o.Status != OrderStatusCompleted && o.Status != OrderStatusCancelled
+}
+
+// Reschedule moves the fulfillment time of a scheduled order that has not been released
+func (o *Order) Reschedule(fulfillmentTime, now time.Time) error {
+	if !o.IsAwaitingRelease() {
+		return errors.WrapConflict("Reschedule", "status", "only scheduled orders awaiting release can be rescheduled", nil)
+	}
+	if !fulfillmentTime.After(now) {
+		return errors.WrapValidation("Reschedule", "fulfillmentTime", "fulfillment time must be in the future", nil)
+	}
+
+	o.FulfillmentTime = &fulfillmentTime
+	o.UpdatedAt = now
+	return nil
+}
+
+// ReleaseTime returns when the order should go to the kitchen so that it is ready
+// by its fulfillment time: fulfillment time minus prep time minus buffer
+func (o *Order) ReleaseTime(prepTime, buffer time.Duration) time.Time {
+	if !o.IsScheduled() {
+		return o.CreatedAt
+	}
+	return o.FulfillmentTime.Add(-prepTime - buffer)
+}
+
+// IsDueForRelease reports whether a scheduled order should be released at the given time
+func (o *Order) IsDueForRelease(prepTime, buffer time.Duration, now time.Time) bool {
+	return o.IsAwaitingRelease() && !now.Before(o.ReleaseTime(prepTime, buffer))
+}
+
+// Release marks a scheduled order as sent to the kitchen
+func (o *Order) Release(now time.Time) error {
+	if !o.IsAwaitingRelease() {
+		return errors.WrapConflict("Release", "status", "order is not awaiting release", nil)
+	}
+
+	o.ReleasedAt = &now
+	o.UpdatedAt = now
+	return nil
+}
@@ -0,0 +1,135 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/restaurant-platform/shared/pkg/errors"
+)
+
+// ScheduleTestSuite contains scheduled order tests
+type ScheduleTestSuite struct {
+	suite.Suite
+	now         time.Time
+	fulfillment time.Time
+	order       *Order
+}
+
+func TestScheduleTestSuite(t *testing.T) {
+	suite.Run(t, new(ScheduleTestSuite))
+}
+
+func (suite *ScheduleTestSuite) SetupTest() {
+	suite.now = time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+	suite.fulfillment = suite.now.Add(30 * time.Hour)
+	suite.order, _ = NewScheduledOrder("customer-123", OrderTypeTakeout, suite.fulfillment, suite.now)
+}
+
+func (suite *ScheduleTestSuite) TestNewScheduledOrder_AwaitsRelease() {
+	// Then
+	assert := assert.New(suite.T())
+	assert.True(suite.order.IsScheduled())
+	assert.True(suite.order.IsAwaitingRelease())
+	assert.Equal(suite.fulfillment, *suite.order.FulfillmentTime)
+}
+
+func (suite *ScheduleTestSuite) TestNewScheduledOrder_PastTime_ShouldFail() {
+	// When
+	order, err := NewScheduledOrder("customer-123", OrderTypeTakeout, suite.now.Add(-time.Minute), suite.now)
+
+	// Then
+	assert.Nil(suite.T(), order)
+	assert.True(suite.T(), errors.IsValidationError(err))
+}
+
+func (suite *ScheduleTestSuite) TestReleaseTime_SubtractsPrepTimeAndBuffer() {
+	// When
+	releaseAt := suite.order.ReleaseTime(25*time.Minute, DefaultReleaseBuffer)
+
+	// Then
+	assert.Equal(suite.T(), suite.fulfillment.Add(-35*time.Minute), releaseAt)
+}
+
+func (suite *ScheduleTestSuite) TestIsDueForRelease() {
+	releaseAt := suite.fulfillment.Add(-20*time.Minute - DefaultReleaseBuffer)
+
+	assert := assert.New(suite.T())
+	assert.False(suite.order.IsDueForRelease(20*time.Minute, DefaultReleaseBuffer, releaseAt.Add(-time.Second)))
+	assert.True(suite.order.IsDueForRelease(20*time.Minute, DefaultReleaseBuffer, releaseAt))
+}
+
+func (suite *ScheduleTestSuite) TestPaidScheduledOrder_StaysEditableUntilRelease() {
+	// Given
+	_ = suite.order.AddItem("tray-1", "Sandwich tray", 2, 45.00, nil, "")
+	_ = suite.order.UpdateStatus(OrderStatusPaid, "", "")
+
+	// When
+	err := suite.order.UpdateItemQuantity(suite.order.Items[0].ID, 3)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	assert.False(suite.order.IsSentToKitchen())
+}
+
+func (suite *ScheduleTestSuite) TestRelease_SendsOrderToKitchen() {
+	// Given
+	_ = suite.order.UpdateStatus(OrderStatusPaid, "", "")
+
+	// When
+	err := suite.order.Release(suite.now)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	assert.False(suite.order.IsAwaitingRelease())
+	assert.True(suite.order.IsSentToKitchen())
+	assert.Equal(suite.now, *suite.order.ReleasedAt)
+	assert.True(errors.IsConflictError(suite.order.Release(suite.now)))
+}
+
+func (suite *ScheduleTestSuite) TestReschedule_Success() {
+	// When
+	later := suite.fulfillment.Add(2 * time.Hour)
+	err := suite.order.Reschedule(later, suite.now)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	assert.Equal(later, *suite.order.FulfillmentTime)
+}
+
+func (suite *ScheduleTestSuite) TestReschedule_AfterRelease_ShouldFail() {
+	// Given
+	_ = suite.order.Release(suite.now)
+
+	// When
+	err := suite.order.Reschedule(suite.fulfillment.Add(time.Hour), suite.now)
+
+	// Then
+	assert.True(suite.T(), errors.IsConflictError(err))
+}
+
+func (suite *ScheduleTestSuite) TestReschedule_ImmediateOrder_ShouldFail() {
+	// Given
+	order, _ := NewOrder("customer-123", OrderTypeTakeout)
+
+	// When
+	err := order.Reschedule(suite.fulfillment, suite.now)
+
+	// Then
+	assert.True(suite.T(), errors.IsConflictError(err))
+}
+
+func (suite *ScheduleTestSuite) TestCancel_BeforeRelease_Succeeds() {
+	// When
+	err := suite.order.Cancel("", "")
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	assert.False(suite.order.IsAwaitingRelease())
+}
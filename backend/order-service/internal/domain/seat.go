@@ -0,0 +1,116 @@
+package domain
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/restaurant-platform/shared/pkg/errors"
+)
+
+// SharedSeat is the seat of items ordered for the whole table, such as starters to share
+const SharedSeat = 0
+
+// SeatItems is the part of a check ordered by one seat
+type SeatItems struct {
+	Seat     int          `json:"seat"`
+	Items    []*OrderItem `json:"items"`
+	Subtotal float64      `json:"subtotal"`
+}
+
+// SeatGuests records how many guests are seated at a dine-in check and, when they
+// were seated from a reservation, which one
+func (o *Order) SeatGuests(guestCount int, reservationID string) error {
+	if o.Type != OrderTypeDineIn {
+		return errors.WrapConflict("SeatGuests", "order_type", "only dine-in orders have guests", nil)
+	}
+	if !o.CanCancel() {
+		return errors.WrapConflict("SeatGuests", "order_status", "cannot seat guests on a completed or cancelled order", nil)
+	}
+	if guestCount <= 0 {
+		return errors.WrapValidation("SeatGuests", "guest_count", "guest count must be positive", nil)
+	}
+	if highest := o.highestSeat(); guestCount < highest {
+		return errors.WrapConflict("SeatGuests", "guest_count",
+			fmt.Sprintf("items are still assigned to seat %d; move them before reducing the guest count", highest), nil)
+	}
+
+	o.GuestCount = guestCount
+	if reservationID != "" {
+		o.ReservationID = reservationID
+	}
+	o.UpdatedAt = time.Now()
+	return nil
+}
+
+// AssignSeat moves an item to a seat of a dine-in check. Seat 0 shares the item with
+// the table; once guests are seated the seat cannot be beyond the guest count.
+func (o *Order) AssignSeat(itemID OrderItemID, seat int) (*OrderItem, error) {
+	if o.Type != OrderTypeDineIn {
+		return nil, errors.WrapConflict("AssignSeat", "order_type", "only dine-in orders have seats", nil)
+	}
+	if !o.CanCancel() {
+		return nil, errors.WrapConflict("AssignSeat", "order_status", "cannot change seats on a completed or cancelled order", nil)
+	}
+	if seat < SharedSeat {
+		return nil, errors.WrapValidation("AssignSeat", "seat", "seat cannot be negative", nil)
+	}
+	if o.GuestCount > 0 && seat > o.GuestCount {
+		return nil, errors.WrapValidation("AssignSeat", "seat", fmt.Sprintf("seat must be between 0 and %d", o.GuestCount), nil)
+	}
+
+	item := o.findItem(itemID)
+	if item == nil {
+		return nil, errors.WrapNotFound("AssignSeat", "order_item", itemID.String(), errors.ErrNotFound)
+	}
+	if item.IsVoided() {
+		return nil, errors.WrapConflict("AssignSeat", "order_item", "voided items cannot change seats", nil)
+	}
+
+	item.Seat = seat
+	o.UpdatedAt = time.Now()
+	return item, nil
+}
+
+// ItemsBySeat groups the live items of the check by seat, shared items first.
+// Every seat up to the guest count is listed, including seats that have not ordered.
+func (o *Order) ItemsBySeat() []*SeatItems {
+	seats := make(map[int]*SeatItems)
+	for seat := 1; seat <= o.GuestCount; seat++ {
+		seats[seat] = &SeatItems{Seat: seat, Items: make([]*OrderItem, 0)}
+	}
+
+	for _, item := range o.Items {
+		if item.IsVoided() {
+			continue
+		}
+		entry, ok := seats[item.Seat]
+		if !ok {
+			entry = &SeatItems{Seat: item.Seat}
+			seats[item.Seat] = entry
+		}
+		entry.Items = append(entry.Items, item)
+		entry.Subtotal += item.Subtotal
+	}
+
+	result := make([]*SeatItems, 0, len(seats))
+	for _, entry := range seats {
+		entry.Subtotal = roundCents(entry.Subtotal)
+		result = append(result, entry)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Seat < result[j].Seat
+	})
+	return result
+}
+
+// highestSeat returns the highest seat any live item is assigned to
+func (o *Order) highestSeat() int {
+	highest := SharedSeat
+	for _, item := range o.Items {
+		if !item.IsVoided() && item.Seat > highest {
+			highest = item.Seat
+		}
+	}
+	return highest
+}
@@ -0,0 +1,107 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/restaurant-platform/shared/pkg/errors"
+)
+
+// SeatTestSuite contains guest count and seat assignment tests
+type SeatTestSuite struct {
+	suite.Suite
+	order *Order
+}
+
+func TestSeatTestSuite(t *testing.T) {
+	suite.Run(t, new(SeatTestSuite))
+}
+
+func (suite *SeatTestSuite) SetupTest() {
+	suite.order, _ = NewOrder("customer-123", OrderTypeDineIn)
+	suite.order.SetTableID("table-4")
+	suite.order.AddItem("nachos", "Nachos", 1, 9.00, nil, "")
+	suite.order.AddItem("burger", "Burger", 1, 12.00, nil, "")
+	suite.order.AddItem("steak", "Steak", 1, 24.50, nil, "")
+}
+
+func (suite *SeatTestSuite) TestSeatGuests_Success() {
+	// When
+	err := suite.order.SeatGuests(4, "res-1")
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	assert.Equal(4, suite.order.GuestCount)
+	assert.Equal("res-1", suite.order.ReservationID)
+}
+
+func (suite *SeatTestSuite) TestSeatGuests_Takeout_ShouldFail() {
+	// Given
+	order, _ := NewOrder("customer-123", OrderTypeTakeout)
+
+	// When
+	err := order.SeatGuests(2, "")
+
+	// Then
+	assert.True(suite.T(), errors.IsConflictError(err))
+}
+
+func (suite *SeatTestSuite) TestSeatGuests_BelowAssignedSeat_ShouldFail() {
+	// Given
+	suite.order.AssignSeat(suite.order.Items[2].ID, 3)
+
+	// When
+	err := suite.order.SeatGuests(2, "")
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.True(errors.IsConflictError(err))
+	assert.Zero(suite.order.GuestCount)
+}
+
+func (suite *SeatTestSuite) TestAssignSeat_BeyondGuestCount_ShouldFail() {
+	// Given
+	suite.order.SeatGuests(2, "")
+
+	// When
+	_, err := suite.order.AssignSeat(suite.order.Items[1].ID, 3)
+
+	// Then
+	assert.True(suite.T(), errors.IsValidationError(err))
+}
+
+func (suite *SeatTestSuite) TestAssignSeat_VoidedItem_ShouldFail() {
+	// Given
+	suite.order.UpdateStatus(OrderStatusPaid, "alice", "")
+	suite.order.VoidItem(suite.order.Items[1].ID, "wrong item")
+
+	// When
+	_, err := suite.order.AssignSeat(suite.order.Items[1].ID, 1)
+
+	// Then
+	assert.True(suite.T(), errors.IsConflictError(err))
+}
+
+func (suite *SeatTestSuite) TestItemsBySeat_GroupsSharedAndEmptySeats() {
+	// Given
+	suite.order.SeatGuests(3, "")
+	suite.order.AssignSeat(suite.order.Items[1].ID, 1)
+	suite.order.AssignSeat(suite.order.Items[2].ID, 1)
+
+	// When
+	seats := suite.order.ItemsBySeat()
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.Len(seats, 4)
+	assert.Equal(SharedSeat, seats[0].Seat)
+	assert.Equal(9.00, seats[0].Subtotal)
+	assert.Equal(1, seats[1].Seat)
+	assert.Len(seats[1].Items, 2)
+	assert.Equal(36.50, seats[1].Subtotal)
+	assert.Empty(seats[2].Items)
+	assert.Empty(seats[3].Items)
+}
@@ -0,0 +1,167 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/restaurant-platform/shared/pkg/types"
+)
+
+// SLAAlertEntity marks SLA alert IDs
+type SLAAlertEntity struct{}
+
+func (SLAAlertEntity) IsEntity() {}
+
+// SLAAlertID is the type-safe ID of an SLA alert
+type SLAAlertID = types.ID[SLAAlertEntity]
+
+// SLADefaultOrderType is the configuration key of the threshold for order types without their own
+const SLADefaultOrderType = "default"
+
+// SLAPolicy sets how long an order may stay in each status before it breaches its service level
+type SLAPolicy struct {
+	// Thresholds maps a status to the longest an order may stay in it, per order type.
+	// The threshold under the empty order type applies to types without their own.
+	Thresholds map[OrderStatus]map[OrderType]time.Duration
+	// UnpaidTimeout is how long an order may stay CREATED before it is cancelled; zero disables it.
+	// Dine-in checks are paid at the end of the meal and are never cancelled for being unpaid.
+	UnpaidTimeout time.Duration
+}
+
+// NewSLAPolicy builds a policy from configured thresholds keyed by status and then order type,
+// "default" being the threshold for order types without their own. Keys are case-insensitive.
+func NewSLAPolicy(thresholds map[string]map[string]time.Duration, unpaidTimeout time.Duration) (SLAPolicy, error) {
+	if unpaidTimeout < 0 {
+		return SLAPolicy{}, fmt.Errorf("invalid unpaid timeout %s: must not be negative", unpaidTimeout)
+	}
+
+	policy := SLAPolicy{
+		Thresholds:    make(map[OrderStatus]map[OrderType]time.Duration),
+		UnpaidTimeout: unpaidTimeout,
+	}
+
+	for statusKey, byType := range thresholds {
+		status := OrderStatus(strings.ToUpper(statusKey))
+		switch status {
+		case OrderStatusCreated, OrderStatusPaid, OrderStatusPreparing, OrderStatusReady, OrderStatusOutForDelivery:
+		default:
+			return SLAPolicy{}, fmt.Errorf("invalid SLA status %q: only open statuses can have an SLA", statusKey)
+		}
+
+		policy.Thresholds[status] = make(map[OrderType]time.Duration)
+		for typeKey, threshold := range byType {
+			orderType := OrderType(strings.ToUpper(typeKey))
+			switch {
+			case strings.EqualFold(typeKey, SLADefaultOrderType):
+				orderType = ""
+			case orderType != OrderTypeDineIn && orderType != OrderTypeTakeout && orderType != OrderTypeDelivery:
+				return SLAPolicy{}, fmt.Errorf("invalid SLA order type %q for status %s", typeKey, status)
+			}
+			if threshold <= 0 {
+				return SLAPolicy{}, fmt.Errorf("invalid SLA threshold %s for %s %s: must be positive", threshold, status, typeKey)
+			}
+			policy.Thresholds[status][orderType] = threshold
+		}
+	}
+
+	return policy, nil
+}
+
+// Threshold returns how long an order of the given type may stay in the status
+func (p SLAPolicy) Threshold(status OrderStatus, orderType OrderType) (time.Duration, bool) {
+	byType, ok := p.Thresholds[status]
+	if !ok {
+		return 0, false
+	}
+	if threshold, ok := byType[orderType]; ok {
+		return threshold, true
+	}
+	threshold, ok := byType[""]
+	return threshold, ok
+}
+
+// Evaluate returns an alert if the order has been in its current status for longer than
+// its threshold. Scheduled orders are not monitored until they are released.
+func (p SLAPolicy) Evaluate(order *Order, now time.Time) *SLAAlert {
+	if !order.CanCancel() || order.IsAwaitingRelease() {
+		return nil
+	}
+
+	threshold, ok := p.Threshold(order.Status, order.Type)
+	if !ok {
+		return nil
+	}
+
+	enteredAt := order.slaClockStart()
+	if now.Sub(enteredAt) <= threshold {
+		return nil
+	}
+
+	return &SLAAlert{
+		ID:         types.NewID[SLAAlertEntity]("sla"),
+		OrderID:    order.ID,
+		OrderType:  order.Type,
+		TableID:    order.TableID,
+		Status:     order.Status,
+		Threshold:  threshold,
+		EnteredAt:  enteredAt,
+		BreachedAt: enteredAt.Add(threshold),
+		DetectedAt: now,
+	}
+}
+
+// IsUnpaidTooLong reports whether the order has stayed CREATED past the unpaid timeout
+// and should be cancelled. A dine-in check stays open for the whole meal, so it never is.
+func (p SLAPolicy) IsUnpaidTooLong(order *Order, now time.Time) bool {
+	if p.UnpaidTimeout <= 0 || order.Status != OrderStatusCreated || order.Type == OrderTypeDineIn || order.IsAwaitingRelease() {
+		return false
+	}
+	return now.Sub(order.slaClockStart()) > p.UnpaidTimeout
+}
+
+// SLAAlert records an order that stayed in a status for longer than its SLA allows.
+// It is resolved once the order leaves that status.
+type SLAAlert struct {
+	ID         SLAAlertID    `json:"id"`
+	OrderID    OrderID       `json:"order_id"`
+	OrderType  OrderType     `json:"order_type"`
+	TableID    string        `json:"table_id,omitempty"`
+	Status     OrderStatus   `json:"status"`
+	Threshold  time.Duration `json:"threshold"`
+	EnteredAt  time.Time     `json:"entered_at"`
+	BreachedAt time.Time     `json:"breached_at"`
+	DetectedAt time.Time     `json:"detected_at"`
+	ResolvedAt *time.Time    `json:"resolved_at,omitempty"`
+}
+
+// Elapsed returns how long the order has been, or was, in the breached status
+func (a *SLAAlert) Elapsed(now time.Time) time.Duration {
+	if a.ResolvedAt != nil {
+		return a.ResolvedAt.Sub(a.EnteredAt)
+	}
+	return now.Sub(a.EnteredAt)
+}
+
+// Resolve closes the alert
+func (a *SLAAlert) Resolve(now time.Time) {
+	a.ResolvedAt = &now
+}
+
+// IsResolved reports whether the order has left the breached status
+func (a *SLAAlert) IsResolved() bool {
+	return a.ResolvedAt != nil
+}
+
+// slaClockStart returns when the order entered its current status. A released scheduled
+// order is measured from its release rather than from when it was placed.
+func (o *Order) slaClockStart() time.Time {
+	enteredAt := o.CreatedAt
+	if at := o.StatusEnteredAt(o.Status); at != nil {
+		enteredAt = *at
+	}
+	if o.ReleasedAt != nil && o.ReleasedAt.After(enteredAt) {
+		enteredAt = *o.ReleasedAt
+	}
+	return enteredAt
+}
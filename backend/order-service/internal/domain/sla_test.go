@@ -0,0 +1,154 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+// SLATestSuite contains SLA policy and breach detection tests
+type SLATestSuite struct {
+	suite.Suite
+	policy SLAPolicy
+	order  *Order
+	now    time.Time
+}
+
+func TestSLATestSuite(t *testing.T) {
+	suite.Run(t, new(SLATestSuite))
+}
+
+func (suite *SLATestSuite) SetupTest() {
+	suite.policy, _ = NewSLAPolicy(map[string]map[string]time.Duration{
+		"paid":  {"default": 20 * time.Minute},
+		"ready": {"default": 20 * time.Minute, "dine_in": 10 * time.Minute},
+	}, 2*time.Hour)
+
+	suite.now = time.Now()
+	suite.order, _ = NewOrder("customer-123", OrderTypeDineIn)
+	suite.order.SetTableID("table-4")
+	suite.order.AddItem("burger", "Burger", 1, 10.00, nil, "")
+}
+
+// inStatus moves the order to status and backdates the transition by ago
+func (suite *SLATestSuite) inStatus(status OrderStatus, ago time.Duration) {
+	suite.order.UpdateStatus(status, "cashier-1", "")
+	suite.order.StatusHistory[len(suite.order.StatusHistory)-1].At = suite.now.Add(-ago)
+}
+
+func (suite *SLATestSuite) TestNewSLAPolicy_KeysAreCaseInsensitive() {
+	// When
+	policy, err := NewSLAPolicy(map[string]map[string]time.Duration{
+		"PREPARING": {"Default": 30 * time.Minute, "delivery": 25 * time.Minute},
+	}, 0)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	threshold, ok := policy.Threshold(OrderStatusPreparing, OrderTypeTakeout)
+	assert.True(ok)
+	assert.Equal(30*time.Minute, threshold)
+	threshold, _ = policy.Threshold(OrderStatusPreparing, OrderTypeDelivery)
+	assert.Equal(25*time.Minute, threshold)
+}
+
+func (suite *SLATestSuite) TestNewSLAPolicy_ClosedStatus_ShouldFail() {
+	// When
+	_, err := NewSLAPolicy(map[string]map[string]time.Duration{"completed": {"default": time.Minute}}, 0)
+
+	// Then
+	assert.Error(suite.T(), err)
+}
+
+func (suite *SLATestSuite) TestNewSLAPolicy_UnknownOrderType_ShouldFail() {
+	// When
+	_, err := NewSLAPolicy(map[string]map[string]time.Duration{"paid": {"drive_thru": time.Minute}}, 0)
+
+	// Then
+	assert.Error(suite.T(), err)
+}
+
+func (suite *SLATestSuite) TestEvaluate_WithinThreshold_NoAlert() {
+	// Given
+	suite.inStatus(OrderStatusPaid, 15*time.Minute)
+
+	// Then
+	assert.Nil(suite.T(), suite.policy.Evaluate(suite.order, suite.now))
+}
+
+func (suite *SLATestSuite) TestEvaluate_PastThreshold_RaisesAlert() {
+	// Given
+	suite.inStatus(OrderStatusPaid, 25*time.Minute)
+
+	// When
+	alert := suite.policy.Evaluate(suite.order, suite.now)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NotNil(alert)
+	assert.Equal(suite.order.ID, alert.OrderID)
+	assert.Equal(OrderStatusPaid, alert.Status)
+	assert.Equal(20*time.Minute, alert.Threshold)
+	assert.Equal(suite.now.Add(-5*time.Minute), alert.BreachedAt)
+	assert.Equal(25*time.Minute, alert.Elapsed(suite.now))
+}
+
+func (suite *SLATestSuite) TestEvaluate_OrderTypeThreshold_OverridesDefault() {
+	// Given
+	suite.inStatus(OrderStatusPaid, 0)
+	suite.inStatus(OrderStatusPreparing, 0)
+	suite.inStatus(OrderStatusReady, 12*time.Minute)
+
+	// When
+	alert := suite.policy.Evaluate(suite.order, suite.now)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NotNil(alert)
+	assert.Equal(10*time.Minute, alert.Threshold)
+}
+
+func (suite *SLATestSuite) TestEvaluate_StatusWithoutThreshold_NoAlert() {
+	// Given
+	suite.inStatus(OrderStatusPaid, 0)
+	suite.inStatus(OrderStatusPreparing, 3*time.Hour)
+
+	// Then
+	assert.Nil(suite.T(), suite.policy.Evaluate(suite.order, suite.now))
+}
+
+func (suite *SLATestSuite) TestIsUnpaidTooLong() {
+	// Given
+	order, _ := NewOrder("customer-123", OrderTypeTakeout)
+	order.CreatedAt = suite.now.Add(-3 * time.Hour)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.True(suite.policy.IsUnpaidTooLong(order, suite.now))
+	assert.False(suite.policy.IsUnpaidTooLong(order, suite.now.Add(-90*time.Minute)))
+	assert.False(SLAPolicy{}.IsUnpaidTooLong(order, suite.now))
+}
+
+func (suite *SLATestSuite) TestIsUnpaidTooLong_DineInOrderOlderThanTimeout_StaysOpen() {
+	// Given a table that has been eating for three hours
+	suite.order.CreatedAt = suite.now.Add(-3 * time.Hour)
+
+	// Then
+	assert.False(suite.T(), suite.policy.IsUnpaidTooLong(suite.order, suite.now))
+}
+
+func (suite *SLATestSuite) TestIsUnpaidTooLong_ScheduledOrder_CountsFromRelease() {
+	// Given
+	fulfillment := suite.now.Add(time.Hour)
+	order, _ := NewScheduledOrder("customer-123", OrderTypeTakeout, fulfillment, suite.now.Add(-3*time.Hour))
+	order.CreatedAt = suite.now.Add(-3 * time.Hour)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.False(suite.policy.IsUnpaidTooLong(order, suite.now))
+	released := suite.now.Add(-30 * time.Minute)
+	order.ReleasedAt = &released
+	assert.False(suite.policy.IsUnpaidTooLong(order, suite.now))
+}
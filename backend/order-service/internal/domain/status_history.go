@@ -0,0 +1,126 @@
+package domain
+
+import (
+	"sort"
+	"time"
+)
+
+// SystemActor is recorded when order-service changes a status on its own behalf
+const SystemActor = "order-service"
+
+// StatusTransition records a single change of order status
+type StatusTransition struct {
+	From   OrderStatus `json:"from"`
+	To     OrderStatus `json:"to"`
+	Actor  string      `json:"actor"`
+	Reason string      `json:"reason,omitempty"`
+	At     time.Time   `json:"at"`
+}
+
+// StatusMetric summarizes how long orders spent in a status
+type StatusMetric struct {
+	Status  OrderStatus   `json:"status"`
+	Orders  int           `json:"orders"`
+	Average time.Duration `json:"average"`
+	Max     time.Duration `json:"max"`
+}
+
+// recordTransition appends a status change to the order's history
+func (o *Order) recordTransition(from, to OrderStatus, actor, reason string, at time.Time) {
+	if actor == "" {
+		actor = SystemActor
+	}
+	o.StatusHistory = append(o.StatusHistory, &StatusTransition{
+		From:   from,
+		To:     to,
+		Actor:  actor,
+		Reason: reason,
+		At:     at,
+	})
+}
+
+// StatusEnteredAt returns when the order most recently entered a status
+func (o *Order) StatusEnteredAt(status OrderStatus) *time.Time {
+	for i := len(o.StatusHistory) - 1; i >= 0; i-- {
+		if o.StatusHistory[i].To == status {
+			return &o.StatusHistory[i].At
+		}
+	}
+	if status == OrderStatusCreated {
+		return &o.CreatedAt
+	}
+	return nil
+}
+
+// TimeInStatus returns how long the order has spent in each status it passed through.
+// The order is CREATED from CreatedAt until its first transition; the current status
+// accrues time until now unless it is terminal.
+func (o *Order) TimeInStatus(now time.Time) map[OrderStatus]time.Duration {
+	durations := make(map[OrderStatus]time.Duration)
+
+	status := OrderStatusCreated
+	enteredAt := o.CreatedAt
+	for _, transition := range o.StatusHistory {
+		durations[status] += transition.At.Sub(enteredAt)
+		status = transition.To
+		enteredAt = transition.At
+	}
+
+	if status != OrderStatusCompleted && status != OrderStatusCancelled {
+		durations[status] += now.Sub(enteredAt)
+	}
+
+	return durations
+}
+
+// SummarizeTimeInStatus computes the average and longest time the given orders spent in each status
+func SummarizeTimeInStatus(orders []*Order, now time.Time) []*StatusMetric {
+	metrics := make(map[OrderStatus]*StatusMetric)
+	totals := make(map[OrderStatus]time.Duration)
+
+	for _, order := range orders {
+		for status, duration := range order.TimeInStatus(now) {
+			metric, ok := metrics[status]
+			if !ok {
+				metric = &StatusMetric{Status: status}
+				metrics[status] = metric
+			}
+			metric.Orders++
+			totals[status] += duration
+			if duration > metric.Max {
+				metric.Max = duration
+			}
+		}
+	}
+
+	summary := make([]*StatusMetric, 0, len(metrics))
+	for status, metric := range metrics {
+		metric.Average = totals[status] / time.Duration(metric.Orders)
+		summary = append(summary, metric)
+	}
+
+	// Report statuses in lifecycle order
+	sort.Slice(summary, func(i, j int) bool {
+		return statusRank(summary[i].Status) < statusRank(summary[j].Status)
+	})
+	return summary
+}
+
+func statusRank(status OrderStatus) int {
+	switch status {
+	case OrderStatusCreated:
+		return 0
+	case OrderStatusPaid:
+		return 1
+	case OrderStatusPreparing:
+		return 2
+	case OrderStatusReady:
+		return 3
+	case OrderStatusOutForDelivery:
+		return 4
+	case OrderStatusCompleted:
+		return 5
+	default:
+		return 6
+	}
+}
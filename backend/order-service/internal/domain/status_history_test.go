@@ -0,0 +1,128 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+// StatusHistoryTestSuite contains status history and time-in-status tests
+type StatusHistoryTestSuite struct {
+	suite.Suite
+	order *Order
+	start time.Time
+}
+
+func TestStatusHistoryTestSuite(t *testing.T) {
+	suite.Run(t, new(StatusHistoryTestSuite))
+}
+
+func (suite *StatusHistoryTestSuite) SetupTest() {
+	suite.order, _ = NewOrder("customer-123", OrderTypeTakeout)
+	suite.start = time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	suite.order.CreatedAt = suite.start
+}
+
+// moveTo transitions the order and backdates the recorded transition
+func (suite *StatusHistoryTestSuite) moveTo(status OrderStatus, after time.Duration) {
+	_ = suite.order.UpdateStatus(status, "user-42", "")
+	suite.order.StatusHistory[len(suite.order.StatusHistory)-1].At = suite.start.Add(after)
+}
+
+func (suite *StatusHistoryTestSuite) TestUpdateStatus_RecordsTransition() {
+	// When
+	err := suite.order.UpdateStatus(OrderStatusPaid, "user-42", "paid at counter")
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	assert.Len(suite.order.StatusHistory, 1)
+	transition := suite.order.StatusHistory[0]
+	assert.Equal(OrderStatusCreated, transition.From)
+	assert.Equal(OrderStatusPaid, transition.To)
+	assert.Equal("user-42", transition.Actor)
+	assert.Equal("paid at counter", transition.Reason)
+}
+
+func (suite *StatusHistoryTestSuite) TestUpdateStatus_WithoutActor_RecordsSystem() {
+	// When
+	_ = suite.order.UpdateStatus(OrderStatusPaid, "", "")
+
+	// Then
+	assert.Equal(suite.T(), SystemActor, suite.order.StatusHistory[0].Actor)
+}
+
+func (suite *StatusHistoryTestSuite) TestUpdateStatus_InvalidTransition_RecordsNothing() {
+	// When
+	err := suite.order.UpdateStatus(OrderStatusReady, "user-42", "")
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.Error(err)
+	assert.Empty(suite.order.StatusHistory)
+}
+
+func (suite *StatusHistoryTestSuite) TestStatusEnteredAt() {
+	// Given
+	suite.moveTo(OrderStatusPaid, 5*time.Minute)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.Equal(suite.start, *suite.order.StatusEnteredAt(OrderStatusCreated))
+	assert.Equal(suite.start.Add(5*time.Minute), *suite.order.StatusEnteredAt(OrderStatusPaid))
+	assert.Nil(suite.order.StatusEnteredAt(OrderStatusReady))
+}
+
+func (suite *StatusHistoryTestSuite) TestTimeInStatus_CurrentStatusAccruesUntilNow() {
+	// Given
+	suite.moveTo(OrderStatusPaid, 5*time.Minute)
+	suite.moveTo(OrderStatusPreparing, 7*time.Minute)
+
+	// When
+	durations := suite.order.TimeInStatus(suite.start.Add(19 * time.Minute))
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.Equal(5*time.Minute, durations[OrderStatusCreated])
+	assert.Equal(2*time.Minute, durations[OrderStatusPaid])
+	assert.Equal(12*time.Minute, durations[OrderStatusPreparing])
+}
+
+func (suite *StatusHistoryTestSuite) TestTimeInStatus_TerminalStatusDoesNotAccrue() {
+	// Given
+	suite.moveTo(OrderStatusPaid, 5*time.Minute)
+	_ = suite.order.Cancel("user-42", "customer left")
+	suite.order.StatusHistory[1].At = suite.start.Add(8 * time.Minute)
+
+	// When
+	durations := suite.order.TimeInStatus(suite.start.Add(time.Hour))
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.Equal(3*time.Minute, durations[OrderStatusPaid])
+	assert.NotContains(durations, OrderStatusCancelled)
+}
+
+func (suite *StatusHistoryTestSuite) TestSummarizeTimeInStatus() {
+	// Given
+	suite.moveTo(OrderStatusPaid, 4*time.Minute)
+	other, _ := NewOrder("customer-456", OrderTypeTakeout)
+	other.CreatedAt = suite.start
+	_ = other.UpdateStatus(OrderStatusPaid, "", "")
+	other.StatusHistory[0].At = suite.start.Add(8 * time.Minute)
+
+	// When
+	metrics := SummarizeTimeInStatus([]*Order{suite.order, other}, suite.start.Add(10*time.Minute))
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.Len(metrics, 2)
+	assert.Equal(OrderStatusCreated, metrics[0].Status)
+	assert.Equal(2, metrics[0].Orders)
+	assert.Equal(6*time.Minute, metrics[0].Average)
+	assert.Equal(8*time.Minute, metrics[0].Max)
+	assert.Equal(OrderStatusPaid, metrics[1].Status)
+	assert.Equal(4*time.Minute, metrics[1].Average)
+}
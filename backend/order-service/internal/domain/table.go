@@ -0,0 +1,116 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/restaurant-platform/shared/pkg/errors"
+)
+
+// TransferTable moves an open dine-in order to another table and returns the table it left
+func (o *Order) TransferTable(tableID string) (string, error) {
+	if o.Type != OrderTypeDineIn {
+		return "", errors.WrapConflict("TransferTable", "order_type", "only dine-in orders can be transferred between tables", nil)
+	}
+	if !o.CanCancel() {
+		return "", errors.WrapConflict("TransferTable", "order_status", "cannot transfer a completed or cancelled order", nil)
+	}
+	if tableID == "" {
+		return "", errors.WrapValidation("TransferTable", "table_id", "table ID is required", nil)
+	}
+	if tableID == o.TableID {
+		return "", errors.WrapConflict("TransferTable", "table_id", "order is already at table "+tableID, nil)
+	}
+
+	previous := o.TableID
+	o.TableID = tableID
+	o.UpdatedAt = time.Now()
+	return previous, nil
+}
+
+// MoveItemsTo moves items onto another open check and recalculates both totals.
+// Item IDs are kept so that kitchen tickets can follow the items.
+func (o *Order) MoveItemsTo(target *Order, itemIDs []OrderItemID) ([]*OrderItem, error) {
+	if err := o.ensureRearrangeable("MoveItemsTo", target); err != nil {
+		return nil, err
+	}
+	if len(itemIDs) == 0 {
+		return nil, errors.WrapValidation("MoveItemsTo", "item_ids", "at least one item is required", nil)
+	}
+
+	moving := make(map[OrderItemID]bool, len(itemIDs))
+	for _, itemID := range itemIDs {
+		if moving[itemID] {
+			return nil, errors.WrapValidation("MoveItemsTo", "item_ids", "items can only be listed once", nil)
+		}
+		item := o.findItem(itemID)
+		if item == nil {
+			return nil, errors.WrapNotFound("MoveItemsTo", "order_item", itemID.String(), errors.ErrNotFound)
+		}
+		if item.IsVoided() {
+			return nil, errors.WrapConflict("MoveItemsTo", "order_item", "voided items cannot be moved", nil)
+		}
+		moving[itemID] = true
+	}
+
+	var moved []*OrderItem
+	kept := make([]*OrderItem, 0, len(o.Items)-len(itemIDs))
+	for _, item := range o.Items {
+		if moving[item.ID] {
+			moved = append(moved, item)
+		} else {
+			kept = append(kept, item)
+		}
+	}
+
+	o.Items = kept
+	target.Items = append(target.Items, moved...)
+	o.touchAfterMove(target)
+	return moved, nil
+}
+
+// MergeInto moves every item of the order onto target and closes the order as merged
+func (o *Order) MergeInto(target *Order, actor string) ([]*OrderItem, error) {
+	if err := o.ensureRearrangeable("MergeInto", target); err != nil {
+		return nil, err
+	}
+
+	moved := o.Items
+	o.Items = make([]*OrderItem, 0)
+	target.Items = append(target.Items, moved...)
+	o.touchAfterMove(target)
+
+	o.MergedInto = target.ID
+	o.recordTransition(o.Status, OrderStatusCancelled, actor, "merged into "+target.ID.String(), o.UpdatedAt)
+	o.Status = OrderStatusCancelled
+	return moved, nil
+}
+
+// IsMerged reports whether the order was closed by merging it into another
+func (o *Order) IsMerged() bool {
+	return o.MergedInto != ""
+}
+
+// ensureRearrangeable checks that items may move between the order and target:
+// two different open dine-in checks that have not been paid yet
+func (o *Order) ensureRearrangeable(op string, target *Order) error {
+	if o.ID == target.ID {
+		return errors.WrapValidation(op, "target_order_id", "source and target must be different orders", nil)
+	}
+	for _, order := range []*Order{o, target} {
+		if order.Type != OrderTypeDineIn {
+			return errors.WrapConflict(op, "order_type", "only dine-in checks can be merged or have items moved", nil)
+		}
+		if order.Status != OrderStatusCreated {
+			return errors.WrapConflict(op, "order_status", "order "+order.ID.String()+" is not an open unpaid check", nil)
+		}
+	}
+	return nil
+}
+
+func (o *Order) touchAfterMove(target *Order) {
+	now := time.Now()
+	o.recalculateTotal()
+	target.recalculateTotal()
+	o.UpdatedAt = now
+	target.UpdatedAt = now
+}
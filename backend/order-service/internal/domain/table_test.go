@@ -0,0 +1,137 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/restaurant-platform/shared/pkg/errors"
+)
+
+// TableTestSuite contains table transfer, merge and item move tests
+type TableTestSuite struct {
+	suite.Suite
+	source *Order
+	target *Order
+}
+
+func TestTableTestSuite(t *testing.T) {
+	suite.Run(t, new(TableTestSuite))
+}
+
+func (suite *TableTestSuite) SetupTest() {
+	suite.source, _ = NewOrder("customer-123", OrderTypeDineIn)
+	suite.source.SetTableID("table-4")
+	suite.source.AddItem("burger", "Burger", 2, 10.00, nil, "")
+	suite.source.AddItem("fries", "Fries", 1, 5.00, nil, "")
+
+	suite.target, _ = NewOrder("customer-456", OrderTypeDineIn)
+	suite.target.SetTableID("table-7")
+	suite.target.AddItem("soup", "Soup", 1, 6.00, nil, "")
+}
+
+func (suite *TableTestSuite) TestTransferTable_Success() {
+	// When
+	previous, err := suite.source.TransferTable("table-9")
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	assert.Equal("table-4", previous)
+	assert.Equal("table-9", suite.source.TableID)
+}
+
+func (suite *TableTestSuite) TestTransferTable_SameTable_ShouldFail() {
+	// When
+	_, err := suite.source.TransferTable("table-4")
+
+	// Then
+	assert.True(suite.T(), errors.IsConflictError(err))
+}
+
+func (suite *TableTestSuite) TestTransferTable_Takeout_ShouldFail() {
+	// Given
+	order, _ := NewOrder("customer-123", OrderTypeTakeout)
+
+	// When
+	_, err := order.TransferTable("table-9")
+
+	// Then
+	assert.True(suite.T(), errors.IsConflictError(err))
+}
+
+func (suite *TableTestSuite) TestMoveItemsTo_RecalculatesBothTotals() {
+	// Given
+	fries := suite.source.Items[1]
+
+	// When
+	moved, err := suite.source.MoveItemsTo(suite.target, []OrderItemID{fries.ID})
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	assert.Equal([]*OrderItem{fries}, moved)
+	assert.Len(suite.source.Items, 1)
+	assert.Len(suite.target.Items, 2)
+	assert.Equal(22.00, suite.source.TotalAmount)
+	assert.Equal(12.10, suite.target.TotalAmount)
+}
+
+func (suite *TableTestSuite) TestMoveItemsTo_UnknownItem_ShouldFail() {
+	// When
+	_, err := suite.source.MoveItemsTo(suite.target, []OrderItemID{"itm_unknown"})
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.True(errors.IsNotFound(err))
+	assert.Len(suite.source.Items, 2)
+}
+
+func (suite *TableTestSuite) TestMoveItemsTo_DuplicateItem_ShouldFail() {
+	// Given
+	fries := suite.source.Items[1]
+
+	// When
+	_, err := suite.source.MoveItemsTo(suite.target, []OrderItemID{fries.ID, fries.ID})
+
+	// Then
+	assert.True(suite.T(), errors.IsValidationError(err))
+}
+
+func (suite *TableTestSuite) TestMoveItemsTo_PaidTarget_ShouldFail() {
+	// Given
+	suite.target.UpdateStatus(OrderStatusPaid, "cashier-1", "")
+
+	// When
+	_, err := suite.source.MoveItemsTo(suite.target, []OrderItemID{suite.source.Items[0].ID})
+
+	// Then
+	assert.True(suite.T(), errors.IsConflictError(err))
+}
+
+func (suite *TableTestSuite) TestMergeInto_ClosesSourceAsMerged() {
+	// When
+	moved, err := suite.source.MergeInto(suite.target, "waiter-1")
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	assert.Len(moved, 2)
+	assert.Empty(suite.source.Items)
+	assert.Len(suite.target.Items, 3)
+	assert.Equal(34.10, suite.target.TotalAmount)
+	assert.Equal(OrderStatusCancelled, suite.source.Status)
+	assert.True(suite.source.IsMerged())
+	assert.Equal(suite.target.ID, suite.source.MergedInto)
+	last := suite.source.StatusHistory[len(suite.source.StatusHistory)-1]
+	assert.Equal("waiter-1", last.Actor)
+}
+
+func (suite *TableTestSuite) TestMergeInto_SameOrder_ShouldFail() {
+	// When
+	_, err := suite.source.MergeInto(suite.source, "waiter-1")
+
+	// Then
+	assert.True(suite.T(), errors.IsValidationError(err))
+}
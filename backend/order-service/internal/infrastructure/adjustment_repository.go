@@ -0,0 +1,93 @@
+package infrastructure
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+
+	"github.com/restaurant-platform/order-service/internal/domain"
+)
+
+type AdjustmentRepository struct {
+	db *DB
+}
+
+func NewAdjustmentRepository(db *DB) *AdjustmentRepository {
+	return &AdjustmentRepository{db: db}
+}
+
+func (r *AdjustmentRepository) Create(ctx context.Context, adjustment *domain.Adjustment) error {
+	linesJSON, err := json.Marshal(adjustment.Lines)
+	if err != nil {
+		return fmt.Errorf("failed to marshal adjustment lines: %w", err)
+	}
+
+	query := `
+		INSERT INTO order_adjustments (
+			id, order_id, type, reason, notes, amount, lines,
+			requested_by, approved_by, approval_method, created_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)`
+
+	_, err = r.db.ExecContext(ctx, query,
+		adjustment.ID.String(), adjustment.OrderID.String(), string(adjustment.Type),
+		string(adjustment.Reason), nullString(adjustment.Notes), adjustment.Amount, linesJSON,
+		adjustment.RequestedBy, nullString(adjustment.ApprovedBy), nullString(string(adjustment.ApprovalMethod)),
+		adjustment.CreatedAt)
+
+	return err
+}
+
+func (r *AdjustmentRepository) FindByOrder(ctx context.Context, orderID domain.OrderID) ([]*domain.Adjustment, error) {
+	query := `
+		SELECT id, order_id, type, reason, notes, amount, lines,
+		       requested_by, approved_by, approval_method, created_at
+		FROM order_adjustments WHERE order_id = $1 ORDER BY created_at ASC`
+
+	rows, err := r.db.QueryContext(ctx, query, orderID.String())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var adjustments []*domain.Adjustment
+	for rows.Next() {
+		adjustment, err := scanAdjustment(rows)
+		if err != nil {
+			return nil, err
+		}
+		adjustments = append(adjustments, adjustment)
+	}
+
+	return adjustments, rows.Err()
+}
+
+// Helper methods
+
+func scanAdjustment(row rowScanner) (*domain.Adjustment, error) {
+	var adjustment domain.Adjustment
+	var idStr, orderID, adjustmentType, reason string
+	var notes, approvedBy, approvalMethod sql.NullString
+	var linesJSON []byte
+
+	err := row.Scan(
+		&idStr, &orderID, &adjustmentType, &reason, &notes, &adjustment.Amount, &linesJSON,
+		&adjustment.RequestedBy, &approvedBy, &approvalMethod, &adjustment.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	adjustment.ID = domain.AdjustmentID(idStr)
+	adjustment.OrderID = domain.OrderID(orderID)
+	adjustment.Type = domain.AdjustmentType(adjustmentType)
+	adjustment.Reason = domain.AdjustmentReason(reason)
+	adjustment.Notes = notes.String
+	adjustment.ApprovedBy = approvedBy.String
+	adjustment.ApprovalMethod = domain.ApprovalMethod(approvalMethod.String)
+
+	if err := json.Unmarshal(linesJSON, &adjustment.Lines); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal adjustment lines: %w", err)
+	}
+
+	return &adjustment, nil
+}
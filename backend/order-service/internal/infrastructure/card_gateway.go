@@ -0,0 +1,145 @@
+package infrastructure
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/restaurant-platform/order-service/internal/domain"
+	"github.com/restaurant-platform/shared/pkg/errors"
+)
+
+// CardGatewayProvider charges card tenders through a card processor's HTTP gateway.
+//
+// Every request carries the header Authorization: Bearer <api key>. Amounts are in cents.
+//
+//	POST {base}/charges                {"amount": 1250, "reference": "...", "order_id": "..."}
+//	  -> 2xx {"id": "ch_123", "status": "approved" | "declined", "message": "..."}
+//	POST {base}/charges/{id}/refunds   {"amount": 500}
+//	  -> 2xx {"id": "rf_456"}
+//	POST {base}/charges/{id}/void
+//	  -> 2xx
+//
+// A 404 means the charge is unknown to the processor; any other non-2xx status fails the call.
+type CardGatewayProvider struct {
+	baseURL string
+	apiKey  string
+	client  *http.Client
+}
+
+// NewCardGatewayProvider creates a provider for the card gateway at baseURL
+func NewCardGatewayProvider(baseURL, apiKey string, client *http.Client) (*CardGatewayProvider, error) {
+	if _, err := url.ParseRequestURI(baseURL); err != nil {
+		return nil, fmt.Errorf("card gateway URL is invalid: %w", err)
+	}
+	if apiKey == "" {
+		return nil, fmt.Errorf("card gateway API key is required")
+	}
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	return &CardGatewayProvider{
+		baseURL: strings.TrimRight(baseURL, "/"),
+		apiKey:  apiKey,
+		client:  client,
+	}, nil
+}
+
+type cardChargeRequest struct {
+	Amount    int64  `json:"amount"`
+	Reference string `json:"reference"`
+	OrderID   string `json:"order_id"`
+}
+
+type cardChargeResponse struct {
+	ID      string `json:"id"`
+	Status  string `json:"status"`
+	Message string `json:"message"`
+}
+
+type cardRefundRequest struct {
+	Amount int64 `json:"amount"`
+}
+
+type cardRefundResponse struct {
+	ID string `json:"id"`
+}
+
+// Charge captures funds for a tender
+func (p *CardGatewayProvider) Charge(ctx context.Context, req domain.ChargeRequest) (*domain.ChargeResult, error) {
+	var resp cardChargeResponse
+	err := p.post(ctx, "/charges", cardChargeRequest{
+		Amount:    toCents(req.Amount),
+		Reference: req.Reference,
+		OrderID:   string(req.OrderID),
+	}, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.Status != "approved" {
+		return &domain.ChargeResult{Approved: false, Message: resp.Message}, nil
+	}
+	return &domain.ChargeResult{ProviderRef: resp.ID, Approved: true, Message: resp.Message}, nil
+}
+
+// Refund returns funds for a previously captured charge
+func (p *CardGatewayProvider) Refund(ctx context.Context, providerRef string, amount float64) (string, error) {
+	var resp cardRefundResponse
+	path := "/charges/" + url.PathEscape(providerRef) + "/refunds"
+	if err := p.post(ctx, path, cardRefundRequest{Amount: toCents(amount)}, &resp); err != nil {
+		return "", err
+	}
+	return resp.ID, nil
+}
+
+// Void cancels a previously captured charge before settlement
+func (p *CardGatewayProvider) Void(ctx context.Context, providerRef string) error {
+	return p.post(ctx, "/charges/"+url.PathEscape(providerRef)+"/void", struct{}{}, nil)
+}
+
+func (p *CardGatewayProvider) post(ctx context.Context, path string, body, out interface{}) error {
+	payload, err := json.Marshal(body)
+	if err != nil {
+		return fmt.Errorf("failed to marshal card gateway request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.baseURL+path, bytes.NewReader(payload))
+	if err != nil {
+		return fmt.Errorf("failed to create card gateway request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+p.apiKey)
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to reach card gateway: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return errors.WrapNotFound("CardGatewayProvider", "charge", path, errors.ErrNotFound)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("card gateway rejected %s with %s", path, resp.Status)
+	}
+
+	if out == nil {
+		return nil
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("failed to decode card gateway response: %w", err)
+	}
+	return nil
+}
+
+// toCents converts a monetary amount to whole cents
+func toCents(amount float64) int64 {
+	return int64(math.Round(amount * 100))
+}
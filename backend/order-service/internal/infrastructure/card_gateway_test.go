@@ -0,0 +1,80 @@
+package infrastructure
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/restaurant-platform/order-service/internal/domain"
+	sharedErrors "github.com/restaurant-platform/shared/pkg/errors"
+)
+
+func TestCardGatewayProvider_Charge_Approved(t *testing.T) {
+	// Given
+	var got cardChargeRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/charges", r.URL.Path)
+		assert.Equal(t, "Bearer k3y", r.Header.Get("Authorization"))
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&got))
+		json.NewEncoder(w).Encode(cardChargeResponse{ID: "ch_1", Status: "approved"})
+	}))
+	defer server.Close()
+	provider, err := NewCardGatewayProvider(server.URL, "k3y", server.Client())
+	require.NoError(t, err)
+
+	// When
+	result, err := provider.Charge(context.Background(), domain.ChargeRequest{OrderID: "ord_1", Amount: 12.50, Reference: "visa-4242"})
+
+	// Then
+	require.NoError(t, err)
+	assert.True(t, result.Approved)
+	assert.Equal(t, "ch_1", result.ProviderRef)
+	assert.Equal(t, int64(1250), got.Amount)
+	assert.Equal(t, "ord_1", got.OrderID)
+}
+
+func TestCardGatewayProvider_Charge_Declined(t *testing.T) {
+	// Given
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(cardChargeResponse{Status: "declined", Message: "insufficient funds"})
+	}))
+	defer server.Close()
+	provider, _ := NewCardGatewayProvider(server.URL, "k3y", server.Client())
+
+	// When
+	result, err := provider.Charge(context.Background(), domain.ChargeRequest{Amount: 10})
+
+	// Then
+	require.NoError(t, err)
+	assert.False(t, result.Approved)
+	assert.Equal(t, "insufficient funds", result.Message)
+}
+
+func TestCardGatewayProvider_Void_UnknownCharge_ShouldBeNotFound(t *testing.T) {
+	// Given
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/charges/ch_9/void", r.URL.Path)
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+	provider, _ := NewCardGatewayProvider(server.URL, "k3y", server.Client())
+
+	// When
+	err := provider.Void(context.Background(), "ch_9")
+
+	// Then
+	assert.True(t, sharedErrors.IsNotFound(err))
+}
+
+func TestNewCardGatewayProvider_MissingAPIKey(t *testing.T) {
+	// When
+	_, err := NewCardGatewayProvider("https://gateway.example.com", "", nil)
+
+	// Then
+	assert.Error(t, err)
+}
@@ -0,0 +1,151 @@
+package infrastructure
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/restaurant-platform/order-service/internal/domain"
+	"github.com/restaurant-platform/shared/pkg/errors"
+)
+
+// rowScanner is satisfied by both *sql.Row and *sql.Rows
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+type DeliveryRepository struct {
+	db *DB
+}
+
+func NewDeliveryRepository(db *DB) *DeliveryRepository {
+	return &DeliveryRepository{db: db}
+}
+
+func (r *DeliveryRepository) Create(ctx context.Context, delivery *domain.Delivery) error {
+	query := `
+		INSERT INTO deliveries (
+			id, order_id, zone_id, driver_id, address, latitude, longitude, fee, status,
+			estimated_arrival, assigned_at, picked_up_at, delivered_at, version, created_at, updated_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16)`
+
+	_, err := r.db.ExecContext(ctx, query,
+		delivery.ID.String(), delivery.OrderID.String(), delivery.ZoneID.String(),
+		nullString(delivery.DriverID.String()), delivery.Address,
+		delivery.Location.Lat, delivery.Location.Lng, delivery.Fee, string(delivery.Status),
+		nullTime(delivery.EstimatedArrival), nullTime(delivery.AssignedAt),
+		nullTime(delivery.PickedUpAt), nullTime(delivery.DeliveredAt),
+		delivery.Version, delivery.CreatedAt, delivery.UpdatedAt)
+
+	return err
+}
+
+func (r *DeliveryRepository) GetByID(ctx context.Context, id domain.DeliveryID) (*domain.Delivery, error) {
+	query := `
+		SELECT id, order_id, zone_id, driver_id, address, latitude, longitude, fee, status,
+		       estimated_arrival, assigned_at, picked_up_at, delivered_at, version, created_at, updated_at
+		FROM deliveries WHERE id = $1`
+
+	delivery, err := scanDelivery(r.db.QueryRowContext(ctx, query, id.String()))
+	if err == sql.ErrNoRows {
+		return nil, errors.WrapNotFound("DeliveryRepository.GetByID", "delivery", id.String(), err)
+	}
+	return delivery, err
+}
+
+func (r *DeliveryRepository) GetByOrderID(ctx context.Context, orderID domain.OrderID) (*domain.Delivery, error) {
+	query := `
+		SELECT id, order_id, zone_id, driver_id, address, latitude, longitude, fee, status,
+		       estimated_arrival, assigned_at, picked_up_at, delivered_at, version, created_at, updated_at
+		FROM deliveries WHERE order_id = $1`
+
+	delivery, err := scanDelivery(r.db.QueryRowContext(ctx, query, orderID.String()))
+	if err == sql.ErrNoRows {
+		return nil, errors.WrapNotFound("DeliveryRepository.GetByOrderID", "delivery", orderID.String(), err)
+	}
+	return delivery, err
+}
+
+func (r *DeliveryRepository) Update(ctx context.Context, delivery *domain.Delivery) error {
+	query := `
+		UPDATE deliveries
+		SET driver_id = $2, status = $3, estimated_arrival = $4, assigned_at = $5,
+		    picked_up_at = $6, delivered_at = $7, updated_at = $8, version = version + 1
+		WHERE id = $1 AND version = $9`
+
+	result, err := r.db.ExecContext(ctx, query,
+		delivery.ID.String(), nullString(delivery.DriverID.String()), string(delivery.Status),
+		nullTime(delivery.EstimatedArrival), nullTime(delivery.AssignedAt),
+		nullTime(delivery.PickedUpAt), nullTime(delivery.DeliveredAt), delivery.UpdatedAt, delivery.Version)
+	if err != nil {
+		return err
+	}
+
+	// No row matched: another writer saved a newer version since this delivery was loaded
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return errors.WrapVersionConflict("DeliveryRepository.Update", "delivery", delivery.ID.String(), delivery.Version)
+	}
+
+	delivery.Version++
+	return nil
+}
+
+func (r *DeliveryRepository) FindByDriver(ctx context.Context, driverID domain.DriverID) ([]*domain.Delivery, error) {
+	query := `
+		SELECT id, order_id, zone_id, driver_id, address, latitude, longitude, fee, status,
+		       estimated_arrival, assigned_at, picked_up_at, delivered_at, version, created_at, updated_at
+		FROM deliveries WHERE driver_id = $1
+		ORDER BY created_at DESC`
+
+	rows, err := r.db.QueryContext(ctx, query, driverID.String())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var deliveries []*domain.Delivery
+	for rows.Next() {
+		delivery, err := scanDelivery(rows)
+		if err != nil {
+			return nil, err
+		}
+		deliveries = append(deliveries, delivery)
+	}
+
+	return deliveries, rows.Err()
+}
+
+// Helper methods
+
+func scanDelivery(row rowScanner) (*domain.Delivery, error) {
+	var delivery domain.Delivery
+	var idStr, orderID, zoneID, status string
+	var driverID sql.NullString
+	var estimatedArrival, assignedAt, pickedUpAt, deliveredAt sql.NullTime
+
+	err := row.Scan(
+		&idStr, &orderID, &zoneID, &driverID, &delivery.Address,
+		&delivery.Location.Lat, &delivery.Location.Lng, &delivery.Fee, &status,
+		&estimatedArrival, &assignedAt, &pickedUpAt, &deliveredAt, &delivery.Version,
+		&delivery.CreatedAt, &delivery.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	delivery.ID = domain.DeliveryID(idStr)
+	delivery.OrderID = domain.OrderID(orderID)
+	delivery.ZoneID = domain.DeliveryZoneID(zoneID)
+	delivery.Status = domain.DeliveryStatus(status)
+	if driverID.Valid {
+		delivery.DriverID = domain.DriverID(driverID.String)
+	}
+	delivery.EstimatedArrival = timePtr(estimatedArrival)
+	delivery.AssignedAt = timePtr(assignedAt)
+	delivery.PickedUpAt = timePtr(pickedUpAt)
+	delivery.DeliveredAt = timePtr(deliveredAt)
+
+	return &delivery, nil
+}
@@ -0,0 +1,112 @@
+package infrastructure
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+
+	"github.com/restaurant-platform/order-service/internal/domain"
+	"github.com/restaurant-platform/shared/pkg/errors"
+)
+
+type DeliveryZoneRepository struct {
+	db *DB
+}
+
+func NewDeliveryZoneRepository(db *DB) *DeliveryZoneRepository {
+	return &DeliveryZoneRepository{db: db}
+}
+
+func (r *DeliveryZoneRepository) Create(ctx context.Context, zone *domain.DeliveryZone) error {
+	boundaryJSON, err := json.Marshal(zone.Boundary)
+	if err != nil {
+		return fmt.Errorf("failed to marshal zone boundary: %w", err)
+	}
+
+	query := `
+		INSERT INTO delivery_zones (
+			id, name, boundary, fee, minimum_order, is_active, created_at, updated_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`
+
+	_, err = r.db.ExecContext(ctx, query,
+		zone.ID.String(), zone.Name, boundaryJSON, zone.Fee, zone.MinimumOrder,
+		zone.IsActive, zone.CreatedAt, zone.UpdatedAt)
+
+	return err
+}
+
+func (r *DeliveryZoneRepository) GetByID(ctx context.Context, id domain.DeliveryZoneID) (*domain.DeliveryZone, error) {
+	query := `
+		SELECT id, name, boundary, fee, minimum_order, is_active, created_at, updated_at
+		FROM delivery_zones WHERE id = $1`
+
+	zone, err := scanDeliveryZone(r.db.QueryRowContext(ctx, query, id.String()))
+	if err == sql.ErrNoRows {
+		return nil, errors.WrapNotFound("DeliveryZoneRepository.GetByID", "delivery_zone", id.String(), err)
+	}
+	return zone, err
+}
+
+func (r *DeliveryZoneRepository) Update(ctx context.Context, zone *domain.DeliveryZone) error {
+	boundaryJSON, err := json.Marshal(zone.Boundary)
+	if err != nil {
+		return fmt.Errorf("failed to marshal zone boundary: %w", err)
+	}
+
+	query := `
+		UPDATE delivery_zones
+		SET name = $2, boundary = $3, fee = $4, minimum_order = $5, is_active = $6, updated_at = $7
+		WHERE id = $1`
+
+	_, err = r.db.ExecContext(ctx, query,
+		zone.ID.String(), zone.Name, boundaryJSON, zone.Fee, zone.MinimumOrder,
+		zone.IsActive, zone.UpdatedAt)
+
+	return err
+}
+
+func (r *DeliveryZoneRepository) List(ctx context.Context) ([]*domain.DeliveryZone, error) {
+	query := `
+		SELECT id, name, boundary, fee, minimum_order, is_active, created_at, updated_at
+		FROM delivery_zones ORDER BY created_at ASC`
+
+	rows, err := r.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var zones []*domain.DeliveryZone
+	for rows.Next() {
+		zone, err := scanDeliveryZone(rows)
+		if err != nil {
+			return nil, err
+		}
+		zones = append(zones, zone)
+	}
+
+	return zones, rows.Err()
+}
+
+// Helper methods
+
+func scanDeliveryZone(row rowScanner) (*domain.DeliveryZone, error) {
+	var zone domain.DeliveryZone
+	var idStr string
+	var boundaryJSON []byte
+
+	err := row.Scan(
+		&idStr, &zone.Name, &boundaryJSON, &zone.Fee, &zone.MinimumOrder,
+		&zone.IsActive, &zone.CreatedAt, &zone.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	zone.ID = domain.DeliveryZoneID(idStr)
+	if err := json.Unmarshal(boundaryJSON, &zone.Boundary); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal zone boundary: %w", err)
+	}
+
+	return &zone, nil
+}
@@ -0,0 +1,190 @@
+package infrastructure
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+
+	"github.com/restaurant-platform/order-service/internal/domain"
+	"github.com/restaurant-platform/shared/pkg/errors"
+)
+
+type DrawerSessionRepository struct {
+	db *DB
+}
+
+func NewDrawerSessionRepository(db *DB) *DrawerSessionRepository {
+	return &DrawerSessionRepository{db: db}
+}
+
+// Create adds the session unless its cashier already has an open one, which the
+// partial unique index on open sessions guards against
+func (r *DrawerSessionRepository) Create(ctx context.Context, session *domain.DrawerSession) error {
+	movementsJSON, reportJSON, err := marshalDrawerSession(session)
+	if err != nil {
+		return err
+	}
+
+	query := `
+		INSERT INTO drawer_sessions (
+			id, cashier_id, status, starting_float, movements, report, opened_at, closed_at,
+			signed_off_by, signed_off_at, sign_off_notes, version, updated_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+		ON CONFLICT DO NOTHING`
+
+	result, err := r.db.ExecContext(ctx, query,
+		session.ID.String(), session.CashierID, string(session.Status), session.StartingFloat,
+		movementsJSON, reportJSON, session.OpenedAt, nullTime(session.ClosedAt),
+		nullString(session.SignedOffBy), nullTime(session.SignedOffAt), nullString(session.SignOffNotes),
+		session.Version, session.UpdatedAt)
+	if err != nil {
+		return err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return errors.WrapConflict("DrawerSessionRepository.Create", "cashier_id",
+			"cashier already has an open drawer session", nil)
+	}
+	return nil
+}
+
+func (r *DrawerSessionRepository) GetByID(ctx context.Context, id domain.DrawerSessionID) (*domain.DrawerSession, error) {
+	query := `
+		SELECT id, cashier_id, status, starting_float, movements, report, opened_at, closed_at,
+		       signed_off_by, signed_off_at, sign_off_notes, version, updated_at
+		FROM drawer_sessions WHERE id = $1`
+
+	session, err := scanDrawerSession(r.db.QueryRowContext(ctx, query, id.String()))
+	if err == sql.ErrNoRows {
+		return nil, errors.WrapNotFound("DrawerSessionRepository.GetByID", "drawer session", id.String(), err)
+	}
+	return session, err
+}
+
+func (r *DrawerSessionRepository) GetOpenByCashier(ctx context.Context, cashierID string) (*domain.DrawerSession, error) {
+	query := `
+		SELECT id, cashier_id, status, starting_float, movements, report, opened_at, closed_at,
+		       signed_off_by, signed_off_at, sign_off_notes, version, updated_at
+		FROM drawer_sessions WHERE cashier_id = $1 AND status = 'OPEN'`
+
+	session, err := scanDrawerSession(r.db.QueryRowContext(ctx, query, cashierID))
+	if err == sql.ErrNoRows {
+		return nil, errors.WrapNotFound("DrawerSessionRepository.GetOpenByCashier", "drawer session", cashierID, err)
+	}
+	return session, err
+}
+
+func (r *DrawerSessionRepository) FindByStatus(ctx context.Context, status domain.DrawerSessionStatus) ([]*domain.DrawerSession, error) {
+	query := `
+		SELECT id, cashier_id, status, starting_float, movements, report, opened_at, closed_at,
+		       signed_off_by, signed_off_at, sign_off_notes, version, updated_at
+		FROM drawer_sessions WHERE status = $1
+		ORDER BY opened_at DESC`
+
+	rows, err := r.db.QueryContext(ctx, query, string(status))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var sessions []*domain.DrawerSession
+	for rows.Next() {
+		session, err := scanDrawerSession(rows)
+		if err != nil {
+			return nil, err
+		}
+		sessions = append(sessions, session)
+	}
+
+	return sessions, rows.Err()
+}
+
+// Update saves the session only if it is still at the version it was loaded at
+func (r *DrawerSessionRepository) Update(ctx context.Context, session *domain.DrawerSession) error {
+	movementsJSON, reportJSON, err := marshalDrawerSession(session)
+	if err != nil {
+		return err
+	}
+
+	query := `
+		UPDATE drawer_sessions
+		SET status = $2, movements = $3, report = $4, closed_at = $5, signed_off_by = $6,
+		    signed_off_at = $7, sign_off_notes = $8, updated_at = $9, version = version + 1
+		WHERE id = $1 AND version = $10`
+
+	result, err := r.db.ExecContext(ctx, query,
+		session.ID.String(), string(session.Status), movementsJSON, reportJSON, nullTime(session.ClosedAt),
+		nullString(session.SignedOffBy), nullTime(session.SignedOffAt), nullString(session.SignOffNotes),
+		session.UpdatedAt, session.Version)
+	if err != nil {
+		return err
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return errors.WrapVersionConflict("DrawerSessionRepository.Update", "drawer session", session.ID.String(), session.Version)
+	}
+
+	session.Version++
+	return nil
+}
+
+// Helper methods
+
+func marshalDrawerSession(session *domain.DrawerSession) ([]byte, []byte, error) {
+	movementsJSON, err := json.Marshal(session.Movements)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to marshal drawer movements: %w", err)
+	}
+
+	var reportJSON []byte
+	if session.Report != nil {
+		if reportJSON, err = json.Marshal(session.Report); err != nil {
+			return nil, nil, fmt.Errorf("failed to marshal drawer report: %w", err)
+		}
+	}
+
+	return movementsJSON, reportJSON, nil
+}
+
+func scanDrawerSession(row rowScanner) (*domain.DrawerSession, error) {
+	var session domain.DrawerSession
+	var idStr, status string
+	var movementsJSON, reportJSON []byte
+	var signedOffBy, signOffNotes sql.NullString
+	var closedAt, signedOffAt sql.NullTime
+
+	err := row.Scan(
+		&idStr, &session.CashierID, &status, &session.StartingFloat, &movementsJSON, &reportJSON,
+		&session.OpenedAt, &closedAt, &signedOffBy, &signedOffAt, &signOffNotes,
+		&session.Version, &session.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	session.ID = domain.DrawerSessionID(idStr)
+	session.Status = domain.DrawerSessionStatus(status)
+	session.ClosedAt = timePtr(closedAt)
+	session.SignedOffBy = signedOffBy.String
+	session.SignedOffAt = timePtr(signedOffAt)
+	session.SignOffNotes = signOffNotes.String
+
+	if err := json.Unmarshal(movementsJSON, &session.Movements); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal drawer movements: %w", err)
+	}
+	if len(reportJSON) > 0 {
+		if err := json.Unmarshal(reportJSON, &session.Report); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal drawer report: %w", err)
+		}
+	}
+
+	return &session, nil
+}
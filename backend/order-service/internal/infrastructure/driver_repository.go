@@ -0,0 +1,118 @@
+package infrastructure
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/restaurant-platform/order-service/internal/domain"
+	"github.com/restaurant-platform/shared/pkg/errors"
+)
+
+type DriverRepository struct {
+	db *DB
+}
+
+func NewDriverRepository(db *DB) *DriverRepository {
+	return &DriverRepository{db: db}
+}
+
+func (r *DriverRepository) Create(ctx context.Context, driver *domain.Driver) error {
+	query := `
+		INSERT INTO drivers (id, name, phone, status, version, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)`
+
+	_, err := r.db.ExecContext(ctx, query,
+		driver.ID.String(), driver.Name, driver.Phone, string(driver.Status),
+		driver.Version, driver.CreatedAt, driver.UpdatedAt)
+
+	return err
+}
+
+func (r *DriverRepository) GetByID(ctx context.Context, id domain.DriverID) (*domain.Driver, error) {
+	query := `
+		SELECT id, name, phone, status, version, created_at, updated_at
+		FROM drivers WHERE id = $1`
+
+	driver, err := scanDriver(r.db.QueryRowContext(ctx, query, id.String()))
+	if err == sql.ErrNoRows {
+		return nil, errors.WrapNotFound("DriverRepository.GetByID", "driver", id.String(), err)
+	}
+	return driver, err
+}
+
+func (r *DriverRepository) Update(ctx context.Context, driver *domain.Driver) error {
+	query := `
+		UPDATE drivers
+		SET name = $2, phone = $3, status = $4, updated_at = $5, version = version + 1
+		WHERE id = $1 AND version = $6`
+
+	result, err := r.db.ExecContext(ctx, query,
+		driver.ID.String(), driver.Name, driver.Phone, string(driver.Status), driver.UpdatedAt, driver.Version)
+	if err != nil {
+		return err
+	}
+
+	// No row matched: another writer saved a newer version since this driver was loaded
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return errors.WrapVersionConflict("DriverRepository.Update", "driver", driver.ID.String(), driver.Version)
+	}
+
+	driver.Version++
+	return nil
+}
+
+func (r *DriverRepository) List(ctx context.Context) ([]*domain.Driver, error) {
+	query := `
+		SELECT id, name, phone, status, version, created_at, updated_at
+		FROM drivers ORDER BY name ASC`
+
+	return r.queryDrivers(ctx, query)
+}
+
+func (r *DriverRepository) FindAvailable(ctx context.Context) ([]*domain.Driver, error) {
+	query := `
+		SELECT id, name, phone, status, version, created_at, updated_at
+		FROM drivers WHERE status = $1
+		ORDER BY updated_at ASC`
+
+	return r.queryDrivers(ctx, query, string(domain.DriverStatusAvailable))
+}
+
+// Helper methods
+
+func (r *DriverRepository) queryDrivers(ctx context.Context, query string, args ...interface{}) ([]*domain.Driver, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var drivers []*domain.Driver
+	for rows.Next() {
+		driver, err := scanDriver(rows)
+		if err != nil {
+			return nil, err
+		}
+		drivers = append(drivers, driver)
+	}
+
+	return drivers, rows.Err()
+}
+
+func scanDriver(row rowScanner) (*domain.Driver, error) {
+	var driver domain.Driver
+	var idStr, status string
+
+	err := row.Scan(&idStr, &driver.Name, &driver.Phone, &status, &driver.Version, &driver.CreatedAt, &driver.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	driver.ID = domain.DriverID(idStr)
+	driver.Status = domain.DriverStatus(status)
+	return &driver, nil
+}
@@ -0,0 +1,47 @@
+package infrastructure
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	"github.com/restaurant-platform/order-service/internal/domain"
+	"github.com/restaurant-platform/shared/pkg/errors"
+)
+
+// FakeGeocoder is a deterministic in-memory geocoder for tests.
+// Addresses resolve only once registered with Register; lookups ignore case and surrounding spaces.
+type FakeGeocoder struct {
+	mu        sync.RWMutex
+	addresses map[string]domain.Coordinates
+}
+
+// NewFakeGeocoder creates a new fake geocoder
+func NewFakeGeocoder() *FakeGeocoder {
+	return &FakeGeocoder{
+		addresses: make(map[string]domain.Coordinates),
+	}
+}
+
+// Register makes an address resolve to the given coordinates
+func (g *FakeGeocoder) Register(address string, location domain.Coordinates) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.addresses[normalizeAddress(address)] = location
+}
+
+// Geocode returns the coordinates of a registered address
+func (g *FakeGeocoder) Geocode(ctx context.Context, address string) (domain.Coordinates, error) {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	location, ok := g.addresses[normalizeAddress(address)]
+	if !ok {
+		return domain.Coordinates{}, errors.WrapValidation("FakeGeocoder.Geocode", "address", "address could not be geocoded", nil)
+	}
+	return location, nil
+}
+
+func normalizeAddress(address string) string {
+	return strings.ToLower(strings.TrimSpace(address))
+}
@@ -0,0 +1,89 @@
+package infrastructure
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+
+	"github.com/restaurant-platform/order-service/internal/domain"
+	"github.com/restaurant-platform/shared/pkg/errors"
+)
+
+// FakeMarketplaceSignatureHeader carries the signature of fake marketplace requests
+const FakeMarketplaceSignatureHeader = "X-Fake-Signature"
+
+// FakeMarketplace is a deterministic in-memory marketplace.
+// Payloads are domain.MarketplaceOrder JSON signed with the fake's secret as is,
+// and status updates are recorded instead of being sent.
+type FakeMarketplace struct {
+	mu      sync.Mutex
+	name    string
+	secret  string
+	pushErr error
+	pushed  []FakeMarketplacePush
+}
+
+// FakeMarketplacePush is a status update the fake marketplace received
+type FakeMarketplacePush struct {
+	ExternalID string
+	Status     domain.MarketplaceOrderStatus
+	Reason     string
+}
+
+// NewFakeMarketplace creates a new fake marketplace accepting payloads signed with the secret
+func NewFakeMarketplace(name, secret string) *FakeMarketplace {
+	return &FakeMarketplace{name: name, secret: secret}
+}
+
+// FailPushes makes every future status update fail with the given error; nil restores them
+func (m *FakeMarketplace) FailPushes(err error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.pushErr = err
+}
+
+// Pushed returns the status updates received so far
+func (m *FakeMarketplace) Pushed() []FakeMarketplacePush {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]FakeMarketplacePush(nil), m.pushed...)
+}
+
+// Name identifies the marketplace
+func (m *FakeMarketplace) Name() string {
+	return m.name
+}
+
+// SignatureHeader is the request header carrying the webhook signature
+func (m *FakeMarketplace) SignatureHeader() string {
+	return FakeMarketplaceSignatureHeader
+}
+
+// VerifySignature accepts payloads whose signature is the secret
+func (m *FakeMarketplace) VerifySignature(payload []byte, signature string) error {
+	if signature != m.secret {
+		return errors.WrapUnauthorized("FakeMarketplace.VerifySignature", "invalid webhook signature", nil)
+	}
+	return nil
+}
+
+// ParseOrder decodes a domain.MarketplaceOrder
+func (m *FakeMarketplace) ParseOrder(payload []byte) (*domain.MarketplaceOrder, error) {
+	var order domain.MarketplaceOrder
+	if err := json.Unmarshal(payload, &order); err != nil {
+		return nil, errors.WrapValidation("FakeMarketplace.ParseOrder", "payload", "malformed order: "+err.Error(), nil)
+	}
+	return &order, nil
+}
+
+// PushStatus records a status update
+func (m *FakeMarketplace) PushStatus(ctx context.Context, externalID string, status domain.MarketplaceOrderStatus, reason string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.pushErr != nil {
+		return m.pushErr
+	}
+	m.pushed = append(m.pushed, FakeMarketplacePush{ExternalID: externalID, Status: status, Reason: reason})
+	return nil
+}
@@ -0,0 +1,98 @@
+package infrastructure
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/restaurant-platform/order-service/internal/domain"
+	"github.com/restaurant-platform/shared/pkg/errors"
+)
+
+// FakePaymentProvider is a deterministic in-memory payment provider for tests.
+// Charges are approved unless their reference was registered with Decline,
+// and provider references are issued sequentially.
+type FakePaymentProvider struct {
+	mu       sync.Mutex
+	sequence int
+	declined map[string]bool
+	charges  map[string]float64
+	voided   map[string]bool
+}
+
+// NewFakePaymentProvider creates a new fake payment provider
+func NewFakePaymentProvider() *FakePaymentProvider {
+	return &FakePaymentProvider{
+		declined: make(map[string]bool),
+		charges:  make(map[string]float64),
+		voided:   make(map[string]bool),
+	}
+}
+
+// Decline makes every future charge carrying the given reference fail
+func (p *FakePaymentProvider) Decline(reference string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.declined[reference] = true
+}
+
+// Charge captures funds for a tender
+func (p *FakePaymentProvider) Charge(ctx context.Context, req domain.ChargeRequest) (*domain.ChargeResult, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.declined[req.Reference] {
+		return &domain.ChargeResult{Approved: false, Message: "declined"}, nil
+	}
+
+	p.sequence++
+	ref := fmt.Sprintf("fake_ch_%06d", p.sequence)
+	p.charges[ref] = req.Amount
+
+	return &domain.ChargeResult{ProviderRef: ref, Approved: true, Message: "approved"}, nil
+}
+
+// Refund returns funds for a previously captured charge
+func (p *FakePaymentProvider) Refund(ctx context.Context, providerRef string, amount float64) (string, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	captured, ok := p.charges[providerRef]
+	if !ok || p.voided[providerRef] {
+		return "", errors.WrapNotFound("FakePaymentProvider.Refund", "charge", providerRef, errors.ErrNotFound)
+	}
+	if amount > captured {
+		return "", errors.WrapValidation("FakePaymentProvider.Refund", "amount", "refund exceeds captured amount", nil)
+	}
+
+	p.charges[providerRef] = captured - amount
+	p.sequence++
+	return fmt.Sprintf("fake_rf_%06d", p.sequence), nil
+}
+
+// Void cancels a previously captured charge before settlement
+func (p *FakePaymentProvider) Void(ctx context.Context, providerRef string) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if _, ok := p.charges[providerRef]; !ok {
+		return errors.WrapNotFound("FakePaymentProvider.Void", "charge", providerRef, errors.ErrNotFound)
+	}
+
+	p.voided[providerRef] = true
+	return nil
+}
+
+// IsVoided reports whether a charge has been voided
+func (p *FakePaymentProvider) IsVoided(providerRef string) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.voided[providerRef]
+}
+
+// ChargeCount returns how many charges have been approved
+func (p *FakePaymentProvider) ChargeCount() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return len(p.charges)
+}
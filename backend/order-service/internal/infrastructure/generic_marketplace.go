@@ -0,0 +1,208 @@
+package infrastructure
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/restaurant-platform/order-service/internal/domain"
+	"github.com/restaurant-platform/shared/pkg/errors"
+)
+
+// GenericMarketplaceSignatureHeader carries the signature of generic marketplace requests
+const GenericMarketplaceSignatureHeader = "X-Marketplace-Signature"
+
+// GenericMarketplaceAdapter speaks a documented JSON format that marketplaces without an
+// adapter of their own, or an integration middleware, can send orders in.
+//
+// Orders are POSTed to /api/v1/marketplaces/{name}/orders:
+//
+//	{
+//	  "order_id": "A-1001",                 // the marketplace's order ID, required
+//	  "type": "DELIVERY",                   // DELIVERY, or PICKUP/TAKEOUT for collection
+//	  "customer": {"name": "Ada", "phone": "+1 555 0100"},
+//	  "delivery_address": "1 Main St",      // required for DELIVERY
+//	  "notes": "ring the bell",
+//	  "placed_at": "2024-05-01T18:30:00Z",  // RFC 3339
+//	  "items": [
+//	    {"id": "mk-burger", "name": "Burger", "quantity": 2, "unit_price": 12.50,
+//	     "modifications": ["no onions"], "notes": "well done"}
+//	  ]
+//	}
+//
+// Item IDs are the marketplace's own and are mapped to menu items per marketplace. Unit
+// prices are what the customer paid for one unit.
+//
+// Every request carries the header X-Marketplace-Signature: sha256=<hex>, the HMAC-SHA256
+// of the raw body keyed with the shared secret. Status updates are POSTed, signed the same
+// way, to the configured status URL:
+//
+//	{"order_id": "A-1001", "status": "ACCEPTED", "reason": "", "updated_at": "2024-05-01T18:30:02Z"}
+//
+// where status is ACCEPTED, REJECTED, PREPARING, READY, OUT_FOR_DELIVERY, COMPLETED or
+// CANCELLED and reason explains a rejection or cancellation.
+type GenericMarketplaceAdapter struct {
+	name      string
+	secret    []byte
+	statusURL string
+	client    *http.Client
+}
+
+// NewGenericMarketplaceAdapter creates an adapter for a marketplace using the generic format.
+// Without a status URL, status updates are not sent.
+func NewGenericMarketplaceAdapter(name, secret, statusURL string, client *http.Client) (*GenericMarketplaceAdapter, error) {
+	if name == "" {
+		return nil, fmt.Errorf("marketplace name is required")
+	}
+	if secret == "" {
+		return nil, fmt.Errorf("marketplace %s: webhook secret is required", name)
+	}
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	return &GenericMarketplaceAdapter{
+		name:      name,
+		secret:    []byte(secret),
+		statusURL: statusURL,
+		client:    client,
+	}, nil
+}
+
+// genericOrderPayload is an order in the generic marketplace format
+type genericOrderPayload struct {
+	OrderID  string `json:"order_id"`
+	Type     string `json:"type"`
+	Customer struct {
+		Name  string `json:"name"`
+		Phone string `json:"phone"`
+	} `json:"customer"`
+	DeliveryAddress string               `json:"delivery_address"`
+	Notes           string               `json:"notes"`
+	PlacedAt        time.Time            `json:"placed_at"`
+	Items           []genericItemPayload `json:"items"`
+}
+
+type genericItemPayload struct {
+	ID            string   `json:"id"`
+	Name          string   `json:"name"`
+	Quantity      int      `json:"quantity"`
+	UnitPrice     float64  `json:"unit_price"`
+	Modifications []string `json:"modifications"`
+	Notes         string   `json:"notes"`
+}
+
+// genericStatusPayload is a status update in the generic marketplace format
+type genericStatusPayload struct {
+	OrderID   string    `json:"order_id"`
+	Status    string    `json:"status"`
+	Reason    string    `json:"reason"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// Name identifies the marketplace
+func (a *GenericMarketplaceAdapter) Name() string {
+	return a.name
+}
+
+// SignatureHeader is the request header carrying the webhook signature
+func (a *GenericMarketplaceAdapter) SignatureHeader() string {
+	return GenericMarketplaceSignatureHeader
+}
+
+// Sign returns the signature of a payload
+func (a *GenericMarketplaceAdapter) Sign(payload []byte) string {
+	mac := hmac.New(sha256.New, a.secret)
+	mac.Write(payload)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// VerifySignature checks that the payload was signed with the shared secret
+func (a *GenericMarketplaceAdapter) VerifySignature(payload []byte, signature string) error {
+	if signature == "" {
+		return errors.WrapUnauthorized("GenericMarketplaceAdapter.VerifySignature", "missing webhook signature", nil)
+	}
+	if !hmac.Equal([]byte(signature), []byte(a.Sign(payload))) {
+		return errors.WrapUnauthorized("GenericMarketplaceAdapter.VerifySignature", "invalid webhook signature", nil)
+	}
+	return nil
+}
+
+// ParseOrder normalises an order in the generic format
+func (a *GenericMarketplaceAdapter) ParseOrder(payload []byte) (*domain.MarketplaceOrder, error) {
+	var body genericOrderPayload
+	if err := json.Unmarshal(payload, &body); err != nil {
+		return nil, errors.WrapValidation("GenericMarketplaceAdapter.ParseOrder", "payload", "malformed order: "+err.Error(), nil)
+	}
+
+	orderType := domain.OrderType(strings.ToUpper(body.Type))
+	if orderType == "PICKUP" {
+		orderType = domain.OrderTypeTakeout
+	}
+
+	order := &domain.MarketplaceOrder{
+		Marketplace:     a.name,
+		ExternalID:      body.OrderID,
+		Type:            orderType,
+		CustomerName:    body.Customer.Name,
+		CustomerPhone:   body.Customer.Phone,
+		DeliveryAddress: body.DeliveryAddress,
+		Notes:           body.Notes,
+		Items:           make([]*domain.MarketplaceOrderItem, len(body.Items)),
+		PlacedAt:        body.PlacedAt,
+	}
+	for i, item := range body.Items {
+		order.Items[i] = &domain.MarketplaceOrderItem{
+			ExternalItemID: item.ID,
+			Name:           item.Name,
+			Quantity:       item.Quantity,
+			UnitPrice:      item.UnitPrice,
+			Modifications:  item.Modifications,
+			Notes:          item.Notes,
+		}
+	}
+
+	return order, nil
+}
+
+// PushStatus POSTs a signed status update to the marketplace's status URL
+func (a *GenericMarketplaceAdapter) PushStatus(ctx context.Context, externalID string, status domain.MarketplaceOrderStatus, reason string) error {
+	if a.statusURL == "" {
+		return nil
+	}
+
+	payload, err := json.Marshal(genericStatusPayload{
+		OrderID:   externalID,
+		Status:    string(status),
+		Reason:    reason,
+		UpdatedAt: time.Now().UTC(),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to marshal status update: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, a.statusURL, bytes.NewReader(payload))
+	if err != nil {
+		return fmt.Errorf("failed to create status request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(GenericMarketplaceSignatureHeader, a.Sign(payload))
+
+	resp, err := a.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send status update: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("marketplace %s rejected status update with %s", a.name, resp.Status)
+	}
+	return nil
+}
@@ -0,0 +1,133 @@
+package infrastructure
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/restaurant-platform/order-service/internal/domain"
+	sharedErrors "github.com/restaurant-platform/shared/pkg/errors"
+)
+
+// GenericMarketplaceTestSuite parses and verifies orders in the generic marketplace format
+type GenericMarketplaceTestSuite struct {
+	suite.Suite
+	adapter *GenericMarketplaceAdapter
+	payload []byte
+}
+
+func TestGenericMarketplaceTestSuite(t *testing.T) {
+	suite.Run(t, new(GenericMarketplaceTestSuite))
+}
+
+func (suite *GenericMarketplaceTestSuite) SetupTest() {
+	adapter, err := NewGenericMarketplaceAdapter("generic", "s3cret", "", nil)
+	suite.Require().NoError(err)
+	suite.adapter = adapter
+
+	payload, err := os.ReadFile("testdata/marketplace/generic_order.json")
+	suite.Require().NoError(err)
+	suite.payload = payload
+}
+
+func (suite *GenericMarketplaceTestSuite) TestVerifySignature_Valid() {
+	// When
+	err := suite.adapter.VerifySignature(suite.payload, suite.adapter.Sign(suite.payload))
+
+	// Then
+	assert.New(suite.T()).NoError(err)
+}
+
+func (suite *GenericMarketplaceTestSuite) TestVerifySignature_WrongSecret_ShouldBeUnauthorized() {
+	// Given
+	other, _ := NewGenericMarketplaceAdapter("generic", "other", "", nil)
+
+	// When
+	err := suite.adapter.VerifySignature(suite.payload, other.Sign(suite.payload))
+
+	// Then
+	assert.New(suite.T()).True(sharedErrors.IsUnauthorizedError(err))
+}
+
+func (suite *GenericMarketplaceTestSuite) TestVerifySignature_Missing_ShouldBeUnauthorized() {
+	// When
+	err := suite.adapter.VerifySignature(suite.payload, "")
+
+	// Then
+	assert.New(suite.T()).True(sharedErrors.IsUnauthorizedError(err))
+}
+
+func (suite *GenericMarketplaceTestSuite) TestParseOrder_NormalisesPickupOrder() {
+	// When
+	order, err := suite.adapter.ParseOrder(suite.payload)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	assert.NoError(order.Validate())
+	assert.Equal("A-1001", order.ExternalID)
+	assert.Equal(domain.OrderTypeTakeout, order.Type)
+	assert.Equal("Ada", order.CustomerName)
+	assert.Equal(time.Date(2024, 5, 1, 18, 30, 0, 0, time.UTC), order.PlacedAt)
+	assert.Len(order.Items, 2)
+	assert.Equal("mk-burger", order.Items[0].ExternalItemID)
+	assert.Equal(2, order.Items[0].Quantity)
+	assert.Equal(12.50, order.Items[0].UnitPrice)
+	assert.Equal([]string{"no onions"}, order.Items[0].Modifications)
+}
+
+func (suite *GenericMarketplaceTestSuite) TestParseOrder_MalformedJSON_ShouldFailValidation() {
+	// When
+	_, err := suite.adapter.ParseOrder([]byte(`{"order_id":`))
+
+	// Then
+	assert.New(suite.T()).True(sharedErrors.IsValidationError(err))
+}
+
+func (suite *GenericMarketplaceTestSuite) TestPushStatus_PostsSignedUpdate() {
+	// Given
+	var received genericStatusPayload
+	var signed bool
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		signed = suite.adapter.VerifySignature(body, r.Header.Get(GenericMarketplaceSignatureHeader)) == nil
+		json.Unmarshal(body, &received)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+	adapter, _ := NewGenericMarketplaceAdapter("generic", "s3cret", server.URL, server.Client())
+
+	// When
+	err := adapter.PushStatus(context.Background(), "A-1001", domain.MarketplaceOrderStatusRejected, "kitchen is at capacity")
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	assert.True(signed)
+	assert.Equal("A-1001", received.OrderID)
+	assert.Equal("REJECTED", received.Status)
+	assert.Equal("kitchen is at capacity", received.Reason)
+}
+
+func (suite *GenericMarketplaceTestSuite) TestPushStatus_ErrorResponse_ShouldFail() {
+	// Given
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+	adapter, _ := NewGenericMarketplaceAdapter("generic", "s3cret", server.URL, server.Client())
+
+	// When
+	err := adapter.PushStatus(context.Background(), "A-1001", domain.MarketplaceOrderStatusReady, "")
+
+	// Then
+	assert.New(suite.T()).Error(err)
+}
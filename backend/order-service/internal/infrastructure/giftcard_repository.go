@@ -0,0 +1,193 @@
+package infrastructure
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/restaurant-platform/order-service/internal/domain"
+	"github.com/restaurant-platform/shared/pkg/errors"
+)
+
+type GiftCardRepository struct {
+	db *DB
+}
+
+func NewGiftCardRepository(db *DB) *GiftCardRepository {
+	return &GiftCardRepository{db: db}
+}
+
+func (r *GiftCardRepository) Create(ctx context.Context, card *domain.GiftCard, issue *domain.GiftCardTransaction) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	query := `
+		INSERT INTO gift_cards (
+			id, code_hash, masked_code, balance, issued_by, version, created_at, updated_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`
+
+	_, err = tx.ExecContext(ctx, query,
+		card.ID.String(), card.CodeHash, card.MaskedCode, card.Balance, card.IssuedBy,
+		card.Version, card.CreatedAt, card.UpdatedAt)
+	if err != nil {
+		return err
+	}
+
+	if err := insertGiftCardTransaction(ctx, tx, issue); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+func (r *GiftCardRepository) GetByID(ctx context.Context, id domain.GiftCardID) (*domain.GiftCard, error) {
+	query := `
+		SELECT id, code_hash, masked_code, balance, issued_by, version, created_at, updated_at
+		FROM gift_cards WHERE id = $1`
+
+	card, err := scanGiftCard(r.db.QueryRowContext(ctx, query, id.String()))
+	if err == sql.ErrNoRows {
+		return nil, errors.WrapNotFound("GiftCardRepository.GetByID", "gift card", id.String(), err)
+	}
+	return card, err
+}
+
+func (r *GiftCardRepository) GetByCodeHash(ctx context.Context, codeHash string) (*domain.GiftCard, error) {
+	query := `
+		SELECT id, code_hash, masked_code, balance, issued_by, version, created_at, updated_at
+		FROM gift_cards WHERE code_hash = $1`
+
+	card, err := scanGiftCard(r.db.QueryRowContext(ctx, query, codeHash))
+	if err == sql.ErrNoRows {
+		// The code is a secret, so it is not echoed back in the error
+		return nil, errors.WrapNotFound("GiftCardRepository.GetByCodeHash", "gift card", "code", err)
+	}
+	return card, err
+}
+
+// Save books the transaction and the new balance in one database transaction. The
+// balance is only written if the card is still at the version it was loaded at.
+func (r *GiftCardRepository) Save(ctx context.Context, card *domain.GiftCard, transaction *domain.GiftCardTransaction) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	query := `
+		UPDATE gift_cards
+		SET balance = $2, updated_at = $3, version = version + 1
+		WHERE id = $1 AND version = $4`
+
+	result, err := tx.ExecContext(ctx, query, card.ID.String(), card.Balance, card.UpdatedAt, card.Version)
+	if err != nil {
+		return err
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return errors.WrapVersionConflict("GiftCardRepository.Save", "gift card", card.ID.String(), card.Version)
+	}
+
+	if err := insertGiftCardTransaction(ctx, tx, transaction); err != nil {
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	card.Version++
+	return nil
+}
+
+func (r *GiftCardRepository) GetTransaction(ctx context.Context, id domain.GiftCardTransactionID) (*domain.GiftCardTransaction, error) {
+	query := `
+		SELECT id, gift_card_id, type, amount, balance_after, order_id, redemption_id, created_by, created_at
+		FROM gift_card_transactions WHERE id = $1`
+
+	transaction, err := scanGiftCardTransaction(r.db.QueryRowContext(ctx, query, id.String()))
+	if err == sql.ErrNoRows {
+		return nil, errors.WrapNotFound("GiftCardRepository.GetTransaction", "gift card transaction", id.String(), err)
+	}
+	return transaction, err
+}
+
+func (r *GiftCardRepository) FindTransactions(ctx context.Context, cardID domain.GiftCardID) ([]*domain.GiftCardTransaction, error) {
+	query := `
+		SELECT id, gift_card_id, type, amount, balance_after, order_id, redemption_id, created_by, created_at
+		FROM gift_card_transactions WHERE gift_card_id = $1
+		ORDER BY created_at ASC, id ASC`
+
+	rows, err := r.db.QueryContext(ctx, query, cardID.String())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var transactions []*domain.GiftCardTransaction
+	for rows.Next() {
+		transaction, err := scanGiftCardTransaction(rows)
+		if err != nil {
+			return nil, err
+		}
+		transactions = append(transactions, transaction)
+	}
+
+	return transactions, rows.Err()
+}
+
+// Helper methods
+
+func insertGiftCardTransaction(ctx context.Context, db execer, transaction *domain.GiftCardTransaction) error {
+	query := `
+		INSERT INTO gift_card_transactions (
+			id, gift_card_id, type, amount, balance_after, order_id, redemption_id, created_by, created_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)`
+
+	_, err := db.ExecContext(ctx, query,
+		transaction.ID.String(), transaction.GiftCardID.String(), string(transaction.Type),
+		transaction.Amount, transaction.BalanceAfter, nullString(transaction.OrderID.String()),
+		nullString(transaction.RedemptionID.String()), transaction.CreatedBy, transaction.CreatedAt)
+	return err
+}
+
+func scanGiftCard(row rowScanner) (*domain.GiftCard, error) {
+	var card domain.GiftCard
+	var idStr string
+
+	err := row.Scan(
+		&idStr, &card.CodeHash, &card.MaskedCode, &card.Balance, &card.IssuedBy,
+		&card.Version, &card.CreatedAt, &card.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	card.ID = domain.GiftCardID(idStr)
+	return &card, nil
+}
+
+func scanGiftCardTransaction(row rowScanner) (*domain.GiftCardTransaction, error) {
+	var transaction domain.GiftCardTransaction
+	var idStr, cardID, transactionType string
+	var orderID, redemptionID sql.NullString
+
+	err := row.Scan(
+		&idStr, &cardID, &transactionType, &transaction.Amount, &transaction.BalanceAfter,
+		&orderID, &redemptionID, &transaction.CreatedBy, &transaction.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	transaction.ID = domain.GiftCardTransactionID(idStr)
+	transaction.GiftCardID = domain.GiftCardID(cardID)
+	transaction.Type = domain.GiftCardTransactionType(transactionType)
+	transaction.OrderID = domain.OrderID(orderID.String)
+	transaction.RedemptionID = domain.GiftCardTransactionID(redemptionID.String)
+
+	return &transaction, nil
+}
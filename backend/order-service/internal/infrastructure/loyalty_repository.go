@@ -0,0 +1,114 @@
+package infrastructure
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/restaurant-platform/order-service/internal/domain"
+	"github.com/restaurant-platform/shared/pkg/errors"
+)
+
+type LoyaltyLedgerRepository struct {
+	db *DB
+}
+
+func NewLoyaltyLedgerRepository(db *DB) *LoyaltyLedgerRepository {
+	return &LoyaltyLedgerRepository{db: db}
+}
+
+func (r *LoyaltyLedgerRepository) Append(ctx context.Context, entry *domain.LoyaltyEntry) error {
+	query := `
+		INSERT INTO loyalty_ledger (
+			id, customer_id, type, points, order_id, reference, amount, description, expires_at, created_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+		ON CONFLICT (reference) DO NOTHING`
+
+	result, err := r.db.ExecContext(ctx, query,
+		entry.ID.String(), entry.CustomerID, string(entry.Type), entry.Points, nullString(entry.OrderID.String()),
+		entry.Reference, entry.Amount, entry.Description, nullTime(entry.ExpiresAt), entry.CreatedAt)
+	if err != nil {
+		return err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return errors.WrapConflict("LoyaltyLedgerRepository.Append", "reference",
+			"loyalty points were already booked for "+entry.Reference, nil)
+	}
+	return nil
+}
+
+func (r *LoyaltyLedgerRepository) FindByCustomer(ctx context.Context, customerID string) ([]*domain.LoyaltyEntry, error) {
+	query := `
+		SELECT id, customer_id, type, points, order_id, reference, amount, description, expires_at, created_at
+		FROM loyalty_ledger WHERE customer_id = $1 ORDER BY created_at ASC, id ASC`
+
+	rows, err := r.db.QueryContext(ctx, query, customerID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var entries []*domain.LoyaltyEntry
+	for rows.Next() {
+		entry, err := scanLoyaltyEntry(rows)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, entry)
+	}
+
+	return entries, rows.Err()
+}
+
+func (r *LoyaltyLedgerRepository) FindCustomersWithExpiringPoints(ctx context.Context, asOf time.Time) ([]string, error) {
+	query := `
+		SELECT DISTINCT customer_id FROM loyalty_ledger
+		WHERE expires_at <= $1 AND points > 0
+		ORDER BY customer_id ASC`
+
+	rows, err := r.db.QueryContext(ctx, query, asOf)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var customerIDs []string
+	for rows.Next() {
+		var customerID string
+		if err := rows.Scan(&customerID); err != nil {
+			return nil, err
+		}
+		customerIDs = append(customerIDs, customerID)
+	}
+
+	return customerIDs, rows.Err()
+}
+
+// Helper methods
+
+func scanLoyaltyEntry(row rowScanner) (*domain.LoyaltyEntry, error) {
+	var entry domain.LoyaltyEntry
+	var idStr, entryType, reference string
+	var orderID sql.NullString
+	var expiresAt sql.NullTime
+
+	err := row.Scan(
+		&idStr, &entry.CustomerID, &entryType, &entry.Points, &orderID, &reference,
+		&entry.Amount, &entry.Description, &expiresAt, &entry.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	entry.ID = domain.LoyaltyEntryID(idStr)
+	entry.Type = domain.LoyaltyEntryType(entryType)
+	entry.OrderID = domain.OrderID(orderID.String)
+	entry.Reference = reference
+	entry.ExpiresAt = timePtr(expiresAt)
+
+	return &entry, nil
+}
@@ -0,0 +1,152 @@
+package infrastructure
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/restaurant-platform/order-service/internal/domain"
+	"github.com/restaurant-platform/shared/pkg/errors"
+)
+
+type MarketplaceItemMappingRepository struct {
+	db *DB
+}
+
+func NewMarketplaceItemMappingRepository(db *DB) *MarketplaceItemMappingRepository {
+	return &MarketplaceItemMappingRepository{db: db}
+}
+
+func (r *MarketplaceItemMappingRepository) Save(ctx context.Context, mapping *domain.MarketplaceItemMapping) error {
+	query := `
+		INSERT INTO marketplace_item_mappings (marketplace, external_item_id, menu_item_id, updated_at)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (marketplace, external_item_id) DO UPDATE SET
+			menu_item_id = EXCLUDED.menu_item_id,
+			updated_at = EXCLUDED.updated_at`
+
+	_, err := r.db.ExecContext(ctx, query,
+		mapping.Marketplace, mapping.ExternalItemID, mapping.MenuItemID, mapping.UpdatedAt)
+	return err
+}
+
+func (r *MarketplaceItemMappingRepository) FindByMarketplace(ctx context.Context, marketplace string) ([]*domain.MarketplaceItemMapping, error) {
+	query := `
+		SELECT marketplace, external_item_id, menu_item_id, updated_at
+		FROM marketplace_item_mappings WHERE marketplace = $1 ORDER BY external_item_id ASC`
+
+	rows, err := r.db.QueryContext(ctx, query, marketplace)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var mappings []*domain.MarketplaceItemMapping
+	for rows.Next() {
+		var mapping domain.MarketplaceItemMapping
+		if err := rows.Scan(&mapping.Marketplace, &mapping.ExternalItemID, &mapping.MenuItemID, &mapping.UpdatedAt); err != nil {
+			return nil, err
+		}
+		mappings = append(mappings, &mapping)
+	}
+
+	return mappings, rows.Err()
+}
+
+type IngestedOrderRepository struct {
+	db *DB
+}
+
+func NewIngestedOrderRepository(db *DB) *IngestedOrderRepository {
+	return &IngestedOrderRepository{db: db}
+}
+
+func (r *IngestedOrderRepository) Create(ctx context.Context, ingested *domain.IngestedOrder) error {
+	query := `
+		INSERT INTO marketplace_orders (
+			id, marketplace, external_id, order_id, status, reason, received_at, updated_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		ON CONFLICT (marketplace, external_id) DO NOTHING`
+
+	result, err := r.db.ExecContext(ctx, query,
+		ingested.ID.String(), ingested.Marketplace, ingested.ExternalID, nullString(ingested.OrderID.String()),
+		string(ingested.Status), nullString(ingested.Reason), ingested.ReceivedAt, ingested.UpdatedAt)
+	if err != nil {
+		return err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return errors.WrapConflict("IngestedOrderRepository.Create", "external_id",
+			fmt.Sprintf("%s order %s was already received", ingested.Marketplace, ingested.ExternalID), nil)
+	}
+	return nil
+}
+
+func (r *IngestedOrderRepository) Update(ctx context.Context, ingested *domain.IngestedOrder) error {
+	query := `UPDATE marketplace_orders SET status = $2, reason = $3, updated_at = $4 WHERE id = $1`
+
+	result, err := r.db.ExecContext(ctx, query,
+		ingested.ID.String(), string(ingested.Status), nullString(ingested.Reason), ingested.UpdatedAt)
+	if err != nil {
+		return err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return errors.WrapNotFound("IngestedOrderRepository.Update", "marketplace_order", ingested.ID.String(), errors.ErrNotFound)
+	}
+	return nil
+}
+
+func (r *IngestedOrderRepository) FindByExternalID(ctx context.Context, marketplace, externalID string) (*domain.IngestedOrder, error) {
+	query := `
+		SELECT id, marketplace, external_id, order_id, status, reason, received_at, updated_at
+		FROM marketplace_orders WHERE marketplace = $1 AND external_id = $2`
+
+	ingested, err := scanIngestedOrder(r.db.QueryRowContext(ctx, query, marketplace, externalID))
+	if err == sql.ErrNoRows {
+		return nil, errors.WrapNotFound("IngestedOrderRepository.FindByExternalID", "marketplace_order", externalID, err)
+	}
+	return ingested, err
+}
+
+func (r *IngestedOrderRepository) FindByOrderID(ctx context.Context, orderID domain.OrderID) (*domain.IngestedOrder, error) {
+	query := `
+		SELECT id, marketplace, external_id, order_id, status, reason, received_at, updated_at
+		FROM marketplace_orders WHERE order_id = $1`
+
+	ingested, err := scanIngestedOrder(r.db.QueryRowContext(ctx, query, orderID.String()))
+	if err == sql.ErrNoRows {
+		return nil, errors.WrapNotFound("IngestedOrderRepository.FindByOrderID", "marketplace_order", orderID.String(), err)
+	}
+	return ingested, err
+}
+
+// Helper methods
+
+func scanIngestedOrder(row rowScanner) (*domain.IngestedOrder, error) {
+	var ingested domain.IngestedOrder
+	var idStr, status string
+	var orderID, reason sql.NullString
+
+	err := row.Scan(
+		&idStr, &ingested.Marketplace, &ingested.ExternalID, &orderID, &status, &reason,
+		&ingested.ReceivedAt, &ingested.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	ingested.ID = domain.IngestedOrderID(idStr)
+	ingested.OrderID = domain.OrderID(orderID.String)
+	ingested.Status = domain.MarketplaceOrderStatus(status)
+	ingested.Reason = reason.String
+
+	return &ingested, nil
+}
@@ -0,0 +1,138 @@
+package infrastructure
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/restaurant-platform/order-service/internal/domain"
+	"github.com/restaurant-platform/shared/pkg/errors"
+)
+
+type MenuItemRepository struct {
+	db *DB
+}
+
+func NewMenuItemRepository(db *DB) *MenuItemRepository {
+	return &MenuItemRepository{db: db}
+}
+
+func (r *MenuItemRepository) GetByID(ctx context.Context, id string) (*domain.MenuItem, error) {
+	query := `
+		SELECT id, menu_id, name, price, category_id, category_name,
+		       is_available, prep_time_seconds, modifier_groups, updated_at
+		FROM menu_items WHERE id = $1`
+
+	var item domain.MenuItem
+	var prepTimeSeconds int64
+	var modifierGroupsJSON []byte
+
+	err := r.db.QueryRowContext(ctx, query, id).Scan(
+		&item.ID, &item.MenuID, &item.Name, &item.Price, &item.CategoryID, &item.CategoryName,
+		&item.IsAvailable, &prepTimeSeconds, &modifierGroupsJSON, &item.UpdatedAt)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, errors.WrapNotFound("MenuItemRepository.GetByID", "menu_item", id, err)
+		}
+		return nil, fmt.Errorf("failed to get menu item: %w", err)
+	}
+
+	item.PrepTime = time.Duration(prepTimeSeconds) * time.Second
+	if len(modifierGroupsJSON) > 0 {
+		if err := json.Unmarshal(modifierGroupsJSON, &item.ModifierGroups); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal modifier groups: %w", err)
+		}
+	}
+	return &item, nil
+}
+
+func (r *MenuItemRepository) Upsert(ctx context.Context, item *domain.MenuItem) error {
+	return upsertMenuItem(ctx, r.db, item)
+}
+
+func (r *MenuItemRepository) SetAvailability(ctx context.Context, id string, isAvailable bool) error {
+	query := `UPDATE menu_items SET is_available = $2, updated_at = $3 WHERE id = $1`
+
+	result, err := r.db.ExecContext(ctx, query, id, isAvailable, time.Now())
+	if err != nil {
+		return fmt.Errorf("failed to set menu item availability: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return errors.WrapNotFound("MenuItemRepository.SetAvailability", "menu_item", id, errors.ErrNotFound)
+	}
+	return nil
+}
+
+func (r *MenuItemRepository) Delete(ctx context.Context, id string) error {
+	_, err := r.db.ExecContext(ctx, `DELETE FROM menu_items WHERE id = $1`, id)
+	return err
+}
+
+func (r *MenuItemRepository) ReplaceMenu(ctx context.Context, menuID string, items []*domain.MenuItem) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM menu_items WHERE menu_id = $1`, menuID); err != nil {
+		return fmt.Errorf("failed to clear menu items: %w", err)
+	}
+
+	for _, item := range items {
+		if err := upsertMenuItem(ctx, tx, item); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (r *MenuItemRepository) DeleteByMenu(ctx context.Context, menuID string) error {
+	_, err := r.db.ExecContext(ctx, `DELETE FROM menu_items WHERE menu_id = $1`, menuID)
+	return err
+}
+
+// Helper functions
+
+type execer interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+}
+
+func upsertMenuItem(ctx context.Context, db execer, item *domain.MenuItem) error {
+	query := `
+		INSERT INTO menu_items (
+			id, menu_id, name, price, category_id, category_name,
+			is_available, prep_time_seconds, modifier_groups, updated_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+		ON CONFLICT (id) DO UPDATE SET
+			menu_id = EXCLUDED.menu_id,
+			name = EXCLUDED.name,
+			price = EXCLUDED.price,
+			category_id = EXCLUDED.category_id,
+			category_name = EXCLUDED.category_name,
+			is_available = EXCLUDED.is_available,
+			prep_time_seconds = EXCLUDED.prep_time_seconds,
+			modifier_groups = EXCLUDED.modifier_groups,
+			updated_at = EXCLUDED.updated_at`
+
+	modifierGroupsJSON, err := json.Marshal(item.ModifierGroups)
+	if err != nil {
+		return fmt.Errorf("failed to marshal modifier groups: %w", err)
+	}
+
+	_, err = db.ExecContext(ctx, query,
+		item.ID, item.MenuID, item.Name, item.Price, item.CategoryID, item.CategoryName,
+		item.IsAvailable, int64(item.PrepTime.Seconds()), modifierGroupsJSON, item.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to upsert menu item: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,56 @@
+package infrastructure
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sharedErrors "github.com/restaurant-platform/shared/pkg/errors"
+)
+
+func TestMenuServiceCatalog_ActiveMenu_FlattensCategories(t *testing.T) {
+	// Given
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v1/menus/active", r.URL.Path)
+		w.Write([]byte(`{"id": "menu-1", "categories": [{"id": "cat-1", "name": "Mains", "items": [
+			{"id": "item-1", "name": "Burger", "price": 14.5, "is_available": true, "preparation_time": 720000000000,
+			 "modifier_groups": [{"id": "grp-1", "name": "Doneness", "min_selections": 1, "max_selections": 1,
+			  "options": [{"id": "opt-1", "name": "Medium", "price_delta": 0, "is_default": true}]}]}]}]}`))
+	}))
+	defer server.Close()
+	catalog, err := NewMenuServiceCatalog(server.URL, server.Client())
+	require.NoError(t, err)
+
+	// When
+	menuID, items, err := catalog.ActiveMenu(context.Background())
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, "menu-1", menuID)
+	require.Len(t, items, 1)
+	assert.Equal(t, "menu-1", items[0].MenuID)
+	assert.Equal(t, "Mains", items[0].CategoryName)
+	assert.Equal(t, 12*time.Minute, items[0].PrepTime)
+	require.Len(t, items[0].ModifierGroups, 1)
+	assert.Equal(t, "Medium", items[0].ModifierGroups[0].Options[0].Name)
+}
+
+func TestMenuServiceCatalog_ActiveMenu_NoActiveMenu_ShouldBeNotFound(t *testing.T) {
+	// Given
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+	catalog, _ := NewMenuServiceCatalog(server.URL, server.Client())
+
+	// When
+	_, _, err := catalog.ActiveMenu(context.Background())
+
+	// Then
+	assert.True(t, sharedErrors.IsNotFound(err))
+}
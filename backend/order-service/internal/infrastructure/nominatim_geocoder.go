@@ -0,0 +1,91 @@
+package infrastructure
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/restaurant-platform/order-service/internal/domain"
+	"github.com/restaurant-platform/shared/pkg/errors"
+)
+
+// NominatimGeocoder resolves addresses through a Nominatim search API, such as
+// OpenStreetMap's public instance or a self-hosted one. The best match is used.
+type NominatimGeocoder struct {
+	baseURL   string
+	userAgent string
+	client    *http.Client
+}
+
+// NewNominatimGeocoder creates a geocoder for the Nominatim instance at baseURL.
+// Nominatim's usage policy requires a user agent identifying the application.
+func NewNominatimGeocoder(baseURL, userAgent string, client *http.Client) (*NominatimGeocoder, error) {
+	if _, err := url.ParseRequestURI(baseURL); err != nil {
+		return nil, fmt.Errorf("geocoder URL is invalid: %w", err)
+	}
+	if userAgent == "" {
+		return nil, fmt.Errorf("geocoder user agent is required")
+	}
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	return &NominatimGeocoder{
+		baseURL:   strings.TrimRight(baseURL, "/"),
+		userAgent: userAgent,
+		client:    client,
+	}, nil
+}
+
+// nominatimPlace is a search result; coordinates are decimal strings
+type nominatimPlace struct {
+	Lat string `json:"lat"`
+	Lon string `json:"lon"`
+}
+
+// Geocode returns the coordinates of the best match for an address
+func (g *NominatimGeocoder) Geocode(ctx context.Context, address string) (domain.Coordinates, error) {
+	query := url.Values{}
+	query.Set("q", address)
+	query.Set("format", "jsonv2")
+	query.Set("limit", "1")
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, g.baseURL+"/search?"+query.Encode(), nil)
+	if err != nil {
+		return domain.Coordinates{}, fmt.Errorf("failed to create geocoding request: %w", err)
+	}
+	req.Header.Set("User-Agent", g.userAgent)
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := g.client.Do(req)
+	if err != nil {
+		return domain.Coordinates{}, fmt.Errorf("failed to reach geocoder: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return domain.Coordinates{}, fmt.Errorf("geocoder rejected request with %s", resp.Status)
+	}
+
+	var places []nominatimPlace
+	if err := json.NewDecoder(resp.Body).Decode(&places); err != nil {
+		return domain.Coordinates{}, fmt.Errorf("failed to decode geocoder response: %w", err)
+	}
+	if len(places) == 0 {
+		return domain.Coordinates{}, errors.WrapValidation("NominatimGeocoder.Geocode", "address", "address could not be geocoded", nil)
+	}
+
+	lat, err := strconv.ParseFloat(places[0].Lat, 64)
+	if err != nil {
+		return domain.Coordinates{}, fmt.Errorf("geocoder returned an invalid latitude: %w", err)
+	}
+	lng, err := strconv.ParseFloat(places[0].Lon, 64)
+	if err != nil {
+		return domain.Coordinates{}, fmt.Errorf("geocoder returned an invalid longitude: %w", err)
+	}
+	return domain.Coordinates{Lat: lat, Lng: lng}, nil
+}
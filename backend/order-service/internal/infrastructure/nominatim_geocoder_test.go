@@ -0,0 +1,65 @@
+package infrastructure
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sharedErrors "github.com/restaurant-platform/shared/pkg/errors"
+)
+
+func TestNominatimGeocoder_Geocode_UsesBestMatch(t *testing.T) {
+	// Given
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/search", r.URL.Path)
+		assert.Equal(t, "1 Main St", r.URL.Query().Get("q"))
+		assert.Equal(t, "restaurant-platform", r.Header.Get("User-Agent"))
+		w.Write([]byte(`[{"lat": "40.7130", "lon": "-74.0070"}]`))
+	}))
+	defer server.Close()
+	geocoder, err := NewNominatimGeocoder(server.URL, "restaurant-platform", server.Client())
+	require.NoError(t, err)
+
+	// When
+	location, err := geocoder.Geocode(context.Background(), "1 Main St")
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, 40.7130, location.Lat)
+	assert.Equal(t, -74.0070, location.Lng)
+}
+
+func TestNominatimGeocoder_Geocode_NoMatch_ShouldBeValidationError(t *testing.T) {
+	// Given
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`[]`))
+	}))
+	defer server.Close()
+	geocoder, _ := NewNominatimGeocoder(server.URL, "restaurant-platform", server.Client())
+
+	// When
+	_, err := geocoder.Geocode(context.Background(), "nowhere")
+
+	// Then
+	assert.True(t, sharedErrors.IsValidationError(err))
+}
+
+func TestNominatimGeocoder_Geocode_ServerError_ShouldFail(t *testing.T) {
+	// Given
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+	geocoder, _ := NewNominatimGeocoder(server.URL, "restaurant-platform", server.Client())
+
+	// When
+	_, err := geocoder.Geocode(context.Background(), "1 Main St")
+
+	// Then
+	assert.Error(t, err)
+	assert.False(t, sharedErrors.IsValidationError(err))
+}
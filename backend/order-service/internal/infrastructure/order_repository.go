@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/restaurant-platform/order-service/internal/domain"
+	"github.com/restaurant-platform/shared/pkg/errors"
 )
 
 type OrderRepository struct {
@@ -24,17 +25,30 @@ func (r *OrderRepository) Create(ctx context.Context, order *domain.Order) error
 		return fmt.Errorf("failed to marshal order items: %w", err)
 	}
 
+	historyJSON, err := json.Marshal(order.StatusHistory)
+	if err != nil {
+		return fmt.Errorf("failed to marshal order status history: %w", err)
+	}
+
+	discountsJSON, err := json.Marshal(order.Discounts)
+	if err != nil {
+		return fmt.Errorf("failed to marshal order discounts: %w", err)
+	}
+
 	query := `
 		INSERT INTO orders (
 			id, customer_id, type, status, items, total_amount, tax_amount,
-			table_id, delivery_address, notes, created_at, updated_at
-		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)`
+			table_id, delivery_address, notes, fulfillment_time, released_at, delivery_fee,
+			status_history, discounts, merged_into, guest_count, reservation_id, version, created_at, updated_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21)`
 
 	_, err = r.db.ExecContext(ctx, query,
 		order.ID.String(), order.CustomerID, string(order.Type), string(order.Status),
 		itemsJSON, order.TotalAmount, order.TaxAmount,
 		nullString(order.TableID), nullString(order.DeliveryAddress), nullString(order.Notes),
-		order.CreatedAt, order.UpdatedAt)
+		nullTime(order.FulfillmentTime), nullTime(order.ReleasedAt), order.DeliveryFee,
+		historyJSON, discountsJSON, nullString(order.MergedInto.String()), order.GuestCount, nullString(order.ReservationID),
+		order.Version, order.CreatedAt, order.UpdatedAt)
 
 	return err
 }
@@ -42,18 +56,21 @@ func (r *OrderRepository) Create(ctx context.Context, order *domain.Order) error
 func (r *OrderRepository) GetByID(ctx context.Context, id domain.OrderID) (*domain.Order, error) {
 	query := `
 		SELECT id, customer_id, type, status, items, total_amount, tax_amount,
-		       table_id, delivery_address, notes, created_at, updated_at
+		       table_id, delivery_address, notes, fulfillment_time, released_at, delivery_fee,
+		       status_history, discounts, merged_into, guest_count, reservation_id, version, created_at, updated_at
 		FROM orders WHERE id = $1`
 
 	var order domain.Order
 	var idStr, orderType, status string
-	var itemsJSON []byte
-	var tableID, deliveryAddress, notes sql.NullString
+	var itemsJSON, historyJSON, discountsJSON []byte
+	var tableID, deliveryAddress, notes, mergedInto, reservationID sql.NullString
+	var fulfillmentTime, releasedAt sql.NullTime
 
 	err := r.db.QueryRowContext(ctx, query, id.String()).Scan(
 		&idStr, &order.CustomerID, &orderType, &status, &itemsJSON,
 		&order.TotalAmount, &order.TaxAmount, &tableID, &deliveryAddress, &notes,
-		&order.CreatedAt, &order.UpdatedAt)
+		&fulfillmentTime, &releasedAt, &order.DeliveryFee, &historyJSON, &discountsJSON, &mergedInto,
+		&order.GuestCount, &reservationID, &order.Version, &order.CreatedAt, &order.UpdatedAt)
 
 	if err != nil {
 		if err == sql.ErrNoRows {
@@ -75,35 +92,56 @@ func (r *OrderRepository) GetByID(ctx context.Context, id domain.OrderID) (*doma
 	if notes.Valid {
 		order.Notes = notes.String
 	}
+	order.FulfillmentTime = timePtr(fulfillmentTime)
+	order.ReleasedAt = timePtr(releasedAt)
+	order.MergedInto = domain.OrderID(mergedInto.String)
+	order.ReservationID = reservationID.String
 
 	// Unmarshal items
 	if err := json.Unmarshal(itemsJSON, &order.Items); err != nil {
 		return nil, fmt.Errorf("failed to unmarshal order items: %w", err)
 	}
+	if err := json.Unmarshal(historyJSON, &order.StatusHistory); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal order status history: %w", err)
+	}
+	if err := json.Unmarshal(discountsJSON, &order.Discounts); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal order discounts: %w", err)
+	}
 
 	return &order, nil
 }
 
 func (r *OrderRepository) Update(ctx context.Context, order *domain.Order) error {
-	itemsJSON, err := json.Marshal(order.Items)
-	if err != nil {
-		return fmt.Errorf("failed to marshal order items: %w", err)
+	if err := updateOrder(ctx, r.db, order); err != nil {
+		return err
 	}
 
-	query := `
-		UPDATE orders 
-		SET customer_id = $2, type = $3, status = $4, items = $5,
-		    total_amount = $6, tax_amount = $7, table_id = $8,
-		    delivery_address = $9, notes = $10, updated_at = $11
-		WHERE id = $1`
+	order.Version++
+	return nil
+}
 
-	_, err = r.db.ExecContext(ctx, query,
-		order.ID.String(), order.CustomerID, string(order.Type), string(order.Status),
-		itemsJSON, order.TotalAmount, order.TaxAmount,
-		nullString(order.TableID), nullString(order.DeliveryAddress), nullString(order.Notes),
-		order.UpdatedAt)
+// UpdateAll saves several orders in one transaction. If any of them was modified
+// concurrently none are saved.
+func (r *OrderRepository) UpdateAll(ctx context.Context, orders ...*domain.Order) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
 
-	return err
+	for _, order := range orders {
+		if err := updateOrder(ctx, tx, order); err != nil {
+			return err
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	for _, order := range orders {
+		order.Version++
+	}
+	return nil
 }
 
 func (r *OrderRepository) Delete(ctx context.Context, id domain.OrderID) error {
@@ -127,7 +165,8 @@ func (r *OrderRepository) List(ctx context.Context, offset, limit int, filters d
 	// Main query with pagination
 	query := `
 		SELECT id, customer_id, type, status, items, total_amount, tax_amount,
-		       table_id, delivery_address, notes, created_at, updated_at
+		       table_id, delivery_address, notes, fulfillment_time, released_at, delivery_fee,
+		       status_history, discounts, merged_into, guest_count, reservation_id, version, created_at, updated_at
 		FROM orders` + whereClause + `
 		ORDER BY created_at DESC 
 		LIMIT $` + fmt.Sprintf("%d", len(args)+1) + ` OFFSET $` + fmt.Sprintf("%d", len(args)+2)
@@ -140,7 +179,8 @@ func (r *OrderRepository) List(ctx context.Context, offset, limit int, filters d
 func (r *OrderRepository) FindByCustomer(ctx context.Context, customerID string) ([]*domain.Order, error) {
 	query := `
 		SELECT id, customer_id, type, status, items, total_amount, tax_amount,
-		       table_id, delivery_address, notes, created_at, updated_at
+		       table_id, delivery_address, notes, fulfillment_time, released_at, delivery_fee,
+		       status_history, discounts, merged_into, guest_count, reservation_id, version, created_at, updated_at
 		FROM orders WHERE customer_id = $1
 		ORDER BY created_at DESC`
 
@@ -150,7 +190,8 @@ func (r *OrderRepository) FindByCustomer(ctx context.Context, customerID string)
 func (r *OrderRepository) FindByStatus(ctx context.Context, status domain.OrderStatus) ([]*domain.Order, error) {
 	query := `
 		SELECT id, customer_id, type, status, items, total_amount, tax_amount,
-		       table_id, delivery_address, notes, created_at, updated_at
+		       table_id, delivery_address, notes, fulfillment_time, released_at, delivery_fee,
+		       status_history, discounts, merged_into, guest_count, reservation_id, version, created_at, updated_at
 		FROM orders WHERE status = $1
 		ORDER BY created_at DESC`
 
@@ -160,7 +201,8 @@ func (r *OrderRepository) FindByStatus(ctx context.Context, status domain.OrderS
 func (r *OrderRepository) FindByDateRange(ctx context.Context, start, end time.Time) ([]*domain.Order, error) {
 	query := `
 		SELECT id, customer_id, type, status, items, total_amount, tax_amount,
-		       table_id, delivery_address, notes, created_at, updated_at
+		       table_id, delivery_address, notes, fulfillment_time, released_at, delivery_fee,
+		       status_history, discounts, merged_into, guest_count, reservation_id, version, created_at, updated_at
 		FROM orders WHERE created_at >= $1 AND created_at <= $2
 		ORDER BY created_at DESC`
 
@@ -170,7 +212,8 @@ func (r *OrderRepository) FindByDateRange(ctx context.Context, start, end time.T
 func (r *OrderRepository) FindByTable(ctx context.Context, tableID string) ([]*domain.Order, error) {
 	query := `
 		SELECT id, customer_id, type, status, items, total_amount, tax_amount,
-		       table_id, delivery_address, notes, created_at, updated_at
+		       table_id, delivery_address, notes, fulfillment_time, released_at, delivery_fee,
+		       status_history, discounts, merged_into, guest_count, reservation_id, version, created_at, updated_at
 		FROM orders WHERE table_id = $1
 		ORDER BY created_at DESC`
 
@@ -180,7 +223,8 @@ func (r *OrderRepository) FindByTable(ctx context.Context, tableID string) ([]*d
 func (r *OrderRepository) FindByType(ctx context.Context, orderType domain.OrderType) ([]*domain.Order, error) {
 	query := `
 		SELECT id, customer_id, type, status, items, total_amount, tax_amount,
-		       table_id, delivery_address, notes, created_at, updated_at
+		       table_id, delivery_address, notes, fulfillment_time, released_at, delivery_fee,
+		       status_history, discounts, merged_into, guest_count, reservation_id, version, created_at, updated_at
 		FROM orders WHERE type = $1
 		ORDER BY created_at DESC`
 
@@ -202,7 +246,8 @@ func (r *OrderRepository) GetTotalsByDateRange(ctx context.Context, start, end t
 func (r *OrderRepository) GetActiveOrders(ctx context.Context) ([]*domain.Order, error) {
 	query := `
 		SELECT id, customer_id, type, status, items, total_amount, tax_amount,
-		       table_id, delivery_address, notes, created_at, updated_at
+		       table_id, delivery_address, notes, fulfillment_time, released_at, delivery_fee,
+		       status_history, discounts, merged_into, guest_count, reservation_id, version, created_at, updated_at
 		FROM orders 
 		WHERE status NOT IN ('COMPLETED', 'CANCELLED')
 		ORDER BY created_at ASC`
@@ -210,6 +255,20 @@ func (r *OrderRepository) GetActiveOrders(ctx context.Context) ([]*domain.Order,
 	return r.queryOrders(ctx, query)
 }
 
+func (r *OrderRepository) FindScheduled(ctx context.Context, from, to time.Time) ([]*domain.Order, error) {
+	query := `
+		SELECT id, customer_id, type, status, items, total_amount, tax_amount,
+		       table_id, delivery_address, notes, fulfillment_time, released_at, delivery_fee,
+		       status_history, discounts, merged_into, guest_count, reservation_id, version, created_at, updated_at
+		FROM orders
+		WHERE fulfillment_time >= $1 AND fulfillment_time <= $2
+		AND released_at IS NULL
+		AND status NOT IN ('COMPLETED', 'CANCELLED')
+		ORDER BY fulfillment_time ASC`
+
+	return r.queryOrders(ctx, query, from, to)
+}
+
 // Helper methods
 
 func (r *OrderRepository) queryOrders(ctx context.Context, query string, args ...interface{}) ([]*domain.Order, error) {
@@ -223,13 +282,15 @@ func (r *OrderRepository) queryOrders(ctx context.Context, query string, args ..
 	for rows.Next() {
 		var order domain.Order
 		var idStr, orderType, status string
-		var itemsJSON []byte
-		var tableID, deliveryAddress, notes sql.NullString
+		var itemsJSON, historyJSON, discountsJSON []byte
+		var tableID, deliveryAddress, notes, mergedInto, reservationID sql.NullString
+		var fulfillmentTime, releasedAt sql.NullTime
 
 		err := rows.Scan(
 			&idStr, &order.CustomerID, &orderType, &status, &itemsJSON,
 			&order.TotalAmount, &order.TaxAmount, &tableID, &deliveryAddress, &notes,
-			&order.CreatedAt, &order.UpdatedAt)
+			&fulfillmentTime, &releasedAt, &order.DeliveryFee, &historyJSON, &discountsJSON, &mergedInto,
+			&order.GuestCount, &reservationID, &order.Version, &order.CreatedAt, &order.UpdatedAt)
 		if err != nil {
 			return nil, err
 		}
@@ -247,11 +308,21 @@ func (r *OrderRepository) queryOrders(ctx context.Context, query string, args ..
 		if notes.Valid {
 			order.Notes = notes.String
 		}
+		order.FulfillmentTime = timePtr(fulfillmentTime)
+		order.ReleasedAt = timePtr(releasedAt)
+		order.MergedInto = domain.OrderID(mergedInto.String)
+		order.ReservationID = reservationID.String
 
 		// Unmarshal items
 		if err := json.Unmarshal(itemsJSON, &order.Items); err != nil {
 			return nil, fmt.Errorf("failed to unmarshal order items: %w", err)
 		}
+		if err := json.Unmarshal(historyJSON, &order.StatusHistory); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal order status history: %w", err)
+		}
+		if err := json.Unmarshal(discountsJSON, &order.Discounts); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal order discounts: %w", err)
+		}
 
 		orders = append(orders, &order)
 	}
@@ -330,4 +401,66 @@ func nullString(s string) sql.NullString {
 		return sql.NullString{}
 	}
 	return sql.NullString{String: s, Valid: true}
-}
\ No newline at end of file
+}
+func nullTime(t *time.Time) sql.NullTime {
+	if t == nil {
+		return sql.NullTime{}
+	}
+	return sql.NullTime{Time: *t, Valid: true}
+}
+
+func timePtr(t sql.NullTime) *time.Time {
+	if !t.Valid {
+		return nil
+	}
+	return &t.Time
+}
+
+// updateOrder saves an order if it is still at the version it was loaded at
+func updateOrder(ctx context.Context, db execer, order *domain.Order) error {
+	itemsJSON, err := json.Marshal(order.Items)
+	if err != nil {
+		return fmt.Errorf("failed to marshal order items: %w", err)
+	}
+
+	historyJSON, err := json.Marshal(order.StatusHistory)
+	if err != nil {
+		return fmt.Errorf("failed to marshal order status history: %w", err)
+	}
+
+	discountsJSON, err := json.Marshal(order.Discounts)
+	if err != nil {
+		return fmt.Errorf("failed to marshal order discounts: %w", err)
+	}
+
+	query := `
+		UPDATE orders 
+		SET customer_id = $2, type = $3, status = $4, items = $5,
+		    total_amount = $6, tax_amount = $7, table_id = $8,
+		    delivery_address = $9, notes = $10, fulfillment_time = $11,
+		    released_at = $12, delivery_fee = $13, status_history = $14, updated_at = $15,
+		    merged_into = $17, discounts = $18, guest_count = $19, reservation_id = $20,
+		    version = version + 1
+		WHERE id = $1 AND version = $16`
+
+	result, err := db.ExecContext(ctx, query,
+		order.ID.String(), order.CustomerID, string(order.Type), string(order.Status),
+		itemsJSON, order.TotalAmount, order.TaxAmount,
+		nullString(order.TableID), nullString(order.DeliveryAddress), nullString(order.Notes),
+		nullTime(order.FulfillmentTime), nullTime(order.ReleasedAt), order.DeliveryFee,
+		historyJSON, order.UpdatedAt, order.Version, nullString(order.MergedInto.String()), discountsJSON,
+		order.GuestCount, nullString(order.ReservationID))
+	if err != nil {
+		return err
+	}
+
+	// No row matched: another writer saved a newer version since this order was loaded
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return errors.WrapVersionConflict("OrderRepository.Update", "order", order.ID.String(), order.Version)
+	}
+	return nil
+}
@@ -0,0 +1,221 @@
+package infrastructure
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+
+	"github.com/lib/pq"
+
+	"github.com/restaurant-platform/order-service/internal/domain"
+	"github.com/restaurant-platform/shared/pkg/errors"
+)
+
+type PaymentRepository struct {
+	db *DB
+}
+
+func NewPaymentRepository(db *DB) *PaymentRepository {
+	return &PaymentRepository{db: db}
+}
+
+func (r *PaymentRepository) Create(ctx context.Context, payment *domain.Payment) error {
+	tendersJSON, refundsJSON, err := marshalPaymentLines(payment)
+	if err != nil {
+		return err
+	}
+
+	query := `
+		INSERT INTO payments (
+			id, order_id, status, amount_due, amount_paid, amount_refunded,
+			change_given, tenders, refunds, void_reason, version, created_at, updated_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)`
+
+	_, err = r.db.ExecContext(ctx, query,
+		payment.ID.String(), payment.OrderID.String(), string(payment.Status),
+		payment.AmountDue, payment.AmountPaid, payment.AmountRefunded, payment.ChangeGiven,
+		tendersJSON, refundsJSON, nullString(payment.VoidReason), payment.Version,
+		payment.CreatedAt, payment.UpdatedAt)
+
+	// Another live payment was opened for the order since it was found to have none
+	if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == "23505" {
+		return errors.WrapVersionConflict("PaymentRepository.Create", "payment", payment.OrderID.String(), payment.Version)
+	}
+	return err
+}
+
+func (r *PaymentRepository) GetByID(ctx context.Context, id domain.PaymentID) (*domain.Payment, error) {
+	query := `
+		SELECT id, order_id, status, amount_due, amount_paid, amount_refunded,
+		       change_given, tenders, refunds, void_reason, version, created_at, updated_at
+		FROM payments WHERE id = $1`
+
+	payment, err := r.scanPayment(r.db.QueryRowContext(ctx, query, id.String()))
+	if err == sql.ErrNoRows {
+		return nil, errors.WrapNotFound("PaymentRepository.GetByID", "payment", id.String(), err)
+	}
+	return payment, err
+}
+
+func (r *PaymentRepository) GetByOrderID(ctx context.Context, orderID domain.OrderID) (*domain.Payment, error) {
+	query := `
+		SELECT id, order_id, status, amount_due, amount_paid, amount_refunded,
+		       change_given, tenders, refunds, void_reason, version, created_at, updated_at
+		FROM payments WHERE order_id = $1 AND status <> 'VOIDED'
+		ORDER BY created_at DESC LIMIT 1`
+
+	payment, err := r.scanPayment(r.db.QueryRowContext(ctx, query, orderID.String()))
+	if err == sql.ErrNoRows {
+		return nil, errors.WrapNotFound("PaymentRepository.GetByOrderID", "payment", orderID.String(), err)
+	}
+	return payment, err
+}
+
+func (r *PaymentRepository) FindByOrderIDs(ctx context.Context, orderIDs []domain.OrderID) ([]*domain.Payment, error) {
+	if len(orderIDs) == 0 {
+		return nil, nil
+	}
+
+	ids := make([]string, len(orderIDs))
+	for i, id := range orderIDs {
+		ids[i] = id.String()
+	}
+
+	query := `
+		SELECT id, order_id, status, amount_due, amount_paid, amount_refunded,
+		       change_given, tenders, refunds, void_reason, version, created_at, updated_at
+		FROM payments WHERE order_id = ANY($1) AND status <> 'VOIDED'
+		ORDER BY created_at ASC`
+
+	rows, err := r.db.QueryContext(ctx, query, pq.Array(ids))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var payments []*domain.Payment
+	for rows.Next() {
+		payment, err := r.scanPayment(rows)
+		if err != nil {
+			return nil, err
+		}
+		payments = append(payments, payment)
+	}
+
+	return payments, rows.Err()
+}
+
+// FindByDrawerSession matches the session against the tenders and refunds by JSONB
+// containment, which the GIN indexes on both columns serve
+func (r *PaymentRepository) FindByDrawerSession(ctx context.Context, sessionID domain.DrawerSessionID) ([]*domain.Payment, error) {
+	link, err := json.Marshal([]map[string]string{{"drawer_session_id": sessionID.String()}})
+	if err != nil {
+		return nil, err
+	}
+
+	query := `
+		SELECT id, order_id, status, amount_due, amount_paid, amount_refunded,
+		       change_given, tenders, refunds, void_reason, version, created_at, updated_at
+		FROM payments WHERE tenders @> $1::jsonb OR refunds @> $1::jsonb
+		ORDER BY created_at ASC`
+
+	rows, err := r.db.QueryContext(ctx, query, string(link))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var payments []*domain.Payment
+	for rows.Next() {
+		payment, err := r.scanPayment(rows)
+		if err != nil {
+			return nil, err
+		}
+		payments = append(payments, payment)
+	}
+
+	return payments, rows.Err()
+}
+
+// Update saves the payment only if it is still at the version it was loaded at
+func (r *PaymentRepository) Update(ctx context.Context, payment *domain.Payment) error {
+	tendersJSON, refundsJSON, err := marshalPaymentLines(payment)
+	if err != nil {
+		return err
+	}
+
+	query := `
+		UPDATE payments
+		SET status = $2, amount_due = $3, amount_paid = $4, amount_refunded = $5,
+		    change_given = $6, tenders = $7, refunds = $8, void_reason = $9, updated_at = $10,
+		    version = version + 1
+		WHERE id = $1 AND version = $11`
+
+	result, err := r.db.ExecContext(ctx, query,
+		payment.ID.String(), string(payment.Status),
+		payment.AmountDue, payment.AmountPaid, payment.AmountRefunded, payment.ChangeGiven,
+		tendersJSON, refundsJSON, nullString(payment.VoidReason), payment.UpdatedAt, payment.Version)
+	if err != nil {
+		return err
+	}
+
+	// No row matched: another writer saved a newer version since this payment was loaded
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return errors.WrapVersionConflict("PaymentRepository.Update", "payment", payment.ID.String(), payment.Version)
+	}
+
+	payment.Version++
+	return nil
+}
+
+// Helper methods
+
+func (r *PaymentRepository) scanPayment(row rowScanner) (*domain.Payment, error) {
+	var payment domain.Payment
+	var idStr, orderID, status string
+	var tendersJSON, refundsJSON []byte
+	var voidReason sql.NullString
+
+	err := row.Scan(
+		&idStr, &orderID, &status, &payment.AmountDue, &payment.AmountPaid, &payment.AmountRefunded,
+		&payment.ChangeGiven, &tendersJSON, &refundsJSON, &voidReason, &payment.Version,
+		&payment.CreatedAt, &payment.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	payment.ID = domain.PaymentID(idStr)
+	payment.OrderID = domain.OrderID(orderID)
+	payment.Status = domain.PaymentStatus(status)
+	if voidReason.Valid {
+		payment.VoidReason = voidReason.String
+	}
+
+	if err := json.Unmarshal(tendersJSON, &payment.Tenders); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal payment tenders: %w", err)
+	}
+	if err := json.Unmarshal(refundsJSON, &payment.Refunds); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal payment refunds: %w", err)
+	}
+
+	return &payment, nil
+}
+
+func marshalPaymentLines(payment *domain.Payment) ([]byte, []byte, error) {
+	tendersJSON, err := json.Marshal(payment.Tenders)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to marshal payment tenders: %w", err)
+	}
+
+	refundsJSON, err := json.Marshal(payment.Refunds)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to marshal payment refunds: %w", err)
+	}
+
+	return tendersJSON, refundsJSON, nil
+}
@@ -0,0 +1,132 @@
+package infrastructure
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+)
+
+// MemoryPINAttemptStore implements PINAttemptStore in process memory, for tests and single instances
+type MemoryPINAttemptStore struct {
+	mu      sync.Mutex
+	entries map[string]pinAttempts
+}
+
+type pinAttempts struct {
+	failures  int
+	expiresAt time.Time
+}
+
+// NewMemoryPINAttemptStore creates an empty in-memory store
+func NewMemoryPINAttemptStore() *MemoryPINAttemptStore {
+	return &MemoryPINAttemptStore{entries: make(map[string]pinAttempts)}
+}
+
+// Failures returns the unexpired failed attempts of the manager
+func (s *MemoryPINAttemptStore) Failures(ctx context.Context, managerID string) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	entry, ok := s.entries[managerID]
+	if !ok || !time.Now().Before(entry.expiresAt) {
+		return 0, nil
+	}
+	return entry.failures, nil
+}
+
+// RecordFailure counts a failed attempt, starting a new window when the last one has expired
+func (s *MemoryPINAttemptStore) RecordFailure(ctx context.Context, managerID string, window time.Duration) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	entry, ok := s.entries[managerID]
+	if !ok || !time.Now().Before(entry.expiresAt) {
+		entry = pinAttempts{expiresAt: time.Now().Add(window)}
+	}
+	entry.failures++
+	s.entries[managerID] = entry
+	return entry.failures, nil
+}
+
+// Reset removes the failed attempts of the manager
+func (s *MemoryPINAttemptStore) Reset(ctx context.Context, managerID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.entries, managerID)
+	return nil
+}
+
+// RedisPINAttemptStore implements PINAttemptStore using Redis, so that every instance of the
+// service counts towards the same lockout
+type RedisPINAttemptStore struct {
+	client *redis.Client
+	prefix string
+}
+
+// NewRedisPINAttemptStore creates a Redis backed store; keys are namespaced by prefix
+func NewRedisPINAttemptStore(redisAddr, password string, db int, prefix string) (*RedisPINAttemptStore, error) {
+	client := redis.NewClient(&redis.Options{
+		Addr:     redisAddr,
+		Password: password,
+		DB:       db,
+	})
+
+	// Test connection
+	ctx := context.Background()
+	if err := client.Ping(ctx).Err(); err != nil {
+		return nil, fmt.Errorf("failed to connect to Redis: %w", err)
+	}
+
+	return &RedisPINAttemptStore{
+		client: client,
+		prefix: prefix,
+	}, nil
+}
+
+// Failures returns the failed attempts counted under the manager's key
+func (s *RedisPINAttemptStore) Failures(ctx context.Context, managerID string) (int, error) {
+	failures, err := s.client.Get(ctx, s.redisKey(managerID)).Int()
+	if err == redis.Nil {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to get PIN attempts: %w", err)
+	}
+	return failures, nil
+}
+
+// RecordFailure increments the manager's counter; the first failure starts the window
+func (s *RedisPINAttemptStore) RecordFailure(ctx context.Context, managerID string, window time.Duration) (int, error) {
+	key := s.redisKey(managerID)
+	failures, err := s.client.Incr(ctx, key).Result()
+	if err != nil {
+		return 0, fmt.Errorf("failed to record PIN attempt: %w", err)
+	}
+	if failures == 1 {
+		if err := s.client.Expire(ctx, key, window).Err(); err != nil {
+			return 0, fmt.Errorf("failed to set PIN attempt window: %w", err)
+		}
+	}
+	return int(failures), nil
+}
+
+// Reset removes the manager's counter
+func (s *RedisPINAttemptStore) Reset(ctx context.Context, managerID string) error {
+	if err := s.client.Del(ctx, s.redisKey(managerID)).Err(); err != nil {
+		return fmt.Errorf("failed to reset PIN attempts: %w", err)
+	}
+	return nil
+}
+
+// Close closes the Redis connection
+func (s *RedisPINAttemptStore) Close() error {
+	return s.client.Close()
+}
+
+func (s *RedisPINAttemptStore) redisKey(managerID string) string {
+	return fmt.Sprintf("%s:pin-failures:%s", s.prefix, managerID)
+}
@@ -0,0 +1,76 @@
+package infrastructure
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/restaurant-platform/shared/pkg/errors"
+)
+
+// PINAttemptStore counts failed manager PIN attempts so that PINs cannot be guessed
+type PINAttemptStore interface {
+	// Failures returns the failed attempts recorded for the manager in the current lockout window
+	Failures(ctx context.Context, managerID string) (int, error)
+	// RecordFailure counts a failed attempt; the count expires window after the first failure
+	RecordFailure(ctx context.Context, managerID string, window time.Duration) (int, error)
+	// Reset clears the failed attempts of the manager
+	Reset(ctx context.Context, managerID string) error
+}
+
+// ConfigPINVerifier checks manager override PINs against bcrypt hashes from configuration.
+// A manager whose PIN was entered wrong maxAttempts times is locked out for the lockout window.
+type ConfigPINVerifier struct {
+	hashes      map[string][]byte
+	attempts    PINAttemptStore
+	maxAttempts int
+	lockout     time.Duration
+}
+
+// NewConfigPINVerifier creates a verifier from manager user IDs mapped to the bcrypt hash of their PIN.
+// Entries that are not valid bcrypt hashes are ignored. Manager IDs are matched case-insensitively,
+// as configuration keys are lowercased when loaded.
+func NewConfigPINVerifier(managerPINs map[string]string, attempts PINAttemptStore, maxAttempts int, lockout time.Duration) *ConfigPINVerifier {
+	hashes := make(map[string][]byte, len(managerPINs))
+	for managerID, hash := range managerPINs {
+		hash = strings.TrimSpace(hash)
+		if _, err := bcrypt.Cost([]byte(hash)); err != nil {
+			log.Printf("Ignoring override PIN of manager %s: not a bcrypt hash", managerID)
+			continue
+		}
+		hashes[strings.ToLower(managerID)] = []byte(hash)
+	}
+	return &ConfigPINVerifier{hashes: hashes, attempts: attempts, maxAttempts: maxAttempts, lockout: lockout}
+}
+
+// VerifyPIN returns nil if pin is the override PIN of the manager
+func (v *ConfigPINVerifier) VerifyPIN(ctx context.Context, managerID, pin string) error {
+	managerID = strings.ToLower(managerID)
+
+	failures, err := v.attempts.Failures(ctx, managerID)
+	if err != nil {
+		return fmt.Errorf("failed to get PIN attempts: %w", err)
+	}
+	if failures >= v.maxAttempts {
+		return errors.WrapForbidden("ConfigPINVerifier.VerifyPIN", "manager PIN is locked after too many failed attempts", nil)
+	}
+
+	hash, ok := v.hashes[managerID]
+	if !ok || bcrypt.CompareHashAndPassword(hash, []byte(pin)) != nil {
+		if _, err := v.attempts.RecordFailure(ctx, managerID, v.lockout); err != nil {
+			return fmt.Errorf("failed to record PIN attempt: %w", err)
+		}
+		return errors.WrapForbidden("ConfigPINVerifier.VerifyPIN", "invalid manager PIN", nil)
+	}
+
+	if failures > 0 {
+		if err := v.attempts.Reset(ctx, managerID); err != nil {
+			log.Printf("Failed to reset PIN attempts of manager %s: %v", managerID, err)
+		}
+	}
+	return nil
+}
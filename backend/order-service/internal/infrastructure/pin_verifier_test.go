@@ -0,0 +1,73 @@
+package infrastructure
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+
+	sharedErrors "github.com/restaurant-platform/shared/pkg/errors"
+)
+
+func newTestPINVerifier(t *testing.T, attempts PINAttemptStore) *ConfigPINVerifier {
+	hash, err := bcrypt.GenerateFromPassword([]byte("1234"), bcrypt.MinCost)
+	require.NoError(t, err)
+	return NewConfigPINVerifier(map[string]string{"mgr-1": string(hash), "mgr-2": "not-a-hash"}, attempts, 3, time.Minute)
+}
+
+func TestConfigPINVerifier_VerifyPIN_MatchesBcryptHash(t *testing.T) {
+	// Given
+	verifier := newTestPINVerifier(t, NewMemoryPINAttemptStore())
+
+	// When
+	err := verifier.VerifyPIN(context.Background(), "MGR-1", "1234")
+
+	// Then
+	assert.NoError(t, err)
+}
+
+func TestConfigPINVerifier_VerifyPIN_InvalidHash_ShouldBeForbidden(t *testing.T) {
+	// Given
+	verifier := newTestPINVerifier(t, NewMemoryPINAttemptStore())
+
+	// When
+	err := verifier.VerifyPIN(context.Background(), "mgr-2", "not-a-hash")
+
+	// Then
+	assert.True(t, sharedErrors.IsForbiddenError(err))
+}
+
+func TestConfigPINVerifier_VerifyPIN_TooManyFailures_ShouldLockOut(t *testing.T) {
+	// Given three wrong PINs
+	ctx := context.Background()
+	verifier := newTestPINVerifier(t, NewMemoryPINAttemptStore())
+	for _, pin := range []string{"0000", "1111", "2222"} {
+		require.Error(t, verifier.VerifyPIN(ctx, "mgr-1", pin))
+	}
+
+	// When the right PIN is entered
+	err := verifier.VerifyPIN(ctx, "mgr-1", "1234")
+
+	// Then
+	assert.True(t, sharedErrors.IsForbiddenError(err))
+	assert.Contains(t, err.Error(), "locked")
+}
+
+func TestConfigPINVerifier_VerifyPIN_Success_ShouldResetFailures(t *testing.T) {
+	// Given
+	ctx := context.Background()
+	attempts := NewMemoryPINAttemptStore()
+	verifier := newTestPINVerifier(t, attempts)
+	require.Error(t, verifier.VerifyPIN(ctx, "mgr-1", "0000"))
+
+	// When
+	err := verifier.VerifyPIN(ctx, "mgr-1", "1234")
+
+	// Then
+	assert.NoError(t, err)
+	failures, _ := attempts.Failures(ctx, "mgr-1")
+	assert.Zero(t, failures)
+}
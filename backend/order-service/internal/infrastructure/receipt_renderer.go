@@ -0,0 +1,352 @@
+package infrastructure
+
+import (
+	"bytes"
+	"embed"
+	"encoding/base64"
+	"fmt"
+	htmltemplate "html/template"
+	"image/png"
+	"io/fs"
+	"os"
+	"strings"
+	"text/template"
+	"time"
+	"unicode/utf8"
+
+	"github.com/boombuler/barcode"
+	"github.com/boombuler/barcode/qr"
+
+	"github.com/restaurant-platform/order-service/internal/domain"
+)
+
+const (
+	textTemplateName = "receipt.txt.tmpl"
+	htmlTemplateName = "receipt.html.tmpl"
+
+	qrCodeSize = 160
+)
+
+// ESC/POS control sequences
+const (
+	escposInit        = "\x1b@"
+	escposBoldOn      = "\x1bE\x01"
+	escposBoldOff     = "\x1bE\x00"
+	escposAlignCenter = "\x1ba\x01"
+	escposAlignLeft   = "\x1ba\x00"
+	escposFeedAndCut  = "\n\n\n\x1dVB\x00"
+)
+
+//go:embed templates/*.tmpl
+var defaultReceiptTemplates embed.FS
+
+// TemplateReceiptRenderer renders receipts from a text template, used for
+// plain text and ESC/POS, and an HTML template for email receipts
+type TemplateReceiptRenderer struct {
+	textSource string
+	htmlSource string
+}
+
+// NewReceiptRenderer creates a renderer using the templates in templateDir,
+// or the built-in templates when templateDir is empty
+func NewReceiptRenderer(templateDir string) (*TemplateReceiptRenderer, error) {
+	if templateDir == "" {
+		templates, _ := fs.Sub(defaultReceiptTemplates, "templates")
+		return NewTemplateReceiptRenderer(templates)
+	}
+	return NewTemplateReceiptRenderer(os.DirFS(templateDir))
+}
+
+// NewTemplateReceiptRenderer creates a renderer from the receipt.txt.tmpl and
+// receipt.html.tmpl templates in fsys
+func NewTemplateReceiptRenderer(fsys fs.FS) (*TemplateReceiptRenderer, error) {
+	textSource, err := fs.ReadFile(fsys, textTemplateName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read receipt template: %w", err)
+	}
+	htmlSource, err := fs.ReadFile(fsys, htmlTemplateName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read receipt template: %w", err)
+	}
+
+	renderer := &TemplateReceiptRenderer{
+		textSource: string(textSource),
+		htmlSource: string(htmlSource),
+	}
+
+	// Fail at startup rather than on the first receipt
+	if _, err := renderer.parseText(domain.ReceiptFormatText, domain.ReceiptWidthWide); err != nil {
+		return nil, err
+	}
+	if _, err := renderer.parseHTML(); err != nil {
+		return nil, err
+	}
+
+	return renderer, nil
+}
+
+// Render renders a receipt as ESC/POS commands, plain text or HTML
+func (r *TemplateReceiptRenderer) Render(receipt *domain.Receipt, format domain.ReceiptFormat, width int) (*domain.RenderedReceipt, error) {
+	if err := domain.ValidateReceiptFormat(format, width); err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	switch format {
+	case domain.ReceiptFormatHTML:
+		tmpl, err := r.parseHTML()
+		if err != nil {
+			return nil, err
+		}
+		if err := tmpl.Execute(&buf, receipt); err != nil {
+			return nil, fmt.Errorf("failed to render receipt: %w", err)
+		}
+		return &domain.RenderedReceipt{Format: format, ContentType: "text/html; charset=utf-8", Content: buf.Bytes()}, nil
+
+	case domain.ReceiptFormatESCPOS:
+		tmpl, err := r.parseText(format, width)
+		if err != nil {
+			return nil, err
+		}
+		buf.WriteString(escposInit)
+		if err := tmpl.Execute(&buf, receipt); err != nil {
+			return nil, fmt.Errorf("failed to render receipt: %w", err)
+		}
+		buf.WriteString(escposFeedAndCut)
+		return &domain.RenderedReceipt{Format: format, ContentType: "application/octet-stream", Content: toPrinterCharset(buf.Bytes())}, nil
+
+	default:
+		tmpl, err := r.parseText(format, width)
+		if err != nil {
+			return nil, err
+		}
+		if err := tmpl.Execute(&buf, receipt); err != nil {
+			return nil, fmt.Errorf("failed to render receipt: %w", err)
+		}
+		return &domain.RenderedReceipt{Format: format, ContentType: "text/plain; charset=utf-8", Content: buf.Bytes()}, nil
+	}
+}
+
+// The layout helpers depend on the paper width and output format, so the
+// text template is parsed for each render
+func (r *TemplateReceiptRenderer) parseText(format domain.ReceiptFormat, width int) (*template.Template, error) {
+	layout := receiptLayout{width: width, escpos: format == domain.ReceiptFormatESCPOS}
+
+	funcs := template.FuncMap{
+		"center": layout.center,
+		"cols":   layout.cols,
+		"rule":   layout.rule,
+		"bold":   layout.bold,
+		"code":   layout.code,
+	}
+	for name, fn := range receiptFuncs() {
+		funcs[name] = fn
+	}
+
+	tmpl, err := template.New(textTemplateName).Funcs(funcs).Parse(r.textSource)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse receipt template: %w", err)
+	}
+	return tmpl, nil
+}
+
+func (r *TemplateReceiptRenderer) parseHTML() (*htmltemplate.Template, error) {
+	funcs := htmltemplate.FuncMap{
+		"qrcode": qrCodeDataURL,
+	}
+	for name, fn := range receiptFuncs() {
+		funcs[name] = fn
+	}
+
+	tmpl, err := htmltemplate.New(htmlTemplateName).Funcs(funcs).Parse(r.htmlSource)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse receipt template: %w", err)
+	}
+	return tmpl, nil
+}
+
+// receiptFuncs are the formatting helpers shared by all receipt templates
+func receiptFuncs() map[string]any {
+	return map[string]any{
+		"money": func(amount float64) string {
+			return fmt.Sprintf("%.2f", amount)
+		},
+		// price leaves free modifiers unpriced
+		"price": func(amount float64) string {
+			if amount == 0 {
+				return ""
+			}
+			return fmt.Sprintf("%.2f", amount)
+		},
+		"percent": func(rate float64) string {
+			return fmt.Sprintf("%g%%", rate*100)
+		},
+		"datetime": func(t time.Time) string {
+			return t.Format("2006-01-02 15:04")
+		},
+		"title": func(kind domain.ReceiptKind) string {
+			if kind == domain.ReceiptKindGuestCheck {
+				return "GUEST CHECK"
+			}
+			return "RECEIPT"
+		},
+		"tender": func(tender *domain.ReceiptTender) string {
+			label := string(tender.Type)
+			if tender.Reference != "" {
+				label += " " + tender.Reference
+			}
+			if tender.Status != domain.TenderStatusCaptured {
+				label += " (" + string(tender.Status) + ")"
+			}
+			return label
+		},
+	}
+}
+
+// receiptLayout pads text to a fixed paper width and emits printer commands for ESC/POS
+type receiptLayout struct {
+	width  int
+	escpos bool
+}
+
+// center centers text on the paper, wrapping text that is too long
+func (l receiptLayout) center(value any) string {
+	lines := wrapText(printable(value), l.width)
+	for i, line := range lines {
+		lines[i] = strings.Repeat(" ", (l.width-utf8.RuneCountInString(line))/2) + line
+	}
+	return strings.Join(lines, "\n")
+}
+
+// cols prints left and right on one line, truncating left to make room
+func (l receiptLayout) cols(left, right any) string {
+	leftText, rightText := printable(left), printable(right)
+	room := l.width - utf8.RuneCountInString(rightText) - 1
+	if rightText == "" {
+		room = l.width
+	}
+	leftText = truncate(leftText, room)
+	padding := l.width - utf8.RuneCountInString(leftText) - utf8.RuneCountInString(rightText)
+	return strings.TrimRight(leftText+strings.Repeat(" ", padding)+rightText, " ")
+}
+
+func (l receiptLayout) rule() string {
+	return strings.Repeat("-", l.width)
+}
+
+func (l receiptLayout) bold(text string) string {
+	if !l.escpos {
+		return text
+	}
+	return escposBoldOn + text + escposBoldOff
+}
+
+// code prints a QR code of value on its own line; plain text has no room for one
+func (l receiptLayout) code(value any) string {
+	if !l.escpos {
+		return ""
+	}
+	return "\n" + escposAlignCenter + escposQRCode(printable(value)) + escposAlignLeft
+}
+
+// printable formats a data value for the receipt without its control characters,
+// so item names, notes and other entered text cannot smuggle printer commands
+// such as a paper cut or drawer kick into ESC/POS output. Line breaks are kept.
+func printable(value any) string {
+	return strings.Map(func(r rune) rune {
+		if r == '\n' {
+			return r
+		}
+		if r < 0x20 || r == 0x7f {
+			return -1
+		}
+		return r
+	}, fmt.Sprint(value))
+}
+
+// escposQRCode stores and prints a QR code with the printer's GS ( k commands
+func escposQRCode(data string) string {
+	var b strings.Builder
+	// Model 2, module size 6, error correction level M
+	b.WriteString("\x1d(k\x04\x00\x31\x41\x32\x00")
+	b.WriteString("\x1d(k\x03\x00\x31\x43\x06")
+	b.WriteString("\x1d(k\x03\x00\x31\x45\x31")
+	// Store the data in the symbol storage area, then print it
+	length := len(data) + 3
+	b.WriteString("\x1d(k")
+	b.WriteByte(byte(length % 256))
+	b.WriteByte(byte(length / 256))
+	b.WriteString("\x31\x50\x30")
+	b.WriteString(data)
+	b.WriteString("\x1d(k\x03\x00\x31\x51\x30")
+	return b.String()
+}
+
+// qrCodeDataURL encodes value as a QR code PNG for embedding in HTML
+func qrCodeDataURL(value any) (htmltemplate.URL, error) {
+	code, err := qr.Encode(fmt.Sprint(value), qr.M, qr.Auto)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode QR code: %w", err)
+	}
+	code, err = barcode.Scale(code, qrCodeSize, qrCodeSize)
+	if err != nil {
+		return "", fmt.Errorf("failed to scale QR code: %w", err)
+	}
+
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, code); err != nil {
+		return "", fmt.Errorf("failed to encode QR code image: %w", err)
+	}
+	return htmltemplate.URL("data:image/png;base64," + base64.StdEncoding.EncodeToString(buf.Bytes())), nil
+}
+
+// toPrinterCharset replaces characters outside ASCII, which thermal printers
+// would print from their own code page, with question marks. Bytes that are
+// not valid UTF-8 are printer command arguments and are kept as they are.
+func toPrinterCharset(content []byte) []byte {
+	out := make([]byte, 0, len(content))
+	for len(content) > 0 {
+		r, size := utf8.DecodeRune(content)
+		switch {
+		case r == utf8.RuneError && size == 1, r < utf8.RuneSelf:
+			out = append(out, content[0])
+		default:
+			out = append(out, '?')
+		}
+		content = content[size:]
+	}
+	return out
+}
+
+func wrapText(text string, width int) []string {
+	words := strings.Fields(text)
+	if len(words) == 0 {
+		return []string{""}
+	}
+
+	lines := make([]string, 0, 1)
+	current := ""
+	for _, word := range words {
+		word = truncate(word, width)
+		switch {
+		case current == "":
+			current = word
+		case utf8.RuneCountInString(current)+1+utf8.RuneCountInString(word) <= width:
+			current += " " + word
+		default:
+			lines = append(lines, current)
+			current = word
+		}
+	}
+	return append(lines, current)
+}
+
+func truncate(text string, width int) string {
+	if width <= 0 {
+		return ""
+	}
+	if utf8.RuneCountInString(text) <= width {
+		return text
+	}
+	return string([]rune(text)[:width])
+}
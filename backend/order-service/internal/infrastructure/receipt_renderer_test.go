@@ -0,0 +1,226 @@
+package infrastructure
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"testing/fstest"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/restaurant-platform/order-service/internal/domain"
+	sharedErrors "github.com/restaurant-platform/shared/pkg/errors"
+)
+
+var updateGolden = flag.Bool("update", false, "update receipt golden files")
+
+// ReceiptRendererTestSuite renders receipts and compares them with the golden files in testdata
+type ReceiptRendererTestSuite struct {
+	suite.Suite
+	renderer *TemplateReceiptRenderer
+}
+
+func TestReceiptRendererTestSuite(t *testing.T) {
+	suite.Run(t, new(ReceiptRendererTestSuite))
+}
+
+func (suite *ReceiptRendererTestSuite) SetupTest() {
+	renderer, err := NewReceiptRenderer("")
+	suite.Require().NoError(err)
+	suite.renderer = renderer
+}
+
+func (suite *ReceiptRendererTestSuite) guestCheck() *domain.Receipt {
+	return &domain.Receipt{
+		Kind: domain.ReceiptKindGuestCheck,
+		Restaurant: domain.RestaurantInfo{
+			Name:    "Trattoria Verde",
+			Address: "12 Market Street, Springfield",
+			Phone:   "555-0100",
+			TaxID:   "TX-884211",
+			Footer:  "Thank you for dining with us!",
+		},
+		OrderID:    "ord_1700000000000000000_1",
+		OrderType:  domain.OrderTypeDineIn,
+		TableID:    "T12",
+		CustomerID: "customer-123",
+		Lines: []*domain.ReceiptLine{
+			{
+				Quantity: 2,
+				Name:     "Margherita Pizza",
+				Amount:   25.98,
+				Modifiers: []*domain.ReceiptModifier{
+					{Name: "Large", Amount: 6.00},
+					{Name: "Thin crust", Amount: 0},
+				},
+				Modifications: []string{"no basil"},
+			},
+			{Quantity: 1, Name: "Wild Mushroom and Truffle Risotto with Parmesan Crisp", Amount: 18.50},
+			{Quantity: 1, Name: "Crème brûlée", Amount: 8.00},
+		},
+		Subtotal: 58.48,
+		Taxes:    []*domain.ReceiptTax{{Label: "Sales tax", Rate: 0.10, Amount: 5.85}},
+		Total:    64.33,
+		SuggestedTips: []*domain.SuggestedTip{
+			{Percent: 15, Amount: 8.77, Total: 73.10},
+			{Percent: 18, Amount: 10.53, Total: 74.86},
+			{Percent: 20, Amount: 11.70, Total: 76.03},
+		},
+		BalanceDue: 64.33,
+		OrderedAt:  time.Date(2024, 3, 8, 19, 5, 0, 0, time.UTC),
+		PrintedAt:  time.Date(2024, 3, 8, 20, 15, 0, 0, time.UTC),
+	}
+}
+
+func (suite *ReceiptRendererTestSuite) paidReceipt() *domain.Receipt {
+	receipt := suite.guestCheck()
+	receipt.Kind = domain.ReceiptKindReceipt
+	receipt.OrderType = domain.OrderTypeDelivery
+	receipt.TableID = ""
+	receipt.DeliveryFee = 4.50
+	receipt.Total = 68.83
+	receipt.SuggestedTips = nil
+	receipt.BalanceDue = 0
+	receipt.Tenders = []*domain.ReceiptTender{
+		{Type: domain.TenderTypeCash, Status: domain.TenderStatusCaptured, Amount: 40.00, Tendered: 50.00, Change: 10.00},
+		{Type: domain.TenderTypeCard, Status: domain.TenderStatusCaptured, Amount: 28.83, Tendered: 28.83, Reference: "VISA-4242"},
+	}
+	receipt.AmountPaid = 68.83
+	receipt.ChangeGiven = 10.00
+	receipt.Reprint = true
+	return receipt
+}
+
+func (suite *ReceiptRendererTestSuite) assertGolden(name string, content []byte) {
+	path := filepath.Join("testdata", "receipts", name)
+	if *updateGolden {
+		suite.Require().NoError(os.MkdirAll(filepath.Dir(path), 0o755))
+		suite.Require().NoError(os.WriteFile(path, content, 0o644))
+	}
+
+	expected, err := os.ReadFile(path)
+	suite.Require().NoError(err, "run go test with -update to create %s", path)
+	assert.Equal(suite.T(), string(expected), string(content), "rendered receipt differs from %s", path)
+}
+
+func (suite *ReceiptRendererTestSuite) TestRender_MatchesGoldenFiles() {
+	cases := []struct {
+		golden  string
+		receipt *domain.Receipt
+		format  domain.ReceiptFormat
+		width   int
+	}{
+		{"guest_check_40.txt", suite.guestCheck(), domain.ReceiptFormatText, domain.ReceiptWidthNarrow},
+		{"guest_check_48.txt", suite.guestCheck(), domain.ReceiptFormatText, domain.ReceiptWidthWide},
+		{"guest_check_48.escpos", suite.guestCheck(), domain.ReceiptFormatESCPOS, domain.ReceiptWidthWide},
+		{"guest_check.html", suite.guestCheck(), domain.ReceiptFormatHTML, 0},
+		{"receipt_40.txt", suite.paidReceipt(), domain.ReceiptFormatText, domain.ReceiptWidthNarrow},
+		{"receipt_40.escpos", suite.paidReceipt(), domain.ReceiptFormatESCPOS, domain.ReceiptWidthNarrow},
+		{"receipt.html", suite.paidReceipt(), domain.ReceiptFormatHTML, 0},
+	}
+
+	for _, tc := range cases {
+		suite.Run(tc.golden, func() {
+			// When
+			rendered, err := suite.renderer.Render(tc.receipt, tc.format, tc.width)
+
+			// Then
+			suite.Require().NoError(err)
+			assert.Equal(suite.T(), tc.format, rendered.Format)
+			suite.assertGolden(tc.golden, rendered.Content)
+		})
+	}
+}
+
+func (suite *ReceiptRendererTestSuite) TestRender_ESCPOS_ShouldWrapPrinterCommands() {
+	// When
+	rendered, err := suite.renderer.Render(suite.paidReceipt(), domain.ReceiptFormatESCPOS, domain.ReceiptWidthWide)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	assert.Equal("application/octet-stream", rendered.ContentType)
+	assert.Equal([]byte(escposInit), rendered.Content[:2])
+	assert.Contains(string(rendered.Content), escposQRCode("ord_1700000000000000000_1"))
+	assert.Contains(string(rendered.Content), "Cr?me br?l?e")
+	assert.Equal([]byte(escposFeedAndCut), rendered.Content[len(rendered.Content)-len(escposFeedAndCut):])
+}
+
+func (suite *ReceiptRendererTestSuite) TestRender_ESCPOS_ShouldStripControlCharactersFromData() {
+	// Given an item name and modification carrying a paper cut and a printer reset
+	receipt := suite.paidReceipt()
+	receipt.Lines[0].Name = "Soup\x1dVB\x00\x1b@"
+	receipt.Lines[0].Modifications = []string{"no\tonions\x07"}
+
+	// When
+	rendered, err := suite.renderer.Render(receipt, domain.ReceiptFormatESCPOS, domain.ReceiptWidthWide)
+
+	// Then only the renderer's own commands reach the printer
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	content := string(rendered.Content)
+	assert.Equal(1, strings.Count(content, "\x1dV"))
+	assert.Equal(1, strings.Count(content, escposInit))
+	assert.NotContains(content, "\x07")
+	assert.Contains(content, "SoupVB@")
+	assert.Contains(content, "* noonions")
+}
+
+func (suite *ReceiptRendererTestSuite) TestRender_Text_ShouldFitPaperWidth() {
+	for _, width := range []int{domain.ReceiptWidthNarrow, domain.ReceiptWidthWide} {
+		// When
+		rendered, err := suite.renderer.Render(suite.guestCheck(), domain.ReceiptFormatText, width)
+
+		// Then
+		suite.Require().NoError(err)
+		for _, line := range strings.Split(string(rendered.Content), "\n") {
+			assert.LessOrEqual(suite.T(), len([]rune(line)), width, "line %q is wider than %d columns", line, width)
+		}
+	}
+}
+
+func (suite *ReceiptRendererTestSuite) TestRender_UnsupportedWidth_ShouldFail() {
+	// When
+	_, err := suite.renderer.Render(suite.guestCheck(), domain.ReceiptFormatText, 32)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.Error(err)
+	assert.True(sharedErrors.IsValidationError(err))
+}
+
+func (suite *ReceiptRendererTestSuite) TestNewTemplateReceiptRenderer_CustomTemplates() {
+	// Given
+	templates := fstest.MapFS{
+		"receipt.txt.tmpl":  {Data: []byte(`{{center .Restaurant.Name}} {{money .Total}}`)},
+		"receipt.html.tmpl": {Data: []byte(`<p>{{.Restaurant.Name}}</p>`)},
+	}
+	renderer, err := NewTemplateReceiptRenderer(templates)
+	suite.Require().NoError(err)
+
+	// When
+	rendered, err := renderer.Render(suite.guestCheck(), domain.ReceiptFormatText, domain.ReceiptWidthNarrow)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	assert.Equal("            Trattoria Verde 64.33", string(rendered.Content))
+}
+
+func (suite *ReceiptRendererTestSuite) TestNewTemplateReceiptRenderer_InvalidTemplate_ShouldFail() {
+	// Given
+	templates := fstest.MapFS{
+		"receipt.txt.tmpl":  {Data: []byte(`{{unknown .Total}}`)},
+		"receipt.html.tmpl": {Data: []byte(`<p></p>`)},
+	}
+
+	// When
+	_, err := NewTemplateReceiptRenderer(templates)
+
+	// Then
+	assert.Error(suite.T(), err)
+}
@@ -0,0 +1,86 @@
+package infrastructure
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/restaurant-platform/order-service/internal/domain"
+	"github.com/restaurant-platform/shared/pkg/errors"
+)
+
+type ReservationRepository struct {
+	db *DB
+}
+
+func NewReservationRepository(db *DB) *ReservationRepository {
+	return &ReservationRepository{db: db}
+}
+
+func (r *ReservationRepository) GetByID(ctx context.Context, id string) (*domain.Reservation, error) {
+	query := `
+		SELECT id, table_id, party_size, date_time, status, updated_at
+		FROM reservations WHERE id = $1`
+
+	reservation, err := scanReservation(r.db.QueryRowContext(ctx, query, id))
+	if err == sql.ErrNoRows {
+		return nil, errors.WrapNotFound("ReservationRepository.GetByID", "reservation", id, err)
+	}
+	return reservation, err
+}
+
+func (r *ReservationRepository) FindSeatable(ctx context.Context, tableID string, at time.Time) (*domain.Reservation, error) {
+	query := `
+		SELECT id, table_id, party_size, date_time, status, updated_at
+		FROM reservations
+		WHERE table_id = $1 AND status IN ('PENDING', 'CONFIRMED')
+		AND date_time BETWEEN $2 AND $3
+		ORDER BY ABS(EXTRACT(EPOCH FROM (date_time - $4)))
+		LIMIT 1`
+
+	reservation, err := scanReservation(r.db.QueryRowContext(ctx, query, tableID,
+		at.Add(-domain.ReservationSeatingWindow), at.Add(domain.ReservationSeatingWindow), at))
+	if err == sql.ErrNoRows {
+		return nil, errors.WrapNotFound("ReservationRepository.FindSeatable", "reservation", "table "+tableID, err)
+	}
+	return reservation, err
+}
+
+func (r *ReservationRepository) Upsert(ctx context.Context, reservation *domain.Reservation) error {
+	// Events can arrive out of order; a change older than the stored one is ignored
+	query := `
+		INSERT INTO reservations (id, table_id, party_size, date_time, status, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (id) DO UPDATE SET
+			table_id = EXCLUDED.table_id,
+			party_size = EXCLUDED.party_size,
+			date_time = EXCLUDED.date_time,
+			status = EXCLUDED.status,
+			updated_at = EXCLUDED.updated_at
+		WHERE reservations.updated_at <= EXCLUDED.updated_at`
+
+	_, err := r.db.ExecContext(ctx, query,
+		reservation.ID, reservation.TableID, reservation.PartySize, reservation.DateTime,
+		string(reservation.Status), reservation.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to upsert reservation: %w", err)
+	}
+	return nil
+}
+
+// Helper methods
+
+func scanReservation(row rowScanner) (*domain.Reservation, error) {
+	var reservation domain.Reservation
+	var status string
+
+	err := row.Scan(&reservation.ID, &reservation.TableID, &reservation.PartySize,
+		&reservation.DateTime, &status, &reservation.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	reservation.Status = domain.ReservationStatus(status)
+	return &reservation, nil
+}
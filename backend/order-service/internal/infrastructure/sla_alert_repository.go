@@ -0,0 +1,117 @@
+package infrastructure
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/restaurant-platform/order-service/internal/domain"
+	"github.com/restaurant-platform/shared/pkg/errors"
+)
+
+type SLAAlertRepository struct {
+	db *DB
+}
+
+func NewSLAAlertRepository(db *DB) *SLAAlertRepository {
+	return &SLAAlertRepository{db: db}
+}
+
+func (r *SLAAlertRepository) Create(ctx context.Context, alert *domain.SLAAlert) error {
+	query := `
+		INSERT INTO order_sla_alerts (
+			id, order_id, order_type, table_id, status, threshold_seconds,
+			entered_at, breached_at, detected_at, resolved_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+		ON CONFLICT (order_id, status) WHERE resolved_at IS NULL DO NOTHING`
+
+	result, err := r.db.ExecContext(ctx, query,
+		alert.ID.String(), alert.OrderID.String(), string(alert.OrderType), nullString(alert.TableID),
+		string(alert.Status), int64(alert.Threshold.Seconds()),
+		alert.EnteredAt, alert.BreachedAt, alert.DetectedAt, nullTime(alert.ResolvedAt))
+	if err != nil {
+		return err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return errors.WrapConflict("SLAAlertRepository.Create", "status",
+			fmt.Sprintf("order %s already has an open %s alert", alert.OrderID, alert.Status), nil)
+	}
+	return nil
+}
+
+func (r *SLAAlertRepository) Update(ctx context.Context, alert *domain.SLAAlert) error {
+	query := `UPDATE order_sla_alerts SET resolved_at = $2 WHERE id = $1`
+
+	result, err := r.db.ExecContext(ctx, query, alert.ID.String(), nullTime(alert.ResolvedAt))
+	if err != nil {
+		return err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return errors.WrapNotFound("SLAAlertRepository.Update", "sla_alert", alert.ID.String(), errors.ErrNotFound)
+	}
+	return nil
+}
+
+func (r *SLAAlertRepository) FindOpen(ctx context.Context) ([]*domain.SLAAlert, error) {
+	query := `
+		SELECT id, order_id, order_type, table_id, status, threshold_seconds,
+		       entered_at, breached_at, detected_at, resolved_at
+		FROM order_sla_alerts WHERE resolved_at IS NULL ORDER BY breached_at ASC`
+
+	rows, err := r.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var alerts []*domain.SLAAlert
+	for rows.Next() {
+		alert, err := scanSLAAlert(rows)
+		if err != nil {
+			return nil, err
+		}
+		alerts = append(alerts, alert)
+	}
+
+	return alerts, rows.Err()
+}
+
+// Helper methods
+
+func scanSLAAlert(row rowScanner) (*domain.SLAAlert, error) {
+	var alert domain.SLAAlert
+	var idStr, orderID, orderType, status string
+	var tableID sql.NullString
+	var thresholdSeconds int64
+	var resolvedAt sql.NullTime
+
+	err := row.Scan(
+		&idStr, &orderID, &orderType, &tableID, &status, &thresholdSeconds,
+		&alert.EnteredAt, &alert.BreachedAt, &alert.DetectedAt, &resolvedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	alert.ID = domain.SLAAlertID(idStr)
+	alert.OrderID = domain.OrderID(orderID)
+	alert.OrderType = domain.OrderType(orderType)
+	alert.TableID = tableID.String
+	alert.Status = domain.OrderStatus(status)
+	alert.Threshold = time.Duration(thresholdSeconds) * time.Second
+	if resolvedAt.Valid {
+		alert.ResolvedAt = &resolvedAt.Time
+	}
+
+	return &alert, nil
+}
@@ -0,0 +1,102 @@
+package infrastructure
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/restaurant-platform/order-service/internal/domain"
+	"github.com/restaurant-platform/shared/pkg/errors"
+)
+
+type ZReportRepository struct {
+	db *DB
+}
+
+func NewZReportRepository(db *DB) *ZReportRepository {
+	return &ZReportRepository{db: db}
+}
+
+func (r *ZReportRepository) Create(ctx context.Context, report *domain.ZReport) error {
+	reportJSON, err := json.Marshal(report.Report)
+	if err != nil {
+		return fmt.Errorf("failed to marshal z-report: %w", err)
+	}
+
+	query := `
+		INSERT INTO z_reports (business_date, report, closed_by, closed_at)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (business_date) DO NOTHING`
+
+	result, err := r.db.ExecContext(ctx, query,
+		report.BusinessDate, reportJSON, report.ClosedBy, report.ClosedAt)
+	if err != nil {
+		return err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return errors.WrapConflict("ZReportRepository.Create", "business_date",
+			fmt.Sprintf("business day %s is already closed", report.BusinessDate), nil)
+	}
+	return nil
+}
+
+func (r *ZReportRepository) GetByDate(ctx context.Context, businessDate string) (*domain.ZReport, error) {
+	query := `
+		SELECT business_date, report, closed_by, closed_at
+		FROM z_reports WHERE business_date = $1`
+
+	report, err := scanZReport(r.db.QueryRowContext(ctx, query, businessDate))
+	if err == sql.ErrNoRows {
+		return nil, errors.WrapNotFound("ZReportRepository.GetByDate", "z_report", businessDate, err)
+	}
+	return report, err
+}
+
+func (r *ZReportRepository) List(ctx context.Context, limit int) ([]*domain.ZReport, error) {
+	query := `
+		SELECT business_date, report, closed_by, closed_at
+		FROM z_reports ORDER BY business_date DESC LIMIT $1`
+
+	rows, err := r.db.QueryContext(ctx, query, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var reports []*domain.ZReport
+	for rows.Next() {
+		report, err := scanZReport(rows)
+		if err != nil {
+			return nil, err
+		}
+		reports = append(reports, report)
+	}
+
+	return reports, rows.Err()
+}
+
+// Helper methods
+
+func scanZReport(row rowScanner) (*domain.ZReport, error) {
+	var report domain.ZReport
+	var businessDate time.Time
+	var reportJSON []byte
+
+	if err := row.Scan(&businessDate, &reportJSON, &report.ClosedBy, &report.ClosedAt); err != nil {
+		return nil, err
+	}
+
+	report.BusinessDate = businessDate.Format(domain.BusinessDateLayout)
+	if err := json.Unmarshal(reportJSON, &report.Report); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal z-report: %w", err)
+	}
+
+	return &report, nil
+}
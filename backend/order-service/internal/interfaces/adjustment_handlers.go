@@ -0,0 +1,96 @@
+package interfaces
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/restaurant-platform/order-service/internal/application"
+	"github.com/restaurant-platform/order-service/internal/domain"
+)
+
+// AdjustmentHandler handles HTTP requests for order voids and refunds
+type AdjustmentHandler struct {
+	adjustmentService domain.AdjustmentService
+}
+
+// NewAdjustmentHandler creates a new adjustment handler
+func NewAdjustmentHandler(adjustmentService domain.AdjustmentService) *AdjustmentHandler {
+	return &AdjustmentHandler{
+		adjustmentService: adjustmentService,
+	}
+}
+
+// VoidOrder voids an unpaid order with a reason code
+// POST /api/v1/orders/:id/void
+func (h *AdjustmentHandler) VoidOrder(c *gin.Context) {
+	orderID := domain.OrderID(c.Param("id"))
+
+	var req application.VoidOrderRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, application.ErrorResponse{
+			Error:   "Invalid request",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	adjustment, err := h.adjustmentService.VoidOrder(c.Request.Context(), orderID,
+		domain.AdjustmentReason(req.Reason), req.Notes, toManagerOverride(req.Approval))
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, adjustment)
+}
+
+// RefundOrder refunds a paid order in full, or the listed items
+// POST /api/v1/orders/:id/refunds
+func (h *AdjustmentHandler) RefundOrder(c *gin.Context) {
+	orderID := domain.OrderID(c.Param("id"))
+
+	var req application.RefundOrderRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, application.ErrorResponse{
+			Error:   "Invalid request",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	itemIDs := make([]domain.OrderItemID, len(req.ItemIDs))
+	for i, itemID := range req.ItemIDs {
+		itemIDs[i] = domain.OrderItemID(itemID)
+	}
+
+	adjustment, err := h.adjustmentService.RefundOrder(c.Request.Context(), orderID, itemIDs,
+		domain.AdjustmentReason(req.Reason), req.Notes, toManagerOverride(req.Approval))
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, adjustment)
+}
+
+// GetAdjustments lists the voids and refunds of an order
+// GET /api/v1/orders/:id/adjustments
+func (h *AdjustmentHandler) GetAdjustments(c *gin.Context) {
+	orderID := domain.OrderID(c.Param("id"))
+
+	adjustments, err := h.adjustmentService.GetAdjustments(c.Request.Context(), orderID)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, adjustments)
+}
+
+func toManagerOverride(approval *application.ManagerApprovalRequest) *domain.ManagerOverride {
+	if approval == nil {
+		return nil
+	}
+	return &domain.ManagerOverride{ManagerID: approval.ManagerID, PIN: approval.PIN}
+}
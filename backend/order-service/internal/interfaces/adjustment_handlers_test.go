@@ -0,0 +1,168 @@
+package interfaces
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/restaurant-platform/order-service/internal/domain"
+	sharedErrors "github.com/restaurant-platform/shared/pkg/errors"
+)
+
+// MockAdjustmentService is a mock implementation of the AdjustmentService interface
+type MockAdjustmentService struct {
+	mock.Mock
+}
+
+func (m *MockAdjustmentService) VoidOrder(ctx context.Context, orderID domain.OrderID, reason domain.AdjustmentReason, notes string, override *domain.ManagerOverride) (*domain.Adjustment, error) {
+	args := m.Called(ctx, orderID, reason, notes, override)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Adjustment), args.Error(1)
+}
+
+func (m *MockAdjustmentService) RefundOrder(ctx context.Context, orderID domain.OrderID, itemIDs []domain.OrderItemID, reason domain.AdjustmentReason, notes string, override *domain.ManagerOverride) (*domain.Adjustment, error) {
+	args := m.Called(ctx, orderID, itemIDs, reason, notes, override)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Adjustment), args.Error(1)
+}
+
+func (m *MockAdjustmentService) GetAdjustments(ctx context.Context, orderID domain.OrderID) ([]*domain.Adjustment, error) {
+	args := m.Called(ctx, orderID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.Adjustment), args.Error(1)
+}
+
+// AdjustmentHandlerTestSuite contains all void and refund handler tests
+type AdjustmentHandlerTestSuite struct {
+	suite.Suite
+	router      *gin.Engine
+	mockService *MockAdjustmentService
+	handler     *AdjustmentHandler
+}
+
+func (suite *AdjustmentHandlerTestSuite) SetupTest() {
+	gin.SetMode(gin.TestMode)
+	suite.mockService = new(MockAdjustmentService)
+	suite.handler = NewAdjustmentHandler(suite.mockService)
+
+	suite.router = gin.New()
+	api := suite.router.Group("/api/v1")
+	{
+		api.POST("/orders/:id/void", suite.handler.VoidOrder)
+		api.POST("/orders/:id/refunds", suite.handler.RefundOrder)
+		api.GET("/orders/:id/adjustments", suite.handler.GetAdjustments)
+	}
+}
+
+func TestAdjustmentHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(AdjustmentHandlerTestSuite))
+}
+
+func (suite *AdjustmentHandlerTestSuite) post(path, body string) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", path, bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", "application/json")
+	suite.router.ServeHTTP(w, req)
+	return w
+}
+
+func (suite *AdjustmentHandlerTestSuite) TestVoidOrder_Success() {
+	// Given
+	adjustment := &domain.Adjustment{ID: "adj_1", OrderID: "ord_123", Type: domain.AdjustmentTypeVoid, Amount: 27.50}
+	suite.mockService.On("VoidOrder", mock.Anything, domain.OrderID("ord_123"), domain.AdjustmentReasonOrderEntryError, "wrong table", (*domain.ManagerOverride)(nil)).
+		Return(adjustment, nil)
+
+	// When
+	w := suite.post("/api/v1/orders/ord_123/void", `{"reason":"ORDER_ENTRY_ERROR","notes":"wrong table"}`)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.Equal(http.StatusCreated, w.Code)
+	var response domain.Adjustment
+	json.Unmarshal(w.Body.Bytes(), &response)
+	assert.Equal(domain.AdjustmentTypeVoid, response.Type)
+	assert.Equal(27.50, response.Amount)
+}
+
+func (suite *AdjustmentHandlerTestSuite) TestVoidOrder_MissingReason_ShouldReturnBadRequest() {
+	// When
+	w := suite.post("/api/v1/orders/ord_123/void", `{"notes":"wrong table"}`)
+
+	// Then
+	assert.New(suite.T()).Equal(http.StatusBadRequest, w.Code)
+	suite.mockService.AssertNotCalled(suite.T(), "VoidOrder", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *AdjustmentHandlerTestSuite) TestVoidOrder_ApprovalRequired_ShouldReturnForbidden() {
+	// Given
+	suite.mockService.On("VoidOrder", mock.Anything, domain.OrderID("ord_123"), domain.AdjustmentReasonCustomerRequest, "", (*domain.ManagerOverride)(nil)).
+		Return(nil, sharedErrors.WrapForbidden("approve", "VOID of 75.00 requires manager approval", nil))
+
+	// When
+	w := suite.post("/api/v1/orders/ord_123/void", `{"reason":"CUSTOMER_REQUEST"}`)
+
+	// Then
+	assert.New(suite.T()).Equal(http.StatusForbidden, w.Code)
+}
+
+func (suite *AdjustmentHandlerTestSuite) TestRefundOrder_WithItemsAndPIN_Success() {
+	// Given
+	override := &domain.ManagerOverride{ManagerID: "manager-1", PIN: "1234"}
+	adjustment := &domain.Adjustment{ID: "adj_1", OrderID: "ord_123", Type: domain.AdjustmentTypeRefund, Amount: 5.50}
+	suite.mockService.On("RefundOrder", mock.Anything, domain.OrderID("ord_123"), []domain.OrderItemID{"itm_1"},
+		domain.AdjustmentReasonQualityIssue, "", override).Return(adjustment, nil)
+
+	// When
+	w := suite.post("/api/v1/orders/ord_123/refunds",
+		`{"item_ids":["itm_1"],"reason":"QUALITY_ISSUE","approval":{"manager_id":"manager-1","pin":"1234"}}`)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.Equal(http.StatusCreated, w.Code)
+	suite.mockService.AssertExpectations(suite.T())
+}
+
+func (suite *AdjustmentHandlerTestSuite) TestRefundOrder_InvalidReason_ShouldReturnBadRequest() {
+	// Given
+	suite.mockService.On("RefundOrder", mock.Anything, domain.OrderID("ord_123"), []domain.OrderItemID{},
+		domain.AdjustmentReason("BORED"), "", (*domain.ManagerOverride)(nil)).
+		Return(nil, sharedErrors.WrapValidation("ValidateAdjustmentReason", "reason", "invalid reason code", nil))
+
+	// When
+	w := suite.post("/api/v1/orders/ord_123/refunds", `{"reason":"BORED"}`)
+
+	// Then
+	assert.New(suite.T()).Equal(http.StatusBadRequest, w.Code)
+}
+
+func (suite *AdjustmentHandlerTestSuite) TestGetAdjustments_Success() {
+	// Given
+	adjustments := []*domain.Adjustment{{ID: "adj_1", OrderID: "ord_123", Type: domain.AdjustmentTypeRefund, Amount: 5.50}}
+	suite.mockService.On("GetAdjustments", mock.Anything, domain.OrderID("ord_123")).Return(adjustments, nil)
+
+	// When
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/api/v1/orders/ord_123/adjustments", nil)
+	suite.router.ServeHTTP(w, req)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.Equal(http.StatusOK, w.Code)
+	var response []*domain.Adjustment
+	json.Unmarshal(w.Body.Bytes(), &response)
+	assert.Len(response, 1)
+}
@@ -0,0 +1,259 @@
+package interfaces
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/restaurant-platform/order-service/internal/application"
+	"github.com/restaurant-platform/order-service/internal/domain"
+	"github.com/restaurant-platform/shared/pkg/errors"
+)
+
+// DeliveryHandler handles HTTP requests for delivery dispatch
+type DeliveryHandler struct {
+	deliveryService domain.DeliveryService
+}
+
+// NewDeliveryHandler creates a new delivery handler
+func NewDeliveryHandler(deliveryService domain.DeliveryService) *DeliveryHandler {
+	return &DeliveryHandler{
+		deliveryService: deliveryService,
+	}
+}
+
+// CreateZone defines a new delivery zone
+// POST /api/v1/delivery/zones
+func (h *DeliveryHandler) CreateZone(c *gin.Context) {
+	var req application.CreateDeliveryZoneRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, application.ErrorResponse{
+			Error:   "Invalid request",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	zone, err := h.deliveryService.CreateZone(c.Request.Context(), req.Name, req.ToBoundary(), req.Fee, req.MinimumOrder)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, application.ToDeliveryZoneResponse(zone))
+}
+
+// ListZones retrieves all delivery zones
+// GET /api/v1/delivery/zones
+func (h *DeliveryHandler) ListZones(c *gin.Context) {
+	zones, err := h.deliveryService.ListZones(c.Request.Context())
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, application.ToDeliveryZoneResponses(zones))
+}
+
+// SetZoneActive enables or disables a delivery zone
+// PATCH /api/v1/delivery/zones/:zoneId/active
+func (h *DeliveryHandler) SetZoneActive(c *gin.Context) {
+	zoneID := domain.DeliveryZoneID(c.Param("zoneId"))
+
+	var req application.SetZoneActiveRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, application.ErrorResponse{
+			Error:   "Invalid request",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	zone, err := h.deliveryService.SetZoneActive(c.Request.Context(), zoneID, *req.IsActive)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, application.ToDeliveryZoneResponse(zone))
+}
+
+// QuoteDelivery returns the zone, fee and minimum order for an address
+// POST /api/v1/delivery/quote
+func (h *DeliveryHandler) QuoteDelivery(c *gin.Context) {
+	var req application.QuoteDeliveryRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, application.ErrorResponse{
+			Error:   "Invalid request",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	zone, err := h.deliveryService.QuoteDelivery(c.Request.Context(), req.Address)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, application.ToDeliveryQuoteResponse(zone))
+}
+
+// CreateDriver registers a new driver
+// POST /api/v1/delivery/drivers
+func (h *DeliveryHandler) CreateDriver(c *gin.Context) {
+	var req application.CreateDriverRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, application.ErrorResponse{
+			Error:   "Invalid request",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	driver, err := h.deliveryService.CreateDriver(c.Request.Context(), req.Name, req.Phone)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, application.ToDriverResponse(driver))
+}
+
+// ListDrivers retrieves all drivers
+// GET /api/v1/delivery/drivers
+func (h *DeliveryHandler) ListDrivers(c *gin.Context) {
+	drivers, err := h.deliveryService.ListDrivers(c.Request.Context())
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, application.ToDriverResponses(drivers))
+}
+
+// SetDriverStatus moves a driver between available and off duty
+// PATCH /api/v1/delivery/drivers/:driverId/status
+func (h *DeliveryHandler) SetDriverStatus(c *gin.Context) {
+	driverID := domain.DriverID(c.Param("driverId"))
+
+	var req application.SetDriverStatusRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, application.ErrorResponse{
+			Error:   "Invalid request",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	status, err := validateDriverStatus(req.Status)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, application.ErrorResponse{
+			Error:   "Invalid driver status",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	driver, err := h.deliveryService.SetDriverStatus(c.Request.Context(), driverID, status)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, application.ToDriverResponse(driver))
+}
+
+// CreateDelivery books a delivery for an order and charges the zone fee
+// POST /api/v1/orders/:id/delivery
+func (h *DeliveryHandler) CreateDelivery(c *gin.Context) {
+	orderID := domain.OrderID(c.Param("id"))
+
+	delivery, err := h.deliveryService.CreateDelivery(c.Request.Context(), orderID)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, application.ToDeliveryResponse(delivery))
+}
+
+// GetDelivery retrieves the delivery booked for an order
+// GET /api/v1/orders/:id/delivery
+func (h *DeliveryHandler) GetDelivery(c *gin.Context) {
+	orderID := domain.OrderID(c.Param("id"))
+
+	delivery, err := h.deliveryService.GetDeliveryForOrder(c.Request.Context(), orderID)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, application.ToDeliveryResponse(delivery))
+}
+
+// AssignDriver hands a delivery to a driver, or to the next available driver
+// POST /api/v1/deliveries/:deliveryId/assign
+func (h *DeliveryHandler) AssignDriver(c *gin.Context) {
+	deliveryID := domain.DeliveryID(c.Param("deliveryId"))
+
+	var req application.AssignDriverRequest
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, application.ErrorResponse{
+				Error:   "Invalid request",
+				Message: err.Error(),
+			})
+			return
+		}
+	}
+
+	delivery, err := h.deliveryService.AssignDriver(c.Request.Context(), deliveryID, domain.DriverID(req.DriverID))
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, application.ToDeliveryResponse(delivery))
+}
+
+// PickUpDelivery records the driver leaving with the order
+// POST /api/v1/deliveries/:deliveryId/pickup
+func (h *DeliveryHandler) PickUpDelivery(c *gin.Context) {
+	deliveryID := domain.DeliveryID(c.Param("deliveryId"))
+
+	delivery, err := h.deliveryService.PickUpDelivery(c.Request.Context(), deliveryID)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, application.ToDeliveryResponse(delivery))
+}
+
+// CompleteDelivery records the hand-over to the customer
+// POST /api/v1/deliveries/:deliveryId/complete
+func (h *DeliveryHandler) CompleteDelivery(c *gin.Context) {
+	deliveryID := domain.DeliveryID(c.Param("deliveryId"))
+
+	delivery, err := h.deliveryService.CompleteDelivery(c.Request.Context(), deliveryID)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, application.ToDeliveryResponse(delivery))
+}
+
+// Helper functions
+
+func validateDriverStatus(status string) (domain.DriverStatus, error) {
+	switch status {
+	case string(domain.DriverStatusAvailable):
+		return domain.DriverStatusAvailable, nil
+	case string(domain.DriverStatusOffDuty):
+		return domain.DriverStatusOffDuty, nil
+	default:
+		return "", errors.WrapValidation("validateDriverStatus", "status", "driver status must be AVAILABLE or OFF_DUTY", nil)
+	}
+}
@@ -0,0 +1,266 @@
+package interfaces
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/restaurant-platform/order-service/internal/application"
+	"github.com/restaurant-platform/order-service/internal/domain"
+	sharedErrors "github.com/restaurant-platform/shared/pkg/errors"
+)
+
+// MockDeliveryService is a mock implementation of the DeliveryService interface
+type MockDeliveryService struct {
+	mock.Mock
+}
+
+func (m *MockDeliveryService) CreateZone(ctx context.Context, name string, boundary []domain.Coordinates, fee, minimumOrder float64) (*domain.DeliveryZone, error) {
+	args := m.Called(ctx, name, boundary, fee, minimumOrder)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.DeliveryZone), args.Error(1)
+}
+
+func (m *MockDeliveryService) SetZoneActive(ctx context.Context, zoneID domain.DeliveryZoneID, isActive bool) (*domain.DeliveryZone, error) {
+	args := m.Called(ctx, zoneID, isActive)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.DeliveryZone), args.Error(1)
+}
+
+func (m *MockDeliveryService) ListZones(ctx context.Context) ([]*domain.DeliveryZone, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]*domain.DeliveryZone), args.Error(1)
+}
+
+func (m *MockDeliveryService) QuoteDelivery(ctx context.Context, address string) (*domain.DeliveryZone, error) {
+	args := m.Called(ctx, address)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.DeliveryZone), args.Error(1)
+}
+
+func (m *MockDeliveryService) CreateDriver(ctx context.Context, name, phone string) (*domain.Driver, error) {
+	args := m.Called(ctx, name, phone)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Driver), args.Error(1)
+}
+
+func (m *MockDeliveryService) SetDriverStatus(ctx context.Context, driverID domain.DriverID, status domain.DriverStatus) (*domain.Driver, error) {
+	args := m.Called(ctx, driverID, status)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Driver), args.Error(1)
+}
+
+func (m *MockDeliveryService) ListDrivers(ctx context.Context) ([]*domain.Driver, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]*domain.Driver), args.Error(1)
+}
+
+func (m *MockDeliveryService) CreateDelivery(ctx context.Context, orderID domain.OrderID) (*domain.Delivery, error) {
+	args := m.Called(ctx, orderID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Delivery), args.Error(1)
+}
+
+func (m *MockDeliveryService) GetDeliveryForOrder(ctx context.Context, orderID domain.OrderID) (*domain.Delivery, error) {
+	args := m.Called(ctx, orderID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Delivery), args.Error(1)
+}
+
+func (m *MockDeliveryService) AssignDriver(ctx context.Context, deliveryID domain.DeliveryID, driverID domain.DriverID) (*domain.Delivery, error) {
+	args := m.Called(ctx, deliveryID, driverID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Delivery), args.Error(1)
+}
+
+func (m *MockDeliveryService) PickUpDelivery(ctx context.Context, deliveryID domain.DeliveryID) (*domain.Delivery, error) {
+	args := m.Called(ctx, deliveryID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Delivery), args.Error(1)
+}
+
+func (m *MockDeliveryService) CompleteDelivery(ctx context.Context, deliveryID domain.DeliveryID) (*domain.Delivery, error) {
+	args := m.Called(ctx, deliveryID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Delivery), args.Error(1)
+}
+
+// DeliveryHandlerTestSuite contains all delivery handler tests
+type DeliveryHandlerTestSuite struct {
+	suite.Suite
+	router      *gin.Engine
+	mockService *MockDeliveryService
+	handler     *DeliveryHandler
+	zone        *domain.DeliveryZone
+}
+
+func (suite *DeliveryHandlerTestSuite) SetupTest() {
+	gin.SetMode(gin.TestMode)
+	suite.mockService = new(MockDeliveryService)
+	suite.handler = NewDeliveryHandler(suite.mockService)
+
+	suite.router = gin.New()
+	api := suite.router.Group("/api/v1")
+	{
+		api.POST("/delivery/zones", suite.handler.CreateZone)
+		api.POST("/delivery/quote", suite.handler.QuoteDelivery)
+		api.PATCH("/delivery/drivers/:driverId/status", suite.handler.SetDriverStatus)
+		api.POST("/orders/:id/delivery", suite.handler.CreateDelivery)
+		api.POST("/deliveries/:deliveryId/assign", suite.handler.AssignDriver)
+	}
+
+	suite.zone, _ = domain.NewDeliveryZone("Downtown", []domain.Coordinates{
+		{Lat: 40.70, Lng: -74.02},
+		{Lat: 40.80, Lng: -74.02},
+		{Lat: 40.80, Lng: -73.92},
+	}, 4.99, 15.00)
+}
+
+func TestDeliveryHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(DeliveryHandlerTestSuite))
+}
+
+func (suite *DeliveryHandlerTestSuite) TestCreateZone_Success() {
+	// Given
+	boundary := []domain.Coordinates{
+		{Lat: 40.70, Lng: -74.02},
+		{Lat: 40.80, Lng: -74.02},
+		{Lat: 40.80, Lng: -73.92},
+	}
+	suite.mockService.On("CreateZone", mock.Anything, "Downtown", boundary, 4.99, 15.00).Return(suite.zone, nil)
+
+	body, _ := json.Marshal(application.CreateDeliveryZoneRequest{
+		Name: "Downtown",
+		Boundary: []application.CoordinatesRequest{
+			{Lat: 40.70, Lng: -74.02},
+			{Lat: 40.80, Lng: -74.02},
+			{Lat: 40.80, Lng: -73.92},
+		},
+		Fee:          4.99,
+		MinimumOrder: 15.00,
+	})
+
+	// When
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", "/api/v1/delivery/zones", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	suite.router.ServeHTTP(w, req)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.Equal(http.StatusCreated, w.Code)
+
+	var response application.DeliveryZoneResponse
+	json.Unmarshal(w.Body.Bytes(), &response)
+	assert.Equal("Downtown", response.Name)
+	assert.Len(response.Boundary, 3)
+	suite.mockService.AssertExpectations(suite.T())
+}
+
+func (suite *DeliveryHandlerTestSuite) TestCreateZone_TooFewPoints_ShouldReturnBadRequest() {
+	// Given
+	body, _ := json.Marshal(application.CreateDeliveryZoneRequest{
+		Name:     "Line",
+		Boundary: []application.CoordinatesRequest{{Lat: 1, Lng: 1}, {Lat: 2, Lng: 2}},
+	})
+
+	// When
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", "/api/v1/delivery/zones", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	suite.router.ServeHTTP(w, req)
+
+	// Then
+	assert.New(suite.T()).Equal(http.StatusBadRequest, w.Code)
+	suite.mockService.AssertNotCalled(suite.T(), "CreateZone")
+}
+
+func (suite *DeliveryHandlerTestSuite) TestQuoteDelivery_OutsideZones_ShouldReturnBadRequest() {
+	// Given
+	suite.mockService.On("QuoteDelivery", mock.Anything, "99 Far Rd").
+		Return(nil, sharedErrors.WrapValidation("FindZone", "address", "address is outside every delivery zone", nil))
+
+	body, _ := json.Marshal(application.QuoteDeliveryRequest{Address: "99 Far Rd"})
+
+	// When
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", "/api/v1/delivery/quote", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	suite.router.ServeHTTP(w, req)
+
+	// Then
+	assert.New(suite.T()).Equal(http.StatusBadRequest, w.Code)
+}
+
+func (suite *DeliveryHandlerTestSuite) TestSetDriverStatus_InvalidStatus_ShouldReturnBadRequest() {
+	// Given
+	body, _ := json.Marshal(application.SetDriverStatusRequest{Status: "ON_DELIVERY"})
+
+	// When
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("PATCH", "/api/v1/delivery/drivers/drv_1/status", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	suite.router.ServeHTTP(w, req)
+
+	// Then
+	assert.New(suite.T()).Equal(http.StatusBadRequest, w.Code)
+	suite.mockService.AssertNotCalled(suite.T(), "SetDriverStatus")
+}
+
+func (suite *DeliveryHandlerTestSuite) TestCreateDelivery_BelowMinimum_ShouldReturnUnprocessable() {
+	// Given
+	suite.mockService.On("CreateDelivery", mock.Anything, domain.OrderID("ord_123")).
+		Return(nil, sharedErrors.WrapConflict("CreateDelivery", "minimum_order", "order subtotal is below the minimum", nil))
+
+	// When
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", "/api/v1/orders/ord_123/delivery", nil)
+	suite.router.ServeHTTP(w, req)
+
+	// Then
+	assert.New(suite.T()).Equal(http.StatusUnprocessableEntity, w.Code)
+}
+
+func (suite *DeliveryHandlerTestSuite) TestAssignDriver_WithoutBody_AssignsNextAvailable() {
+	// Given
+	delivery, _ := domain.NewDelivery(domain.OrderID("ord_123"), suite.zone, "1 Main St", domain.Coordinates{Lat: 40.78, Lng: -74.00})
+	suite.mockService.On("AssignDriver", mock.Anything, delivery.ID, domain.DriverID("")).Return(delivery, nil)
+
+	// When
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", "/api/v1/deliveries/"+string(delivery.ID)+"/assign", nil)
+	suite.router.ServeHTTP(w, req)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.Equal(http.StatusOK, w.Code)
+	suite.mockService.AssertExpectations(suite.T())
+}
@@ -0,0 +1,152 @@
+package interfaces
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/restaurant-platform/order-service/internal/application"
+	"github.com/restaurant-platform/order-service/internal/domain"
+)
+
+// DrawerHandler handles HTTP requests for cashier drawer sessions
+type DrawerHandler struct {
+	drawerService domain.DrawerService
+}
+
+// NewDrawerHandler creates a new drawer handler
+func NewDrawerHandler(drawerService domain.DrawerService) *DrawerHandler {
+	return &DrawerHandler{
+		drawerService: drawerService,
+	}
+}
+
+// OpenSession opens a drawer session for the authenticated cashier
+// POST /api/v1/drawers
+func (h *DrawerHandler) OpenSession(c *gin.Context) {
+	var req application.OpenDrawerRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, application.ErrorResponse{
+			Error:   "Invalid request",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	session, err := h.drawerService.OpenSession(c.Request.Context(), req.StartingFloat)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, session)
+}
+
+// ListSessions lists drawer sessions by status, the ones awaiting sign-off by default
+// GET /api/v1/drawers?status=CLOSED
+func (h *DrawerHandler) ListSessions(c *gin.Context) {
+	var req application.ListDrawerSessionsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		c.JSON(http.StatusBadRequest, application.ErrorResponse{
+			Error:   "Invalid request",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	sessions, err := h.drawerService.ListSessions(c.Request.Context(), domain.DrawerSessionStatus(req.Status))
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, sessions)
+}
+
+// GetCurrentSession returns the open drawer session of the authenticated cashier
+// GET /api/v1/drawers/current
+func (h *DrawerHandler) GetCurrentSession(c *gin.Context) {
+	session, err := h.drawerService.GetCurrentSession(c.Request.Context())
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, session)
+}
+
+// GetSession returns a drawer session with its report
+// GET /api/v1/drawers/:id
+func (h *DrawerHandler) GetSession(c *gin.Context) {
+	session, err := h.drawerService.GetSession(c.Request.Context(), domain.DrawerSessionID(c.Param("id")))
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, session)
+}
+
+// RecordMovement records a paid-in, paid-out or drop on a drawer session
+// POST /api/v1/drawers/:id/movements
+func (h *DrawerHandler) RecordMovement(c *gin.Context) {
+	var req application.DrawerMovementRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, application.ErrorResponse{
+			Error:   "Invalid request",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	session, err := h.drawerService.RecordMovement(c.Request.Context(), domain.DrawerSessionID(c.Param("id")),
+		domain.DrawerMovementType(req.Type), req.Amount, req.Reason)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, session)
+}
+
+// CloseSession counts a drawer and returns its over/short report
+// POST /api/v1/drawers/:id/close
+func (h *DrawerHandler) CloseSession(c *gin.Context) {
+	var req application.CloseDrawerRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, application.ErrorResponse{
+			Error:   "Invalid request",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	session, err := h.drawerService.CloseSession(c.Request.Context(), domain.DrawerSessionID(c.Param("id")), req.Counted)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, session)
+}
+
+// SignOff signs off a closed drawer session
+// POST /api/v1/drawers/:id/sign-off
+func (h *DrawerHandler) SignOff(c *gin.Context) {
+	var req application.SignOffDrawerRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, application.ErrorResponse{
+			Error:   "Invalid request",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	session, err := h.drawerService.SignOff(c.Request.Context(), domain.DrawerSessionID(c.Param("id")), req.Notes)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, session)
+}
@@ -0,0 +1,230 @@
+package interfaces
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/restaurant-platform/order-service/internal/domain"
+	sharedErrors "github.com/restaurant-platform/shared/pkg/errors"
+)
+
+// MockDrawerService is a mock implementation of the DrawerService interface
+type MockDrawerService struct {
+	mock.Mock
+}
+
+func (m *MockDrawerService) OpenSession(ctx context.Context, startingFloat float64) (*domain.DrawerSession, error) {
+	args := m.Called(ctx, startingFloat)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.DrawerSession), args.Error(1)
+}
+
+func (m *MockDrawerService) GetSession(ctx context.Context, id domain.DrawerSessionID) (*domain.DrawerSession, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.DrawerSession), args.Error(1)
+}
+
+func (m *MockDrawerService) GetCurrentSession(ctx context.Context) (*domain.DrawerSession, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.DrawerSession), args.Error(1)
+}
+
+func (m *MockDrawerService) ListSessions(ctx context.Context, status domain.DrawerSessionStatus) ([]*domain.DrawerSession, error) {
+	args := m.Called(ctx, status)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.DrawerSession), args.Error(1)
+}
+
+func (m *MockDrawerService) RecordMovement(ctx context.Context, id domain.DrawerSessionID, movementType domain.DrawerMovementType, amount float64, reason string) (*domain.DrawerSession, error) {
+	args := m.Called(ctx, id, movementType, amount, reason)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.DrawerSession), args.Error(1)
+}
+
+func (m *MockDrawerService) CloseSession(ctx context.Context, id domain.DrawerSessionID, counted float64) (*domain.DrawerSession, error) {
+	args := m.Called(ctx, id, counted)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.DrawerSession), args.Error(1)
+}
+
+func (m *MockDrawerService) SignOff(ctx context.Context, id domain.DrawerSessionID, notes string) (*domain.DrawerSession, error) {
+	args := m.Called(ctx, id, notes)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.DrawerSession), args.Error(1)
+}
+
+// DrawerHandlerTestSuite contains drawer session handler tests
+type DrawerHandlerTestSuite struct {
+	suite.Suite
+	router      *gin.Engine
+	mockService *MockDrawerService
+	handler     *DrawerHandler
+	session     *domain.DrawerSession
+}
+
+func (suite *DrawerHandlerTestSuite) SetupTest() {
+	gin.SetMode(gin.TestMode)
+	suite.mockService = new(MockDrawerService)
+	suite.handler = NewDrawerHandler(suite.mockService)
+
+	suite.router = gin.New()
+	api := suite.router.Group("/api/v1")
+	{
+		api.POST("/drawers", suite.handler.OpenSession)
+		api.GET("/drawers", suite.handler.ListSessions)
+		api.GET("/drawers/current", suite.handler.GetCurrentSession)
+		api.GET("/drawers/:id", suite.handler.GetSession)
+		api.POST("/drawers/:id/movements", suite.handler.RecordMovement)
+		api.POST("/drawers/:id/close", suite.handler.CloseSession)
+		api.POST("/drawers/:id/sign-off", suite.handler.SignOff)
+	}
+
+	suite.session, _ = domain.NewDrawerSession("cashier-1", 100.00)
+}
+
+func TestDrawerHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(DrawerHandlerTestSuite))
+}
+
+func (suite *DrawerHandlerTestSuite) request(method, path, body string) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest(method, path, bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", "application/json")
+	suite.router.ServeHTTP(w, req)
+	return w
+}
+
+func (suite *DrawerHandlerTestSuite) TestOpenSession_Success() {
+	// Given
+	suite.mockService.On("OpenSession", mock.Anything, 100.00).Return(suite.session, nil)
+
+	// When
+	w := suite.request("POST", "/api/v1/drawers", `{"starting_float":100}`)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.Equal(http.StatusCreated, w.Code)
+	var response domain.DrawerSession
+	json.Unmarshal(w.Body.Bytes(), &response)
+	assert.Equal(suite.session.ID, response.ID)
+	assert.Equal(domain.DrawerSessionOpen, response.Status)
+}
+
+func (suite *DrawerHandlerTestSuite) TestListSessions_DefaultsToAwaitingSignOff() {
+	// Given
+	suite.mockService.On("ListSessions", mock.Anything, domain.DrawerSessionClosed).Return([]*domain.DrawerSession{}, nil)
+
+	// When
+	w := suite.request("GET", "/api/v1/drawers", "")
+
+	// Then
+	assert.New(suite.T()).Equal(http.StatusOK, w.Code)
+	suite.mockService.AssertExpectations(suite.T())
+}
+
+func (suite *DrawerHandlerTestSuite) TestGetCurrentSession_NoOpenSession_ShouldReturnNotFound() {
+	// Given
+	suite.mockService.On("GetCurrentSession", mock.Anything).
+		Return(nil, sharedErrors.WrapNotFound("GetOpenByCashier", "drawer session", "cashier-1", sharedErrors.ErrNotFound))
+
+	// When
+	w := suite.request("GET", "/api/v1/drawers/current", "")
+
+	// Then
+	assert.New(suite.T()).Equal(http.StatusNotFound, w.Code)
+}
+
+func (suite *DrawerHandlerTestSuite) TestRecordMovement_InvalidType_ShouldReturnBadRequest() {
+	// When
+	w := suite.request("POST", "/api/v1/drawers/"+suite.session.ID.String()+"/movements", `{"type":"TIP","amount":5}`)
+
+	// Then
+	assert.New(suite.T()).Equal(http.StatusBadRequest, w.Code)
+	suite.mockService.AssertNotCalled(suite.T(), "RecordMovement", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *DrawerHandlerTestSuite) TestRecordMovement_Success() {
+	// Given
+	suite.session.RecordMovement(domain.DrawerMovementPaidOut, 12.50, "window cleaner", "cashier-1")
+	suite.mockService.On("RecordMovement", mock.Anything, suite.session.ID, domain.DrawerMovementPaidOut, 12.50, "window cleaner").
+		Return(suite.session, nil)
+
+	// When
+	w := suite.request("POST", "/api/v1/drawers/"+suite.session.ID.String()+"/movements",
+		`{"type":"PAID_OUT","amount":12.5,"reason":"window cleaner"}`)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.Equal(http.StatusCreated, w.Code)
+	var response domain.DrawerSession
+	json.Unmarshal(w.Body.Bytes(), &response)
+	assert.Len(response.Movements, 1)
+}
+
+func (suite *DrawerHandlerTestSuite) TestCloseSession_ReturnsReport() {
+	// Given
+	suite.session.Close(98.00, nil)
+	suite.mockService.On("CloseSession", mock.Anything, suite.session.ID, 98.00).Return(suite.session, nil)
+
+	// When
+	w := suite.request("POST", "/api/v1/drawers/"+suite.session.ID.String()+"/close", `{"counted":98}`)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.Equal(http.StatusOK, w.Code)
+	var response domain.DrawerSession
+	json.Unmarshal(w.Body.Bytes(), &response)
+	assert.Equal(domain.DrawerSessionClosed, response.Status)
+	assert.Equal(-2.00, response.Report.OverShort)
+}
+
+func (suite *DrawerHandlerTestSuite) TestCloseSession_OpenOrders_ShouldReturnUnprocessableEntity() {
+	// Given
+	suite.mockService.On("CloseSession", mock.Anything, suite.session.ID, 98.00).
+		Return(nil, sharedErrors.WrapConflict("Close", "orders", "drawer still has open orders: ord_1", nil))
+
+	// When
+	w := suite.request("POST", "/api/v1/drawers/"+suite.session.ID.String()+"/close", `{"counted":98}`)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.Equal(http.StatusUnprocessableEntity, w.Code)
+	assert.Contains(w.Body.String(), "ord_1")
+}
+
+func (suite *DrawerHandlerTestSuite) TestSignOff_Forbidden() {
+	// Given
+	suite.mockService.On("SignOff", mock.Anything, suite.session.ID, "").
+		Return(nil, sharedErrors.WrapForbidden("SignOff", "only a manager can sign off a drawer session", nil))
+
+	// When
+	w := suite.request("POST", "/api/v1/drawers/"+suite.session.ID.String()+"/sign-off", `{}`)
+
+	// Then
+	assert.New(suite.T()).Equal(http.StatusForbidden, w.Code)
+}
@@ -0,0 +1,98 @@
+package interfaces
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/restaurant-platform/order-service/internal/application"
+	"github.com/restaurant-platform/order-service/internal/domain"
+)
+
+// GiftCardHandler handles HTTP requests for gift cards. Card codes are secrets,
+// so they are always sent in the request body rather than the URL.
+type GiftCardHandler struct {
+	giftCardService domain.GiftCardService
+}
+
+// NewGiftCardHandler creates a new gift card handler
+func NewGiftCardHandler(giftCardService domain.GiftCardService) *GiftCardHandler {
+	return &GiftCardHandler{
+		giftCardService: giftCardService,
+	}
+}
+
+// IssueGiftCard issues a new gift card; the response is the only one carrying the full code
+// POST /api/v1/gift-cards
+func (h *GiftCardHandler) IssueGiftCard(c *gin.Context) {
+	var req application.IssueGiftCardRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, application.ErrorResponse{
+			Error:   "Invalid request",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	card, err := h.giftCardService.Issue(c.Request.Context(), req.Amount)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, application.ToGiftCardResponse(card))
+}
+
+// GetBalance looks up the balance of a gift card by its code
+// POST /api/v1/gift-cards/balance
+func (h *GiftCardHandler) GetBalance(c *gin.Context) {
+	var req application.GiftCardCodeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, application.ErrorResponse{
+			Error:   "Invalid request",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	card, err := h.giftCardService.CheckBalance(c.Request.Context(), req.Code)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, application.ToGiftCardResponse(card))
+}
+
+// ReloadGiftCard adds money to a gift card
+// POST /api/v1/gift-cards/reload
+func (h *GiftCardHandler) ReloadGiftCard(c *gin.Context) {
+	var req application.ReloadGiftCardRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, application.ErrorResponse{
+			Error:   "Invalid request",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	card, err := h.giftCardService.Reload(c.Request.Context(), req.Code, req.Amount)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, application.ToGiftCardResponse(card))
+}
+
+// GetTransactions returns the balance ledger of a gift card
+// GET /api/v1/gift-cards/:id/transactions
+func (h *GiftCardHandler) GetTransactions(c *gin.Context) {
+	transactions, err := h.giftCardService.GetTransactions(c.Request.Context(), domain.GiftCardID(c.Param("id")))
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, transactions)
+}
@@ -0,0 +1,202 @@
+package interfaces
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/restaurant-platform/order-service/internal/application"
+	"github.com/restaurant-platform/order-service/internal/domain"
+	sharedErrors "github.com/restaurant-platform/shared/pkg/errors"
+)
+
+// MockGiftCardService is a mock implementation of the GiftCardService interface
+type MockGiftCardService struct {
+	mock.Mock
+}
+
+func (m *MockGiftCardService) Issue(ctx context.Context, amount float64) (*domain.GiftCard, error) {
+	args := m.Called(ctx, amount)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.GiftCard), args.Error(1)
+}
+
+func (m *MockGiftCardService) Reload(ctx context.Context, code string, amount float64) (*domain.GiftCard, error) {
+	args := m.Called(ctx, code, amount)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.GiftCard), args.Error(1)
+}
+
+func (m *MockGiftCardService) CheckBalance(ctx context.Context, code string) (*domain.GiftCard, error) {
+	args := m.Called(ctx, code)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.GiftCard), args.Error(1)
+}
+
+func (m *MockGiftCardService) GetTransactions(ctx context.Context, id domain.GiftCardID) ([]*domain.GiftCardTransaction, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.GiftCardTransaction), args.Error(1)
+}
+
+func (m *MockGiftCardService) Redeem(ctx context.Context, code string, amount float64, orderID domain.OrderID) (*domain.GiftCardTransaction, error) {
+	args := m.Called(ctx, code, amount, orderID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.GiftCardTransaction), args.Error(1)
+}
+
+func (m *MockGiftCardService) Refund(ctx context.Context, redemptionID domain.GiftCardTransactionID, amount float64) (*domain.GiftCardTransaction, error) {
+	args := m.Called(ctx, redemptionID, amount)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.GiftCardTransaction), args.Error(1)
+}
+
+// GiftCardHandlerTestSuite contains gift card issuance, balance and reload handler tests
+type GiftCardHandlerTestSuite struct {
+	suite.Suite
+	router      *gin.Engine
+	mockService *MockGiftCardService
+	handler     *GiftCardHandler
+	card        *domain.GiftCard
+}
+
+func (suite *GiftCardHandlerTestSuite) SetupTest() {
+	gin.SetMode(gin.TestMode)
+	suite.mockService = new(MockGiftCardService)
+	suite.handler = NewGiftCardHandler(suite.mockService)
+
+	suite.router = gin.New()
+	api := suite.router.Group("/api/v1")
+	{
+		api.POST("/gift-cards", suite.handler.IssueGiftCard)
+		api.POST("/gift-cards/balance", suite.handler.GetBalance)
+		api.POST("/gift-cards/reload", suite.handler.ReloadGiftCard)
+		api.GET("/gift-cards/:id/transactions", suite.handler.GetTransactions)
+	}
+
+	suite.card, _, _ = domain.NewGiftCard("ABCD-EFGH-JKLM-NPQR", 50.00, "cashier-1")
+}
+
+func TestGiftCardHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(GiftCardHandlerTestSuite))
+}
+
+func (suite *GiftCardHandlerTestSuite) post(path, body string) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", path, bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", "application/json")
+	suite.router.ServeHTTP(w, req)
+	return w
+}
+
+func (suite *GiftCardHandlerTestSuite) TestIssueGiftCard_ReturnsCode() {
+	// Given
+	suite.mockService.On("Issue", mock.Anything, 50.00).Return(suite.card, nil)
+
+	// When
+	w := suite.post("/api/v1/gift-cards", `{"amount":50}`)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.Equal(http.StatusCreated, w.Code)
+	var response application.GiftCardResponse
+	json.Unmarshal(w.Body.Bytes(), &response)
+	assert.Equal("ABCD-EFGH-JKLM-NPQR", response.Code)
+	assert.Equal("****-NPQR", response.MaskedCode)
+	assert.Equal(50.00, response.Balance)
+}
+
+func (suite *GiftCardHandlerTestSuite) TestIssueGiftCard_InvalidAmount_ShouldReturnBadRequest() {
+	// When
+	w := suite.post("/api/v1/gift-cards", `{"amount":-5}`)
+
+	// Then
+	assert.New(suite.T()).Equal(http.StatusBadRequest, w.Code)
+	suite.mockService.AssertNotCalled(suite.T(), "Issue", mock.Anything, mock.Anything)
+}
+
+func (suite *GiftCardHandlerTestSuite) TestGetBalance_HidesCode() {
+	// Given
+	suite.card.Code = ""
+	suite.mockService.On("CheckBalance", mock.Anything, "ABCD-EFGH-JKLM-NPQR").Return(suite.card, nil)
+
+	// When
+	w := suite.post("/api/v1/gift-cards/balance", `{"code":"ABCD-EFGH-JKLM-NPQR"}`)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.Equal(http.StatusOK, w.Code)
+	assert.NotContains(w.Body.String(), "ABCD")
+	var response application.GiftCardResponse
+	json.Unmarshal(w.Body.Bytes(), &response)
+	assert.Equal(50.00, response.Balance)
+}
+
+func (suite *GiftCardHandlerTestSuite) TestGetBalance_UnknownCode_ShouldReturnNotFound() {
+	// Given
+	suite.mockService.On("CheckBalance", mock.Anything, "ZZZZ-ZZZZ-ZZZZ-ZZZZ").
+		Return(nil, sharedErrors.WrapNotFound("GetByCodeHash", "gift card", "code", sharedErrors.ErrNotFound))
+
+	// When
+	w := suite.post("/api/v1/gift-cards/balance", `{"code":"ZZZZ-ZZZZ-ZZZZ-ZZZZ"}`)
+
+	// Then
+	assert.New(suite.T()).Equal(http.StatusNotFound, w.Code)
+}
+
+func (suite *GiftCardHandlerTestSuite) TestReloadGiftCard_Success() {
+	// Given
+	suite.card.Code = ""
+	suite.card.Reload(25.00, "cashier-1")
+	suite.mockService.On("Reload", mock.Anything, "ABCD-EFGH-JKLM-NPQR", 25.00).Return(suite.card, nil)
+
+	// When
+	w := suite.post("/api/v1/gift-cards/reload", `{"code":"ABCD-EFGH-JKLM-NPQR","amount":25}`)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.Equal(http.StatusOK, w.Code)
+	var response application.GiftCardResponse
+	json.Unmarshal(w.Body.Bytes(), &response)
+	assert.Equal(75.00, response.Balance)
+}
+
+func (suite *GiftCardHandlerTestSuite) TestGetTransactions_Success() {
+	// Given
+	redemption, _ := suite.card.Redeem(20.00, "ord_1", "cashier-1")
+	suite.mockService.On("GetTransactions", mock.Anything, suite.card.ID).Return([]*domain.GiftCardTransaction{redemption}, nil)
+
+	// When
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/api/v1/gift-cards/"+suite.card.ID.String()+"/transactions", nil)
+	suite.router.ServeHTTP(w, req)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.Equal(http.StatusOK, w.Code)
+	var response []*domain.GiftCardTransaction
+	json.Unmarshal(w.Body.Bytes(), &response)
+	assert.Len(response, 1)
+	assert.Equal(-20.00, response[0].Amount)
+	assert.Equal(30.00, response[0].BalanceAfter)
+}
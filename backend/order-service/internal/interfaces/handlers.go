@@ -2,24 +2,29 @@ package interfaces
 
 import (
 	"net/http"
+	"strconv"
 	"time"
 
 	"github.com/gin-gonic/gin"
 
 	"github.com/restaurant-platform/order-service/internal/application"
 	"github.com/restaurant-platform/order-service/internal/domain"
+	"github.com/restaurant-platform/shared/pkg/auth"
+	"github.com/restaurant-platform/shared/pkg/concurrency"
 	"github.com/restaurant-platform/shared/pkg/errors"
 )
 
 // OrderHandler handles HTTP requests for orders
 type OrderHandler struct {
 	orderService domain.OrderService
+	tableService domain.TableService
 }
 
 // NewOrderHandler creates a new order handler
-func NewOrderHandler(orderService domain.OrderService) *OrderHandler {
+func NewOrderHandler(orderService domain.OrderService, tableService domain.TableService) *OrderHandler {
 	return &OrderHandler{
 		orderService: orderService,
+		tableService: tableService,
 	}
 }
 
@@ -45,7 +50,12 @@ func (h *OrderHandler) CreateOrder(c *gin.Context) {
 		return
 	}
 
-	order, err := h.orderService.CreateOrder(c.Request.Context(), req.CustomerID, orderType)
+	var order *domain.Order
+	if req.FulfillmentTime != nil {
+		order, err = h.orderService.CreateScheduledOrder(c.Request.Context(), req.CustomerID, orderType, *req.FulfillmentTime)
+	} else {
+		order, err = h.orderService.CreateOrder(c.Request.Context(), req.CustomerID, orderType)
+	}
 	if err != nil {
 		handleError(c, err)
 		return
@@ -58,6 +68,21 @@ func (h *OrderHandler) CreateOrder(c *gin.Context) {
 			return
 		}
 		order.TableID = req.TableID
+		order.Version++
+	}
+
+	// Dine-in guests default to the party size of the table's reservation
+	if orderType == domain.OrderTypeDineIn && (req.TableID != "" || req.GuestCount > 0 || req.ReservationID != "") {
+		seated, err := h.tableService.SeatGuests(c.Request.Context(), order.ID, req.GuestCount, req.ReservationID)
+		switch {
+		case err == nil:
+			order = seated
+		case errors.IsNotFound(err) && req.GuestCount == 0 && req.ReservationID == "":
+			// A walk-in without a reservation; its guest count is set once the party is seated
+		default:
+			handleError(c, err)
+			return
+		}
 	}
 
 	if req.Address != "" && orderType == domain.OrderTypeDelivery {
@@ -66,6 +91,7 @@ func (h *OrderHandler) CreateOrder(c *gin.Context) {
 			return
 		}
 		order.DeliveryAddress = req.Address
+		order.Version++
 	}
 
 	if req.Notes != "" {
@@ -74,8 +100,10 @@ func (h *OrderHandler) CreateOrder(c *gin.Context) {
 			return
 		}
 		order.Notes = req.Notes
+		order.Version++
 	}
 
+	concurrency.SetETag(c, order.Version)
 	c.JSON(http.StatusCreated, application.ToOrderResponse(order))
 }
 
@@ -90,6 +118,7 @@ func (h *OrderHandler) GetOrder(c *gin.Context) {
 		return
 	}
 
+	concurrency.SetETag(c, order.Version)
 	c.JSON(http.StatusOK, application.ToOrderResponse(order))
 }
 
@@ -111,9 +140,10 @@ func (h *OrderHandler) AddItemToOrder(c *gin.Context) {
 		c.Request.Context(),
 		id,
 		req.MenuItemID,
-		req.Name,
 		req.Quantity,
-		req.UnitPrice,
+		req.Course,
+		req.Seat,
+		req.ToModifierSelections(),
 		req.Modifications,
 		req.Notes,
 	)
@@ -149,6 +179,30 @@ func (h *OrderHandler) UpdateItemQuantity(c *gin.Context) {
 	c.JSON(http.StatusOK, gin.H{"message": "Item quantity updated successfully"})
 }
 
+// FireCourse releases a held course to the kitchen
+// POST /api/v1/orders/:id/courses/:course/fire
+func (h *OrderHandler) FireCourse(c *gin.Context) {
+	orderID := domain.OrderID(c.Param("id"))
+
+	course, err := strconv.Atoi(c.Param("course"))
+	if err != nil || course < 1 {
+		c.JSON(http.StatusBadRequest, application.ErrorResponse{
+			Error:   "Invalid course",
+			Message: "course must be a positive number",
+		})
+		return
+	}
+
+	order, err := h.orderService.FireCourse(c.Request.Context(), orderID, course)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	concurrency.SetETag(c, order.Version)
+	c.JSON(http.StatusOK, application.ToOrderResponse(order))
+}
+
 // RemoveItemFromOrder removes an item from an order
 // DELETE /api/v1/orders/:id/items/:itemId
 func (h *OrderHandler) RemoveItemFromOrder(c *gin.Context) {
@@ -164,6 +218,30 @@ func (h *OrderHandler) RemoveItemFromOrder(c *gin.Context) {
 	c.JSON(http.StatusOK, gin.H{"message": "Item removed successfully"})
 }
 
+// VoidItem voids an item on an order already sent to the kitchen
+// POST /api/v1/orders/:id/items/:itemId/void
+func (h *OrderHandler) VoidItem(c *gin.Context) {
+	orderID := domain.OrderID(c.Param("id"))
+	itemID := domain.OrderItemID(c.Param("itemId"))
+
+	var req application.VoidItemRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, application.ErrorResponse{
+			Error:   "Invalid request",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	err := h.orderService.VoidItem(c.Request.Context(), orderID, itemID, req.Reason)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Item voided successfully"})
+}
+
 // UpdateOrderStatus updates the status of an order
 // PATCH /api/v1/orders/:id/status
 func (h *OrderHandler) UpdateOrderStatus(c *gin.Context) {
@@ -187,7 +265,17 @@ func (h *OrderHandler) UpdateOrderStatus(c *gin.Context) {
 		return
 	}
 
-	err = h.orderService.UpdateOrderStatus(c.Request.Context(), id, status)
+	// Cancelling an unpaid order outside the void workflow is reserved for managers;
+	// paid orders can only be refunded
+	if status == domain.OrderStatusCancelled && !auth.IsManager(c.Request.Context()) {
+		c.JSON(http.StatusForbidden, application.ErrorResponse{
+			Error:   "Forbidden",
+			Message: "only managers can cancel orders; void unpaid orders or refund paid ones instead",
+		})
+		return
+	}
+
+	err = h.orderService.UpdateOrderStatus(c.Request.Context(), id, status, req.Reason)
 	if err != nil {
 		handleError(c, err)
 		return
@@ -265,34 +353,6 @@ func (h *OrderHandler) AddNotes(c *gin.Context) {
 	c.JSON(http.StatusOK, gin.H{"message": "Notes added successfully"})
 }
 
-// PayOrder marks an order as paid
-// PATCH /api/v1/orders/:id/pay
-func (h *OrderHandler) PayOrder(c *gin.Context) {
-	id := domain.OrderID(c.Param("id"))
-
-	err := h.orderService.PayOrder(c.Request.Context(), id)
-	if err != nil {
-		handleError(c, err)
-		return
-	}
-
-	c.JSON(http.StatusOK, gin.H{"message": "Order paid successfully"})
-}
-
-// CancelOrder cancels an order
-// DELETE /api/v1/orders/:id
-func (h *OrderHandler) CancelOrder(c *gin.Context) {
-	id := domain.OrderID(c.Param("id"))
-
-	err := h.orderService.CancelOrder(c.Request.Context(), id)
-	if err != nil {
-		handleError(c, err)
-		return
-	}
-
-	c.JSON(http.StatusOK, gin.H{"message": "Order cancelled successfully"})
-}
-
 // GetOrdersByCustomer retrieves orders for a specific customer
 // GET /api/v1/orders/customer/:customerId
 func (h *OrderHandler) GetOrdersByCustomer(c *gin.Context) {
@@ -376,6 +436,142 @@ func (h *OrderHandler) GetActiveOrders(c *gin.Context) {
 	c.JSON(http.StatusOK, gin.H{"orders": responses})
 }
 
+// RescheduleOrder moves the fulfillment time of a scheduled order awaiting release
+// PATCH /api/v1/orders/:id/schedule
+func (h *OrderHandler) RescheduleOrder(c *gin.Context) {
+	id := domain.OrderID(c.Param("id"))
+
+	var req application.RescheduleOrderRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, application.ErrorResponse{
+			Error:   "Invalid request",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	order, err := h.orderService.RescheduleOrder(c.Request.Context(), id, req.FulfillmentTime)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	concurrency.SetETag(c, order.Version)
+	c.JSON(http.StatusOK, application.ToOrderResponse(order))
+}
+
+// GetScheduledOrders lists scheduled orders awaiting release, soonest first.
+// The window defaults to the next seven days.
+// GET /api/v1/orders/scheduled
+func (h *OrderHandler) GetScheduledOrders(c *gin.Context) {
+	var req application.ScheduledOrdersRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		c.JSON(http.StatusBadRequest, application.ErrorResponse{
+			Error:   "Invalid request",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	from := time.Now()
+	to := from.Add(7 * 24 * time.Hour)
+
+	if req.From != nil {
+		parsed, err := time.Parse(time.RFC3339, *req.From)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, application.ErrorResponse{
+				Error:   "Invalid from format",
+				Message: "Use RFC3339 format (e.g., 2023-01-01T00:00:00Z)",
+			})
+			return
+		}
+		from = parsed
+	}
+
+	if req.To != nil {
+		parsed, err := time.Parse(time.RFC3339, *req.To)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, application.ErrorResponse{
+				Error:   "Invalid to format",
+				Message: "Use RFC3339 format (e.g., 2023-01-01T23:59:59Z)",
+			})
+			return
+		}
+		to = parsed
+	}
+
+	scheduled, err := h.orderService.GetScheduledOrders(c.Request.Context(), from, to)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, application.ToScheduledOrderResponses(scheduled))
+}
+
+// GetStatusHistory retrieves the status transitions of an order and the time spent in each status
+// GET /api/v1/orders/:id/history
+func (h *OrderHandler) GetStatusHistory(c *gin.Context) {
+	id := domain.OrderID(c.Param("id"))
+
+	order, err := h.orderService.GetOrderByID(c.Request.Context(), id)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, application.ToStatusHistoryResponse(order, time.Now()))
+}
+
+// GetStatusMetrics reports the average and longest time orders spent in each status.
+// The window defaults to orders created in the last 24 hours.
+// GET /api/v1/orders/metrics/status
+func (h *OrderHandler) GetStatusMetrics(c *gin.Context) {
+	var req application.StatusMetricsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		c.JSON(http.StatusBadRequest, application.ErrorResponse{
+			Error:   "Invalid request",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	to := time.Now()
+	from := to.Add(-24 * time.Hour)
+
+	if req.From != nil {
+		parsed, err := time.Parse(time.RFC3339, *req.From)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, application.ErrorResponse{
+				Error:   "Invalid from format",
+				Message: "Use RFC3339 format (e.g., 2023-01-01T00:00:00Z)",
+			})
+			return
+		}
+		from = parsed
+	}
+
+	if req.To != nil {
+		parsed, err := time.Parse(time.RFC3339, *req.To)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, application.ErrorResponse{
+				Error:   "Invalid to format",
+				Message: "Use RFC3339 format (e.g., 2023-01-01T23:59:59Z)",
+			})
+			return
+		}
+		to = parsed
+	}
+
+	metrics, err := h.orderService.GetStatusMetrics(c.Request.Context(), from, to)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, application.ToStatusMetricResponses(metrics))
+}
+
 // ListOrders retrieves orders with pagination and filters
 // GET /api/v1/orders
 func (h *OrderHandler) ListOrders(c *gin.Context) {
@@ -495,6 +691,8 @@ func validateOrderStatus(status string) (domain.OrderStatus, error) {
 		return domain.OrderStatusPreparing, nil
 	case string(domain.OrderStatusReady):
 		return domain.OrderStatusReady, nil
+	case string(domain.OrderStatusOutForDelivery):
+		return domain.OrderStatusOutForDelivery, nil
 	case string(domain.OrderStatusCompleted):
 		return domain.OrderStatusCompleted, nil
 	case string(domain.OrderStatusCancelled):
@@ -507,11 +705,31 @@ func validateOrderStatus(status string) (domain.OrderStatus, error) {
 // Error handling helper
 func handleError(c *gin.Context, err error) {
 	switch {
+	case errors.IsPreconditionFailed(err):
+		c.JSON(http.StatusPreconditionFailed, application.ErrorResponse{
+			Error:   "Precondition failed",
+			Message: err.Error(),
+		})
+	case errors.IsVersionConflict(err):
+		c.JSON(http.StatusConflict, application.ErrorResponse{
+			Error:   "Concurrent modification",
+			Message: err.Error(),
+		})
 	case errors.IsNotFound(err):
 		c.JSON(http.StatusNotFound, application.ErrorResponse{
 			Error:   "Not found",
 			Message: err.Error(),
 		})
+	case errors.IsUnauthorizedError(err):
+		c.JSON(http.StatusUnauthorized, application.ErrorResponse{
+			Error:   "Unauthorized",
+			Message: err.Error(),
+		})
+	case errors.IsForbiddenError(err):
+		c.JSON(http.StatusForbidden, application.ErrorResponse{
+			Error:   "Forbidden",
+			Message: err.Error(),
+		})
 	case errors.IsValidationError(err):
 		c.JSON(http.StatusBadRequest, application.ErrorResponse{
 			Error:   "Validation error",
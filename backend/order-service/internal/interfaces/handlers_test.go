@@ -9,6 +9,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
@@ -17,6 +18,8 @@ import (
 
 	"github.com/restaurant-platform/order-service/internal/application"
 	"github.com/restaurant-platform/order-service/internal/domain"
+	"github.com/restaurant-platform/shared/pkg/auth"
+	sharedErrors "github.com/restaurant-platform/shared/pkg/errors"
 )
 
 // MockOrderService is a mock implementation of the OrderService interface
@@ -40,8 +43,50 @@ func (m *MockOrderService) GetOrderByID(ctx context.Context, id domain.OrderID)
 	return args.Get(0).(*domain.Order), args.Error(1)
 }
 
-func (m *MockOrderService) AddItemToOrder(ctx context.Context, orderID domain.OrderID, menuItemID, name string, quantity int, unitPrice float64, modifications []string, notes string) error {
-	args := m.Called(ctx, orderID, menuItemID, name, quantity, unitPrice, modifications, notes)
+func (m *MockOrderService) AddItemToOrder(ctx context.Context, orderID domain.OrderID, menuItemID string, quantity, course, seat int, modifiers []domain.ModifierSelection, modifications []string, notes string) error {
+	args := m.Called(ctx, orderID, menuItemID, quantity, course, seat, modifiers, modifications, notes)
+	return args.Error(0)
+}
+
+func (m *MockOrderService) FireCourse(ctx context.Context, orderID domain.OrderID, course int) (*domain.Order, error) {
+	args := m.Called(ctx, orderID, course)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Order), args.Error(1)
+}
+
+func (m *MockOrderService) CreateScheduledOrder(ctx context.Context, customerID string, orderType domain.OrderType, fulfillmentTime time.Time) (*domain.Order, error) {
+	args := m.Called(ctx, customerID, orderType, fulfillmentTime)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Order), args.Error(1)
+}
+
+func (m *MockOrderService) RescheduleOrder(ctx context.Context, orderID domain.OrderID, fulfillmentTime time.Time) (*domain.Order, error) {
+	args := m.Called(ctx, orderID, fulfillmentTime)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Order), args.Error(1)
+}
+
+func (m *MockOrderService) GetScheduledOrders(ctx context.Context, from, to time.Time) ([]*domain.ScheduledOrder, error) {
+	args := m.Called(ctx, from, to)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.ScheduledOrder), args.Error(1)
+}
+
+func (m *MockOrderService) ReleaseDueOrders(ctx context.Context, now time.Time) (int, error) {
+	args := m.Called(ctx, now)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockOrderService) VoidItem(ctx context.Context, orderID domain.OrderID, itemID domain.OrderItemID, reason string) error {
+	args := m.Called(ctx, orderID, itemID, reason)
 	return args.Error(0)
 }
 
@@ -55,8 +100,8 @@ func (m *MockOrderService) UpdateItemQuantity(ctx context.Context, orderID domai
 	return args.Error(0)
 }
 
-func (m *MockOrderService) UpdateOrderStatus(ctx context.Context, orderID domain.OrderID, status domain.OrderStatus) error {
-	args := m.Called(ctx, orderID, status)
+func (m *MockOrderService) UpdateOrderStatus(ctx context.Context, orderID domain.OrderID, status domain.OrderStatus, reason string) error {
+	args := m.Called(ctx, orderID, status, reason)
 	return args.Error(0)
 }
 
@@ -75,13 +120,8 @@ func (m *MockOrderService) AddOrderNotes(ctx context.Context, orderID domain.Ord
 	return args.Error(0)
 }
 
-func (m *MockOrderService) CancelOrder(ctx context.Context, orderID domain.OrderID) error {
-	args := m.Called(ctx, orderID)
-	return args.Error(0)
-}
-
-func (m *MockOrderService) PayOrder(ctx context.Context, orderID domain.OrderID) error {
-	args := m.Called(ctx, orderID)
+func (m *MockOrderService) CancelOrder(ctx context.Context, orderID domain.OrderID, reason string) error {
+	args := m.Called(ctx, orderID, reason)
 	return args.Error(0)
 }
 
@@ -125,20 +165,30 @@ func (m *MockOrderService) ListOrders(ctx context.Context, offset, limit int, fi
 	return args.Get(0).([]*domain.Order), args.Int(1), args.Error(2)
 }
 
+func (m *MockOrderService) GetStatusMetrics(ctx context.Context, from, to time.Time) ([]*domain.StatusMetric, error) {
+	args := m.Called(ctx, from, to)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.StatusMetric), args.Error(1)
+}
+
 // OrderHandlerTestSuite contains all HTTP handler tests
 type OrderHandlerTestSuite struct {
 	suite.Suite
 	handler     *OrderHandler
 	mockService *MockOrderService
+	mockTables  *MockTableService
 	router      *gin.Engine
 }
 
 func (suite *OrderHandlerTestSuite) SetupTest() {
 	gin.SetMode(gin.TestMode)
 	suite.mockService = new(MockOrderService)
+	suite.mockTables = new(MockTableService)
 	
-	// Create handler with mock service
-	suite.handler = NewOrderHandler(suite.mockService)
+	// Create handler with mock services
+	suite.handler = NewOrderHandler(suite.mockService, suite.mockTables)
 	
 	suite.router = gin.New()
 	suite.setupRoutes()
@@ -149,17 +199,21 @@ func (suite *OrderHandlerTestSuite) setupRoutes() {
 	{
 		api.POST("/orders", suite.handler.CreateOrder)
 		api.GET("/orders/active", suite.handler.GetActiveOrders)
+		api.GET("/orders/scheduled", suite.handler.GetScheduledOrders)
+		api.GET("/orders/metrics/status", suite.handler.GetStatusMetrics)
 		api.GET("/orders", suite.handler.ListOrders)
 		api.GET("/orders/:id", func(c *gin.Context) {
 			// Simplified handler for testing
 			c.JSON(http.StatusOK, gin.H{"message": "order details"})
 		})
+		api.GET("/orders/:id/history", suite.handler.GetStatusHistory)
 		api.PUT("/orders/:id/status", suite.handler.UpdateOrderStatus)
+		api.PATCH("/orders/:id/schedule", suite.handler.RescheduleOrder)
 		api.POST("/orders/:id/items", suite.handler.AddItemToOrder)
+		api.POST("/orders/:id/items/:itemId/void", suite.handler.VoidItem)
+		api.POST("/orders/:id/courses/:course/fire", suite.handler.FireCourse)
 		api.PUT("/orders/:id/table", suite.handler.SetTable)
 		api.PUT("/orders/:id/delivery-address", suite.handler.SetDeliveryAddress)
-		api.PUT("/orders/:id/cancel", suite.handler.CancelOrder)
-		api.PUT("/orders/:id/pay", suite.handler.PayOrder)
 	}
 }
 
@@ -180,6 +234,8 @@ func (suite *OrderHandlerTestSuite) TestCreateOrder_Success() {
 	expectedOrder, _ := domain.NewOrder(request.CustomerID, domain.OrderTypeDineIn)
 	suite.mockService.On("CreateOrder", mock.Anything, request.CustomerID, domain.OrderTypeDineIn).Return(expectedOrder, nil)
 	suite.mockService.On("SetTableForOrder", mock.Anything, expectedOrder.ID, request.TableID).Return(nil)
+	suite.mockTables.On("SeatGuests", mock.Anything, expectedOrder.ID, 0, "").
+		Return(nil, sharedErrors.WrapNotFound("ReservationRepository.FindSeatable", "reservation", "table table-5", sharedErrors.ErrNotFound))
 
 	// When
 	w := httptest.NewRecorder()
@@ -192,6 +248,94 @@ func (suite *OrderHandlerTestSuite) TestCreateOrder_Success() {
 	assert.Equal(http.StatusCreated, w.Code)
 	
 	suite.mockService.AssertExpectations(suite.T())
+	suite.mockTables.AssertExpectations(suite.T())
+}
+
+func (suite *OrderHandlerTestSuite) TestCreateOrder_WithReservation_SeatsParty() {
+	// Given
+	request := application.CreateOrderRequest{
+		CustomerID:    "customer-123",
+		Type:          "DINE_IN",
+		TableID:       "table-5",
+		ReservationID: "res-1",
+	}
+	requestJSON, _ := json.Marshal(request)
+
+	expectedOrder, _ := domain.NewOrder(request.CustomerID, domain.OrderTypeDineIn)
+	seated := *expectedOrder
+	seated.TableID = "table-5"
+	seated.GuestCount = 4
+	seated.ReservationID = "res-1"
+	suite.mockService.On("CreateOrder", mock.Anything, request.CustomerID, domain.OrderTypeDineIn).Return(expectedOrder, nil)
+	suite.mockService.On("SetTableForOrder", mock.Anything, expectedOrder.ID, request.TableID).Return(nil)
+	suite.mockTables.On("SeatGuests", mock.Anything, expectedOrder.ID, 0, "res-1").Return(&seated, nil)
+
+	// When
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", "/api/v1/orders", bytes.NewBuffer(requestJSON))
+	req.Header.Set("Content-Type", "application/json")
+	suite.router.ServeHTTP(w, req)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.Equal(http.StatusCreated, w.Code)
+	var response application.OrderResponse
+	json.Unmarshal(w.Body.Bytes(), &response)
+	assert.Equal(4, response.GuestCount)
+	assert.Equal("res-1", response.ReservationID)
+}
+
+func (suite *OrderHandlerTestSuite) TestCreateOrder_CancelledReservation_ShouldReturnUnprocessableEntity() {
+	// Given
+	request := application.CreateOrderRequest{
+		CustomerID:    "customer-123",
+		Type:          "DINE_IN",
+		ReservationID: "res-1",
+	}
+	requestJSON, _ := json.Marshal(request)
+
+	expectedOrder, _ := domain.NewOrder(request.CustomerID, domain.OrderTypeDineIn)
+	suite.mockService.On("CreateOrder", mock.Anything, request.CustomerID, domain.OrderTypeDineIn).Return(expectedOrder, nil)
+	suite.mockTables.On("SeatGuests", mock.Anything, expectedOrder.ID, 0, "res-1").
+		Return(nil, sharedErrors.WrapConflict("SeatGuests", "reservation", "reservation res-1 is CANCELLED", nil))
+
+	// When
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", "/api/v1/orders", bytes.NewBuffer(requestJSON))
+	req.Header.Set("Content-Type", "application/json")
+	suite.router.ServeHTTP(w, req)
+
+	// Then
+	assert.Equal(suite.T(), http.StatusUnprocessableEntity, w.Code)
+}
+
+func (suite *OrderHandlerTestSuite) TestCreateOrder_WithFulfillmentTime_CreatesScheduledOrder() {
+	// Given
+	fulfillmentTime := time.Date(2030, 5, 1, 18, 30, 0, 0, time.UTC)
+	request := application.CreateOrderRequest{
+		CustomerID:      "customer-123",
+		Type:            "TAKEOUT",
+		FulfillmentTime: &fulfillmentTime,
+	}
+	requestJSON, _ := json.Marshal(request)
+
+	expectedOrder, _ := domain.NewScheduledOrder(request.CustomerID, domain.OrderTypeTakeout, fulfillmentTime, time.Now())
+	suite.mockService.On("CreateScheduledOrder", mock.Anything, request.CustomerID, domain.OrderTypeTakeout, fulfillmentTime).Return(expectedOrder, nil)
+
+	// When
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", "/api/v1/orders", bytes.NewBuffer(requestJSON))
+	req.Header.Set("Content-Type", "application/json")
+	suite.router.ServeHTTP(w, req)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.Equal(http.StatusCreated, w.Code)
+
+	var response application.OrderResponse
+	assert.NoError(json.Unmarshal(w.Body.Bytes(), &response))
+	assert.True(fulfillmentTime.Equal(*response.FulfillmentTime))
+	suite.mockService.AssertNotCalled(suite.T(), "CreateOrder", mock.Anything, mock.Anything, mock.Anything)
 }
 
 func (suite *OrderHandlerTestSuite) TestCreateOrder_InvalidJSON_ShouldReturnBadRequest() {
@@ -276,11 +420,11 @@ func (suite *OrderHandlerTestSuite) TestUpdateOrderStatus_Success() {
 	// Given
 	orderID := "ord_123"
 	request := application.UpdateOrderStatusRequest{
-		Status: "PAID",
+		Status: "PREPARING",
 	}
 	requestJSON, _ := json.Marshal(request)
 	
-	suite.mockService.On("UpdateOrderStatus", mock.Anything, domain.OrderID(orderID), domain.OrderStatusPaid).Return(nil)
+	suite.mockService.On("UpdateOrderStatus", mock.Anything, domain.OrderID(orderID), domain.OrderStatusPreparing, "").Return(nil)
 
 	// When
 	w := httptest.NewRecorder()
@@ -320,17 +464,15 @@ func (suite *OrderHandlerTestSuite) TestAddItemToOrder_Success() {
 	orderID := "ord_123"
 	request := application.AddItemRequest{
 		MenuItemID:    "menu-item-1",
-		Name:          "Caesar Salad",
 		Quantity:      2,
-		UnitPrice:     12.99,
+		Seat:          2,
 		Modifications: []string{"no croutons"},
 		Notes:         "extra dressing",
 	}
 	requestJSON, _ := json.Marshal(request)
 	
 	suite.mockService.On("AddItemToOrder", mock.Anything, domain.OrderID(orderID), 
-		request.MenuItemID, request.Name, request.Quantity, request.UnitPrice, 
-		request.Modifications, request.Notes).Return(nil)
+		request.MenuItemID, request.Quantity, 0, 2, []domain.ModifierSelection{}, request.Modifications, request.Notes).Return(nil)
 
 	// When
 	w := httptest.NewRecorder()
@@ -345,14 +487,191 @@ func (suite *OrderHandlerTestSuite) TestAddItemToOrder_Success() {
 	suite.mockService.AssertExpectations(suite.T())
 }
 
+func (suite *OrderHandlerTestSuite) TestAddItemToOrder_WithModifiers_PassesSelections() {
+	// Given
+	orderID := "ord_123"
+	request := application.AddItemRequest{
+		MenuItemID: "burger-1",
+		Quantity:   1,
+		Modifiers: []application.ModifierSelectionRequest{
+			{GroupID: "mgrp_cheese", OptionIDs: []string{"mopt_cheddar"}},
+		},
+	}
+	requestJSON, _ := json.Marshal(request)
+
+	expected := []domain.ModifierSelection{{GroupID: "mgrp_cheese", OptionIDs: []string{"mopt_cheddar"}}}
+	suite.mockService.On("AddItemToOrder", mock.Anything, domain.OrderID(orderID),
+		"burger-1", 1, 0, 0, expected, []string(nil), "").Return(nil)
+
+	// When
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", "/api/v1/orders/"+orderID+"/items", bytes.NewBuffer(requestJSON))
+	req.Header.Set("Content-Type", "application/json")
+	suite.router.ServeHTTP(w, req)
+
+	// Then
+	assert.Equal(suite.T(), http.StatusOK, w.Code)
+	suite.mockService.AssertExpectations(suite.T())
+}
+
+// Test RescheduleOrder Handler
+func (suite *OrderHandlerTestSuite) TestRescheduleOrder_Success() {
+	// Given
+	fulfillmentTime := time.Date(2030, 5, 1, 19, 0, 0, 0, time.UTC)
+	order, _ := domain.NewScheduledOrder("customer-123", domain.OrderTypeTakeout, fulfillmentTime, time.Now())
+	requestJSON, _ := json.Marshal(application.RescheduleOrderRequest{FulfillmentTime: fulfillmentTime})
+	suite.mockService.On("RescheduleOrder", mock.Anything, order.ID, fulfillmentTime).Return(order, nil)
+
+	// When
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("PATCH", "/api/v1/orders/"+string(order.ID)+"/schedule", bytes.NewBuffer(requestJSON))
+	req.Header.Set("Content-Type", "application/json")
+	suite.router.ServeHTTP(w, req)
+
+	// Then
+	assert.Equal(suite.T(), http.StatusOK, w.Code)
+	assert.Equal(suite.T(), `"1"`, w.Header().Get("ETag"))
+	suite.mockService.AssertExpectations(suite.T())
+}
+
+func (suite *OrderHandlerTestSuite) TestRescheduleOrder_Released_ShouldReturnUnprocessable() {
+	// Given
+	fulfillmentTime := time.Date(2030, 5, 1, 19, 0, 0, 0, time.UTC)
+	requestJSON, _ := json.Marshal(application.RescheduleOrderRequest{FulfillmentTime: fulfillmentTime})
+	conflict := sharedErrors.WrapConflict("Reschedule", "status", "only scheduled orders awaiting release can be rescheduled", nil)
+	suite.mockService.On("RescheduleOrder", mock.Anything, domain.OrderID("ord_123"), fulfillmentTime).Return(nil, conflict)
+
+	// When
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("PATCH", "/api/v1/orders/ord_123/schedule", bytes.NewBuffer(requestJSON))
+	req.Header.Set("Content-Type", "application/json")
+	suite.router.ServeHTTP(w, req)
+
+	// Then
+	assert.Equal(suite.T(), http.StatusUnprocessableEntity, w.Code)
+}
+
+// Test GetScheduledOrders Handler
+func (suite *OrderHandlerTestSuite) TestGetScheduledOrders_Success() {
+	// Given
+	from := time.Date(2030, 5, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2030, 5, 2, 0, 0, 0, 0, time.UTC)
+	order, _ := domain.NewScheduledOrder("customer-123", domain.OrderTypeTakeout, from.Add(18*time.Hour), time.Now())
+	scheduled := []*domain.ScheduledOrder{{Order: order, PrepTime: 40 * time.Minute, ReleaseAt: from.Add(17 * time.Hour)}}
+	suite.mockService.On("GetScheduledOrders", mock.Anything, from, to).Return(scheduled, nil)
+
+	// When
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/api/v1/orders/scheduled?from=2030-05-01T00:00:00Z&to=2030-05-02T00:00:00Z", nil)
+	suite.router.ServeHTTP(w, req)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.Equal(http.StatusOK, w.Code)
+
+	var response []*application.ScheduledOrderResponse
+	assert.NoError(json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Len(response, 1)
+	assert.Equal(2400, response[0].PrepTime)
+	assert.True(from.Add(17 * time.Hour).Equal(response[0].ReleaseAt))
+}
+
+func (suite *OrderHandlerTestSuite) TestGetScheduledOrders_InvalidFrom_ShouldReturnBadRequest() {
+	// When
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/api/v1/orders/scheduled?from=tomorrow", nil)
+	suite.router.ServeHTTP(w, req)
+
+	// Then
+	assert.Equal(suite.T(), http.StatusBadRequest, w.Code)
+	suite.mockService.AssertNotCalled(suite.T(), "GetScheduledOrders", mock.Anything, mock.Anything, mock.Anything)
+}
+
+// Test VoidItem Handler
+func (suite *OrderHandlerTestSuite) TestVoidItem_Success() {
+	// Given
+	requestJSON, _ := json.Marshal(application.VoidItemRequest{Reason: "guest changed mind"})
+	suite.mockService.On("VoidItem", mock.Anything, domain.OrderID("ord_123"), domain.OrderItemID("item_1"), "guest changed mind").Return(nil)
+
+	// When
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", "/api/v1/orders/ord_123/items/item_1/void", bytes.NewBuffer(requestJSON))
+	req.Header.Set("Content-Type", "application/json")
+	suite.router.ServeHTTP(w, req)
+
+	// Then
+	assert.Equal(suite.T(), http.StatusOK, w.Code)
+	suite.mockService.AssertExpectations(suite.T())
+}
+
+func (suite *OrderHandlerTestSuite) TestVoidItem_MissingReason_ShouldReturnBadRequest() {
+	// When
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", "/api/v1/orders/ord_123/items/item_1/void", bytes.NewBufferString(`{}`))
+	req.Header.Set("Content-Type", "application/json")
+	suite.router.ServeHTTP(w, req)
+
+	// Then
+	assert.Equal(suite.T(), http.StatusBadRequest, w.Code)
+	suite.mockService.AssertNotCalled(suite.T(), "VoidItem", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *OrderHandlerTestSuite) TestVoidItem_NotSentToKitchen_ShouldReturnUnprocessable() {
+	// Given
+	requestJSON, _ := json.Marshal(application.VoidItemRequest{Reason: "guest changed mind"})
+	conflict := sharedErrors.WrapConflict("VoidItem", "status", "only items sent to the kitchen can be voided", nil)
+	suite.mockService.On("VoidItem", mock.Anything, domain.OrderID("ord_123"), domain.OrderItemID("item_1"), "guest changed mind").Return(conflict)
+
+	// When
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", "/api/v1/orders/ord_123/items/item_1/void", bytes.NewBuffer(requestJSON))
+	req.Header.Set("Content-Type", "application/json")
+	suite.router.ServeHTTP(w, req)
+
+	// Then
+	assert.Equal(suite.T(), http.StatusUnprocessableEntity, w.Code)
+}
+
+// Test FireCourse Handler
+func (suite *OrderHandlerTestSuite) TestFireCourse_Success() {
+	// Given
+	order, _ := domain.NewOrder("customer-123", domain.OrderTypeDineIn)
+	_ = order.AddCourseItem(2, "steak-1", "Ribeye", 1, 34.00, nil, nil, "")
+	_, _ = order.FireCourse(2)
+	suite.mockService.On("FireCourse", mock.Anything, order.ID, 2).Return(order, nil)
+
+	// When
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", "/api/v1/orders/"+string(order.ID)+"/courses/2/fire", nil)
+	suite.router.ServeHTTP(w, req)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.Equal(http.StatusOK, w.Code)
+
+	var response application.OrderResponse
+	assert.NoError(json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal("FIRED", response.Items[0].CourseStatus)
+	suite.mockService.AssertExpectations(suite.T())
+}
+
+func (suite *OrderHandlerTestSuite) TestFireCourse_InvalidCourse_ShouldReturnBadRequest() {
+	// When
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", "/api/v1/orders/ord_123/courses/mains/fire", nil)
+	suite.router.ServeHTTP(w, req)
+
+	// Then
+	assert.Equal(suite.T(), http.StatusBadRequest, w.Code)
+	suite.mockService.AssertNotCalled(suite.T(), "FireCourse", mock.Anything, mock.Anything, mock.Anything)
+}
+
 func (suite *OrderHandlerTestSuite) TestAddItemToOrder_InvalidQuantity_ShouldReturnBadRequest() {
 	// Given
 	orderID := "ord_123"
 	request := application.AddItemRequest{
 		MenuItemID: "menu-item-1",
-		Name:       "Caesar Salad",
 		Quantity:   0, // Invalid quantity
-		UnitPrice:  12.99,
 	}
 	requestJSON, _ := json.Marshal(request)
 
@@ -415,44 +734,147 @@ func (suite *OrderHandlerTestSuite) TestSetDeliveryAddress_Success() {
 	suite.mockService.AssertExpectations(suite.T())
 }
 
-// Test CancelOrder Handler
-func (suite *OrderHandlerTestSuite) TestCancelOrder_Success() {
+func (suite *OrderHandlerTestSuite) TestSetTableForOrder_StaleIfMatch_ShouldReturnPreconditionFailed() {
 	// Given
 	orderID := "ord_123"
-	
-	suite.mockService.On("CancelOrder", mock.Anything, domain.OrderID(orderID)).Return(nil)
+	requestJSON, _ := json.Marshal(application.SetTableRequest{TableID: "table-5"})
+
+	suite.mockService.On("SetTableForOrder", mock.Anything, domain.OrderID(orderID), "table-5").
+		Return(sharedErrors.WrapPreconditionFailed("modifyOrder", "order", orderID, 1, 2))
+
+	// When
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("PUT", "/api/v1/orders/"+orderID+"/table", bytes.NewBuffer(requestJSON))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("If-Match", `"1"`)
+	suite.router.ServeHTTP(w, req)
+
+	// Then
+	assert.New(suite.T()).Equal(http.StatusPreconditionFailed, w.Code)
+}
+
+func (suite *OrderHandlerTestSuite) TestSetTableForOrder_VersionConflict_ShouldReturnConflict() {
+	// Given
+	orderID := "ord_123"
+	requestJSON, _ := json.Marshal(application.SetTableRequest{TableID: "table-5"})
+
+	suite.mockService.On("SetTableForOrder", mock.Anything, domain.OrderID(orderID), "table-5").
+		Return(sharedErrors.WrapVersionConflict("OrderRepository.Update", "order", orderID, 4))
+
+	// When
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("PUT", "/api/v1/orders/"+orderID+"/table", bytes.NewBuffer(requestJSON))
+	req.Header.Set("Content-Type", "application/json")
+	suite.router.ServeHTTP(w, req)
+
+	// Then
+	assert.New(suite.T()).Equal(http.StatusConflict, w.Code)
+}
+
+func (suite *OrderHandlerTestSuite) TestUpdateOrderStatus_CancelWithoutManager_ShouldReturnForbidden() {
+	// Given
+	orderID := "ord_123"
+	requestJSON, _ := json.Marshal(application.UpdateOrderStatusRequest{
+		Status: "CANCELLED",
+		Reason: "customer left",
+	})
 
 	// When
 	w := httptest.NewRecorder()
-	req, _ := http.NewRequest("PUT", "/api/v1/orders/"+orderID+"/cancel", nil)
+	req, _ := http.NewRequest("PUT", "/api/v1/orders/"+orderID+"/status", bytes.NewBuffer(requestJSON))
+	req.Header.Set("Content-Type", "application/json")
+	suite.router.ServeHTTP(w, req)
+
+	// Then
+	assert.New(suite.T()).Equal(http.StatusForbidden, w.Code)
+	suite.mockService.AssertNotCalled(suite.T(), "UpdateOrderStatus", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *OrderHandlerTestSuite) TestUpdateOrderStatus_CancelByManager_Success() {
+	// Given
+	orderID := "ord_123"
+	requestJSON, _ := json.Marshal(application.UpdateOrderStatusRequest{
+		Status: "CANCELLED",
+		Reason: "kitchen fire",
+	})
+	suite.mockService.On("UpdateOrderStatus", mock.Anything, domain.OrderID(orderID), domain.OrderStatusCancelled, "kitchen fire").Return(nil)
+
+	// When
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("PUT", "/api/v1/orders/"+orderID+"/status", bytes.NewBuffer(requestJSON))
+	req.Header.Set("Content-Type", "application/json")
+	req = req.WithContext(auth.WithRole(req.Context(), auth.RoleManager))
+	suite.router.ServeHTTP(w, req)
+
+	// Then
+	assert.New(suite.T()).Equal(http.StatusOK, w.Code)
+	suite.mockService.AssertExpectations(suite.T())
+}
+
+func (suite *OrderHandlerTestSuite) TestGetStatusHistory_Success() {
+	// Given
+	order, _ := domain.NewOrder("customer-123", domain.OrderTypeTakeout)
+	_ = order.UpdateStatus(domain.OrderStatusPaid, "user-42", "paid at counter")
+	suite.mockService.On("GetOrderByID", mock.Anything, order.ID).Return(order, nil)
+
+	// When
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/api/v1/orders/"+string(order.ID)+"/history", nil)
 	suite.router.ServeHTTP(w, req)
 
 	// Then
 	assert := assert.New(suite.T())
 	assert.Equal(http.StatusOK, w.Code)
-	
-	suite.mockService.AssertExpectations(suite.T())
+
+	var response application.StatusHistoryResponse
+	json.Unmarshal(w.Body.Bytes(), &response)
+	assert.Equal("PAID", response.Status)
+	assert.Len(response.Transitions, 1)
+	assert.Equal("CREATED", response.Transitions[0].From)
+	assert.Equal("user-42", response.Transitions[0].Actor)
+	assert.Equal("paid at counter", response.Transitions[0].Reason)
+	assert.Contains(response.TimeInStatus, "CREATED")
+	assert.Contains(response.TimeInStatus, "PAID")
 }
 
-// Test PayOrder Handler
-func (suite *OrderHandlerTestSuite) TestPayOrder_Success() {
+func (suite *OrderHandlerTestSuite) TestGetStatusMetrics_Success() {
 	// Given
-	orderID := "ord_123"
-	
-	suite.mockService.On("PayOrder", mock.Anything, domain.OrderID(orderID)).Return(nil)
+	from, _ := time.Parse(time.RFC3339, "2024-01-01T00:00:00Z")
+	to, _ := time.Parse(time.RFC3339, "2024-01-02T00:00:00Z")
+	metrics := []*domain.StatusMetric{
+		{Status: domain.OrderStatusPreparing, Orders: 4, Average: 12 * time.Minute, Max: 20 * time.Minute},
+	}
+	suite.mockService.On("GetStatusMetrics", mock.Anything, from, to).Return(metrics, nil)
 
 	// When
 	w := httptest.NewRecorder()
-	req, _ := http.NewRequest("PUT", "/api/v1/orders/"+orderID+"/pay", nil)
+	req, _ := http.NewRequest("GET", "/api/v1/orders/metrics/status?from=2024-01-01T00:00:00Z&to=2024-01-02T00:00:00Z", nil)
 	suite.router.ServeHTTP(w, req)
 
 	// Then
 	assert := assert.New(suite.T())
 	assert.Equal(http.StatusOK, w.Code)
-	
-	suite.mockService.AssertExpectations(suite.T())
+
+	var response []application.StatusMetricResponse
+	json.Unmarshal(w.Body.Bytes(), &response)
+	assert.Len(response, 1)
+	assert.Equal("PREPARING", response[0].Status)
+	assert.Equal(720, response[0].Average)
+	assert.Equal(1200, response[0].Max)
 }
 
+func (suite *OrderHandlerTestSuite) TestGetStatusMetrics_InvalidFrom_ShouldReturnBadRequest() {
+	// When
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/api/v1/orders/metrics/status?from=yesterday", nil)
+	suite.router.ServeHTTP(w, req)
+
+	// Then
+	assert.New(suite.T()).Equal(http.StatusBadRequest, w.Code)
+	suite.mockService.AssertNotCalled(suite.T(), "GetStatusMetrics")
+}
+
+
 // Test GetActiveOrders Handler
 func (suite *OrderHandlerTestSuite) TestGetActiveOrders_Success() {
 	// Given
@@ -596,11 +1018,11 @@ func (suite *OrderHandlerTestSuite) TestSuccessResponse_OK() {
 	// Test successful status update response
 	orderID := "ord_123"
 	request := application.UpdateOrderStatusRequest{
-		Status: "PAID",
+		Status: "PREPARING",
 	}
 	requestJSON, _ := json.Marshal(request)
 	
-	suite.mockService.On("UpdateOrderStatus", mock.Anything, domain.OrderID(orderID), domain.OrderStatusPaid).Return(nil)
+	suite.mockService.On("UpdateOrderStatus", mock.Anything, domain.OrderID(orderID), domain.OrderStatusPreparing, "").Return(nil)
 
 	w := httptest.NewRecorder()
 	req, _ := http.NewRequest("PUT", "/api/v1/orders/"+orderID+"/status", bytes.NewBuffer(requestJSON))
@@ -0,0 +1,248 @@
+package interfaces
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/restaurant-platform/shared/pkg/idempotency"
+)
+
+// IdempotencyTestSuite contains Idempotency-Key middleware tests
+type IdempotencyTestSuite struct {
+	suite.Suite
+	router *gin.Engine
+	store  *idempotency.MemoryStore
+	calls  int
+	status int
+}
+
+func TestIdempotencyTestSuite(t *testing.T) {
+	suite.Run(t, new(IdempotencyTestSuite))
+}
+
+func (suite *IdempotencyTestSuite) SetupTest() {
+	gin.SetMode(gin.TestMode)
+	suite.store = idempotency.NewMemoryStore()
+	suite.calls = 0
+	suite.status = http.StatusCreated
+
+	suite.router = gin.New()
+	suite.router.Use(idempotency.Middleware(suite.store, time.Hour))
+	suite.router.POST("/orders", func(c *gin.Context) {
+		suite.calls++
+		c.JSON(suite.status, gin.H{"call": suite.calls})
+	})
+}
+
+// ttlRecordingStore records the ttl of each claim and stored response, and whether the
+// context the store was called with had been cancelled
+type ttlRecordingStore struct {
+	*idempotency.MemoryStore
+	beginTTL    time.Duration
+	completeTTL time.Duration
+	completeErr error
+}
+
+func (s *ttlRecordingStore) Begin(ctx context.Context, key, fingerprint string, ttl time.Duration) (*idempotency.Record, bool, error) {
+	s.beginTTL = ttl
+	return s.MemoryStore.Begin(ctx, key, fingerprint, ttl)
+}
+
+func (s *ttlRecordingStore) Complete(ctx context.Context, key string, record *idempotency.Record, ttl time.Duration) error {
+	s.completeTTL = ttl
+	s.completeErr = ctx.Err()
+	return s.MemoryStore.Complete(ctx, key, record, ttl)
+}
+
+func (suite *IdempotencyTestSuite) request(key, body string) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", "/orders", bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", "application/json")
+	if key != "" {
+		req.Header.Set(idempotency.HeaderKey, key)
+	}
+	suite.router.ServeHTTP(w, req)
+	return w
+}
+
+func (suite *IdempotencyTestSuite) TestRetry_SamePayload_ShouldReplayStoredResponse() {
+	// Given
+	first := suite.request("key-1", `{"customer_id":"c1"}`)
+
+	// When
+	retry := suite.request("key-1", `{"customer_id":"c1"}`)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.Equal(1, suite.calls)
+	assert.Equal(http.StatusCreated, retry.Code)
+	assert.JSONEq(first.Body.String(), retry.Body.String())
+	assert.Equal("application/json; charset=utf-8", retry.Header().Get("Content-Type"))
+	assert.Equal("true", retry.Header().Get(idempotency.HeaderReplayed))
+	assert.Empty(first.Header().Get(idempotency.HeaderReplayed))
+}
+
+func (suite *IdempotencyTestSuite) TestRetry_DifferentPayload_ShouldBeRejected() {
+	// Given
+	suite.request("key-1", `{"customer_id":"c1"}`)
+
+	// When
+	w := suite.request("key-1", `{"customer_id":"c2"}`)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.Equal(http.StatusUnprocessableEntity, w.Code)
+	assert.Equal(1, suite.calls)
+}
+
+func (suite *IdempotencyTestSuite) TestDuplicate_WhileInProgress_ShouldConflict() {
+	// Given
+	started := make(chan struct{})
+	release := make(chan struct{})
+	suite.router.POST("/slow", func(c *gin.Context) {
+		close(started)
+		<-release
+		c.Status(http.StatusNoContent)
+	})
+	slowRequest := func() *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("POST", "/slow", bytes.NewBufferString(`{}`))
+		req.Header.Set(idempotency.HeaderKey, "key-1")
+		suite.router.ServeHTTP(w, req)
+		return w
+	}
+	firstDone := make(chan *httptest.ResponseRecorder)
+	go func() { firstDone <- slowRequest() }()
+	<-started
+
+	// When
+	duplicate := slowRequest()
+	close(release)
+	first := <-firstDone
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.Equal(http.StatusConflict, duplicate.Code)
+	assert.Equal("1", duplicate.Header().Get("Retry-After"))
+	assert.Equal(http.StatusNoContent, first.Code)
+}
+
+func (suite *IdempotencyTestSuite) TestServerError_ShouldReleaseKeyForRetry() {
+	// Given
+	suite.status = http.StatusInternalServerError
+	first := suite.request("key-1", `{"customer_id":"c1"}`)
+	suite.status = http.StatusCreated
+
+	// When
+	retry := suite.request("key-1", `{"customer_id":"c1"}`)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.Equal(http.StatusInternalServerError, first.Code)
+	assert.Equal(http.StatusCreated, retry.Code)
+	assert.Equal(2, suite.calls)
+}
+
+func (suite *IdempotencyTestSuite) TestNoKey_ShouldProcessEveryRequest() {
+	// When
+	suite.request("", `{"customer_id":"c1"}`)
+	suite.request("", `{"customer_id":"c1"}`)
+
+	// Then
+	assert.Equal(suite.T(), 2, suite.calls)
+}
+
+func (suite *IdempotencyTestSuite) TestClientDisconnects_ShouldStillStoreResponseForFullTTL() {
+	// Given a client that goes away while its request is being handled
+	store := &ttlRecordingStore{MemoryStore: suite.store}
+	ctx, cancel := context.WithCancel(context.Background())
+	router := gin.New()
+	router.Use(idempotency.Middleware(store, time.Hour))
+	router.POST("/orders", func(c *gin.Context) {
+		cancel()
+		c.Status(http.StatusCreated)
+	})
+
+	// When
+	req, _ := http.NewRequestWithContext(ctx, "POST", "/orders", bytes.NewBufferString(`{}`))
+	req.Header.Set(idempotency.HeaderKey, "key-1")
+	router.ServeHTTP(httptest.NewRecorder(), req)
+
+	// Then the key was only locked briefly while in flight, and the response is kept
+	assert := assert.New(suite.T())
+	assert.Equal(idempotency.LockTTL, store.beginTTL)
+	assert.Equal(time.Hour, store.completeTTL)
+	assert.NoError(store.completeErr)
+	retry := suite.request("key-1", `{}`)
+	assert.Equal(http.StatusCreated, retry.Code)
+	assert.Equal("true", retry.Header().Get(idempotency.HeaderReplayed))
+}
+
+func (suite *IdempotencyTestSuite) TestRetry_DifferentQuery_ShouldBeRejected() {
+	// Given
+	suite.router.POST("/refunds", func(c *gin.Context) {
+		suite.calls++
+		c.Status(http.StatusCreated)
+	})
+	refund := func(query string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("POST", "/refunds?"+query, bytes.NewBufferString(`{}`))
+		req.Header.Set(idempotency.HeaderKey, "key-1")
+		suite.router.ServeHTTP(w, req)
+		return w
+	}
+	refund("amount=5")
+
+	// When
+	w := refund("amount=50")
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.Equal(http.StatusUnprocessableEntity, w.Code)
+	assert.Equal(1, suite.calls)
+}
+
+func (suite *IdempotencyTestSuite) TestDuplicate_WhileInProgressPastLockTTL_ShouldStillConflict() {
+	// Given a request that runs for several times the claim's lifetime
+	lockTTL := 30 * time.Millisecond
+	started := make(chan struct{})
+	release := make(chan struct{})
+	router := gin.New()
+	router.Use(idempotency.Middleware(suite.store, lockTTL))
+	router.POST("/slow", func(c *gin.Context) {
+		suite.calls++
+		close(started)
+		<-release
+		c.Status(http.StatusNoContent)
+	})
+	slowRequest := func() *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("POST", "/slow", bytes.NewBufferString(`{}`))
+		req.Header.Set(idempotency.HeaderKey, "key-1")
+		router.ServeHTTP(w, req)
+		return w
+	}
+	firstDone := make(chan *httptest.ResponseRecorder)
+	go func() { firstDone <- slowRequest() }()
+	<-started
+	time.Sleep(4 * lockTTL)
+
+	// When
+	duplicate := slowRequest()
+	close(release)
+	first := <-firstDone
+
+	// Then the claim was kept alive and the duplicate was not processed
+	assert := assert.New(suite.T())
+	assert.Equal(http.StatusConflict, duplicate.Code)
+	assert.Equal(http.StatusNoContent, first.Code)
+	assert.Equal(1, suite.calls)
+}
@@ -0,0 +1,71 @@
+package interfaces
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/restaurant-platform/order-service/internal/application"
+	"github.com/restaurant-platform/order-service/internal/domain"
+	"github.com/restaurant-platform/shared/pkg/concurrency"
+)
+
+// LoyaltyHandler handles HTTP requests for loyalty points
+type LoyaltyHandler struct {
+	loyaltyService domain.LoyaltyService
+}
+
+// NewLoyaltyHandler creates a new loyalty handler
+func NewLoyaltyHandler(loyaltyService domain.LoyaltyService) *LoyaltyHandler {
+	return &LoyaltyHandler{
+		loyaltyService: loyaltyService,
+	}
+}
+
+// GetAccount returns the points balance and tier of a customer
+// GET /api/v1/loyalty/:customerId
+func (h *LoyaltyHandler) GetAccount(c *gin.Context) {
+	account, err := h.loyaltyService.GetAccount(c.Request.Context(), c.Param("customerId"))
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, account)
+}
+
+// GetHistory returns the points ledger of a customer, newest first
+// GET /api/v1/loyalty/:customerId/history
+func (h *LoyaltyHandler) GetHistory(c *gin.Context) {
+	history, err := h.loyaltyService.GetHistory(c.Request.Context(), c.Param("customerId"))
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, history)
+}
+
+// RedeemPoints spends loyalty points of the order's customer on a discount on the order
+// POST /api/v1/orders/:id/loyalty/redeem
+func (h *LoyaltyHandler) RedeemPoints(c *gin.Context) {
+	orderID := domain.OrderID(c.Param("id"))
+
+	var req application.RedeemPointsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, application.ErrorResponse{
+			Error:   "Invalid request",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	order, err := h.loyaltyService.RedeemPoints(c.Request.Context(), orderID, req.Points)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	concurrency.SetETag(c, order.Version)
+	c.JSON(http.StatusOK, application.ToOrderResponse(order))
+}
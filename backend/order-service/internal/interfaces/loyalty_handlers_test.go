@@ -0,0 +1,165 @@
+package interfaces
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/restaurant-platform/order-service/internal/application"
+	"github.com/restaurant-platform/order-service/internal/domain"
+	sharedErrors "github.com/restaurant-platform/shared/pkg/errors"
+)
+
+// MockLoyaltyService is a mock implementation of the LoyaltyService interface
+type MockLoyaltyService struct {
+	mock.Mock
+}
+
+func (m *MockLoyaltyService) GetAccount(ctx context.Context, customerID string) (*domain.LoyaltyAccount, error) {
+	args := m.Called(ctx, customerID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.LoyaltyAccount), args.Error(1)
+}
+
+func (m *MockLoyaltyService) GetHistory(ctx context.Context, customerID string) ([]*domain.LoyaltyEntry, error) {
+	args := m.Called(ctx, customerID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.LoyaltyEntry), args.Error(1)
+}
+
+func (m *MockLoyaltyService) RedeemPoints(ctx context.Context, orderID domain.OrderID, points int) (*domain.Order, error) {
+	args := m.Called(ctx, orderID, points)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Order), args.Error(1)
+}
+
+// LoyaltyHandlerTestSuite contains loyalty balance, history and redemption handler tests
+type LoyaltyHandlerTestSuite struct {
+	suite.Suite
+	router      *gin.Engine
+	mockService *MockLoyaltyService
+	handler     *LoyaltyHandler
+}
+
+func (suite *LoyaltyHandlerTestSuite) SetupTest() {
+	gin.SetMode(gin.TestMode)
+	suite.mockService = new(MockLoyaltyService)
+	suite.handler = NewLoyaltyHandler(suite.mockService)
+
+	suite.router = gin.New()
+	api := suite.router.Group("/api/v1")
+	{
+		api.GET("/loyalty/:customerId", suite.handler.GetAccount)
+		api.GET("/loyalty/:customerId/history", suite.handler.GetHistory)
+		api.POST("/orders/:id/loyalty/redeem", suite.handler.RedeemPoints)
+	}
+}
+
+func TestLoyaltyHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(LoyaltyHandlerTestSuite))
+}
+
+func (suite *LoyaltyHandlerTestSuite) TestGetAccount_Success() {
+	// Given
+	account := &domain.LoyaltyAccount{CustomerID: "customer-123", Balance: 700, LifetimePoints: 1200, Tier: "Gold"}
+	suite.mockService.On("GetAccount", mock.Anything, "customer-123").Return(account, nil)
+
+	// When
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/api/v1/loyalty/customer-123", nil)
+	suite.router.ServeHTTP(w, req)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.Equal(http.StatusOK, w.Code)
+	var response domain.LoyaltyAccount
+	json.Unmarshal(w.Body.Bytes(), &response)
+	assert.Equal(700, response.Balance)
+	assert.Equal("Gold", response.Tier)
+}
+
+func (suite *LoyaltyHandlerTestSuite) TestGetHistory_Success() {
+	// Given
+	history := []*domain.LoyaltyEntry{
+		{ID: "lpt_2", CustomerID: "customer-123", Type: domain.LoyaltyEntryRedeem, Points: -500},
+		{ID: "lpt_1", CustomerID: "customer-123", Type: domain.LoyaltyEntryEarn, Points: 1200},
+	}
+	suite.mockService.On("GetHistory", mock.Anything, "customer-123").Return(history, nil)
+
+	// When
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/api/v1/loyalty/customer-123/history", nil)
+	suite.router.ServeHTTP(w, req)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.Equal(http.StatusOK, w.Code)
+	var response []*domain.LoyaltyEntry
+	json.Unmarshal(w.Body.Bytes(), &response)
+	assert.Len(response, 2)
+	assert.Equal(domain.LoyaltyEntryRedeem, response[0].Type)
+}
+
+func (suite *LoyaltyHandlerTestSuite) TestRedeemPoints_Success() {
+	// Given
+	order, _ := domain.NewOrder("customer-123", domain.OrderTypeTakeout)
+	order.AddItem("burger", "Burger", 2, 10.00, nil, "")
+	order.ApplyDiscount(domain.NewLoyaltyDiscount(500, 5.00, "cashier-1"))
+	suite.mockService.On("RedeemPoints", mock.Anything, order.ID, 500).Return(order, nil)
+
+	// When
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", "/api/v1/orders/"+order.ID.String()+"/loyalty/redeem", bytes.NewBufferString(`{"points":500}`))
+	req.Header.Set("Content-Type", "application/json")
+	suite.router.ServeHTTP(w, req)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.Equal(http.StatusOK, w.Code)
+	var response application.OrderResponse
+	json.Unmarshal(w.Body.Bytes(), &response)
+	assert.Len(response.Discounts, 1)
+	assert.Equal("LOYALTY", response.Discounts[0].Type)
+	assert.Equal(500, response.Discounts[0].Points)
+}
+
+func (suite *LoyaltyHandlerTestSuite) TestRedeemPoints_MissingPoints_ShouldReturnBadRequest() {
+	// When
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", "/api/v1/orders/ord_1/loyalty/redeem", bytes.NewBufferString(`{}`))
+	req.Header.Set("Content-Type", "application/json")
+	suite.router.ServeHTTP(w, req)
+
+	// Then
+	assert.New(suite.T()).Equal(http.StatusBadRequest, w.Code)
+	suite.mockService.AssertNotCalled(suite.T(), "RedeemPoints", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *LoyaltyHandlerTestSuite) TestRedeemPoints_InsufficientBalance_ShouldReturnUnprocessable() {
+	// Given
+	suite.mockService.On("RedeemPoints", mock.Anything, domain.OrderID("ord_1"), 500).
+		Return(nil, sharedErrors.WrapConflict("Redeem", "balance", "the balance of 100 points does not cover 500 points", nil))
+
+	// When
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", "/api/v1/orders/ord_1/loyalty/redeem", bytes.NewBufferString(`{"points":500}`))
+	req.Header.Set("Content-Type", "application/json")
+	suite.router.ServeHTTP(w, req)
+
+	// Then
+	assert.New(suite.T()).Equal(http.StatusUnprocessableEntity, w.Code)
+}
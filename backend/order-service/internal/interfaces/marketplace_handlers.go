@@ -0,0 +1,89 @@
+package interfaces
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/restaurant-platform/order-service/internal/application"
+	"github.com/restaurant-platform/order-service/internal/domain"
+)
+
+// MarketplaceHandler handles marketplace order webhooks and item mappings
+type MarketplaceHandler struct {
+	marketplaceService domain.MarketplaceService
+}
+
+// NewMarketplaceHandler creates a new marketplace handler
+func NewMarketplaceHandler(marketplaceService domain.MarketplaceService) *MarketplaceHandler {
+	return &MarketplaceHandler{
+		marketplaceService: marketplaceService,
+	}
+}
+
+// ReceiveOrder ingests an order webhook signed by the marketplace. Rejected orders are
+// answered with 200 too, since the webhook was handled and must not be retried.
+// POST /api/v1/marketplaces/:marketplace/orders
+func (h *MarketplaceHandler) ReceiveOrder(c *gin.Context) {
+	marketplace := c.Param("marketplace")
+
+	header, err := h.marketplaceService.SignatureHeader(marketplace)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	// The signature covers the exact bytes sent, so the body is read raw rather than bound
+	payload, err := c.GetRawData()
+	if err != nil {
+		c.JSON(http.StatusBadRequest, application.ErrorResponse{
+			Error:   "Invalid request",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	ingested, err := h.marketplaceService.IngestOrder(c.Request.Context(), marketplace, payload, c.GetHeader(header))
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, ingested)
+}
+
+// GetItemMappings lists the marketplace item IDs mapped to menu items
+// GET /api/v1/marketplaces/:marketplace/items
+func (h *MarketplaceHandler) GetItemMappings(c *gin.Context) {
+	mappings, err := h.marketplaceService.GetItemMappings(c.Request.Context(), c.Param("marketplace"))
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	if mappings == nil {
+		mappings = []*domain.MarketplaceItemMapping{}
+	}
+	c.JSON(http.StatusOK, mappings)
+}
+
+// SetItemMapping maps a marketplace item ID to a menu item
+// PUT /api/v1/marketplaces/:marketplace/items/:externalItemId
+func (h *MarketplaceHandler) SetItemMapping(c *gin.Context) {
+	var req application.SetItemMappingRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, application.ErrorResponse{
+			Error:   "Invalid request",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	mapping, err := h.marketplaceService.SetItemMapping(c.Request.Context(), c.Param("marketplace"), c.Param("externalItemId"), req.MenuItemID)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, mapping)
+}
@@ -0,0 +1,194 @@
+package interfaces
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/restaurant-platform/order-service/internal/domain"
+	sharedErrors "github.com/restaurant-platform/shared/pkg/errors"
+)
+
+// MockMarketplaceService is a mock implementation of the MarketplaceService interface
+type MockMarketplaceService struct {
+	mock.Mock
+}
+
+func (m *MockMarketplaceService) SignatureHeader(marketplace string) (string, error) {
+	args := m.Called(marketplace)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockMarketplaceService) IngestOrder(ctx context.Context, marketplace string, payload []byte, signature string) (*domain.IngestedOrder, error) {
+	args := m.Called(ctx, marketplace, payload, signature)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.IngestedOrder), args.Error(1)
+}
+
+func (m *MockMarketplaceService) SetItemMapping(ctx context.Context, marketplace, externalItemID, menuItemID string) (*domain.MarketplaceItemMapping, error) {
+	args := m.Called(ctx, marketplace, externalItemID, menuItemID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.MarketplaceItemMapping), args.Error(1)
+}
+
+func (m *MockMarketplaceService) GetItemMappings(ctx context.Context, marketplace string) ([]*domain.MarketplaceItemMapping, error) {
+	args := m.Called(ctx, marketplace)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.MarketplaceItemMapping), args.Error(1)
+}
+
+// MarketplaceHandlerTestSuite contains marketplace webhook and item mapping handler tests
+type MarketplaceHandlerTestSuite struct {
+	suite.Suite
+	router      *gin.Engine
+	mockService *MockMarketplaceService
+	handler     *MarketplaceHandler
+}
+
+func (suite *MarketplaceHandlerTestSuite) SetupTest() {
+	gin.SetMode(gin.TestMode)
+	suite.mockService = new(MockMarketplaceService)
+	suite.handler = NewMarketplaceHandler(suite.mockService)
+
+	suite.router = gin.New()
+	api := suite.router.Group("/api/v1")
+	{
+		api.POST("/marketplaces/:marketplace/orders", suite.handler.ReceiveOrder)
+		api.GET("/marketplaces/:marketplace/items", suite.handler.GetItemMappings)
+		api.PUT("/marketplaces/:marketplace/items/:externalItemId", suite.handler.SetItemMapping)
+	}
+}
+
+func TestMarketplaceHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(MarketplaceHandlerTestSuite))
+}
+
+func (suite *MarketplaceHandlerTestSuite) receive(marketplace, body, signature string) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", "/api/v1/marketplaces/"+marketplace+"/orders", bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Marketplace-Signature", signature)
+	suite.router.ServeHTTP(w, req)
+	return w
+}
+
+func (suite *MarketplaceHandlerTestSuite) TestReceiveOrder_PassesRawBodyAndSignature() {
+	// Given
+	body := `{"order_id": "A-1001"}`
+	ingested := &domain.IngestedOrder{ID: "mko_1", Marketplace: "generic", ExternalID: "A-1001", OrderID: "ord_1", Status: domain.MarketplaceOrderStatusAccepted}
+	suite.mockService.On("SignatureHeader", "generic").Return("X-Marketplace-Signature", nil)
+	suite.mockService.On("IngestOrder", mock.Anything, "generic", []byte(body), "sha256=abc").Return(ingested, nil)
+
+	// When
+	w := suite.receive("generic", body, "sha256=abc")
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.Equal(http.StatusOK, w.Code)
+	var response domain.IngestedOrder
+	json.Unmarshal(w.Body.Bytes(), &response)
+	assert.Equal(domain.MarketplaceOrderStatusAccepted, response.Status)
+	assert.Equal(domain.OrderID("ord_1"), response.OrderID)
+}
+
+func (suite *MarketplaceHandlerTestSuite) TestReceiveOrder_Rejected_ShouldReturnOK() {
+	// Given
+	ingested := &domain.IngestedOrder{ID: "mko_1", Marketplace: "generic", ExternalID: "A-1001",
+		Status: domain.MarketplaceOrderStatusRejected, Reason: "kitchen is at capacity with 25 orders in progress"}
+	suite.mockService.On("SignatureHeader", "generic").Return("X-Marketplace-Signature", nil)
+	suite.mockService.On("IngestOrder", mock.Anything, "generic", mock.Anything, "sha256=abc").Return(ingested, nil)
+
+	// When
+	w := suite.receive("generic", `{}`, "sha256=abc")
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.Equal(http.StatusOK, w.Code)
+	assert.Contains(w.Body.String(), "REJECTED")
+}
+
+func (suite *MarketplaceHandlerTestSuite) TestReceiveOrder_InvalidSignature_ShouldReturnUnauthorized() {
+	// Given
+	suite.mockService.On("SignatureHeader", "generic").Return("X-Marketplace-Signature", nil)
+	suite.mockService.On("IngestOrder", mock.Anything, "generic", mock.Anything, "forged").
+		Return(nil, sharedErrors.WrapUnauthorized("VerifySignature", "invalid webhook signature", nil))
+
+	// When
+	w := suite.receive("generic", `{}`, "forged")
+
+	// Then
+	assert.New(suite.T()).Equal(http.StatusUnauthorized, w.Code)
+}
+
+func (suite *MarketplaceHandlerTestSuite) TestReceiveOrder_UnknownMarketplace_ShouldReturnNotFound() {
+	// Given
+	suite.mockService.On("SignatureHeader", "other").
+		Return("", sharedErrors.WrapNotFound("MarketplaceAdapters.Get", "marketplace", "other", sharedErrors.ErrNotFound))
+
+	// When
+	w := suite.receive("other", `{}`, "sha256=abc")
+
+	// Then
+	assert.New(suite.T()).Equal(http.StatusNotFound, w.Code)
+	suite.mockService.AssertNotCalled(suite.T(), "IngestOrder", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *MarketplaceHandlerTestSuite) TestSetItemMapping_Success() {
+	// Given
+	mapping := &domain.MarketplaceItemMapping{Marketplace: "generic", ExternalItemID: "mk-burger", MenuItemID: "burger"}
+	suite.mockService.On("SetItemMapping", mock.Anything, "generic", "mk-burger", "burger").Return(mapping, nil)
+
+	// When
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("PUT", "/api/v1/marketplaces/generic/items/mk-burger", bytes.NewBufferString(`{"menu_item_id":"burger"}`))
+	req.Header.Set("Content-Type", "application/json")
+	suite.router.ServeHTTP(w, req)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.Equal(http.StatusOK, w.Code)
+	suite.mockService.AssertExpectations(suite.T())
+}
+
+func (suite *MarketplaceHandlerTestSuite) TestSetItemMapping_MissingMenuItem_ShouldReturnBadRequest() {
+	// When
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("PUT", "/api/v1/marketplaces/generic/items/mk-burger", bytes.NewBufferString(`{}`))
+	req.Header.Set("Content-Type", "application/json")
+	suite.router.ServeHTTP(w, req)
+
+	// Then
+	assert.New(suite.T()).Equal(http.StatusBadRequest, w.Code)
+}
+
+func (suite *MarketplaceHandlerTestSuite) TestGetItemMappings_Success() {
+	// Given
+	mappings := []*domain.MarketplaceItemMapping{{Marketplace: "generic", ExternalItemID: "mk-burger", MenuItemID: "burger"}}
+	suite.mockService.On("GetItemMappings", mock.Anything, "generic").Return(mappings, nil)
+
+	// When
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/api/v1/marketplaces/generic/items", nil)
+	suite.router.ServeHTTP(w, req)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.Equal(http.StatusOK, w.Code)
+	var response []*domain.MarketplaceItemMapping
+	json.Unmarshal(w.Body.Bytes(), &response)
+	assert.Len(response, 1)
+}
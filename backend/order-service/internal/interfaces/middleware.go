@@ -0,0 +1,73 @@
+package interfaces
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/restaurant-platform/order-service/internal/application"
+	"github.com/restaurant-platform/shared/pkg/auth"
+)
+
+// AnonymousActor is recorded for API requests made without a bearer token
+const AnonymousActor = "anonymous"
+
+// ActorMiddleware attributes each request to the user in its bearer token so that
+// status changes record who made them. Requests without a token are served as
+// anonymous; a token that fails validation is rejected.
+func ActorMiddleware(secretKey string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		actor := AnonymousActor
+
+		if authHeader := c.GetHeader("Authorization"); authHeader != "" {
+			tokenString := strings.TrimSpace(strings.TrimPrefix(authHeader, "Bearer "))
+			if tokenString == authHeader || tokenString == "" {
+				c.AbortWithStatusJSON(http.StatusUnauthorized, application.ErrorResponse{
+					Error:   "Unauthorized",
+					Message: "Bearer token required",
+				})
+				return
+			}
+
+			claims, err := auth.ParseToken(tokenString, secretKey)
+			if err != nil {
+				c.AbortWithStatusJSON(http.StatusUnauthorized, application.ErrorResponse{
+					Error:   "Unauthorized",
+					Message: err.Error(),
+				})
+				return
+			}
+
+			actor = claims.UserID
+			c.Set("userID", claims.UserID)
+			ctx = auth.WithRole(ctx, claims.RoleID)
+		}
+
+		c.Request = c.Request.WithContext(auth.WithActor(ctx, actor))
+		c.Next()
+	}
+}
+
+// FrontOfHouseRoles are the roles that may move checks between tables
+var FrontOfHouseRoles = []string{auth.RoleAdmin, auth.RoleManager, auth.RoleWaitstaff, auth.RoleHost, auth.RoleCashier}
+
+// RequireRole rejects requests from users without one of the given roles
+func RequireRole(roleIDs ...string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !auth.HasRole(c.Request.Context(), roleIDs...) {
+			c.AbortWithStatusJSON(http.StatusForbidden, application.ErrorResponse{
+				Error:   "Forbidden",
+				Message: "Your role is not allowed to perform this operation",
+			})
+			return
+		}
+		c.Next()
+	}
+}
+
+// RequireManager rejects requests from users without the manager or administrator role
+func RequireManager() gin.HandlerFunc {
+	return RequireRole(auth.RoleManager, auth.RoleAdmin)
+}
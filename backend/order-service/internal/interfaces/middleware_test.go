@@ -0,0 +1,235 @@
+package interfaces
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/restaurant-platform/shared/pkg/auth"
+	"github.com/restaurant-platform/shared/pkg/concurrency"
+)
+
+const testSecret = "test-secret"
+
+// MiddlewareTestSuite contains actor middleware tests
+type MiddlewareTestSuite struct {
+	suite.Suite
+	router *gin.Engine
+	actor  string
+}
+
+func TestMiddlewareTestSuite(t *testing.T) {
+	suite.Run(t, new(MiddlewareTestSuite))
+}
+
+func (suite *MiddlewareTestSuite) SetupTest() {
+	gin.SetMode(gin.TestMode)
+	suite.actor = ""
+
+	suite.router = gin.New()
+	suite.router.Use(ActorMiddleware(testSecret))
+	suite.router.GET("/whoami", func(c *gin.Context) {
+		suite.actor, _ = auth.ActorFromContext(c.Request.Context())
+		c.Status(http.StatusOK)
+	})
+}
+
+func (suite *MiddlewareTestSuite) signToken(claims auth.Claims, secret string) string {
+	token, _ := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(secret))
+	return token
+}
+
+func (suite *MiddlewareTestSuite) request(authHeader string) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/whoami", nil)
+	if authHeader != "" {
+		req.Header.Set("Authorization", authHeader)
+	}
+	suite.router.ServeHTTP(w, req)
+	return w
+}
+
+func (suite *MiddlewareTestSuite) TestActorMiddleware_ValidToken_SetsUser() {
+	// Given
+	token := suite.signToken(auth.Claims{
+		UserID:    "user-42",
+		TokenType: "access",
+		RegisteredClaims: jwt.RegisteredClaims{
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+		},
+	}, testSecret)
+
+	// When
+	w := suite.request("Bearer " + token)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.Equal(http.StatusOK, w.Code)
+	assert.Equal("user-42", suite.actor)
+}
+
+func (suite *MiddlewareTestSuite) TestActorMiddleware_NoToken_IsAnonymous() {
+	// When
+	w := suite.request("")
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.Equal(http.StatusOK, w.Code)
+	assert.Equal(AnonymousActor, suite.actor)
+}
+
+func (suite *MiddlewareTestSuite) TestActorMiddleware_WrongSecret_ShouldReturnUnauthorized() {
+	// Given
+	token := suite.signToken(auth.Claims{UserID: "user-42", TokenType: "access"}, "other-secret")
+
+	// When
+	w := suite.request("Bearer " + token)
+
+	// Then
+	assert.New(suite.T()).Equal(http.StatusUnauthorized, w.Code)
+}
+
+func (suite *MiddlewareTestSuite) TestActorMiddleware_RefreshToken_ShouldReturnUnauthorized() {
+	// Given
+	token := suite.signToken(auth.Claims{UserID: "user-42", TokenType: "refresh"}, testSecret)
+
+	// When
+	w := suite.request("Bearer " + token)
+
+	// Then
+	assert.New(suite.T()).Equal(http.StatusUnauthorized, w.Code)
+}
+
+func (suite *MiddlewareTestSuite) TestActorMiddleware_NotBearer_ShouldReturnUnauthorized() {
+	// When
+	w := suite.request("Basic dXNlcjpwYXNz")
+
+	// Then
+	assert.New(suite.T()).Equal(http.StatusUnauthorized, w.Code)
+}
+
+func (suite *MiddlewareTestSuite) TestIfMatchMiddleware_SetsExpectedVersion() {
+	// Given
+	var version int
+	var ok bool
+	router := gin.New()
+	router.Use(concurrency.IfMatchMiddleware())
+	router.PATCH("/orders/:id", func(c *gin.Context) {
+		version, ok = concurrency.ExpectedVersionFromContext(c.Request.Context())
+		c.Status(http.StatusOK)
+	})
+
+	// When
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("PATCH", "/orders/ord_1", nil)
+	req.Header.Set("If-Match", `W/"7"`)
+	router.ServeHTTP(w, req)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.Equal(http.StatusOK, w.Code)
+	assert.True(ok)
+	assert.Equal(7, version)
+}
+
+func (suite *MiddlewareTestSuite) TestIfMatchMiddleware_Malformed_ShouldReturnBadRequest() {
+	// Given
+	router := gin.New()
+	router.Use(concurrency.IfMatchMiddleware())
+	router.PATCH("/orders/:id", func(c *gin.Context) {
+		c.Status(http.StatusOK)
+	})
+
+	// When
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("PATCH", "/orders/ord_1", nil)
+	req.Header.Set("If-Match", "seven")
+	router.ServeHTTP(w, req)
+
+	// Then
+	assert.New(suite.T()).Equal(http.StatusBadRequest, w.Code)
+}
+
+func (suite *MiddlewareTestSuite) TestRequireManager_ManagerRole_IsAllowed() {
+	// Given
+	router := gin.New()
+	router.Use(ActorMiddleware(testSecret), RequireManager())
+	router.POST("/refund", func(c *gin.Context) {
+		c.Status(http.StatusOK)
+	})
+	token := suite.signToken(auth.Claims{
+		UserID:    "manager-1",
+		RoleID:    auth.RoleManager,
+		TokenType: "access",
+		RegisteredClaims: jwt.RegisteredClaims{
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+		},
+	}, testSecret)
+
+	// When
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", "/refund", nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+	router.ServeHTTP(w, req)
+
+	// Then
+	assert.New(suite.T()).Equal(http.StatusOK, w.Code)
+}
+
+func (suite *MiddlewareTestSuite) TestRequireManager_OtherRole_ShouldReturnForbidden() {
+	// Given
+	router := gin.New()
+	router.Use(ActorMiddleware(testSecret), RequireManager())
+	router.POST("/refund", func(c *gin.Context) {
+		c.Status(http.StatusOK)
+	})
+	token := suite.signToken(auth.Claims{
+		UserID:    "cashier-1",
+		RoleID:    "role_cashier",
+		TokenType: "access",
+		RegisteredClaims: jwt.RegisteredClaims{
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+		},
+	}, testSecret)
+
+	// When
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", "/refund", nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+	router.ServeHTTP(w, req)
+
+	// Then
+	assert.New(suite.T()).Equal(http.StatusForbidden, w.Code)
+}
+
+func (suite *MiddlewareTestSuite) TestRequireRole_KitchenStaff_ShouldReturnForbidden() {
+	// Given
+	router := gin.New()
+	router.Use(ActorMiddleware(testSecret), RequireRole(FrontOfHouseRoles...))
+	router.POST("/transfer", func(c *gin.Context) {
+		c.Status(http.StatusOK)
+	})
+	token := suite.signToken(auth.Claims{
+		UserID:    "cook-1",
+		RoleID:    auth.RoleKitchenStaff,
+		TokenType: "access",
+		RegisteredClaims: jwt.RegisteredClaims{
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+		},
+	}, testSecret)
+
+	// When
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", "/transfer", nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+	router.ServeHTTP(w, req)
+
+	// Then
+	assert.New(suite.T()).Equal(http.StatusForbidden, w.Code)
+}
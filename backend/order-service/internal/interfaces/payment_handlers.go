@@ -0,0 +1,131 @@
+package interfaces
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/restaurant-platform/order-service/internal/application"
+	"github.com/restaurant-platform/order-service/internal/domain"
+	"github.com/restaurant-platform/shared/pkg/errors"
+)
+
+// PaymentHandler handles HTTP requests for order payments
+type PaymentHandler struct {
+	paymentService domain.PaymentService
+}
+
+// NewPaymentHandler creates a new payment handler
+func NewPaymentHandler(paymentService domain.PaymentService) *PaymentHandler {
+	return &PaymentHandler{
+		paymentService: paymentService,
+	}
+}
+
+// AddTender applies a tender to an order
+// POST /api/v1/orders/:id/payments
+func (h *PaymentHandler) AddTender(c *gin.Context) {
+	orderID := domain.OrderID(c.Param("id"))
+
+	var req application.AddTenderRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, application.ErrorResponse{
+			Error:   "Invalid request",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	tenderType, err := validateTenderType(req.Type)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, application.ErrorResponse{
+			Error:   "Invalid tender type",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	payment, err := h.paymentService.AddTender(c.Request.Context(), orderID, tenderType, req.Amount, req.AmountTendered, req.Reference)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, application.ToPaymentResponse(payment))
+}
+
+// GetPayment retrieves the payment for an order
+// GET /api/v1/orders/:id/payments
+func (h *PaymentHandler) GetPayment(c *gin.Context) {
+	orderID := domain.OrderID(c.Param("id"))
+
+	payment, err := h.paymentService.GetPaymentForOrder(c.Request.Context(), orderID)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, application.ToPaymentResponse(payment))
+}
+
+// RefundTender refunds part or all of a tender
+// POST /api/v1/payments/:paymentId/tenders/:tenderId/refund
+func (h *PaymentHandler) RefundTender(c *gin.Context) {
+	paymentID := domain.PaymentID(c.Param("paymentId"))
+	tenderID := domain.TenderID(c.Param("tenderId"))
+
+	var req application.RefundTenderRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, application.ErrorResponse{
+			Error:   "Invalid request",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	payment, err := h.paymentService.RefundTender(c.Request.Context(), paymentID, tenderID, req.Amount, req.Reason)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, application.ToPaymentResponse(payment))
+}
+
+// VoidPayment voids an unsettled payment
+// POST /api/v1/payments/:paymentId/void
+func (h *PaymentHandler) VoidPayment(c *gin.Context) {
+	paymentID := domain.PaymentID(c.Param("paymentId"))
+
+	var req application.VoidPaymentRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, application.ErrorResponse{
+			Error:   "Invalid request",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	payment, err := h.paymentService.VoidPayment(c.Request.Context(), paymentID, req.Reason)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, application.ToPaymentResponse(payment))
+}
+
+// Helper functions
+
+func validateTenderType(tenderType string) (domain.TenderType, error) {
+	switch tenderType {
+	case string(domain.TenderTypeCash):
+		return domain.TenderTypeCash, nil
+	case string(domain.TenderTypeCard):
+		return domain.TenderTypeCard, nil
+	case string(domain.TenderTypeGiftCard):
+		return domain.TenderTypeGiftCard, nil
+	default:
+		return "", errors.WrapValidation("validateTenderType", "type", "invalid tender type", nil)
+	}
+}
@@ -0,0 +1,183 @@
+package interfaces
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/restaurant-platform/order-service/internal/application"
+	"github.com/restaurant-platform/order-service/internal/domain"
+	"github.com/restaurant-platform/shared/pkg/concurrency"
+	sharedErrors "github.com/restaurant-platform/shared/pkg/errors"
+)
+
+// MockPaymentService is a mock implementation of the PaymentService interface
+type MockPaymentService struct {
+	mock.Mock
+}
+
+func (m *MockPaymentService) AddTender(ctx context.Context, orderID domain.OrderID, tenderType domain.TenderType, amount, amountTendered float64, reference string) (*domain.Payment, error) {
+	args := m.Called(ctx, orderID, tenderType, amount, amountTendered, reference)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Payment), args.Error(1)
+}
+
+func (m *MockPaymentService) GetPaymentForOrder(ctx context.Context, orderID domain.OrderID) (*domain.Payment, error) {
+	args := m.Called(ctx, orderID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Payment), args.Error(1)
+}
+
+func (m *MockPaymentService) RefundTender(ctx context.Context, paymentID domain.PaymentID, tenderID domain.TenderID, amount float64, reason string) (*domain.Payment, error) {
+	args := m.Called(ctx, paymentID, tenderID, amount, reason)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Payment), args.Error(1)
+}
+
+func (m *MockPaymentService) VoidPayment(ctx context.Context, paymentID domain.PaymentID, reason string) (*domain.Payment, error) {
+	args := m.Called(ctx, paymentID, reason)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Payment), args.Error(1)
+}
+
+// PaymentHandlerTestSuite contains all payment handler tests
+type PaymentHandlerTestSuite struct {
+	suite.Suite
+	router      *gin.Engine
+	mockService *MockPaymentService
+	handler     *PaymentHandler
+}
+
+func (suite *PaymentHandlerTestSuite) SetupTest() {
+	gin.SetMode(gin.TestMode)
+	suite.mockService = new(MockPaymentService)
+	suite.handler = NewPaymentHandler(suite.mockService)
+
+	suite.router = gin.New()
+	api := suite.router.Group("/api/v1")
+	api.Use(concurrency.IfMatchMiddleware())
+	{
+		api.POST("/orders/:id/payments", suite.handler.AddTender)
+		api.GET("/orders/:id/payments", suite.handler.GetPayment)
+		api.POST("/payments/:paymentId/tenders/:tenderId/refund", suite.handler.RefundTender)
+		api.POST("/payments/:paymentId/void", suite.handler.VoidPayment)
+	}
+}
+
+func TestPaymentHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(PaymentHandlerTestSuite))
+}
+
+func (suite *PaymentHandlerTestSuite) TestAddTender_Success() {
+	// Given
+	orderID := "ord_123"
+	payment, _ := domain.NewPayment(domain.OrderID(orderID), 22.00)
+	payment.AddTender(domain.TenderTypeCash, 0, 30.00, "", "")
+
+	suite.mockService.On("AddTender", mock.Anything, domain.OrderID(orderID), domain.TenderTypeCash, float64(0), 30.00, "").
+		Return(payment, nil)
+
+	body, _ := json.Marshal(application.AddTenderRequest{Type: "CASH", AmountTendered: 30.00})
+
+	// When
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", "/api/v1/orders/"+orderID+"/payments", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	suite.router.ServeHTTP(w, req)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.Equal(http.StatusCreated, w.Code)
+
+	var response application.PaymentResponse
+	json.Unmarshal(w.Body.Bytes(), &response)
+	assert.Equal("PAID", response.Status)
+	assert.Equal(8.00, response.ChangeGiven)
+	assert.Len(response.Tenders, 1)
+
+	suite.mockService.AssertExpectations(suite.T())
+}
+
+func (suite *PaymentHandlerTestSuite) TestAddTender_StaleIfMatch_ShouldReturnPreconditionFailed() {
+	// Given
+	orderID := "ord_123"
+	suite.mockService.On("AddTender", mock.MatchedBy(func(ctx context.Context) bool {
+		version, ok := concurrency.ExpectedVersionFromContext(ctx)
+		return ok && version == 1
+	}), domain.OrderID(orderID), domain.TenderTypeCard, 22.00, float64(0), "visa-4242").
+		Return(nil, sharedErrors.WrapPreconditionFailed("AddTender", "order", orderID, 1, 2))
+
+	body, _ := json.Marshal(application.AddTenderRequest{Type: "CARD", Amount: 22.00, Reference: "visa-4242"})
+
+	// When
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", "/api/v1/orders/"+orderID+"/payments", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("If-Match", `"1"`)
+	suite.router.ServeHTTP(w, req)
+
+	// Then
+	assert.New(suite.T()).Equal(http.StatusPreconditionFailed, w.Code)
+	suite.mockService.AssertExpectations(suite.T())
+}
+
+func (suite *PaymentHandlerTestSuite) TestAddTender_InvalidTenderType_ShouldReturnBadRequest() {
+	// Given
+	body, _ := json.Marshal(application.AddTenderRequest{Type: "BITCOIN", Amount: 10.00})
+
+	// When
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", "/api/v1/orders/ord_123/payments", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	suite.router.ServeHTTP(w, req)
+
+	// Then
+	assert.New(suite.T()).Equal(http.StatusBadRequest, w.Code)
+	suite.mockService.AssertNotCalled(suite.T(), "AddTender")
+}
+
+func (suite *PaymentHandlerTestSuite) TestRefundTender_Overpayment_ShouldReturnBadRequest() {
+	// Given
+	suite.mockService.On("RefundTender", mock.Anything, domain.PaymentID("pay_1"), domain.TenderID("tnd_1"), 50.00, "wrong item").
+		Return(nil, sharedErrors.WrapValidation("Refund", "amount", "amount exceeds refundable balance of tender", nil))
+
+	body, _ := json.Marshal(application.RefundTenderRequest{Amount: 50.00, Reason: "wrong item"})
+
+	// When
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", "/api/v1/payments/pay_1/tenders/tnd_1/refund", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	suite.router.ServeHTTP(w, req)
+
+	// Then
+	assert.New(suite.T()).Equal(http.StatusBadRequest, w.Code)
+	suite.mockService.AssertExpectations(suite.T())
+}
+
+func (suite *PaymentHandlerTestSuite) TestVoidPayment_MissingReason_ShouldReturnBadRequest() {
+	// When
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", "/api/v1/payments/pay_1/void", bytes.NewBufferString(`{}`))
+	req.Header.Set("Content-Type", "application/json")
+	suite.router.ServeHTTP(w, req)
+
+	// Then
+	assert.New(suite.T()).Equal(http.StatusBadRequest, w.Code)
+	suite.mockService.AssertNotCalled(suite.T(), "VoidPayment")
+}
@@ -0,0 +1,82 @@
+package interfaces
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/restaurant-platform/order-service/internal/application"
+	"github.com/restaurant-platform/order-service/internal/domain"
+)
+
+// ReceiptHandler handles HTTP requests for guest checks and receipts
+type ReceiptHandler struct {
+	receiptService domain.ReceiptService
+}
+
+// NewReceiptHandler creates a new receipt handler
+func NewReceiptHandler(receiptService domain.ReceiptService) *ReceiptHandler {
+	return &ReceiptHandler{
+		receiptService: receiptService,
+	}
+}
+
+// GetReceipt renders the guest check or receipt of an order.
+// format is escpos, text or html and defaults to text; width is 40 or 48 and defaults to 48.
+// GET /api/v1/orders/:id/receipt
+func (h *ReceiptHandler) GetReceipt(c *gin.Context) {
+	orderID := domain.OrderID(c.Param("id"))
+
+	format, width, ok := bindReceiptRequest(c)
+	if !ok {
+		return
+	}
+
+	rendered, err := h.receiptService.GetReceipt(c.Request.Context(), orderID, format, width)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.Data(http.StatusOK, rendered.ContentType, rendered.Content)
+}
+
+// ReprintReceipt renders the guest check or receipt of an order again, marked as a reprint
+// POST /api/v1/orders/:id/receipt/reprint
+func (h *ReceiptHandler) ReprintReceipt(c *gin.Context) {
+	orderID := domain.OrderID(c.Param("id"))
+
+	format, width, ok := bindReceiptRequest(c)
+	if !ok {
+		return
+	}
+
+	rendered, err := h.receiptService.ReprintReceipt(c.Request.Context(), orderID, format, width)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.Data(http.StatusOK, rendered.ContentType, rendered.Content)
+}
+
+func bindReceiptRequest(c *gin.Context) (domain.ReceiptFormat, int, bool) {
+	var req application.ReceiptRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		c.JSON(http.StatusBadRequest, application.ErrorResponse{
+			Error:   "Invalid request",
+			Message: err.Error(),
+		})
+		return "", 0, false
+	}
+
+	format := domain.ReceiptFormat(req.Format)
+	if format == "" {
+		format = domain.ReceiptFormatText
+	}
+	width := req.Width
+	if width == 0 {
+		width = domain.ReceiptWidthWide
+	}
+	return format, width, true
+}
@@ -0,0 +1,143 @@
+package interfaces
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/restaurant-platform/order-service/internal/domain"
+	sharedErrors "github.com/restaurant-platform/shared/pkg/errors"
+)
+
+// MockReceiptService is a mock implementation of the ReceiptService interface
+type MockReceiptService struct {
+	mock.Mock
+}
+
+func (m *MockReceiptService) GetReceipt(ctx context.Context, orderID domain.OrderID, format domain.ReceiptFormat, width int) (*domain.RenderedReceipt, error) {
+	args := m.Called(ctx, orderID, format, width)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.RenderedReceipt), args.Error(1)
+}
+
+func (m *MockReceiptService) ReprintReceipt(ctx context.Context, orderID domain.OrderID, format domain.ReceiptFormat, width int) (*domain.RenderedReceipt, error) {
+	args := m.Called(ctx, orderID, format, width)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.RenderedReceipt), args.Error(1)
+}
+
+// ReceiptHandlerTestSuite contains all receipt handler tests
+type ReceiptHandlerTestSuite struct {
+	suite.Suite
+	router      *gin.Engine
+	mockService *MockReceiptService
+	handler     *ReceiptHandler
+}
+
+func (suite *ReceiptHandlerTestSuite) SetupTest() {
+	gin.SetMode(gin.TestMode)
+	suite.mockService = new(MockReceiptService)
+	suite.handler = NewReceiptHandler(suite.mockService)
+
+	suite.router = gin.New()
+	api := suite.router.Group("/api/v1")
+	{
+		api.GET("/orders/:id/receipt", suite.handler.GetReceipt)
+		api.POST("/orders/:id/receipt/reprint", suite.handler.ReprintReceipt)
+	}
+}
+
+func TestReceiptHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(ReceiptHandlerTestSuite))
+}
+
+func (suite *ReceiptHandlerTestSuite) TestGetReceipt_DefaultsToWideText() {
+	// Given
+	suite.mockService.On("GetReceipt", mock.Anything, domain.OrderID("ord_123"), domain.ReceiptFormatText, 48).
+		Return(&domain.RenderedReceipt{Format: domain.ReceiptFormatText, ContentType: "text/plain; charset=utf-8", Content: []byte("GUEST CHECK")}, nil)
+
+	// When
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/api/v1/orders/ord_123/receipt", nil)
+	suite.router.ServeHTTP(w, req)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.Equal(http.StatusOK, w.Code)
+	assert.Equal("text/plain; charset=utf-8", w.Header().Get("Content-Type"))
+	assert.Equal("GUEST CHECK", w.Body.String())
+	suite.mockService.AssertExpectations(suite.T())
+}
+
+func (suite *ReceiptHandlerTestSuite) TestGetReceipt_ESCPOS_ReturnsPrinterBytes() {
+	// Given
+	content := []byte("\x1b@RECEIPT\x1dVB\x00")
+	suite.mockService.On("GetReceipt", mock.Anything, domain.OrderID("ord_123"), domain.ReceiptFormatESCPOS, 40).
+		Return(&domain.RenderedReceipt{Format: domain.ReceiptFormatESCPOS, ContentType: "application/octet-stream", Content: content}, nil)
+
+	// When
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/api/v1/orders/ord_123/receipt?format=escpos&width=40", nil)
+	suite.router.ServeHTTP(w, req)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.Equal(http.StatusOK, w.Code)
+	assert.Equal("application/octet-stream", w.Header().Get("Content-Type"))
+	assert.Equal(content, w.Body.Bytes())
+}
+
+func (suite *ReceiptHandlerTestSuite) TestGetReceipt_InvalidWidth_ShouldReturnBadRequest() {
+	// Given
+	suite.mockService.On("GetReceipt", mock.Anything, domain.OrderID("ord_123"), domain.ReceiptFormatText, 80).
+		Return(nil, sharedErrors.WrapValidation("ValidateReceiptFormat", "width", "width must be 40 or 48 columns", nil))
+
+	// When
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/api/v1/orders/ord_123/receipt?width=80", nil)
+	suite.router.ServeHTTP(w, req)
+
+	// Then
+	assert.Equal(suite.T(), http.StatusBadRequest, w.Code)
+}
+
+func (suite *ReceiptHandlerTestSuite) TestGetReceipt_OrderNotFound_ShouldReturnNotFound() {
+	// Given
+	suite.mockService.On("GetReceipt", mock.Anything, domain.OrderID("missing"), domain.ReceiptFormatHTML, 48).
+		Return(nil, sharedErrors.WrapNotFound("GetByID", "order", "missing", sharedErrors.ErrNotFound))
+
+	// When
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/api/v1/orders/missing/receipt?format=html", nil)
+	suite.router.ServeHTTP(w, req)
+
+	// Then
+	assert.Equal(suite.T(), http.StatusNotFound, w.Code)
+}
+
+func (suite *ReceiptHandlerTestSuite) TestReprintReceipt_Success() {
+	// Given
+	suite.mockService.On("ReprintReceipt", mock.Anything, domain.OrderID("ord_123"), domain.ReceiptFormatHTML, 48).
+		Return(&domain.RenderedReceipt{Format: domain.ReceiptFormatHTML, ContentType: "text/html; charset=utf-8", Content: []byte("<p>REPRINT</p>")}, nil)
+
+	// When
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", "/api/v1/orders/ord_123/receipt/reprint?format=html", nil)
+	suite.router.ServeHTTP(w, req)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.Equal(http.StatusOK, w.Code)
+	assert.Equal("<p>REPRINT</p>", w.Body.String())
+	suite.mockService.AssertExpectations(suite.T())
+}
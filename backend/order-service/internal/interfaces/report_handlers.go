@@ -0,0 +1,201 @@
+package interfaces
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/restaurant-platform/order-service/internal/application"
+	"github.com/restaurant-platform/order-service/internal/domain"
+)
+
+const (
+	reportFormatJSON = "json"
+	reportFormatCSV  = "csv"
+)
+
+// ReportHandler handles HTTP requests for sales reports and the end-of-day close
+type ReportHandler struct {
+	reportService domain.ReportService
+}
+
+// NewReportHandler creates a new report handler
+func NewReportHandler(reportService domain.ReportService) *ReportHandler {
+	return &ReportHandler{
+		reportService: reportService,
+	}
+}
+
+// GetSalesReport returns the live sales report of a business day as JSON or CSV.
+// date is YYYY-MM-DD and defaults to the current business day.
+// GET /api/v1/reports/sales
+func (h *ReportHandler) GetSalesReport(c *gin.Context) {
+	var req application.SalesReportRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		c.JSON(http.StatusBadRequest, application.ErrorResponse{
+			Error:   "Invalid request",
+			Message: err.Error(),
+		})
+		return
+	}
+	if !validReportFormat(c, req.Format) {
+		return
+	}
+
+	report, err := h.reportService.GetSalesReport(c.Request.Context(), req.Date)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	writeReport(c, req.Format, "sales-report-"+report.BusinessDate, report, report)
+}
+
+// CloseBusinessDay takes the immutable Z-report of a business day that has ended.
+// business_date defaults to the previous business day.
+// POST /api/v1/reports/z
+func (h *ReportHandler) CloseBusinessDay(c *gin.Context) {
+	var req application.CloseBusinessDayRequest
+	if c.Request.ContentLength != 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, application.ErrorResponse{
+				Error:   "Invalid request",
+				Message: err.Error(),
+			})
+			return
+		}
+	}
+
+	zReport, err := h.reportService.CloseBusinessDay(c.Request.Context(), req.BusinessDate)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, zReport)
+}
+
+// ListZReports lists the most recent Z-reports, newest first
+// GET /api/v1/reports/z
+func (h *ReportHandler) ListZReports(c *gin.Context) {
+	var req application.ListZReportsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		c.JSON(http.StatusBadRequest, application.ErrorResponse{
+			Error:   "Invalid request",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	zReports, err := h.reportService.ListZReports(c.Request.Context(), req.Limit)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, zReports)
+}
+
+// GetZReport returns the Z-report of a closed business day as JSON or CSV
+// GET /api/v1/reports/z/:date
+func (h *ReportHandler) GetZReport(c *gin.Context) {
+	var req application.ZReportRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		c.JSON(http.StatusBadRequest, application.ErrorResponse{
+			Error:   "Invalid request",
+			Message: err.Error(),
+		})
+		return
+	}
+	if !validReportFormat(c, req.Format) {
+		return
+	}
+
+	zReport, err := h.reportService.GetZReport(c.Request.Context(), c.Param("date"))
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	writeReport(c, req.Format, "z-report-"+zReport.BusinessDate, zReport, zReport.Report)
+}
+
+func validReportFormat(c *gin.Context, format string) bool {
+	switch format {
+	case "", reportFormatJSON, reportFormatCSV:
+		return true
+	}
+	c.JSON(http.StatusBadRequest, application.ErrorResponse{
+		Error:   "Invalid format",
+		Message: "format must be json or csv",
+	})
+	return false
+}
+
+// writeReport responds with body as JSON, or with the sales report as a CSV download
+func writeReport(c *gin.Context, format, filename string, body interface{}, report *domain.SalesReport) {
+	if format != reportFormatCSV {
+		c.JSON(http.StatusOK, body)
+		return
+	}
+
+	content, err := salesReportCSV(report)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="%s.csv"`, filename))
+	c.Data(http.StatusOK, "text/csv; charset=utf-8", content)
+}
+
+// salesReportCSV flattens a sales report into section,key,count,amount rows
+func salesReportCSV(report *domain.SalesReport) ([]byte, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+
+	row := func(section, key string, count int, amount float64) {
+		w.Write([]string{section, key, strconv.Itoa(count), strconv.FormatFloat(amount, 'f', 2, 64)})
+	}
+	breakdown := func(section string, entries []*domain.SalesBreakdown, quantities bool) {
+		for _, entry := range entries {
+			count := entry.Orders
+			if quantities {
+				count = entry.Quantity
+			}
+			row(section, entry.Key, count, entry.Amount)
+		}
+	}
+
+	w.Write([]string{"section", "key", "count", "amount"})
+	row("summary", "gross_sales", report.Orders, report.GrossSales)
+	row("summary", "discounts", 0, report.Discounts)
+	row("summary", "net_sales", report.Orders, report.NetSales)
+	row("summary", "tax_collected", report.Orders, report.TaxCollected)
+	row("summary", "delivery_fees", report.Orders, report.DeliveryFees)
+	row("summary", "refunds", 0, report.Refunds)
+	row("summary", "average_check", report.Orders, report.AverageCheck)
+	row("voids", "items", report.Voids.Items, report.Voids.ItemAmount)
+	row("voids", "cancelled_orders", report.Voids.CancelledOrders, report.Voids.CancelledAmount)
+	row("open_checks", "open_checks", report.OpenChecks, report.OpenAmount)
+	breakdown("order_type", report.ByOrderType, false)
+	breakdown("hour", report.ByHour, false)
+	breakdown("category", report.ByCategory, true)
+	breakdown("menu_item", report.ByMenuItem, true)
+	breakdown("staff", report.ByStaff, false)
+	for _, tender := range report.Tenders {
+		row("tender", string(tender.Type), tender.Count, tender.Amount)
+		row("tender_refunded", string(tender.Type), tender.Count, tender.Refunded)
+		row("tender_net", string(tender.Type), tender.Count, tender.Net)
+	}
+
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return nil, fmt.Errorf("failed to write report csv: %w", err)
+	}
+	return buf.Bytes(), nil
+}
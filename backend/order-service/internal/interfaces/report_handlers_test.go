@@ -0,0 +1,242 @@
+package interfaces
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/restaurant-platform/order-service/internal/domain"
+	sharedErrors "github.com/restaurant-platform/shared/pkg/errors"
+)
+
+// MockReportService is a mock implementation of the ReportService interface
+type MockReportService struct {
+	mock.Mock
+}
+
+func (m *MockReportService) GetSalesReport(ctx context.Context, businessDate string) (*domain.SalesReport, error) {
+	args := m.Called(ctx, businessDate)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.SalesReport), args.Error(1)
+}
+
+func (m *MockReportService) CloseBusinessDay(ctx context.Context, businessDate string) (*domain.ZReport, error) {
+	args := m.Called(ctx, businessDate)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.ZReport), args.Error(1)
+}
+
+func (m *MockReportService) GetZReport(ctx context.Context, businessDate string) (*domain.ZReport, error) {
+	args := m.Called(ctx, businessDate)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.ZReport), args.Error(1)
+}
+
+func (m *MockReportService) ListZReports(ctx context.Context, limit int) ([]*domain.ZReport, error) {
+	args := m.Called(ctx, limit)
+	return args.Get(0).([]*domain.ZReport), args.Error(1)
+}
+
+// ReportHandlerTestSuite contains all report handler tests
+type ReportHandlerTestSuite struct {
+	suite.Suite
+	router      *gin.Engine
+	mockService *MockReportService
+	handler     *ReportHandler
+	report      *domain.SalesReport
+}
+
+func (suite *ReportHandlerTestSuite) SetupTest() {
+	gin.SetMode(gin.TestMode)
+	suite.mockService = new(MockReportService)
+	suite.handler = NewReportHandler(suite.mockService)
+
+	suite.router = gin.New()
+	api := suite.router.Group("/api/v1")
+	{
+		api.GET("/reports/sales", suite.handler.GetSalesReport)
+		api.POST("/reports/z", suite.handler.CloseBusinessDay)
+		api.GET("/reports/z", suite.handler.ListZReports)
+		api.GET("/reports/z/:date", suite.handler.GetZReport)
+	}
+
+	suite.report = &domain.SalesReport{
+		BusinessDate: "2024-03-08",
+		Orders:       2,
+		GrossSales:   48.47,
+		NetSales:     48.47,
+		TaxCollected: 4.85,
+		ByCategory:   []*domain.SalesBreakdown{{Key: "Mains", Quantity: 3, Amount: 38.97}},
+		ByStaff:      []*domain.SalesBreakdown{{Key: "alice", Orders: 2, Amount: 48.47}},
+		Tenders:      []*domain.TenderTotal{{Type: domain.TenderTypeCard, Count: 1, Amount: 14.29, Refunded: 2.00, Net: 12.29}},
+	}
+}
+
+func TestReportHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(ReportHandlerTestSuite))
+}
+
+func (suite *ReportHandlerTestSuite) TestGetSalesReport_JSON() {
+	// Given
+	suite.mockService.On("GetSalesReport", mock.Anything, "2024-03-08").Return(suite.report, nil)
+
+	// When
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/api/v1/reports/sales?date=2024-03-08", nil)
+	suite.router.ServeHTTP(w, req)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.Equal(http.StatusOK, w.Code)
+
+	var response domain.SalesReport
+	assert.NoError(json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(48.47, response.NetSales)
+	assert.Equal("Mains", response.ByCategory[0].Key)
+	suite.mockService.AssertExpectations(suite.T())
+}
+
+func (suite *ReportHandlerTestSuite) TestGetSalesReport_CSV() {
+	// Given
+	suite.mockService.On("GetSalesReport", mock.Anything, "").Return(suite.report, nil)
+
+	// When
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/api/v1/reports/sales?format=csv", nil)
+	suite.router.ServeHTTP(w, req)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.Equal(http.StatusOK, w.Code)
+	assert.Equal("text/csv; charset=utf-8", w.Header().Get("Content-Type"))
+	assert.Equal(`attachment; filename="sales-report-2024-03-08.csv"`, w.Header().Get("Content-Disposition"))
+
+	lines := strings.Split(strings.TrimSpace(w.Body.String()), "\n")
+	assert.Equal("section,key,count,amount", lines[0])
+	assert.Contains(lines, "summary,net_sales,2,48.47")
+	assert.Contains(lines, "category,Mains,3,38.97")
+	assert.Contains(lines, "staff,alice,2,48.47")
+	assert.Contains(lines, "tender_net,CARD,1,12.29")
+}
+
+func (suite *ReportHandlerTestSuite) TestGetSalesReport_InvalidFormat_ShouldReturnBadRequest() {
+	// When
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/api/v1/reports/sales?format=xlsx", nil)
+	suite.router.ServeHTTP(w, req)
+
+	// Then
+	assert.Equal(suite.T(), http.StatusBadRequest, w.Code)
+	suite.mockService.AssertNotCalled(suite.T(), "GetSalesReport", mock.Anything, mock.Anything)
+}
+
+func (suite *ReportHandlerTestSuite) TestCloseBusinessDay_Success() {
+	// Given
+	zReport := &domain.ZReport{BusinessDate: "2024-03-08", Report: suite.report, ClosedBy: "manager-1", ClosedAt: time.Now()}
+	suite.mockService.On("CloseBusinessDay", mock.Anything, "2024-03-08").Return(zReport, nil)
+
+	// When
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", "/api/v1/reports/z", strings.NewReader(`{"business_date":"2024-03-08"}`))
+	req.Header.Set("Content-Type", "application/json")
+	suite.router.ServeHTTP(w, req)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.Equal(http.StatusCreated, w.Code)
+
+	var response domain.ZReport
+	assert.NoError(json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal("manager-1", response.ClosedBy)
+	assert.Equal(48.47, response.Report.NetSales)
+}
+
+func (suite *ReportHandlerTestSuite) TestCloseBusinessDay_NoBody_ClosesPreviousDay() {
+	// Given
+	zReport := &domain.ZReport{BusinessDate: "2024-03-08", Report: suite.report}
+	suite.mockService.On("CloseBusinessDay", mock.Anything, "").Return(zReport, nil)
+
+	// When
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", "/api/v1/reports/z", nil)
+	suite.router.ServeHTTP(w, req)
+
+	// Then
+	assert.Equal(suite.T(), http.StatusCreated, w.Code)
+	suite.mockService.AssertExpectations(suite.T())
+}
+
+func (suite *ReportHandlerTestSuite) TestCloseBusinessDay_AlreadyClosed_ShouldReturnUnprocessableEntity() {
+	// Given
+	suite.mockService.On("CloseBusinessDay", mock.Anything, "2024-03-08").
+		Return(nil, sharedErrors.WrapConflict("ZReportRepository.Create", "business_date", "business day 2024-03-08 is already closed", nil))
+
+	// When
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", "/api/v1/reports/z", strings.NewReader(`{"business_date":"2024-03-08"}`))
+	req.Header.Set("Content-Type", "application/json")
+	suite.router.ServeHTTP(w, req)
+
+	// Then
+	assert.Equal(suite.T(), http.StatusUnprocessableEntity, w.Code)
+}
+
+func (suite *ReportHandlerTestSuite) TestListZReports_DefaultLimit() {
+	// Given
+	suite.mockService.On("ListZReports", mock.Anything, 30).Return([]*domain.ZReport{{BusinessDate: "2024-03-08"}}, nil)
+
+	// When
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/api/v1/reports/z", nil)
+	suite.router.ServeHTTP(w, req)
+
+	// Then
+	assert.Equal(suite.T(), http.StatusOK, w.Code)
+	suite.mockService.AssertExpectations(suite.T())
+}
+
+func (suite *ReportHandlerTestSuite) TestGetZReport_CSV() {
+	// Given
+	zReport := &domain.ZReport{BusinessDate: "2024-03-08", Report: suite.report, ClosedBy: "manager-1"}
+	suite.mockService.On("GetZReport", mock.Anything, "2024-03-08").Return(zReport, nil)
+
+	// When
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/api/v1/reports/z/2024-03-08?format=csv", nil)
+	suite.router.ServeHTTP(w, req)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.Equal(http.StatusOK, w.Code)
+	assert.Equal(`attachment; filename="z-report-2024-03-08.csv"`, w.Header().Get("Content-Disposition"))
+	assert.Contains(w.Body.String(), "summary,gross_sales,2,48.47")
+}
+
+func (suite *ReportHandlerTestSuite) TestGetZReport_NotClosed_ShouldReturnNotFound() {
+	// Given
+	suite.mockService.On("GetZReport", mock.Anything, "2024-03-09").
+		Return(nil, sharedErrors.WrapNotFound("ZReportRepository.GetByDate", "z_report", "2024-03-09", sharedErrors.ErrNotFound))
+
+	// When
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/api/v1/reports/z/2024-03-09", nil)
+	suite.router.ServeHTTP(w, req)
+
+	// Then
+	assert.Equal(suite.T(), http.StatusNotFound, w.Code)
+}
@@ -9,9 +9,11 @@ import (
 
 	"github.com/restaurant-platform/order-service/internal/application"
 	"github.com/restaurant-platform/order-service/internal/domain"
+	"github.com/restaurant-platform/shared/pkg/concurrency"
+	"github.com/restaurant-platform/shared/pkg/idempotency"
 )
 
-func SetupRouter(orderService domain.OrderService) *gin.Engine {
+func SetupRouter(orderService domain.OrderService, paymentService domain.PaymentService, deliveryService domain.DeliveryService, receiptService domain.ReceiptService, reportService domain.ReportService, adjustmentService domain.AdjustmentService, tableService domain.TableService, slaService domain.SLAService, marketplaceService domain.MarketplaceService, loyaltyService domain.LoyaltyService, giftCardService domain.GiftCardService, drawerService domain.DrawerService, idempotencyStore idempotency.Store, jwtSecret string) *gin.Engine {
 	router := gin.Default()
 
 	// CORS middleware
@@ -19,7 +21,7 @@ func SetupRouter(orderService domain.OrderService) *gin.Engine {
 		AllowOrigins:     []string{"*"},
 		AllowMethods:     []string{"GET", "POST", "PUT", "PATCH", "DELETE", "OPTIONS"},
 		AllowHeaders:     []string{"*"},
-		ExposeHeaders:    []string{"Content-Length"},
+		ExposeHeaders:    []string{"Content-Length", "ETag", idempotency.HeaderReplayed},
 		AllowCredentials: true,
 		MaxAge:           12 * time.Hour,
 	}))
@@ -34,32 +36,161 @@ func SetupRouter(orderService domain.OrderService) *gin.Engine {
 	})
 
 	// Initialize handlers
-	orderHandler := NewOrderHandler(orderService)
+	orderHandler := NewOrderHandler(orderService, tableService)
+	paymentHandler := NewPaymentHandler(paymentService)
+	deliveryHandler := NewDeliveryHandler(deliveryService)
+	receiptHandler := NewReceiptHandler(receiptService)
+	reportHandler := NewReportHandler(reportService)
+	adjustmentHandler := NewAdjustmentHandler(adjustmentService)
+	tableHandler := NewTableHandler(tableService)
+	slaHandler := NewSLAHandler(slaService)
+	marketplaceHandler := NewMarketplaceHandler(marketplaceService)
+	loyaltyHandler := NewLoyaltyHandler(loyaltyService)
+	giftCardHandler := NewGiftCardHandler(giftCardService)
+	drawerHandler := NewDrawerHandler(drawerService)
 
-	// API routes
+	// API routes, attributed to the authenticated user when a token is present.
+	// Writes carrying an Idempotency-Key are replayed instead of being applied twice.
 	v1 := router.Group("/api/v1")
+	v1.Use(ActorMiddleware(jwtSecret), idempotency.Middleware(idempotencyStore, idempotency.DefaultTTL))
 	{
-		// Order routes
+		// Order routes, with If-Match conditional updates against the order version
 		orders := v1.Group("/orders")
+		orders.Use(concurrency.IfMatchMiddleware())
 		{
 			orders.POST("", orderHandler.CreateOrder)
 			orders.GET("", orderHandler.ListOrders)
 			orders.GET("/active", orderHandler.GetActiveOrders)
+			orders.GET("/scheduled", orderHandler.GetScheduledOrders)
+			orders.GET("/metrics/status", orderHandler.GetStatusMetrics)
 			orders.GET("/status/:status", orderHandler.GetOrdersByStatus)
 			orders.GET("/customer/:customerId", orderHandler.GetOrdersByCustomer)
 			orders.GET("/table/:tableId", orderHandler.GetOrdersByTable)
 			orders.GET("/:id", orderHandler.GetOrder)
+			orders.GET("/:id/history", orderHandler.GetStatusHistory)
 			orders.PATCH("/:id/status", orderHandler.UpdateOrderStatus)
 			orders.PATCH("/:id/table", orderHandler.SetTable)
 			orders.PATCH("/:id/delivery-address", orderHandler.SetDeliveryAddress)
 			orders.PATCH("/:id/notes", orderHandler.AddNotes)
-			orders.PATCH("/:id/pay", orderHandler.PayOrder)
-			orders.DELETE("/:id", orderHandler.CancelOrder)
+			orders.PATCH("/:id/schedule", orderHandler.RescheduleOrder)
 
 			// Order item management
 			orders.POST("/:id/items", orderHandler.AddItemToOrder)
 			orders.PATCH("/:id/items/:itemId/quantity", orderHandler.UpdateItemQuantity)
 			orders.DELETE("/:id/items/:itemId", orderHandler.RemoveItemFromOrder)
+			orders.POST("/:id/items/:itemId/void", orderHandler.VoidItem)
+
+			// Table transfers, check merges and item moves, for front-of-house staff
+			orders.POST("/:id/transfer", RequireRole(FrontOfHouseRoles...), tableHandler.TransferTable)
+			orders.POST("/:id/merge", RequireRole(FrontOfHouseRoles...), tableHandler.MergeOrders)
+			orders.POST("/:id/items/move", RequireRole(FrontOfHouseRoles...), tableHandler.MoveItems)
+
+			// Guest counts and seat positions on dine-in checks
+			orders.PATCH("/:id/guests", RequireRole(FrontOfHouseRoles...), tableHandler.SeatGuests)
+			orders.PATCH("/:id/items/:itemId/seat", RequireRole(FrontOfHouseRoles...), tableHandler.AssignSeat)
+			orders.GET("/:id/seats", tableHandler.GetSeats)
+
+			// Course hold-and-fire
+			orders.POST("/:id/courses/:course/fire", orderHandler.FireCourse)
+
+			// Order payments
+			orders.POST("/:id/payments", paymentHandler.AddTender)
+			orders.GET("/:id/payments", paymentHandler.GetPayment)
+
+			// Voids and refunds, with manager approval above the threshold
+			orders.POST("/:id/void", adjustmentHandler.VoidOrder)
+			orders.POST("/:id/refunds", adjustmentHandler.RefundOrder)
+			orders.GET("/:id/adjustments", adjustmentHandler.GetAdjustments)
+
+			// Order delivery
+			orders.POST("/:id/delivery", deliveryHandler.CreateDelivery)
+			orders.GET("/:id/delivery", deliveryHandler.GetDelivery)
+
+			// Guest checks and receipts
+			orders.GET("/:id/receipt", receiptHandler.GetReceipt)
+			orders.POST("/:id/receipt/reprint", receiptHandler.ReprintReceipt)
+
+			// Loyalty points redeemed as an order discount
+			orders.POST("/:id/loyalty/redeem", loyaltyHandler.RedeemPoints)
+		}
+
+		// Payment routes; refunding a tender or voiding a payment directly bypasses the refund
+		// and void workflow, so both are manager-only
+		payments := v1.Group("/payments")
+		{
+			payments.POST("/:paymentId/tenders/:tenderId/refund", RequireManager(), paymentHandler.RefundTender)
+			payments.POST("/:paymentId/void", RequireManager(), paymentHandler.VoidPayment)
+		}
+
+		// Delivery zone and driver routes; zones and drivers are administered by managers
+		delivery := v1.Group("/delivery")
+		{
+			delivery.POST("/zones", RequireManager(), deliveryHandler.CreateZone)
+			delivery.GET("/zones", deliveryHandler.ListZones)
+			delivery.PATCH("/zones/:zoneId/active", RequireManager(), deliveryHandler.SetZoneActive)
+			delivery.POST("/quote", deliveryHandler.QuoteDelivery)
+			delivery.POST("/drivers", RequireManager(), deliveryHandler.CreateDriver)
+			delivery.GET("/drivers", deliveryHandler.ListDrivers)
+			delivery.PATCH("/drivers/:driverId/status", RequireManager(), deliveryHandler.SetDriverStatus)
+		}
+
+		// Delivery tracking routes, for front-of-house staff
+		deliveries := v1.Group("/deliveries", RequireRole(FrontOfHouseRoles...))
+		{
+			deliveries.POST("/:deliveryId/assign", deliveryHandler.AssignDriver)
+			deliveries.POST("/:deliveryId/pickup", deliveryHandler.PickUpDelivery)
+			deliveries.POST("/:deliveryId/complete", deliveryHandler.CompleteDelivery)
+		}
+
+		// Sales reporting and end-of-day Z-reports, for managers only
+		reports := v1.Group("/reports", RequireManager())
+		{
+			reports.GET("/sales", reportHandler.GetSalesReport)
+			reports.POST("/z", reportHandler.CloseBusinessDay)
+			reports.GET("/z", reportHandler.ListZReports)
+			reports.GET("/z/:date", reportHandler.GetZReport)
+		}
+
+		// Orders in breach of their SLA
+		alerts := v1.Group("/alerts")
+		{
+			alerts.GET("/sla", slaHandler.GetAlerts)
+		}
+
+		// Delivery marketplace order webhooks, authenticated by their signature, and item mappings
+		marketplaces := v1.Group("/marketplaces/:marketplace")
+		{
+			marketplaces.POST("/orders", marketplaceHandler.ReceiveOrder)
+			marketplaces.GET("/items", marketplaceHandler.GetItemMappings)
+			marketplaces.PUT("/items/:externalItemId", RequireManager(), marketplaceHandler.SetItemMapping)
+		}
+
+		// Loyalty points balances and ledgers, keyed by the customer ID on orders
+		loyalty := v1.Group("/loyalty")
+		{
+			loyalty.GET("/:customerId", loyaltyHandler.GetAccount)
+			loyalty.GET("/:customerId/history", loyaltyHandler.GetHistory)
+		}
+
+		// Gift cards, issued and reloaded at the counter; the card code travels in the body
+		giftCards := v1.Group("/gift-cards")
+		{
+			giftCards.POST("", RequireRole(FrontOfHouseRoles...), giftCardHandler.IssueGiftCard)
+			giftCards.POST("/balance", giftCardHandler.GetBalance)
+			giftCards.POST("/reload", RequireRole(FrontOfHouseRoles...), giftCardHandler.ReloadGiftCard)
+			giftCards.GET("/:id/transactions", RequireManager(), giftCardHandler.GetTransactions)
+		}
+
+		// Cashier drawer sessions; cash tenders are taken into the cashier's open session
+		drawers := v1.Group("/drawers")
+		{
+			drawers.POST("", RequireRole(FrontOfHouseRoles...), drawerHandler.OpenSession)
+			drawers.GET("", RequireManager(), drawerHandler.ListSessions)
+			drawers.GET("/current", drawerHandler.GetCurrentSession)
+			drawers.GET("/:id", drawerHandler.GetSession)
+			drawers.POST("/:id/movements", RequireRole(FrontOfHouseRoles...), drawerHandler.RecordMovement)
+			drawers.POST("/:id/close", RequireRole(FrontOfHouseRoles...), drawerHandler.CloseSession)
+			drawers.POST("/:id/sign-off", RequireManager(), drawerHandler.SignOff)
 		}
 	}
 
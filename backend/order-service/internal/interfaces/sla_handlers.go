@@ -0,0 +1,35 @@
+package interfaces
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/restaurant-platform/order-service/internal/application"
+	"github.com/restaurant-platform/order-service/internal/domain"
+)
+
+// SLAHandler handles HTTP requests for order SLA alerts
+type SLAHandler struct {
+	slaService domain.SLAService
+}
+
+// NewSLAHandler creates a new SLA handler
+func NewSLAHandler(slaService domain.SLAService) *SLAHandler {
+	return &SLAHandler{
+		slaService: slaService,
+	}
+}
+
+// GetAlerts returns the orders currently in breach of their SLA, oldest breach first
+// GET /api/v1/alerts/sla
+func (h *SLAHandler) GetAlerts(c *gin.Context) {
+	alerts, err := h.slaService.GetOpenAlerts(c.Request.Context())
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, application.ToSLAAlertResponses(alerts, time.Now()))
+}
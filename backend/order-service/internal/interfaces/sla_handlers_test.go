@@ -0,0 +1,95 @@
+package interfaces
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/restaurant-platform/order-service/internal/application"
+	"github.com/restaurant-platform/order-service/internal/domain"
+)
+
+// MockSLAService is a mock implementation of the SLAService interface
+type MockSLAService struct {
+	mock.Mock
+}
+
+func (m *MockSLAService) CheckOrders(ctx context.Context, now time.Time) (int, error) {
+	args := m.Called(ctx, now)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockSLAService) CancelUnpaidOrders(ctx context.Context, now time.Time) (int, error) {
+	args := m.Called(ctx, now)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockSLAService) GetOpenAlerts(ctx context.Context) ([]*domain.SLAAlert, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.SLAAlert), args.Error(1)
+}
+
+// SLAHandlerTestSuite contains all SLA alert handler tests
+type SLAHandlerTestSuite struct {
+	suite.Suite
+	router      *gin.Engine
+	mockService *MockSLAService
+	handler     *SLAHandler
+}
+
+func (suite *SLAHandlerTestSuite) SetupTest() {
+	gin.SetMode(gin.TestMode)
+	suite.mockService = new(MockSLAService)
+	suite.handler = NewSLAHandler(suite.mockService)
+
+	suite.router = gin.New()
+	api := suite.router.Group("/api/v1")
+	{
+		api.GET("/alerts/sla", suite.handler.GetAlerts)
+	}
+}
+
+func TestSLAHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(SLAHandlerTestSuite))
+}
+
+func (suite *SLAHandlerTestSuite) TestGetAlerts_Success() {
+	// Given
+	enteredAt := time.Now().Add(-25 * time.Minute)
+	alerts := []*domain.SLAAlert{{
+		ID:         "sla_1",
+		OrderID:    "ord_123",
+		OrderType:  domain.OrderTypeTakeout,
+		Status:     domain.OrderStatusPaid,
+		Threshold:  20 * time.Minute,
+		EnteredAt:  enteredAt,
+		BreachedAt: enteredAt.Add(20 * time.Minute),
+	}}
+	suite.mockService.On("GetOpenAlerts", mock.Anything).Return(alerts, nil)
+
+	// When
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/api/v1/alerts/sla", nil)
+	suite.router.ServeHTTP(w, req)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.Equal(http.StatusOK, w.Code)
+	var response []*application.SLAAlertResponse
+	json.Unmarshal(w.Body.Bytes(), &response)
+	assert.Len(response, 1)
+	assert.Equal("ord_123", response[0].OrderID)
+	assert.Equal(int64(1200), response[0].ThresholdSeconds)
+	assert.GreaterOrEqual(response[0].ElapsedSeconds, int64(1500))
+}
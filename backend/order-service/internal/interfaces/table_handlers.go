@@ -0,0 +1,160 @@
+package interfaces
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/restaurant-platform/order-service/internal/application"
+	"github.com/restaurant-platform/order-service/internal/domain"
+)
+
+// TableHandler handles HTTP requests for table transfers, check merges and item moves
+type TableHandler struct {
+	tableService domain.TableService
+}
+
+// NewTableHandler creates a new table handler
+func NewTableHandler(tableService domain.TableService) *TableHandler {
+	return &TableHandler{
+		tableService: tableService,
+	}
+}
+
+// TransferTable moves an order to another table
+// POST /api/v1/orders/:id/transfer
+func (h *TableHandler) TransferTable(c *gin.Context) {
+	orderID := domain.OrderID(c.Param("id"))
+
+	var req application.TransferTableRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, application.ErrorResponse{
+			Error:   "Invalid request",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	order, err := h.tableService.TransferTable(c.Request.Context(), orderID, req.TableID)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, application.ToOrderResponse(order))
+}
+
+// MergeOrders merges another check into this order
+// POST /api/v1/orders/:id/merge
+func (h *TableHandler) MergeOrders(c *gin.Context) {
+	targetID := domain.OrderID(c.Param("id"))
+
+	var req application.MergeOrdersRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, application.ErrorResponse{
+			Error:   "Invalid request",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	order, err := h.tableService.MergeOrders(c.Request.Context(), targetID, domain.OrderID(req.SourceOrderID))
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, application.ToOrderResponse(order))
+}
+
+// MoveItems moves items of this order onto another open check
+// POST /api/v1/orders/:id/items/move
+func (h *TableHandler) MoveItems(c *gin.Context) {
+	sourceID := domain.OrderID(c.Param("id"))
+
+	var req application.MoveItemsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, application.ErrorResponse{
+			Error:   "Invalid request",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	itemIDs := make([]domain.OrderItemID, len(req.ItemIDs))
+	for i, itemID := range req.ItemIDs {
+		itemIDs[i] = domain.OrderItemID(itemID)
+	}
+
+	source, target, err := h.tableService.MoveItems(c.Request.Context(), sourceID, domain.OrderID(req.TargetOrderID), itemIDs)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"source": application.ToOrderResponse(source),
+		"target": application.ToOrderResponse(target),
+	})
+}
+
+// SeatGuests sets the guest count of a dine-in order, defaulting to its reservation's party size
+// PATCH /api/v1/orders/:id/guests
+func (h *TableHandler) SeatGuests(c *gin.Context) {
+	orderID := domain.OrderID(c.Param("id"))
+
+	var req application.SeatGuestsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, application.ErrorResponse{
+			Error:   "Invalid request",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	order, err := h.tableService.SeatGuests(c.Request.Context(), orderID, req.GuestCount, req.ReservationID)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, application.ToOrderResponse(order))
+}
+
+// AssignSeat moves an item of a dine-in order to a seat
+// PATCH /api/v1/orders/:id/items/:itemId/seat
+func (h *TableHandler) AssignSeat(c *gin.Context) {
+	orderID := domain.OrderID(c.Param("id"))
+	itemID := domain.OrderItemID(c.Param("itemId"))
+
+	var req application.AssignSeatRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, application.ErrorResponse{
+			Error:   "Invalid request",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	order, err := h.tableService.AssignSeat(c.Request.Context(), orderID, itemID, *req.Seat)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, application.ToOrderResponse(order))
+}
+
+// GetSeats lists the items of a dine-in order per seat
+// GET /api/v1/orders/:id/seats
+func (h *TableHandler) GetSeats(c *gin.Context) {
+	orderID := domain.OrderID(c.Param("id"))
+
+	seats, err := h.tableService.GetSeats(c.Request.Context(), orderID)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, application.ToSeatResponses(seats))
+}
@@ -0,0 +1,259 @@
+package interfaces
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/restaurant-platform/order-service/internal/application"
+	"github.com/restaurant-platform/order-service/internal/domain"
+	sharedErrors "github.com/restaurant-platform/shared/pkg/errors"
+)
+
+// MockTableService is a mock implementation of the TableService interface
+type MockTableService struct {
+	mock.Mock
+}
+
+func (m *MockTableService) TransferTable(ctx context.Context, orderID domain.OrderID, tableID string) (*domain.Order, error) {
+	args := m.Called(ctx, orderID, tableID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Order), args.Error(1)
+}
+
+func (m *MockTableService) MergeOrders(ctx context.Context, targetID, sourceID domain.OrderID) (*domain.Order, error) {
+	args := m.Called(ctx, targetID, sourceID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Order), args.Error(1)
+}
+
+func (m *MockTableService) MoveItems(ctx context.Context, sourceID, targetID domain.OrderID, itemIDs []domain.OrderItemID) (*domain.Order, *domain.Order, error) {
+	args := m.Called(ctx, sourceID, targetID, itemIDs)
+	if args.Get(0) == nil {
+		return nil, nil, args.Error(2)
+	}
+	return args.Get(0).(*domain.Order), args.Get(1).(*domain.Order), args.Error(2)
+}
+
+func (m *MockTableService) SeatGuests(ctx context.Context, orderID domain.OrderID, guestCount int, reservationID string) (*domain.Order, error) {
+	args := m.Called(ctx, orderID, guestCount, reservationID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Order), args.Error(1)
+}
+
+func (m *MockTableService) AssignSeat(ctx context.Context, orderID domain.OrderID, itemID domain.OrderItemID, seat int) (*domain.Order, error) {
+	args := m.Called(ctx, orderID, itemID, seat)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Order), args.Error(1)
+}
+
+func (m *MockTableService) GetSeats(ctx context.Context, orderID domain.OrderID) ([]*domain.SeatItems, error) {
+	args := m.Called(ctx, orderID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.SeatItems), args.Error(1)
+}
+
+// TableHandlerTestSuite contains all table transfer, merge and item move handler tests
+type TableHandlerTestSuite struct {
+	suite.Suite
+	router      *gin.Engine
+	mockService *MockTableService
+	handler     *TableHandler
+}
+
+func (suite *TableHandlerTestSuite) SetupTest() {
+	gin.SetMode(gin.TestMode)
+	suite.mockService = new(MockTableService)
+	suite.handler = NewTableHandler(suite.mockService)
+
+	suite.router = gin.New()
+	api := suite.router.Group("/api/v1")
+	{
+		api.POST("/orders/:id/transfer", suite.handler.TransferTable)
+		api.POST("/orders/:id/merge", suite.handler.MergeOrders)
+		api.POST("/orders/:id/items/move", suite.handler.MoveItems)
+		api.PATCH("/orders/:id/guests", suite.handler.SeatGuests)
+		api.PATCH("/orders/:id/items/:itemId/seat", suite.handler.AssignSeat)
+		api.GET("/orders/:id/seats", suite.handler.GetSeats)
+	}
+}
+
+func TestTableHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(TableHandlerTestSuite))
+}
+
+func (suite *TableHandlerTestSuite) post(path, body string) *httptest.ResponseRecorder {
+	return suite.request("POST", path, body)
+}
+
+func (suite *TableHandlerTestSuite) request(method, path, body string) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest(method, path, bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", "application/json")
+	suite.router.ServeHTTP(w, req)
+	return w
+}
+
+func (suite *TableHandlerTestSuite) TestTransferTable_Success() {
+	// Given
+	order := &domain.Order{ID: "ord_123", Type: domain.OrderTypeDineIn, TableID: "table-9"}
+	suite.mockService.On("TransferTable", mock.Anything, domain.OrderID("ord_123"), "table-9").Return(order, nil)
+
+	// When
+	w := suite.post("/api/v1/orders/ord_123/transfer", `{"table_id":"table-9"}`)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.Equal(http.StatusOK, w.Code)
+	var response application.OrderResponse
+	json.Unmarshal(w.Body.Bytes(), &response)
+	assert.Equal("table-9", response.TableID)
+}
+
+func (suite *TableHandlerTestSuite) TestTransferTable_MissingTable_ShouldReturnBadRequest() {
+	// When
+	w := suite.post("/api/v1/orders/ord_123/transfer", `{}`)
+
+	// Then
+	assert.New(suite.T()).Equal(http.StatusBadRequest, w.Code)
+	suite.mockService.AssertNotCalled(suite.T(), "TransferTable", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *TableHandlerTestSuite) TestMergeOrders_Success() {
+	// Given
+	order := &domain.Order{ID: "ord_123", Type: domain.OrderTypeDineIn, TableID: "table-7"}
+	suite.mockService.On("MergeOrders", mock.Anything, domain.OrderID("ord_123"), domain.OrderID("ord_456")).Return(order, nil)
+
+	// When
+	w := suite.post("/api/v1/orders/ord_123/merge", `{"source_order_id":"ord_456"}`)
+
+	// Then
+	assert.New(suite.T()).Equal(http.StatusOK, w.Code)
+	suite.mockService.AssertExpectations(suite.T())
+}
+
+func (suite *TableHandlerTestSuite) TestMergeOrders_PaidCheck_ShouldReturnUnprocessable() {
+	// Given
+	suite.mockService.On("MergeOrders", mock.Anything, domain.OrderID("ord_123"), domain.OrderID("ord_456")).
+		Return(nil, sharedErrors.WrapConflict("MergeInto", "order_status", "order ord_456 is not an open unpaid check", nil))
+
+	// When
+	w := suite.post("/api/v1/orders/ord_123/merge", `{"source_order_id":"ord_456"}`)
+
+	// Then
+	assert.New(suite.T()).Equal(http.StatusUnprocessableEntity, w.Code)
+}
+
+func (suite *TableHandlerTestSuite) TestMoveItems_Success() {
+	// Given
+	source := &domain.Order{ID: "ord_123", Type: domain.OrderTypeDineIn, TableID: "table-4"}
+	target := &domain.Order{ID: "ord_456", Type: domain.OrderTypeDineIn, TableID: "table-7"}
+	suite.mockService.On("MoveItems", mock.Anything, domain.OrderID("ord_123"), domain.OrderID("ord_456"), []domain.OrderItemID{"itm_1"}).
+		Return(source, target, nil)
+
+	// When
+	w := suite.post("/api/v1/orders/ord_123/items/move", `{"target_order_id":"ord_456","item_ids":["itm_1"]}`)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.Equal(http.StatusOK, w.Code)
+	var response map[string]application.OrderResponse
+	json.Unmarshal(w.Body.Bytes(), &response)
+	assert.Equal("ord_123", response["source"].ID)
+	assert.Equal("ord_456", response["target"].ID)
+}
+
+func (suite *TableHandlerTestSuite) TestMoveItems_NoItems_ShouldReturnBadRequest() {
+	// When
+	w := suite.post("/api/v1/orders/ord_123/items/move", `{"target_order_id":"ord_456","item_ids":[]}`)
+
+	// Then
+	assert.New(suite.T()).Equal(http.StatusBadRequest, w.Code)
+}
+
+func (suite *TableHandlerTestSuite) TestSeatGuests_DefaultsFromReservation() {
+	// Given
+	order := &domain.Order{ID: "ord_123", Type: domain.OrderTypeDineIn, TableID: "table-4", GuestCount: 4, ReservationID: "res-1"}
+	suite.mockService.On("SeatGuests", mock.Anything, domain.OrderID("ord_123"), 0, "res-1").Return(order, nil)
+
+	// When
+	w := suite.request("PATCH", "/api/v1/orders/ord_123/guests", `{"reservation_id":"res-1"}`)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.Equal(http.StatusOK, w.Code)
+	var response application.OrderResponse
+	json.Unmarshal(w.Body.Bytes(), &response)
+	assert.Equal(4, response.GuestCount)
+	assert.Equal("res-1", response.ReservationID)
+}
+
+func (suite *TableHandlerTestSuite) TestSeatGuests_NegativeCount_ShouldReturnBadRequest() {
+	// When
+	w := suite.request("PATCH", "/api/v1/orders/ord_123/guests", `{"guest_count":-1}`)
+
+	// Then
+	assert.New(suite.T()).Equal(http.StatusBadRequest, w.Code)
+	suite.mockService.AssertNotCalled(suite.T(), "SeatGuests", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *TableHandlerTestSuite) TestAssignSeat_SharedSeat() {
+	// Given
+	order := &domain.Order{ID: "ord_123", Type: domain.OrderTypeDineIn}
+	suite.mockService.On("AssignSeat", mock.Anything, domain.OrderID("ord_123"), domain.OrderItemID("itm_1"), 0).Return(order, nil)
+
+	// When
+	w := suite.request("PATCH", "/api/v1/orders/ord_123/items/itm_1/seat", `{"seat":0}`)
+
+	// Then
+	assert.New(suite.T()).Equal(http.StatusOK, w.Code)
+	suite.mockService.AssertExpectations(suite.T())
+}
+
+func (suite *TableHandlerTestSuite) TestAssignSeat_MissingSeat_ShouldReturnBadRequest() {
+	// When
+	w := suite.request("PATCH", "/api/v1/orders/ord_123/items/itm_1/seat", `{}`)
+
+	// Then
+	assert.New(suite.T()).Equal(http.StatusBadRequest, w.Code)
+}
+
+func (suite *TableHandlerTestSuite) TestGetSeats_Success() {
+	// Given
+	seats := []*domain.SeatItems{
+		{Seat: 1, Items: []*domain.OrderItem{{ID: "itm_1", Name: "Burger", Quantity: 1, Subtotal: 10.00, Seat: 1}}, Subtotal: 10.00},
+		{Seat: 2, Items: []*domain.OrderItem{}},
+	}
+	suite.mockService.On("GetSeats", mock.Anything, domain.OrderID("ord_123")).Return(seats, nil)
+
+	// When
+	w := suite.request("GET", "/api/v1/orders/ord_123/seats", "")
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.Equal(http.StatusOK, w.Code)
+	var response []application.SeatResponse
+	json.Unmarshal(w.Body.Bytes(), &response)
+	assert.Len(response, 2)
+	assert.Equal(10.00, response[0].Subtotal)
+	assert.Equal("Burger", response[0].Items[0].Name)
+	assert.Empty(response[1].Items)
+}
@@ -0,0 +1,28 @@
+-- Order Service Database Schema
+-- Database: order_service_db
+
+-- Create payments table
+CREATE TABLE IF NOT EXISTS payments (
+    id VARCHAR(255) PRIMARY KEY,
+    order_id VARCHAR(255) NOT NULL REFERENCES orders(id),
+    status VARCHAR(20) NOT NULL DEFAULT 'PENDING'
+        CHECK (status IN ('PENDING', 'PARTIALLY_PAID', 'PAID', 'PARTIALLY_REFUNDED', 'REFUNDED', 'VOIDED')),
+    amount_due DECIMAL(10, 2) NOT NULL,
+    amount_paid DECIMAL(10, 2) NOT NULL DEFAULT 0.00,
+    amount_refunded DECIMAL(10, 2) NOT NULL DEFAULT 0.00,
+    change_given DECIMAL(10, 2) NOT NULL DEFAULT 0.00,
+    tenders JSONB NOT NULL DEFAULT '[]',
+    refunds JSONB NOT NULL DEFAULT '[]',
+    void_reason TEXT,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+-- Create indexes for better query performance
+CREATE INDEX IF NOT EXISTS idx_payments_order_id ON payments(order_id);
+CREATE INDEX IF NOT EXISTS idx_payments_status ON payments(status);
+CREATE INDEX IF NOT EXISTS idx_payments_created_at ON payments(created_at);
+
+-- Only one live (non-voided) payment per order
+CREATE UNIQUE INDEX IF NOT EXISTS idx_payments_order_live
+ON payments(order_id) WHERE status <> 'VOIDED';
@@ -0,0 +1,18 @@
+-- Order Service Database Schema
+-- Database: order_service_db
+
+-- Local read model of orderable menu items, kept current from menu.* events
+CREATE TABLE IF NOT EXISTS menu_items (
+    id VARCHAR(255) PRIMARY KEY,
+    menu_id VARCHAR(255) NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    price DECIMAL(10, 2) NOT NULL CHECK (price >= 0),
+    category_id VARCHAR(255),
+    category_name VARCHAR(255),
+    is_available BOOLEAN NOT NULL DEFAULT TRUE,
+    prep_time_seconds INTEGER NOT NULL DEFAULT 0,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+-- Create indexes for better query performance
+CREATE INDEX IF NOT EXISTS idx_menu_items_menu_id ON menu_items(menu_id);
@@ -0,0 +1,5 @@
+-- Order Service Database Schema
+-- Database: order_service_db
+
+-- Modifier groups offered for each menu item, snapshotted from menu.* events
+ALTER TABLE menu_items ADD COLUMN IF NOT EXISTS modifier_groups JSONB NOT NULL DEFAULT '[]';
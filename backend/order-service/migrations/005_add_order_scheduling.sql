@@ -0,0 +1,10 @@
+-- Order Service Database Schema
+-- Database: order_service_db
+
+-- Requested fulfillment time for scheduled orders and when they were released to the kitchen
+ALTER TABLE orders ADD COLUMN IF NOT EXISTS fulfillment_time TIMESTAMP WITH TIME ZONE;
+ALTER TABLE orders ADD COLUMN IF NOT EXISTS released_at TIMESTAMP WITH TIME ZONE;
+
+-- Scheduled orders awaiting release, scanned by the release scheduler
+CREATE INDEX IF NOT EXISTS idx_orders_awaiting_release ON orders(fulfillment_time)
+    WHERE fulfillment_time IS NOT NULL AND released_at IS NULL;
@@ -0,0 +1,58 @@
+-- Order Service Database Schema
+-- Database: order_service_db
+
+-- Delivery orders go out for delivery between READY and COMPLETED
+ALTER TABLE orders DROP CONSTRAINT IF EXISTS orders_status_check;
+ALTER TABLE orders ADD CONSTRAINT orders_status_check
+    CHECK (status IN ('CREATED', 'PAID', 'PREPARING', 'READY', 'OUT_FOR_DELIVERY', 'COMPLETED', 'CANCELLED'));
+
+-- Zone fee charged on delivery orders
+ALTER TABLE orders ADD COLUMN IF NOT EXISTS delivery_fee DECIMAL(10, 2) NOT NULL DEFAULT 0.00;
+
+-- Create delivery zones table; the boundary is a polygon of lat/lng points
+CREATE TABLE IF NOT EXISTS delivery_zones (
+    id VARCHAR(255) PRIMARY KEY,
+    name VARCHAR(255) NOT NULL,
+    boundary JSONB NOT NULL,
+    fee DECIMAL(10, 2) NOT NULL DEFAULT 0.00,
+    minimum_order DECIMAL(10, 2) NOT NULL DEFAULT 0.00,
+    is_active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+-- Create drivers table
+CREATE TABLE IF NOT EXISTS drivers (
+    id VARCHAR(255) PRIMARY KEY,
+    name VARCHAR(255) NOT NULL,
+    phone VARCHAR(50) NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'OFF_DUTY'
+        CHECK (status IN ('AVAILABLE', 'ON_DELIVERY', 'OFF_DUTY')),
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+-- Create deliveries table
+CREATE TABLE IF NOT EXISTS deliveries (
+    id VARCHAR(255) PRIMARY KEY,
+    order_id VARCHAR(255) NOT NULL UNIQUE REFERENCES orders(id),
+    zone_id VARCHAR(255) NOT NULL REFERENCES delivery_zones(id),
+    driver_id VARCHAR(255) REFERENCES drivers(id),
+    address TEXT NOT NULL,
+    latitude DOUBLE PRECISION NOT NULL,
+    longitude DOUBLE PRECISION NOT NULL,
+    fee DECIMAL(10, 2) NOT NULL DEFAULT 0.00,
+    status VARCHAR(20) NOT NULL DEFAULT 'PENDING'
+        CHECK (status IN ('PENDING', 'ASSIGNED', 'PICKED_UP', 'DELIVERED')),
+    estimated_arrival TIMESTAMP WITH TIME ZONE,
+    assigned_at TIMESTAMP WITH TIME ZONE,
+    picked_up_at TIMESTAMP WITH TIME ZONE,
+    delivered_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+-- Create indexes for better query performance
+CREATE INDEX IF NOT EXISTS idx_drivers_status ON drivers(status);
+CREATE INDEX IF NOT EXISTS idx_deliveries_driver_id ON deliveries(driver_id);
+CREATE INDEX IF NOT EXISTS idx_deliveries_status ON deliveries(status);
@@ -0,0 +1,5 @@
+-- Order Service Database Schema
+-- Database: order_service_db
+
+-- Status transitions of each order with the acting user or service and the reason
+ALTER TABLE orders ADD COLUMN IF NOT EXISTS status_history JSONB NOT NULL DEFAULT '[]';
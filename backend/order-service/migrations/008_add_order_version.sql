@@ -0,0 +1,5 @@
+-- Order Service Database Schema
+-- Database: order_service_db
+
+-- Optimistic concurrency: updates only apply to the version they were loaded at
+ALTER TABLE orders ADD COLUMN IF NOT EXISTS version INTEGER NOT NULL DEFAULT 1;
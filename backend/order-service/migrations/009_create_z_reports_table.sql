@@ -0,0 +1,24 @@
+-- Order Service Database Schema
+-- Database: order_service_db
+
+-- End-of-day Z-reports: one immutable sales snapshot per closed business day
+CREATE TABLE IF NOT EXISTS z_reports (
+    business_date DATE PRIMARY KEY,
+    report JSONB NOT NULL,
+    closed_by VARCHAR(255) NOT NULL,
+    closed_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+-- Reject changes to a Z-report once the day has been closed
+CREATE OR REPLACE FUNCTION prevent_z_report_changes()
+RETURNS TRIGGER AS $$
+BEGIN
+    RAISE EXCEPTION 'z_reports are immutable: business day % is closed', OLD.business_date;
+END;
+$$ language 'plpgsql';
+
+DROP TRIGGER IF EXISTS z_reports_immutable ON z_reports;
+CREATE TRIGGER z_reports_immutable
+    BEFORE UPDATE OR DELETE ON z_reports
+    FOR EACH ROW
+    EXECUTE FUNCTION prevent_z_report_changes();
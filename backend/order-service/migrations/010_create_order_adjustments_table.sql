@@ -0,0 +1,20 @@
+-- Order Service Database Schema
+-- Database: order_service_db
+
+-- Voids and refunds of orders with their reason code and manager approval
+CREATE TABLE IF NOT EXISTS order_adjustments (
+    id VARCHAR(255) PRIMARY KEY,
+    order_id VARCHAR(255) NOT NULL REFERENCES orders(id),
+    type VARCHAR(20) NOT NULL CHECK (type IN ('VOID', 'REFUND')),
+    reason VARCHAR(50) NOT NULL,
+    notes TEXT,
+    amount DECIMAL(10, 2) NOT NULL,
+    lines JSONB NOT NULL DEFAULT '[]',
+    requested_by VARCHAR(255) NOT NULL,
+    approved_by VARCHAR(255),
+    approval_method VARCHAR(20) CHECK (approval_method IN ('ROLE', 'PIN')),
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_order_adjustments_order_id ON order_adjustments(order_id);
+CREATE INDEX IF NOT EXISTS idx_order_adjustments_created_at ON order_adjustments(created_at);
@@ -0,0 +1,7 @@
+-- Order Service Database Schema
+-- Database: order_service_db
+
+-- Checks merged into another order are closed and point at the order that took their items
+ALTER TABLE orders ADD COLUMN IF NOT EXISTS merged_into VARCHAR(255);
+
+CREATE INDEX IF NOT EXISTS idx_orders_merged_into ON orders(merged_into) WHERE merged_into IS NOT NULL;
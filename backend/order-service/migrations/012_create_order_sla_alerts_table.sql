@@ -0,0 +1,20 @@
+-- Order Service Database Schema
+-- Database: order_service_db
+
+-- Orders that stayed in a status for longer than its SLA allows
+CREATE TABLE IF NOT EXISTS order_sla_alerts (
+    id VARCHAR(255) PRIMARY KEY,
+    order_id VARCHAR(255) NOT NULL REFERENCES orders(id),
+    order_type VARCHAR(20) NOT NULL,
+    table_id VARCHAR(255),
+    status VARCHAR(20) NOT NULL,
+    threshold_seconds INTEGER NOT NULL CHECK (threshold_seconds > 0),
+    entered_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    breached_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    detected_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    resolved_at TIMESTAMP WITH TIME ZONE
+);
+
+-- At most one open alert per order and status
+CREATE UNIQUE INDEX IF NOT EXISTS idx_order_sla_alerts_open ON order_sla_alerts(order_id, status) WHERE resolved_at IS NULL;
+CREATE INDEX IF NOT EXISTS idx_order_sla_alerts_order_id ON order_sla_alerts(order_id);
@@ -0,0 +1,26 @@
+-- Order Service Database Schema
+-- Database: order_service_db
+
+-- Marketplace item IDs mapped to menu items, per marketplace
+CREATE TABLE IF NOT EXISTS marketplace_item_mappings (
+    marketplace VARCHAR(100) NOT NULL,
+    external_item_id VARCHAR(255) NOT NULL,
+    menu_item_id VARCHAR(255) NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (marketplace, external_item_id)
+);
+
+-- Orders received from marketplaces, accepted or rejected, with the status last reported back
+CREATE TABLE IF NOT EXISTS marketplace_orders (
+    id VARCHAR(255) PRIMARY KEY,
+    marketplace VARCHAR(100) NOT NULL,
+    external_id VARCHAR(255) NOT NULL,
+    order_id VARCHAR(255) REFERENCES orders(id),
+    status VARCHAR(20) NOT NULL,
+    reason TEXT,
+    received_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    UNIQUE (marketplace, external_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_marketplace_orders_order_id ON marketplace_orders(order_id);
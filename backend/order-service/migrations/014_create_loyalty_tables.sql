@@ -0,0 +1,22 @@
+-- Order Service Database Schema
+-- Database: order_service_db
+
+-- Discount lines taken off the order subtotal, such as loyalty rewards
+ALTER TABLE orders ADD COLUMN IF NOT EXISTS discounts JSONB NOT NULL DEFAULT '[]';
+
+-- Loyalty points ledger; a customer's balance is the sum of their entries
+CREATE TABLE IF NOT EXISTS loyalty_ledger (
+    id VARCHAR(255) PRIMARY KEY,
+    customer_id VARCHAR(255) NOT NULL,
+    type VARCHAR(20) NOT NULL,
+    points INTEGER NOT NULL,
+    order_id VARCHAR(255) REFERENCES orders(id),
+    reference VARCHAR(255) NOT NULL UNIQUE,
+    amount DECIMAL(10,2) NOT NULL DEFAULT 0,
+    description TEXT NOT NULL,
+    expires_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_loyalty_ledger_customer_id ON loyalty_ledger(customer_id, created_at);
+CREATE INDEX IF NOT EXISTS idx_loyalty_ledger_expires_at ON loyalty_ledger(expires_at) WHERE expires_at IS NOT NULL;
@@ -0,0 +1,30 @@
+-- Order Service Database Schema
+-- Database: order_service_db
+
+-- Stored-value gift cards; only a SHA-256 hash of the card code is stored
+CREATE TABLE IF NOT EXISTS gift_cards (
+    id VARCHAR(255) PRIMARY KEY,
+    code_hash CHAR(64) NOT NULL UNIQUE,
+    masked_code VARCHAR(20) NOT NULL,
+    balance DECIMAL(10,2) NOT NULL CHECK (balance >= 0),
+    issued_by VARCHAR(255) NOT NULL,
+    version INTEGER NOT NULL DEFAULT 1,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+-- Gift card balance ledger; credits are positive and debits negative
+CREATE TABLE IF NOT EXISTS gift_card_transactions (
+    id VARCHAR(255) PRIMARY KEY,
+    gift_card_id VARCHAR(255) NOT NULL REFERENCES gift_cards(id),
+    type VARCHAR(20) NOT NULL,
+    amount DECIMAL(10,2) NOT NULL,
+    balance_after DECIMAL(10,2) NOT NULL,
+    order_id VARCHAR(255) REFERENCES orders(id),
+    redemption_id VARCHAR(255) REFERENCES gift_card_transactions(id),
+    created_by VARCHAR(255) NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_gift_card_transactions_card_id ON gift_card_transactions(gift_card_id, created_at);
+CREATE INDEX IF NOT EXISTS idx_gift_card_transactions_redemption_id ON gift_card_transactions(redemption_id) WHERE redemption_id IS NOT NULL;
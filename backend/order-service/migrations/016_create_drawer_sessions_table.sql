@@ -0,0 +1,26 @@
+-- Order Service Database Schema
+-- Database: order_service_db
+
+-- Cashier drawer sessions; paid-ins, paid-outs and drops are kept with the session
+CREATE TABLE IF NOT EXISTS drawer_sessions (
+    id VARCHAR(255) PRIMARY KEY,
+    cashier_id VARCHAR(255) NOT NULL,
+    status VARCHAR(20) NOT NULL,
+    starting_float DECIMAL(10,2) NOT NULL CHECK (starting_float >= 0),
+    movements JSONB NOT NULL DEFAULT '[]',
+    report JSONB,
+    opened_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    closed_at TIMESTAMP WITH TIME ZONE,
+    signed_off_by VARCHAR(255),
+    signed_off_at TIMESTAMP WITH TIME ZONE,
+    sign_off_notes TEXT,
+    version INTEGER NOT NULL DEFAULT 1,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_drawer_sessions_open_cashier ON drawer_sessions(cashier_id) WHERE status = 'OPEN';
+CREATE INDEX IF NOT EXISTS idx_drawer_sessions_status ON drawer_sessions(status, opened_at);
+
+-- Cash tenders and refunds carry the drawer session they went through
+CREATE INDEX IF NOT EXISTS idx_payments_tenders ON payments USING GIN (tenders jsonb_path_ops);
+CREATE INDEX IF NOT EXISTS idx_payments_refunds ON payments USING GIN (refunds jsonb_path_ops);
@@ -0,0 +1,19 @@
+-- Order Service Database Schema
+-- Database: order_service_db
+
+-- Dine-in checks record how many guests are seated and the reservation they came in on.
+-- Seat positions are kept on the order items in the items JSONB.
+ALTER TABLE orders ADD COLUMN IF NOT EXISTS guest_count INTEGER NOT NULL DEFAULT 0 CHECK (guest_count >= 0);
+ALTER TABLE orders ADD COLUMN IF NOT EXISTS reservation_id VARCHAR(255);
+
+-- Local read model of table reservations, kept current from reservation.* events
+CREATE TABLE IF NOT EXISTS reservations (
+    id VARCHAR(255) PRIMARY KEY,
+    table_id VARCHAR(255) NOT NULL,
+    party_size INTEGER NOT NULL CHECK (party_size > 0),
+    date_time TIMESTAMP WITH TIME ZONE NOT NULL,
+    status VARCHAR(20) NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_reservations_table_date_time ON reservations(table_id, date_time);
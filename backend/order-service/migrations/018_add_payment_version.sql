@@ -0,0 +1,5 @@
+-- Order Service Database Schema
+-- Database: order_service_db
+
+-- Optimistic concurrency: payment updates only apply to the version they were loaded at
+ALTER TABLE payments ADD COLUMN IF NOT EXISTS version INTEGER NOT NULL DEFAULT 1;
@@ -0,0 +1,6 @@
+-- Order Service Database Schema
+-- Database: order_service_db
+
+-- Optimistic concurrency: driver and delivery updates only apply to the version they were loaded at
+ALTER TABLE drivers ADD COLUMN IF NOT EXISTS version INTEGER NOT NULL DEFAULT 1;
+ALTER TABLE deliveries ADD COLUMN IF NOT EXISTS version INTEGER NOT NULL DEFAULT 1;
@@ -9,6 +9,7 @@ import (
 	"github.com/restaurant-platform/reservation-service/internal/interfaces"
 	"github.com/restaurant-platform/shared/pkg/config"
 	"github.com/restaurant-platform/shared/events"
+	"github.com/restaurant-platform/shared/pkg/idempotency"
 	"net/http"
 	"os"
 	"os/signal"
@@ -38,6 +39,13 @@ func main() {
 	}
 	defer eventPublisher.Close()
 
+	// Setup idempotency store for retried write requests
+	idempotencyStore, err := idempotency.NewRedisStore(redisAddr, cfg.Redis.Password, cfg.Redis.DB, "reservation-service")
+	if err != nil {
+		log.Fatalf("Failed to create idempotency store: %v", err)
+	}
+	defer idempotencyStore.Close()
+
 	// Initialize repositories
 	reservationRepo := infrastructure.NewReservationRepository(db)
 
@@ -77,7 +85,7 @@ func main() {
 	}()
 
 	// Setup router
-	router := interfaces.SetupRouter(reservationService)
+	router := interfaces.SetupRouter(reservationService, idempotencyStore)
 
 	// Create HTTP server
 	srv := &http.Server{
@@ -13,6 +13,7 @@ type ReservationResponse struct {
 	PartySize  int    `json:"party_size"`
 	Status     string `json:"status"`
 	Notes      string `json:"notes,omitempty"`
+	Version    int    `json:"version"`
 	CreatedAt  string `json:"created_at"`
 	UpdatedAt  string `json:"updated_at"`
 }
@@ -52,6 +53,7 @@ func ReservationToResponse(r *reservation.Reservation) *ReservationResponse {
 		PartySize:  r.PartySize,
 		Status:     string(r.Status),
 		Notes:      r.Notes,
+		Version:    r.Version,
 		CreatedAt:  r.CreatedAt.Format(time.RFC3339),
 		UpdatedAt:  r.UpdatedAt.Format(time.RFC3339),
 	}
@@ -6,6 +6,7 @@ import (
 	"time"
 	reservation "github.com/restaurant-platform/reservation-service/internal/domain"
 	"github.com/restaurant-platform/shared/events"
+	"github.com/restaurant-platform/shared/pkg/concurrency"
 )
 
 // ReservationService provides business logic for reservation operations
@@ -81,18 +82,11 @@ func (s *ReservationService) GetReservationsByTableAndDateRange(ctx context.Cont
 
 // ConfirmReservation confirms a reservation
 func (s *ReservationService) ConfirmReservation(ctx context.Context, id reservation.ReservationID) error {
-	res, err := s.reservationRepo.GetByID(ctx, id)
-	if err != nil {
-		return err
-	}
-
-	oldStatus := string(res.Status)
-	err = res.Confirm()
-	if err != nil {
-		return err
-	}
-
-	err = s.reservationRepo.Update(ctx, res)
+	var oldStatus string
+	res, err := s.modifyReservation(ctx, id, func(res *reservation.Reservation) error {
+		oldStatus = string(res.Status)
+		return res.Confirm()
+	})
 	if err != nil {
 		return err
 	}
@@ -125,104 +119,59 @@ func (s *ReservationService) ConfirmReservation(ctx context.Context, id reservat
 
 // CancelReservation cancels a reservation
 func (s *ReservationService) CancelReservation(ctx context.Context, id reservation.ReservationID) error {
-	res, err := s.reservationRepo.GetByID(ctx, id)
-	if err != nil {
-		return err
-	}
-
-	err = res.Cancel()
-	if err != nil {
-		return err
-	}
-
-	return s.reservationRepo.Update(ctx, res)
+	_, err := s.modifyReservation(ctx, id, func(res *reservation.Reservation) error {
+		return res.Cancel()
+	})
+	return err
 }
 
 // CompleteReservation marks a reservation as completed
 func (s *ReservationService) CompleteReservation(ctx context.Context, id reservation.ReservationID) error {
-	res, err := s.reservationRepo.GetByID(ctx, id)
-	if err != nil {
-		return err
-	}
-
-	err = res.Complete()
-	if err != nil {
-		return err
-	}
-
-	return s.reservationRepo.Update(ctx, res)
+	_, err := s.modifyReservation(ctx, id, func(res *reservation.Reservation) error {
+		return res.Complete()
+	})
+	return err
 }
 
 // MarkNoShow marks a reservation as no show
 func (s *ReservationService) MarkNoShow(ctx context.Context, id reservation.ReservationID) error {
-	res, err := s.reservationRepo.GetByID(ctx, id)
-	if err != nil {
-		return err
-	}
-
-	err = res.MarkNoShow()
-	if err != nil {
-		return err
-	}
-
-	return s.reservationRepo.Update(ctx, res)
+	_, err := s.modifyReservation(ctx, id, func(res *reservation.Reservation) error {
+		return res.MarkNoShow()
+	})
+	return err
 }
 
 // UpdateReservationPartySize updates the party size of a reservation
 func (s *ReservationService) UpdateReservationPartySize(ctx context.Context, id reservation.ReservationID, partySize int) error {
-	res, err := s.reservationRepo.GetByID(ctx, id)
-	if err != nil {
-		return err
-	}
-
-	err = res.UpdatePartySize(partySize)
-	if err != nil {
-		return err
-	}
-
-	return s.reservationRepo.Update(ctx, res)
+	_, err := s.modifyReservation(ctx, id, func(res *reservation.Reservation) error {
+		return res.UpdatePartySize(partySize)
+	})
+	return err
 }
 
 // UpdateReservationTable updates the table assignment of a reservation
 func (s *ReservationService) UpdateReservationTable(ctx context.Context, id reservation.ReservationID, tableID string) error {
-	res, err := s.reservationRepo.GetByID(ctx, id)
-	if err != nil {
-		return err
-	}
-
-	err = res.UpdateTable(tableID)
-	if err != nil {
-		return err
-	}
-
-	return s.reservationRepo.Update(ctx, res)
+	_, err := s.modifyReservation(ctx, id, func(res *reservation.Reservation) error {
+		return res.UpdateTable(tableID)
+	})
+	return err
 }
 
 // UpdateReservationDateTime updates the date and time of a reservation
 func (s *ReservationService) UpdateReservationDateTime(ctx context.Context, id reservation.ReservationID, dateTime time.Time) error {
-	res, err := s.reservationRepo.GetByID(ctx, id)
-	if err != nil {
-		return err
-	}
-
-	err = res.UpdateDateTime(dateTime)
-	if err != nil {
-		return err
-	}
-
-	return s.reservationRepo.Update(ctx, res)
+	_, err := s.modifyReservation(ctx, id, func(res *reservation.Reservation) error {
+		return res.UpdateDateTime(dateTime)
+	})
+	return err
 }
 
 // AddReservationNotes adds notes to a reservation
 func (s *ReservationService) AddReservationNotes(ctx context.Context, id reservation.ReservationID, notes string) error {
-	res, err := s.reservationRepo.GetByID(ctx, id)
-	if err != nil {
-		return err
-	}
-
-	res.AddNotes(notes)
-
-	return s.reservationRepo.Update(ctx, res)
+	_, err := s.modifyReservation(ctx, id, func(res *reservation.Reservation) error {
+		res.AddNotes(notes)
+		return nil
+	})
+	return err
 }
 
 // FindAvailableTables finds available tables for a given date, duration, and party size
@@ -233,4 +182,31 @@ func (s *ReservationService) FindAvailableTables(ctx context.Context, dateTime t
 // ListReservations retrieves reservations with pagination
 func (s *ReservationService) ListReservations(ctx context.Context, offset, limit int) ([]*reservation.Reservation, int, error) {
 	return s.reservationRepo.List(ctx, offset, limit)
-}
\ No newline at end of file
+}
+
+// modifyReservation loads a reservation, applies change and saves it, starting over
+// from a fresh copy when another writer saved the reservation in between
+func (s *ReservationService) modifyReservation(ctx context.Context, id reservation.ReservationID, change func(res *reservation.Reservation) error) (*reservation.Reservation, error) {
+	var res *reservation.Reservation
+	err := concurrency.RetryOnConflict(ctx, func() error {
+		var err error
+		res, err = s.reservationRepo.GetByID(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		if err := concurrency.CheckVersion(ctx, "modifyReservation", "reservation", id.String(), res.Version); err != nil {
+			return err
+		}
+
+		if err := change(res); err != nil {
+			return err
+		}
+
+		return s.reservationRepo.Update(ctx, res)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
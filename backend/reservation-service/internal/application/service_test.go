@@ -12,6 +12,7 @@ import (
 
 	reservation "github.com/restaurant-platform/reservation-service/internal/domain"
 	"github.com/restaurant-platform/shared/events"
+	sharedErrors "github.com/restaurant-platform/shared/pkg/errors"
 )
 
 // MockReservationRepository is a mock implementation of ReservationRepository
@@ -352,6 +353,41 @@ func (suite *ReservationServiceTestSuite) TestConfirmReservation_Success() {
 	assert.Equal(reservation.StatusConfirmed, existingReservation.Status)
 }
 
+func (suite *ReservationServiceTestSuite) TestConfirmReservation_VersionConflict_RetriesOnFreshCopy() {
+	// Given
+	reservationID := reservation.ReservationID("res_123")
+	staleReservation := &reservation.Reservation{
+		ID:        reservationID,
+		Status:    reservation.StatusPending,
+		DateTime:  time.Now().Add(2 * time.Hour),
+		PartySize: 4,
+		Version:   1,
+	}
+	freshReservation := &reservation.Reservation{
+		ID:        reservationID,
+		Status:    reservation.StatusPending,
+		DateTime:  time.Now().Add(2 * time.Hour),
+		PartySize: 6,
+		Version:   2,
+	}
+	conflict := sharedErrors.WrapVersionConflict("ReservationRepository.Update", "reservation", reservationID.String(), 1)
+
+	suite.mockRepo.On("GetByID", suite.ctx, reservationID).Return(staleReservation, nil).Once()
+	suite.mockRepo.On("Update", suite.ctx, staleReservation).Return(conflict).Once()
+	suite.mockRepo.On("GetByID", suite.ctx, reservationID).Return(freshReservation, nil).Once()
+	suite.mockRepo.On("Update", suite.ctx, freshReservation).Return(nil).Once()
+	suite.mockEvents.On("Publish", suite.ctx, mock.AnythingOfType("*events.DomainEvent")).Return(nil)
+
+	// When
+	err := suite.service.ConfirmReservation(suite.ctx, reservationID)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.NoError(err)
+	assert.Equal(reservation.StatusConfirmed, freshReservation.Status)
+	suite.mockRepo.AssertExpectations(suite.T())
+}
+
 func (suite *ReservationServiceTestSuite) TestConfirmReservation_ReservationNotFound_ShouldFail() {
 	// Given
 	reservationID := reservation.ReservationID("res_nonexistent")
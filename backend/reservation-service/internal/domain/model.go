@@ -39,6 +39,7 @@ type Reservation struct {
 	PartySize  int               `json:"party_size"`
 	Status     ReservationStatus `json:"status"`
 	Notes      string            `json:"notes,omitempty"`
+	Version    int               `json:"version"`
 	CreatedAt  time.Time         `json:"created_at"`
 	UpdatedAt  time.Time         `json:"updated_at"`
 }
@@ -67,6 +68,7 @@ func NewReservation(customerID, tableID string, dateTime time.Time, partySize in
 		DateTime:   dateTime,
 		PartySize:  partySize,
 		Status:     StatusPending,
+		Version:    1,
 		CreatedAt:  now,
 		UpdatedAt:  now,
 	}, nil
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"time"
 	reservation "github.com/restaurant-platform/reservation-service/internal/domain"
+	"github.com/restaurant-platform/shared/pkg/errors"
 )
 
 type ReservationRepository struct {
@@ -18,19 +19,19 @@ func NewReservationRepository(db *DB) *ReservationRepository {
 
 func (r *ReservationRepository) Create(ctx context.Context, res *reservation.Reservation) error {
 	query := `
-		INSERT INTO reservations (id, customer_id, table_id, date_time, party_size, status, notes, created_at, updated_at)
-		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`
+		INSERT INTO reservations (id, customer_id, table_id, date_time, party_size, status, notes, version, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
 
 	_, err := r.db.ExecContext(ctx, query,
 		res.ID.String(), res.CustomerID, res.TableID, res.DateTime, res.PartySize,
-		string(res.Status), res.Notes, res.CreatedAt, res.UpdatedAt)
+		string(res.Status), res.Notes, res.Version, res.CreatedAt, res.UpdatedAt)
 
 	return err
 }
 
 func (r *ReservationRepository) GetByID(ctx context.Context, id reservation.ReservationID) (*reservation.Reservation, error) {
 	query := `
-		SELECT id, customer_id, table_id, date_time, party_size, status, notes, created_at, updated_at
+		SELECT id, customer_id, table_id, date_time, party_size, status, notes, version, created_at, updated_at
 		FROM reservations WHERE id = ?`
 
 	var res reservation.Reservation
@@ -38,7 +39,7 @@ func (r *ReservationRepository) GetByID(ctx context.Context, id reservation.Rese
 
 	err := r.db.QueryRowContext(ctx, query, id.String()).Scan(
 		&idStr, &res.CustomerID, &res.TableID, &res.DateTime, &res.PartySize,
-		&status, &res.Notes, &res.CreatedAt, &res.UpdatedAt)
+		&status, &res.Notes, &res.Version, &res.CreatedAt, &res.UpdatedAt)
 
 	if err != nil {
 		if err == sql.ErrNoRows {
@@ -57,14 +58,37 @@ func (r *ReservationRepository) Update(ctx context.Context, res *reservation.Res
 	query := `
 		UPDATE reservations 
 		SET customer_id = ?, table_id = ?, date_time = ?, party_size = ?, 
-		    status = ?, notes = ?, updated_at = ?
-		WHERE id = ?`
+		    status = ?, notes = ?, updated_at = ?, version = version + 1
+		WHERE id = ? AND version = ?`
 
-	_, err := r.db.ExecContext(ctx, query,
+	result, err := r.db.ExecContext(ctx, query,
 		res.CustomerID, res.TableID, res.DateTime, res.PartySize,
-		string(res.Status), res.Notes, res.UpdatedAt, res.ID.String())
+		string(res.Status), res.Notes, res.UpdatedAt, res.ID.String(), res.Version)
+	if err != nil {
+		return err
+	}
 
-	return err
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		// A missing reservation is not an error here; a row that exists at
+		// another version means a concurrent writer got there first
+		var exists bool
+		err := r.db.QueryRowContext(ctx, `SELECT EXISTS(SELECT 1 FROM reservations WHERE id = ?)`, res.ID.String()).Scan(&exists)
+		if err != nil {
+			return err
+		}
+		if exists {
+			return errors.WrapVersionConflict("ReservationRepository.Update", "reservation", res.ID.String(), res.Version)
+		}
+		return nil
+	}
+
+	res.Version++
+	return nil
 }
 
 func (r *ReservationRepository) Delete(ctx context.Context, id reservation.ReservationID) error {
@@ -82,7 +106,7 @@ func (r *ReservationRepository) List(ctx context.Context, offset, limit int) ([]
 	}
 
 	query := `
-		SELECT id, customer_id, table_id, date_time, party_size, status, notes, created_at, updated_at
+		SELECT id, customer_id, table_id, date_time, party_size, status, notes, version, created_at, updated_at
 		FROM reservations ORDER BY created_at DESC LIMIT ? OFFSET ?`
 
 	rows, err := r.db.QueryContext(ctx, query, limit, offset)
@@ -97,7 +121,7 @@ func (r *ReservationRepository) List(ctx context.Context, offset, limit int) ([]
 		var idStr, status string
 
 		err := rows.Scan(&idStr, &res.CustomerID, &res.TableID, &res.DateTime, &res.PartySize,
-			&status, &res.Notes, &res.CreatedAt, &res.UpdatedAt)
+			&status, &res.Notes, &res.Version, &res.CreatedAt, &res.UpdatedAt)
 		if err != nil {
 			return nil, 0, err
 		}
@@ -112,7 +136,7 @@ func (r *ReservationRepository) List(ctx context.Context, offset, limit int) ([]
 
 func (r *ReservationRepository) FindByCustomer(ctx context.Context, customerID string) ([]*reservation.Reservation, error) {
 	query := `
-		SELECT id, customer_id, table_id, date_time, party_size, status, notes, created_at, updated_at
+		SELECT id, customer_id, table_id, date_time, party_size, status, notes, version, created_at, updated_at
 		FROM reservations WHERE customer_id = ? ORDER BY date_time DESC`
 
 	rows, err := r.db.QueryContext(ctx, query, customerID)
@@ -127,7 +151,7 @@ func (r *ReservationRepository) FindByCustomer(ctx context.Context, customerID s
 		var idStr, status string
 
 		err := rows.Scan(&idStr, &res.CustomerID, &res.TableID, &res.DateTime, &res.PartySize,
-			&status, &res.Notes, &res.CreatedAt, &res.UpdatedAt)
+			&status, &res.Notes, &res.Version, &res.CreatedAt, &res.UpdatedAt)
 		if err != nil {
 			return nil, err
 		}
@@ -142,7 +166,7 @@ func (r *ReservationRepository) FindByCustomer(ctx context.Context, customerID s
 
 func (r *ReservationRepository) FindByDateRange(ctx context.Context, start, end time.Time) ([]*reservation.Reservation, error) {
 	query := `
-		SELECT id, customer_id, table_id, date_time, party_size, status, notes, created_at, updated_at
+		SELECT id, customer_id, table_id, date_time, party_size, status, notes, version, created_at, updated_at
 		FROM reservations WHERE date_time BETWEEN ? AND ? ORDER BY date_time ASC`
 
 	rows, err := r.db.QueryContext(ctx, query, start, end)
@@ -157,7 +181,7 @@ func (r *ReservationRepository) FindByDateRange(ctx context.Context, start, end
 		var idStr, status string
 
 		err := rows.Scan(&idStr, &res.CustomerID, &res.TableID, &res.DateTime, &res.PartySize,
-			&status, &res.Notes, &res.CreatedAt, &res.UpdatedAt)
+			&status, &res.Notes, &res.Version, &res.CreatedAt, &res.UpdatedAt)
 		if err != nil {
 			return nil, err
 		}
@@ -172,7 +196,7 @@ func (r *ReservationRepository) FindByDateRange(ctx context.Context, start, end
 
 func (r *ReservationRepository) FindByTableAndDateRange(ctx context.Context, tableID string, start, end time.Time) ([]*reservation.Reservation, error) {
 	query := `
-		SELECT id, customer_id, table_id, date_time, party_size, status, notes, created_at, updated_at
+		SELECT id, customer_id, table_id, date_time, party_size, status, notes, version, created_at, updated_at
 		FROM reservations WHERE table_id = ? AND date_time BETWEEN ? AND ? ORDER BY date_time ASC`
 
 	rows, err := r.db.QueryContext(ctx, query, tableID, start, end)
@@ -187,7 +211,7 @@ func (r *ReservationRepository) FindByTableAndDateRange(ctx context.Context, tab
 		var idStr, status string
 
 		err := rows.Scan(&idStr, &res.CustomerID, &res.TableID, &res.DateTime, &res.PartySize,
-			&status, &res.Notes, &res.CreatedAt, &res.UpdatedAt)
+			&status, &res.Notes, &res.Version, &res.CreatedAt, &res.UpdatedAt)
 		if err != nil {
 			return nil, err
 		}
@@ -12,6 +12,7 @@ import (
 	_ "github.com/mattn/go-sqlite3"
 
 	reservation "github.com/restaurant-platform/reservation-service/internal/domain"
+	sharedErrors "github.com/restaurant-platform/shared/pkg/errors"
 )
 
 // ReservationRepositoryTestSuite contains all repository tests
@@ -58,6 +59,7 @@ func (suite *ReservationRepositoryTestSuite) createSchema() {
 		party_size INTEGER NOT NULL,
 		status TEXT NOT NULL,
 		notes TEXT DEFAULT '',
+		version INTEGER NOT NULL DEFAULT 1,
 		created_at DATETIME NOT NULL,
 		updated_at DATETIME NOT NULL
 	);
@@ -194,6 +196,30 @@ func (suite *ReservationRepositoryTestSuite) TestUpdate_NonExistent_ShouldSuccee
 	assert.NoError(suite.T(), err)
 }
 
+func (suite *ReservationRepositoryTestSuite) TestUpdate_StaleVersion_ShouldConflict() {
+	// Given
+	res := suite.createTestReservation("customer-123", "table-5", time.Now().Add(2*time.Hour), 4)
+	assert.NoError(suite.T(), suite.repo.Create(suite.ctx, res))
+
+	first, _ := suite.repo.GetByID(suite.ctx, res.ID)
+	second, _ := suite.repo.GetByID(suite.ctx, res.ID)
+	first.PartySize = 6
+	assert.NoError(suite.T(), suite.repo.Update(suite.ctx, first))
+
+	// When
+	second.TableID = "table-9"
+	err := suite.repo.Update(suite.ctx, second)
+
+	// Then
+	assert := assert.New(suite.T())
+	assert.True(sharedErrors.IsVersionConflict(err))
+
+	stored, _ := suite.repo.GetByID(suite.ctx, res.ID)
+	assert.Equal(6, stored.PartySize)
+	assert.Equal("table-5", stored.TableID)
+	assert.Equal(first.Version, stored.Version)
+}
+
 // Test Delete
 func (suite *ReservationRepositoryTestSuite) TestDelete_Success() {
 	// Given
@@ -6,6 +6,8 @@ import (
 	"time"
 	"github.com/restaurant-platform/reservation-service/internal/application"
 	reservation "github.com/restaurant-platform/reservation-service/internal/domain"
+	"github.com/restaurant-platform/shared/pkg/concurrency"
+	"github.com/restaurant-platform/shared/pkg/errors"
 
 	"github.com/gin-gonic/gin"
 )
@@ -39,6 +41,7 @@ func (h *ReservationHandler) CreateReservation(c *gin.Context) {
 		return
 	}
 
+	concurrency.SetETag(c, res.Version)
 	c.JSON(http.StatusCreated, application.ReservationToResponse(res))
 }
 
@@ -51,6 +54,7 @@ func (h *ReservationHandler) GetReservation(c *gin.Context) {
 		return
 	}
 
+	concurrency.SetETag(c, res.Version)
 	c.JSON(http.StatusOK, application.ReservationToResponse(res))
 }
 
@@ -96,7 +100,7 @@ func (h *ReservationHandler) ConfirmReservation(c *gin.Context) {
 	
 	err := h.reservationService.ConfirmReservation(c.Request.Context(), id)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		handleWriteError(c, err)
 		return
 	}
 
@@ -108,7 +112,7 @@ func (h *ReservationHandler) CancelReservation(c *gin.Context) {
 	
 	err := h.reservationService.CancelReservation(c.Request.Context(), id)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		handleWriteError(c, err)
 		return
 	}
 
@@ -162,4 +166,17 @@ func (h *ReservationHandler) FindAvailableTables(c *gin.Context) {
 	}
 
 	c.JSON(http.StatusOK, gin.H{"available_tables": tables})
-}
\ No newline at end of file
+}
+
+// handleWriteError reports a failed reservation change, telling clients whether to
+// reload the reservation before trying again
+func handleWriteError(c *gin.Context, err error) {
+	switch {
+	case errors.IsPreconditionFailed(err):
+		c.JSON(http.StatusPreconditionFailed, gin.H{"error": err.Error()})
+	case errors.IsVersionConflict(err):
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	}
+}
@@ -7,9 +7,12 @@ import (
 
 	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
+
+	"github.com/restaurant-platform/shared/pkg/concurrency"
+	"github.com/restaurant-platform/shared/pkg/idempotency"
 )
 
-func SetupRouter(reservationService *application.ReservationService) *gin.Engine {
+func SetupRouter(reservationService *application.ReservationService, idempotencyStore idempotency.Store) *gin.Engine {
 	router := gin.Default()
 
 	// CORS middleware
@@ -17,7 +20,7 @@ func SetupRouter(reservationService *application.ReservationService) *gin.Engine
 		AllowOrigins:     []string{"*"},
 		AllowMethods:     []string{"GET", "POST", "PUT", "PATCH", "DELETE", "OPTIONS"},
 		AllowHeaders:     []string{"*"},
-		ExposeHeaders:    []string{"Content-Length"},
+		ExposeHeaders:    []string{"Content-Length", "ETag", idempotency.HeaderReplayed},
 		AllowCredentials: true,
 		MaxAge:           12 * time.Hour,
 	}))
@@ -30,11 +33,13 @@ func SetupRouter(reservationService *application.ReservationService) *gin.Engine
 	// Initialize handlers
 	reservationHandler := NewReservationHandler(reservationService)
 
-	// API routes
+	// API routes; writes carrying an Idempotency-Key are replayed instead of being applied twice
 	v1 := router.Group("/api/v1")
+	v1.Use(idempotency.Middleware(idempotencyStore, idempotency.DefaultTTL))
 	{
 		// Reservation routes
 		reservations := v1.Group("/reservations")
+		reservations.Use(concurrency.IfMatchMiddleware())
 		{
 			reservations.POST("", reservationHandler.CreateReservation)
 			reservations.GET("", reservationHandler.ListReservations)
@@ -0,0 +1,5 @@
+-- Reservation Service Database Schema
+-- Database: reservation_service_db
+
+-- Optimistic concurrency: updates only apply to the version they were loaded at
+ALTER TABLE reservations ADD COLUMN IF NOT EXISTS version INTEGER NOT NULL DEFAULT 1;
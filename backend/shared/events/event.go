@@ -43,11 +43,15 @@ const (
 
 	// Kitchen Events
 	KitchenOrderCreatedEvent        EventType = "kitchen.order.created"
+	KitchenOrderUpdatedEvent        EventType = "kitchen.order.updated"
 	KitchenOrderStatusChangedEvent  EventType = "kitchen.order.status.changed"
 	KitchenOrderAssignedEvent       EventType = "kitchen.order.assigned"
 	KitchenOrderPriorityChangedEvent EventType = "kitchen.order.priority.changed"
 	KitchenOrderCompletedEvent      EventType = "kitchen.order.completed"
+	KitchenOrderBumpedEvent         EventType = "kitchen.order.bumped"
+	KitchenOrderRecalledEvent       EventType = "kitchen.order.recalled"
 	KitchenOrderCancelledEvent      EventType = "kitchen.order.cancelled"
+	KitchenOrderSLABreachedEvent    EventType = "kitchen.order.sla.breached"
 	KitchenItemStatusChangedEvent   EventType = "kitchen.item.status.changed"
 
 	// Order Events
@@ -57,6 +61,28 @@ const (
 	OrderStatusChangedEvent     EventType = "order.status.changed"
 	OrderCancelledEvent         EventType = "order.cancelled"
 	OrderCompletedEvent         EventType = "order.completed"
+	OrderCourseFiredEvent       EventType = "order.course.fired"
+	OrderItemAddedEvent         EventType = "order.item.added"
+	OrderItemRemovedEvent       EventType = "order.item.removed"
+	OrderItemUpdatedEvent       EventType = "order.item.updated"
+	OrderItemVoidedEvent        EventType = "order.item.voided"
+	OrderItemSeatChangedEvent   EventType = "order.item.seat.changed"
+	OrderReleasedEvent          EventType = "order.released"
+	OrderVoidedEvent            EventType = "order.voided"
+	OrderRefundedEvent          EventType = "order.refunded"
+	OrderTableChangedEvent      EventType = "order.table.changed"
+	OrderItemsMovedEvent        EventType = "order.items.moved"
+	OrderMergedEvent            EventType = "order.merged"
+	OrderSLABreachedEvent       EventType = "order.sla.breached"
+
+	// Payment Events
+	PaymentRefundedEvent EventType = "payment.refunded"
+	PaymentVoidedEvent   EventType = "payment.voided"
+
+	// Delivery Events
+	DeliveryAssignedEvent  EventType = "delivery.assigned"
+	DeliveryPickedUpEvent  EventType = "delivery.picked_up"
+	DeliveryDeliveredEvent EventType = "delivery.delivered"
 )
 
 // DomainEvent represents a domain event in the system
@@ -3,6 +3,7 @@ package events
 import (
 	"encoding/json"
 	"fmt"
+	"time"
 )
 
 // Package-level exports for convenience
@@ -25,13 +26,53 @@ type MenuCreatedData struct {
 	IsActive bool   `json:"is_active"`
 }
 
-// MenuActivatedData represents data for menu activated event
+// MenuActivatedData represents data for menu activated event.
+// Items carries a snapshot of every item so consumers can rebuild their read models.
 type MenuActivatedData struct {
+	MenuID  string         `json:"menu_id"`
+	Name    string         `json:"name"`
+	Version int            `json:"version"`
+	Items   []MenuItemData `json:"items"`
+}
+
+// MenuDeactivatedData represents data for menu deactivated event
+type MenuDeactivatedData struct {
 	MenuID  string `json:"menu_id"`
 	Name    string `json:"name"`
 	Version int    `json:"version"`
 }
 
+// MenuItemData represents data for menu item added, updated and removed events
+type MenuItemData struct {
+	MenuID          string              `json:"menu_id"`
+	ItemID          string              `json:"item_id"`
+	Name            string              `json:"name"`
+	Price           float64             `json:"price"`
+	CategoryID      string              `json:"category_id"`
+	CategoryName    string              `json:"category_name"`
+	IsAvailable     bool                `json:"is_available"`
+	PrepTimeSeconds int64               `json:"prep_time_seconds"`
+	MenuActive      bool                `json:"menu_active"`
+	ModifierGroups  []ModifierGroupData `json:"modifier_groups,omitempty"`
+}
+
+// ModifierGroupData represents a menu item's modifier group and its selection rules
+type ModifierGroupData struct {
+	GroupID       string               `json:"group_id"`
+	Name          string               `json:"name"`
+	MinSelections int                  `json:"min_selections"`
+	MaxSelections int                  `json:"max_selections"`
+	Options       []ModifierOptionData `json:"options"`
+}
+
+// ModifierOptionData represents a selectable option within a modifier group
+type ModifierOptionData struct {
+	OptionID   string  `json:"option_id"`
+	Name       string  `json:"name"`
+	PriceDelta float64 `json:"price_delta"`
+	IsDefault  bool    `json:"is_default"`
+}
+
 // ItemAvailabilityChangedData represents data for item availability changed event
 type ItemAvailabilityChangedData struct {
 	MenuID      string `json:"menu_id"`
@@ -125,6 +166,20 @@ type KitchenOrderCreatedData struct {
 	Status         string `json:"status"`
 	Priority       string `json:"priority"`
 	EstimatedTime  int64  `json:"estimated_time"`
+	Stations       []string `json:"stations,omitempty"`
+}
+
+// KitchenOrderUpdatedData represents data for kitchen order updated events, raised when
+// a ticket's items change after it arrived
+type KitchenOrderUpdatedData struct {
+	KitchenOrderID string   `json:"kitchen_order_id"`
+	OrderID        string   `json:"order_id"`
+	TableID        string   `json:"table_id"`
+	Status         string   `json:"status"`
+	Priority       string   `json:"priority"`
+	EstimatedTime  int64    `json:"estimated_time"`
+	Stations       []string `json:"stations,omitempty"`
+	Change         string   `json:"change"`
 }
 
 // KitchenOrderStatusChangedData represents data for kitchen order status change events
@@ -134,6 +189,34 @@ type KitchenOrderStatusChangedData struct {
 	OldStatus      string `json:"old_status"`
 	NewStatus      string `json:"new_status"`
 	UpdatedBy      string `json:"updated_by"`
+	Stations       []string `json:"stations,omitempty"`
+}
+
+// KitchenOrderBumpedData represents data for kitchen order bumped and recalled events.
+// Action is the bump recorded on the ticket; Station is empty for expo bumps and recalls.
+type KitchenOrderBumpedData struct {
+	KitchenOrderID string    `json:"kitchen_order_id"`
+	OrderID        string    `json:"order_id"`
+	Action         string    `json:"action"`
+	Station        string    `json:"station,omitempty"`
+	ItemIDs        []string  `json:"item_ids,omitempty"`
+	UserID         string    `json:"user_id"`
+	OldStatus      string    `json:"old_status"`
+	NewStatus      string    `json:"new_status"`
+	Stations       []string  `json:"stations,omitempty"`
+	BumpedAt       time.Time `json:"bumped_at"`
+}
+
+// KitchenOrderSLABreachedData represents a kitchen order that stayed in a status for longer than its SLA allows
+type KitchenOrderSLABreachedData struct {
+	KitchenOrderID   string    `json:"kitchen_order_id"`
+	OrderID          string    `json:"order_id"`
+	TableID          string    `json:"table_id,omitempty"`
+	Status           string    `json:"status"`
+	Priority         string    `json:"priority"`
+	ThresholdSeconds int64     `json:"threshold_seconds"`
+	ElapsedSeconds   int64     `json:"elapsed_seconds"`
+	BreachedAt       time.Time `json:"breached_at"`
 }
 
 // KitchenItemStatusChangedData represents data for kitchen item status change events
@@ -145,18 +228,48 @@ type KitchenItemStatusChangedData struct {
 	OldStatus      string `json:"old_status"`
 	NewStatus      string `json:"new_status"`
 	UpdatedBy      string `json:"updated_by"`
+	Wasted         bool   `json:"wasted,omitempty"`
+	Station        string `json:"station,omitempty"`
 }
 
 // Order Event Data Structures
 
 // OrderCreatedData represents data for order created event
 type OrderCreatedData struct {
-	OrderID     string  `json:"order_id"`
-	CustomerID  string  `json:"customer_id"`
-	TableID     string  `json:"table_id"`
-	OrderType   string  `json:"order_type"`
-	TotalAmount float64 `json:"total_amount"`
-	Status      string  `json:"status"`
+	OrderID         string          `json:"order_id"`
+	CustomerID      string          `json:"customer_id"`
+	TableID         string          `json:"table_id"`
+	OrderType       string          `json:"order_type"`
+	TotalAmount     float64         `json:"total_amount"`
+	Status          string          `json:"status"`
+	FulfillmentTime *time.Time      `json:"fulfillment_time,omitempty"`
+	Items           []OrderLineData `json:"items,omitempty"`
+}
+
+// OrderReleasedData represents data for a scheduled order being released to the kitchen
+type OrderReleasedData struct {
+	OrderID         string          `json:"order_id"`
+	CustomerID      string          `json:"customer_id"`
+	TableID         string          `json:"table_id"`
+	OrderType       string          `json:"order_type"`
+	Status          string          `json:"status"`
+	FulfillmentTime time.Time       `json:"fulfillment_time"`
+	ReleasedAt      time.Time       `json:"released_at"`
+	Items           []OrderLineData `json:"items,omitempty"`
+}
+
+// OrderLineData represents an order line carried in a snapshot of the order's items
+type OrderLineData struct {
+	ItemID        string                  `json:"item_id"`
+	MenuItemID    string                  `json:"menu_item_id"`
+	Name          string                  `json:"name"`
+	Quantity      int                     `json:"quantity"`
+	Course        int                     `json:"course"`
+	Fired         bool                    `json:"fired,omitempty"`
+	Seat          int                     `json:"seat,omitempty"`
+	Modifiers     []OrderItemModifierData `json:"modifiers,omitempty"`
+	Modifications []string                `json:"modifications,omitempty"`
+	Notes         string                  `json:"notes,omitempty"`
 }
 
 // OrderStatusChangedData represents data for order status change events
@@ -165,17 +278,188 @@ type OrderStatusChangedData struct {
 	OldStatus string `json:"old_status"`
 	NewStatus string `json:"new_status"`
 	UpdatedBy string `json:"updated_by"`
+	Reason    string `json:"reason,omitempty"`
+}
+
+// OrderCourseFiredData represents data for a held course being fired to the kitchen
+type OrderCourseFiredData struct {
+	OrderID     string   `json:"order_id"`
+	TableID     string   `json:"table_id"`
+	Course      int      `json:"course"`
+	MenuItemIDs []string `json:"menu_item_ids"`
+}
+
+// OrderItemModifierData represents a modifier selection on an amended order item
+type OrderItemModifierData struct {
+	Group  string `json:"group"`
+	Option string `json:"option"`
+}
+
+// OrderItemAddedData represents data for an item added to an order
+type OrderItemAddedData struct {
+	OrderID       string                  `json:"order_id"`
+	TableID       string                  `json:"table_id"`
+	ItemID        string                  `json:"item_id"`
+	MenuItemID    string                  `json:"menu_item_id"`
+	Name          string                  `json:"name"`
+	Quantity      int                     `json:"quantity"`
+	Course        int                     `json:"course"`
+	Seat          int                     `json:"seat,omitempty"`
+	Modifiers     []OrderItemModifierData `json:"modifiers,omitempty"`
+	Modifications []string                `json:"modifications,omitempty"`
+	Notes         string                  `json:"notes,omitempty"`
+}
+
+// OrderItemRemovedData represents data for an item removed from an order before it was sent to the kitchen
+type OrderItemRemovedData struct {
+	OrderID    string `json:"order_id"`
+	TableID    string `json:"table_id"`
+	ItemID     string `json:"item_id"`
+	MenuItemID string `json:"menu_item_id"`
+	Name       string `json:"name"`
+}
+
+// OrderItemUpdatedData represents data for a change to the quantity of an item before it was sent to the kitchen
+type OrderItemUpdatedData struct {
+	OrderID     string `json:"order_id"`
+	TableID     string `json:"table_id"`
+	ItemID      string `json:"item_id"`
+	Name        string `json:"name"`
+	OldQuantity int    `json:"old_quantity"`
+	Quantity    int    `json:"quantity"`
+}
+
+// OrderItemVoidedData represents data for an item voided from an order already sent to the kitchen
+type OrderItemVoidedData struct {
+	OrderID    string `json:"order_id"`
+	TableID    string `json:"table_id"`
+	ItemID     string `json:"item_id"`
+	MenuItemID string `json:"menu_item_id"`
+	Name       string `json:"name"`
+	Quantity   int    `json:"quantity"`
+	Reason     string `json:"reason"`
+}
+
+// OrderItemSeatChangedData represents data for an item moved to another seat of a dine-in order
+type OrderItemSeatChangedData struct {
+	OrderID   string `json:"order_id"`
+	TableID   string `json:"table_id"`
+	ItemID    string `json:"item_id"`
+	Name      string `json:"name"`
+	Seat      int    `json:"seat"`
+	ChangedBy string `json:"changed_by"`
+}
+
+// OrderAdjustmentLineData represents an order item covered by a void or refund
+type OrderAdjustmentLineData struct {
+	ItemID     string  `json:"item_id"`
+	MenuItemID string  `json:"menu_item_id"`
+	Name       string  `json:"name"`
+	Quantity   int     `json:"quantity"`
+	Amount     float64 `json:"amount"`
+}
+
+// OrderAdjustedData represents data for order voided and refunded events, which
+// compensate for sales already recorded so that stock and reports can be corrected
+type OrderAdjustedData struct {
+	OrderID      string                    `json:"order_id"`
+	AdjustmentID string                    `json:"adjustment_id"`
+	Type         string                    `json:"type"`
+	Reason       string                    `json:"reason"`
+	Notes        string                    `json:"notes,omitempty"`
+	Amount       float64                   `json:"amount"`
+	Lines        []OrderAdjustmentLineData `json:"lines"`
+	RequestedBy  string                    `json:"requested_by"`
+	ApprovedBy   string                    `json:"approved_by,omitempty"`
+}
+
+// OrderTableChangedData represents data for an order transferred to another table
+type OrderTableChangedData struct {
+	OrderID    string `json:"order_id"`
+	OldTableID string `json:"old_table_id"`
+	NewTableID string `json:"new_table_id"`
+	ChangedBy  string `json:"changed_by"`
+}
+
+// OrderItemsMovedData represents data for items moved from one order to another.
+// When an order is merged all of its items move and the source order is closed.
+type OrderItemsMovedData struct {
+	FromOrderID string   `json:"from_order_id"`
+	FromTableID string   `json:"from_table_id"`
+	ToOrderID   string   `json:"to_order_id"`
+	ToTableID   string   `json:"to_table_id"`
+	ItemIDs     []string `json:"item_ids"`
+	MovedBy     string   `json:"moved_by"`
+}
+
+// OrderSLABreachedData represents an order that stayed in a status for longer than its SLA allows
+type OrderSLABreachedData struct {
+	OrderID          string    `json:"order_id"`
+	OrderType        string    `json:"order_type"`
+	TableID          string    `json:"table_id,omitempty"`
+	Status           string    `json:"status"`
+	ThresholdSeconds int64     `json:"threshold_seconds"`
+	ElapsedSeconds   int64     `json:"elapsed_seconds"`
+	BreachedAt       time.Time `json:"breached_at"`
+}
+
+// PaymentTenderData represents a single tender in a payment breakdown
+type PaymentTenderData struct {
+	TenderID       string  `json:"tender_id"`
+	Type           string  `json:"type"`
+	Amount         float64 `json:"amount"`
+	AmountTendered float64 `json:"amount_tendered"`
+	Change         float64 `json:"change"`
+	Reference      string  `json:"reference,omitempty"`
+}
+
+// OrderPaidData represents data for order paid events, including the payment breakdown
+type OrderPaidData struct {
+	OrderID     string              `json:"order_id"`
+	OldStatus   string              `json:"old_status"`
+	NewStatus   string              `json:"new_status"`
+	UpdatedBy   string              `json:"updated_by"`
+	PaymentID   string              `json:"payment_id"`
+	AmountDue   float64             `json:"amount_due"`
+	AmountPaid  float64             `json:"amount_paid"`
+	ChangeGiven float64             `json:"change_given"`
+	Tenders     []PaymentTenderData `json:"tenders"`
+}
+
+// Payment Event Data Structures
+
+// PaymentAdjustedData represents data for payment refund and void events
+type PaymentAdjustedData struct {
+	PaymentID string  `json:"payment_id"`
+	OrderID   string  `json:"order_id"`
+	TenderID  string  `json:"tender_id,omitempty"`
+	Amount    float64 `json:"amount"`
+	Reason    string  `json:"reason"`
+	Status    string  `json:"status"`
+}
+
+// Delivery Event Data Structures
+
+// DeliveryEventData represents data for delivery dispatch events
+type DeliveryEventData struct {
+	DeliveryID       string     `json:"delivery_id"`
+	OrderID          string     `json:"order_id"`
+	ZoneID           string     `json:"zone_id"`
+	DriverID         string     `json:"driver_id"`
+	Status           string     `json:"status"`
+	Fee              float64    `json:"fee"`
+	EstimatedArrival *time.Time `json:"estimated_arrival,omitempty"`
 }
 
 // Helper functions to create event data maps
 
 // EventData represents any valid event data structure
 type EventData interface {
-	MenuCreatedData | MenuActivatedData | ItemAvailabilityChangedData |
+	MenuCreatedData | MenuActivatedData | MenuDeactivatedData | MenuItemData | ItemAvailabilityChangedData |
 	ReservationCreatedData | ReservationStatusChangedData |
 	InventoryItemCreatedData | StockMovementData | StockAlertData | SupplierEventData | SupplierDeletedData |
-	OrderCreatedData | OrderStatusChangedData |
-	KitchenOrderCreatedData | KitchenOrderStatusChangedData | KitchenItemStatusChangedData
+	OrderCreatedData | OrderReleasedData | OrderStatusChangedData | OrderPaidData | OrderCourseFiredData | OrderItemAddedData | OrderItemRemovedData | OrderItemUpdatedData | OrderItemVoidedData | OrderItemSeatChangedData | OrderAdjustedData | OrderTableChangedData | OrderItemsMovedData | OrderSLABreachedData | PaymentAdjustedData | DeliveryEventData |
+	KitchenOrderCreatedData | KitchenOrderUpdatedData | KitchenOrderStatusChangedData | KitchenOrderBumpedData | KitchenOrderSLABreachedData | KitchenItemStatusChangedData
 }
 
 // ToEventData converts a struct to event data map using Go 1.24.4 generics
@@ -0,0 +1,154 @@
+package events
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+)
+
+// StreamStart is the sequence before the first event of a stream
+const StreamStart = "0-0"
+
+// StreamedEvent is a domain event together with its position in the stream.
+// Sequence is the Redis Stream message ID, e.g. "1718000000000-0".
+type StreamedEvent struct {
+	Sequence string
+	Event    *DomainEvent
+}
+
+// EventStreamReader reads a stream from a given position without a consumer group,
+// so every reader sees every event
+type EventStreamReader interface {
+	// ReadAfter returns up to count events after the given sequence, waiting up to block
+	// for one to arrive; a negative block returns immediately
+	ReadAfter(ctx context.Context, after string, count int64, block time.Duration) ([]*StreamedEvent, error)
+
+	// LastSequence returns the sequence of the newest event in the stream, or StreamStart when it is empty
+	LastSequence(ctx context.Context) (string, error)
+
+	Close() error
+}
+
+// RedisStreamReader implements EventStreamReader using Redis Streams
+type RedisStreamReader struct {
+	client *redis.Client
+	stream string
+}
+
+// NewRedisStreamReader creates a new Redis Streams reader
+func NewRedisStreamReader(redisAddr, password string, db int, streamName string) (*RedisStreamReader, error) {
+	client := redis.NewClient(&redis.Options{
+		Addr:     redisAddr,
+		Password: password,
+		DB:       db,
+	})
+
+	// Test connection
+	ctx := context.Background()
+	if err := client.Ping(ctx).Err(); err != nil {
+		return nil, fmt.Errorf("failed to connect to Redis: %w", err)
+	}
+
+	return &RedisStreamReader{
+		client: client,
+		stream: streamName,
+	}, nil
+}
+
+// ReadAfter returns up to count events after the given sequence
+func (r *RedisStreamReader) ReadAfter(ctx context.Context, after string, count int64, block time.Duration) ([]*StreamedEvent, error) {
+	streams, err := r.client.XRead(ctx, &redis.XReadArgs{
+		Streams: []string{r.stream, after},
+		Count:   count,
+		Block:   block,
+	}).Result()
+	if err != nil {
+		if err == redis.Nil {
+			return nil, nil // No new messages
+		}
+		return nil, fmt.Errorf("failed to read stream %s: %w", r.stream, err)
+	}
+
+	var result []*StreamedEvent
+	for _, stream := range streams {
+		for _, message := range stream.Messages {
+			eventData, ok := message.Values["data"].(string)
+			if !ok {
+				log.Printf("Skipping message %s without event data", message.ID)
+				continue
+			}
+
+			event, err := FromJSON([]byte(eventData))
+			if err != nil {
+				log.Printf("Skipping message %s: failed to deserialize event: %v", message.ID, err)
+				continue
+			}
+			result = append(result, &StreamedEvent{Sequence: message.ID, Event: event})
+		}
+	}
+
+	return result, nil
+}
+
+// LastSequence returns the sequence of the newest event in the stream
+func (r *RedisStreamReader) LastSequence(ctx context.Context) (string, error) {
+	messages, err := r.client.XRevRangeN(ctx, r.stream, "+", "-", 1).Result()
+	if err != nil {
+		return "", fmt.Errorf("failed to read stream %s: %w", r.stream, err)
+	}
+	if len(messages) == 0 {
+		return StreamStart, nil
+	}
+	return messages[0].ID, nil
+}
+
+// Close closes the Redis connection
+func (r *RedisStreamReader) Close() error {
+	return r.client.Close()
+}
+
+// CompareSequences orders two stream sequences, returning -1, 0 or 1.
+// Sequences that cannot be parsed compare as the start of the stream.
+func CompareSequences(a, b string) int {
+	aMillis, aSeq := parseSequence(a)
+	bMillis, bSeq := parseSequence(b)
+	switch {
+	case aMillis != bMillis:
+		if aMillis < bMillis {
+			return -1
+		}
+		return 1
+	case aSeq != bSeq:
+		if aSeq < bSeq {
+			return -1
+		}
+		return 1
+	default:
+		return 0
+	}
+}
+
+// ValidSequence reports whether s is a stream sequence of the form "<millis>-<seq>"
+func ValidSequence(s string) bool {
+	millis, seq, ok := strings.Cut(s, "-")
+	if !ok {
+		return false
+	}
+	if _, err := strconv.ParseUint(millis, 10, 64); err != nil {
+		return false
+	}
+	_, err := strconv.ParseUint(seq, 10, 64)
+	return err == nil
+}
+
+func parseSequence(s string) (uint64, uint64) {
+	millis, seq, _ := strings.Cut(s, "-")
+	m, _ := strconv.ParseUint(millis, 10, 64)
+	n, _ := strconv.ParseUint(seq, 10, 64)
+	return m, n
+}
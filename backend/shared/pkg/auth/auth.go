@@ -0,0 +1,100 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// Claims mirrors the access token claims issued by user-service
+type Claims struct {
+	UserID    string `json:"userId"`
+	SessionID string `json:"sessionId"`
+	RoleID    string `json:"roleId"`
+	Email     string `json:"email"`
+	TokenType string `json:"tokenType"`
+	jwt.RegisteredClaims
+}
+
+// ParseToken validates an access token signed with the shared secret and returns its claims
+func ParseToken(tokenString, secretKey string) (*Claims, error) {
+	token, err := jwt.ParseWithClaims(tokenString, &Claims{}, func(token *jwt.Token) (interface{}, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
+		}
+		return []byte(secretKey), nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse token: %w", err)
+	}
+
+	claims, ok := token.Claims.(*Claims)
+	if !ok || !token.Valid {
+		return nil, fmt.Errorf("invalid token")
+	}
+	if claims.TokenType != "access" {
+		return nil, fmt.Errorf("invalid token type: %s", claims.TokenType)
+	}
+	if claims.UserID == "" {
+		return nil, fmt.Errorf("token has no user ID")
+	}
+
+	return claims, nil
+}
+
+type actorKey struct{}
+
+// WithActor returns a context recording who is performing an operation:
+// an authenticated user ID or the name of the service acting on its own
+func WithActor(ctx context.Context, actor string) context.Context {
+	return context.WithValue(ctx, actorKey{}, actor)
+}
+
+// ActorFromContext returns the actor recorded on the context, if any
+func ActorFromContext(ctx context.Context) (string, bool) {
+	actor, ok := ctx.Value(actorKey{}).(string)
+	return actor, ok && actor != ""
+}
+
+// Role IDs of the default user-service roles
+const (
+	RoleAdmin        = "role_admin"
+	RoleManager      = "role_manager"
+	RoleKitchenStaff = "role_kitchen_staff"
+	RoleWaitstaff    = "role_waitstaff"
+	RoleHost         = "role_host"
+	RoleCashier      = "role_cashier"
+)
+
+type roleKey struct{}
+
+// WithRole returns a context recording the role ID of the authenticated user
+func WithRole(ctx context.Context, roleID string) context.Context {
+	return context.WithValue(ctx, roleKey{}, roleID)
+}
+
+// RoleFromContext returns the role ID recorded on the context, if any
+func RoleFromContext(ctx context.Context) (string, bool) {
+	roleID, ok := ctx.Value(roleKey{}).(string)
+	return roleID, ok && roleID != ""
+}
+
+// HasRole reports whether the authenticated user has one of the given roles
+func HasRole(ctx context.Context, roleIDs ...string) bool {
+	roleID, ok := RoleFromContext(ctx)
+	if !ok {
+		return false
+	}
+	for _, allowed := range roleIDs {
+		if roleID == allowed {
+			return true
+		}
+	}
+	return false
+}
+
+// IsManager reports whether the authenticated user is a manager or administrator
+func IsManager(ctx context.Context) bool {
+	return HasRole(ctx, RoleManager, RoleAdmin)
+}
@@ -0,0 +1,92 @@
+package concurrency
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/restaurant-platform/shared/pkg/errors"
+)
+
+// MaxAttempts is how many times RetryOnConflict runs an operation before giving up
+const MaxAttempts = 3
+
+// retryBackoff is the base delay between attempts; it grows linearly with each retry
+const retryBackoff = 10 * time.Millisecond
+
+// RetryOnConflict runs a load-modify-save operation, re-running it when the save loses
+// an optimistic concurrency race. Any other error, including a failed If-Match
+// precondition, is returned immediately.
+func RetryOnConflict(ctx context.Context, operation func() error) error {
+	var err error
+	for attempt := 1; attempt <= MaxAttempts; attempt++ {
+		err = operation()
+		if !errors.IsVersionConflict(err) {
+			return err
+		}
+
+		if attempt < MaxAttempts {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(time.Duration(attempt) * retryBackoff):
+			}
+		}
+	}
+	return err
+}
+
+type expectedVersionKey struct{}
+
+// WithExpectedVersion returns a context carrying the aggregate version a client last saw
+func WithExpectedVersion(ctx context.Context, version int) context.Context {
+	return context.WithValue(ctx, expectedVersionKey{}, version)
+}
+
+// ExpectedVersionFromContext returns the client's expected aggregate version, if any
+func ExpectedVersionFromContext(ctx context.Context) (int, bool) {
+	version, ok := ctx.Value(expectedVersionKey{}).(int)
+	return version, ok
+}
+
+// WithoutExpectedVersion returns a context that no longer carries the client's expected
+// version, for changes made once the precondition has been checked and acted upon
+func WithoutExpectedVersion(ctx context.Context) context.Context {
+	if _, ok := ExpectedVersionFromContext(ctx); !ok {
+		return ctx
+	}
+	return context.WithValue(ctx, expectedVersionKey{}, nil)
+}
+
+// CheckVersion fails with a precondition error when the client expected a different
+// version of the aggregate than the one just loaded
+func CheckVersion(ctx context.Context, op, resource, id string, actual int) error {
+	expected, ok := ExpectedVersionFromContext(ctx)
+	if !ok || expected == actual {
+		return nil
+	}
+	return errors.WrapPreconditionFailed(op, resource, id, expected, actual)
+}
+
+// ETag formats an aggregate version as an HTTP entity tag
+func ETag(version int) string {
+	return strconv.Quote(strconv.Itoa(version))
+}
+
+// ParseETag reads an aggregate version from an If-Match header value,
+// accepting weak tags
+func ParseETag(value string) (int, error) {
+	tag := strings.TrimPrefix(strings.TrimSpace(value), "W/")
+	unquoted, err := strconv.Unquote(tag)
+	if err != nil {
+		return 0, fmt.Errorf("entity tag must be quoted: %s", value)
+	}
+
+	version, err := strconv.Atoi(unquoted)
+	if err != nil || version < 1 {
+		return 0, fmt.Errorf("entity tag is not a version: %s", value)
+	}
+	return version, nil
+}
@@ -0,0 +1,37 @@
+package concurrency
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// IfMatchMiddleware reads the aggregate version a client expects from the If-Match header
+// of a modifying request and puts it on the request context. Services check it with
+// CheckVersion and reject the change with 412 if the aggregate has moved on.
+func IfMatchMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ifMatch := c.GetHeader("If-Match")
+		if ifMatch == "" || ifMatch == "*" || c.Request.Method == http.MethodGet {
+			c.Next()
+			return
+		}
+
+		version, err := ParseETag(ifMatch)
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{
+				"error":   "Invalid If-Match header",
+				"message": err.Error(),
+			})
+			return
+		}
+
+		c.Request = c.Request.WithContext(WithExpectedVersion(c.Request.Context(), version))
+		c.Next()
+	}
+}
+
+// SetETag exposes an aggregate version so clients can send it back in If-Match
+func SetETag(c *gin.Context, version int) {
+	c.Header("ETag", ETag(version))
+}
@@ -3,16 +3,28 @@ package config
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/spf13/viper"
 )
 
 // Config holds all configuration for the application
 type Config struct {
-	Server   ServerConfig   `mapstructure:"server" json:"server"`
-	Database DatabaseConfig `mapstructure:"database" json:"database"`
-	Redis    RedisConfig    `mapstructure:"redis" json:"redis"`
-	JWT      JWTConfig      `mapstructure:"jwt" json:"jwt"`
+	Server         ServerConfig         `mapstructure:"server" json:"server"`
+	Database       DatabaseConfig       `mapstructure:"database" json:"database"`
+	Redis          RedisConfig          `mapstructure:"redis" json:"redis"`
+	JWT            JWTConfig            `mapstructure:"jwt" json:"jwt"`
+	Payment        PaymentConfig        `mapstructure:"payment" json:"payment"`
+	Delivery       DeliveryConfig       `mapstructure:"delivery" json:"delivery"`
+	Receipt        ReceiptConfig        `mapstructure:"receipt" json:"receipt"`
+	Reporting      ReportingConfig      `mapstructure:"reporting" json:"reporting"`
+	Approval       ApprovalConfig       `mapstructure:"approval" json:"approval"`
+	SLA            SLAConfig            `mapstructure:"sla" json:"sla"`
+	Marketplace    MarketplaceConfig    `mapstructure:"marketplace" json:"marketplace"`
+	Loyalty        LoyaltyConfig        `mapstructure:"loyalty" json:"loyalty"`
+	PrepTime       PrepTimeConfig       `mapstructure:"prep_time" json:"prep_time"`
+	KitchenDisplay KitchenDisplayConfig `mapstructure:"kitchen_display" json:"kitchen_display"`
+	MenuService    MenuServiceConfig    `mapstructure:"menu_service" json:"menu_service"`
 }
 
 // ServerConfig holds server configuration
@@ -50,6 +62,137 @@ type JWTConfig struct {
 	RefreshExpirationHours  int    `mapstructure:"refresh_expiration_hours" json:"refresh_expiration_hours"`
 }
 
+// PaymentConfig holds the processor card tenders are charged through
+type PaymentConfig struct {
+	// Provider selects the processor; "gateway" is the HTTP card gateway, empty disables card tenders
+	Provider string `mapstructure:"provider" json:"provider"`
+	// GatewayURL is the base URL of the card gateway
+	GatewayURL string `mapstructure:"gateway_url" json:"gateway_url"`
+	// APIKey authenticates requests to the card gateway
+	APIKey string `mapstructure:"api_key" json:"-"`
+	// Timeout bounds each request to the processor
+	Timeout time.Duration `mapstructure:"timeout" json:"timeout"`
+}
+
+// DeliveryConfig holds delivery dispatch configuration
+type DeliveryConfig struct {
+	OriginLat float64 `mapstructure:"origin_lat" json:"origin_lat"`
+	OriginLng float64 `mapstructure:"origin_lng" json:"origin_lng"`
+	// Geocoder selects how delivery addresses are resolved; "nominatim" is a Nominatim search API
+	Geocoder string `mapstructure:"geocoder" json:"geocoder"`
+	// GeocoderURL is the base URL of the geocoder
+	GeocoderURL string `mapstructure:"geocoder_url" json:"geocoder_url"`
+	// GeocoderUserAgent identifies the restaurant to the geocoder
+	GeocoderUserAgent string `mapstructure:"geocoder_user_agent" json:"geocoder_user_agent"`
+}
+
+// ReceiptConfig holds the restaurant details printed on receipts
+type ReceiptConfig struct {
+	RestaurantName string `mapstructure:"restaurant_name" json:"restaurant_name"`
+	Address        string `mapstructure:"address" json:"address"`
+	Phone          string `mapstructure:"phone" json:"phone"`
+	TaxID          string `mapstructure:"tax_id" json:"tax_id"`
+	Footer         string `mapstructure:"footer" json:"footer"`
+	TemplateDir    string `mapstructure:"template_dir" json:"template_dir"`
+}
+
+// ReportingConfig holds sales reporting configuration
+type ReportingConfig struct {
+	// BusinessDayCutoff is the local HH:MM time at which one business day ends and the next begins
+	BusinessDayCutoff string `mapstructure:"business_day_cutoff" json:"business_day_cutoff"`
+}
+
+// ApprovalConfig holds the manager approval rules for voids and refunds
+type ApprovalConfig struct {
+	// Threshold is the amount above which a void or refund needs manager approval
+	Threshold float64 `mapstructure:"threshold" json:"threshold"`
+	// ManagerPINs maps manager user IDs to the bcrypt hash of their override PIN
+	ManagerPINs map[string]string `mapstructure:"manager_pins" json:"-"`
+	// MaxPINAttempts is how many wrong PINs lock a manager's override out
+	MaxPINAttempts int `mapstructure:"max_pin_attempts" json:"max_pin_attempts"`
+	// PINLockout is how long the failed attempts are counted, and so how long a lockout lasts
+	PINLockout time.Duration `mapstructure:"pin_lockout" json:"pin_lockout"`
+}
+
+// SLAConfig holds the service levels that active orders and kitchen tickets are monitored against
+type SLAConfig struct {
+	// CheckInterval is how often active orders and kitchen tickets are evaluated
+	CheckInterval time.Duration `mapstructure:"check_interval" json:"check_interval"`
+	// Thresholds maps an order status to the longest an order may stay in it, per order type.
+	// The "default" order type applies to types without their own threshold.
+	Thresholds map[string]map[string]time.Duration `mapstructure:"thresholds" json:"thresholds"`
+	// UnpaidTimeout is how long a takeout or delivery order may go unpaid before it is cancelled;
+	// zero disables it. Dine-in checks are left open for the meal.
+	UnpaidTimeout time.Duration `mapstructure:"unpaid_timeout" json:"unpaid_timeout"`
+	// KitchenThresholds maps a kitchen order status to the longest a ticket may stay in it
+	KitchenThresholds map[string]time.Duration `mapstructure:"kitchen_thresholds" json:"kitchen_thresholds"`
+}
+
+// MarketplaceConfig holds the delivery marketplaces orders are accepted from
+type MarketplaceConfig struct {
+	// MaxKitchenLoad is the number of paid orders waiting on or being prepared by the kitchen
+	// at which marketplace orders are rejected; zero accepts every order
+	MaxKitchenLoad int `mapstructure:"max_kitchen_load" json:"max_kitchen_load"`
+	// Marketplaces configures each marketplace by the name used in its webhook URL
+	Marketplaces map[string]MarketplaceAdapterConfig `mapstructure:"marketplaces" json:"marketplaces"`
+}
+
+// MarketplaceAdapterConfig holds the connection to a single marketplace
+type MarketplaceAdapterConfig struct {
+	// Format selects the adapter; "generic" is the documented generic JSON format
+	Format string `mapstructure:"format" json:"format"`
+	// Secret signs webhooks and status updates
+	Secret string `mapstructure:"secret" json:"-"`
+	// StatusURL receives status updates; empty disables them
+	StatusURL string `mapstructure:"status_url" json:"status_url"`
+}
+
+// LoyaltyConfig holds the rules of the loyalty points program
+type LoyaltyConfig struct {
+	// PointsPerDollar is the points earned per dollar spent on items
+	PointsPerDollar float64 `mapstructure:"points_per_dollar" json:"points_per_dollar"`
+	// CategoryRates overrides the points per dollar for menu categories
+	CategoryRates map[string]float64 `mapstructure:"category_rates" json:"category_rates"`
+	// PointValue is the discount in dollars a redeemed point is worth
+	PointValue float64 `mapstructure:"point_value" json:"point_value"`
+	// MinRedemption is the fewest points that can be redeemed at once
+	MinRedemption int `mapstructure:"min_redemption" json:"min_redemption"`
+	// Expiry is how long earned points last; zero means they never expire
+	Expiry time.Duration `mapstructure:"expiry" json:"expiry"`
+	// ExpiryCheckInterval is how often expired points are written off
+	ExpiryCheckInterval time.Duration `mapstructure:"expiry_check_interval" json:"expiry_check_interval"`
+	// Tiers are the membership levels reached with lifetime points
+	Tiers []LoyaltyTierConfig `mapstructure:"tiers" json:"tiers"`
+}
+
+// PrepTimeConfig holds how preparation times are learned from past kitchen orders
+type PrepTimeConfig struct {
+	// StatsInterval is how often learned preparation times are recomputed
+	StatsInterval time.Duration `mapstructure:"stats_interval" json:"stats_interval"`
+	// Lookback is how far back kitchen orders are taken into account
+	Lookback time.Duration `mapstructure:"lookback" json:"lookback"`
+}
+
+// KitchenDisplayConfig holds who may open kitchen display streams
+type KitchenDisplayConfig struct {
+	// AllowedOrigins are the browser origins, such as "https://kds.example.com", that may open a
+	// display WebSocket in addition to the service's own host
+	AllowedOrigins []string `mapstructure:"allowed_origins" json:"allowed_origins"`
+}
+
+// MenuServiceConfig holds how other services reach the menu service
+type MenuServiceConfig struct {
+	// URL is the base URL of the menu service; the active menu is loaded from it at startup
+	URL string `mapstructure:"url" json:"url"`
+}
+
+// LoyaltyTierConfig holds a loyalty tier and the multiplier of the points earned in it
+type LoyaltyTierConfig struct {
+	Name       string  `mapstructure:"name" json:"name"`
+	MinPoints  int     `mapstructure:"min_points" json:"min_points"`
+	Multiplier float64 `mapstructure:"multiplier" json:"multiplier"`
+}
+
 // Load creates a new configuration using Viper
 func Load() (*Config, error) {
 	v := viper.New()
@@ -143,6 +286,64 @@ func setDefaults(v *viper.Viper) {
 	v.SetDefault("jwt.secret_key", "restaurant-platform-secret-key-change-in-production")
 	v.SetDefault("jwt.expiration_minutes", 60)
 	v.SetDefault("jwt.refresh_expiration_hours", 168)
+
+	// Payment defaults
+	v.SetDefault("payment.provider", "")
+	v.SetDefault("payment.timeout", "15s")
+
+	// Delivery defaults
+	v.SetDefault("delivery.origin_lat", 40.7128)
+	v.SetDefault("delivery.origin_lng", -74.0060)
+	v.SetDefault("delivery.geocoder", "nominatim")
+	v.SetDefault("delivery.geocoder_url", "https://nominatim.openstreetmap.org")
+	v.SetDefault("delivery.geocoder_user_agent", "restaurant-platform-order-service")
+
+	// Receipt defaults
+	v.SetDefault("receipt.restaurant_name", "Restaurant Platform")
+	v.SetDefault("receipt.address", "")
+	v.SetDefault("receipt.phone", "")
+	v.SetDefault("receipt.tax_id", "")
+	v.SetDefault("receipt.footer", "Thank you for dining with us!")
+	v.SetDefault("receipt.template_dir", "")
+
+	// Reporting defaults
+	v.SetDefault("reporting.business_day_cutoff", "04:00")
+
+	// Approval defaults
+	v.SetDefault("approval.threshold", 50.00)
+	v.SetDefault("approval.max_pin_attempts", 5)
+	v.SetDefault("approval.pin_lockout", "15m")
+
+	// SLA defaults
+	v.SetDefault("sla.check_interval", "1m")
+	v.SetDefault("sla.unpaid_timeout", "2h")
+	v.SetDefault("sla.thresholds", map[string]map[string]string{
+		"paid":      {"default": "20m"},
+		"preparing": {"default": "30m"},
+		"ready":     {"default": "20m", "dine_in": "10m"},
+	})
+	v.SetDefault("sla.kitchen_thresholds", map[string]string{
+		"new":       "5m",
+		"preparing": "25m",
+		"ready":     "5m",
+	})
+
+	// Marketplace defaults
+	v.SetDefault("marketplace.max_kitchen_load", 25)
+
+	// Loyalty defaults
+	v.SetDefault("loyalty.points_per_dollar", 1.0)
+	v.SetDefault("loyalty.point_value", 0.01)
+	v.SetDefault("loyalty.min_redemption", 500)
+	v.SetDefault("loyalty.expiry", "8760h")
+	v.SetDefault("loyalty.expiry_check_interval", "1h")
+
+	// Prep time defaults
+	v.SetDefault("prep_time.stats_interval", "1h")
+	v.SetDefault("prep_time.lookback", "720h")
+
+	// Menu service defaults
+	v.SetDefault("menu_service.url", "http://localhost:8081")
 }
 
 // GetConfigPath returns the path to the config file being used
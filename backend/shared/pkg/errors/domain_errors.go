@@ -15,6 +15,10 @@ var (
 	ErrForbidden     = errors.New("forbidden operation")
 	ErrConflict      = errors.New("operation conflict")
 	ErrInternal      = errors.New("internal server error")
+
+	// Concurrency errors
+	ErrVersionConflict    = fmt.Errorf("version conflict: %w", ErrConflict)
+	ErrPreconditionFailed = errors.New("precondition failed")
 	
 	// Menu domain errors
 	ErrMenuNotFound         = fmt.Errorf("menu: %w", ErrNotFound)
@@ -112,11 +116,26 @@ func IsConflictError(err error) bool {
 	return false
 }
 
+// IsVersionConflict checks if error is an optimistic concurrency conflict
+func IsVersionConflict(err error) bool {
+	return errors.Is(err, ErrVersionConflict)
+}
+
+// IsPreconditionFailed checks if error is a failed If-Match precondition
+func IsPreconditionFailed(err error) bool {
+	return errors.Is(err, ErrPreconditionFailed)
+}
+
 // IsUnauthorizedError checks if error is an unauthorized error
 func IsUnauthorizedError(err error) bool {
 	return errors.Is(err, ErrUnauthorized)
 }
 
+// IsForbiddenError checks if error is a forbidden error
+func IsForbiddenError(err error) bool {
+	return errors.Is(err, ErrForbidden)
+}
+
 // WrapNotFound wraps an error as a not found error with context
 func WrapNotFound(op, resource, id string, err error) error {
 	return NewDomainError(op, "NOT_FOUND", 
@@ -141,6 +160,24 @@ func WrapConflict(op, resource, reason string, err error) error {
 		WithContext("reason", reason)
 }
 
+// WrapVersionConflict wraps a failed conditional update of an aggregate
+func WrapVersionConflict(op, resource, id string, version int) error {
+	return NewDomainError(op, "VERSION_CONFLICT",
+		fmt.Sprintf("%s %s was modified concurrently (expected version %d)", resource, id, version), fmt.Errorf("%w", ErrVersionConflict)).
+		WithContext("resource", resource).
+		WithContext("id", id).
+		WithContext("version", version)
+}
+
+// WrapPreconditionFailed wraps a client version that no longer matches the aggregate
+func WrapPreconditionFailed(op, resource, id string, expected, actual int) error {
+	return NewDomainError(op, "PRECONDITION_FAILED",
+		fmt.Sprintf("%s %s is at version %d, not %d", resource, id, actual, expected), fmt.Errorf("%w", ErrPreconditionFailed)).
+		WithContext("resource", resource).
+		WithContext("id", id).
+		WithContext("version", actual)
+}
+
 // WrapUnauthorized wraps an error as an unauthorized error with context
 func WrapUnauthorized(op, reason string, err error) error {
 	return NewDomainError(op, "UNAUTHORIZED",
@@ -148,6 +185,13 @@ func WrapUnauthorized(op, reason string, err error) error {
 		WithContext("reason", reason)
 }
 
+// WrapForbidden wraps an error as a forbidden error with context
+func WrapForbidden(op, reason string, err error) error {
+	return NewDomainError(op, "FORBIDDEN",
+		reason, fmt.Errorf("%w", ErrForbidden)).
+		WithContext("reason", reason)
+}
+
 // WrapBadRequest wraps an error as a bad request error with context
 func WrapBadRequest(op, reason string, err error) error {
 	return NewDomainError(op, "BAD_REQUEST",
@@ -0,0 +1,209 @@
+package idempotency
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"log"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/restaurant-platform/shared/pkg/auth"
+)
+
+const (
+	// HeaderKey is the request header carrying the client's idempotency key
+	HeaderKey = "Idempotency-Key"
+	// HeaderReplayed is set on responses replayed from a stored record
+	HeaderReplayed = "Idempotent-Replayed"
+	// DefaultTTL is how long keys and their responses are kept
+	DefaultTTL = 24 * time.Hour
+	// LockTTL is how long a key stays claimed by a request that is still in flight.
+	// The claim is extended while the request runs, so a claim left behind by a crashed
+	// instance expires after it, not after the full ttl.
+	LockTTL = time.Minute
+
+	maxKeyLength = 255
+)
+
+// Middleware makes write requests that carry an Idempotency-Key safe to retry.
+// The first request with a key is processed and its response stored for ttl;
+// retries with the same payload get the stored response, a different payload
+// under the same key is rejected, and a duplicate arriving while the first is
+// still in flight is told to retry later. Server errors are not stored, so the
+// key can be retried. Keys are scoped to the actor on the context when there is one.
+// Store calls outlive the request context, so a client that disconnects mid-request
+// still leaves its response stored for the retry. The claim is kept alive for as long
+// as the handler runs, however long that is.
+func Middleware(store Store, ttl time.Duration) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		key := c.GetHeader(HeaderKey)
+		if key == "" || !isWriteMethod(c.Request.Method) {
+			c.Next()
+			return
+		}
+		if len(key) > maxKeyLength {
+			c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{
+				"error":   "Invalid Idempotency-Key header",
+				"message": "idempotency key must be at most 255 characters",
+			})
+			return
+		}
+
+		body, err := io.ReadAll(c.Request.Body)
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{
+				"error":   "Invalid request body",
+				"message": err.Error(),
+			})
+			return
+		}
+		c.Request.Body = io.NopCloser(bytes.NewReader(body))
+
+		ctx := context.WithoutCancel(c.Request.Context())
+		if actor, ok := auth.ActorFromContext(ctx); ok {
+			key = actor + ":" + key
+		}
+		fingerprint := fingerprintRequest(c.Request.Method, c.Request.URL.Path, c.Request.URL.RawQuery, body)
+
+		lockTTL := min(LockTTL, ttl)
+		existing, claimed, err := store.Begin(ctx, key, fingerprint, lockTTL)
+		if err != nil {
+			log.Printf("Failed to claim idempotency key: %v", err)
+			c.AbortWithStatusJSON(http.StatusServiceUnavailable, gin.H{
+				"error":   "Idempotency store unavailable",
+				"message": "request was not processed, retry later",
+			})
+			return
+		}
+		if !claimed {
+			replay(c, existing, fingerprint)
+			return
+		}
+
+		writer := &recordingWriter{ResponseWriter: c.Writer}
+		c.Writer = writer
+
+		stopExtending := extendClaim(ctx, store, key, fingerprint, lockTTL)
+
+		stored := false
+		defer func() {
+			stopExtending()
+
+			// Free the key when the handler failed or panicked so the client can retry
+			if stored {
+				return
+			}
+			if err := store.Release(ctx, key); err != nil {
+				log.Printf("Failed to release idempotency key: %v", err)
+			}
+		}()
+
+		c.Next()
+		stopExtending()
+
+		if writer.Status() >= http.StatusInternalServerError {
+			return
+		}
+
+		record := &Record{
+			Fingerprint: fingerprint,
+			Completed:   true,
+			StatusCode:  writer.Status(),
+			ContentType: writer.Header().Get("Content-Type"),
+			Body:        writer.body.Bytes(),
+		}
+		if err := store.Complete(ctx, key, record, ttl); err != nil {
+			log.Printf("Failed to store idempotent response: %v", err)
+			return
+		}
+		stored = true
+	}
+}
+
+// extendClaim keeps key claimed while the handler runs, extending the claim well before it
+// would expire. The returned function stops it and may be called more than once.
+func extendClaim(ctx context.Context, store Store, key, fingerprint string, lockTTL time.Duration) func() {
+	done := make(chan struct{})
+	go func() {
+		ticker := time.NewTicker(lockTTL / 3)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				if err := store.Extend(ctx, key, fingerprint, lockTTL); err != nil {
+					log.Printf("Failed to extend idempotency key: %v", err)
+				}
+			}
+		}
+	}()
+
+	var once sync.Once
+	return func() { once.Do(func() { close(done) }) }
+}
+
+func replay(c *gin.Context, record *Record, fingerprint string) {
+	if record.Fingerprint != fingerprint {
+		c.AbortWithStatusJSON(http.StatusUnprocessableEntity, gin.H{
+			"error":   "Idempotency key reused",
+			"message": "idempotency key was already used for a different request",
+		})
+		return
+	}
+	if !record.Completed {
+		c.Header("Retry-After", "1")
+		c.AbortWithStatusJSON(http.StatusConflict, gin.H{
+			"error":   "Request in progress",
+			"message": "a request with this idempotency key is still being processed",
+		})
+		return
+	}
+
+	c.Header(HeaderReplayed, "true")
+	c.Data(record.StatusCode, record.ContentType, record.Body)
+	c.Abort()
+}
+
+func isWriteMethod(method string) bool {
+	switch method {
+	case http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+func fingerprintRequest(method, path, query string, body []byte) string {
+	hash := sha256.New()
+	hash.Write([]byte(method))
+	hash.Write([]byte{0})
+	hash.Write([]byte(path))
+	hash.Write([]byte{0})
+	hash.Write([]byte(query))
+	hash.Write([]byte{0})
+	hash.Write(body)
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+// recordingWriter copies the response body as it is written
+type recordingWriter struct {
+	gin.ResponseWriter
+	body bytes.Buffer
+}
+
+func (w *recordingWriter) Write(data []byte) (int, error) {
+	w.body.Write(data)
+	return w.ResponseWriter.Write(data)
+}
+
+func (w *recordingWriter) WriteString(s string) (int, error) {
+	w.body.WriteString(s)
+	return w.ResponseWriter.WriteString(s)
+}
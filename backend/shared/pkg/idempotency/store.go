@@ -0,0 +1,212 @@
+package idempotency
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+)
+
+// Record is what is kept for an idempotency key: the fingerprint of the first
+// request that used it and, once that request has finished, its response
+type Record struct {
+	Fingerprint string `json:"fingerprint"`
+	Completed   bool   `json:"completed"`
+	StatusCode  int    `json:"status_code,omitempty"`
+	ContentType string `json:"content_type,omitempty"`
+	Body        []byte `json:"body,omitempty"`
+}
+
+// Store keeps idempotency records for a limited time
+type Store interface {
+	// Begin claims key for a request with the given fingerprint. When the key
+	// is already taken the existing record is returned and claimed is false.
+	Begin(ctx context.Context, key, fingerprint string, ttl time.Duration) (existing *Record, claimed bool, err error)
+	// Complete stores the response of the request that claimed key
+	Complete(ctx context.Context, key string, record *Record, ttl time.Duration) error
+	// Extend keeps key claimed for another ttl while the request with the given
+	// fingerprint is still in flight. A completed or released key is left alone.
+	Extend(ctx context.Context, key, fingerprint string, ttl time.Duration) error
+	// Release frees a claimed key so that the request can be retried
+	Release(ctx context.Context, key string) error
+}
+
+// MemoryStore implements Store in process memory, for tests and single instances
+type MemoryStore struct {
+	mu      sync.Mutex
+	entries map[string]memoryEntry
+}
+
+type memoryEntry struct {
+	record    Record
+	expiresAt time.Time
+}
+
+// NewMemoryStore creates an empty in-memory store
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{entries: make(map[string]memoryEntry)}
+}
+
+// Begin claims key unless an unexpired record already holds it
+func (s *MemoryStore) Begin(ctx context.Context, key, fingerprint string, ttl time.Duration) (*Record, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if entry, ok := s.entries[key]; ok && time.Now().Before(entry.expiresAt) {
+		record := entry.record
+		return &record, false, nil
+	}
+
+	s.entries[key] = memoryEntry{
+		record:    Record{Fingerprint: fingerprint},
+		expiresAt: time.Now().Add(ttl),
+	}
+	return nil, true, nil
+}
+
+// Complete stores the response for key
+func (s *MemoryStore) Complete(ctx context.Context, key string, record *Record, ttl time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.entries[key] = memoryEntry{record: *record, expiresAt: time.Now().Add(ttl)}
+	return nil
+}
+
+// Extend pushes back the expiry of key while it is claimed by the request with the given fingerprint
+func (s *MemoryStore) Extend(ctx context.Context, key, fingerprint string, ttl time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	entry, ok := s.entries[key]
+	if !ok || entry.record.Completed || entry.record.Fingerprint != fingerprint || !time.Now().Before(entry.expiresAt) {
+		return nil
+	}
+	entry.expiresAt = time.Now().Add(ttl)
+	s.entries[key] = entry
+	return nil
+}
+
+// Release removes key
+func (s *MemoryStore) Release(ctx context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.entries, key)
+	return nil
+}
+
+// RedisStore implements Store using Redis, so that keys are shared by all instances of a service
+type RedisStore struct {
+	client *redis.Client
+	prefix string
+}
+
+// NewRedisStore creates a Redis backed store; keys are namespaced by prefix
+func NewRedisStore(redisAddr, password string, db int, prefix string) (*RedisStore, error) {
+	client := redis.NewClient(&redis.Options{
+		Addr:     redisAddr,
+		Password: password,
+		DB:       db,
+	})
+
+	// Test connection
+	ctx := context.Background()
+	if err := client.Ping(ctx).Err(); err != nil {
+		return nil, fmt.Errorf("failed to connect to Redis: %w", err)
+	}
+
+	return &RedisStore{
+		client: client,
+		prefix: prefix,
+	}, nil
+}
+
+// Begin claims key with SETNX so that concurrent duplicates cannot both proceed
+func (s *RedisStore) Begin(ctx context.Context, key, fingerprint string, ttl time.Duration) (*Record, bool, error) {
+	data, err := json.Marshal(Record{Fingerprint: fingerprint})
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to marshal idempotency record: %w", err)
+	}
+
+	redisKey := s.redisKey(key)
+	for {
+		claimed, err := s.client.SetNX(ctx, redisKey, data, ttl).Result()
+		if err != nil {
+			return nil, false, fmt.Errorf("failed to claim idempotency key: %w", err)
+		}
+		if claimed {
+			return nil, true, nil
+		}
+
+		stored, err := s.client.Get(ctx, redisKey).Bytes()
+		if err == redis.Nil {
+			// Expired or released between the two calls, try to claim it again
+			continue
+		}
+		if err != nil {
+			return nil, false, fmt.Errorf("failed to get idempotency record: %w", err)
+		}
+
+		var record Record
+		if err := json.Unmarshal(stored, &record); err != nil {
+			return nil, false, fmt.Errorf("failed to unmarshal idempotency record: %w", err)
+		}
+		return &record, false, nil
+	}
+}
+
+// Complete stores the response for key
+func (s *RedisStore) Complete(ctx context.Context, key string, record *Record, ttl time.Duration) error {
+	data, err := json.Marshal(record)
+	if err != nil {
+		return fmt.Errorf("failed to marshal idempotency record: %w", err)
+	}
+
+	if err := s.client.Set(ctx, s.redisKey(key), data, ttl).Err(); err != nil {
+		return fmt.Errorf("failed to store idempotency record: %w", err)
+	}
+	return nil
+}
+
+// extendClaimScript pushes back the expiry of a key only while it still holds the claim
+// it was given, so that a stored response or another request's claim is never touched
+var extendClaimScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("PEXPIRE", KEYS[1], ARGV[2])
+end
+return 0
+`)
+
+// Extend pushes back the expiry of key while it is claimed by the request with the given fingerprint
+func (s *RedisStore) Extend(ctx context.Context, key, fingerprint string, ttl time.Duration) error {
+	data, err := json.Marshal(Record{Fingerprint: fingerprint})
+	if err != nil {
+		return fmt.Errorf("failed to marshal idempotency record: %w", err)
+	}
+
+	if err := extendClaimScript.Run(ctx, s.client, []string{s.redisKey(key)}, data, ttl.Milliseconds()).Err(); err != nil {
+		return fmt.Errorf("failed to extend idempotency key: %w", err)
+	}
+	return nil
+}
+
+// Release removes key
+func (s *RedisStore) Release(ctx context.Context, key string) error {
+	if err := s.client.Del(ctx, s.redisKey(key)).Err(); err != nil {
+		return fmt.Errorf("failed to release idempotency key: %w", err)
+	}
+	return nil
+}
+
+// Close closes the Redis connection
+func (s *RedisStore) Close() error {
+	return s.client.Close()
+}
+
+func (s *RedisStore) redisKey(key string) string {
+	return fmt.Sprintf("%s:idempotency:%s", s.prefix, key)
+}